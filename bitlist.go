@@ -0,0 +1,315 @@
+package ssz
+
+import (
+	"errors"
+	"math/bits"
+)
+
+// ErrBitsLength is returned by the set operations of bitlists and bitvectors of different lengths
+var ErrBitsLength = errors.New("bitfields do not have the same length")
+
+// Bitlist is a SSZ bitlist. The last bit set is the length delimiter bit and it is not
+// part of the bitlist. It can be used as a field of the generated structs with the
+// 'ssz-max' tag (i.e. AggregationBits ssz.Bitlist `ssz-max:"2048"`).
+type Bitlist []byte
+
+// NewBitlist creates a bitlist of n bits set to zero
+func NewBitlist(n uint64) Bitlist {
+	b := make(Bitlist, n/8+1)
+	b[n/8] = 1 << (n % 8)
+	return b
+}
+
+// Len returns the number of bits of the bitlist without the length delimiter bit
+func (b Bitlist) Len() uint64 {
+	if len(b) == 0 || b[len(b)-1] == 0 {
+		return 0
+	}
+	return uint64(8*(len(b)-1) + bits.Len8(b[len(b)-1]) - 1)
+}
+
+// BitAt returns the bit at the index. It is false if the index is out of range
+func (b Bitlist) BitAt(i uint64) bool {
+	if i >= b.Len() {
+		return false
+	}
+	return b[i/8]&(1<<(i%8)) != 0
+}
+
+// SetBitAt sets the bit at the index. It does nothing if the index is out of range
+func (b Bitlist) SetBitAt(i uint64, val bool) {
+	if i >= b.Len() {
+		return
+	}
+	if val {
+		b[i/8] |= 1 << (i % 8)
+	} else {
+		b[i/8] &^= 1 << (i % 8)
+	}
+}
+
+// Count returns the number of bits set without the length delimiter bit
+func (b Bitlist) Count() uint64 {
+	if len(b) == 0 {
+		return 0
+	}
+	count := 0
+	for _, v := range b {
+		count += bits.OnesCount8(v)
+	}
+	if b[len(b)-1] != 0 {
+		count--
+	}
+	return uint64(count)
+}
+
+// BitIndices returns the indices of the bits set
+func (b Bitlist) BitIndices() []int {
+	return bitIndices(b, b.Len())
+}
+
+// Or returns the union of two bitlists of the same length
+func (b Bitlist) Or(c Bitlist) (Bitlist, error) {
+	if !b.sameLength(c) {
+		return nil, ErrBitsLength
+	}
+	res := make(Bitlist, len(b))
+	for i := range b {
+		// the delimiter bit is at the same position in both
+		res[i] = b[i] | c[i]
+	}
+	return res, nil
+}
+
+// And returns the intersection of two bitlists of the same length
+func (b Bitlist) And(c Bitlist) (Bitlist, error) {
+	if !b.sameLength(c) {
+		return nil, ErrBitsLength
+	}
+	res := make(Bitlist, len(b))
+	for i := range b {
+		res[i] = b[i] & c[i]
+	}
+	return res, nil
+}
+
+// Overlaps returns true if both bitlists of the same length have a bit set at the same index
+func (b Bitlist) Overlaps(c Bitlist) (bool, error) {
+	if !b.sameLength(c) {
+		return false, ErrBitsLength
+	}
+	n := b.Len()
+	for i := range b {
+		mask := b[i] & c[i]
+		if uint64(i) == n/8 {
+			// remove the delimiter bit
+			mask &^= 1 << (n % 8)
+		}
+		if mask != 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Contains returns true if all the bits set of the other bitlist of the same length are set
+func (b Bitlist) Contains(c Bitlist) (bool, error) {
+	if !b.sameLength(c) {
+		return false, ErrBitsLength
+	}
+	for i := range b {
+		if b[i]&c[i] != c[i] {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (b Bitlist) sameLength(c Bitlist) bool {
+	return len(b) == len(c) && b.Len() == c.Len()
+}
+
+// BitvectorOf is a SSZ bitvector of N bits. The bits over the size in the last byte are
+// padding bits, they are never set and they are ignored by the bit functions. It can be used
+// as a field of the generated structs (i.e. JustificationBits ssz.BitvectorOf[ssz.Limit4]).
+type BitvectorOf[N Limit] []byte
+
+// NewBitvectorOf creates a bitvector of N bits set to zero
+func NewBitvectorOf[N Limit]() BitvectorOf[N] {
+	return make(BitvectorOf[N], (limitOf[N]()+7)/8)
+}
+
+// Len returns the number of bits of the bitvector
+func (b BitvectorOf[N]) Len() uint64 {
+	return limitOf[N]()
+}
+
+// BitAt returns the bit at the index. It is false if the index is out of range
+func (b BitvectorOf[N]) BitAt(i uint64) bool {
+	if i >= b.Len() || i/8 >= uint64(len(b)) {
+		return false
+	}
+	return b[i/8]&(1<<(i%8)) != 0
+}
+
+// SetBitAt sets the bit at the index. It does nothing if the index is out of range
+func (b BitvectorOf[N]) SetBitAt(i uint64, val bool) {
+	if i >= b.Len() || i/8 >= uint64(len(b)) {
+		return
+	}
+	if val {
+		b[i/8] |= 1 << (i % 8)
+	} else {
+		b[i/8] &^= 1 << (i % 8)
+	}
+}
+
+// Count returns the number of bits set
+func (b BitvectorOf[N]) Count() uint64 {
+	return uint64(len(b.BitIndices()))
+}
+
+// BitIndices returns the indices of the bits set
+func (b BitvectorOf[N]) BitIndices() []int {
+	return bitIndices(b, b.Len())
+}
+
+// Or returns the union of two bitvectors
+func (b BitvectorOf[N]) Or(c BitvectorOf[N]) (BitvectorOf[N], error) {
+	if !b.validLength() || !c.validLength() {
+		return nil, ErrBitsLength
+	}
+	res := make(BitvectorOf[N], len(b))
+	for i := range b {
+		res[i] = b[i] | c[i]
+	}
+	res.clearPadding()
+	return res, nil
+}
+
+// And returns the intersection of two bitvectors
+func (b BitvectorOf[N]) And(c BitvectorOf[N]) (BitvectorOf[N], error) {
+	if !b.validLength() || !c.validLength() {
+		return nil, ErrBitsLength
+	}
+	res := make(BitvectorOf[N], len(b))
+	for i := range b {
+		res[i] = b[i] & c[i]
+	}
+	res.clearPadding()
+	return res, nil
+}
+
+// Overlaps returns true if both bitvectors have a bit set at the same index
+func (b BitvectorOf[N]) Overlaps(c BitvectorOf[N]) (bool, error) {
+	res, err := b.And(c)
+	if err != nil {
+		return false, err
+	}
+	return res.Count() != 0, nil
+}
+
+// Contains returns true if all the bits set of the other bitvector are set
+func (b BitvectorOf[N]) Contains(c BitvectorOf[N]) (bool, error) {
+	res, err := b.And(c)
+	if err != nil {
+		return false, err
+	}
+	return res.Count() == c.Count(), nil
+}
+
+func (b BitvectorOf[N]) validLength() bool {
+	return uint64(len(b)) == (b.Len()+7)/8
+}
+
+// clearPadding unsets the bits over the size of the bitvector
+func (b BitvectorOf[N]) clearPadding() {
+	if n := b.Len(); n%8 != 0 && len(b) != 0 {
+		b[len(b)-1] &= 1<<(n%8) - 1
+	}
+}
+
+// SizeSSZ returns the ssz encoded size in bytes of the bitvector
+func (b BitvectorOf[N]) SizeSSZ() int {
+	return int((b.Len() + 7) / 8)
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes of the bitvector
+func (b BitvectorOf[N]) MinSSZSize() uint64 {
+	return (b.Len() + 7) / 8
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes of the bitvector
+func (b BitvectorOf[N]) MaxSSZSize() uint64 {
+	return (b.Len() + 7) / 8
+}
+
+// MarshalSSZ ssz marshals the bitvector
+func (b BitvectorOf[N]) MarshalSSZ() ([]byte, error) {
+	return MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the bitvector to a target array
+func (b BitvectorOf[N]) MarshalSSZTo(dst []byte) ([]byte, error) {
+	if !b.validLength() {
+		return nil, ErrBytesLength
+	}
+	if err := ValidateBitvector(b, b.Len()); err != nil {
+		return nil, err
+	}
+	return append(dst, b...), nil
+}
+
+// UnmarshalSSZ ssz unmarshals the bitvector
+func (b *BitvectorOf[N]) UnmarshalSSZ(buf []byte) error {
+	if uint64(len(buf)) != (b.Len()+7)/8 {
+		return ErrSize
+	}
+	if err := ValidateBitvector(buf, b.Len()); err != nil {
+		return err
+	}
+	*b = append((*b)[:0], buf...)
+	return nil
+}
+
+// HashTreeRoot ssz hashes the bitvector
+func (b BitvectorOf[N]) HashTreeRoot() ([32]byte, error) {
+	return HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the bitvector with a hasher
+func (b BitvectorOf[N]) HashTreeRootWith(hh HashWalker) error {
+	if !b.validLength() {
+		return ErrBytesLength
+	}
+	if err := ValidateBitvector(b, b.Len()); err != nil {
+		return err
+	}
+	hh.PutBytes(b)
+	return nil
+}
+
+// GetTree ssz hashes the bitvector
+func (b BitvectorOf[N]) GetTree() (*Node, error) {
+	return ProofTree(b)
+}
+
+// SchemaSSZ returns the schema of the tree of the bitvector
+func (b BitvectorOf[N]) SchemaSSZ() *Schema {
+	return SchemaBitvector(b.Len())
+}
+
+func bitIndices(b []byte, n uint64) []int {
+	indices := []int{}
+	for i, v := range b {
+		for v != 0 {
+			indx := 8*i + bits.TrailingZeros8(v)
+			if uint64(indx) >= n {
+				return indices
+			}
+			indices = append(indices, indx)
+			v &= v - 1
+		}
+	}
+	return indices
+}
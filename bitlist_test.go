@@ -0,0 +1,152 @@
+package ssz
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBitlistBits(t *testing.T) {
+	for _, n := range []uint64{0, 1, 7, 8, 9, 100} {
+		b := NewBitlist(n)
+		require.Equal(t, n, b.Len())
+		require.Equal(t, uint64(0), b.Count())
+		require.Equal(t, []byte(bitfield.NewBitlist(n)), []byte(b))
+		require.NoError(t, ValidateBitlist(b, n))
+	}
+
+	b := NewBitlist(10)
+	b.SetBitAt(1, true)
+	b.SetBitAt(9, true)
+	// out of range
+	b.SetBitAt(10, true)
+	require.Equal(t, uint64(10), b.Len())
+	require.Equal(t, uint64(2), b.Count())
+	require.True(t, b.BitAt(9))
+	require.False(t, b.BitAt(10))
+	require.Equal(t, []int{1, 9}, b.BitIndices())
+
+	b.SetBitAt(9, false)
+	require.Equal(t, []int{1}, b.BitIndices())
+	require.Equal(t, uint64(10), b.Len())
+}
+
+func TestBitlistSetOperations(t *testing.T) {
+	newBitlist := func(n uint64, indices ...uint64) Bitlist {
+		b := NewBitlist(n)
+		for _, i := range indices {
+			b.SetBitAt(i, true)
+		}
+		return b
+	}
+
+	a := newBitlist(12, 0, 3, 11)
+	b := newBitlist(12, 3, 5)
+	c := newBitlist(12, 5, 7)
+
+	or, err := a.Or(b)
+	require.NoError(t, err)
+	require.Equal(t, newBitlist(12, 0, 3, 5, 11), or)
+	require.Equal(t, uint64(12), or.Len())
+
+	and, err := a.And(b)
+	require.NoError(t, err)
+	require.Equal(t, newBitlist(12, 3), and)
+	require.Equal(t, uint64(12), and.Len())
+
+	ok, err := a.Overlaps(b)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// the delimiter bits do not overlap
+	ok, err = a.Overlaps(c)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	ok, err = or.Contains(b)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = b.Contains(or)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// different lengths
+	_, err = a.Or(NewBitlist(13))
+	require.ErrorIs(t, err, ErrBitsLength)
+	_, err = a.And(NewBitlist(20))
+	require.ErrorIs(t, err, ErrBitsLength)
+	_, err = a.Overlaps(nil)
+	require.ErrorIs(t, err, ErrBitsLength)
+	_, err = Bitlist{0x1}.Contains(Bitlist{})
+	require.ErrorIs(t, err, ErrBitsLength)
+}
+
+func TestBitvectorOf(t *testing.T) {
+	b := NewBitvectorOf[Limit4]()
+	require.Len(t, b, 1)
+	require.Equal(t, uint64(4), b.Len())
+
+	b.SetBitAt(0, true)
+	b.SetBitAt(2, true)
+	b.SetBitAt(8, true)
+	require.Equal(t, uint64(2), b.Count())
+	require.Equal(t, []int{0, 2}, b.BitIndices())
+	require.Equal(t, []byte(bitfield.Bitvector4{0x5}), []byte(b))
+
+	c := NewBitvectorOf[Limit4]()
+	c.SetBitAt(1, true)
+
+	or, err := b.Or(c)
+	require.NoError(t, err)
+	require.Equal(t, []int{0, 1, 2}, or.BitIndices())
+
+	and, err := b.And(c)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), and.Count())
+
+	ok, err := b.Overlaps(c)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	ok, err = or.Contains(c)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	_, err = b.Or(BitvectorOf[Limit4]{0x1, 0x0})
+	require.ErrorIs(t, err, ErrBitsLength)
+}
+
+func TestBitvectorOfPadding(t *testing.T) {
+	b := NewBitvectorOf[Limit4]()
+
+	// the padding bits cannot be set
+	for i := uint64(4); i < 8; i++ {
+		b.SetBitAt(i, true)
+		require.False(t, b.BitAt(i))
+	}
+	require.Equal(t, []byte{0x0}, []byte(b))
+
+	// the padding bits of an invalid value are ignored
+	b = BitvectorOf[Limit4]{0xf3}
+	require.Equal(t, uint64(2), b.Count())
+	require.Equal(t, []int{0, 1}, b.BitIndices())
+	require.False(t, b.BitAt(4))
+
+	or, err := b.Or(NewBitvectorOf[Limit4]())
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x3}, []byte(or))
+
+	ok, err := b.Overlaps(BitvectorOf[Limit4]{0x10})
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, err = b.MarshalSSZ()
+	require.ErrorIs(t, err, ErrBitvectorPadding)
+	require.ErrorIs(t, new(BitvectorOf[Limit4]).UnmarshalSSZ([]byte{0xf3}), ErrBitvectorPadding)
+
+	data, err := or.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x3}, data)
+}
@@ -0,0 +1,172 @@
+package codetrie
+
+import (
+	"fmt"
+	"math/bits"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+const (
+	// Version is the version of the code trie in the metadata
+	Version = 1
+
+	// ChunkSize is the size in bytes of a chunk of code
+	ChunkSize = 32
+
+	// PUSH1 and PUSH32 are the first and the last EVM opcodes followed by push data
+	PUSH1  = 0x60
+	PUSH32 = 0x7f
+
+	// SmallChunksLimit and BigChunksLimit are the maximum number of chunks
+	// of a CodeTrieSmall and a CodeTrieBig
+	SmallChunksLimit = 4
+	BigChunksLimit   = 1024
+)
+
+// Chunkify splits the bytecode in chunks of 32 bytes, the last one right-padded with zeros.
+// The FIO of a chunk is the offset of its first instruction, the bytes before it are the push data
+// of an instruction of a previous chunk. If the chunk has only push data, the FIO is the length
+// of the code in the chunk.
+func Chunkify(code []byte) []*Chunk {
+	num := (len(code) + ChunkSize - 1) / ChunkSize
+	chunks := make([]*Chunk, num)
+	for i := range chunks {
+		buf := make([]byte, ChunkSize)
+		copy(buf, code[i*ChunkSize:])
+		chunks[i] = &Chunk{Code: buf}
+	}
+
+	for pc := 0; pc < len(code); pc++ {
+		op := code[pc]
+		if op < PUSH1 || op > PUSH32 {
+			continue
+		}
+		// the push data is [pc+1, end)
+		end := pc + 1 + int(op-PUSH1) + 1
+		if end > len(code) {
+			end = len(code)
+		}
+		for i := pc/ChunkSize + 1; i*ChunkSize < end; i++ {
+			fio := end - i*ChunkSize
+			if fio > ChunkSize {
+				fio = ChunkSize
+			}
+			chunks[i].FIO = uint8(fio)
+		}
+		pc = end - 1
+	}
+	return chunks
+}
+
+// NewMetadata returns the metadata of the bytecode with the given code hash
+// (the keccak256 hash of the code in the account).
+func NewMetadata(code []byte, codeHash [32]byte) *Metadata {
+	return &Metadata{
+		Version:    Version,
+		CodeHash:   append([]byte{}, codeHash[:]...),
+		CodeLength: uint16(len(code)),
+	}
+}
+
+// NewCodeTrieSmall returns the code trie of a bytecode of up to 4 chunks
+func NewCodeTrieSmall(code []byte, codeHash [32]byte) (*CodeTrieSmall, error) {
+	chunks := Chunkify(code)
+	if len(chunks) > SmallChunksLimit {
+		return nil, ssz.ErrListTooBigFn("CodeTrieSmall.Chunks", len(chunks), SmallChunksLimit)
+	}
+	return &CodeTrieSmall{Metadata: NewMetadata(code, codeHash), Chunks: chunks}, nil
+}
+
+// NewCodeTrieBig returns the code trie of a bytecode of up to 1024 chunks
+func NewCodeTrieBig(code []byte, codeHash [32]byte) (*CodeTrieBig, error) {
+	chunks := Chunkify(code)
+	if len(chunks) > BigChunksLimit {
+		return nil, ssz.ErrListTooBigFn("CodeTrieBig.Chunks", len(chunks), BigChunksLimit)
+	}
+	return &CodeTrieBig{Metadata: NewMetadata(code, codeHash), Chunks: chunks}, nil
+}
+
+// ChunkGIndex returns the generalized index of the chunk at the given position
+// in a code trie with the given limit of chunks
+func ChunkGIndex(limit uint64, index uint64) (ssz.GIndex, error) {
+	if index >= limit {
+		return 0, fmt.Errorf("chunk %d out of range, the limit is %d", index, limit)
+	}
+	depth := 0
+	if limit > 1 {
+		depth = bits.Len64(limit - 1)
+	}
+	chunk, err := ssz.NewGIndex(depth, index)
+	if err != nil {
+		return 0, err
+	}
+	// the chunks are the left subtree of the 'Chunks' list, which is the
+	// second field of both CodeTrieSmall and CodeTrieBig
+	return ssz.ConcatGIndices(CodeTrieSmallChunksGIndex, 2, chunk)
+}
+
+// chunkLeaves returns the generalized indices of the 'FIO' and 'Code' fields of the chunks
+func chunkLeaves(limit uint64, indices []uint64) ([]ssz.GIndex, error) {
+	res := []ssz.GIndex{}
+	for _, index := range indices {
+		chunk, err := ChunkGIndex(limit, index)
+		if err != nil {
+			return nil, err
+		}
+		fio, err := chunk.Concat(ChunkFIOGIndex)
+		if err != nil {
+			return nil, err
+		}
+		code, err := chunk.Concat(ChunkCodeGIndex)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, fio, code)
+	}
+	return res, nil
+}
+
+func proveChunks(obj ssz.HashRoot, limit uint64, indices []uint64) (*ssz.Multiproof, error) {
+	leaves, err := chunkLeaves(limit, indices)
+	if err != nil {
+		return nil, err
+	}
+	tree, err := obj.GetTree()
+	if err != nil {
+		return nil, err
+	}
+	return tree.ProveMulti(leaves)
+}
+
+// ProveChunksAt returns a multiproof of the chunks at the given positions. The leaves
+// of the proof are the 'FIO' and 'Code' fields of each chunk.
+func (c *CodeTrieSmall) ProveChunksAt(indices []uint64) (*ssz.Multiproof, error) {
+	return proveChunks(c, SmallChunksLimit, indices)
+}
+
+// ProveChunksAt returns a multiproof of the chunks at the given positions. The leaves
+// of the proof are the 'FIO' and 'Code' fields of each chunk.
+func (c *CodeTrieBig) ProveChunksAt(indices []uint64) (*ssz.Multiproof, error) {
+	return proveChunks(c, BigChunksLimit, indices)
+}
+
+// VerifyChunksAt verifies that the chunks are at the given positions of the code trie with
+// the given root and limit of chunks, with the hashes of a multiproof from ProveChunksAt.
+func VerifyChunksAt(root []byte, limit uint64, indices []uint64, chunks []*Chunk, hashes [][]byte) (bool, error) {
+	if len(indices) != len(chunks) {
+		return false, fmt.Errorf("%d chunks for %d indices", len(chunks), len(indices))
+	}
+	gindices, err := chunkLeaves(limit, indices)
+	if err != nil {
+		return false, err
+	}
+	leaves := [][]byte{}
+	for _, chunk := range chunks {
+		if len(chunk.Code) != ChunkSize {
+			return false, ssz.ErrBytesLengthFn("Chunk.Code", len(chunk.Code), ChunkSize)
+		}
+		leaves = append(leaves, ssz.LeafFromUint8(chunk.FIO).Hash(), chunk.Code)
+	}
+	return ssz.VerifyMultiproof(root, hashes, leaves, gindices)
+}
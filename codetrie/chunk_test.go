@@ -0,0 +1,148 @@
+package codetrie
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/minio/sha256-simd"
+)
+
+func TestChunkify(t *testing.T) {
+	// PUSH4 in the last byte of the first chunk
+	push4 := make([]byte, 40)
+	push4[31] = 0x63
+
+	// PUSH32 in the last byte of the first chunk, the second chunk is all push data
+	push32 := make([]byte, 70)
+	push32[31] = 0x7f
+
+	// PUSH2 with opcodes in the push data
+	pushData := make([]byte, 34)
+	pushData[29] = 0x61
+	pushData[30] = 0x7f
+	pushData[31] = 0x60
+
+	// PUSH32 truncated by the end of the code
+	truncated := make([]byte, 33)
+	truncated[31] = 0x7f
+
+	cases := []struct {
+		code []byte
+		fio  []uint8
+	}{
+		{nil, []uint8{}},
+		{[]byte{0x60, 0x01}, []uint8{0}},
+		{push4, []uint8{0, 4}},
+		{push32, []uint8{0, 32, 0}},
+		{pushData, []uint8{0, 0}},
+		{truncated, []uint8{0, 1}},
+	}
+
+	for _, c := range cases {
+		chunks := Chunkify(c.code)
+		if len(chunks) != len(c.fio) {
+			t.Fatalf("expected %d chunks but found %d", len(c.fio), len(chunks))
+		}
+		for i, chunk := range chunks {
+			if chunk.FIO != c.fio[i] {
+				t.Errorf("chunk %d: expected fio %d but found %d", i, c.fio[i], chunk.FIO)
+			}
+			code := make([]byte, ChunkSize)
+			copy(code, c.code[i*ChunkSize:])
+			if !bytes.Equal(chunk.Code, code) {
+				t.Errorf("chunk %d: incorrect code %x", i, chunk.Code)
+			}
+		}
+	}
+}
+
+func TestNewCodeTrie(t *testing.T) {
+	code := []byte{0x60, 0x01}
+	codeHash := sha256.Sum256(code)
+
+	codeTrie, err := NewCodeTrieSmall(code, codeHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the code trie of the proof tests
+	codePadded := make([]byte, 32)
+	copy(codePadded[:2], code[:])
+	expected := &CodeTrieSmall{
+		Metadata: &Metadata{Version: 1, CodeLength: uint16(len(code)), CodeHash: codeHash[:]},
+		Chunks:   []*Chunk{{FIO: 0, Code: codePadded}},
+	}
+
+	root, err := codeTrie.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedRoot, err := expected.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != expectedRoot {
+		t.Fatalf("incorrect root %x", root)
+	}
+
+	if _, err := NewCodeTrieSmall(make([]byte, 4*ChunkSize+1), codeHash); err == nil {
+		t.Fatal("expected the code to be too big for a small code trie")
+	}
+}
+
+func TestProveChunksAt(t *testing.T) {
+	code := make([]byte, 24*1024)
+	for i := range code {
+		code[i] = byte(i)
+	}
+	codeTrie, err := NewCodeTrieBig(code, sha256.Sum256(code))
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := codeTrie.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	indices := []uint64{0, 3, 767}
+	proof, err := codeTrie.ProveChunksAt(indices)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(proof.Leaves) != 2*len(indices) {
+		t.Fatalf("expected %d leaves but found %d", 2*len(indices), len(proof.Leaves))
+	}
+
+	chunks := []*Chunk{}
+	for _, index := range indices {
+		chunks = append(chunks, codeTrie.Chunks[index])
+	}
+	ok, err := VerifyChunksAt(root[:], BigChunksLimit, indices, chunks, proof.Hashes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("could not verify the chunks")
+	}
+
+	// incorrect fio
+	tampered := append([]*Chunk{}, chunks...)
+	tampered[1] = &Chunk{FIO: chunks[1].FIO + 1, Code: chunks[1].Code}
+	ok, err = VerifyChunksAt(root[:], BigChunksLimit, indices, tampered, proof.Hashes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Fatal("verified a chunk with an incorrect fio")
+	}
+
+	// chunks at other positions
+	ok, err = VerifyChunksAt(root[:], BigChunksLimit, []uint64{0, 4, 767}, chunks, proof.Hashes)
+	if err == nil && ok {
+		t.Fatal("verified the chunks at other positions")
+	}
+
+	if _, err := codeTrie.ProveChunksAt([]uint64{BigChunksLimit}); err == nil {
+		t.Fatal("expected an error for a chunk out of range")
+	}
+}
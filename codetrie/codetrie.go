@@ -0,0 +1,28 @@
+// Package codetrie merkleizes the EVM bytecode of an account as an SSZ code trie so that
+// the chunks of the code executed by a transaction can be proven in a stateless witness.
+package codetrie
+
+// Metadata of the code of an account
+type Metadata struct {
+	Version    uint8
+	CodeHash   []byte `ssz-size:"32"`
+	CodeLength uint16
+}
+
+// Chunk is a 32 bytes chunk of the code with the offset of its first instruction
+type Chunk struct {
+	FIO  uint8
+	Code []byte `ssz-size:"32"` // Last chunk is right-padded with zeros
+}
+
+// CodeTrieSmall is a code trie of up to 4 chunks
+type CodeTrieSmall struct {
+	Metadata *Metadata
+	Chunks   []*Chunk `ssz-max:"4"`
+}
+
+// CodeTrieBig is a code trie of up to 1024 chunks (32KB of code)
+type CodeTrieBig struct {
+	Metadata *Metadata
+	Chunks   []*Chunk `ssz-max:"1024"`
+}
@@ -0,0 +1,936 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: 5cb4ba6a35e4b897f77f75a1dc02e1ac0230f0c8092ab1dd0005411f3ea28dfd
+// Version: 0.1.3
+package codetrie
+
+import (
+	"io"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MarshalSSZ ssz marshals the Metadata object
+func (m *Metadata) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(m)
+}
+
+// MarshalSSZTo ssz marshals the Metadata object to a target array
+func (m *Metadata) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Version'
+	dst = ssz.MarshalUint8(dst, m.Version)
+
+	// Field (1) 'CodeHash'
+	if size := len(m.CodeHash); size != 32 {
+		err = ssz.ErrBytesLengthFn("Metadata.CodeHash", size, 32)
+		return
+	}
+	dst = append(dst, m.CodeHash...)
+
+	// Field (2) 'CodeLength'
+	dst = ssz.MarshalUint16(dst, m.CodeLength)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the Metadata object to a writer
+func (m *Metadata) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(m, writer)
+}
+
+// MarshalSSZToStream ssz marshals the Metadata object to a target array that is flushed to a stream writer
+func (m *Metadata) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Version'
+	dst = ssz.MarshalUint8(dst, m.Version)
+
+	// Field (1) 'CodeHash'
+	if size := len(m.CodeHash); size != 32 {
+		err = ssz.ErrBytesLengthFn("Metadata.CodeHash", size, 32)
+		return
+	}
+	dst = append(dst, m.CodeHash...)
+
+	// Field (2) 'CodeLength'
+	dst = ssz.MarshalUint16(dst, m.CodeLength)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Metadata object
+func (m *Metadata) UnmarshalSSZ(buf []byte) error {
+	return m.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Metadata object with the decoding options
+func (m *Metadata) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 35 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Version'
+	m.Version = ssz.UnmarshallUint8(buf[0:1])
+
+	// Field (1) 'CodeHash'
+	if cap(m.CodeHash) == 0 {
+		m.CodeHash = make([]byte, 0, len(buf[1:33]))
+	}
+	m.CodeHash = append(m.CodeHash, buf[1:33]...)
+
+	// Field (2) 'CodeLength'
+	m.CodeLength = ssz.UnmarshallUint16(buf[33:35])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Metadata object
+func (m *Metadata) SizeSSZ() (size int) {
+	size = 35
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Metadata object
+func (m *Metadata) MinSSZSize() uint64 {
+	return 35
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Metadata object
+func (m *Metadata) MaxSSZSize() uint64 {
+	return 35
+}
+
+// HashTreeRoot ssz hashes the Metadata object
+func (m *Metadata) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(m)
+}
+
+// HashTreeRootWith ssz hashes the Metadata object with a hasher
+func (m *Metadata) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Version'
+	hh.PutUint8(m.Version)
+
+	// Field (1) 'CodeHash'
+	if size := len(m.CodeHash); size != 32 {
+		err = ssz.ErrBytesLengthFn("Metadata.CodeHash", size, 32)
+		return
+	}
+	hh.PutBytes(m.CodeHash)
+
+	// Field (2) 'CodeLength'
+	hh.PutUint16(m.CodeLength)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded Metadata object without decoding it
+func (m *Metadata) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(m, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded Metadata object with a hasher
+func (m *Metadata) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 35 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Version'
+	hh.PutUint8(ssz.UnmarshallUint8(buf[0:1]))
+
+	// Field (1) 'CodeHash'
+	hh.PutBytes(buf[1:33])
+
+	// Field (2) 'CodeLength'
+	hh.PutUint16(ssz.UnmarshallUint16(buf[33:35]))
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Metadata object
+func (m *Metadata) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(m)
+}
+
+const (
+	// MetadataVersionGIndex is the generalized index of the 'Version' field
+	MetadataVersionGIndex ssz.GIndex = 4
+	// MetadataCodeHashGIndex is the generalized index of the 'CodeHash' field
+	MetadataCodeHashGIndex ssz.GIndex = 5
+	// MetadataCodeLengthGIndex is the generalized index of the 'CodeLength' field
+	MetadataCodeLengthGIndex ssz.GIndex = 6
+)
+
+// ProveVersion returns a merkle proof of the 'Version' field of the Metadata object
+func (m *Metadata) ProveVersion() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(m, MetadataVersionGIndex)
+}
+
+// VerifyMetadataVersion verifies a merkle proof of the 'Version' field of a Metadata object
+func VerifyMetadataVersion(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, MetadataVersionGIndex)
+}
+
+// ProveCodeHash returns a merkle proof of the 'CodeHash' field of the Metadata object
+func (m *Metadata) ProveCodeHash() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(m, MetadataCodeHashGIndex)
+}
+
+// VerifyMetadataCodeHash verifies a merkle proof of the 'CodeHash' field of a Metadata object
+func VerifyMetadataCodeHash(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, MetadataCodeHashGIndex)
+}
+
+// ProveCodeLength returns a merkle proof of the 'CodeLength' field of the Metadata object
+func (m *Metadata) ProveCodeLength() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(m, MetadataCodeLengthGIndex)
+}
+
+// VerifyMetadataCodeLength verifies a merkle proof of the 'CodeLength' field of a Metadata object
+func VerifyMetadataCodeLength(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, MetadataCodeLengthGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the Metadata object
+func (m *Metadata) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Version", Schema: ssz.SchemaUint(1)},
+		&ssz.SchemaField{Name: "CodeHash", Schema: ssz.SchemaBytes(32)},
+		&ssz.SchemaField{Name: "CodeLength", Schema: ssz.SchemaUint(2)},
+	)
+}
+
+// MarshalSSZ ssz marshals the Chunk object
+func (c *Chunk) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the Chunk object to a target array
+func (c *Chunk) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'FIO'
+	dst = ssz.MarshalUint8(dst, c.FIO)
+
+	// Field (1) 'Code'
+	if size := len(c.Code); size != 32 {
+		err = ssz.ErrBytesLengthFn("Chunk.Code", size, 32)
+		return
+	}
+	dst = append(dst, c.Code...)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the Chunk object to a writer
+func (c *Chunk) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(c, writer)
+}
+
+// MarshalSSZToStream ssz marshals the Chunk object to a target array that is flushed to a stream writer
+func (c *Chunk) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'FIO'
+	dst = ssz.MarshalUint8(dst, c.FIO)
+
+	// Field (1) 'Code'
+	if size := len(c.Code); size != 32 {
+		err = ssz.ErrBytesLengthFn("Chunk.Code", size, 32)
+		return
+	}
+	dst = append(dst, c.Code...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Chunk object
+func (c *Chunk) UnmarshalSSZ(buf []byte) error {
+	return c.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Chunk object with the decoding options
+func (c *Chunk) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 33 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'FIO'
+	c.FIO = ssz.UnmarshallUint8(buf[0:1])
+
+	// Field (1) 'Code'
+	if cap(c.Code) == 0 {
+		c.Code = make([]byte, 0, len(buf[1:33]))
+	}
+	c.Code = append(c.Code, buf[1:33]...)
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Chunk object
+func (c *Chunk) SizeSSZ() (size int) {
+	size = 33
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Chunk object
+func (c *Chunk) MinSSZSize() uint64 {
+	return 33
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Chunk object
+func (c *Chunk) MaxSSZSize() uint64 {
+	return 33
+}
+
+// HashTreeRoot ssz hashes the Chunk object
+func (c *Chunk) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the Chunk object with a hasher
+func (c *Chunk) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'FIO'
+	hh.PutUint8(c.FIO)
+
+	// Field (1) 'Code'
+	if size := len(c.Code); size != 32 {
+		err = ssz.ErrBytesLengthFn("Chunk.Code", size, 32)
+		return
+	}
+	hh.PutBytes(c.Code)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded Chunk object without decoding it
+func (c *Chunk) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(c, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded Chunk object with a hasher
+func (c *Chunk) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 33 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'FIO'
+	hh.PutUint8(ssz.UnmarshallUint8(buf[0:1]))
+
+	// Field (1) 'Code'
+	hh.PutBytes(buf[1:33])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Chunk object
+func (c *Chunk) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(c)
+}
+
+const (
+	// ChunkFIOGIndex is the generalized index of the 'FIO' field
+	ChunkFIOGIndex ssz.GIndex = 2
+	// ChunkCodeGIndex is the generalized index of the 'Code' field
+	ChunkCodeGIndex ssz.GIndex = 3
+)
+
+// ProveFIO returns a merkle proof of the 'FIO' field of the Chunk object
+func (c *Chunk) ProveFIO() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, ChunkFIOGIndex)
+}
+
+// VerifyChunkFIO verifies a merkle proof of the 'FIO' field of a Chunk object
+func VerifyChunkFIO(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ChunkFIOGIndex)
+}
+
+// ProveCode returns a merkle proof of the 'Code' field of the Chunk object
+func (c *Chunk) ProveCode() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, ChunkCodeGIndex)
+}
+
+// VerifyChunkCode verifies a merkle proof of the 'Code' field of a Chunk object
+func VerifyChunkCode(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ChunkCodeGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the Chunk object
+func (c *Chunk) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "FIO", Schema: ssz.SchemaUint(1)},
+		&ssz.SchemaField{Name: "Code", Schema: ssz.SchemaBytes(32)},
+	)
+}
+
+// MarshalSSZ ssz marshals the CodeTrieSmall object
+func (c *CodeTrieSmall) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the CodeTrieSmall object to a target array
+func (c *CodeTrieSmall) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(39)
+
+	// Field (0) 'Metadata'
+	if c.Metadata == nil {
+		c.Metadata = new(Metadata)
+	}
+	if dst, err = c.Metadata.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Offset (1) 'Chunks'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'Chunks'
+	if size := len(c.Chunks); size > 4 {
+		err = ssz.ErrListTooBigFn("CodeTrieSmall.Chunks", size, 4)
+		return
+	}
+	for ii := 0; ii < len(c.Chunks); ii++ {
+		if dst, err = c.Chunks[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the CodeTrieSmall object to a writer
+func (c *CodeTrieSmall) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(c, writer)
+}
+
+// MarshalSSZToStream ssz marshals the CodeTrieSmall object to a target array that is flushed to a stream writer
+func (c *CodeTrieSmall) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(39)
+
+	// Field (0) 'Metadata'
+	if c.Metadata == nil {
+		c.Metadata = new(Metadata)
+	}
+	if dst, err = c.Metadata.MarshalSSZToStream(dst, sw); err != nil {
+		return
+	}
+
+	// Offset (1) 'Chunks'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'Chunks'
+	if size := len(c.Chunks); size > 4 {
+		err = ssz.ErrListTooBigFn("CodeTrieSmall.Chunks", size, 4)
+		return
+	}
+	for ii := 0; ii < len(c.Chunks); ii++ {
+		if dst, err = c.Chunks[ii].MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the CodeTrieSmall object
+func (c *CodeTrieSmall) UnmarshalSSZ(buf []byte) error {
+	return c.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the CodeTrieSmall object with the decoding options
+func (c *CodeTrieSmall) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 39 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'Metadata'
+	if c.Metadata == nil {
+		c.Metadata = new(Metadata)
+	}
+	if err = c.Metadata.UnmarshalSSZWithOptions(buf[0:35], opts); err != nil {
+		return err
+	}
+
+	// Offset (1) 'Chunks'
+	if o1 = ssz.ReadOffset(buf[35:39]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = opts.ValidateFirstOffset(o1, 39); err != nil {
+		return err
+	}
+
+	// Field (1) 'Chunks'
+	{
+		buf = tail[o1:]
+		num, err := ssz.DivideInt2(len(buf), 33, 4)
+		if err != nil {
+			return err
+		}
+		c.Chunks = make([]*Chunk, num)
+		for ii := 0; ii < num; ii++ {
+			if c.Chunks[ii] == nil {
+				c.Chunks[ii] = new(Chunk)
+			}
+			if err = c.Chunks[ii].UnmarshalSSZWithOptions(buf[ii*33:(ii+1)*33], opts); err != nil {
+				return err
+			}
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the CodeTrieSmall object
+func (c *CodeTrieSmall) SizeSSZ() (size int) {
+	size = 39
+
+	// Field (1) 'Chunks'
+	size += len(c.Chunks) * 33
+
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the CodeTrieSmall object
+func (c *CodeTrieSmall) MinSSZSize() uint64 {
+	return 39
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the CodeTrieSmall object
+func (c *CodeTrieSmall) MaxSSZSize() uint64 {
+	return 171
+}
+
+// HashTreeRoot ssz hashes the CodeTrieSmall object
+func (c *CodeTrieSmall) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the CodeTrieSmall object with a hasher
+func (c *CodeTrieSmall) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Metadata'
+	if c.Metadata == nil {
+		c.Metadata = new(Metadata)
+	}
+	if err = c.Metadata.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Chunks'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(c.Chunks))
+		if num > 4 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range c.Chunks {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 4)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded CodeTrieSmall object without decoding it
+func (c *CodeTrieSmall) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(c, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded CodeTrieSmall object with a hasher
+func (c *CodeTrieSmall) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 39 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Offset (1) 'Chunks'
+	if o1 = ssz.ReadOffset(buf[35:39]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = ssz.ValidateFirstOffset(o1, 39); err != nil {
+		return err
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Metadata'
+	if err = (*Metadata)(nil).HashTreeRootSSZWith(hh, buf[0:35]); err != nil {
+		return err
+	}
+
+	// Field (1) 'Chunks'
+	{
+		buf := tail[o1:]
+		{
+			subIndx := hh.Index()
+			num, err := ssz.DivideInt2(len(buf), 33, 4)
+			if err != nil {
+				return err
+			}
+			for ii := 0; ii < num; ii++ {
+				if err = (*Chunk)(nil).HashTreeRootSSZWith(hh, buf[ii*33:(ii+1)*33]); err != nil {
+					return err
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, uint64(num), 4)
+		}
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the CodeTrieSmall object
+func (c *CodeTrieSmall) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(c)
+}
+
+const (
+	// CodeTrieSmallMetadataGIndex is the generalized index of the 'Metadata' field
+	CodeTrieSmallMetadataGIndex ssz.GIndex = 2
+	// CodeTrieSmallChunksGIndex is the generalized index of the 'Chunks' field
+	CodeTrieSmallChunksGIndex ssz.GIndex = 3
+)
+
+// ProveMetadata returns a merkle proof of the 'Metadata' field of the CodeTrieSmall object
+func (c *CodeTrieSmall) ProveMetadata() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, CodeTrieSmallMetadataGIndex)
+}
+
+// VerifyCodeTrieSmallMetadata verifies a merkle proof of the 'Metadata' field of a CodeTrieSmall object
+func VerifyCodeTrieSmallMetadata(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, CodeTrieSmallMetadataGIndex)
+}
+
+// ProveChunks returns a merkle proof of the 'Chunks' field of the CodeTrieSmall object
+func (c *CodeTrieSmall) ProveChunks() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, CodeTrieSmallChunksGIndex)
+}
+
+// VerifyCodeTrieSmallChunks verifies a merkle proof of the 'Chunks' field of a CodeTrieSmall object
+func VerifyCodeTrieSmallChunks(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, CodeTrieSmallChunksGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the CodeTrieSmall object
+func (c *CodeTrieSmall) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Metadata", Schema: (*Metadata)(nil).SchemaSSZ()},
+		&ssz.SchemaField{Name: "Chunks", Schema: ssz.SchemaList((*Chunk)(nil).SchemaSSZ(), 4)},
+	)
+}
+
+// MarshalSSZ ssz marshals the CodeTrieBig object
+func (c *CodeTrieBig) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the CodeTrieBig object to a target array
+func (c *CodeTrieBig) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(39)
+
+	// Field (0) 'Metadata'
+	if c.Metadata == nil {
+		c.Metadata = new(Metadata)
+	}
+	if dst, err = c.Metadata.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Offset (1) 'Chunks'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'Chunks'
+	if size := len(c.Chunks); size > 1024 {
+		err = ssz.ErrListTooBigFn("CodeTrieBig.Chunks", size, 1024)
+		return
+	}
+	for ii := 0; ii < len(c.Chunks); ii++ {
+		if dst, err = c.Chunks[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the CodeTrieBig object to a writer
+func (c *CodeTrieBig) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(c, writer)
+}
+
+// MarshalSSZToStream ssz marshals the CodeTrieBig object to a target array that is flushed to a stream writer
+func (c *CodeTrieBig) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(39)
+
+	// Field (0) 'Metadata'
+	if c.Metadata == nil {
+		c.Metadata = new(Metadata)
+	}
+	if dst, err = c.Metadata.MarshalSSZToStream(dst, sw); err != nil {
+		return
+	}
+
+	// Offset (1) 'Chunks'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'Chunks'
+	if size := len(c.Chunks); size > 1024 {
+		err = ssz.ErrListTooBigFn("CodeTrieBig.Chunks", size, 1024)
+		return
+	}
+	for ii := 0; ii < len(c.Chunks); ii++ {
+		if dst, err = c.Chunks[ii].MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the CodeTrieBig object
+func (c *CodeTrieBig) UnmarshalSSZ(buf []byte) error {
+	return c.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the CodeTrieBig object with the decoding options
+func (c *CodeTrieBig) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 39 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'Metadata'
+	if c.Metadata == nil {
+		c.Metadata = new(Metadata)
+	}
+	if err = c.Metadata.UnmarshalSSZWithOptions(buf[0:35], opts); err != nil {
+		return err
+	}
+
+	// Offset (1) 'Chunks'
+	if o1 = ssz.ReadOffset(buf[35:39]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = opts.ValidateFirstOffset(o1, 39); err != nil {
+		return err
+	}
+
+	// Field (1) 'Chunks'
+	{
+		buf = tail[o1:]
+		num, err := ssz.DivideInt2(len(buf), 33, 1024)
+		if err != nil {
+			return err
+		}
+		c.Chunks = make([]*Chunk, num)
+		for ii := 0; ii < num; ii++ {
+			if c.Chunks[ii] == nil {
+				c.Chunks[ii] = new(Chunk)
+			}
+			if err = c.Chunks[ii].UnmarshalSSZWithOptions(buf[ii*33:(ii+1)*33], opts); err != nil {
+				return err
+			}
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the CodeTrieBig object
+func (c *CodeTrieBig) SizeSSZ() (size int) {
+	size = 39
+
+	// Field (1) 'Chunks'
+	size += len(c.Chunks) * 33
+
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the CodeTrieBig object
+func (c *CodeTrieBig) MinSSZSize() uint64 {
+	return 39
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the CodeTrieBig object
+func (c *CodeTrieBig) MaxSSZSize() uint64 {
+	return 33831
+}
+
+// HashTreeRoot ssz hashes the CodeTrieBig object
+func (c *CodeTrieBig) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the CodeTrieBig object with a hasher
+func (c *CodeTrieBig) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Metadata'
+	if c.Metadata == nil {
+		c.Metadata = new(Metadata)
+	}
+	if err = c.Metadata.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Chunks'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(c.Chunks))
+		if num > 1024 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range c.Chunks {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 1024)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded CodeTrieBig object without decoding it
+func (c *CodeTrieBig) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(c, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded CodeTrieBig object with a hasher
+func (c *CodeTrieBig) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 39 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Offset (1) 'Chunks'
+	if o1 = ssz.ReadOffset(buf[35:39]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = ssz.ValidateFirstOffset(o1, 39); err != nil {
+		return err
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Metadata'
+	if err = (*Metadata)(nil).HashTreeRootSSZWith(hh, buf[0:35]); err != nil {
+		return err
+	}
+
+	// Field (1) 'Chunks'
+	{
+		buf := tail[o1:]
+		{
+			subIndx := hh.Index()
+			num, err := ssz.DivideInt2(len(buf), 33, 1024)
+			if err != nil {
+				return err
+			}
+			for ii := 0; ii < num; ii++ {
+				if err = (*Chunk)(nil).HashTreeRootSSZWith(hh, buf[ii*33:(ii+1)*33]); err != nil {
+					return err
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, uint64(num), 1024)
+		}
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the CodeTrieBig object
+func (c *CodeTrieBig) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(c)
+}
+
+const (
+	// CodeTrieBigMetadataGIndex is the generalized index of the 'Metadata' field
+	CodeTrieBigMetadataGIndex ssz.GIndex = 2
+	// CodeTrieBigChunksGIndex is the generalized index of the 'Chunks' field
+	CodeTrieBigChunksGIndex ssz.GIndex = 3
+)
+
+// ProveMetadata returns a merkle proof of the 'Metadata' field of the CodeTrieBig object
+func (c *CodeTrieBig) ProveMetadata() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, CodeTrieBigMetadataGIndex)
+}
+
+// VerifyCodeTrieBigMetadata verifies a merkle proof of the 'Metadata' field of a CodeTrieBig object
+func VerifyCodeTrieBigMetadata(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, CodeTrieBigMetadataGIndex)
+}
+
+// ProveChunks returns a merkle proof of the 'Chunks' field of the CodeTrieBig object
+func (c *CodeTrieBig) ProveChunks() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, CodeTrieBigChunksGIndex)
+}
+
+// VerifyCodeTrieBigChunks verifies a merkle proof of the 'Chunks' field of a CodeTrieBig object
+func VerifyCodeTrieBigChunks(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, CodeTrieBigChunksGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the CodeTrieBig object
+func (c *CodeTrieBig) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Metadata", Schema: (*Metadata)(nil).SchemaSSZ()},
+		&ssz.SchemaField{Name: "Chunks", Schema: ssz.SchemaList((*Chunk)(nil).SchemaSSZ(), 1024)},
+	)
+}
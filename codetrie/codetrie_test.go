@@ -1,4 +1,4 @@
-package tests
+package codetrie
 
 import (
 	"bytes"
@@ -16,7 +16,7 @@ func TestVerifyMetadataProof(t *testing.T) {
 		root  string
 		proof []string
 		leaf  string
-		index int
+		index ssz.GIndex
 		valid bool
 	}{
 		{
@@ -87,7 +87,7 @@ func TestVerifyCodeTrieProof(t *testing.T) {
 		root  string
 		proof []string
 		leaf  string
-		index int
+		index ssz.GIndex
 		valid bool
 	}{
 		{
@@ -151,7 +151,7 @@ func TestVerifyCodeTrieMultiProof(t *testing.T) {
 		root    string
 		proof   []string
 		leaves  []string
-		indices []int
+		indices []ssz.GIndex
 		valid   bool
 	}{
 		{
@@ -168,7 +168,7 @@ func TestVerifyCodeTrieMultiProof(t *testing.T) {
 				"0200000000000000000000000000000000000000000000000000000000000000",
 				"6001000000000000000000000000000000000000000000000000000000000000",
 			},
-			indices: []int{10, 49},
+			indices: []ssz.GIndex{10, 49},
 			valid:   true,
 		},
 	}
@@ -384,7 +384,7 @@ func TestProveMultiSmallCodeTrie(t *testing.T) {
 		t.Errorf("Failed to construct tree for codeTrie: %v\n", err)
 	}
 
-	proof, err := tree.ProveMulti([]int{10, 49})
+	proof, err := tree.ProveMulti([]ssz.GIndex{10, 49})
 	if err != nil {
 		t.Errorf("Failed to generate proof for codeTrie: %v\n", err)
 	}
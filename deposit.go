@@ -0,0 +1,230 @@
+package ssz
+
+//go:generate go run ./sszgen --path deposit.go --objs DepositTreeSnapshot
+
+import (
+	"errors"
+	"fmt"
+)
+
+// DepositContractDepth is the depth of the merkle tree of the deposit contract
+const DepositContractDepth = 32
+
+// DepositTree is the incremental merkle tree of the deposit contract (EIP-4881). The leaves are
+// appended in O(depth) since only the nodes in the path of the new leaf are created (see Node.Set)
+// and the rest of the tree is either shared with the previous tree or an empty subtree of the zero
+// hashes. The finalized deposits can be pruned, then, the finalized subtrees are only kept by their
+// hash and they can be exported and restored with a DepositTreeSnapshot.
+type DepositTree struct {
+	tree  *Node
+	count uint64
+
+	// finalizedCount is the number of finalized deposits
+	finalizedCount uint64
+
+	// finalizedBlockHash and finalizedBlockHeight are the execution block of the
+	// last finalization or nil if the tree has not been finalized
+	finalizedBlockHash   *[32]byte
+	finalizedBlockHeight uint64
+}
+
+// NewDepositTree creates an empty DepositTree
+func NewDepositTree() *DepositTree {
+	return &DepositTree{
+		tree: NewEmptyNode(DefaultHashing.ZeroHash(DepositContractDepth)),
+	}
+}
+
+// DepositTreeFromSnapshot creates a DepositTree with the finalized deposits of the snapshot.
+// The new leaves are appended after the finalized deposits.
+func DepositTreeFromSnapshot(s *DepositTreeSnapshot) (*DepositTree, error) {
+	root, err := s.CalculateRoot()
+	if err != nil {
+		return nil, err
+	}
+	if root != s.DepositRoot {
+		return nil, fmt.Errorf("snapshot root %x does not match the root of the finalized deposits %x", s.DepositRoot, root)
+	}
+
+	d := NewDepositTree()
+	subtrees := finalizedSubtrees(s.DepositCount)
+	for i, index := range subtrees {
+		node := &Node{value: append([]byte{}, s.Finalized[i][:]...), opaque: true}
+		if d.tree, err = d.tree.Set(index, node); err != nil {
+			return nil, err
+		}
+	}
+
+	d.count = s.DepositCount
+	d.finalizedCount = s.DepositCount
+	blockHash := s.ExecutionBlockHash
+	d.finalizedBlockHash = &blockHash
+	d.finalizedBlockHeight = s.ExecutionBlockHeight
+	return d, nil
+}
+
+// Count returns the number of deposits in the tree
+func (d *DepositTree) Count() uint64 {
+	return d.count
+}
+
+// PushLeaf appends the hash tree root of a deposit data to the tree
+func (d *DepositTree) PushLeaf(leaf [32]byte) error {
+	if d.count == 1<<DepositContractDepth {
+		return errors.New("deposit tree is full")
+	}
+	tree, err := d.tree.SetLeaf(GIndex(1<<DepositContractDepth+d.count), leaf[:])
+	if err != nil {
+		return err
+	}
+	d.tree = tree
+	d.count++
+	return nil
+}
+
+// Root returns the deposit root, the root of the tree with the deposit count mixed in
+func (d *DepositTree) Root() [32]byte {
+	var root [32]byte
+	copy(root[:], DefaultHashing.hash(d.tree.Hash(), LeafFromUint64(d.count).value))
+	return root
+}
+
+// Proof returns the merkle branch of the deposit at the given index. The proof is for the
+// deposit root and it has DepositContractDepth+1 hashes, the last one is the deposit count
+// (as in the 'proof' field of a Deposit). The finalized deposits cannot be proven.
+func (d *DepositTree) Proof(index uint64) (*Proof, error) {
+	if index >= d.count {
+		return nil, fmt.Errorf("deposit %d not found, the tree has %d deposits", index, d.count)
+	}
+	if index < d.finalizedCount {
+		return nil, fmt.Errorf("deposit %d is finalized", index)
+	}
+	proof, err := d.tree.Prove(GIndex(1<<DepositContractDepth + index))
+	if err != nil {
+		return nil, err
+	}
+
+	// the root with the deposit count is the parent of the tree
+	proof.Index = GIndex(1<<(DepositContractDepth+1) + index)
+	proof.Hashes = append(proof.Hashes, LeafFromUint64(d.count).value)
+	return proof, nil
+}
+
+// Finalize prunes the first 'count' deposits of the tree, which are included in the
+// finalized execution block with the given hash and height.
+func (d *DepositTree) Finalize(count uint64, blockHash [32]byte, blockHeight uint64) error {
+	if count > d.count {
+		return fmt.Errorf("cannot finalize %d deposits, the tree has %d deposits", count, d.count)
+	}
+	if count < d.finalizedCount {
+		return fmt.Errorf("cannot finalize %d deposits, %d deposits are already finalized", count, d.finalizedCount)
+	}
+
+	for _, index := range finalizedSubtrees(count) {
+		node, err := d.tree.Get(index)
+		if err != nil {
+			return err
+		}
+		if node.opaque {
+			// already finalized
+			continue
+		}
+		opaque := &Node{value: append([]byte{}, node.Hash()...), opaque: true}
+		if d.tree, err = d.tree.Set(index, opaque); err != nil {
+			return err
+		}
+	}
+
+	d.finalizedCount = count
+	d.finalizedBlockHash = &blockHash
+	d.finalizedBlockHeight = blockHeight
+	return nil
+}
+
+// Snapshot returns the EIP-4881 snapshot of the finalized deposits
+func (d *DepositTree) Snapshot() (*DepositTreeSnapshot, error) {
+	if d.finalizedBlockHash == nil {
+		return nil, errors.New("deposit tree is not finalized")
+	}
+
+	s := &DepositTreeSnapshot{
+		Finalized:            [][32]byte{},
+		DepositCount:         d.finalizedCount,
+		ExecutionBlockHash:   *d.finalizedBlockHash,
+		ExecutionBlockHeight: d.finalizedBlockHeight,
+	}
+	for _, index := range finalizedSubtrees(d.finalizedCount) {
+		node, err := d.tree.Get(index)
+		if err != nil {
+			return nil, err
+		}
+		var hash [32]byte
+		copy(hash[:], node.Hash())
+		s.Finalized = append(s.Finalized, hash)
+	}
+
+	root, err := s.CalculateRoot()
+	if err != nil {
+		return nil, err
+	}
+	s.DepositRoot = root
+	return s, nil
+}
+
+// finalizedSubtrees returns the generalized indices of the largest subtrees that
+// contain the first 'count' leaves, from left to right. There is a subtree of height
+// h for each bit h of the count.
+func finalizedSubtrees(count uint64) []GIndex {
+	res := []GIndex{}
+	offset := uint64(0)
+	for height := DepositContractDepth; height >= 0; height-- {
+		if count&(1<<height) == 0 {
+			continue
+		}
+		res = append(res, GIndex(1<<(DepositContractDepth-height)+offset>>height))
+		offset += 1 << height
+	}
+	return res
+}
+
+// DepositTreeSnapshot is the EIP-4881 snapshot of the finalized deposits of a DepositTree.
+// Finalized are the roots of the finalized subtrees (see DepositTree.Finalize) and
+// DepositRoot is the deposit root of the finalized deposits.
+type DepositTreeSnapshot struct {
+	Finalized            [][32]byte `ssz-max:"32"`
+	DepositRoot          [32]byte
+	DepositCount         uint64
+	ExecutionBlockHash   [32]byte
+	ExecutionBlockHeight uint64
+}
+
+// CalculateRoot returns the deposit root of the finalized deposits of the snapshot
+func (s *DepositTreeSnapshot) CalculateRoot() ([32]byte, error) {
+	var res [32]byte
+	if s.DepositCount > 1<<DepositContractDepth {
+		return res, fmt.Errorf("invalid deposit count %d", s.DepositCount)
+	}
+	if num := len(finalizedSubtrees(s.DepositCount)); num != len(s.Finalized) {
+		return res, fmt.Errorf("snapshot has %d finalized roots but %d expected", len(s.Finalized), num)
+	}
+
+	size := s.DepositCount
+	index := len(s.Finalized)
+	root := DefaultHashing.ZeroHash(0)
+	for level := 0; level < DepositContractDepth; level++ {
+		if size&1 == 1 {
+			index--
+			root = DefaultHashing.hash(s.Finalized[index][:], root)
+		} else {
+			root = DefaultHashing.hash(root, DefaultHashing.ZeroHash(level))
+		}
+		size >>= 1
+	}
+	if size&1 == 1 {
+		// the tree is full
+		root = s.Finalized[0][:]
+	}
+
+	copy(res[:], DefaultHashing.hash(root, LeafFromUint64(s.DepositCount).value))
+	return res, nil
+}
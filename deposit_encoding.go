@@ -0,0 +1,332 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: b1e921786745729038c356c7b8793e5f369a828756766ac1caee2ef4d4c07981
+// Version: 0.1.3
+package ssz
+
+import (
+	"io"
+)
+
+// MarshalSSZ ssz marshals the DepositTreeSnapshot object
+func (d *DepositTreeSnapshot) MarshalSSZ() ([]byte, error) {
+	return MarshalSSZ(d)
+}
+
+// MarshalSSZTo ssz marshals the DepositTreeSnapshot object to a target array
+func (d *DepositTreeSnapshot) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(84)
+
+	// Offset (0) 'Finalized'
+	dst = WriteOffset(dst, offset)
+
+	// Field (1) 'DepositRoot'
+	dst = append(dst, d.DepositRoot[:]...)
+
+	// Field (2) 'DepositCount'
+	dst = MarshalUint64(dst, d.DepositCount)
+
+	// Field (3) 'ExecutionBlockHash'
+	dst = append(dst, d.ExecutionBlockHash[:]...)
+
+	// Field (4) 'ExecutionBlockHeight'
+	dst = MarshalUint64(dst, d.ExecutionBlockHeight)
+
+	// Field (0) 'Finalized'
+	if size := len(d.Finalized); size > 32 {
+		err = ErrListTooBigFn("DepositTreeSnapshot.Finalized", size, 32)
+		return
+	}
+	for ii := 0; ii < len(d.Finalized); ii++ {
+		dst = append(dst, d.Finalized[ii][:]...)
+
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the DepositTreeSnapshot object to a writer
+func (d *DepositTreeSnapshot) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return MarshalSSZToWriter(d, writer)
+}
+
+// MarshalSSZToStream ssz marshals the DepositTreeSnapshot object to a target array that is flushed to a stream writer
+func (d *DepositTreeSnapshot) MarshalSSZToStream(buf []byte, sw *StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(84)
+
+	// Offset (0) 'Finalized'
+	dst = WriteOffset(dst, offset)
+
+	// Field (1) 'DepositRoot'
+	dst = append(dst, d.DepositRoot[:]...)
+
+	// Field (2) 'DepositCount'
+	dst = MarshalUint64(dst, d.DepositCount)
+
+	// Field (3) 'ExecutionBlockHash'
+	dst = append(dst, d.ExecutionBlockHash[:]...)
+
+	// Field (4) 'ExecutionBlockHeight'
+	dst = MarshalUint64(dst, d.ExecutionBlockHeight)
+
+	// Field (0) 'Finalized'
+	if size := len(d.Finalized); size > 32 {
+		err = ErrListTooBigFn("DepositTreeSnapshot.Finalized", size, 32)
+		return
+	}
+	for ii := 0; ii < len(d.Finalized); ii++ {
+		dst = append(dst, d.Finalized[ii][:]...)
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the DepositTreeSnapshot object
+func (d *DepositTreeSnapshot) UnmarshalSSZ(buf []byte) error {
+	return d.UnmarshalSSZWithOptions(buf, UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the DepositTreeSnapshot object with the decoding options
+func (d *DepositTreeSnapshot) UnmarshalSSZWithOptions(buf []byte, opts UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 84 {
+		return ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Finalized'
+	if o0 = ReadOffset(buf[0:4]); o0 > size {
+		return ErrOffset
+	}
+
+	if err = opts.ValidateFirstOffset(o0, 84); err != nil {
+		return err
+	}
+
+	// Field (1) 'DepositRoot'
+	copy(d.DepositRoot[:], buf[4:36])
+
+	// Field (2) 'DepositCount'
+	d.DepositCount = UnmarshallUint64(buf[36:44])
+
+	// Field (3) 'ExecutionBlockHash'
+	copy(d.ExecutionBlockHash[:], buf[44:76])
+
+	// Field (4) 'ExecutionBlockHeight'
+	d.ExecutionBlockHeight = UnmarshallUint64(buf[76:84])
+
+	// Field (0) 'Finalized'
+	{
+		buf = tail[o0:]
+		num, err := DivideInt2(len(buf), 32, 32)
+		if err != nil {
+			return err
+		}
+		d.Finalized = make([][32]byte, num)
+		for ii := 0; ii < num; ii++ {
+			copy(d.Finalized[ii][:], buf[ii*32:(ii+1)*32])
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the DepositTreeSnapshot object
+func (d *DepositTreeSnapshot) SizeSSZ() (size int) {
+	size = 84
+
+	// Field (0) 'Finalized'
+	size += len(d.Finalized) * 32
+
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the DepositTreeSnapshot object
+func (d *DepositTreeSnapshot) MinSSZSize() uint64 {
+	return 84
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the DepositTreeSnapshot object
+func (d *DepositTreeSnapshot) MaxSSZSize() uint64 {
+	return 1108
+}
+
+// HashTreeRoot ssz hashes the DepositTreeSnapshot object
+func (d *DepositTreeSnapshot) HashTreeRoot() ([32]byte, error) {
+	return HashWithDefaultHasher(d)
+}
+
+// HashTreeRootWith ssz hashes the DepositTreeSnapshot object with a hasher
+func (d *DepositTreeSnapshot) HashTreeRootWith(hh HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Finalized'
+	{
+		if size := len(d.Finalized); size > 32 {
+			err = ErrListTooBigFn("DepositTreeSnapshot.Finalized", size, 32)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range d.Finalized {
+			hh.Append(i[:])
+		}
+		numItems := uint64(len(d.Finalized))
+		hh.MerkleizeWithMixin(subIndx, numItems, 32)
+	}
+
+	// Field (1) 'DepositRoot'
+	hh.PutBytes(d.DepositRoot[:])
+
+	// Field (2) 'DepositCount'
+	hh.PutUint64(d.DepositCount)
+
+	// Field (3) 'ExecutionBlockHash'
+	hh.PutBytes(d.ExecutionBlockHash[:])
+
+	// Field (4) 'ExecutionBlockHeight'
+	hh.PutUint64(d.ExecutionBlockHeight)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded DepositTreeSnapshot object without decoding it
+func (d *DepositTreeSnapshot) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return HashSSZWithDefaultHasher(d, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded DepositTreeSnapshot object with a hasher
+func (d *DepositTreeSnapshot) HashTreeRootSSZWith(hh HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 84 {
+		return ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Finalized'
+	if o0 = ReadOffset(buf[0:4]); o0 > size {
+		return ErrOffset
+	}
+
+	if err = ValidateFirstOffset(o0, 84); err != nil {
+		return err
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Finalized'
+	{
+		buf := tail[o0:]
+		{
+			subIndx := hh.Index()
+			num, err := DivideInt2(len(buf), 32, 32)
+			if err != nil {
+				return err
+			}
+			hh.Append(buf)
+			hh.MerkleizeWithMixin(subIndx, uint64(num), 32)
+		}
+	}
+
+	// Field (1) 'DepositRoot'
+	hh.PutBytes(buf[4:36])
+
+	// Field (2) 'DepositCount'
+	hh.PutUint64(UnmarshallUint64(buf[36:44]))
+
+	// Field (3) 'ExecutionBlockHash'
+	hh.PutBytes(buf[44:76])
+
+	// Field (4) 'ExecutionBlockHeight'
+	hh.PutUint64(UnmarshallUint64(buf[76:84]))
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the DepositTreeSnapshot object
+func (d *DepositTreeSnapshot) GetTree() (*Node, error) {
+	return ProofTree(d)
+}
+
+const (
+	// DepositTreeSnapshotFinalizedGIndex is the generalized index of the 'Finalized' field
+	DepositTreeSnapshotFinalizedGIndex GIndex = 8
+	// DepositTreeSnapshotDepositRootGIndex is the generalized index of the 'DepositRoot' field
+	DepositTreeSnapshotDepositRootGIndex GIndex = 9
+	// DepositTreeSnapshotDepositCountGIndex is the generalized index of the 'DepositCount' field
+	DepositTreeSnapshotDepositCountGIndex GIndex = 10
+	// DepositTreeSnapshotExecutionBlockHashGIndex is the generalized index of the 'ExecutionBlockHash' field
+	DepositTreeSnapshotExecutionBlockHashGIndex GIndex = 11
+	// DepositTreeSnapshotExecutionBlockHeightGIndex is the generalized index of the 'ExecutionBlockHeight' field
+	DepositTreeSnapshotExecutionBlockHeightGIndex GIndex = 12
+)
+
+// ProveFinalized returns a merkle proof of the 'Finalized' field of the DepositTreeSnapshot object
+func (d *DepositTreeSnapshot) ProveFinalized() (*Proof, error) {
+	return ProveGIndex(d, DepositTreeSnapshotFinalizedGIndex)
+}
+
+// VerifyDepositTreeSnapshotFinalized verifies a merkle proof of the 'Finalized' field of a DepositTreeSnapshot object
+func VerifyDepositTreeSnapshotFinalized(root []byte, proof *Proof) (bool, error) {
+	return VerifyProofAtGIndex(root, proof, DepositTreeSnapshotFinalizedGIndex)
+}
+
+// ProveDepositRoot returns a merkle proof of the 'DepositRoot' field of the DepositTreeSnapshot object
+func (d *DepositTreeSnapshot) ProveDepositRoot() (*Proof, error) {
+	return ProveGIndex(d, DepositTreeSnapshotDepositRootGIndex)
+}
+
+// VerifyDepositTreeSnapshotDepositRoot verifies a merkle proof of the 'DepositRoot' field of a DepositTreeSnapshot object
+func VerifyDepositTreeSnapshotDepositRoot(root []byte, proof *Proof) (bool, error) {
+	return VerifyProofAtGIndex(root, proof, DepositTreeSnapshotDepositRootGIndex)
+}
+
+// ProveDepositCount returns a merkle proof of the 'DepositCount' field of the DepositTreeSnapshot object
+func (d *DepositTreeSnapshot) ProveDepositCount() (*Proof, error) {
+	return ProveGIndex(d, DepositTreeSnapshotDepositCountGIndex)
+}
+
+// VerifyDepositTreeSnapshotDepositCount verifies a merkle proof of the 'DepositCount' field of a DepositTreeSnapshot object
+func VerifyDepositTreeSnapshotDepositCount(root []byte, proof *Proof) (bool, error) {
+	return VerifyProofAtGIndex(root, proof, DepositTreeSnapshotDepositCountGIndex)
+}
+
+// ProveExecutionBlockHash returns a merkle proof of the 'ExecutionBlockHash' field of the DepositTreeSnapshot object
+func (d *DepositTreeSnapshot) ProveExecutionBlockHash() (*Proof, error) {
+	return ProveGIndex(d, DepositTreeSnapshotExecutionBlockHashGIndex)
+}
+
+// VerifyDepositTreeSnapshotExecutionBlockHash verifies a merkle proof of the 'ExecutionBlockHash' field of a DepositTreeSnapshot object
+func VerifyDepositTreeSnapshotExecutionBlockHash(root []byte, proof *Proof) (bool, error) {
+	return VerifyProofAtGIndex(root, proof, DepositTreeSnapshotExecutionBlockHashGIndex)
+}
+
+// ProveExecutionBlockHeight returns a merkle proof of the 'ExecutionBlockHeight' field of the DepositTreeSnapshot object
+func (d *DepositTreeSnapshot) ProveExecutionBlockHeight() (*Proof, error) {
+	return ProveGIndex(d, DepositTreeSnapshotExecutionBlockHeightGIndex)
+}
+
+// VerifyDepositTreeSnapshotExecutionBlockHeight verifies a merkle proof of the 'ExecutionBlockHeight' field of a DepositTreeSnapshot object
+func VerifyDepositTreeSnapshotExecutionBlockHeight(root []byte, proof *Proof) (bool, error) {
+	return VerifyProofAtGIndex(root, proof, DepositTreeSnapshotExecutionBlockHeightGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the DepositTreeSnapshot object
+func (d *DepositTreeSnapshot) SchemaSSZ() *Schema {
+	return SchemaContainer(
+		&SchemaField{Name: "Finalized", Schema: SchemaList(SchemaBytes(32), 32)},
+		&SchemaField{Name: "DepositRoot", Schema: SchemaBytes(32)},
+		&SchemaField{Name: "DepositCount", Schema: SchemaUint(8)},
+		&SchemaField{Name: "ExecutionBlockHash", Schema: SchemaBytes(32)},
+		&SchemaField{Name: "ExecutionBlockHeight", Schema: SchemaUint(8)},
+	)
+}
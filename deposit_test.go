@@ -0,0 +1,190 @@
+package ssz
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// depositContract is the incremental merkle tree of the deposit contract
+type depositContract struct {
+	branch [DepositContractDepth][]byte
+	count  uint64
+}
+
+func (d *depositContract) deposit(leaf []byte) {
+	d.count++
+	size := d.count
+	node := leaf
+	for height := 0; height < DepositContractDepth; height++ {
+		if size&1 == 1 {
+			d.branch[height] = node
+			return
+		}
+		node = DefaultHashing.hash(d.branch[height], node)
+		size >>= 1
+	}
+}
+
+func (d *depositContract) root() [32]byte {
+	node := DefaultHashing.ZeroHash(0)
+	size := d.count
+	for height := 0; height < DepositContractDepth; height++ {
+		if size&1 == 1 {
+			node = DefaultHashing.hash(d.branch[height], node)
+		} else {
+			node = DefaultHashing.hash(node, DefaultHashing.ZeroHash(height))
+		}
+		size >>= 1
+	}
+	var res [32]byte
+	copy(res[:], DefaultHashing.hash(node, LeafFromUint64(d.count).value))
+	return res
+}
+
+func depositLeaf(i uint64) [32]byte {
+	var leaf [32]byte
+	copy(leaf[:], LeafFromUint64(i+1).value)
+	return leaf
+}
+
+func TestDepositTree(t *testing.T) {
+	tree := NewDepositTree()
+	contract := &depositContract{}
+	require.Equal(t, contract.root(), tree.Root())
+
+	for i := uint64(0); i < 20; i++ {
+		leaf := depositLeaf(i)
+		require.NoError(t, tree.PushLeaf(leaf))
+		contract.deposit(leaf[:])
+		require.Equal(t, contract.root(), tree.Root())
+	}
+	require.Equal(t, uint64(20), tree.Count())
+
+	root := tree.Root()
+	for i := uint64(0); i < 20; i++ {
+		proof, err := tree.Proof(i)
+		require.NoError(t, err)
+		require.Len(t, proof.Hashes, DepositContractDepth+1)
+
+		leaf := depositLeaf(i)
+		require.Equal(t, leaf[:], proof.Leaf)
+
+		ok, err := VerifyProof(root[:], proof)
+		require.NoError(t, err)
+		require.True(t, ok)
+	}
+
+	_, err := tree.Proof(20)
+	require.Error(t, err)
+}
+
+func TestDepositTreeFinalize(t *testing.T) {
+	tree := NewDepositTree()
+	contract := &depositContract{}
+
+	_, err := tree.Snapshot()
+	require.Error(t, err)
+
+	push := func(tree *DepositTree, from, to uint64) {
+		for i := from; i < to; i++ {
+			leaf := depositLeaf(i)
+			require.NoError(t, tree.PushLeaf(leaf))
+		}
+	}
+	push(tree, 0, 13)
+
+	for _, count := range []uint64{5, 5, 8, 13} {
+		require.NoError(t, tree.Finalize(count, [32]byte{0x1}, count*10))
+		require.Equal(t, uint64(13), tree.Count())
+
+		for i := uint64(0); i < 13; i++ {
+			_, err := tree.Proof(i)
+			require.Equal(t, i < count, err != nil, "deposit %d finalized %d", i, count)
+		}
+
+		snapshot, err := tree.Snapshot()
+		require.NoError(t, err)
+		require.Equal(t, count, snapshot.DepositCount)
+		require.Equal(t, count*10, snapshot.ExecutionBlockHeight)
+
+		// the root of the first 'count' deposits
+		contract = &depositContract{}
+		for i := uint64(0); i < count; i++ {
+			leaf := depositLeaf(i)
+			contract.deposit(leaf[:])
+		}
+		require.Equal(t, contract.root(), snapshot.DepositRoot)
+
+		// ssz round trip
+		data, err := snapshot.MarshalSSZ()
+		require.NoError(t, err)
+		snapshot2 := new(DepositTreeSnapshot)
+		require.NoError(t, snapshot2.UnmarshalSSZ(data))
+		require.Equal(t, snapshot, snapshot2)
+
+		snapshotRoot, err := snapshot.HashTreeRoot()
+		require.NoError(t, err)
+		sszRoot, err := new(DepositTreeSnapshot).HashTreeRootSSZ(data)
+		require.NoError(t, err)
+		require.Equal(t, snapshotRoot, sszRoot)
+
+		// the tree from the snapshot has the same roots with the new deposits
+		tree2, err := DepositTreeFromSnapshot(snapshot2)
+		require.NoError(t, err)
+		require.Equal(t, snapshot.DepositRoot, tree2.Root())
+
+		push(tree2, count, 20)
+		push(tree, 13, 20)
+		require.Equal(t, tree.Root(), tree2.Root())
+
+		proof, err := tree2.Proof(19)
+		require.NoError(t, err)
+		root := tree2.Root()
+		ok, err := VerifyProof(root[:], proof)
+		require.NoError(t, err)
+		require.True(t, ok)
+
+		// remove the new deposits
+		tree = NewDepositTree()
+		push(tree, 0, 13)
+		require.NoError(t, tree.Finalize(count, [32]byte{0x1}, count*10))
+	}
+
+	// cannot finalize less deposits
+	require.Error(t, tree.Finalize(8, [32]byte{}, 0))
+	// or more than the deposits of the tree
+	require.Error(t, tree.Finalize(14, [32]byte{}, 0))
+}
+
+func TestDepositTreeSnapshotInvalid(t *testing.T) {
+	tree := NewDepositTree()
+	for i := uint64(0); i < 7; i++ {
+		require.NoError(t, tree.PushLeaf(depositLeaf(i)))
+	}
+	require.NoError(t, tree.Finalize(7, [32]byte{}, 0))
+
+	snapshot, err := tree.Snapshot()
+	require.NoError(t, err)
+	require.Len(t, snapshot.Finalized, 3)
+
+	// wrong root
+	invalid := *snapshot
+	invalid.DepositRoot = [32]byte{}
+	_, err = DepositTreeFromSnapshot(&invalid)
+	require.Error(t, err)
+
+	// wrong number of subtrees
+	invalid = *snapshot
+	invalid.Finalized = invalid.Finalized[1:]
+	_, err = DepositTreeFromSnapshot(&invalid)
+	require.Error(t, err)
+
+	// gap between the fixed part and the finalized roots
+	data, err := snapshot.MarshalSSZ()
+	require.NoError(t, err)
+	gap := append(append(append([]byte{}, data[:84]...), make([]byte, 32)...), data[84:]...)
+	MarshalUint32(gap[:0], 84+32)
+	require.Equal(t, ErrInvalidVariableOffset, new(DepositTreeSnapshot).UnmarshalSSZ(gap))
+	require.NoError(t, new(DepositTreeSnapshot).UnmarshalSSZWithOptions(gap, UnmarshalOptions{Lenient: true}))
+}
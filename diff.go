@@ -0,0 +1,86 @@
+package ssz
+
+import "bytes"
+
+// DiffTrees returns the generalized indices of the minimal set of subtrees that are different
+// in both trees. The trees are walked from the root and only the subtrees with a different hash
+// are visited, then, the cost depends on the number of differences and not on the size of the trees.
+// An index is returned when the nodes cannot be compared further (i.e. leaves, opaque nodes or
+// subtrees with a different structure). The indices are sorted from left to right.
+func DiffTrees(a, b *Node) []GIndex {
+	res := []GIndex{}
+	diffTrees(a, b, 1, &res)
+	return res
+}
+
+// DiffTreesWithSchema returns the field paths of the subtrees that are different in both
+// trees (see DiffTrees and Schema.FieldPath). The chunks of basic elements are compared
+// element by element to return the path of each element that is different (i.e. 'Balances[1234]').
+func DiffTreesWithSchema(a, b *Node, s *Schema) []string {
+	paths := []string{}
+	for _, index := range DiffTrees(a, b) {
+		res := s.resolve(index)
+		if res.ok && res.elems != nil {
+			if elems, ok := diffElements(a, b, index, res.elems); ok {
+				paths = append(paths, elems...)
+				continue
+			}
+		}
+		paths = append(paths, res.path)
+	}
+	return paths
+}
+
+// diffElements returns the paths of the basic elements that are different in the chunk at the given index
+func diffElements(a, b *Node, index GIndex, elems *schemaElems) ([]string, bool) {
+	aLeaf, err := a.Get(index)
+	if err != nil {
+		return nil, false
+	}
+	bLeaf, err := b.Get(index)
+	if err != nil {
+		return nil, false
+	}
+	aChunk, bChunk := hashNode(aLeaf), hashNode(bLeaf)
+	if len(aChunk) != 32 || len(bChunk) != 32 {
+		return nil, false
+	}
+
+	paths := []string{}
+	for i := uint64(0); i < elems.to-elems.from; i++ {
+		var equal bool
+		if elems.bits < 8 {
+			bit := i * elems.bits
+			mask := byte(1) << (bit % 8)
+			equal = aChunk[bit/8]&mask == bChunk[bit/8]&mask
+		} else {
+			size := elems.bits / 8
+			equal = bytes.Equal(aChunk[i*size:(i+1)*size], bChunk[i*size:(i+1)*size])
+		}
+		if !equal {
+			paths = append(paths, elems.name(i))
+		}
+	}
+	if len(paths) == 0 {
+		// only the padding of the chunk is different
+		return nil, false
+	}
+	return paths, true
+}
+
+func diffTrees(a, b *Node, index GIndex, res *[]GIndex) {
+	if bytes.Equal(hashNode(a), hashNode(b)) {
+		return
+	}
+	if index.Depth() < 63 {
+		// the empty subtrees are expanded to compare them with the branches
+		aLeft, aRight, errA := a.children()
+		bLeft, bRight, errB := b.children()
+		if errA == nil && errB == nil {
+			diffTrees(aLeft, bLeft, index<<1, res)
+			diffTrees(aRight, bRight, index<<1|1, res)
+			return
+		}
+	}
+	*res = append(*res, index)
+}
@@ -3,6 +3,7 @@ package ssz
 import (
 	"encoding/binary"
 	"fmt"
+	"math"
 	"math/bits"
 	"time"
 )
@@ -13,6 +14,19 @@ func MarshalSSZ(m Marshaler) ([]byte, error) {
 	return m.MarshalSSZTo(buf[:0])
 }
 
+// MaxSizeUnbounded is the maximum size returned by MaxSSZSize for objects
+// whose maximum encoded size does not fit in an uint64 or is unknown.
+const MaxSizeUnbounded = math.MaxUint64
+
+// CheckSizeBounds validates the size of an encoding against the static bounds of
+// the object before decoding it.
+func CheckSizeBounds(buf []byte, b SizeBounder) error {
+	if size := uint64(len(buf)); size < b.MinSSZSize() || size > b.MaxSSZSize() {
+		return fmt.Errorf("%w: %d is out of bounds [%d, %d]", ErrSize, size, b.MinSSZSize(), b.MaxSSZSize())
+	}
+	return nil
+}
+
 // Errors
 
 var (
@@ -23,8 +37,80 @@ var (
 	ErrListTooBig            = fmt.Errorf("list length is higher than max value")
 	ErrEmptyBitlist          = fmt.Errorf("bitlist is empty")
 	ErrInvalidVariableOffset = fmt.Errorf("invalid ssz encoding. first variable element offset indexes into fixed value data")
+	ErrInvalidBool           = fmt.Errorf("invalid bool, the byte is not 0 or 1")
+	ErrBitvectorPadding      = fmt.Errorf("bitvector has bits set over its size")
 )
 
+// ---- strict decoding ----
+
+// UnmarshalOptions are the options to decode the objects generated by sszgen (see
+// UnmarshalerWithOptions). The zero value is the strict decoding, which rejects the non
+// canonical encodings so that encoding a decoded object returns the same input.
+type UnmarshalOptions struct {
+	// Lenient accepts the non canonical encodings. Booleans other than 1 are false, the padding
+	// bits of the bitvectors are kept and there can be a gap between the fixed part of a container
+	// and its first offset.
+	Lenient bool
+}
+
+// UnmarshalSSZWithOptions unmarshals an object with the decoding options. The objects
+// that do not implement UnmarshalerWithOptions are decoded with UnmarshalSSZ.
+func UnmarshalSSZWithOptions(u Unmarshaler, buf []byte, opts UnmarshalOptions) error {
+	if obj, ok := u.(UnmarshalerWithOptions); ok {
+		return obj.UnmarshalSSZWithOptions(buf, opts)
+	}
+	return u.UnmarshalSSZ(buf)
+}
+
+// ValidateBool validates that the encoding of a boolean is a single byte with 0 or 1
+func ValidateBool(src []byte) error {
+	return UnmarshalOptions{}.ValidateBool(src)
+}
+
+// ValidateBool validates that the byte of a boolean is 0 or 1 with the strict decoding
+func (o UnmarshalOptions) ValidateBool(src []byte) error {
+	if len(src) != 1 {
+		return ErrSize
+	}
+	if src[0] > 1 && !o.Lenient {
+		return ErrInvalidBool
+	}
+	return nil
+}
+
+// ValidateBitvector validates that the bits over the size of a bitvector of bitLen bits are not set
+func ValidateBitvector(buf []byte, bitLen uint64) error {
+	return UnmarshalOptions{}.ValidateBitvector(buf, bitLen)
+}
+
+// ValidateBitvector validates that the bits over the size of a bitvector of bitLen bits
+// are not set with the strict decoding
+func (o UnmarshalOptions) ValidateBitvector(buf []byte, bitLen uint64) error {
+	if bitLen%8 == 0 || uint64(len(buf)) != (bitLen+7)/8 || o.Lenient {
+		return nil
+	}
+	if buf[len(buf)-1]>>(bitLen%8) != 0 {
+		return ErrBitvectorPadding
+	}
+	return nil
+}
+
+// ValidateFirstOffset validates that the first offset of a container whose fixed part has
+// the given size is right after it.
+func ValidateFirstOffset(offset, fixedSize uint64) error {
+	return UnmarshalOptions{}.ValidateFirstOffset(offset, fixedSize)
+}
+
+// ValidateFirstOffset validates the first offset of a container whose fixed part has the given
+// size. The offset cannot point into the fixed part and, with the strict decoding, it has to be
+// right after it.
+func (o UnmarshalOptions) ValidateFirstOffset(offset, fixedSize uint64) error {
+	if offset < fixedSize || (offset != fixedSize && !o.Lenient) {
+		return ErrInvalidVariableOffset
+	}
+	return nil
+}
+
 func ErrBytesLengthFn(name string, found, expected int) error {
 	return fmt.Errorf("%s (%v): expected %d and %d found", name, ErrBytesLength, expected, found)
 }
@@ -235,6 +321,12 @@ func ValidateBitlist(buf []byte, bitLimit uint64) error {
 
 // DecodeDynamicLength decodes the length from the dynamic input
 func DecodeDynamicLength(buf []byte, maxSize int) (int, error) {
+	return UnmarshalOptions{}.DecodeDynamicLength(buf, maxSize)
+}
+
+// DecodeDynamicLength decodes the length from the dynamic input. With the strict
+// decoding the first offset of a list that is not empty cannot be zero.
+func (o UnmarshalOptions) DecodeDynamicLength(buf []byte, maxSize int) (int, error) {
 	if len(buf) == 0 {
 		return 0, nil
 	}
@@ -246,6 +338,10 @@ func DecodeDynamicLength(buf []byte, maxSize int) (int, error) {
 	if !ok {
 		return 0, fmt.Errorf("bad")
 	}
+	if length == 0 && !o.Lenient {
+		// the list is not empty
+		return 0, ErrInvalidVariableOffset
+	}
 	if length > maxSize {
 		return 0, fmt.Errorf("too big for the list")
 	}
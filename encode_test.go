@@ -52,6 +52,86 @@ func TestBitlist(t *testing.T) {
 	}
 }
 
+func readInvalidFixtures(t *testing.T, dir string) map[string][]byte {
+	res := map[string][]byte{}
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		serialized, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		res[filepath.Base(filepath.Dir(path))] = serialized
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res
+}
+
+func TestStrictDecoding_Bool(t *testing.T) {
+	for name, serialized := range readInvalidFixtures(t, "./spectests/fixtures/boolean") {
+		t.Run(name, func(t *testing.T) {
+			if err := ValidateBool(serialized); err != ErrInvalidBool {
+				t.Fatalf("expected invalid bool error but found %v", err)
+			}
+			if err := (UnmarshalOptions{Lenient: true}).ValidateBool(serialized); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+
+	// the size is checked even with the lenient decoding
+	for _, buf := range [][]byte{nil, {0, 0}} {
+		if err := (UnmarshalOptions{Lenient: true}).ValidateBool(buf); err != ErrSize {
+			t.Fatalf("expected size error but found %v", err)
+		}
+	}
+}
+
+func TestStrictDecoding_Bitvector(t *testing.T) {
+	for name, serialized := range readInvalidFixtures(t, "./spectests/fixtures/bitvector") {
+		t.Run(name, func(t *testing.T) {
+			// decode the size from name (i.e. bitvec_3_max_4)
+			size, err := strconv.Atoi(strings.Split(name, "_")[1])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := ValidateBitvector(serialized, uint64(size)); err != ErrBitvectorPadding {
+				t.Fatalf("expected padding error but found %v", err)
+			}
+			if err := (UnmarshalOptions{Lenient: true}).ValidateBitvector(serialized, uint64(size)); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestStrictDecoding_FirstOffset(t *testing.T) {
+	if err := ValidateFirstOffset(8, 8); err != nil {
+		t.Fatal(err)
+	}
+	if err := ValidateFirstOffset(4, 8); err != ErrInvalidVariableOffset {
+		t.Fatal("the offset cannot point to the fixed part")
+	}
+	if err := ValidateFirstOffset(12, 8); err != ErrInvalidVariableOffset {
+		t.Fatal("the offset has to be right after the fixed part")
+	}
+
+	lenient := UnmarshalOptions{Lenient: true}
+	if err := lenient.ValidateFirstOffset(12, 8); err != nil {
+		t.Fatal(err)
+	}
+	if err := lenient.ValidateFirstOffset(4, 8); err != ErrInvalidVariableOffset {
+		t.Fatal("the offset cannot point to the fixed part")
+	}
+}
+
 func TestBitlist_MaxValue(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -0,0 +1,198 @@
+// Package era reads and writes e2store files and the era archives of SSZ encoded beacon
+// blocks and states. An e2store file is a sequence of TLV entries with an 8 bytes header
+// (2 bytes type, 4 bytes little endian length and 2 reserved zero bytes) and the value.
+package era
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// HeaderSize is the size in bytes of the header of an entry
+const HeaderSize = 8
+
+// EntryType is the type of an e2store entry
+type EntryType [2]byte
+
+var (
+	// TypeEmpty is an entry without meaning
+	TypeEmpty = EntryType{0x00, 0x00}
+	// TypeCompressedSignedBeaconBlock is a snappy framed SSZ encoded SignedBeaconBlock
+	TypeCompressedSignedBeaconBlock = EntryType{0x01, 0x00}
+	// TypeCompressedBeaconState is a snappy framed SSZ encoded BeaconState
+	TypeCompressedBeaconState = EntryType{0x02, 0x00}
+	// TypeVersion is the first entry of an e2store file and it has no value
+	TypeVersion = EntryType{0x65, 0x32}
+	// TypeSlotIndex is the index of the offsets of the entries of the slots
+	TypeSlotIndex = EntryType{0x69, 0x32}
+)
+
+func (t EntryType) String() string {
+	return fmt.Sprintf("0x%02x%02x", t[0], t[1])
+}
+
+var (
+	// ErrReservedNotZero is returned when the reserved bytes of the header are not zero
+	ErrReservedNotZero = errors.New("e2store header reserved bytes are not zero")
+	// ErrValueTooBig is returned when a value does not fit in the length of the header
+	ErrValueTooBig = errors.New("e2store value too big")
+)
+
+// Header is the header of an e2store entry
+type Header struct {
+	Type   EntryType
+	Length uint32
+}
+
+func (h *Header) marshal() []byte {
+	buf := make([]byte, HeaderSize)
+	copy(buf[0:2], h.Type[:])
+	binary.LittleEndian.PutUint32(buf[2:6], h.Length)
+	return buf
+}
+
+func (h *Header) unmarshal(buf []byte) error {
+	copy(h.Type[:], buf[0:2])
+	h.Length = binary.LittleEndian.Uint32(buf[2:6])
+	if buf[6] != 0 || buf[7] != 0 {
+		return ErrReservedNotZero
+	}
+	return nil
+}
+
+// ReadHeaderAt reads the header of the entry at the given offset. It returns
+// io.EOF if there are no more entries at the offset.
+func ReadHeaderAt(r io.ReaderAt, off int64) (*Header, error) {
+	buf := make([]byte, HeaderSize)
+	if n, err := r.ReadAt(buf, off); n != HeaderSize {
+		if err == io.EOF && n != 0 {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	h := &Header{}
+	if err := h.unmarshal(buf); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// ReadEntryAt reads the type and the value of the entry at the given offset
+func ReadEntryAt(r io.ReaderAt, off int64) (EntryType, []byte, error) {
+	h, err := ReadHeaderAt(r, off)
+	if err == io.EOF {
+		return EntryType{}, nil, io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return EntryType{}, nil, err
+	}
+	value := make([]byte, h.Length)
+	if n, err := r.ReadAt(value, off+HeaderSize); n != len(value) {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return EntryType{}, nil, err
+	}
+	return h.Type, value, nil
+}
+
+// Writer writes e2store entries and keeps the offset of the next entry
+type Writer struct {
+	w      io.Writer
+	offset int64
+}
+
+// NewWriter creates a Writer of entries at the start of an e2store file
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// Offset returns the offset of the next entry
+func (w *Writer) Offset() int64 {
+	return w.offset
+}
+
+// WriteEntry writes an entry with the given type and value and
+// returns the number of bytes written
+func (w *Writer) WriteEntry(typ EntryType, value []byte) (int, error) {
+	if uint64(len(value)) > math.MaxUint32 {
+		return 0, ErrValueTooBig
+	}
+	h := &Header{Type: typ, Length: uint32(len(value))}
+
+	n, err := w.w.Write(h.marshal())
+	w.offset += int64(n)
+	if err != nil {
+		return n, err
+	}
+	m, err := w.w.Write(value)
+	w.offset += int64(m)
+	return n + m, err
+}
+
+// Iterator iterates over the entries of an e2store file. The values are only read
+// when they are requested so that the entries can be skipped without loading them.
+type Iterator struct {
+	r      io.ReaderAt
+	offset int64
+	next   int64
+	header *Header
+	err    error
+}
+
+// NewIterator creates an Iterator over the entries that start at the given offset
+func NewIterator(r io.ReaderAt, offset int64) *Iterator {
+	return &Iterator{r: r, next: offset}
+}
+
+// Next moves to the next entry and returns false at the end of the file or on error
+func (it *Iterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	h, err := ReadHeaderAt(it.r, it.next)
+	if err != nil {
+		if err != io.EOF {
+			it.err = err
+		}
+		return false
+	}
+	it.offset = it.next
+	it.header = h
+	it.next += HeaderSize + int64(h.Length)
+	return true
+}
+
+// Type returns the type of the current entry
+func (it *Iterator) Type() EntryType {
+	return it.header.Type
+}
+
+// Offset returns the offset of the current entry
+func (it *Iterator) Offset() int64 {
+	return it.offset
+}
+
+// Length returns the length of the value of the current entry
+func (it *Iterator) Length() uint32 {
+	return it.header.Length
+}
+
+// Reader returns a reader of the value of the current entry
+func (it *Iterator) Reader() io.Reader {
+	return io.NewSectionReader(it.r, it.offset+HeaderSize, int64(it.header.Length))
+}
+
+// Value reads the value of the current entry
+func (it *Iterator) Value() ([]byte, error) {
+	_, value, err := ReadEntryAt(it.r, it.offset)
+	return value, err
+}
+
+// Err returns the error that stopped the iteration
+func (it *Iterator) Err() error {
+	return it.err
+}
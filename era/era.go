@@ -0,0 +1,313 @@
+package era
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/golang/snappy"
+)
+
+var (
+	// ErrSlotNotFound is returned when a slot is not in the range of the slot index
+	ErrSlotNotFound = errors.New("slot not found in the era")
+	// ErrEmptySlot is returned when a slot of the slot index has no block
+	ErrEmptySlot = errors.New("slot without block")
+	// ErrDecompressedSize is returned when a decompressed entry is larger than the object
+	ErrDecompressedSize = errors.New("decompressed entry is too large")
+)
+
+// MaxDecompressedSize is the maximum size of the decompressed entries of the objects
+// without static bounds of their encoding (the ones that are not a ssz.SizeBounder)
+var MaxDecompressedSize uint64 = 1 << 30
+
+// SlotIndex is the value of a slot index entry, the offsets of the entries of consecutive
+// slots from the start slot. The offsets are relative to the start of the slot index entry
+// and they are 0 for the slots without an entry.
+type SlotIndex struct {
+	StartSlot uint64
+	Offsets   []int64
+}
+
+// MarshalBinary encodes the slot index as the value of an entry
+func (s *SlotIndex) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, slotIndexSize(len(s.Offsets)))
+	binary.LittleEndian.PutUint64(buf[0:8], s.StartSlot)
+	for i, offset := range s.Offsets {
+		binary.LittleEndian.PutUint64(buf[8+8*i:], uint64(offset))
+	}
+	binary.LittleEndian.PutUint64(buf[len(buf)-8:], uint64(len(s.Offsets)))
+	return buf, nil
+}
+
+// UnmarshalBinary decodes the value of a slot index entry
+func (s *SlotIndex) UnmarshalBinary(buf []byte) error {
+	if len(buf) < 16 || len(buf)%8 != 0 {
+		return fmt.Errorf("invalid slot index size %d", len(buf))
+	}
+	count := binary.LittleEndian.Uint64(buf[len(buf)-8:])
+	if count != uint64(len(buf)-16)/8 {
+		return fmt.Errorf("slot index has %d offsets but count is %d", (len(buf)-16)/8, count)
+	}
+	s.StartSlot = binary.LittleEndian.Uint64(buf[0:8])
+	s.Offsets = make([]int64, count)
+	for i := range s.Offsets {
+		s.Offsets[i] = int64(binary.LittleEndian.Uint64(buf[8+8*i:]))
+	}
+	return nil
+}
+
+func slotIndexSize(count int) int {
+	return 16 + 8*count
+}
+
+// Builder writes an era file: the version entry, the blocks of the era, the state at the
+// end of the era and the slot indices of the blocks and the state.
+type Builder struct {
+	w         *Writer
+	startSlot uint64
+	blocks    []int64
+	finalized bool
+}
+
+// NewBuilder creates a Builder of an era whose blocks start at the given slot
+func NewBuilder(w io.Writer, startSlot uint64) (*Builder, error) {
+	b := &Builder{w: NewWriter(w), startSlot: startSlot}
+	if _, err := b.w.WriteEntry(TypeVersion, nil); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// AddBlock writes a SignedBeaconBlock of the given slot. The blocks are written in increasing
+// order of slots and the slots between them are empty.
+func (b *Builder) AddBlock(slot uint64, block ssz.Marshaler) error {
+	if b.finalized {
+		return errors.New("era already finalized")
+	}
+	if slot < b.startSlot+uint64(len(b.blocks)) {
+		return fmt.Errorf("block slot %d is not after the last slot %d", slot, b.startSlot+uint64(len(b.blocks))-1)
+	}
+	offset := b.w.Offset()
+	if err := b.writeCompressed(TypeCompressedSignedBeaconBlock, block); err != nil {
+		return err
+	}
+	for b.startSlot+uint64(len(b.blocks)) < slot {
+		b.blocks = append(b.blocks, 0)
+	}
+	b.blocks = append(b.blocks, offset)
+	return nil
+}
+
+// Finalize writes the BeaconState at the end of the era and the slot indices. The block
+// index has the slots from the start slot to the slot of the state and it is not written
+// for an era without blocks (the genesis era).
+func (b *Builder) Finalize(stateSlot uint64, state ssz.Marshaler) error {
+	if b.finalized {
+		return errors.New("era already finalized")
+	}
+	if stateSlot < b.startSlot+uint64(len(b.blocks)) {
+		return fmt.Errorf("state slot %d is before the last block", stateSlot)
+	}
+	stateOffset := b.w.Offset()
+	if err := b.writeCompressed(TypeCompressedBeaconState, state); err != nil {
+		return err
+	}
+
+	if stateSlot > b.startSlot {
+		index := &SlotIndex{StartSlot: b.startSlot, Offsets: make([]int64, stateSlot-b.startSlot)}
+		indexOffset := b.w.Offset()
+		for i, offset := range b.blocks {
+			if offset != 0 {
+				index.Offsets[i] = offset - indexOffset
+			}
+		}
+		if err := b.writeIndex(index); err != nil {
+			return err
+		}
+	}
+
+	index := &SlotIndex{StartSlot: stateSlot, Offsets: []int64{stateOffset - b.w.Offset()}}
+	if err := b.writeIndex(index); err != nil {
+		return err
+	}
+	b.finalized = true
+	return nil
+}
+
+func (b *Builder) writeIndex(index *SlotIndex) error {
+	buf, err := index.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	_, err = b.w.WriteEntry(TypeSlotIndex, buf)
+	return err
+}
+
+func (b *Builder) writeCompressed(typ EntryType, obj ssz.Marshaler) error {
+	data, err := obj.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	sw := snappy.NewBufferedWriter(&buf)
+	if _, err := sw.Write(data); err != nil {
+		return err
+	}
+	if err := sw.Close(); err != nil {
+		return err
+	}
+	_, err = b.w.WriteEntry(typ, buf.Bytes())
+	return err
+}
+
+// Reader reads the blocks and the state of an era file by slot. Only the slot indices
+// are read when it is opened, the entries are read when they are requested.
+type Reader struct {
+	r io.ReaderAt
+
+	blocks      *SlotIndex
+	blocksIndex int64
+
+	state      *SlotIndex
+	stateIndex int64
+}
+
+// Open reads the slot indices at the end of an era file of the given size
+func Open(r io.ReaderAt, size int64) (*Reader, error) {
+	typ, _, err := ReadEntryAt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+	if typ != TypeVersion {
+		return nil, fmt.Errorf("expected version entry but found %s", typ)
+	}
+
+	e := &Reader{r: r}
+	if e.state, e.stateIndex, err = readIndexBefore(r, size); err != nil {
+		return nil, fmt.Errorf("failed to read the state index: %v", err)
+	}
+	if len(e.state.Offsets) != 1 {
+		return nil, fmt.Errorf("state index has %d offsets", len(e.state.Offsets))
+	}
+
+	// the block index is not written for the genesis era, the entry before the state
+	// index is the block index if its slots end at the slot of the state
+	blocks, blocksIndex, err := readIndexBefore(r, e.stateIndex)
+	if err == nil && blocks.StartSlot+uint64(len(blocks.Offsets)) == e.state.StartSlot {
+		e.blocks, e.blocksIndex = blocks, blocksIndex
+	}
+	return e, nil
+}
+
+// readIndexBefore reads the slot index entry that ends at the given offset
+func readIndexBefore(r io.ReaderAt, end int64) (*SlotIndex, int64, error) {
+	buf := make([]byte, 8)
+	if end < HeaderSize+int64(slotIndexSize(0)) {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	if n, err := r.ReadAt(buf, end-8); n != len(buf) {
+		return nil, 0, err
+	}
+	count := binary.LittleEndian.Uint64(buf)
+	if count > uint64(end) {
+		return nil, 0, fmt.Errorf("invalid slot index count %d", count)
+	}
+	offset := end - HeaderSize - int64(slotIndexSize(int(count)))
+	if offset < 0 {
+		return nil, 0, fmt.Errorf("invalid slot index count %d", count)
+	}
+
+	typ, value, err := ReadEntryAt(r, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	if typ != TypeSlotIndex {
+		return nil, 0, fmt.Errorf("expected slot index entry but found %s", typ)
+	}
+	if len(value) != slotIndexSize(int(count)) {
+		return nil, 0, fmt.Errorf("slot index entry does not end at offset %d", end)
+	}
+	index := &SlotIndex{}
+	if err := index.UnmarshalBinary(value); err != nil {
+		return nil, 0, err
+	}
+	return index, offset, nil
+}
+
+// StartSlot returns the first slot of the blocks of the era
+func (e *Reader) StartSlot() uint64 {
+	if e.blocks == nil {
+		return e.state.StartSlot
+	}
+	return e.blocks.StartSlot
+}
+
+// StateSlot returns the slot of the state of the era
+func (e *Reader) StateSlot() uint64 {
+	return e.state.StartSlot
+}
+
+// Block decodes the SignedBeaconBlock of the given slot
+func (e *Reader) Block(slot uint64, block ssz.Unmarshaler) error {
+	if e.blocks == nil || slot < e.blocks.StartSlot || slot-e.blocks.StartSlot >= uint64(len(e.blocks.Offsets)) {
+		return ErrSlotNotFound
+	}
+	offset := e.blocks.Offsets[slot-e.blocks.StartSlot]
+	if offset == 0 {
+		return ErrEmptySlot
+	}
+	return readCompressedAt(e.r, e.blocksIndex+offset, TypeCompressedSignedBeaconBlock, block)
+}
+
+// State decodes the BeaconState of the era
+func (e *Reader) State(state ssz.Unmarshaler) error {
+	return readCompressedAt(e.r, e.stateIndex+e.state.Offsets[0], TypeCompressedBeaconState, state)
+}
+
+// Iterator returns an iterator over all the entries of the era file
+func (e *Reader) Iterator() *Iterator {
+	return NewIterator(e.r, 0)
+}
+
+// Decode decodes the snappy framed SSZ value of the current entry
+func (it *Iterator) Decode(obj ssz.Unmarshaler) error {
+	return decodeCompressed(it.Reader(), obj)
+}
+
+func readCompressedAt(r io.ReaderAt, offset int64, typ EntryType, obj ssz.Unmarshaler) error {
+	h, err := ReadHeaderAt(r, offset)
+	if err != nil {
+		return err
+	}
+	if h.Type != typ {
+		return fmt.Errorf("expected entry %s but found %s", typ, h.Type)
+	}
+	return decodeCompressed(io.NewSectionReader(r, offset+HeaderSize, int64(h.Length)), obj)
+}
+
+// decodeCompressed decompresses the entry up to the maximum size of the object
+func decodeCompressed(r io.Reader, obj ssz.Unmarshaler) error {
+	maxSize := MaxDecompressedSize
+	if b, ok := obj.(ssz.SizeBounder); ok {
+		maxSize = b.MaxSSZSize()
+	}
+	if maxSize >= math.MaxInt64 {
+		// the limit of the reader is an int64
+		maxSize = math.MaxInt64 - 1
+	}
+
+	// read one more byte to know if the entry is larger than the maximum size
+	data, err := io.ReadAll(io.LimitReader(snappy.NewReader(r), int64(maxSize)+1))
+	if err != nil {
+		return err
+	}
+	if uint64(len(data)) > maxSize {
+		return fmt.Errorf("%w: more than %d bytes", ErrDecompressedSize, maxSize)
+	}
+	return obj.UnmarshalSSZ(data)
+}
@@ -0,0 +1,231 @@
+package era
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/ferranbt/fastssz/fuzz"
+	"github.com/ferranbt/fastssz/spectests"
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/require"
+)
+
+func TestE2Store(t *testing.T) {
+	entries := []struct {
+		typ   EntryType
+		value []byte
+	}{
+		{TypeVersion, nil},
+		{TypeEmpty, []byte{0x1, 0x2, 0x3}},
+		{EntryType{0xaa, 0xbb}, bytes.Repeat([]byte{0x1}, 100)},
+		{TypeEmpty, nil},
+	}
+
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	offsets := []int64{}
+	for _, e := range entries {
+		offsets = append(offsets, w.Offset())
+		n, err := w.WriteEntry(e.typ, e.value)
+		require.NoError(t, err)
+		require.Equal(t, HeaderSize+len(e.value), n)
+	}
+	require.Equal(t, int64(buf.Len()), w.Offset())
+
+	r := bytes.NewReader(buf.Bytes())
+	it := NewIterator(r, 0)
+	for i, e := range entries {
+		require.True(t, it.Next())
+		require.Equal(t, e.typ, it.Type())
+		require.Equal(t, offsets[i], it.Offset())
+		require.Equal(t, uint32(len(e.value)), it.Length())
+
+		value, err := it.Value()
+		require.NoError(t, err)
+		require.Equal(t, len(e.value), len(value))
+		require.True(t, bytes.Equal(e.value, value))
+
+		typ, value, err := ReadEntryAt(r, offsets[i])
+		require.NoError(t, err)
+		require.Equal(t, e.typ, typ)
+		require.True(t, bytes.Equal(e.value, value))
+	}
+	require.False(t, it.Next())
+	require.NoError(t, it.Err())
+
+	// truncated entry
+	it = NewIterator(bytes.NewReader(buf.Bytes()[:buf.Len()-1]), 0)
+	for it.Next() {
+	}
+	require.ErrorIs(t, it.Err(), io.ErrUnexpectedEOF)
+
+	_, _, err := ReadEntryAt(bytes.NewReader(buf.Bytes()[:offsets[3]-1]), offsets[2])
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	// reserved bytes
+	data := append([]byte{}, buf.Bytes()...)
+	data[offsets[1]+7] = 1
+	_, err = ReadHeaderAt(bytes.NewReader(data), offsets[1])
+	require.ErrorIs(t, err, ErrReservedNotZero)
+}
+
+func randomObj(t *testing.T, obj interface{}) {
+	require.False(t, fuzz.NewWithSeed(1).Fuzz(obj))
+}
+
+func randomState(t *testing.T) *spectests.BeaconState {
+	state := new(spectests.BeaconState)
+	randomObj(t, state)
+
+	// the fuzzer caps the lists at 1000 elements
+	state.Slashings = make([]uint64, 8192)
+
+	// nor valid bitvectors
+	state.JustificationBits = []byte{0x0f}
+	return state
+}
+
+func TestEra(t *testing.T) {
+	blocks := map[uint64]*spectests.SignedBeaconBlock{}
+	for _, slot := range []uint64{100, 101, 105} {
+		block := new(spectests.SignedBeaconBlock)
+		randomObj(t, block)
+		block.Block.Slot = slot
+		// the fuzzer does not fill valid bitlists
+		block.Block.Body.Attestations = nil
+		blocks[slot] = block
+	}
+	state := randomState(t)
+
+	var buf bytes.Buffer
+	b, err := NewBuilder(&buf, 100)
+	require.NoError(t, err)
+	for _, slot := range []uint64{100, 101, 105} {
+		require.NoError(t, b.AddBlock(slot, blocks[slot]))
+	}
+	require.Error(t, b.AddBlock(103, blocks[100]))
+	require.NoError(t, b.Finalize(110, state))
+	require.Error(t, b.Finalize(110, state))
+
+	e, err := Open(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), e.StartSlot())
+	require.Equal(t, uint64(110), e.StateSlot())
+
+	for slot := uint64(99); slot <= 110; slot++ {
+		block := new(spectests.SignedBeaconBlock)
+		err := e.Block(slot, block)
+
+		switch {
+		case slot < 100 || slot >= 110:
+			require.ErrorIs(t, err, ErrSlotNotFound)
+		case blocks[slot] == nil:
+			require.ErrorIs(t, err, ErrEmptySlot)
+		default:
+			require.NoError(t, err)
+			requireEqualSSZ(t, blocks[slot], block)
+		}
+	}
+
+	state2 := new(spectests.BeaconState)
+	require.NoError(t, e.State(state2))
+	requireEqualSSZ(t, state, state2)
+
+	// version, blocks, state and the two indices
+	types := []EntryType{}
+	it := e.Iterator()
+	for it.Next() {
+		types = append(types, it.Type())
+		if it.Type() == TypeCompressedSignedBeaconBlock {
+			block := new(spectests.SignedBeaconBlock)
+			require.NoError(t, it.Decode(block))
+			requireEqualSSZ(t, blocks[block.Block.Slot], block)
+		}
+	}
+	require.NoError(t, it.Err())
+	require.Equal(t, []EntryType{
+		TypeVersion,
+		TypeCompressedSignedBeaconBlock,
+		TypeCompressedSignedBeaconBlock,
+		TypeCompressedSignedBeaconBlock,
+		TypeCompressedBeaconState,
+		TypeSlotIndex,
+		TypeSlotIndex,
+	}, types)
+}
+
+func TestEraGenesis(t *testing.T) {
+	state := randomState(t)
+
+	var buf bytes.Buffer
+	b, err := NewBuilder(&buf, 0)
+	require.NoError(t, err)
+	require.NoError(t, b.Finalize(0, state))
+
+	e, err := Open(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), e.StartSlot())
+	require.Equal(t, uint64(0), e.StateSlot())
+
+	err = e.Block(0, new(spectests.SignedBeaconBlock))
+	require.ErrorIs(t, err, ErrSlotNotFound)
+
+	state2 := new(spectests.BeaconState)
+	require.NoError(t, e.State(state2))
+	requireEqualSSZ(t, state, state2)
+
+	// not an era file
+	_, err = Open(bytes.NewReader(buf.Bytes()[HeaderSize:]), int64(buf.Len()-HeaderSize))
+	require.Error(t, err)
+}
+
+func requireEqualSSZ(t *testing.T, expected, found ssz.Marshaler) {
+	a, err := expected.MarshalSSZ()
+	require.NoError(t, err)
+	b, err := found.MarshalSSZ()
+	require.NoError(t, err)
+	require.True(t, bytes.Equal(a, b))
+}
+
+// rawValue is an object without static bounds of its encoding
+type rawValue []byte
+
+func (r *rawValue) UnmarshalSSZ(buf []byte) error {
+	*r = append((*r)[:0], buf...)
+	return nil
+}
+
+func TestEraDecodeMaxSize(t *testing.T) {
+	compressed := func(size int) *Iterator {
+		var buf bytes.Buffer
+		sw := snappy.NewBufferedWriter(&buf)
+		_, err := sw.Write(make([]byte, size))
+		require.NoError(t, err)
+		require.NoError(t, sw.Close())
+
+		var entry bytes.Buffer
+		_, err = NewWriter(&entry).WriteEntry(TypeCompressedBeaconState, buf.Bytes())
+		require.NoError(t, err)
+
+		it := NewIterator(bytes.NewReader(entry.Bytes()), 0)
+		require.True(t, it.Next())
+		return it
+	}
+
+	// the limit of a ssz.SizeBounder is its maximum size
+	require.NoError(t, compressed(40).Decode(new(spectests.Checkpoint)))
+	require.ErrorIs(t, compressed(41).Decode(new(spectests.Checkpoint)), ErrDecompressedSize)
+
+	// the other objects are limited by MaxDecompressedSize
+	defer func(size uint64) {
+		MaxDecompressedSize = size
+	}(MaxDecompressedSize)
+	MaxDecompressedSize = 100
+
+	var value rawValue
+	require.NoError(t, compressed(100).Decode(&value))
+	require.Len(t, value, 100)
+	require.ErrorIs(t, compressed(101).Decode(&value), ErrDecompressedSize)
+}
@@ -0,0 +1,186 @@
+package ssz
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// TreeFormat is the output format of Node.WriteTo
+type TreeFormat int
+
+const (
+	// TreeFormatDOT is a Graphviz DOT digraph
+	TreeFormatDOT TreeFormat = iota
+	// TreeFormatJSON is a JSON object with the children of each node
+	TreeFormatJSON
+	// TreeFormatMermaid is a Mermaid flowchart
+	TreeFormatMermaid
+)
+
+// TreeWriteOptions are the options of Node.WriteToWithOptions
+type TreeWriteOptions struct {
+	// MaxDepth is the maximum depth of the nodes written. If 0, all the nodes are written
+	MaxDepth int
+
+	// Schema is used to annotate the nodes with the path of their field
+	Schema *Schema
+}
+
+// WriteTo writes the tree in the given format so that it can be rendered or parsed by other tools
+func (n *Node) WriteTo(w io.Writer, format TreeFormat) error {
+	return n.WriteToWithOptions(w, format, nil)
+}
+
+// WriteToWithOptions writes the tree in the given format with a depth limit
+// and the field names of a schema
+func (n *Node) WriteToWithOptions(w io.Writer, format TreeFormat, opts *TreeWriteOptions) error {
+	if opts == nil {
+		opts = &TreeWriteOptions{}
+	}
+	root, err := n.exportNode(1, 0, opts)
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case TreeFormatDOT:
+		return writeDOT(w, root)
+	case TreeFormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(root)
+	case TreeFormatMermaid:
+		return writeMermaid(w, root)
+	default:
+		return fmt.Errorf("unknown tree format %d", format)
+	}
+}
+
+// exportedNode is the representation of a node used by WriteTo
+type exportedNode struct {
+	GIndex GIndex        `json:"gindex"`
+	Field  string        `json:"field,omitempty"`
+	Hash   string        `json:"hash"`
+	Value  string        `json:"value,omitempty"`
+	Zero   bool          `json:"zero,omitempty"`
+	Opaque bool          `json:"opaque,omitempty"`
+	Left   *exportedNode `json:"left,omitempty"`
+	Right  *exportedNode `json:"right,omitempty"`
+}
+
+func (n *Node) exportNode(index GIndex, depth int, opts *TreeWriteOptions) (*exportedNode, error) {
+	if err := n.expand(); err != nil {
+		return nil, err
+	}
+
+	res := &exportedNode{
+		GIndex: index,
+		Hash:   "0x" + hex.EncodeToString(hashNode(n)),
+		Zero:   n.isEmpty,
+		Opaque: n.opaque,
+	}
+	if opts.Schema != nil {
+		if field, ok := opts.Schema.FieldPath(index); ok {
+			res.Field = field
+		}
+	}
+	if n.left == nil && n.right == nil {
+		if !n.isEmpty && !n.opaque {
+			res.Value = "0x" + hex.EncodeToString(n.value)
+		}
+		return res, nil
+	}
+
+	if (opts.MaxDepth > 0 && depth == opts.MaxDepth) || index.Depth() == 63 {
+		// the children are not written
+		return res, nil
+	}
+
+	var err error
+	if res.Left, err = n.left.exportNode(index<<1, depth+1, opts); err != nil {
+		return nil, err
+	}
+	if res.Right, err = n.right.exportNode(index<<1|1, depth+1, opts); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// label returns the lines that describe the node in the diagrams
+func (e *exportedNode) label() []string {
+	lines := []string{fmt.Sprintf("%d", e.GIndex)}
+	if e.Field != "" {
+		lines = append(lines, e.Field)
+	}
+	switch {
+	case e.Zero:
+		lines = append(lines, "zero "+shortHex(e.Hash))
+	case e.Opaque:
+		lines = append(lines, "opaque "+shortHex(e.Hash))
+	case e.Value != "":
+		lines = append(lines, "value "+shortHex(e.Value))
+	default:
+		lines = append(lines, "hash "+shortHex(e.Hash))
+	}
+	return lines
+}
+
+// shortHex abbreviates a hex value to its first 4 bytes
+func shortHex(str string) string {
+	if len(str) <= 2+8 {
+		return str
+	}
+	return str[:2+8] + "..."
+}
+
+func (e *exportedNode) walk(fn func(parent, child *exportedNode)) {
+	for _, child := range []*exportedNode{e.Left, e.Right} {
+		if child != nil {
+			fn(e, child)
+			child.walk(fn)
+		}
+	}
+}
+
+func writeDOT(w io.Writer, root *exportedNode) error {
+	b := &strings.Builder{}
+	b.WriteString("digraph tree {\n\tnode [shape=box];\n")
+
+	writeNode := func(e *exportedNode) {
+		style := ""
+		if e.Zero || e.Opaque {
+			style = ", style=dashed"
+		}
+		fmt.Fprintf(b, "\tn%d [label=%q%s];\n", e.GIndex, strings.Join(e.label(), "\n"), style)
+	}
+	writeNode(root)
+	root.walk(func(parent, child *exportedNode) {
+		writeNode(child)
+		fmt.Fprintf(b, "\tn%d -> n%d;\n", parent.GIndex, child.GIndex)
+	})
+
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeMermaid(w io.Writer, root *exportedNode) error {
+	b := &strings.Builder{}
+	b.WriteString("graph TD\n")
+
+	writeNode := func(e *exportedNode) {
+		label := strings.ReplaceAll(strings.Join(e.label(), "<br/>"), "\"", "#quot;")
+		fmt.Fprintf(b, "\tn%d[\"%s\"]\n", e.GIndex, label)
+	}
+	writeNode(root)
+	root.walk(func(parent, child *exportedNode) {
+		writeNode(child)
+		fmt.Fprintf(b, "\tn%d --> n%d\n", parent.GIndex, child.GIndex)
+	})
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
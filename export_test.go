@@ -0,0 +1,95 @@
+package ssz
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testExportTree(t *testing.T) (*Node, *Schema) {
+	list, err := TreeFromNodesWithMixin([]*Node{LeafFromUint64(1), LeafFromUint64(2)}, 2, 2)
+	require.NoError(t, err)
+
+	// container { A uint64; B []uint64 `ssz-max:"8"` }
+	tree := NewNodeWithLR(LeafFromUint64(10), list)
+	schema := SchemaContainer(
+		&SchemaField{Name: "A", Schema: SchemaUint(8)},
+		&SchemaField{Name: "B", Schema: SchemaList(SchemaUint(8), 8)},
+	)
+	return tree, schema
+}
+
+func TestNodeWriteToJSON(t *testing.T) {
+	tree, schema := testExportTree(t)
+
+	var buf bytes.Buffer
+	require.NoError(t, tree.WriteToWithOptions(&buf, TreeFormatJSON, &TreeWriteOptions{Schema: schema}))
+
+	var root exportedNode
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &root))
+
+	require.Equal(t, GIndex(1), root.GIndex)
+	require.Equal(t, "0x"+hex.EncodeToString(tree.Hash()), root.Hash)
+	require.Equal(t, "A", root.Left.Field)
+	require.Equal(t, "0x"+hex.EncodeToString(LeafFromUint64(10).value), root.Left.Value)
+	require.Equal(t, "B", root.Right.Field)
+	require.Equal(t, "len(B)", root.Right.Right.Field)
+
+	// the elements 0 to 3 of the list are packed in the first chunk
+	chunk := root.Right.Left.Left
+	require.Equal(t, GIndex(12), chunk.GIndex)
+	require.Equal(t, "B[0:4]", chunk.Field)
+	require.False(t, chunk.Zero)
+
+	// depth limit
+	buf.Reset()
+	require.NoError(t, tree.WriteToWithOptions(&buf, TreeFormatJSON, &TreeWriteOptions{MaxDepth: 1}))
+
+	root = exportedNode{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &root))
+	require.Nil(t, root.Right.Left)
+	require.Empty(t, root.Right.Field)
+}
+
+func TestNodeWriteToZeroSubtree(t *testing.T) {
+	tree, err := TreeFromNodesWithMixin([]*Node{LeafFromUint64(1)}, 1, 4)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, tree.WriteTo(&buf, TreeFormatJSON))
+
+	var root exportedNode
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &root))
+	require.True(t, root.Left.Right.Zero)
+	require.Empty(t, root.Left.Right.Value)
+}
+
+func TestNodeWriteToDiagrams(t *testing.T) {
+	tree, schema := testExportTree(t)
+	opts := &TreeWriteOptions{Schema: schema, MaxDepth: 2}
+
+	var buf bytes.Buffer
+	require.NoError(t, tree.WriteToWithOptions(&buf, TreeFormatDOT, opts))
+
+	dot := buf.String()
+	require.True(t, strings.HasPrefix(dot, "digraph tree {\n"))
+	require.Contains(t, dot, "\tn2 [label=\"2\\nA\\nvalue 0x0a000000...\"];\n")
+	require.Contains(t, dot, "\tn3 -> n7;\n")
+	require.Contains(t, dot, "\tn7 [label=\"7\\nlen(B)\\nvalue 0x02000000...\"];\n")
+	require.NotContains(t, dot, "n12")
+
+	buf.Reset()
+	require.NoError(t, tree.WriteToWithOptions(&buf, TreeFormatMermaid, opts))
+
+	mermaid := buf.String()
+	require.True(t, strings.HasPrefix(mermaid, "graph TD\n"))
+	require.Contains(t, mermaid, "\tn3[\"3<br/>B<br/>hash ")
+	require.Contains(t, mermaid, "\tn1 --> n2\n")
+	require.NotContains(t, mermaid, "n12")
+
+	require.Error(t, tree.WriteTo(&buf, TreeFormat(10)))
+}
@@ -0,0 +1,126 @@
+package ssz
+
+import "fmt"
+
+// Fork is a fork of the consensus specs. Objects generated from a single struct with
+// fork tags ('ssz-fork' and 'ssz-until') encode their fields depending on the fork.
+type Fork int
+
+const (
+	ForkPhase0 Fork = iota
+	ForkAltair
+	ForkBellatrix
+	ForkCapella
+	ForkDeneb
+)
+
+// ErrForkField means that the field of a fork aware object is not part of the given fork
+var ErrForkField = fmt.Errorf("field is not part of the fork")
+
+var forkNames = []string{
+	ForkPhase0:    "phase0",
+	ForkAltair:    "altair",
+	ForkBellatrix: "bellatrix",
+	ForkCapella:   "capella",
+	ForkDeneb:     "deneb",
+}
+
+func (f Fork) String() string {
+	if f < 0 || int(f) >= len(forkNames) {
+		return fmt.Sprintf("fork(%d)", int(f))
+	}
+	return forkNames[f]
+}
+
+// ForkFromString returns the fork with the given name (i.e. 'altair')
+func ForkFromString(name string) (Fork, error) {
+	for indx, forkName := range forkNames {
+		if forkName == name {
+			return Fork(indx), nil
+		}
+	}
+	return 0, fmt.Errorf("fork '%s' not found", name)
+}
+
+// ForkMarshaler is the interface implemented by types that can marshal themselves
+// into valid SSZ for a given fork.
+type ForkMarshaler interface {
+	MarshalSSZToFork(dst []byte, fork Fork) ([]byte, error)
+	SizeSSZFork(fork Fork) int
+}
+
+// ForkUnmarshaler is the interface implemented by types that can unmarshal a SSZ
+// description of themselves for a given fork.
+type ForkUnmarshaler interface {
+	UnmarshalSSZFork(buf []byte, fork Fork) error
+}
+
+// ForkSizeBounder is the interface implemented by types that know the static
+// bounds of the size of their SSZ encoding for a given fork.
+type ForkSizeBounder interface {
+	MinSSZSizeFork(fork Fork) uint64
+	MaxSSZSizeFork(fork Fork) uint64
+}
+
+// ForkHashRoot is the interface implemented by types that can hash themselves
+// for a given fork.
+type ForkHashRoot interface {
+	HashTreeRootWithFork(hh HashWalker, fork Fork) error
+}
+
+// ForkHashRootSSZ is the interface implemented by types that can hash
+// their ssz encoding for a given fork without decoding it.
+type ForkHashRootSSZ interface {
+	HashTreeRootSSZWithFork(hh HashWalker, buf []byte, fork Fork) error
+}
+
+// MarshalSSZFork marshals an object for the given fork
+func MarshalSSZFork(m ForkMarshaler, fork Fork) ([]byte, error) {
+	buf := make([]byte, m.SizeSSZFork(fork))
+	return m.MarshalSSZToFork(buf[:0], fork)
+}
+
+// HashWithDefaultHasherFork hashes a ForkHashRoot object for the given
+// fork with a Hasher from the default HasherPool
+func HashWithDefaultHasherFork(v ForkHashRoot, fork Fork) ([32]byte, error) {
+	hh := DefaultHasherPool.Get()
+	if err := v.HashTreeRootWithFork(hh, fork); err != nil {
+		DefaultHasherPool.Put(hh)
+		return [32]byte{}, err
+	}
+	root, err := hh.HashRoot()
+	DefaultHasherPool.Put(hh)
+	return root, err
+}
+
+// HashSSZWithDefaultHasherFork hashes the ssz encoding of a ForkHashRootSSZ
+// object for the given fork with a Hasher from the default HasherPool
+func HashSSZWithDefaultHasherFork(v ForkHashRootSSZ, buf []byte, fork Fork) ([32]byte, error) {
+	hh := DefaultHasherPool.Get()
+	if err := v.HashTreeRootSSZWithFork(hh, buf, fork); err != nil {
+		DefaultHasherPool.Put(hh)
+		return [32]byte{}, err
+	}
+	root, err := hh.HashRoot()
+	DefaultHasherPool.Put(hh)
+	return root, err
+}
+
+// ProofTreeFork returns the tree of a ForkHashRoot object for the given fork
+func ProofTreeFork(v ForkHashRoot, fork Fork) (*Node, error) {
+	w := &Wrapper{}
+	if err := v.HashTreeRootWithFork(w, fork); err != nil {
+		return nil, err
+	}
+	return w.Node(), nil
+}
+
+// ProveGIndexFork returns a merkle proof of the node at the given
+// generalized index of the tree of the object for the given fork.
+func ProveGIndexFork(v ForkHashRoot, index GIndex, fork Fork) (*Proof, error) {
+	tree, err := ProofTreeFork(v, fork)
+	if err != nil {
+		return nil, err
+	}
+	return tree.Prove(index)
+}
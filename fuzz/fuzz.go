@@ -7,6 +7,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	ssz "github.com/ferranbt/fastssz"
 )
 
 // Based on https://github.com/google/gofuzz
@@ -15,6 +17,7 @@ import (
 type Fuzzer struct {
 	r         *rand.Rand
 	failRatio float64
+	fork      *ssz.Fork
 }
 
 func randomInt(min, max int) int {
@@ -39,6 +42,26 @@ func (f *Fuzzer) SetFailureRatio(failRatio float64) {
 	f.failRatio = failRatio
 }
 
+// SetFork sets the fork of the objects. The fields that are not part of the
+// encoding in the fork ('ssz-fork' and 'ssz-until' tags) are left empty.
+func (f *Fuzzer) SetFork(fork ssz.Fork) {
+	f.fork = &fork
+}
+
+// isActive returns true if the field with the tag is part of the encoding in the fork
+func (f *Fuzzer) isActive(tag reflect.StructTag) bool {
+	if f.fork == nil {
+		return true
+	}
+	if name := tag.Get("ssz-fork"); name != "" && *f.fork < convertFork(name) {
+		return false
+	}
+	if name := tag.Get("ssz-until"); name != "" && *f.fork >= convertFork(name) {
+		return false
+	}
+	return true
+}
+
 // Fuzz recursively fills all of obj's fields with something random
 func (f *Fuzzer) Fuzz(obj interface{}) bool {
 	v := reflect.ValueOf(obj)
@@ -64,6 +87,14 @@ func convertNum(str string) int {
 	return num
 }
 
+func convertFork(name string) ssz.Fork {
+	fork, err := ssz.ForkFromString(name)
+	if err != nil {
+		panic(err)
+	}
+	return fork
+}
+
 func (f *Fuzzer) getShoudlFail() bool {
 	return f.r.Float64() < f.failRatio
 }
@@ -97,7 +128,7 @@ func (fc *fuzzerContext) getRandomNum(maxStr string, isMax bool) int {
 }
 
 func (fc *fuzzerContext) genElementCount(tag reflect.StructTag) (reflect.StructTag, int) {
-	if size := tag.Get("ssz-size"); size != "" {
+	if size := tag.Get("ssz-size"); size != "" && size != "?" {
 		indx := strings.Index(size, ",")
 		if indx == -1 {
 			// just one size
@@ -105,12 +136,18 @@ func (fc *fuzzerContext) genElementCount(tag reflect.StructTag) (reflect.StructT
 		}
 
 		var num int
+		subTag := "ssz-size:\"" + size[indx+1:] + "\""
 		if size[:indx] == "?" {
 			// search for ssz-max tag
 			max := tag.Get("ssz-max")
 			if max == "" {
 				panic("BUG: Max tag expected after ?")
 			}
+			if maxIndx := strings.Index(max, ","); maxIndx != -1 {
+				// the max of the inner dimension (i.e. ssz-max:"a,b" ssz-size:"?,?")
+				subTag += " ssz-max:\"" + max[maxIndx+1:] + "\""
+				max = max[:maxIndx]
+			}
 			num = fc.getRandomNum(max, true)
 		} else {
 			// its a number
@@ -118,7 +155,7 @@ func (fc *fuzzerContext) genElementCount(tag reflect.StructTag) (reflect.StructT
 		}
 
 		// a,b
-		return reflect.StructTag("ssz-size:\"" + size[indx+1:] + "\""), num
+		return reflect.StructTag(subTag), num
 	}
 	if max := tag.Get("ssz-max"); max != "" {
 		return "", fc.getRandomNum(max, true)
@@ -161,6 +198,9 @@ func (fc *fuzzerContext) doFuzz(v reflect.Value, tag reflect.StructTag) {
 	case reflect.Struct:
 		typ := v.Type()
 		for i := 0; i < v.NumField(); i++ {
+			if !fc.fuzzer.isActive(typ.Field(i).Tag) {
+				continue
+			}
 			// fuzz nil values if the field of the struct is
 			// another struct
 			if isPtrToStruct(v.Field(i)) {
@@ -0,0 +1,147 @@
+package ssz
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+)
+
+var (
+	// ErrGIndexOverflow means that the generalized index does not fit in 64 bits
+	ErrGIndexOverflow = fmt.Errorf("generalized index overflows 64 bits")
+)
+
+// GIndex is a generalized index of a node in a merkle tree. The root has the index 1
+// and the children of the node with index i have the indices 2*i and 2*i+1. The bits of the
+// index after the leading 1 are the path from the root to the node (0 left and 1 right).
+// Then, a GIndex can address nodes up to a depth of 63. Deeper nodes are addressed with
+// a big.Int (see ConcatGIndicesBig and Node.GetBig).
+type GIndex uint64
+
+// NewGIndex returns the generalized index of the node at the given position
+// among the nodes at the given depth (i.e. the index of a leaf).
+func NewGIndex(depth int, index uint64) (GIndex, error) {
+	if depth < 0 || depth > 63 {
+		return 0, ErrGIndexOverflow
+	}
+	if index >= 1<<depth {
+		return 0, fmt.Errorf("index %d out of range for depth %d", index, depth)
+	}
+	return GIndex(1<<depth | index), nil
+}
+
+// GIndexFromBig converts a big.Int generalized index into a GIndex
+func GIndexFromBig(b *big.Int) (GIndex, error) {
+	if b.Sign() <= 0 {
+		return 0, fmt.Errorf("invalid generalized index %s", b.String())
+	}
+	if !b.IsUint64() {
+		return 0, ErrGIndexOverflow
+	}
+	return GIndex(b.Uint64()), nil
+}
+
+// Depth returns the length of the path from the root to the node (the root has depth 0).
+// It returns -1 for the invalid index 0.
+func (g GIndex) Depth() int {
+	return bits.Len64(uint64(g)) - 1
+}
+
+// IsRight returns true if the node is the right child of its parent
+func (g GIndex) IsRight() bool {
+	return g&1 == 1
+}
+
+// Parent returns the generalized index of the parent of the node
+func (g GIndex) Parent() GIndex {
+	return g >> 1
+}
+
+// Sibling returns the generalized index of the other child of the parent of the node
+func (g GIndex) Sibling() GIndex {
+	return g ^ 1
+}
+
+// Left returns the generalized index of the left child of the node
+func (g GIndex) Left() (GIndex, error) {
+	return g.Child(false)
+}
+
+// Right returns the generalized index of the right child of the node
+func (g GIndex) Right() (GIndex, error) {
+	return g.Child(true)
+}
+
+// Child returns the generalized index of the left or right child of the node
+func (g GIndex) Child(right bool) (GIndex, error) {
+	if g>>63 != 0 {
+		return 0, ErrGIndexOverflow
+	}
+	if right {
+		return g<<1 | 1, nil
+	}
+	return g << 1, nil
+}
+
+// Concat returns the generalized index of the node with the index 'other'
+// in the subtree whose root is the node with index g.
+func (g GIndex) Concat(other GIndex) (GIndex, error) {
+	if g == 0 || other == 0 {
+		return 0, fmt.Errorf("invalid generalized index 0")
+	}
+	depth := other.Depth()
+	if g.Depth()+depth > 63 {
+		return 0, ErrGIndexOverflow
+	}
+	return g<<depth | (other ^ 1<<depth), nil
+}
+
+// Big returns the generalized index as a big.Int
+func (g GIndex) Big() *big.Int {
+	return new(big.Int).SetUint64(uint64(g))
+}
+
+// ConcatGIndices returns the generalized index of a node in a nested
+// subtree given the generalized indices of each subtree along the path
+// (i.e. the root of the field of a container that is also a field).
+// It returns an error if the result does not fit in a GIndex.
+func ConcatGIndices(indices ...GIndex) (GIndex, error) {
+	res := GIndex(1)
+	for _, index := range indices {
+		var err error
+		if res, err = res.Concat(index); err != nil {
+			return 0, err
+		}
+	}
+	return res, nil
+}
+
+// ConcatGIndicesBig is the same as ConcatGIndices for generalized
+// indices of any size represented as big.Int.
+func ConcatGIndicesBig(indices ...*big.Int) (*big.Int, error) {
+	res := big.NewInt(1)
+	for _, index := range indices {
+		if index.Sign() <= 0 {
+			return nil, fmt.Errorf("invalid generalized index %s", index.String())
+		}
+		depth := uint(index.BitLen() - 1)
+
+		// remove the leading 1 of the index and append the path
+		path := new(big.Int).SetBit(index, int(depth), 0)
+		res.Lsh(res, depth).Or(res, path)
+	}
+	return res, nil
+}
+
+// getPosAtLevel returns the position (i.e. false for left, true for right)
+// of an index at a given level.
+// Level 0 is the actual index's level, Level 1 is the position
+// of the parent, etc.
+func getPosAtLevel(index GIndex, level int) bool {
+	return (index & (1 << level)) > 0
+}
+
+// getPathLength returns the length of the path to a node represented by its generalized index.
+func getPathLength(index GIndex) int {
+	return index.Depth()
+}
@@ -1,6 +1,11 @@
 package ssz
 
-import "hash"
+import (
+	"fmt"
+	"hash"
+
+	"github.com/minio/sha256-simd"
+)
 
 type HashFn func(dst []byte, input []byte) error
 
@@ -23,3 +28,93 @@ func NativeHashWrapper(hashFn hash.Hash) HashFn {
 		return nil
 	}
 }
+
+// Hashing is a hash function (HashFn) used to merkleize the SSZ objects together with
+// its precomputed zero hashes (the roots of the empty subtrees at each depth). The same
+// Hashing has to be used to hash the objects (Hasher), to build the trees (Wrapper) and
+// to verify the proofs so that the roots are consistent. The zero hashes are computed once
+// when the Hashing is created and they are shared by all its users, a Hashing is safe for
+// concurrent use as long as its HashFn is.
+type Hashing struct {
+	fn HashFn
+
+	// hashData hashes a buffer of any size, only used for sha256
+	hashData func(data []byte) []byte
+
+	zeroHashes [65][32]byte
+	zeroLevels map[string]int
+}
+
+// DefaultHashing is the sha256 Hashing used by default
+var DefaultHashing = newHashing(sha256HashFn, func(data []byte) []byte {
+	res := sha256.Sum256(data)
+	return res[:]
+})
+
+// NewHashing creates a new Hashing with a HashFn function. The HashFn is called from the
+// goroutines that hash the trees and it must be safe for concurrent use (i.e. it cannot
+// be a NativeHashWrapper). The Hashing should be created once and reused.
+func NewHashing(fn HashFn) *Hashing {
+	return newHashing(fn, nil)
+}
+
+func newHashing(fn HashFn, hashData func(data []byte) []byte) *Hashing {
+	h := &Hashing{
+		fn:         fn,
+		hashData:   hashData,
+		zeroLevels: map[string]int{},
+	}
+	h.zeroLevels[string(h.zeroHashes[0][:])] = 0
+	for i := 0; i < 64; i++ {
+		copy(h.zeroHashes[i+1][:], h.hash(h.zeroHashes[i][:], h.zeroHashes[i][:]))
+		h.zeroLevels[string(h.zeroHashes[i+1][:])] = i + 1
+	}
+	return h
+}
+
+// hash returns the hash of the concatenation of two nodes
+func (h *Hashing) hash(left, right []byte) []byte {
+	if h.hashData != nil {
+		// use a new buffer since the values of the nodes might share the same array
+		buf := make([]byte, 0, len(left)+len(right))
+		buf = append(buf, left...)
+		buf = append(buf, right...)
+		return h.hashData(buf)
+	}
+
+	buf := make([]byte, 64)
+	copy(buf[:32], left)
+	copy(buf[32:], right)
+
+	if err := h.fn(buf, buf); err != nil {
+		panic(fmt.Errorf("failed to hash: %v", err))
+	}
+	return buf[:32]
+}
+
+// ZeroHash returns the root of an empty subtree of the given depth
+func (h *Hashing) ZeroHash(depth int) []byte {
+	return h.zeroHashes[depth][:]
+}
+
+// zeroLevel returns the depth of the empty subtree with the given root
+func (h *Hashing) zeroLevel(root []byte) (int, bool) {
+	level, ok := h.zeroLevels[string(root)]
+	return level, ok
+}
+
+func orDefaultHashing(h *Hashing) *Hashing {
+	if h == nil {
+		return DefaultHashing
+	}
+	return h
+}
+
+// sha256HashFn is a HashFn that hashes the pairs of chunks with sha256
+func sha256HashFn(dst []byte, input []byte) error {
+	for i := 0; i+64 <= len(input); i += 64 {
+		res := sha256.Sum256(input[i : i+64])
+		copy(dst[i/2:], res[:])
+	}
+	return nil
+}
@@ -21,24 +21,12 @@ var (
 	ErrIncorrectListSize = fmt.Errorf("incorrect list size")
 )
 
-var zeroHashes [65][32]byte
-var zeroHashLevels map[string]int
 var trueBytes, falseBytes []byte
 
 func init() {
 	falseBytes = make([]byte, 32)
 	trueBytes = make([]byte, 32)
 	trueBytes[0] = 1
-	zeroHashLevels = make(map[string]int)
-	zeroHashLevels[string(falseBytes)] = 0
-
-	tmp := [64]byte{}
-	for i := 0; i < 64; i++ {
-		copy(tmp[:32], zeroHashes[i][:])
-		copy(tmp[32:], zeroHashes[i][:])
-		zeroHashes[i+1] = sha256.Sum256(tmp[:])
-		zeroHashLevels[string(zeroHashes[i+1][:])] = i + 1
-	}
 }
 
 // HashWithDefaultHasher hashes a HashRoot object with a Hasher from
@@ -54,6 +42,19 @@ func HashWithDefaultHasher(v HashRoot) ([32]byte, error) {
 	return root, err
 }
 
+// HashSSZWithDefaultHasher hashes the ssz encoding of a HashRootSSZ
+// object with a Hasher from the default HasherPool
+func HashSSZWithDefaultHasher(v HashRootSSZ, buf []byte) ([32]byte, error) {
+	hh := DefaultHasherPool.Get()
+	if err := v.HashTreeRootSSZWith(hh, buf); err != nil {
+		DefaultHasherPool.Put(hh)
+		return [32]byte{}, err
+	}
+	root, err := hh.HashRoot()
+	DefaultHasherPool.Put(hh)
+	return root, err
+}
+
 var zeroBytes = make([]byte, 32)
 
 // DefaultHasherPool is a default hasher pool
@@ -69,11 +70,18 @@ type Hasher struct {
 
 	// sha256 hash function
 	hash HashFn
+
+	// hashing includes the zero hashes of the hash function
+	hashing *Hashing
 }
 
 // NewHasher creates a new Hasher object with sha256 hash
 func NewHasher() *Hasher {
-	return NewHasherWithHash(sha256.New())
+	return &Hasher{
+		hash:    NativeHashWrapper(sha256.New()),
+		hashing: DefaultHashing,
+		tmp:     make([]byte, 32),
+	}
 }
 
 // NewHasherWithHash creates a new Hasher object with a custom hash.Hash function
@@ -81,11 +89,23 @@ func NewHasherWithHash(hh hash.Hash) *Hasher {
 	return NewHasherWithHashFn(NativeHashWrapper(hh))
 }
 
-// NewHasherWithHashFn creates a new Hasher object with a custom HashFn function
+// NewHasherWithHashFn creates a new Hasher object with a custom HashFn function. The HashFn
+// is an implementation of sha256 (i.e. gohashtree) and the zero hashes are the ones of the
+// DefaultHashing, use NewHasherWithHashing for other hash functions.
 func NewHasherWithHashFn(hh HashFn) *Hasher {
 	return &Hasher{
-		hash: hh,
-		tmp:  make([]byte, 32),
+		hash:    hh,
+		hashing: DefaultHashing,
+		tmp:     make([]byte, 32),
+	}
+}
+
+// NewHasherWithHashing creates a new Hasher object with a custom Hashing
+func NewHasherWithHashing(hh *Hashing) *Hasher {
+	return &Hasher{
+		hash:    hh.fn,
+		hashing: hh,
+		tmp:     make([]byte, 32),
 	}
 }
 
@@ -367,7 +387,7 @@ func (h *Hasher) merkleizeImpl(dst []byte, input []byte, limit uint64) []byte {
 
 	depth := getDepth(limit)
 	if len(input) == 0 {
-		return append(dst, zeroHashes[depth][:]...)
+		return append(dst, h.hashing.zeroHashes[depth][:]...)
 	}
 
 	for i := uint8(0); i < depth; i++ {
@@ -376,7 +396,7 @@ func (h *Hasher) merkleizeImpl(dst []byte, input []byte, limit uint64) []byte {
 
 		if oddNodeLength {
 			// is odd length
-			input = append(input, zeroHashes[i][:]...)
+			input = append(input, h.hashing.zeroHashes[i][:]...)
 			layerLen++
 		}
 
@@ -1,10 +1,14 @@
 package ssz
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/prysmaticlabs/gohashtree"
+	"github.com/stretchr/testify/require"
 )
 
 func TestDepth(t *testing.T) {
@@ -72,3 +76,150 @@ func TestHashGoHashTree(t *testing.T) {
 
 	fmt.Println(buf)
 }
+
+// testHashingObj is a container with a basic field and a list
+type testHashingObj struct {
+	A uint64
+	B []uint64
+}
+
+func (t *testHashingObj) HashTreeRootWith(hh HashWalker) error {
+	indx := hh.Index()
+	hh.PutUint64(t.A)
+	hh.PutUint64Array(t.B, 128)
+	hh.Merkleize(indx)
+	return nil
+}
+
+func (t *testHashingObj) HashTreeRoot() ([32]byte, error) {
+	return HashWithDefaultHasher(t)
+}
+
+func (t *testHashingObj) GetTree() (*Node, error) {
+	return ProofTree(t)
+}
+
+func TestHashingCustomHashFn(t *testing.T) {
+	// sha256 with a domain separator
+	hashing := NewHashing(func(dst []byte, input []byte) error {
+		for i := 0; i+64 <= len(input); i += 64 {
+			res := sha256.Sum256(append([]byte{0x1}, input[i:i+64]...))
+			copy(dst[i/2:], res[:])
+		}
+		return nil
+	})
+
+	obj := &testHashingObj{A: 1, B: []uint64{1, 2, 3}}
+
+	hh := NewHasherWithHashing(hashing)
+	require.NoError(t, obj.HashTreeRootWith(hh))
+	root, err := hh.HashRoot()
+	require.NoError(t, err)
+
+	// the root is different from the sha256 root
+	defaultRoot, err := obj.HashTreeRoot()
+	require.NoError(t, err)
+	require.NotEqual(t, defaultRoot, root)
+
+	// the root of the tree is consistent with the hasher
+	tree, err := ProofTreeWithHashing(obj, hashing)
+	require.NoError(t, err)
+	require.Equal(t, root[:], tree.Hash())
+
+	defaultTree, err := obj.GetTree()
+	require.NoError(t, err)
+	require.Equal(t, defaultRoot[:], defaultTree.Hash())
+
+	// the zero hashes of the list are from the custom hash function
+	proof, err := tree.Prove(193)
+	require.NoError(t, err)
+	require.Equal(t, hashing.ZeroHash(0), proof.Leaf)
+
+	ok, err := VerifyProofWithHashing(root[:], proof, hashing)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = VerifyProof(root[:], proof)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	multiproof, err := tree.ProveMulti([]GIndex{2, 192})
+	require.NoError(t, err)
+	multiproof = multiproof.CompressWithHashing(hashing).DecompressWithHashing(hashing)
+
+	ok, err = VerifyMultiproofWithHashing(root[:], multiproof.Hashes, multiproof.Leaves, multiproof.Indices, hashing)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// update the tree with the custom hash function
+	tree, err = tree.SetLeaf(2, LeafFromUint64(5).Hash())
+	require.NoError(t, err)
+
+	obj.A = 5
+	hh.Reset()
+	require.NoError(t, obj.HashTreeRootWith(hh))
+	root, err = hh.HashRoot()
+	require.NoError(t, err)
+	require.Equal(t, root[:], tree.Hash())
+}
+
+func TestHashingDefaultZeroHashes(t *testing.T) {
+	zero := make([]byte, 64)
+	for i := 0; i < 64; i++ {
+		res := sha256.Sum256(zero)
+		require.Equal(t, res[:], DefaultHashing.ZeroHash(i+1))
+		copy(zero[:32], res[:])
+		copy(zero[32:], res[:])
+	}
+}
+
+func TestHashingHashFnZeroHashes(t *testing.T) {
+	obj := &testHashingObj{A: 1, B: []uint64{1, 2, 3}}
+	expected, err := obj.HashTreeRoot()
+	require.NoError(t, err)
+
+	// the sha256 hash functions share the zero hashes of the default hashing
+	hh := NewHasherWithHashFn(gohashtree.HashByteSlice)
+	require.Same(t, DefaultHashing, hh.hashing)
+
+	require.NoError(t, obj.HashTreeRootWith(hh))
+	root, err := hh.HashRoot()
+	require.NoError(t, err)
+	require.Equal(t, expected, root)
+}
+
+// run with the race detector (go test -race) to find data races
+func TestHashingConcurrent(t *testing.T) {
+	hashing := NewHashing(gohashtree.HashByteSlice)
+
+	obj := &testHashingObj{A: 1, B: []uint64{1, 2, 3}}
+	expected, err := obj.HashTreeRoot()
+	require.NoError(t, err)
+
+	// the same hashing is used by several hashers and trees at the same time
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			hh := NewHasherWithHashing(hashing)
+			if err := obj.HashTreeRootWith(hh); err != nil {
+				t.Error(err)
+				return
+			}
+			if root, err := hh.HashRoot(); err != nil || root != expected {
+				t.Error("bad root")
+			}
+			tree, err := ProofTreeWithHashing(obj, hashing)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if !bytes.Equal(tree.Hash(), expected[:]) {
+				t.Error("bad tree root")
+			}
+		}()
+	}
+	wg.Wait()
+}
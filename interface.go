@@ -7,17 +7,41 @@ type Marshaler interface {
 	SizeSSZ() int
 }
 
+// SizeBounder is the interface implemented by types that know the static
+// bounds of the size of their SSZ encoding.
+type SizeBounder interface {
+	MinSSZSize() uint64
+	MaxSSZSize() uint64
+}
+
 // Unmarshaler is the interface implemented by types that can unmarshal a SSZ description of themselves
 type Unmarshaler interface {
 	UnmarshalSSZ(buf []byte) error
 }
 
+// UnmarshalerWithOptions is the interface implemented by types that can unmarshal
+// a SSZ description of themselves with the decoding options.
+type UnmarshalerWithOptions interface {
+	UnmarshalSSZWithOptions(buf []byte, opts UnmarshalOptions) error
+}
+
 type HashRoot interface {
 	GetTree() (*Node, error)
 	HashTreeRoot() ([32]byte, error)
 	HashTreeRootWith(hh HashWalker) error
 }
 
+// HashRootSSZ is the interface implemented by types that can hash
+// their ssz encoding without decoding it.
+type HashRootSSZ interface {
+	HashTreeRootSSZWith(hh HashWalker, buf []byte) error
+}
+
+// Schemer is the interface implemented by types that describe the schema of their tree.
+type Schemer interface {
+	SchemaSSZ() *Schema
+}
+
 type HashWalker interface {
 	// Intended for testing purposes to know the latest hash generated during merkleize
 	Hash() []byte
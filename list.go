@@ -0,0 +1,715 @@
+package ssz
+
+import (
+	"fmt"
+	"math/bits"
+	"reflect"
+)
+
+// Limit is the maximum number of elements of a List or a BitlistOf and the number
+// of elements of a Vector. The limit is a type parameter so that it travels with
+// the type of the field instead of a struct tag (i.e. ssz.List[*Validator, ssz.Limit1T]).
+type Limit interface {
+	Limit() uint64
+}
+
+// Predefined limits. The K, M, G and T suffixes are powers of 2^10.
+type (
+	Limit1     struct{}
+	Limit2     struct{}
+	Limit4     struct{}
+	Limit8     struct{}
+	Limit16    struct{}
+	Limit20    struct{}
+	Limit32    struct{}
+	Limit48    struct{}
+	Limit64    struct{}
+	Limit96    struct{}
+	Limit128   struct{}
+	Limit256   struct{}
+	Limit512   struct{}
+	Limit1024  struct{}
+	Limit2048  struct{}
+	Limit4096  struct{}
+	Limit8192  struct{}
+	Limit65536 struct{}
+	Limit1M    struct{}
+	Limit1G    struct{}
+	Limit1T    struct{}
+)
+
+func (Limit1) Limit() uint64     { return 1 }
+func (Limit2) Limit() uint64     { return 2 }
+func (Limit4) Limit() uint64     { return 4 }
+func (Limit8) Limit() uint64     { return 8 }
+func (Limit16) Limit() uint64    { return 16 }
+func (Limit20) Limit() uint64    { return 20 }
+func (Limit32) Limit() uint64    { return 32 }
+func (Limit48) Limit() uint64    { return 48 }
+func (Limit64) Limit() uint64    { return 64 }
+func (Limit96) Limit() uint64    { return 96 }
+func (Limit128) Limit() uint64   { return 128 }
+func (Limit256) Limit() uint64   { return 256 }
+func (Limit512) Limit() uint64   { return 512 }
+func (Limit1024) Limit() uint64  { return 1024 }
+func (Limit2048) Limit() uint64  { return 2048 }
+func (Limit4096) Limit() uint64  { return 4096 }
+func (Limit8192) Limit() uint64  { return 8192 }
+func (Limit65536) Limit() uint64 { return 65536 }
+func (Limit1M) Limit() uint64    { return 1 << 20 }
+func (Limit1G) Limit() uint64    { return 1 << 30 }
+func (Limit1T) Limit() uint64    { return 1 << 40 }
+
+func limitOf[L Limit]() uint64 {
+	var l L
+	return l.Limit()
+}
+
+// List is a SSZ list of at most L elements. The elements are either unsigned integers,
+// booleans, byte arrays or objects generated by sszgen (i.e. *Validator or another List).
+// A List of bytes is a SSZ byte list.
+type List[T any, L Limit] []T
+
+// Len returns the number of elements of the list
+func (l List[T, L]) Len() int {
+	return len(l)
+}
+
+// Limit returns the maximum number of elements of the list
+func (l List[T, L]) Limit() uint64 {
+	return limitOf[L]()
+}
+
+// Get returns the element at the index
+func (l List[T, L]) Get(i int) T {
+	return l[i]
+}
+
+// Append appends an element to the list if it is not full
+func (l *List[T, L]) Append(v T) error {
+	if uint64(len(*l)) >= limitOf[L]() {
+		return fmt.Errorf("%w: the limit is %d", ErrListTooBig, limitOf[L]())
+	}
+	*l = append(*l, v)
+	return nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes of the list
+func (l List[T, L]) SizeSSZ() int {
+	return sizeElems([]T(l))
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes of the list
+func (l List[T, L]) MinSSZSize() uint64 {
+	return 0
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes of the list
+func (l List[T, L]) MaxSSZSize() uint64 {
+	info, err := elemInfoOf[T]()
+	if err != nil {
+		return MaxSizeUnbounded
+	}
+	return info.maxElems(limitOf[L]())
+}
+
+// MarshalSSZ ssz marshals the list
+func (l List[T, L]) MarshalSSZ() ([]byte, error) {
+	return MarshalSSZ(l)
+}
+
+// MarshalSSZTo ssz marshals the list to a target array
+func (l List[T, L]) MarshalSSZTo(dst []byte) ([]byte, error) {
+	if uint64(len(l)) > limitOf[L]() {
+		return nil, fmt.Errorf("%w: %d elements and the limit is %d", ErrListTooBig, len(l), limitOf[L]())
+	}
+	return marshalElems(dst, []T(l))
+}
+
+// UnmarshalSSZ ssz unmarshals the list
+func (l *List[T, L]) UnmarshalSSZ(buf []byte) error {
+	items, err := unmarshalElems[T](buf, limitOf[L](), false)
+	if err != nil {
+		return err
+	}
+	*l = items
+	return nil
+}
+
+// HashTreeRoot ssz hashes the list
+func (l List[T, L]) HashTreeRoot() ([32]byte, error) {
+	return HashWithDefaultHasher(l)
+}
+
+// HashTreeRootWith ssz hashes the list with a hasher
+func (l List[T, L]) HashTreeRootWith(hh HashWalker) error {
+	num := uint64(len(l))
+	if num > limitOf[L]() {
+		return ErrIncorrectListSize
+	}
+	info, err := elemInfoOf[T]()
+	if err != nil {
+		return err
+	}
+	indx := hh.Index()
+	if err := hashElems(hh, []T(l), info); err != nil {
+		return err
+	}
+	limit := limitOf[L]()
+	if info.kind == elemBasic {
+		limit = CalculateLimit(limit, num, uint64(info.size))
+	}
+	hh.MerkleizeWithMixin(indx, num, limit)
+	return nil
+}
+
+// GetTree ssz hashes the list
+func (l List[T, L]) GetTree() (*Node, error) {
+	return ProofTree(l)
+}
+
+// SchemaSSZ returns the schema of the tree of the list
+func (l List[T, L]) SchemaSSZ() *Schema {
+	return SchemaList(elemSchema[T](), limitOf[L]())
+}
+
+// Vector is a SSZ vector of N elements with the same elements as a List.
+// A Vector of bytes is a SSZ byte vector.
+type Vector[T any, N Limit] []T
+
+// Len returns the number of elements of the vector
+func (v Vector[T, N]) Len() int {
+	return len(v)
+}
+
+// Limit returns the number of elements of the vector
+func (v Vector[T, N]) Limit() uint64 {
+	return limitOf[N]()
+}
+
+// Get returns the element at the index
+func (v Vector[T, N]) Get(i int) T {
+	return v[i]
+}
+
+// Append appends an element to the vector while it has less than N elements
+func (v *Vector[T, N]) Append(elem T) error {
+	if uint64(len(*v)) >= limitOf[N]() {
+		return fmt.Errorf("%w: the vector has %d elements", ErrVectorLength, limitOf[N]())
+	}
+	*v = append(*v, elem)
+	return nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes of the vector
+func (v Vector[T, N]) SizeSSZ() int {
+	return sizeElems([]T(v))
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes of the vector
+func (v Vector[T, N]) MinSSZSize() uint64 {
+	info, err := elemInfoOf[T]()
+	if err != nil {
+		return 0
+	}
+	return info.minElems(limitOf[N]())
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes of the vector
+func (v Vector[T, N]) MaxSSZSize() uint64 {
+	info, err := elemInfoOf[T]()
+	if err != nil {
+		return MaxSizeUnbounded
+	}
+	return info.maxElems(limitOf[N]())
+}
+
+// MarshalSSZ ssz marshals the vector
+func (v Vector[T, N]) MarshalSSZ() ([]byte, error) {
+	return MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the vector to a target array
+func (v Vector[T, N]) MarshalSSZTo(dst []byte) ([]byte, error) {
+	if uint64(len(v)) != limitOf[N]() {
+		return nil, ErrVectorLengthFn("Vector", len(v), int(limitOf[N]()))
+	}
+	return marshalElems(dst, []T(v))
+}
+
+// UnmarshalSSZ ssz unmarshals the vector
+func (v *Vector[T, N]) UnmarshalSSZ(buf []byte) error {
+	items, err := unmarshalElems[T](buf, limitOf[N](), true)
+	if err != nil {
+		return err
+	}
+	*v = items
+	return nil
+}
+
+// HashTreeRoot ssz hashes the vector
+func (v Vector[T, N]) HashTreeRoot() ([32]byte, error) {
+	return HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the vector with a hasher
+func (v Vector[T, N]) HashTreeRootWith(hh HashWalker) error {
+	if uint64(len(v)) != limitOf[N]() {
+		return ErrVectorLengthFn("Vector", len(v), int(limitOf[N]()))
+	}
+	info, err := elemInfoOf[T]()
+	if err != nil {
+		return err
+	}
+	indx := hh.Index()
+	if err := hashElems(hh, []T(v), info); err != nil {
+		return err
+	}
+	hh.Merkleize(indx)
+	return nil
+}
+
+// GetTree ssz hashes the vector
+func (v Vector[T, N]) GetTree() (*Node, error) {
+	return ProofTree(v)
+}
+
+// SchemaSSZ returns the schema of the tree of the vector
+func (v Vector[T, N]) SchemaSSZ() *Schema {
+	return SchemaVector(elemSchema[T](), limitOf[N]())
+}
+
+// BitlistOf is a SSZ Bitlist of at most L bits with the length delimiter bit.
+// An empty value is a bitlist without bits.
+type BitlistOf[L Limit] Bitlist
+
+// Len returns the number of bits of the bitlist without the length delimiter bit
+func (b BitlistOf[L]) Len() uint64 {
+	return Bitlist(b).Len()
+}
+
+// Limit returns the maximum number of bits of the bitlist
+func (b BitlistOf[L]) Limit() uint64 {
+	return limitOf[L]()
+}
+
+// BitAt returns the bit at the index. It is false if the index is out of range
+func (b BitlistOf[L]) BitAt(i uint64) bool {
+	return Bitlist(b).BitAt(i)
+}
+
+// SetBitAt sets the bit at the index. It does nothing if the index is out of range
+func (b BitlistOf[L]) SetBitAt(i uint64, val bool) {
+	Bitlist(b).SetBitAt(i, val)
+}
+
+// Count returns the number of bits set without the length delimiter bit
+func (b BitlistOf[L]) Count() uint64 {
+	return Bitlist(b).Count()
+}
+
+// BitIndices returns the indices of the bits set
+func (b BitlistOf[L]) BitIndices() []int {
+	return Bitlist(b).BitIndices()
+}
+
+// Or returns the union of two bitlists of the same length
+func (b BitlistOf[L]) Or(c BitlistOf[L]) (BitlistOf[L], error) {
+	res, err := Bitlist(b).Or(Bitlist(c))
+	return BitlistOf[L](res), err
+}
+
+// And returns the intersection of two bitlists of the same length
+func (b BitlistOf[L]) And(c BitlistOf[L]) (BitlistOf[L], error) {
+	res, err := Bitlist(b).And(Bitlist(c))
+	return BitlistOf[L](res), err
+}
+
+// Overlaps returns true if both bitlists of the same length have a bit set at the same index
+func (b BitlistOf[L]) Overlaps(c BitlistOf[L]) (bool, error) {
+	return Bitlist(b).Overlaps(Bitlist(c))
+}
+
+// Contains returns true if all the bits set of the other bitlist of the same length are set
+func (b BitlistOf[L]) Contains(c BitlistOf[L]) (bool, error) {
+	return Bitlist(b).Contains(Bitlist(c))
+}
+
+// Append appends a bit to the bitlist and moves the length delimiter bit
+func (b *BitlistOf[L]) Append(bit bool) error {
+	n := b.Len()
+	if n >= limitOf[L]() {
+		return fmt.Errorf("%w: the limit is %d bits", ErrListTooBig, limitOf[L]())
+	}
+	if len(*b) == 0 {
+		*b = BitlistOf[L]{0x1}
+	}
+	buf := *b
+
+	// replace the delimiter bit with the new bit
+	buf[n/8] &^= 1 << (n % 8)
+	if bit {
+		buf[n/8] |= 1 << (n % 8)
+	}
+	if (n+1)/8 == uint64(len(buf)) {
+		buf = append(buf, 0)
+	}
+	buf[(n+1)/8] |= 1 << ((n + 1) % 8)
+	*b = buf
+	return nil
+}
+
+// SizeSSZ returns the ssz encoded size in bytes of the bitlist
+func (b BitlistOf[L]) SizeSSZ() int {
+	if len(b) == 0 {
+		return 1
+	}
+	return len(b)
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes of the bitlist
+func (b BitlistOf[L]) MinSSZSize() uint64 {
+	return 1
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes of the bitlist
+func (b BitlistOf[L]) MaxSSZSize() uint64 {
+	return limitOf[L]()/8 + 1
+}
+
+// MarshalSSZ ssz marshals the bitlist
+func (b BitlistOf[L]) MarshalSSZ() ([]byte, error) {
+	return MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the bitlist to a target array
+func (b BitlistOf[L]) MarshalSSZTo(dst []byte) ([]byte, error) {
+	if len(b) == 0 {
+		return append(dst, 0x1), nil
+	}
+	if err := ValidateBitlist(b, limitOf[L]()); err != nil {
+		return nil, err
+	}
+	return append(dst, b...), nil
+}
+
+// UnmarshalSSZ ssz unmarshals the bitlist
+func (b *BitlistOf[L]) UnmarshalSSZ(buf []byte) error {
+	if err := ValidateBitlist(buf, limitOf[L]()); err != nil {
+		return err
+	}
+	*b = append((*b)[:0], buf...)
+	return nil
+}
+
+// HashTreeRoot ssz hashes the bitlist
+func (b BitlistOf[L]) HashTreeRoot() ([32]byte, error) {
+	return HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the bitlist with a hasher
+func (b BitlistOf[L]) HashTreeRootWith(hh HashWalker) error {
+	if len(b) == 0 {
+		hh.PutBitlist([]byte{0x1}, limitOf[L]())
+		return nil
+	}
+	if err := ValidateBitlist(b, limitOf[L]()); err != nil {
+		return err
+	}
+	hh.PutBitlist(b, limitOf[L]())
+	return nil
+}
+
+// GetTree ssz hashes the bitlist
+func (b BitlistOf[L]) GetTree() (*Node, error) {
+	return ProofTree(b)
+}
+
+// SchemaSSZ returns the schema of the tree of the bitlist
+func (b BitlistOf[L]) SchemaSSZ() *Schema {
+	return SchemaBitlist(limitOf[L]())
+}
+
+type elemKind int
+
+const (
+	// elemBasic is an unsigned integer or a boolean packed in the chunks
+	elemBasic elemKind = iota
+	// elemBytes is a byte array with its own chunks
+	elemBytes
+	// elemObject implements the Marshaler, Unmarshaler and HashRoot interfaces
+	elemObject
+)
+
+// elemInfo describes the encoding of the elements of a List or a Vector
+type elemInfo struct {
+	kind elemKind
+	size int
+	min  uint64
+	max  uint64
+}
+
+func (e elemInfo) fixed() bool {
+	return e.kind != elemObject || e.min == e.max
+}
+
+func (e elemInfo) minElems(num uint64) uint64 {
+	if e.fixed() {
+		return mulSize(num, e.min)
+	}
+	return mulSize(num, addSize(e.min, bytesPerLengthOffset))
+}
+
+func (e elemInfo) maxElems(num uint64) uint64 {
+	if e.fixed() {
+		return mulSize(num, e.max)
+	}
+	return mulSize(num, addSize(e.max, bytesPerLengthOffset))
+}
+
+func mulSize(a, b uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	if hi != 0 || lo == MaxSizeUnbounded {
+		return MaxSizeUnbounded
+	}
+	return lo
+}
+
+func addSize(a, b uint64) uint64 {
+	sum, carry := bits.Add64(a, b, 0)
+	if carry != 0 {
+		return MaxSizeUnbounded
+	}
+	return sum
+}
+
+type objectSSZ interface {
+	Marshaler
+	HashRoot
+	SizeBounder
+}
+
+func elemInfoOf[T any]() (elemInfo, error) {
+	var zero T
+	switch any(zero).(type) {
+	case uint8, bool:
+		return elemInfo{kind: elemBasic, size: 1, min: 1, max: 1}, nil
+	case uint16:
+		return elemInfo{kind: elemBasic, size: 2, min: 2, max: 2}, nil
+	case uint32:
+		return elemInfo{kind: elemBasic, size: 4, min: 4, max: 4}, nil
+	case uint64:
+		return elemInfo{kind: elemBasic, size: 8, min: 8, max: 8}, nil
+	case objectSSZ:
+		obj := any(zero).(objectSSZ)
+		return elemInfo{kind: elemObject, min: obj.MinSSZSize(), max: obj.MaxSSZSize()}, nil
+	}
+	if typ := reflect.TypeOf(&zero).Elem(); typ.Kind() == reflect.Array && typ.Elem().Kind() == reflect.Uint8 {
+		return elemInfo{kind: elemBytes, size: typ.Len(), min: uint64(typ.Len()), max: uint64(typ.Len())}, nil
+	}
+	return elemInfo{}, fmt.Errorf("type %T is not supported as an element", zero)
+}
+
+// elemSchema returns the schema of the elements of a List or a Vector
+func elemSchema[T any]() *Schema {
+	info, err := elemInfoOf[T]()
+	if err != nil {
+		return SchemaOf(nil)
+	}
+	var zero T
+	switch info.kind {
+	case elemBasic:
+		return SchemaUint(uint64(info.size))
+	case elemBytes:
+		return SchemaBytes(uint64(info.size))
+	default:
+		return SchemaOf(zero)
+	}
+}
+
+func sizeElems[T any](items []T) int {
+	info, err := elemInfoOf[T]()
+	if err != nil {
+		return 0
+	}
+	if info.kind != elemObject {
+		return len(items) * info.size
+	}
+	size := 0
+	for _, item := range items {
+		size += any(item).(objectSSZ).SizeSSZ()
+		if !info.fixed() {
+			size += bytesPerLengthOffset
+		}
+	}
+	return size
+}
+
+func marshalElems[T any](dst []byte, items []T) ([]byte, error) {
+	info, err := elemInfoOf[T]()
+	if err != nil {
+		return nil, err
+	}
+	switch info.kind {
+	case elemBasic:
+		for _, item := range items {
+			switch v := any(item).(type) {
+			case uint8:
+				dst = MarshalUint8(dst, v)
+			case bool:
+				dst = MarshalBool(dst, v)
+			case uint16:
+				dst = MarshalUint16(dst, v)
+			case uint32:
+				dst = MarshalUint32(dst, v)
+			case uint64:
+				dst = MarshalUint64(dst, v)
+			}
+		}
+
+	case elemBytes:
+		for i := range items {
+			dst = append(dst, reflect.ValueOf(&items[i]).Elem().Slice(0, info.size).Bytes()...)
+		}
+
+	case elemObject:
+		if !info.fixed() {
+			offset := bytesPerLengthOffset * len(items)
+			for _, item := range items {
+				dst = WriteOffset(dst, offset)
+				offset += any(item).(objectSSZ).SizeSSZ()
+			}
+		}
+		for _, item := range items {
+			if dst, err = any(item).(objectSSZ).MarshalSSZTo(dst); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return dst, nil
+}
+
+// unmarshalElems decodes at most num elements or exactly num elements if exact is set
+func unmarshalElems[T any](buf []byte, num uint64, exact bool) ([]T, error) {
+	info, err := elemInfoOf[T]()
+	if err != nil {
+		return nil, err
+	}
+
+	var items []T
+	if info.fixed() {
+		size := int(info.max)
+		if size == 0 || len(buf)%size != 0 {
+			return nil, ErrSize
+		}
+		items = make([]T, len(buf)/size)
+	} else {
+		length, err := DecodeDynamicLength(buf, int(minUint64(num, uint64(len(buf)))))
+		if err != nil {
+			return nil, err
+		}
+		items = make([]T, length)
+	}
+	if uint64(len(items)) > num {
+		return nil, ErrListTooBigFn("List", len(items), int(num))
+	}
+	if exact && uint64(len(items)) != num {
+		return nil, ErrVectorLengthFn("Vector", len(items), int(num))
+	}
+
+	unmarshal := func(indx int, b []byte) error {
+		switch info.kind {
+		case elemBasic:
+			var v interface{}
+			switch any(items[indx]).(type) {
+			case uint8:
+				v = UnmarshallUint8(b)
+			case bool:
+				if err := ValidateBool(b); err != nil {
+					return err
+				}
+				v = UnmarshalBool(b)
+			case uint16:
+				v = UnmarshallUint16(b)
+			case uint32:
+				v = UnmarshallUint32(b)
+			case uint64:
+				v = UnmarshallUint64(b)
+			}
+			items[indx] = v.(T)
+
+		case elemBytes:
+			reflect.Copy(reflect.ValueOf(&items[indx]).Elem(), reflect.ValueOf(b))
+
+		case elemObject:
+			if u, ok := any(&items[indx]).(Unmarshaler); ok {
+				// value types (i.e. a List)
+				return u.UnmarshalSSZ(b)
+			}
+			// pointers to the generated structs
+			obj := reflect.New(reflect.TypeOf(items[indx]).Elem()).Interface()
+			if err := obj.(Unmarshaler).UnmarshalSSZ(b); err != nil {
+				return err
+			}
+			items[indx] = obj.(T)
+		}
+		return nil
+	}
+
+	if info.fixed() {
+		size := int(info.max)
+		for indx := range items {
+			if err := unmarshal(indx, buf[indx*size:(indx+1)*size]); err != nil {
+				return nil, err
+			}
+		}
+	} else if err := UnmarshalDynamic(buf, len(items), unmarshal); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func minUint64(a, b uint64) uint64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func hashElems[T any](hh HashWalker, items []T, info elemInfo) error {
+	switch info.kind {
+	case elemBasic:
+		for _, item := range items {
+			switch v := any(item).(type) {
+			case uint8:
+				hh.AppendUint8(v)
+			case bool:
+				if v {
+					hh.AppendUint8(1)
+				} else {
+					hh.AppendUint8(0)
+				}
+			case uint16:
+				hh.Append(MarshalUint16(nil, v))
+			case uint32:
+				hh.AppendUint32(v)
+			case uint64:
+				hh.AppendUint64(v)
+			}
+		}
+		hh.FillUpTo32()
+
+	case elemBytes:
+		for i := range items {
+			hh.PutBytes(reflect.ValueOf(&items[i]).Elem().Slice(0, info.size).Bytes())
+		}
+
+	case elemObject:
+		for _, item := range items {
+			if err := any(item).(objectSSZ).HashTreeRootWith(hh); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package ssz
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListBasic(t *testing.T) {
+	var l List[uint64, Limit16]
+	for i := 0; i < 5; i++ {
+		require.NoError(t, l.Append(uint64(i)))
+	}
+	require.Equal(t, 5, l.Len())
+	require.Equal(t, uint64(16), l.Limit())
+	require.Equal(t, uint64(3), l.Get(3))
+	require.Equal(t, 40, l.SizeSSZ())
+	require.Equal(t, uint64(128), l.MaxSSZSize())
+
+	data, err := l.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, 40)
+
+	var l2 List[uint64, Limit16]
+	require.NoError(t, l2.UnmarshalSSZ(data))
+	require.Equal(t, l, l2)
+
+	// same root as a tagged list of uint64
+	hh := NewHasher()
+	hh.PutUint64Array(l, 16)
+	expected, err := hh.HashRoot()
+	require.NoError(t, err)
+
+	root, err := l.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, expected, root)
+
+	// the limit is checked
+	require.Error(t, l2.UnmarshalSSZ(make([]byte, 17*8)))
+	require.Error(t, l2.UnmarshalSSZ(make([]byte, 7)))
+
+	// non canonical booleans
+	var bools List[bool, Limit16]
+	require.NoError(t, bools.UnmarshalSSZ([]byte{0x0, 0x1}))
+	require.Equal(t, List[bool, Limit16]{false, true}, bools)
+	require.Error(t, bools.UnmarshalSSZ([]byte{0x2}))
+}
+
+func TestVectorRoots(t *testing.T) {
+	v := make(Vector[[32]byte, Limit4], 4)
+	for i := range v {
+		v[i][0] = byte(i)
+	}
+	require.Equal(t, uint64(128), v.MinSSZSize())
+	require.Equal(t, uint64(128), v.MaxSSZSize())
+
+	roots := [][]byte{}
+	for i := range v {
+		roots = append(roots, v[i][:])
+	}
+	hh := NewHasher()
+	require.NoError(t, hh.PutRootVector(roots))
+	expected, err := hh.HashRoot()
+	require.NoError(t, err)
+
+	root, err := v.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, expected, root)
+
+	data, err := v.MarshalSSZ()
+	require.NoError(t, err)
+
+	var v2 Vector[[32]byte, Limit4]
+	require.NoError(t, v2.UnmarshalSSZ(data))
+	require.Equal(t, v, v2)
+
+	// a vector needs all the elements
+	require.Error(t, v2.UnmarshalSSZ(data[:96]))
+	require.Error(t, v2.Append([32]byte{}))
+	_, err = v[:3].MarshalSSZ()
+	require.Error(t, err)
+}
+
+func TestListNested(t *testing.T) {
+	var l List[List[byte, Limit32], Limit4]
+	require.NoError(t, l.Append(List[byte, Limit32]{0x1, 0x2}))
+	require.NoError(t, l.Append(nil))
+	require.NoError(t, l.Append(List[byte, Limit32]{0x3}))
+
+	data, err := l.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, []byte{12, 0, 0, 0, 14, 0, 0, 0, 14, 0, 0, 0, 0x1, 0x2, 0x3}, data)
+
+	var l2 List[List[byte, Limit32], Limit4]
+	require.NoError(t, l2.UnmarshalSSZ(data))
+	require.Equal(t, 3, l2.Len())
+	require.Equal(t, []byte{0x3}, []byte(l2.Get(2)))
+
+	// offsets out of order
+	data[4] = 15
+	require.Error(t, l2.UnmarshalSSZ(data))
+}
+
+func TestBitlistOf(t *testing.T) {
+	var b BitlistOf[Limit16]
+	require.Equal(t, uint64(0), b.Len())
+
+	expected := bitfield.NewBitlist(10)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, b.Append(i%3 == 0))
+		expected.SetBitAt(uint64(i), i%3 == 0)
+	}
+	require.Equal(t, uint64(10), b.Len())
+	require.Equal(t, []byte(expected), []byte(b))
+	require.True(t, b.BitAt(3))
+	require.False(t, b.BitAt(4))
+	require.False(t, b.BitAt(10))
+
+	// the same functions as the Bitlist
+	require.Equal(t, uint64(4), b.Count())
+	require.Equal(t, []int{0, 3, 6, 9}, b.BitIndices())
+	b.SetBitAt(4, true)
+	require.Equal(t, Bitlist(b).BitIndices(), b.BitIndices())
+	b.SetBitAt(4, false)
+
+	for i := 0; i < 6; i++ {
+		require.NoError(t, b.Append(true))
+	}
+	require.Error(t, b.Append(true))
+
+	data, err := b.MarshalSSZ()
+	require.NoError(t, err)
+
+	var b2 BitlistOf[Limit16]
+	require.NoError(t, b2.UnmarshalSSZ(data))
+	require.Equal(t, b, b2)
+
+	var b3 BitlistOf[Limit8]
+	require.Error(t, b3.UnmarshalSSZ(data))
+
+	// an empty bitlist is encoded with the length bit
+	data, err = BitlistOf[Limit8]{}.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x1}, data)
+}
+
+func TestListUnsupportedElem(t *testing.T) {
+	var l List[string, Limit4]
+	require.NoError(t, l.Append("a"))
+	_, err := l.MarshalSSZ()
+	require.Error(t, err)
+	require.Equal(t, uint64(MaxSizeUnbounded), l.MaxSSZSize())
+}
@@ -2,165 +2,314 @@ package ssz
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
 	"fmt"
-	"math"
 	"sort"
-
-	"github.com/minio/sha256-simd"
 )
 
 // VerifyProof verifies a single merkle branch. It's more
 // efficient than VerifyMultiproof for proving one leaf.
 func VerifyProof(root []byte, proof *Proof) (bool, error) {
+	return VerifyProofWithHashing(root, proof, DefaultHashing)
+}
+
+// VerifyProofWithHashing verifies a single merkle branch with the given Hashing
+func VerifyProofWithHashing(root []byte, proof *Proof, hh *Hashing) (bool, error) {
 	if len(proof.Hashes) != getPathLength(proof.Index) {
 		return false, errors.New("invalid proof length")
 	}
 
 	node := proof.Leaf[:]
-	tmp := make([]byte, 64)
 	for i, h := range proof.Hashes {
 		if getPosAtLevel(proof.Index, i) {
-			copy(tmp[:32], h[:])
-			copy(tmp[32:], node[:])
-			node = hashFn(tmp)
+			node = hh.hash(h, node)
 		} else {
-			copy(tmp[:32], node[:])
-			copy(tmp[32:], h[:])
-			node = hashFn(tmp)
+			node = hh.hash(node, h)
 		}
 	}
 
 	return bytes.Equal(root, node), nil
 }
 
+// ProveGIndex returns a merkle proof of the node at the given
+// generalized index of the tree of the object.
+func ProveGIndex(v HashRoot, index GIndex) (*Proof, error) {
+	tree, err := v.GetTree()
+	if err != nil {
+		return nil, err
+	}
+	return tree.Prove(index)
+}
+
+// VerifyProofAtGIndex verifies a single merkle branch and checks
+// that it proves the node at the given generalized index.
+func VerifyProofAtGIndex(root []byte, proof *Proof, index GIndex) (bool, error) {
+	if proof.Index != index {
+		return false, fmt.Errorf("proof is for index %d but %d expected", proof.Index, index)
+	}
+	return VerifyProof(root, proof)
+}
+
 // VerifyMultiproof verifies a proof for multiple leaves against the given root.
-func VerifyMultiproof(root []byte, proof [][]byte, leaves [][]byte, indices []int) (bool, error) {
+func VerifyMultiproof(root []byte, proof [][]byte, leaves [][]byte, indices []GIndex) (bool, error) {
+	return VerifyMultiproofWithHashing(root, proof, leaves, indices, DefaultHashing)
+}
+
+// VerifyMultiproofWithHashing verifies a proof for multiple leaves against the given root with the given Hashing.
+// The proof hashes are the nodes of the helper indices of the leaves (see getHelperIndices) and the root is
+// computed as in calculate_multi_merkle_root from the consensus specs. The proof is rejected if the indices
+// are repeated or overlap (one index is in the subtree of another) and if it has missing or extra hashes.
+func VerifyMultiproofWithHashing(root []byte, proof [][]byte, leaves [][]byte, indices []GIndex, hh *Hashing) (bool, error) {
+	nodes, err := multiproofNodes(proof, leaves, indices)
+	if err != nil {
+		return false, err
+	}
+	res, err := calculateMultiMerkleRoot(nodes, hh)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(res, root), nil
+}
+
+// proofNode is a node of a multiproof with its generalized index
+type proofNode struct {
+	index GIndex
+	hash  []byte
+
+	// node is the tree node when the multiproof is expanded as a tree
+	node *Node
+}
+
+// multiproofNodes validates the indices of a multiproof and returns the
+// leaves and the proof hashes sorted by decreasing generalized index.
+func multiproofNodes(proof [][]byte, leaves [][]byte, indices []GIndex) ([]proofNode, error) {
 	if len(leaves) != len(indices) {
-		return false, errors.New("number of leaves and indices mismatch")
+		return nil, errors.New("number of leaves and indices mismatch")
+	}
+	if err := checkIndices(indices); err != nil {
+		return nil, err
 	}
 
-	reqIndices := getRequiredIndices(indices)
-	if len(reqIndices) != len(proof) {
-		return false, fmt.Errorf("number of proof hashes %d and required indices %d mismatch", len(proof), len(reqIndices))
+	helperIndices := getHelperIndices(indices)
+	if len(helperIndices) != len(proof) {
+		return nil, fmt.Errorf("number of proof hashes %d and required indices %d mismatch", len(proof), len(helperIndices))
 	}
 
-	keys := make([]int, len(indices)+len(reqIndices))
-	nk := 0
-	// Create database of index -> value (hash)
-	// from inputs
-	db := make(map[int][]byte)
+	nodes := make([]proofNode, 0, len(indices)+len(helperIndices))
 	for i, leaf := range leaves {
-		db[indices[i]] = leaf
-		keys[nk] = indices[i]
-		nk++
+		nodes = append(nodes, proofNode{index: indices[i], hash: leaf})
 	}
 	for i, h := range proof {
-		db[reqIndices[i]] = h
-		keys[nk] = reqIndices[i]
-		nk++
+		nodes = append(nodes, proofNode{index: helperIndices[i], hash: h})
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].index > nodes[j].index })
+	return nodes, nil
+}
+
+// calculateMultiMerkleRoot computes the root of the nodes sorted by decreasing generalized index
+func calculateMultiMerkleRoot(nodes []proofNode, hh *Hashing) ([]byte, error) {
+	root, err := walkMultiproof(nodes, func(left, right *proofNode) proofNode {
+		return proofNode{hash: hh.hash(left.hash, right.hash)}
+	})
+	if err != nil {
+		return nil, err
 	}
-	sort.Sort(sort.Reverse(sort.IntSlice(keys)))
+	return root.hash, nil
+}
 
-	pos := 0
-	tmp := make([]byte, 64)
-	for pos < len(keys) {
-		k := keys[pos]
-		// Root has been reached
-		if k == 1 {
-			break
-		}
+// walkMultiproof combines the nodes sorted by decreasing generalized index up to the root.
+// The generalized indices of a level are larger than the ones of the levels above, so in this
+// order the nodes are sorted by level and the siblings are consecutive (the right node first).
+// The parents are created in the same order, then they are kept in a queue that is merged with
+// the input nodes instead of storing every node in a map.
+func walkMultiproof(nodes []proofNode, parent func(left, right *proofNode) proofNode) (*proofNode, error) {
+	parents := make([]proofNode, 0, len(nodes))
 
-		_, hasParent := db[getParent(k)]
+	i, j := 0, 0
+	next := func() (*proofNode, error) {
+		hasNode, hasParent := i < len(nodes), j < len(parents)
+		if hasNode && hasParent && nodes[i].index == parents[j].index {
+			return nil, fmt.Errorf("node %d is in the proof and it is also computed", nodes[i].index)
+		}
+		if hasNode && (!hasParent || nodes[i].index > parents[j].index) {
+			i++
+			return &nodes[i-1], nil
+		}
 		if hasParent {
-			pos++
-			continue
+			j++
+			return &parents[j-1], nil
+		}
+		return nil, nil
+	}
+
+	for {
+		right, err := next()
+		if err != nil {
+			return nil, err
+		}
+		if right == nil {
+			return nil, errors.New("root was not computed during proof verification")
+		}
+		if right.index == 1 {
+			// the root is the last node
+			if extra, err := next(); err != nil || extra != nil {
+				return nil, errors.New("proof has nodes that are not used to compute the root")
+			}
+			return right, nil
+		}
+		if !right.index.IsRight() {
+			return nil, fmt.Errorf("proof is missing the required node %d", right.index.Sibling())
 		}
 
-		left, hasLeft := db[(k|1)^1]
-		right, hasRight := db[k|1]
-		if !hasRight || !hasLeft {
-			return false, fmt.Errorf("proof is missing required nodes, either %d or %d", (k|1)^1, k|1)
+		left, err := next()
+		if err != nil {
+			return nil, err
+		}
+		if left == nil || left.index != right.index.Sibling() {
+			return nil, fmt.Errorf("proof is missing the required node %d", right.index.Sibling())
 		}
+		node := parent(left, right)
+		node.index = right.index.Parent()
+		parents = append(parents, node)
+	}
+}
 
-		copy(tmp[:32], left[:])
-		copy(tmp[32:], right[:])
-		db[getParent(k)] = hashFn(tmp)
-		keys = append(keys, getParent(k))
+// checkIndices checks that the indices of a multiproof are valid, not
+// repeated and that none of them is in the subtree of another.
+func checkIndices(indices []GIndex) error {
+	sorted := make([]GIndex, len(indices))
+	copy(sorted, indices)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
 
-		pos++
+	for i, index := range sorted {
+		if index < 1 {
+			return fmt.Errorf("invalid index %d", index)
+		}
+		if i > 0 && sorted[i-1] == index {
+			return fmt.Errorf("duplicated index %d", index)
+		}
+		for cur := index.Parent(); cur >= 1; cur = cur.Parent() {
+			// the ancestors are smaller than the index
+			if pos := sort.Search(i, func(k int) bool { return sorted[k] >= cur }); pos < i && sorted[pos] == cur {
+				return fmt.Errorf("index %d is in the subtree of index %d", index, cur)
+			}
+		}
 	}
+	return nil
+}
 
-	res, ok := db[1]
-	if !ok {
-		return false, fmt.Errorf("root was not computed during proof verification")
+// getHelperIndices returns the generalized indices of the nodes in the tree that are
+// required to prove the given leaf indices (get_helper_indices from the consensus specs):
+// the siblings of the nodes on the paths from the leaves to the root that are not on any
+// of those paths. The returned indices are in a decreasing order.
+func getHelperIndices(leafIndices []GIndex) []GIndex {
+	branch := []GIndex{}
+	path := []GIndex{}
+	for _, leaf := range leafIndices {
+		for cur := leaf; cur > 1; cur = cur.Parent() {
+			branch = append(branch, cur.Sibling())
+			path = append(path, cur)
+		}
 	}
+	branch = sortDecreasing(branch)
+	path = sortDecreasing(path)
 
-	return bytes.Equal(res, root), nil
+	// difference of both sorted sets
+	helpers := make([]GIndex, 0, len(branch))
+	j := 0
+	for _, index := range branch {
+		for j < len(path) && path[j] > index {
+			j++
+		}
+		if j < len(path) && path[j] == index {
+			continue
+		}
+		helpers = append(helpers, index)
+	}
+	return helpers
 }
 
-// Returns the position (i.e. false for left, true for right)
-// of an index at a given level.
-// Level 0 is the actual index's level, Level 1 is the position
-// of the parent, etc.
-func getPosAtLevel(index int, level int) bool {
-	return (index & (1 << level)) > 0
-}
+// sortDecreasing sorts the indices in a decreasing order and removes the duplicates
+func sortDecreasing(indices []GIndex) []GIndex {
+	sort.Slice(indices, func(i, j int) bool { return indices[i] > indices[j] })
 
-// Returns the length of the path to a node represented by its generalized index.
-func getPathLength(index int) int {
-	return int(math.Log2(float64(index)))
+	res := indices[:0]
+	for _, index := range indices {
+		if len(res) == 0 || res[len(res)-1] != index {
+			res = append(res, index)
+		}
+	}
+	return res
 }
 
-// Returns the generalized index for a node's sibling.
-func getSibling(index int) int {
-	return index ^ 1
+// VerifyListLength verifies a proof of the length mix-in leaf of the list at the
+// given general index (see Node.ProveListLength) and checks that the length is 'length'.
+func VerifyListLength(root []byte, proof *Proof, list GIndex, length uint64) (bool, error) {
+	return VerifyListLengthWithHashing(root, proof, list, length, DefaultHashing)
 }
 
-// Returns the generalized index for a node's parent.
-func getParent(index int) int {
-	return index >> 1
+// VerifyListLengthWithHashing is the same as VerifyListLength with the given Hashing
+func VerifyListLengthWithHashing(root []byte, proof *Proof, list GIndex, length uint64, hh *Hashing) (bool, error) {
+	proofLength, err := verifyListLength(root, proof, list, hh)
+	if err != nil {
+		return false, err
+	}
+	return proofLength == length, nil
 }
 
-// Returns generalized indices for all nodes in the tree that are
-// required to prove the given leaf indices. The returned indices
-// are in a decreasing order.
-func getRequiredIndices(leafIndices []int) []int {
-	exists := struct{}{}
-	// Sibling hashes needed for verification
-	required := make(map[int]struct{})
-	// Set of hashes that will be computed
-	// on the path from leaf to root.
-	computed := make(map[int]struct{})
-	leaves := make(map[int]struct{})
+// VerifyListElementAbsent verifies a proof of the length mix-in leaf of the list at the
+// given general index and checks that the element 'index' is not in the list (the index
+// is larger or equal than the length). The nodes of the list beyond the length are empty
+// subtrees, which can be proven with Node.ProveZeroSubtree.
+func VerifyListElementAbsent(root []byte, proof *Proof, list GIndex, index uint64) (bool, error) {
+	return VerifyListElementAbsentWithHashing(root, proof, list, index, DefaultHashing)
+}
 
-	for _, leaf := range leafIndices {
-		leaves[leaf] = exists
-		cur := leaf
-		for cur > 1 {
-			sibling := getSibling(cur)
-			parent := getParent(cur)
-			required[sibling] = exists
-			computed[parent] = exists
-			cur = parent
-		}
+// VerifyListElementAbsentWithHashing is the same as VerifyListElementAbsent with the given Hashing
+func VerifyListElementAbsentWithHashing(root []byte, proof *Proof, list GIndex, index uint64, hh *Hashing) (bool, error) {
+	length, err := verifyListLength(root, proof, list, hh)
+	if err != nil {
+		return false, err
 	}
+	return index >= length, nil
+}
 
-	requiredList := make([]int, 0, len(required))
-	// Remove computed indices from required ones
-	for r := range required {
-		_, isComputed := computed[r]
-		_, isLeaf := leaves[r]
-		if !isComputed && !isLeaf {
-			requiredList = append(requiredList, r)
-		}
+// verifyListLength verifies the proof of the length mix-in leaf and returns the length
+func verifyListLength(root []byte, proof *Proof, list GIndex, hh *Hashing) (uint64, error) {
+	index, err := list.Right()
+	if err != nil {
+		return 0, err
 	}
+	if proof.Index != index {
+		return 0, fmt.Errorf("proof is for index %d but length of list %d is at %d", proof.Index, list, index)
+	}
+	if len(proof.Leaf) != 32 || !bytes.Equal(proof.Leaf[8:], make([]byte, 24)) {
+		return 0, errors.New("proof leaf is not a length")
+	}
+	ok, err := VerifyProofWithHashing(root, proof, hh)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, errors.New("invalid length proof")
+	}
+	return binary.LittleEndian.Uint64(proof.Leaf[:8]), nil
+}
 
-	sort.Sort(sort.Reverse(sort.IntSlice(requiredList)))
-	return requiredList
+// VerifyZeroSubtree verifies a proof of a node (see Node.ProveZeroSubtree) and checks
+// that the node is the root of an empty subtree with the given height.
+func VerifyZeroSubtree(root []byte, proof *Proof, height int) (bool, error) {
+	return VerifyZeroSubtreeWithHashing(root, proof, height, DefaultHashing)
 }
 
-func hashFn(data []byte) []byte {
-	res := sha256.Sum256(data)
-	return res[:]
+// VerifyZeroSubtreeWithHashing is the same as VerifyZeroSubtree with the given Hashing
+func VerifyZeroSubtreeWithHashing(root []byte, proof *Proof, height int, hh *Hashing) (bool, error) {
+	if height < 0 || height > 64 {
+		return false, fmt.Errorf("invalid subtree height %d", height)
+	}
+	if !bytes.Equal(proof.Leaf, hh.ZeroHash(height)) {
+		return false, nil
+	}
+	return VerifyProofWithHashing(root, proof, hh)
 }
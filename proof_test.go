@@ -0,0 +1,223 @@
+package ssz
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+// multiproofFixture are the vectors in testdata/multiproof.yaml generated
+// with the reference functions of the consensus specs (testdata/multiproof.py)
+type multiproofFixture struct {
+	Depth  int
+	Leaves []string
+	Root   string
+	Cases  []struct {
+		Indices       []GIndex `yaml:"indices"`
+		HelperIndices []GIndex `yaml:"helper_indices"`
+		Leaves        []string `yaml:"leaves"`
+		Proof         []string `yaml:"proof"`
+	}
+}
+
+func decodeHexList(t *testing.T, list []string) [][]byte {
+	res := [][]byte{}
+	for _, str := range list {
+		buf, err := hex.DecodeString(strings.TrimPrefix(str, "0x"))
+		require.NoError(t, err)
+		res = append(res, buf)
+	}
+	return res
+}
+
+func TestMultiproofSpecVectors(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/multiproof.yaml")
+	require.NoError(t, err)
+
+	var fixture multiproofFixture
+	require.NoError(t, yaml.Unmarshal(data, &fixture))
+
+	tree, err := TreeFromChunks(decodeHexList(t, fixture.Leaves))
+	require.NoError(t, err)
+
+	root := decodeHexList(t, []string{fixture.Root})[0]
+	require.Equal(t, root, tree.Hash())
+
+	for _, c := range fixture.Cases {
+		leaves, proof := decodeHexList(t, c.Leaves), decodeHexList(t, c.Proof)
+		require.Equal(t, c.HelperIndices, getHelperIndices(c.Indices))
+
+		multiproof, err := tree.ProveMulti(c.Indices)
+		require.NoError(t, err)
+		require.Equal(t, leaves, multiproof.Leaves)
+		require.Equal(t, proof, multiproof.Hashes)
+
+		ok, err := VerifyMultiproof(root, proof, leaves, c.Indices)
+		require.NoError(t, err)
+		require.True(t, ok, "indices %v", c.Indices)
+
+		if len(proof) != 0 {
+			// tampered hash
+			tampered := append([][]byte{}, proof...)
+			tampered[0] = make([]byte, 32)
+			ok, err = VerifyMultiproof(root, tampered, leaves, c.Indices)
+			require.NoError(t, err)
+			require.False(t, ok)
+
+			// missing hash
+			_, err = VerifyMultiproof(root, proof[1:], leaves, c.Indices)
+			require.Error(t, err)
+		}
+
+		// extra hash
+		_, err = VerifyMultiproof(root, append(proof, proof...), leaves, c.Indices)
+		if len(proof) != 0 {
+			require.Error(t, err)
+		}
+		_, err = VerifyMultiproof(root, append(append([][]byte{}, proof...), root), leaves, c.Indices)
+		require.Error(t, err)
+	}
+}
+
+func TestMultiproofInvalidIndices(t *testing.T) {
+	chunks := [][]byte{}
+	for i := uint64(0); i < 8; i++ {
+		chunks = append(chunks, LeafFromUint64(i).value)
+	}
+	tree, err := TreeFromChunks(chunks)
+	require.NoError(t, err)
+	root := tree.Hash()
+
+	cases := []struct {
+		name    string
+		indices []GIndex
+	}{
+		{"duplicated", []GIndex{9, 9}},
+		{"overlapping", []GIndex{9, 4}},
+		{"overlapping with root", []GIndex{1, 15}},
+		{"zero", []GIndex{0, 9}},
+	}
+	for _, c := range cases {
+		_, err := tree.ProveMulti(c.indices)
+		require.Error(t, err, c.name)
+
+		// create the proof without the invalid indices
+		leaves := [][]byte{}
+		for _, index := range c.indices {
+			leaf := make([]byte, 32)
+			if index != 0 {
+				node, err := tree.Get(index)
+				require.NoError(t, err)
+				leaf = node.Hash()
+			}
+			leaves = append(leaves, leaf)
+		}
+		proof, err := tree.ProveMulti(c.indices[1:])
+		require.NoError(t, err)
+
+		_, err = VerifyMultiproof(root, proof.Hashes, leaves, c.indices)
+		require.Error(t, err, c.name)
+	}
+}
+
+func TestCalculateMultiMerkleRoot(t *testing.T) {
+	leaf := func(i uint64) []byte {
+		return LeafFromUint64(i).value
+	}
+	node := func(index GIndex, i uint64) proofNode {
+		return proofNode{index: index, hash: leaf(i)}
+	}
+
+	// the node 4 is missing
+	_, err := calculateMultiMerkleRoot([]proofNode{node(5, 1), node(3, 3)}, DefaultHashing)
+	require.Error(t, err)
+
+	// the node 2 is given and computed
+	_, err = calculateMultiMerkleRoot([]proofNode{node(5, 1), node(4, 2), node(3, 3), node(2, 4)}, DefaultHashing)
+	require.Error(t, err)
+
+	res, err := calculateMultiMerkleRoot([]proofNode{node(5, 1), node(4, 2), node(3, 3)}, DefaultHashing)
+	require.NoError(t, err)
+	expected := DefaultHashing.hash(DefaultHashing.hash(leaf(2), leaf(1)), leaf(3))
+	require.Equal(t, expected, res)
+}
+
+func TestListLengthProof(t *testing.T) {
+	leaves := []*Node{}
+	for i := uint64(0); i < 5; i++ {
+		leaves = append(leaves, LeafFromUint64(i+1))
+	}
+	list, err := TreeFromNodesWithMixin(leaves, len(leaves), 8)
+	require.NoError(t, err)
+
+	// the list is the left field of a container
+	tree := NewNodeWithLR(list, LeafFromUint64(100))
+	root := tree.Hash()
+
+	proof, err := tree.ProveListLength(2)
+	require.NoError(t, err)
+	require.Equal(t, GIndex(5), proof.Index)
+
+	ok, err := VerifyListLength(root, proof, 2, 5)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = VerifyListLength(root, proof, 2, 4)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// proof for another list
+	_, err = VerifyListLength(root, proof, 3, 5)
+	require.Error(t, err)
+
+	for i, absent := range []bool{false, false, false, false, false, true, true, true} {
+		ok, err := VerifyListElementAbsent(root, proof, 2, uint64(i))
+		require.NoError(t, err)
+		require.Equal(t, absent, ok, "element %d", i)
+	}
+
+	// the proof of a leaf that is not a length
+	proof, err = tree.Prove(3)
+	require.NoError(t, err)
+	proof.Index = 5
+	_, err = VerifyListLength(root, proof, 2, 100)
+	require.Error(t, err)
+}
+
+func TestZeroSubtreeProof(t *testing.T) {
+	leaves := []*Node{}
+	for i := uint64(0); i < 5; i++ {
+		leaves = append(leaves, LeafFromUint64(i+1))
+	}
+	tree, err := TreeFromNodesWithMixin(leaves, len(leaves), 8)
+	require.NoError(t, err)
+	root := tree.Hash()
+
+	// the elements are the leaves 16 to 23, the elements 6 and 7 are the node 11
+	proof, err := tree.ProveZeroSubtree(11)
+	require.NoError(t, err)
+
+	ok, err := VerifyZeroSubtree(root, proof, 1)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = VerifyZeroSubtree(root, proof, 0)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// element 5 is the leaf 21
+	proof, err = tree.ProveZeroSubtree(21)
+	require.NoError(t, err)
+
+	ok, err = VerifyZeroSubtree(root, proof, 0)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// element 4 is not empty
+	_, err = tree.ProveZeroSubtree(20)
+	require.Error(t, err)
+}
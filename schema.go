@@ -0,0 +1,231 @@
+package ssz
+
+import (
+	"fmt"
+)
+
+type schemaKind int
+
+const (
+	schemaBasic schemaKind = iota
+	schemaContainer
+	schemaVector
+	schemaList
+	schemaOpaque
+)
+
+// Schema describes the layout of the tree of an ssz object. It is used to
+// name the nodes of the tree with the path of the field (see FieldPath).
+type Schema struct {
+	kind schemaKind
+
+	// bits is the size in bits of a basic type
+	bits uint64
+
+	// fields of a container
+	fields []*SchemaField
+
+	// elem is the type of the elements of a vector or a list
+	elem *Schema
+
+	// length is the length of a vector or the limit of a list
+	length uint64
+}
+
+// SchemaField is a named field of a container
+type SchemaField struct {
+	Name   string
+	Schema *Schema
+}
+
+// SchemaUint returns the schema of an unsigned integer of the given size in bytes
+func SchemaUint(size uint64) *Schema {
+	return &Schema{kind: schemaBasic, bits: size * 8}
+}
+
+// SchemaBool returns the schema of a boolean
+func SchemaBool() *Schema {
+	return SchemaUint(1)
+}
+
+// SchemaBytes returns the schema of a fixed size byte array
+func SchemaBytes(size uint64) *Schema {
+	return SchemaVector(SchemaUint(1), size)
+}
+
+// SchemaContainer returns the schema of a container with the given fields
+func SchemaContainer(fields ...*SchemaField) *Schema {
+	return &Schema{kind: schemaContainer, fields: fields}
+}
+
+// SchemaVector returns the schema of a vector of the given length
+func SchemaVector(elem *Schema, length uint64) *Schema {
+	return &Schema{kind: schemaVector, elem: elem, length: length}
+}
+
+// SchemaList returns the schema of a list with the given limit
+func SchemaList(elem *Schema, limit uint64) *Schema {
+	return &Schema{kind: schemaList, elem: elem, length: limit}
+}
+
+// SchemaBitlist returns the schema of a bitlist with the given limit
+func SchemaBitlist(limit uint64) *Schema {
+	return SchemaList(&Schema{kind: schemaBasic, bits: 1}, limit)
+}
+
+// SchemaBitvector returns the schema of a bitvector of the given length
+func SchemaBitvector(length uint64) *Schema {
+	return SchemaVector(&Schema{kind: schemaBasic, bits: 1}, length)
+}
+
+// SchemaOf returns the schema of an object generated by sszgen (see Schemer). The objects
+// that do not describe their schema return an opaque schema without field paths.
+func SchemaOf(v interface{}) *Schema {
+	if obj, ok := v.(Schemer); ok {
+		return obj.SchemaSSZ()
+	}
+	return &Schema{kind: schemaOpaque}
+}
+
+// chunks returns the number of leaves of the tree of the elements of a vector or a list
+func (s *Schema) chunks() uint64 {
+	if s.elem.kind == schemaBasic {
+		return (s.length*s.elem.bits + 255) / 256
+	}
+	return s.length
+}
+
+// FieldPath returns the path of the field of the node with the given generalized index
+// (i.e. 'Validators[3].Slashed'). The chunks of basic elements return the range of the elements
+// (i.e. 'Balances[4:8]') and the length of a list is 'len(Balances)'. The intermediate nodes of
+// a container, a vector or a list that do not match a field or a chunk return false with the path
+// of the closest object.
+func (s *Schema) FieldPath(index GIndex) (string, bool) {
+	res := s.resolve(index)
+	return res.path, res.ok
+}
+
+// schemaPath is the node of the schema that matches a generalized index
+type schemaPath struct {
+	path string
+	ok   bool
+
+	// elems is set for the chunks of basic elements of a vector or a list
+	elems *schemaElems
+}
+
+// schemaElems is the range of basic elements of a vector or a list in a chunk
+type schemaElems struct {
+	prefix   string
+	from, to uint64
+	bits     uint64
+}
+
+// name returns the path of the element at the given position of the range
+func (e *schemaElems) name(i uint64) string {
+	return fmt.Sprintf("%s[%d]", e.prefix, e.from+i)
+}
+
+func (s *Schema) resolve(index GIndex) *schemaPath {
+	if index < 1 {
+		return &schemaPath{}
+	}
+	return s.fieldPath("", index, index.Depth())
+}
+
+func (s *Schema) fieldPath(prefix string, index GIndex, level int) *schemaPath {
+	if level == 0 {
+		return &schemaPath{path: prefix, ok: true}
+	}
+
+	switch s.kind {
+	case schemaContainer:
+		depth := int(getDepth(uint64(len(s.fields))))
+		if level < depth {
+			return &schemaPath{path: prefix}
+		}
+		indx := pathBits(index, level, depth)
+		if indx >= uint64(len(s.fields)) {
+			// padding
+			return &schemaPath{path: prefix}
+		}
+		f := s.fields[indx]
+		name := f.Name
+		if prefix != "" {
+			name = prefix + "." + name
+		}
+		return f.Schema.fieldPath(name, index, level-depth)
+
+	case schemaList:
+		if pathBits(index, level, 1) == 1 {
+			// length mix-in
+			if level == 1 {
+				return &schemaPath{path: "len(" + prefix + ")", ok: true}
+			}
+			return &schemaPath{path: prefix}
+		}
+		return s.elementsPath(prefix, index, level-1)
+
+	case schemaVector:
+		return s.elementsPath(prefix, index, level)
+
+	default:
+		return &schemaPath{path: prefix}
+	}
+}
+
+// elementsPath returns the path of a node in the tree of the elements of a vector or a list
+func (s *Schema) elementsPath(prefix string, index GIndex, level int) *schemaPath {
+	chunks := s.chunks()
+	depth := int(getDepth(chunks))
+	if level < depth {
+		// subtree of several chunks
+		first := pathBits(index, level, level) << (depth - level)
+		return &schemaPath{path: s.chunksPath(prefix, first, first+1<<(depth-level), chunks).path}
+	}
+
+	chunk := pathBits(index, level, depth)
+	if chunk >= chunks {
+		return &schemaPath{path: prefix}
+	}
+	if s.elem.kind == schemaBasic {
+		if level > depth {
+			return &schemaPath{path: prefix}
+		}
+		res := s.chunksPath(prefix, chunk, chunk+1, chunks)
+		res.ok = true
+		return res
+	}
+	return s.elem.fieldPath(fmt.Sprintf("%s[%d]", prefix, chunk), index, level-depth)
+}
+
+// chunksPath returns the path of the range of elements in the given chunks
+func (s *Schema) chunksPath(prefix string, from, to uint64, chunks uint64) *schemaPath {
+	if from >= chunks {
+		return &schemaPath{path: prefix}
+	}
+	res := &schemaPath{}
+	if s.elem.kind == schemaBasic {
+		perChunk := 256 / s.elem.bits
+		from, to = from*perChunk, to*perChunk
+		res.elems = &schemaElems{prefix: prefix, bits: s.elem.bits}
+	}
+	if to > s.length {
+		to = s.length
+	}
+	if to-from == 1 {
+		res.path = fmt.Sprintf("%s[%d]", prefix, from)
+	} else {
+		res.path = fmt.Sprintf("%s[%d:%d]", prefix, from, to)
+	}
+	if res.elems != nil {
+		res.elems.from, res.elems.to = from, to
+	}
+	return res
+}
+
+// pathBits returns the next 'num' bits of the path of the index
+// after consuming the bits of the path up to 'level'
+func pathBits(index GIndex, level int, num int) uint64 {
+	return uint64(index>>(level-num)) & (1<<num - 1)
+}
@@ -15,8 +15,8 @@ func TestBeaconHeader_SingleProof(t *testing.T) {
 	data, err := os.ReadFile(TestFileName)
 	require.NoError(t, err)
 
-	sszState := BeaconStateBellatrix{}
-	err = sszState.UnmarshalSSZ(data)
+	sszState := BeaconState{}
+	err = sszState.UnmarshalSSZFork(data, ssz.ForkBellatrix)
 	require.NoError(t, err)
 
 	object := sszState.LatestBlockHeader
@@ -24,7 +24,7 @@ func TestBeaconHeader_SingleProof(t *testing.T) {
 	objectTree, err := object.GetTree()
 	require.NoError(t, err)
 
-	proofAtIndex := 8
+	proofAtIndex := ssz.GIndex(8)
 
 	proof, err := objectTree.Prove(proofAtIndex)
 	require.NoError(t, err)
@@ -41,8 +41,8 @@ func TestBeaconHeader_MultiProof(t *testing.T) {
 	data, err := os.ReadFile(TestFileName)
 	require.NoError(t, err)
 
-	sszState := BeaconStateBellatrix{}
-	err = sszState.UnmarshalSSZ(data)
+	sszState := BeaconState{}
+	err = sszState.UnmarshalSSZFork(data, ssz.ForkBellatrix)
 	require.NoError(t, err)
 
 	object := sszState.LatestBlockHeader
@@ -50,7 +50,7 @@ func TestBeaconHeader_MultiProof(t *testing.T) {
 	objectTree, err := object.GetTree()
 	require.NoError(t, err)
 
-	proofAtIndices := []int{8, 9, 13}
+	proofAtIndices := []ssz.GIndex{8, 9, 13}
 
 	multiProof, err := objectTree.ProveMulti(proofAtIndices)
 	require.NoError(t, err)
@@ -71,27 +71,27 @@ func TestBeaconState_BlockRootAtIndexProof(t *testing.T) {
 	data, err := os.ReadFile(TestFileName)
 	require.NoError(t, err)
 
-	sszState := BeaconStateBellatrix{}
-	err = sszState.UnmarshalSSZ(data)
+	sszState := BeaconState{}
+	err = sszState.UnmarshalSSZFork(data, ssz.ForkBellatrix)
 	require.NoError(t, err)
 
 	// index of first block_root field in the beacon state
 	leavesStart := 303104
 	// let's prove block roof at position 4 in the block roots array
-	index := leavesStart + 3
+	index := ssz.GIndex(leavesStart + 3)
 
 	expectedLeaf := sszState.BlockRoots[3]
 
-	tree, err := sszState.GetTree()
+	tree, err := sszState.GetTreeFork(ssz.ForkBellatrix)
 	require.NoError(t, err)
 
 	proof, err := tree.Prove(index)
 	require.NoError(t, err)
 
 	// check that the block root hash at the index matches what is in the beacon state
-	require.Equal(t, expectedLeaf, proof.Leaf)
+	require.Equal(t, expectedLeaf[:], proof.Leaf)
 
-	root, err := sszState.HashTreeRoot()
+	root, err := sszState.HashTreeRootFork(ssz.ForkBellatrix)
 	require.NoError(t, err)
 
 	ok, err := ssz.VerifyProof(root[:], proof)
@@ -103,16 +103,16 @@ func TestBeaconState_BlockRootsProof(t *testing.T) {
 	data, err := os.ReadFile(TestFileName)
 	require.NoError(t, err)
 
-	sszState := BeaconStateBellatrix{}
-	err = sszState.UnmarshalSSZ(data)
+	sszState := BeaconState{}
+	err = sszState.UnmarshalSSZFork(data, ssz.ForkBellatrix)
 	require.NoError(t, err)
 
-	index := 37
+	index := ssz.GIndex(37)
 
-	tree, err := sszState.GetTree()
+	tree, err := sszState.GetTreeFork(ssz.ForkBellatrix)
 	require.NoError(t, err)
 
-	root, err := sszState.HashTreeRoot()
+	root, err := sszState.HashTreeRootFork(ssz.ForkBellatrix)
 	require.NoError(t, err)
 
 	// This is required to set the node values as the tree is hashed. Ideally should be done as part of GetTree() or Prove()
@@ -130,11 +130,11 @@ func TestBeaconStateTree_HashTreeRoot(t *testing.T) {
 	data, err := os.ReadFile(TestFileName)
 	require.NoError(t, err)
 
-	sszState := BeaconStateBellatrix{}
-	err = sszState.UnmarshalSSZ(data)
+	sszState := BeaconState{}
+	err = sszState.UnmarshalSSZFork(data, ssz.ForkBellatrix)
 	require.NoError(t, err)
 
-	tree, err := sszState.GetTree()
+	tree, err := sszState.GetTreeFork(ssz.ForkBellatrix)
 	require.NoError(t, err)
 
 	hash := tree.Hash()
@@ -0,0 +1,103 @@
+package spectests
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/golang/snappy"
+)
+
+var genericContainers = map[string]func() codec{
+	"SingleFieldTestStruct": func() codec { return new(SingleFieldTestStruct) },
+	"SmallTestStruct":       func() codec { return new(SmallTestStruct) },
+	"FixedTestStruct":       func() codec { return new(FixedTestStruct) },
+	"VarTestStruct":         func() codec { return new(VarTestStruct) },
+	"ComplexTestStruct":     func() codec { return new(ComplexTestStruct) },
+	"BitsStruct":            func() codec { return new(BitsStruct) },
+}
+
+// genericBitlists are the containers with a single bitlist of the
+// limit of the invalid cases (i.e. bitlist_3_but_4)
+var genericBitlists = map[string]func() codec{
+	"1":   func() codec { return new(Bitlist1TestStruct) },
+	"2":   func() codec { return new(Bitlist2TestStruct) },
+	"3":   func() codec { return new(Bitlist3TestStruct) },
+	"4":   func() codec { return new(Bitlist4TestStruct) },
+	"5":   func() codec { return new(Bitlist5TestStruct) },
+	"8":   func() codec { return new(Bitlist8TestStruct) },
+	"32":  func() codec { return new(Bitlist32TestStruct) },
+	"512": func() codec { return new(Bitlist512TestStruct) },
+}
+
+func readInvalidGenericSSZ(t *testing.T, path string) []byte {
+	serializedSnappy, err := ioutil.ReadFile(filepath.Join(path, serializedFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	serialized, err := snappy.Decode(nil, serializedSnappy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return serialized
+}
+
+func genericInvalidCases(t *testing.T, handler string) []string {
+	return readDir(t, filepath.Join(testsPath, "general/phase0/ssz_generic", handler, "invalid"))
+}
+
+func testGenericInvalid(t *testing.T, obj func() codec, serialized []byte) {
+	if err := obj().UnmarshalSSZ(serialized); err == nil {
+		t.Fatal("the invalid encoding is decoded")
+	}
+	if _, err := obj().HashTreeRootSSZ(serialized); err == nil {
+		t.Fatal("the invalid encoding is hashed")
+	}
+}
+
+func TestSpec_GenericInvalid_Containers(t *testing.T) {
+	for _, f := range genericInvalidCases(t, "containers") {
+		name := filepath.Base(f)
+		t.Run(name, func(t *testing.T) {
+			obj, ok := genericContainers[strings.Split(name, "_")[0]]
+			if !ok {
+				t.Fatalf("container for %s not found", name)
+			}
+			testGenericInvalid(t, obj, readInvalidGenericSSZ(t, f))
+		})
+	}
+}
+
+func TestSpec_GenericInvalid_Boolean(t *testing.T) {
+	for _, f := range genericInvalidCases(t, "boolean") {
+		t.Run(filepath.Base(f), func(t *testing.T) {
+			// the container with one bool has the same encoding as the bool
+			obj := func() codec { return new(BoolTestStruct) }
+			testGenericInvalid(t, obj, readInvalidGenericSSZ(t, f))
+		})
+	}
+}
+
+func TestSpec_GenericInvalid_Bitlist(t *testing.T) {
+	for _, f := range genericInvalidCases(t, "bitlist") {
+		name := filepath.Base(f)
+		t.Run(name, func(t *testing.T) {
+			// the limit does not matter for the bitlists without the delimiter bit
+			limit := "512"
+			if !strings.Contains(name, "_no_delimiter_") {
+				limit = strings.Split(name, "_")[1]
+			}
+			obj, ok := genericBitlists[limit]
+			if !ok {
+				t.Fatalf("container for %s not found", name)
+			}
+
+			// the bitlist is the dynamic field after the offset of the container
+			serialized := ssz.MarshalUint32(nil, 4)
+			serialized = append(serialized, readInvalidGenericSSZ(t, f)...)
+			testGenericInvalid(t, obj, serialized)
+		})
+	}
+}
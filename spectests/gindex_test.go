@@ -0,0 +1,291 @@
+package spectests
+
+import (
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGIndex_LightClient(t *testing.T) {
+	// generalized indices from the altair light client specs
+	index, err := ssz.ConcatGIndices(BeaconStateFinalizedCheckpointGIndex, CheckpointRootGIndex)
+	require.NoError(t, err)
+	require.Equal(t, ssz.GIndex(105), index)
+	require.Equal(t, ssz.GIndex(54), BeaconStateCurrentSyncCommitteeGIndex)
+	require.Equal(t, ssz.GIndex(55), BeaconStateNextSyncCommitteeGIndex)
+}
+
+func TestGIndex_ProveField(t *testing.T) {
+	obj := &AttestationData{
+		Slot:   1,
+		Index:  2,
+		Source: &Checkpoint{Epoch: 3, Root: make([]byte, 32)},
+		Target: &Checkpoint{Epoch: 4, Root: make([]byte, 32)},
+	}
+	root, err := obj.HashTreeRoot()
+	require.NoError(t, err)
+
+	proof, err := obj.ProveTarget()
+	require.NoError(t, err)
+	require.Equal(t, AttestationDataTargetGIndex, proof.Index)
+
+	targetRoot, err := obj.Target.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, targetRoot[:], proof.Leaf)
+
+	ok, err := VerifyAttestationDataTarget(root[:], proof)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// the proof is not valid for another field
+	_, err = VerifyAttestationDataSource(root[:], proof)
+	require.Error(t, err)
+
+	// prove the field of a nested container
+	index, err := ssz.ConcatGIndices(AttestationDataTargetGIndex, CheckpointEpochGIndex)
+	require.NoError(t, err)
+	proof, err = ssz.ProveGIndex(obj, index)
+	require.NoError(t, err)
+	require.Equal(t, ssz.LeafFromUint64(4).Hash(), proof.Leaf)
+
+	ok, err = ssz.VerifyProof(root[:], proof)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestGIndex_ProveFieldFork(t *testing.T) {
+	obj := &ExecutionPayload{
+		ParentHash:  [32]byte{0x1},
+		BlockNumber: 10,
+	}
+
+	// the payloads of bellatrix and capella have a tree of depth 4
+	for _, fork := range []ssz.Fork{ssz.ForkBellatrix, ssz.ForkCapella} {
+		index, err := ExecutionPayloadParentHashGIndexFork(fork)
+		require.NoError(t, err)
+		require.Equal(t, ssz.GIndex(16), index)
+
+		root, err := obj.HashTreeRootFork(fork)
+		require.NoError(t, err)
+
+		proof, err := obj.ProveParentHashFork(fork)
+		require.NoError(t, err)
+		require.Equal(t, index, proof.Index)
+		require.Equal(t, obj.ParentHash[:], proof.Leaf)
+
+		ok, err := VerifyExecutionPayloadParentHashFork(root[:], proof, fork)
+		require.NoError(t, err)
+		require.True(t, ok)
+
+		// the proof is not valid for the deneb payload
+		_, err = VerifyExecutionPayloadParentHashFork(root[:], proof, ssz.ForkDeneb)
+		require.Error(t, err)
+	}
+
+	index, err := ExecutionPayloadParentHashGIndexFork(ssz.ForkDeneb)
+	require.NoError(t, err)
+	require.Equal(t, ExecutionPayloadParentHashGIndex, index)
+
+	// the withdrawals are not part of the bellatrix payload
+	_, err = ExecutionPayloadWithdrawalsGIndexFork(ssz.ForkBellatrix)
+	require.Equal(t, ssz.ErrForkField, err)
+	_, err = obj.ProveWithdrawalsFork(ssz.ForkBellatrix)
+	require.Equal(t, ssz.ErrForkField, err)
+
+	// the fields removed before deneb have an index in the earlier forks
+	index, err = BeaconStatePreviousEpochAttestationsGIndexFork(ssz.ForkPhase0)
+	require.NoError(t, err)
+	require.Equal(t, ssz.GIndex(47), index)
+
+	_, err = BeaconStatePreviousEpochAttestationsGIndexFork(ssz.ForkAltair)
+	require.Equal(t, ssz.ErrForkField, err)
+}
+
+func TestGIndex_SetField(t *testing.T) {
+	obj := &AttestationData{
+		Slot:   1,
+		Index:  2,
+		Source: &Checkpoint{Epoch: 3, Root: make([]byte, 32)},
+		Target: &Checkpoint{Epoch: 4, Root: make([]byte, 32)},
+	}
+	tree, err := obj.GetTree()
+	require.NoError(t, err)
+
+	// update the tree without building it again
+	target := &Checkpoint{Epoch: 5, Root: make([]byte, 32)}
+	targetTree, err := target.GetTree()
+	require.NoError(t, err)
+
+	tree, err = tree.Set(AttestationDataTargetGIndex, targetTree)
+	require.NoError(t, err)
+	tree, err = tree.SetLeaf(AttestationDataSlotGIndex, ssz.LeafFromUint64(10).Hash())
+	require.NoError(t, err)
+
+	obj.Target = target
+	obj.Slot = 10
+	root, err := obj.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, root[:], tree.Hash())
+}
+
+func TestGIndex_NodeStore(t *testing.T) {
+	store := ssz.NewNodeStore(nil)
+
+	obj := &AttestationData{
+		Slot:   1,
+		Index:  2,
+		Source: &Checkpoint{Epoch: 3, Root: make([]byte, 32)},
+		Target: &Checkpoint{Epoch: 4, Root: make([]byte, 32)},
+	}
+	tree, err := ssz.ProofTreeWithStore(obj, store)
+	require.NoError(t, err)
+
+	obj.Slot = 2
+	tree2, err := ssz.ProofTreeWithStore(obj, store)
+	require.NoError(t, err)
+
+	root, err := obj.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, root[:], tree2.Hash())
+
+	// both trees share the subtree of the unmodified fields
+	source, err := tree.Get(AttestationDataSourceGIndex)
+	require.NoError(t, err)
+	source2, err := tree2.Get(AttestationDataSourceGIndex)
+	require.NoError(t, err)
+	require.Same(t, source, source2)
+}
+
+func TestGIndex_PartialTree(t *testing.T) {
+	obj := &AttestationData{
+		Slot:   1,
+		Index:  2,
+		Source: &Checkpoint{Epoch: 3, Root: make([]byte, 32)},
+		Target: &Checkpoint{Epoch: 4, Root: make([]byte, 32)},
+	}
+	root, err := obj.HashTreeRoot()
+	require.NoError(t, err)
+
+	tree, err := obj.GetTree()
+	require.NoError(t, err)
+
+	targetEpoch, err := ssz.ConcatGIndices(AttestationDataTargetGIndex, CheckpointEpochGIndex)
+	require.NoError(t, err)
+
+	proof, err := tree.ProveMulti([]ssz.GIndex{AttestationDataSlotGIndex, targetEpoch})
+	require.NoError(t, err)
+
+	partial, err := ssz.NodeFromMultiproof(root[:], proof)
+	require.NoError(t, err)
+	require.Equal(t, root[:], partial.Hash())
+
+	// read a proven field
+	slot, err := partial.Get(AttestationDataSlotGIndex)
+	require.NoError(t, err)
+	require.Equal(t, ssz.LeafFromUint64(1).Hash(), slot.Hash())
+
+	// the unproven fields are only available if they are part of the proof
+	_, err = partial.Get(AttestationDataIndexGIndex)
+	require.NoError(t, err)
+	_, err = partial.Get(AttestationDataSourceGIndex)
+	require.ErrorIs(t, err, ssz.ErrOpaqueNode)
+
+	sourceEpoch, err := ssz.ConcatGIndices(AttestationDataSourceGIndex, CheckpointEpochGIndex)
+	require.NoError(t, err)
+	_, err = partial.Get(sourceEpoch)
+	require.ErrorIs(t, err, ssz.ErrOpaqueNode)
+	_, err = partial.SetLeaf(sourceEpoch, ssz.LeafFromUint64(10).Hash())
+	require.ErrorIs(t, err, ssz.ErrOpaqueNode)
+
+	// update the proven fields and compute the new root
+	partial, err = partial.SetLeaf(AttestationDataSlotGIndex, ssz.LeafFromUint64(10).Hash())
+	require.NoError(t, err)
+	partial, err = partial.SetLeaf(targetEpoch, ssz.LeafFromUint64(5).Hash())
+	require.NoError(t, err)
+
+	obj.Slot = 10
+	obj.Target.Epoch = 5
+	root, err = obj.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, root[:], partial.Hash())
+
+	// the proof does not match another root
+	_, err = ssz.NodeFromMultiproof(root[:], proof)
+	require.Error(t, err)
+}
+
+func TestGIndex_Schema(t *testing.T) {
+	schema := (&BeaconState{}).SchemaSSZ()
+
+	concat := func(indices ...ssz.GIndex) ssz.GIndex {
+		index, err := ssz.ConcatGIndices(indices...)
+		require.NoError(t, err)
+		return index
+	}
+	leaf := func(depth int, index uint64) ssz.GIndex {
+		g, err := ssz.NewGIndex(depth, index)
+		require.NoError(t, err)
+		return g
+	}
+
+	cases := []struct {
+		index ssz.GIndex
+		path  string
+		ok    bool
+	}{
+		{BeaconStateCurrentSyncCommitteeGIndex, "CurrentSyncCommittee", true},
+		{concat(BeaconStateFinalizedCheckpointGIndex, CheckpointRootGIndex), "FinalizedCheckpoint.Root", true},
+		// 4 balances in each chunk of a list of 2^40 elements
+		{concat(BeaconStateBalancesGIndex, 2, leaf(38, 308)), "Balances[1232:1236]", true},
+		{concat(BeaconStateBalancesGIndex, 3), "len(Balances)", true},
+		{concat(BeaconStateValidatorsGIndex, 2, leaf(40, 3), ValidatorSlashedGIndex), "Validators[3].Slashed", true},
+		// the 48 bytes public key has two chunks
+		{concat(BeaconStateValidatorsGIndex, 2, leaf(40, 3), ValidatorPubkeyGIndex, 3), "Validators[3].Pubkey[32:48]", true},
+		{concat(BeaconStateBlockRootsGIndex, leaf(13, 5)), "BlockRoots[5]", true},
+		// intermediate nodes
+		{2, "", false},
+		{concat(BeaconStateBalancesGIndex, 2, leaf(20, 1)), "Balances[1048576:2097152]", false},
+	}
+	for _, c := range cases {
+		path, ok := schema.FieldPath(c.index)
+		require.Equal(t, c.path, path)
+		require.Equal(t, c.ok, ok, c.path)
+	}
+}
+
+func TestGIndex_DiffTrees(t *testing.T) {
+	newObj := func() *IndexedAttestation {
+		return &IndexedAttestation{
+			AttestationIndices: []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			Data: &AttestationData{
+				Slot:   1,
+				Index:  2,
+				Source: &Checkpoint{Epoch: 3, Root: make([]byte, 32)},
+				Target: &Checkpoint{Epoch: 4, Root: make([]byte, 32)},
+			},
+			Signature: make([]byte, 96),
+		}
+	}
+	schema := (&IndexedAttestation{}).SchemaSSZ()
+
+	a, b := newObj(), newObj()
+	b.AttestationIndices[5] = 100
+	b.AttestationIndices = append(b.AttestationIndices, 11)
+	b.Data.Target.Epoch = 5
+
+	aTree, err := a.GetTree()
+	require.NoError(t, err)
+	bTree, err := b.GetTree()
+	require.NoError(t, err)
+
+	targetEpoch, err := ssz.ConcatGIndices(IndexedAttestationDataGIndex, AttestationDataTargetGIndex, CheckpointEpochGIndex)
+	require.NoError(t, err)
+
+	diff := ssz.DiffTrees(aTree, bTree)
+	require.Len(t, diff, 4)
+	require.Equal(t, targetEpoch, diff[3])
+
+	paths := ssz.DiffTreesWithSchema(aTree, bTree, schema)
+	require.Equal(t, []string{"AttestationIndices[5]", "AttestationIndices[10]", "len(AttestationIndices)", "Data.Target.Epoch"}, paths)
+}
@@ -0,0 +1,79 @@
+package spectests
+
+import (
+	"bytes"
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/ferranbt/fastssz/fuzz"
+)
+
+// writesRecorder is a writer that records the size of the largest write
+type writesRecorder struct {
+	bytes.Buffer
+	maxWrite int
+}
+
+func (w *writesRecorder) Write(p []byte) (int, error) {
+	if len(p) > w.maxWrite {
+		w.maxWrite = len(p)
+	}
+	return w.Buffer.Write(p)
+}
+
+func checkMarshalSSZToWriter(t *testing.T, name string, obj codec) *writesRecorder {
+	expected, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := &writesRecorder{}
+	n, err := obj.MarshalSSZToWriter(w)
+	if err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+	if n != len(expected) {
+		t.Fatalf("%s: expected %d bytes written but found %d", name, len(expected), n)
+	}
+	if !bytes.Equal(w.Bytes(), expected) {
+		t.Fatalf("%s: the streamed encoding is not equal", name)
+	}
+	return w
+}
+
+func TestMarshalSSZToWriter(t *testing.T) {
+	for _, name := range canonicalCodecs {
+		for i := 0; i < 10; i++ {
+			obj := codecs[name]()
+			f := fuzz.New()
+			f.SetFork(ssz.ForkPhase0)
+			f.Fuzz(obj)
+
+			checkMarshalSSZToWriter(t, name, atFork(obj, ssz.ForkPhase0))
+		}
+	}
+
+	payload := &ExecutionPayload{
+		ExtraData:    []byte{1, 2, 3},
+		Transactions: [][]byte{{1}, make([]byte, 10000), {}},
+		Withdrawals:  []*Withdrawal{{Index: 1}, {Amount: 3}},
+	}
+	checkMarshalSSZToWriter(t, "ExecutionPayload", atFork(payload, ssz.ForkCapella))
+}
+
+func TestMarshalSSZToWriter_BeaconState(t *testing.T) {
+	state := &BeaconState{}
+	f := fuzz.New()
+	f.SetFork(ssz.ForkBellatrix)
+	f.Fuzz(state)
+
+	// the fuzzer caps the lists at 1000 elements
+	state.Slashings = make([]uint64, 8192)
+
+	w := checkMarshalSSZToWriter(t, "BeaconState", atFork(state, ssz.ForkBellatrix))
+
+	// the state is written in small chunks
+	if w.maxWrite > w.Len()/100 {
+		t.Fatalf("the largest write is %d bytes for a state of %d bytes", w.maxWrite, w.Len())
+	}
+}
@@ -0,0 +1,76 @@
+package spectests
+
+import (
+	"bytes"
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+func TestStrictDecoding_Bool(t *testing.T) {
+	buf, err := (&Validator{Pubkey: make([]byte, 48), WithdrawalCredentials: make([]byte, 32)}).MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the 'slashed' field is right after the effective balance
+	buf[88] = 2
+
+	if err := new(Validator).UnmarshalSSZ(buf); err != ssz.ErrInvalidBool {
+		t.Fatalf("expected invalid bool error but found %v", err)
+	}
+
+	obj := new(Validator)
+	if err := obj.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{Lenient: true}); err != nil {
+		t.Fatal(err)
+	}
+	if obj.Slashed {
+		t.Fatal("a bool other than 1 is false")
+	}
+}
+
+func TestStrictDecoding_FirstOffset(t *testing.T) {
+	obj := &Attestation{AggregationBits: []byte{0x03}, Data: &AttestationData{Source: &Checkpoint{Root: make([]byte, 32)}, Target: &Checkpoint{Root: make([]byte, 32)}}}
+	buf, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// add a gap between the fixed part and the aggregation bits
+	fixedSize := ssz.ReadOffset(buf)
+	gap := append([]byte{}, buf[:fixedSize]...)
+	gap = append(gap, 0)
+	gap = append(gap, buf[fixedSize:]...)
+	ssz.MarshalUint32(gap[:0], uint32(fixedSize+1))
+
+	if err := new(Attestation).UnmarshalSSZ(gap); err != ssz.ErrInvalidVariableOffset {
+		t.Fatalf("expected invalid offset error but found %v", err)
+	}
+
+	obj2 := new(Attestation)
+	if err := obj2.UnmarshalSSZWithOptions(gap, ssz.UnmarshalOptions{Lenient: true}); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(obj2.AggregationBits, obj.AggregationBits) {
+		t.Fatal("bad")
+	}
+
+	// the options are passed to the nested objects
+	agg, err := (&AggregateAndProof{Aggregate: obj}).MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	aggGap := append(agg[:ssz.ReadOffset(agg[8:12])], gap...)
+
+	obj3 := new(AggregateAndProof)
+	if err := obj3.UnmarshalSSZWithOptions(aggGap, ssz.UnmarshalOptions{Lenient: true}); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(obj3.Aggregate.AggregationBits, obj.AggregationBits) {
+		t.Fatal("bad")
+	}
+
+	// the other calls are still strict
+	if err := new(AggregateAndProof).UnmarshalSSZ(aggGap); err != ssz.ErrInvalidVariableOffset {
+		t.Fatalf("expected invalid offset error but found %v", err)
+	}
+}
@@ -1,5 +1,7 @@
 package spectests
 
+import ssz "github.com/ferranbt/fastssz"
+
 type AggregateAndProof struct {
 	Index          uint64       `json:"aggregator_index"`
 	Aggregate      *Attestation `json:"aggregate"`
@@ -121,11 +123,11 @@ type AttesterSlashing struct {
 }
 
 type BeaconBlock struct {
-	Slot          uint64                 `json:"slot"`
-	ProposerIndex uint64                 `json:"proposer_index"`
-	ParentRoot    []byte                 `json:"parent_root" ssz-size:"32"`
-	StateRoot     []byte                 `json:"state_root" ssz-size:"32"`
-	Body          *BeaconBlockBodyPhase0 `json:"body"`
+	Slot          uint64           `json:"slot"`
+	ProposerIndex uint64           `json:"proposer_index"`
+	ParentRoot    [32]byte         `json:"parent_root" ssz-size:"32"`
+	StateRoot     [32]byte         `json:"state_root" ssz-size:"32"`
+	Body          *BeaconBlockBody `json:"body"`
 }
 
 type SignedBeaconBlock struct {
@@ -144,110 +146,51 @@ type Transfer struct {
 }
 
 type BeaconState struct {
-	GenesisTime                 uint64                `json:"genesis_time"`
-	GenesisValidatorsRoot       []byte                `json:"genesis_validators_root" ssz-size:"32"`
-	Slot                        uint64                `json:"slot"`
-	Fork                        *Fork                 `json:"fork"`
-	LatestBlockHeader           *BeaconBlockHeader    `json:"latest_block_header"`
-	BlockRoots                  [][]byte              `json:"block_roots" ssz-size:"8192,32"`
-	StateRoots                  [][]byte              `json:"state_roots" ssz-size:"8192,32"`
-	HistoricalRoots             [][]byte              `json:"historical_roots" ssz-max:"16777216" ssz-size:"?,32"`
-	Eth1Data                    *Eth1Data             `json:"eth1_data"`
-	Eth1DataVotes               []*Eth1Data           `json:"eth1_data_votes" ssz-max:"2048"`
-	Eth1DepositIndex            uint64                `json:"eth1_deposit_index"`
-	Validators                  []*Validator          `json:"validators" ssz-max:"1099511627776"`
-	Balances                    []uint64              `json:"balances" ssz-max:"1099511627776"`
-	RandaoMixes                 [][]byte              `json:"randao_mixes" ssz-size:"65536,32"`
-	Slashings                   []uint64              `json:"slashings" ssz-size:"8192"`
-	PreviousEpochAttestations   []*PendingAttestation `json:"previous_epoch_attestations" ssz-max:"4096"`
-	CurrentEpochAttestations    []*PendingAttestation `json:"current_epoch_attestations" ssz-max:"4096"`
-	JustificationBits           []byte                `json:"justification_bits" ssz-size:"1"`
-	PreviousJustifiedCheckpoint *Checkpoint           `json:"previous_justified_checkpoint"`
-	CurrentJustifiedCheckpoint  *Checkpoint           `json:"current_justified_checkpoint"`
-	FinalizedCheckpoint         *Checkpoint           `json:"finalized_checkpoint"`
-}
-
-type BeaconBlockBodyPhase0 struct {
-	RandaoReveal      []byte                 `json:"randao_reveal" ssz-size:"96"`
-	Eth1Data          *Eth1Data              `json:"eth1_data"`
-	Graffiti          [32]byte               `json:"graffiti" ssz-size:"32"`
-	ProposerSlashings []*ProposerSlashing    `json:"proposer_slashings" ssz-max:"16"`
-	AttesterSlashings []*AttesterSlashing    `json:"attester_slashings" ssz-max:"2"`
-	Attestations      []*Attestation         `json:"attestations" ssz-max:"128"`
-	Deposits          []*Deposit             `json:"deposits" ssz-max:"16"`
-	VoluntaryExits    []*SignedVoluntaryExit `json:"voluntary_exits" ssz-max:"16"`
-}
-
-type BeaconBlockBodyAltair struct {
-	RandaoReveal      []byte                 `json:"randao_reveal" ssz-size:"96"`
-	Eth1Data          *Eth1Data              `json:"eth1_data"`
-	Graffiti          [32]byte               `json:"graffiti" ssz-size:"32"`
-	ProposerSlashings []*ProposerSlashing    `json:"proposer_slashings" ssz-max:"16"`
-	AttesterSlashings []*AttesterSlashing    `json:"attester_slashings" ssz-max:"2"`
-	Attestations      []*Attestation         `json:"attestations" ssz-max:"128"`
-	Deposits          []*Deposit             `json:"deposits" ssz-max:"16"`
-	VoluntaryExits    []*SignedVoluntaryExit `json:"voluntary_exits" ssz-max:"16"`
-	SyncAggregate     *SyncAggregate         `json:"sync_aggregate"`
-}
-
-type BeaconBlockBodyBellatrix struct {
-	BeaconBlockBodyAltair `json:",squash"`
-	ExecutionPayload      *ExecutionPayload `json:"execution_payload"`
-}
-
-type BeaconStateAltair struct {
-	GenesisTime                 uint64             `json:"genesis_time"`
-	GenesisValidatorsRoot       []byte             `json:"genesis_validators_root" ssz-size:"32"`
-	Slot                        uint64             `json:"slot"`
-	Fork                        *Fork              `json:"fork"`
-	LatestBlockHeader           *BeaconBlockHeader `json:"latest_block_header"`
-	BlockRoots                  [][]byte           `json:"block_roots" ssz-size:"8192,32"`
-	StateRoots                  [][]byte           `json:"state_roots" ssz-size:"8192,32"`
-	HistoricalRoots             [][]byte           `json:"historical_roots" ssz-max:"16777216" ssz-size:"?,32"`
-	Eth1Data                    *Eth1Data          `json:"eth1_data"`
-	Eth1DataVotes               []*Eth1Data        `json:"eth1_data_votes" ssz-max:"2048"`
-	Eth1DepositIndex            uint64             `json:"eth1_deposit_index"`
-	Validators                  []*Validator       `json:"validators" ssz-max:"1099511627776"`
-	Balances                    []uint64           `json:"balances" ssz-max:"1099511627776"`
-	RandaoMixes                 [][]byte           `json:"randao_mixes" ssz-size:"65536,32"`
-	Slashings                   []uint64           `json:"slashings" ssz-size:"8192"`
-	PreviousEpochParticipation  []byte             `json:"previous_epoch_participation" ssz-max:"1099511627776"`
-	CurrentEpochParticipation   []byte             `json:"current_epoch_participation" ssz-max:"1099511627776"`
-	JustificationBits           []byte             `json:"justification_bits" cast-type:"github.com/prysmaticlabs/go-bitfield.Bitvector4" ssz-size:"1"`
-	PreviousJustifiedCheckpoint *Checkpoint        `json:"previous_justified_checkpoint"`
-	CurrentJustifiedCheckpoint  *Checkpoint        `json:"current_justified_checkpoint"`
-	FinalizedCheckpoint         *Checkpoint        `json:"finalized_checkpoint"`
-	InactivityScores            []uint64           `json:"inactivity_scores" ssz-max:"1099511627776"`
-	CurrentSyncCommittee        *SyncCommittee     `json:"current_sync_committee"`
-	NextSyncCommittee           *SyncCommittee     `json:"next_sync_committee"`
-}
-
-type BeaconStateBellatrix struct {
 	GenesisTime                  uint64                  `json:"genesis_time"`
-	GenesisValidatorsRoot        []byte                  `json:"genesis_validators_root" ssz-size:"32"`
+	GenesisValidatorsRoot        [32]byte                `json:"genesis_validators_root" ssz-size:"32"`
 	Slot                         uint64                  `json:"slot"`
 	Fork                         *Fork                   `json:"fork"`
 	LatestBlockHeader            *BeaconBlockHeader      `json:"latest_block_header"`
-	BlockRoots                   [][]byte                `json:"block_roots" ssz-size:"8192,32"`
-	StateRoots                   [][]byte                `json:"state_roots" ssz-size:"8192,32"`
+	BlockRoots                   [8192][32]byte          `json:"block_roots" ssz-size:"8192,32"`
+	StateRoots                   [8192][32]byte          `json:"state_roots" ssz-size:"8192,32"`
 	HistoricalRoots              [][]byte                `json:"historical_roots" ssz-max:"16777216" ssz-size:"?,32"`
 	Eth1Data                     *Eth1Data               `json:"eth1_data"`
 	Eth1DataVotes                []*Eth1Data             `json:"eth1_data_votes" ssz-max:"2048"`
 	Eth1DepositIndex             uint64                  `json:"eth1_deposit_index"`
 	Validators                   []*Validator            `json:"validators" ssz-max:"1099511627776"`
 	Balances                     []uint64                `json:"balances" ssz-max:"1099511627776"`
-	RandaoMixes                  [][]byte                `json:"randao_mixes" ssz-size:"65536,32"`
+	RandaoMixes                  [65536][32]byte         `json:"randao_mixes" ssz-size:"65536,32"`
 	Slashings                    []uint64                `json:"slashings" ssz-size:"8192"`
-	PreviousEpochParticipation   []byte                  `json:"previous_epoch_participation" ssz-max:"1099511627776"`
-	CurrentEpochParticipation    []byte                  `json:"current_epoch_participation" ssz-max:"1099511627776"`
+	PreviousEpochAttestations    []*PendingAttestation   `json:"previous_epoch_attestations" ssz-max:"4096" ssz-until:"altair"`
+	CurrentEpochAttestations     []*PendingAttestation   `json:"current_epoch_attestations" ssz-max:"4096" ssz-until:"altair"`
+	PreviousEpochParticipation   []byte                  `json:"previous_epoch_participation" ssz-max:"1099511627776" ssz-fork:"altair"`
+	CurrentEpochParticipation    []byte                  `json:"current_epoch_participation" ssz-max:"1099511627776" ssz-fork:"altair"`
 	JustificationBits            []byte                  `json:"justification_bits" cast-type:"github.com/prysmaticlabs/go-bitfield.Bitvector4" ssz-size:"1"`
 	PreviousJustifiedCheckpoint  *Checkpoint             `json:"previous_justified_checkpoint"`
 	CurrentJustifiedCheckpoint   *Checkpoint             `json:"current_justified_checkpoint"`
 	FinalizedCheckpoint          *Checkpoint             `json:"finalized_checkpoint"`
-	InactivityScores             []uint64                `json:"inactivity_scores" ssz-max:"1099511627776"`
-	CurrentSyncCommittee         *SyncCommittee          `json:"current_sync_committee"`
-	NextSyncCommittee            *SyncCommittee          `json:"next_sync_committee"`
-	LatestExecutionPayloadHeader *ExecutionPayloadHeader `json:"latest_execution_payload_header"`
+	InactivityScores             []uint64                `json:"inactivity_scores" ssz-max:"1099511627776" ssz-fork:"altair"`
+	CurrentSyncCommittee         *SyncCommittee          `json:"current_sync_committee" ssz-fork:"altair"`
+	NextSyncCommittee            *SyncCommittee          `json:"next_sync_committee" ssz-fork:"altair"`
+	LatestExecutionPayloadHeader *ExecutionPayloadHeader `json:"latest_execution_payload_header" ssz-fork:"bellatrix"`
+	NextWithdrawalIndex          uint64                  `json:"next_withdrawal_index" ssz-fork:"capella"`
+	NextWithdrawalValidatorIndex uint64                  `json:"next_withdrawal_validator_index" ssz-fork:"capella"`
+	HistoricalSummaries          []*HistoricalSummary    `json:"historical_summaries" ssz-max:"16777216" ssz-fork:"capella"`
+}
+
+type BeaconBlockBody struct {
+	RandaoReveal          []byte                        `json:"randao_reveal" ssz-size:"96"`
+	Eth1Data              *Eth1Data                     `json:"eth1_data"`
+	Graffiti              [32]byte                      `json:"graffiti" ssz-size:"32"`
+	ProposerSlashings     []*ProposerSlashing           `json:"proposer_slashings" ssz-max:"16"`
+	AttesterSlashings     []*AttesterSlashing           `json:"attester_slashings" ssz-max:"2"`
+	Attestations          []*Attestation                `json:"attestations" ssz-max:"128"`
+	Deposits              []*Deposit                    `json:"deposits" ssz-max:"16"`
+	VoluntaryExits        []*SignedVoluntaryExit        `json:"voluntary_exits" ssz-max:"16"`
+	SyncAggregate         *SyncAggregate                `json:"sync_aggregate" ssz-fork:"altair"`
+	ExecutionPayload      *ExecutionPayload             `json:"execution_payload" ssz-fork:"bellatrix"`
+	BlsToExecutionChanges []*SignedBLSToExecutionChange `json:"bls_to_execution_changes" ssz-max:"16" ssz-fork:"capella"`
+	BlobKzgCommitments    [][48]byte                    `json:"blob_kzg_commitments" ssz-max:"4096" ssz-size:"?,48" ssz-fork:"deneb"`
 }
 
 type SignedBeaconBlockHeader struct {
@@ -285,45 +228,9 @@ type SyncAggregate struct {
 
 // bellatrix
 
-type ExecutionPayload struct {
-	ParentHash    [32]byte  `ssz-size:"32" json:"parent_hash"`
-	FeeRecipient  [20]byte  `ssz-size:"20" json:"fee_recipient"`
-	StateRoot     [32]byte  `ssz-size:"32" json:"state_root"`
-	ReceiptsRoot  [32]byte  `ssz-size:"32" json:"receipts_root"`
-	LogsBloom     [256]byte `ssz-size:"256" json:"logs_bloom"`
-	PrevRandao    [32]byte  `ssz-size:"32" json:"prev_randao"`
-	BlockNumber   uint64    `json:"block_number"`
-	GasLimit      uint64    `json:"gas_limit"`
-	GasUsed       uint64    `json:"gas_used"`
-	Timestamp     uint64    `json:"timestamp"`
-	ExtraData     []byte    `ssz-max:"32" json:"extra_data"`
-	BaseFeePerGas [32]byte  `ssz-size:"32" json:"base_fee_per_gas"`
-	BlockHash     [32]byte  `ssz-size:"32" json:"block_hash"`
-	Transactions  [][]byte  `ssz-max:"1048576,1073741824" ssz-size:"?,?" json:"transactions"`
-}
-
-type ExecutionPayloadHeader struct {
-	ParentHash       []byte `json:"parent_hash" ssz-size:"32"`
-	FeeRecipient     []byte `json:"fee_recipient" ssz-size:"20"`
-	StateRoot        []byte `json:"state_root" ssz-size:"32"`
-	ReceiptsRoot     []byte `json:"receipts_root" ssz-size:"32"`
-	LogsBloom        []byte `json:"logs_bloom" ssz-size:"256"`
-	PrevRandao       []byte `json:"prev_randao" ssz-size:"32"`
-	BlockNumber      uint64 `json:"block_number"`
-	GasLimit         uint64 `json:"gas_limit"`
-	GasUsed          uint64 `json:"gas_used"`
-	Timestamp        uint64 `json:"timestamp"`
-	ExtraData        []byte `json:"extra_data" ssz-max:"32"`
-	BaseFeePerGas    []byte `json:"base_fee_per_gas" ssz-size:"32"`
-	BlockHash        []byte `json:"block_hash" ssz-size:"32"`
-	TransactionsRoot []byte `json:"transactions_root" ssz-size:"32"`
-}
-
-// Capella types
-
 type Uint256 [32]byte
 
-type ExecutionPayloadCapella struct {
+type ExecutionPayload struct {
 	ParentHash    [32]byte      `ssz-size:"32" json:"parent_hash"`
 	FeeRecipient  [20]byte      `ssz-size:"20" json:"fee_recipient"`
 	StateRoot     [32]byte      `ssz-size:"32" json:"state_root"`
@@ -338,10 +245,12 @@ type ExecutionPayloadCapella struct {
 	BaseFeePerGas Uint256       `ssz-size:"32" json:"base_fee_per_gas"`
 	BlockHash     [32]byte      `ssz-size:"32" json:"block_hash"`
 	Transactions  [][]byte      `ssz-max:"1048576,1073741824" ssz-size:"?,?" json:"transactions"`
-	Withdrawals   []*Withdrawal `json:"withdrawals" ssz-max:"16"`
+	Withdrawals   []*Withdrawal `json:"withdrawals" ssz-max:"16" ssz-fork:"capella"`
+	BlobGasUsed   uint64        `json:"blob_gas_used" ssz-fork:"deneb"`
+	ExcessBlobGas uint64        `json:"excess_blob_gas" ssz-fork:"deneb"`
 }
 
-type ExecutionPayloadHeaderCapella struct {
+type ExecutionPayloadHeader struct {
 	ParentHash       [32]byte  `json:"parent_hash" ssz-size:"32"`
 	FeeRecipient     [20]byte  `json:"fee_recipient" ssz-size:"20"`
 	StateRoot        [32]byte  `json:"state_root" ssz-size:"32"`
@@ -356,9 +265,13 @@ type ExecutionPayloadHeaderCapella struct {
 	BaseFeePerGas    Uint256   `json:"base_fee_per_gas" ssz-size:"32"`
 	BlockHash        [32]byte  `json:"block_hash" ssz-size:"32"`
 	TransactionsRoot [32]byte  `json:"transactions_root" ssz-size:"32"`
-	WithdrawalRoot   [32]byte  `json:"withdrawals_root" ssz-size:"32"`
+	WithdrawalRoot   [32]byte  `json:"withdrawals_root" ssz-size:"32" ssz-fork:"capella"`
+	BlobGasUsed      uint64    `json:"blob_gas_used" ssz-fork:"deneb"`
+	ExcessBlobGas    uint64    `json:"excess_blob_gas" ssz-fork:"deneb"`
 }
 
+// capella
+
 type BLSToExecutionChange struct {
 	ValidatorIndex     uint64   `json:"validator_index"`
 	FromBLSPubKey      [48]byte `json:"from_bls_pubkey" ssz-size:"48"`
@@ -382,102 +295,83 @@ type Withdrawal struct {
 	Amount         uint64   `json:"amount"`
 }
 
-type BeaconStateCapella struct {
-	GenesisTime                  uint64                         `json:"genesis_time"`
-	GenesisValidatorsRoot        [32]byte                       `json:"genesis_validators_root" ssz-size:"32"`
-	Slot                         uint64                         `json:"slot"`
-	Fork                         *Fork                          `json:"fork"`
-	LatestBlockHeader            *BeaconBlockHeader             `json:"latest_block_header"`
-	BlockRoots                   [8192][32]byte                 `json:"block_roots" ssz-size:"8192,32"`
-	StateRoots                   [8192][32]byte                 `json:"state_roots" ssz-size:"8192,32"`
-	HistoricalRoots              [][]byte                       `json:"historical_roots" ssz-max:"16777216" ssz-size:"?,32"`
-	Eth1Data                     *Eth1Data                      `json:"eth1_data"`
-	Eth1DataVotes                []*Eth1Data                    `json:"eth1_data_votes" ssz-max:"2048"`
-	Eth1DepositIndex             uint64                         `json:"eth1_deposit_index"`
-	Validators                   []*Validator                   `json:"validators" ssz-max:"1099511627776"`
-	Balances                     []uint64                       `json:"balances" ssz-max:"1099511627776"`
-	RandaoMixes                  [65536][32]byte                `json:"randao_mixes" ssz-size:"65536,32"`
-	Slashings                    []uint64                       `json:"slashings" ssz-size:"8192"`
-	PreviousEpochParticipation   []byte                         `json:"previous_epoch_participation" ssz-max:"1099511627776"`
-	CurrentEpochParticipation    []byte                         `json:"current_epoch_participation" ssz-max:"1099511627776"`
-	JustificationBits            [1]byte                        `json:"justification_bits" ssz-size:"1"`
-	PreviousJustifiedCheckpoint  *Checkpoint                    `json:"previous_justified_checkpoint"`
-	CurrentJustifiedCheckpoint   *Checkpoint                    `json:"current_justified_checkpoint"`
-	FinalizedCheckpoint          *Checkpoint                    `json:"finalized_checkpoint"`
-	InactivityScores             []uint64                       `json:"inactivity_scores" ssz-max:"1099511627776"`
-	CurrentSyncCommittee         *SyncCommittee                 `json:"current_sync_committee"`
-	NextSyncCommittee            *SyncCommittee                 `json:"next_sync_committee"`
-	LatestExecutionPayloadHeader *ExecutionPayloadHeaderCapella `json:"latest_execution_payload_header"`
-	NextWithdrawalIndex          uint64                         `json:"next_withdrawal_index"`
-	NextWithdrawalValidatorIndex uint64                         `json:"next_withdrawal_validator_index"`
-	HistoricalSummaries          []*HistoricalSummary           `json:"historical_summaries" ssz-max:"16777216"`
-}
-
-type SignedBeaconBlockCapella struct {
-	Block     *BeaconBlockCapella `json:"message"`
-	Signature []byte              `json:"signature" ssz-size:"96"`
-}
-
-type BeaconBlockCapella struct {
-	Slot          uint64                  `json:"slot"`
-	ProposerIndex uint64                  `json:"proposer_index"`
-	ParentRoot    [32]byte                `json:"parent_root" ssz-size:"32"`
-	StateRoot     [32]byte                `json:"state_root" ssz-size:"32"`
-	Body          *BeaconBlockBodyCapella `json:"body"`
-}
-
-type BeaconBlockBodyCapella struct {
-	RandaoReveal          []byte                        `json:"randao_reveal" ssz-size:"96"`
-	Eth1Data              *Eth1Data                     `json:"eth1_data"`
-	Graffiti              [32]byte                      `json:"graffiti" ssz-size:"32"`
-	ProposerSlashings     []*ProposerSlashing           `json:"proposer_slashings" ssz-max:"16"`
-	AttesterSlashings     []*AttesterSlashing           `json:"attester_slashings" ssz-max:"2"`
-	Attestations          []*Attestation                `json:"attestations" ssz-max:"128"`
-	Deposits              []*Deposit                    `json:"deposits" ssz-max:"16"`
-	VoluntaryExits        []*SignedVoluntaryExit        `json:"voluntary_exits" ssz-max:"16"`
-	SyncAggregate         *SyncAggregate                `json:"sync_aggregate"`
-	ExecutionPayload      *ExecutionPayloadCapella      `json:"execution_payload"`
-	BlsToExecutionChanges []*SignedBLSToExecutionChange `json:"bls_to_execution_changes" ssz-max:"16"`
+// ssz_generic containers
+
+type SingleFieldTestStruct struct {
+	A uint8
 }
 
-// -- deneb --
+type SmallTestStruct struct {
+	A uint16
+	B uint16
+}
 
-type ExecutionPayloadDeneb struct {
-	ParentHash    [32]byte      `ssz-size:"32" json:"parent_hash"`
-	FeeRecipient  [20]byte      `ssz-size:"20" json:"fee_recipient"`
-	StateRoot     [32]byte      `ssz-size:"32" json:"state_root"`
-	ReceiptsRoot  [32]byte      `ssz-size:"32" json:"receipts_root"`
-	LogsBloom     [256]byte     `ssz-size:"256" json:"logs_bloom"`
-	PrevRandao    [32]byte      `ssz-size:"32" json:"prev_randao"`
-	BlockNumber   uint64        `json:"block_number"`
-	GasLimit      uint64        `json:"gas_limit"`
-	GasUsed       uint64        `json:"gas_used"`
-	Timestamp     uint64        `json:"timestamp"`
-	ExtraData     []byte        `ssz-max:"32" json:"extra_data"`
-	BaseFeePerGas Uint256       `ssz-size:"32" json:"base_fee_per_gas"`
-	BlockHash     [32]byte      `ssz-size:"32" json:"block_hash"`
-	Transactions  [][]byte      `ssz-max:"1048576,1073741824" ssz-size:"?,?" json:"transactions"`
-	Withdrawals   []*Withdrawal `json:"withdrawals" ssz-max:"16"`
-	BlobGasUsed   uint64        `json:"blob_gas_used"`
-	ExcessBlobGas uint64        `json:"excess_blob_gas"`
+type FixedTestStruct struct {
+	A uint8
+	B uint64
+	C uint32
 }
 
-type ExecutionPayloadHeaderDeneb struct {
-	ParentHash       [32]byte  `json:"parent_hash" ssz-size:"32"`
-	FeeRecipient     [20]byte  `json:"fee_recipient" ssz-size:"20"`
-	StateRoot        [32]byte  `json:"state_root" ssz-size:"32"`
-	ReceiptsRoot     [32]byte  `json:"receipts_root" ssz-size:"32"`
-	LogsBloom        [256]byte `json:"logs_bloom" ssz-size:"256"`
-	PrevRandao       [32]byte  `json:"prev_randao" ssz-size:"32"`
-	BlockNumber      uint64    `json:"block_number"`
-	GasLimit         uint64    `json:"gas_limit"`
-	GasUsed          uint64    `json:"gas_used"`
-	Timestamp        uint64    `json:"timestamp"`
-	ExtraData        []byte    `json:"extra_data" ssz-max:"32"`
-	BaseFeePerGas    Uint256   `json:"base_fee_per_gas" ssz-size:"32"`
-	BlockHash        [32]byte  `json:"block_hash" ssz-size:"32"`
-	TransactionsRoot [32]byte  `json:"transactions_root" ssz-size:"32"`
-	WithdrawalRoot   [32]byte  `json:"withdrawals_root" ssz-size:"32"`
-	BlobGasUsed      uint64    `json:"blob_gas_used"`
-	ExcessBlobGas    uint64    `json:"excess_blob_gas"`
+type VarTestStruct struct {
+	A uint16
+	B []uint16 `ssz-max:"1024"`
+	C uint8
+}
+
+type BitsStruct struct {
+	A []byte `ssz:"bitlist" ssz-max:"5"`
+	B ssz.BitvectorOf[ssz.Limit2]
+	C ssz.BitvectorOf[ssz.Limit1]
+	D []byte `ssz:"bitlist" ssz-max:"6"`
+	E ssz.BitvectorOf[ssz.Limit8]
+}
+
+type ComplexTestStruct struct {
+	A uint16
+	B []uint16 `ssz-max:"128"`
+	C uint8
+	D []byte `ssz-max:"256"`
+	E *VarTestStruct
+	F []*FixedTestStruct `ssz-size:"4"`
+	G []*VarTestStruct   `ssz-size:"2"`
+}
+
+// ssz_generic booleans and bitlists, the invalid encodings are decoded as the
+// single field of a container. A container with one fixed field has the same
+// encoding as the field and a container with one bitlist has its offset before it.
+
+type BoolTestStruct struct {
+	A bool
+}
+
+type Bitlist1TestStruct struct {
+	A []byte `ssz:"bitlist" ssz-max:"1"`
+}
+
+type Bitlist2TestStruct struct {
+	A []byte `ssz:"bitlist" ssz-max:"2"`
+}
+
+type Bitlist3TestStruct struct {
+	A []byte `ssz:"bitlist" ssz-max:"3"`
+}
+
+type Bitlist4TestStruct struct {
+	A []byte `ssz:"bitlist" ssz-max:"4"`
+}
+
+type Bitlist5TestStruct struct {
+	A []byte `ssz:"bitlist" ssz-max:"5"`
+}
+
+type Bitlist8TestStruct struct {
+	A []byte `ssz:"bitlist" ssz-max:"8"`
+}
+
+type Bitlist32TestStruct struct {
+	A []byte `ssz:"bitlist" ssz-max:"32"`
+}
+
+type Bitlist512TestStruct struct {
+	A []byte `ssz:"bitlist" ssz-max:"512"`
 }
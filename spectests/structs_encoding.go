@@ -1,10 +1,13 @@
 // Code generated by fastssz. DO NOT EDIT.
-// Hash: 94669d09774072812f06df44119d4739b8efb93b53f59c9c690a6e66ec4c7156
+// Hash: c81da396c0f2eb141bcf6fadf1bbb53cf9449a4fbd1cb3f9681a6e6a575d4342
 // Version: 0.1.3
 package spectests
 
 import (
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
 )
 
 // MarshalSSZ ssz marshals the AggregateAndProof object
@@ -34,8 +37,40 @@ func (a *AggregateAndProof) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the AggregateAndProof object to a writer
+func (a *AggregateAndProof) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(a, writer)
+}
+
+// MarshalSSZToStream ssz marshals the AggregateAndProof object to a target array that is flushed to a stream writer
+func (a *AggregateAndProof) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(108)
+
+	// Field (0) 'Index'
+	dst = ssz.MarshalUint64(dst, a.Index)
+
+	// Offset (1) 'Aggregate'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (2) 'SelectionProof'
+	dst = append(dst, a.SelectionProof[:]...)
+
+	// Field (1) 'Aggregate'
+	if dst, err = a.Aggregate.MarshalSSZToStream(dst, sw); err != nil {
+		return
+	}
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the AggregateAndProof object
 func (a *AggregateAndProof) UnmarshalSSZ(buf []byte) error {
+	return a.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the AggregateAndProof object with the decoding options
+func (a *AggregateAndProof) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size < 108 {
@@ -53,8 +88,8 @@ func (a *AggregateAndProof) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o1 < 108 {
-		return ssz.ErrInvalidVariableOffset
+	if err = opts.ValidateFirstOffset(o1, 108); err != nil {
+		return err
 	}
 
 	// Field (2) 'SelectionProof'
@@ -66,7 +101,7 @@ func (a *AggregateAndProof) UnmarshalSSZ(buf []byte) error {
 		if a.Aggregate == nil {
 			a.Aggregate = new(Attestation)
 		}
-		if err = a.Aggregate.UnmarshalSSZ(buf); err != nil {
+		if err = a.Aggregate.UnmarshalSSZWithOptions(buf, opts); err != nil {
 			return err
 		}
 	}
@@ -86,6 +121,16 @@ func (a *AggregateAndProof) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the AggregateAndProof object
+func (a *AggregateAndProof) MinSSZSize() uint64 {
+	return 337
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the AggregateAndProof object
+func (a *AggregateAndProof) MaxSSZSize() uint64 {
+	return 593
+}
+
 // HashTreeRoot ssz hashes the AggregateAndProof object
 func (a *AggregateAndProof) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(a)
@@ -110,11 +155,103 @@ func (a *AggregateAndProof) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded AggregateAndProof object without decoding it
+func (a *AggregateAndProof) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(a, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded AggregateAndProof object with a hasher
+func (a *AggregateAndProof) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 108 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Offset (1) 'Aggregate'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = ssz.ValidateFirstOffset(o1, 108); err != nil {
+		return err
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Index'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'Aggregate'
+	{
+		buf := tail[o1:]
+		if err = (*Attestation)(nil).HashTreeRootSSZWith(hh, buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (2) 'SelectionProof'
+	hh.PutBytes(buf[12:108])
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the AggregateAndProof object
 func (a *AggregateAndProof) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(a)
 }
 
+const (
+	// AggregateAndProofIndexGIndex is the generalized index of the 'Index' field
+	AggregateAndProofIndexGIndex ssz.GIndex = 4
+	// AggregateAndProofAggregateGIndex is the generalized index of the 'Aggregate' field
+	AggregateAndProofAggregateGIndex ssz.GIndex = 5
+	// AggregateAndProofSelectionProofGIndex is the generalized index of the 'SelectionProof' field
+	AggregateAndProofSelectionProofGIndex ssz.GIndex = 6
+)
+
+// ProveIndex returns a merkle proof of the 'Index' field of the AggregateAndProof object
+func (a *AggregateAndProof) ProveIndex() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(a, AggregateAndProofIndexGIndex)
+}
+
+// VerifyAggregateAndProofIndex verifies a merkle proof of the 'Index' field of a AggregateAndProof object
+func VerifyAggregateAndProofIndex(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, AggregateAndProofIndexGIndex)
+}
+
+// ProveAggregate returns a merkle proof of the 'Aggregate' field of the AggregateAndProof object
+func (a *AggregateAndProof) ProveAggregate() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(a, AggregateAndProofAggregateGIndex)
+}
+
+// VerifyAggregateAndProofAggregate verifies a merkle proof of the 'Aggregate' field of a AggregateAndProof object
+func VerifyAggregateAndProofAggregate(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, AggregateAndProofAggregateGIndex)
+}
+
+// ProveSelectionProof returns a merkle proof of the 'SelectionProof' field of the AggregateAndProof object
+func (a *AggregateAndProof) ProveSelectionProof() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(a, AggregateAndProofSelectionProofGIndex)
+}
+
+// VerifyAggregateAndProofSelectionProof verifies a merkle proof of the 'SelectionProof' field of a AggregateAndProof object
+func VerifyAggregateAndProofSelectionProof(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, AggregateAndProofSelectionProofGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the AggregateAndProof object
+func (a *AggregateAndProof) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Index", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "Aggregate", Schema: (*Attestation)(nil).SchemaSSZ()},
+		&ssz.SchemaField{Name: "SelectionProof", Schema: ssz.SchemaBytes(96)},
+	)
+}
+
 // MarshalSSZ ssz marshals the Checkpoint object
 func (c *Checkpoint) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(c)
@@ -137,8 +274,35 @@ func (c *Checkpoint) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the Checkpoint object to a writer
+func (c *Checkpoint) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(c, writer)
+}
+
+// MarshalSSZToStream ssz marshals the Checkpoint object to a target array that is flushed to a stream writer
+func (c *Checkpoint) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Epoch'
+	dst = ssz.MarshalUint64(dst, c.Epoch)
+
+	// Field (1) 'Root'
+	if size := len(c.Root); size != 32 {
+		err = ssz.ErrBytesLengthFn("Checkpoint.Root", size, 32)
+		return
+	}
+	dst = append(dst, c.Root...)
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the Checkpoint object
 func (c *Checkpoint) UnmarshalSSZ(buf []byte) error {
+	return c.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Checkpoint object with the decoding options
+func (c *Checkpoint) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 40 {
@@ -163,6 +327,16 @@ func (c *Checkpoint) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Checkpoint object
+func (c *Checkpoint) MinSSZSize() uint64 {
+	return 40
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Checkpoint object
+func (c *Checkpoint) MaxSSZSize() uint64 {
+	return 40
+}
+
 // HashTreeRoot ssz hashes the Checkpoint object
 func (c *Checkpoint) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(c)
@@ -186,11 +360,70 @@ func (c *Checkpoint) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded Checkpoint object without decoding it
+func (c *Checkpoint) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(c, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded Checkpoint object with a hasher
+func (c *Checkpoint) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 40 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Epoch'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'Root'
+	hh.PutBytes(buf[8:40])
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the Checkpoint object
 func (c *Checkpoint) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
 
+const (
+	// CheckpointEpochGIndex is the generalized index of the 'Epoch' field
+	CheckpointEpochGIndex ssz.GIndex = 2
+	// CheckpointRootGIndex is the generalized index of the 'Root' field
+	CheckpointRootGIndex ssz.GIndex = 3
+)
+
+// ProveEpoch returns a merkle proof of the 'Epoch' field of the Checkpoint object
+func (c *Checkpoint) ProveEpoch() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, CheckpointEpochGIndex)
+}
+
+// VerifyCheckpointEpoch verifies a merkle proof of the 'Epoch' field of a Checkpoint object
+func VerifyCheckpointEpoch(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, CheckpointEpochGIndex)
+}
+
+// ProveRoot returns a merkle proof of the 'Root' field of the Checkpoint object
+func (c *Checkpoint) ProveRoot() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, CheckpointRootGIndex)
+}
+
+// VerifyCheckpointRoot verifies a merkle proof of the 'Root' field of a Checkpoint object
+func VerifyCheckpointRoot(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, CheckpointRootGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the Checkpoint object
+func (c *Checkpoint) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Epoch", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "Root", Schema: ssz.SchemaBytes(32)},
+	)
+}
+
 // MarshalSSZ ssz marshals the AttestationData object
 func (a *AttestationData) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(a)
@@ -228,8 +461,50 @@ func (a *AttestationData) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the AttestationData object to a writer
+func (a *AttestationData) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(a, writer)
+}
+
+// MarshalSSZToStream ssz marshals the AttestationData object to a target array that is flushed to a stream writer
+func (a *AttestationData) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, uint64(a.Slot))
+
+	// Field (1) 'Index'
+	dst = ssz.MarshalUint64(dst, a.Index)
+
+	// Field (2) 'BeaconBlockHash'
+	dst = append(dst, a.BeaconBlockHash[:]...)
+
+	// Field (3) 'Source'
+	if a.Source == nil {
+		a.Source = new(Checkpoint)
+	}
+	if dst, err = a.Source.MarshalSSZToStream(dst, sw); err != nil {
+		return
+	}
+
+	// Field (4) 'Target'
+	if a.Target == nil {
+		a.Target = new(Checkpoint)
+	}
+	if dst, err = a.Target.MarshalSSZToStream(dst, sw); err != nil {
+		return
+	}
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the AttestationData object
 func (a *AttestationData) UnmarshalSSZ(buf []byte) error {
+	return a.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the AttestationData object with the decoding options
+func (a *AttestationData) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 128 {
@@ -249,7 +524,7 @@ func (a *AttestationData) UnmarshalSSZ(buf []byte) error {
 	if a.Source == nil {
 		a.Source = new(Checkpoint)
 	}
-	if err = a.Source.UnmarshalSSZ(buf[48:88]); err != nil {
+	if err = a.Source.UnmarshalSSZWithOptions(buf[48:88], opts); err != nil {
 		return err
 	}
 
@@ -257,7 +532,7 @@ func (a *AttestationData) UnmarshalSSZ(buf []byte) error {
 	if a.Target == nil {
 		a.Target = new(Checkpoint)
 	}
-	if err = a.Target.UnmarshalSSZ(buf[88:128]); err != nil {
+	if err = a.Target.UnmarshalSSZWithOptions(buf[88:128], opts); err != nil {
 		return err
 	}
 
@@ -270,6 +545,16 @@ func (a *AttestationData) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the AttestationData object
+func (a *AttestationData) MinSSZSize() uint64 {
+	return 128
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the AttestationData object
+func (a *AttestationData) MaxSSZSize() uint64 {
+	return 128
+}
+
 // HashTreeRoot ssz hashes the AttestationData object
 func (a *AttestationData) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(a)
@@ -308,11 +593,122 @@ func (a *AttestationData) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded AttestationData object without decoding it
+func (a *AttestationData) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(a, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded AttestationData object with a hasher
+func (a *AttestationData) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 128 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'Index'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[8:16]))
+
+	// Field (2) 'BeaconBlockHash'
+	hh.PutBytes(buf[16:48])
+
+	// Field (3) 'Source'
+	if err = (*Checkpoint)(nil).HashTreeRootSSZWith(hh, buf[48:88]); err != nil {
+		return err
+	}
+
+	// Field (4) 'Target'
+	if err = (*Checkpoint)(nil).HashTreeRootSSZWith(hh, buf[88:128]); err != nil {
+		return err
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the AttestationData object
 func (a *AttestationData) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(a)
 }
 
+const (
+	// AttestationDataSlotGIndex is the generalized index of the 'Slot' field
+	AttestationDataSlotGIndex ssz.GIndex = 8
+	// AttestationDataIndexGIndex is the generalized index of the 'Index' field
+	AttestationDataIndexGIndex ssz.GIndex = 9
+	// AttestationDataBeaconBlockHashGIndex is the generalized index of the 'BeaconBlockHash' field
+	AttestationDataBeaconBlockHashGIndex ssz.GIndex = 10
+	// AttestationDataSourceGIndex is the generalized index of the 'Source' field
+	AttestationDataSourceGIndex ssz.GIndex = 11
+	// AttestationDataTargetGIndex is the generalized index of the 'Target' field
+	AttestationDataTargetGIndex ssz.GIndex = 12
+)
+
+// ProveSlot returns a merkle proof of the 'Slot' field of the AttestationData object
+func (a *AttestationData) ProveSlot() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(a, AttestationDataSlotGIndex)
+}
+
+// VerifyAttestationDataSlot verifies a merkle proof of the 'Slot' field of a AttestationData object
+func VerifyAttestationDataSlot(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, AttestationDataSlotGIndex)
+}
+
+// ProveIndex returns a merkle proof of the 'Index' field of the AttestationData object
+func (a *AttestationData) ProveIndex() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(a, AttestationDataIndexGIndex)
+}
+
+// VerifyAttestationDataIndex verifies a merkle proof of the 'Index' field of a AttestationData object
+func VerifyAttestationDataIndex(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, AttestationDataIndexGIndex)
+}
+
+// ProveBeaconBlockHash returns a merkle proof of the 'BeaconBlockHash' field of the AttestationData object
+func (a *AttestationData) ProveBeaconBlockHash() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(a, AttestationDataBeaconBlockHashGIndex)
+}
+
+// VerifyAttestationDataBeaconBlockHash verifies a merkle proof of the 'BeaconBlockHash' field of a AttestationData object
+func VerifyAttestationDataBeaconBlockHash(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, AttestationDataBeaconBlockHashGIndex)
+}
+
+// ProveSource returns a merkle proof of the 'Source' field of the AttestationData object
+func (a *AttestationData) ProveSource() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(a, AttestationDataSourceGIndex)
+}
+
+// VerifyAttestationDataSource verifies a merkle proof of the 'Source' field of a AttestationData object
+func VerifyAttestationDataSource(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, AttestationDataSourceGIndex)
+}
+
+// ProveTarget returns a merkle proof of the 'Target' field of the AttestationData object
+func (a *AttestationData) ProveTarget() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(a, AttestationDataTargetGIndex)
+}
+
+// VerifyAttestationDataTarget verifies a merkle proof of the 'Target' field of a AttestationData object
+func VerifyAttestationDataTarget(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, AttestationDataTargetGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the AttestationData object
+func (a *AttestationData) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Slot", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "Index", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "BeaconBlockHash", Schema: ssz.SchemaBytes(32)},
+		&ssz.SchemaField{Name: "Source", Schema: (*Checkpoint)(nil).SchemaSSZ()},
+		&ssz.SchemaField{Name: "Target", Schema: (*Checkpoint)(nil).SchemaSSZ()},
+	)
+}
+
 // MarshalSSZ ssz marshals the Attestation object
 func (a *Attestation) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(a)
@@ -347,8 +743,47 @@ func (a *Attestation) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the Attestation object to a writer
+func (a *Attestation) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(a, writer)
+}
+
+// MarshalSSZToStream ssz marshals the Attestation object to a target array that is flushed to a stream writer
+func (a *Attestation) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(228)
+
+	// Offset (0) 'AggregationBits'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'Data'
+	if a.Data == nil {
+		a.Data = new(AttestationData)
+	}
+	if dst, err = a.Data.MarshalSSZToStream(dst, sw); err != nil {
+		return
+	}
+
+	// Field (2) 'Signature'
+	dst = append(dst, a.Signature[:]...)
+
+	// Field (0) 'AggregationBits'
+	if size := len(a.AggregationBits); size > 2048 {
+		err = ssz.ErrBytesLengthFn("Attestation.AggregationBits", size, 2048)
+		return
+	}
+	dst = append(dst, a.AggregationBits...)
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the Attestation object
 func (a *Attestation) UnmarshalSSZ(buf []byte) error {
+	return a.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Attestation object with the decoding options
+func (a *Attestation) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size < 228 {
@@ -363,15 +798,15 @@ func (a *Attestation) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 228 {
-		return ssz.ErrInvalidVariableOffset
+	if err = opts.ValidateFirstOffset(o0, 228); err != nil {
+		return err
 	}
 
 	// Field (1) 'Data'
 	if a.Data == nil {
 		a.Data = new(AttestationData)
 	}
-	if err = a.Data.UnmarshalSSZ(buf[4:132]); err != nil {
+	if err = a.Data.UnmarshalSSZWithOptions(buf[4:132], opts); err != nil {
 		return err
 	}
 
@@ -402,6 +837,16 @@ func (a *Attestation) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Attestation object
+func (a *Attestation) MinSSZSize() uint64 {
+	return 229
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Attestation object
+func (a *Attestation) MaxSSZSize() uint64 {
+	return 485
+}
+
 // HashTreeRoot ssz hashes the Attestation object
 func (a *Attestation) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(a)
@@ -433,9 +878,104 @@ func (a *Attestation) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
-// GetTree ssz hashes the Attestation object
-func (a *Attestation) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(a)
+// HashTreeRootSSZ ssz hashes the encoded Attestation object without decoding it
+func (a *Attestation) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(a, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded Attestation object with a hasher
+func (a *Attestation) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 228 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'AggregationBits'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = ssz.ValidateFirstOffset(o0, 228); err != nil {
+		return err
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'AggregationBits'
+	{
+		buf := tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 2048); err != nil {
+			return err
+		}
+		hh.PutBitlist(buf, 2048)
+	}
+
+	// Field (1) 'Data'
+	if err = (*AttestationData)(nil).HashTreeRootSSZWith(hh, buf[4:132]); err != nil {
+		return err
+	}
+
+	// Field (2) 'Signature'
+	hh.PutBytes(buf[132:228])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Attestation object
+func (a *Attestation) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(a)
+}
+
+const (
+	// AttestationAggregationBitsGIndex is the generalized index of the 'AggregationBits' field
+	AttestationAggregationBitsGIndex ssz.GIndex = 4
+	// AttestationDataGIndex is the generalized index of the 'Data' field
+	AttestationDataGIndex ssz.GIndex = 5
+	// AttestationSignatureGIndex is the generalized index of the 'Signature' field
+	AttestationSignatureGIndex ssz.GIndex = 6
+)
+
+// ProveAggregationBits returns a merkle proof of the 'AggregationBits' field of the Attestation object
+func (a *Attestation) ProveAggregationBits() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(a, AttestationAggregationBitsGIndex)
+}
+
+// VerifyAttestationAggregationBits verifies a merkle proof of the 'AggregationBits' field of a Attestation object
+func VerifyAttestationAggregationBits(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, AttestationAggregationBitsGIndex)
+}
+
+// ProveData returns a merkle proof of the 'Data' field of the Attestation object
+func (a *Attestation) ProveData() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(a, AttestationDataGIndex)
+}
+
+// VerifyAttestationData verifies a merkle proof of the 'Data' field of a Attestation object
+func VerifyAttestationData(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, AttestationDataGIndex)
+}
+
+// ProveSignature returns a merkle proof of the 'Signature' field of the Attestation object
+func (a *Attestation) ProveSignature() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(a, AttestationSignatureGIndex)
+}
+
+// VerifyAttestationSignature verifies a merkle proof of the 'Signature' field of a Attestation object
+func VerifyAttestationSignature(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, AttestationSignatureGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the Attestation object
+func (a *Attestation) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "AggregationBits", Schema: ssz.SchemaBitlist(2048)},
+		&ssz.SchemaField{Name: "Data", Schema: (*AttestationData)(nil).SchemaSSZ()},
+		&ssz.SchemaField{Name: "Signature", Schema: ssz.SchemaBytes(96)},
+	)
 }
 
 // MarshalSSZ ssz marshals the DepositData object
@@ -466,8 +1006,41 @@ func (d *DepositData) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the DepositData object to a writer
+func (d *DepositData) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(d, writer)
+}
+
+// MarshalSSZToStream ssz marshals the DepositData object to a target array that is flushed to a stream writer
+func (d *DepositData) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Pubkey'
+	dst = append(dst, d.Pubkey[:]...)
+
+	// Field (1) 'WithdrawalCredentials'
+	dst = append(dst, d.WithdrawalCredentials[:]...)
+
+	// Field (2) 'Amount'
+	dst = ssz.MarshalUint64(dst, d.Amount)
+
+	// Field (3) 'Signature'
+	if size := len(d.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("DepositData.Signature", size, 96)
+		return
+	}
+	dst = append(dst, d.Signature...)
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the DepositData object
 func (d *DepositData) UnmarshalSSZ(buf []byte) error {
+	return d.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the DepositData object with the decoding options
+func (d *DepositData) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 184 {
@@ -498,6 +1071,16 @@ func (d *DepositData) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the DepositData object
+func (d *DepositData) MinSSZSize() uint64 {
+	return 184
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the DepositData object
+func (d *DepositData) MaxSSZSize() uint64 {
+	return 184
+}
+
 // HashTreeRoot ssz hashes the DepositData object
 func (d *DepositData) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(d)
@@ -527,11 +1110,102 @@ func (d *DepositData) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded DepositData object without decoding it
+func (d *DepositData) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(d, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded DepositData object with a hasher
+func (d *DepositData) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 184 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Pubkey'
+	hh.PutBytes(buf[0:48])
+
+	// Field (1) 'WithdrawalCredentials'
+	hh.PutBytes(buf[48:80])
+
+	// Field (2) 'Amount'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[80:88]))
+
+	// Field (3) 'Signature'
+	hh.PutBytes(buf[88:184])
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the DepositData object
 func (d *DepositData) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(d)
 }
 
+const (
+	// DepositDataPubkeyGIndex is the generalized index of the 'Pubkey' field
+	DepositDataPubkeyGIndex ssz.GIndex = 4
+	// DepositDataWithdrawalCredentialsGIndex is the generalized index of the 'WithdrawalCredentials' field
+	DepositDataWithdrawalCredentialsGIndex ssz.GIndex = 5
+	// DepositDataAmountGIndex is the generalized index of the 'Amount' field
+	DepositDataAmountGIndex ssz.GIndex = 6
+	// DepositDataSignatureGIndex is the generalized index of the 'Signature' field
+	DepositDataSignatureGIndex ssz.GIndex = 7
+)
+
+// ProvePubkey returns a merkle proof of the 'Pubkey' field of the DepositData object
+func (d *DepositData) ProvePubkey() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(d, DepositDataPubkeyGIndex)
+}
+
+// VerifyDepositDataPubkey verifies a merkle proof of the 'Pubkey' field of a DepositData object
+func VerifyDepositDataPubkey(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, DepositDataPubkeyGIndex)
+}
+
+// ProveWithdrawalCredentials returns a merkle proof of the 'WithdrawalCredentials' field of the DepositData object
+func (d *DepositData) ProveWithdrawalCredentials() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(d, DepositDataWithdrawalCredentialsGIndex)
+}
+
+// VerifyDepositDataWithdrawalCredentials verifies a merkle proof of the 'WithdrawalCredentials' field of a DepositData object
+func VerifyDepositDataWithdrawalCredentials(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, DepositDataWithdrawalCredentialsGIndex)
+}
+
+// ProveAmount returns a merkle proof of the 'Amount' field of the DepositData object
+func (d *DepositData) ProveAmount() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(d, DepositDataAmountGIndex)
+}
+
+// VerifyDepositDataAmount verifies a merkle proof of the 'Amount' field of a DepositData object
+func VerifyDepositDataAmount(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, DepositDataAmountGIndex)
+}
+
+// ProveSignature returns a merkle proof of the 'Signature' field of the DepositData object
+func (d *DepositData) ProveSignature() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(d, DepositDataSignatureGIndex)
+}
+
+// VerifyDepositDataSignature verifies a merkle proof of the 'Signature' field of a DepositData object
+func VerifyDepositDataSignature(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, DepositDataSignatureGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the DepositData object
+func (d *DepositData) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Pubkey", Schema: ssz.SchemaBytes(48)},
+		&ssz.SchemaField{Name: "WithdrawalCredentials", Schema: ssz.SchemaBytes(32)},
+		&ssz.SchemaField{Name: "Amount", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "Signature", Schema: ssz.SchemaBytes(96)},
+	)
+}
+
 // MarshalSSZ ssz marshals the Deposit object
 func (d *Deposit) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(d)
@@ -552,6 +1226,7 @@ func (d *Deposit) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 			return
 		}
 		dst = append(dst, d.Proof[ii]...)
+
 	}
 
 	// Field (1) 'Data'
@@ -565,8 +1240,49 @@ func (d *Deposit) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the Deposit object to a writer
+func (d *Deposit) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(d, writer)
+}
+
+// MarshalSSZToStream ssz marshals the Deposit object to a target array that is flushed to a stream writer
+func (d *Deposit) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Proof'
+	if size := len(d.Proof); size != 33 {
+		err = ssz.ErrVectorLengthFn("Deposit.Proof", size, 33)
+		return
+	}
+	for ii := 0; ii < 33; ii++ {
+		if size := len(d.Proof[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("Deposit.Proof[ii]", size, 32)
+			return
+		}
+		dst = append(dst, d.Proof[ii]...)
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (1) 'Data'
+	if d.Data == nil {
+		d.Data = new(DepositData)
+	}
+	if dst, err = d.Data.MarshalSSZToStream(dst, sw); err != nil {
+		return
+	}
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the Deposit object
 func (d *Deposit) UnmarshalSSZ(buf []byte) error {
+	return d.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Deposit object with the decoding options
+func (d *Deposit) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 1240 {
@@ -586,7 +1302,7 @@ func (d *Deposit) UnmarshalSSZ(buf []byte) error {
 	if d.Data == nil {
 		d.Data = new(DepositData)
 	}
-	if err = d.Data.UnmarshalSSZ(buf[1056:1240]); err != nil {
+	if err = d.Data.UnmarshalSSZWithOptions(buf[1056:1240], opts); err != nil {
 		return err
 	}
 
@@ -599,6 +1315,16 @@ func (d *Deposit) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Deposit object
+func (d *Deposit) MinSSZSize() uint64 {
+	return 1240
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Deposit object
+func (d *Deposit) MaxSSZSize() uint64 {
+	return 1240
+}
+
 // HashTreeRoot ssz hashes the Deposit object
 func (d *Deposit) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(d)
@@ -637,11 +1363,76 @@ func (d *Deposit) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded Deposit object without decoding it
+func (d *Deposit) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(d, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded Deposit object with a hasher
+func (d *Deposit) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 1240 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Proof'
+	{
+		subIndx := hh.Index()
+		hh.Append(buf[0:1056])
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (1) 'Data'
+	if err = (*DepositData)(nil).HashTreeRootSSZWith(hh, buf[1056:1240]); err != nil {
+		return err
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the Deposit object
 func (d *Deposit) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(d)
 }
 
+const (
+	// DepositProofGIndex is the generalized index of the 'Proof' field
+	DepositProofGIndex ssz.GIndex = 2
+	// DepositDataGIndex is the generalized index of the 'Data' field
+	DepositDataGIndex ssz.GIndex = 3
+)
+
+// ProveProof returns a merkle proof of the 'Proof' field of the Deposit object
+func (d *Deposit) ProveProof() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(d, DepositProofGIndex)
+}
+
+// VerifyDepositProof verifies a merkle proof of the 'Proof' field of a Deposit object
+func VerifyDepositProof(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, DepositProofGIndex)
+}
+
+// ProveData returns a merkle proof of the 'Data' field of the Deposit object
+func (d *Deposit) ProveData() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(d, DepositDataGIndex)
+}
+
+// VerifyDepositData verifies a merkle proof of the 'Data' field of a Deposit object
+func VerifyDepositData(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, DepositDataGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the Deposit object
+func (d *Deposit) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Proof", Schema: ssz.SchemaVector(ssz.SchemaBytes(32), 33)},
+		&ssz.SchemaField{Name: "Data", Schema: (*DepositData)(nil).SchemaSSZ()},
+	)
+}
+
 // MarshalSSZ ssz marshals the DepositMessage object
 func (d *DepositMessage) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(d)
@@ -671,8 +1462,42 @@ func (d *DepositMessage) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the DepositMessage object to a writer
+func (d *DepositMessage) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(d, writer)
+}
+
+// MarshalSSZToStream ssz marshals the DepositMessage object to a target array that is flushed to a stream writer
+func (d *DepositMessage) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Pubkey'
+	if size := len(d.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("DepositMessage.Pubkey", size, 48)
+		return
+	}
+	dst = append(dst, d.Pubkey...)
+
+	// Field (1) 'WithdrawalCredentials'
+	if size := len(d.WithdrawalCredentials); size != 32 {
+		err = ssz.ErrBytesLengthFn("DepositMessage.WithdrawalCredentials", size, 32)
+		return
+	}
+	dst = append(dst, d.WithdrawalCredentials...)
+
+	// Field (2) 'Amount'
+	dst = ssz.MarshalUint64(dst, d.Amount)
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the DepositMessage object
 func (d *DepositMessage) UnmarshalSSZ(buf []byte) error {
+	return d.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the DepositMessage object with the decoding options
+func (d *DepositMessage) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 88 {
@@ -703,6 +1528,16 @@ func (d *DepositMessage) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the DepositMessage object
+func (d *DepositMessage) MinSSZSize() uint64 {
+	return 88
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the DepositMessage object
+func (d *DepositMessage) MaxSSZSize() uint64 {
+	return 88
+}
+
 // HashTreeRoot ssz hashes the DepositMessage object
 func (d *DepositMessage) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(d)
@@ -733,20 +1568,95 @@ func (d *DepositMessage) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded DepositMessage object without decoding it
+func (d *DepositMessage) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(d, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded DepositMessage object with a hasher
+func (d *DepositMessage) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 88 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Pubkey'
+	hh.PutBytes(buf[0:48])
+
+	// Field (1) 'WithdrawalCredentials'
+	hh.PutBytes(buf[48:80])
+
+	// Field (2) 'Amount'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[80:88]))
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the DepositMessage object
 func (d *DepositMessage) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(d)
 }
 
-// MarshalSSZ ssz marshals the IndexedAttestation object
-func (i *IndexedAttestation) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(i)
+const (
+	// DepositMessagePubkeyGIndex is the generalized index of the 'Pubkey' field
+	DepositMessagePubkeyGIndex ssz.GIndex = 4
+	// DepositMessageWithdrawalCredentialsGIndex is the generalized index of the 'WithdrawalCredentials' field
+	DepositMessageWithdrawalCredentialsGIndex ssz.GIndex = 5
+	// DepositMessageAmountGIndex is the generalized index of the 'Amount' field
+	DepositMessageAmountGIndex ssz.GIndex = 6
+)
+
+// ProvePubkey returns a merkle proof of the 'Pubkey' field of the DepositMessage object
+func (d *DepositMessage) ProvePubkey() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(d, DepositMessagePubkeyGIndex)
 }
 
-// MarshalSSZTo ssz marshals the IndexedAttestation object to a target array
-func (i *IndexedAttestation) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := int(228)
+// VerifyDepositMessagePubkey verifies a merkle proof of the 'Pubkey' field of a DepositMessage object
+func VerifyDepositMessagePubkey(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, DepositMessagePubkeyGIndex)
+}
+
+// ProveWithdrawalCredentials returns a merkle proof of the 'WithdrawalCredentials' field of the DepositMessage object
+func (d *DepositMessage) ProveWithdrawalCredentials() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(d, DepositMessageWithdrawalCredentialsGIndex)
+}
+
+// VerifyDepositMessageWithdrawalCredentials verifies a merkle proof of the 'WithdrawalCredentials' field of a DepositMessage object
+func VerifyDepositMessageWithdrawalCredentials(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, DepositMessageWithdrawalCredentialsGIndex)
+}
+
+// ProveAmount returns a merkle proof of the 'Amount' field of the DepositMessage object
+func (d *DepositMessage) ProveAmount() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(d, DepositMessageAmountGIndex)
+}
+
+// VerifyDepositMessageAmount verifies a merkle proof of the 'Amount' field of a DepositMessage object
+func VerifyDepositMessageAmount(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, DepositMessageAmountGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the DepositMessage object
+func (d *DepositMessage) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Pubkey", Schema: ssz.SchemaBytes(48)},
+		&ssz.SchemaField{Name: "WithdrawalCredentials", Schema: ssz.SchemaBytes(32)},
+		&ssz.SchemaField{Name: "Amount", Schema: ssz.SchemaUint(8)},
+	)
+}
+
+// MarshalSSZ ssz marshals the IndexedAttestation object
+func (i *IndexedAttestation) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(i)
+}
+
+// MarshalSSZTo ssz marshals the IndexedAttestation object to a target array
+func (i *IndexedAttestation) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(228)
 
 	// Offset (0) 'AttestationIndices'
 	dst = ssz.WriteOffset(dst, offset)
@@ -773,6 +1683,50 @@ func (i *IndexedAttestation) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	}
 	for ii := 0; ii < len(i.AttestationIndices); ii++ {
 		dst = ssz.MarshalUint64(dst, i.AttestationIndices[ii])
+
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the IndexedAttestation object to a writer
+func (i *IndexedAttestation) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(i, writer)
+}
+
+// MarshalSSZToStream ssz marshals the IndexedAttestation object to a target array that is flushed to a stream writer
+func (i *IndexedAttestation) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(228)
+
+	// Offset (0) 'AttestationIndices'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'Data'
+	if i.Data == nil {
+		i.Data = new(AttestationData)
+	}
+	if dst, err = i.Data.MarshalSSZToStream(dst, sw); err != nil {
+		return
+	}
+
+	// Field (2) 'Signature'
+	if size := len(i.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("IndexedAttestation.Signature", size, 96)
+		return
+	}
+	dst = append(dst, i.Signature...)
+
+	// Field (0) 'AttestationIndices'
+	if size := len(i.AttestationIndices); size > 2048 {
+		err = ssz.ErrListTooBigFn("IndexedAttestation.AttestationIndices", size, 2048)
+		return
+	}
+	for ii := 0; ii < len(i.AttestationIndices); ii++ {
+		dst = ssz.MarshalUint64(dst, i.AttestationIndices[ii])
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
 	}
 
 	return
@@ -780,6 +1734,11 @@ func (i *IndexedAttestation) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the IndexedAttestation object
 func (i *IndexedAttestation) UnmarshalSSZ(buf []byte) error {
+	return i.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the IndexedAttestation object with the decoding options
+func (i *IndexedAttestation) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size < 228 {
@@ -794,15 +1753,15 @@ func (i *IndexedAttestation) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 228 {
-		return ssz.ErrInvalidVariableOffset
+	if err = opts.ValidateFirstOffset(o0, 228); err != nil {
+		return err
 	}
 
 	// Field (1) 'Data'
 	if i.Data == nil {
 		i.Data = new(AttestationData)
 	}
-	if err = i.Data.UnmarshalSSZ(buf[4:132]); err != nil {
+	if err = i.Data.UnmarshalSSZWithOptions(buf[4:132], opts); err != nil {
 		return err
 	}
 
@@ -837,6 +1796,16 @@ func (i *IndexedAttestation) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the IndexedAttestation object
+func (i *IndexedAttestation) MinSSZSize() uint64 {
+	return 228
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the IndexedAttestation object
+func (i *IndexedAttestation) MaxSSZSize() uint64 {
+	return 16612
+}
+
 // HashTreeRoot ssz hashes the IndexedAttestation object
 func (i *IndexedAttestation) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(i)
@@ -880,11 +1849,112 @@ func (i *IndexedAttestation) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded IndexedAttestation object without decoding it
+func (i *IndexedAttestation) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(i, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded IndexedAttestation object with a hasher
+func (i *IndexedAttestation) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 228 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'AttestationIndices'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = ssz.ValidateFirstOffset(o0, 228); err != nil {
+		return err
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'AttestationIndices'
+	{
+		buf := tail[o0:]
+		{
+			subIndx := hh.Index()
+			num, err := ssz.DivideInt2(len(buf), 8, 2048)
+			if err != nil {
+				return err
+			}
+			hh.Append(buf)
+			hh.FillUpTo32()
+			hh.MerkleizeWithMixin(subIndx, uint64(num), ssz.CalculateLimit(2048, uint64(num), 8))
+		}
+	}
+
+	// Field (1) 'Data'
+	if err = (*AttestationData)(nil).HashTreeRootSSZWith(hh, buf[4:132]); err != nil {
+		return err
+	}
+
+	// Field (2) 'Signature'
+	hh.PutBytes(buf[132:228])
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the IndexedAttestation object
 func (i *IndexedAttestation) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(i)
 }
 
+const (
+	// IndexedAttestationAttestationIndicesGIndex is the generalized index of the 'AttestationIndices' field
+	IndexedAttestationAttestationIndicesGIndex ssz.GIndex = 4
+	// IndexedAttestationDataGIndex is the generalized index of the 'Data' field
+	IndexedAttestationDataGIndex ssz.GIndex = 5
+	// IndexedAttestationSignatureGIndex is the generalized index of the 'Signature' field
+	IndexedAttestationSignatureGIndex ssz.GIndex = 6
+)
+
+// ProveAttestationIndices returns a merkle proof of the 'AttestationIndices' field of the IndexedAttestation object
+func (i *IndexedAttestation) ProveAttestationIndices() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(i, IndexedAttestationAttestationIndicesGIndex)
+}
+
+// VerifyIndexedAttestationAttestationIndices verifies a merkle proof of the 'AttestationIndices' field of a IndexedAttestation object
+func VerifyIndexedAttestationAttestationIndices(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, IndexedAttestationAttestationIndicesGIndex)
+}
+
+// ProveData returns a merkle proof of the 'Data' field of the IndexedAttestation object
+func (i *IndexedAttestation) ProveData() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(i, IndexedAttestationDataGIndex)
+}
+
+// VerifyIndexedAttestationData verifies a merkle proof of the 'Data' field of a IndexedAttestation object
+func VerifyIndexedAttestationData(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, IndexedAttestationDataGIndex)
+}
+
+// ProveSignature returns a merkle proof of the 'Signature' field of the IndexedAttestation object
+func (i *IndexedAttestation) ProveSignature() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(i, IndexedAttestationSignatureGIndex)
+}
+
+// VerifyIndexedAttestationSignature verifies a merkle proof of the 'Signature' field of a IndexedAttestation object
+func VerifyIndexedAttestationSignature(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, IndexedAttestationSignatureGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the IndexedAttestation object
+func (i *IndexedAttestation) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "AttestationIndices", Schema: ssz.SchemaList(ssz.SchemaUint(8), 2048)},
+		&ssz.SchemaField{Name: "Data", Schema: (*AttestationData)(nil).SchemaSSZ()},
+		&ssz.SchemaField{Name: "Signature", Schema: ssz.SchemaBytes(96)},
+	)
+}
+
 // MarshalSSZ ssz marshals the PendingAttestation object
 func (p *PendingAttestation) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(p)
@@ -922,8 +1992,50 @@ func (p *PendingAttestation) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the PendingAttestation object to a writer
+func (p *PendingAttestation) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(p, writer)
+}
+
+// MarshalSSZToStream ssz marshals the PendingAttestation object to a target array that is flushed to a stream writer
+func (p *PendingAttestation) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(148)
+
+	// Offset (0) 'AggregationBits'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'Data'
+	if p.Data == nil {
+		p.Data = new(AttestationData)
+	}
+	if dst, err = p.Data.MarshalSSZToStream(dst, sw); err != nil {
+		return
+	}
+
+	// Field (2) 'InclusionDelay'
+	dst = ssz.MarshalUint64(dst, p.InclusionDelay)
+
+	// Field (3) 'ProposerIndex'
+	dst = ssz.MarshalUint64(dst, p.ProposerIndex)
+
+	// Field (0) 'AggregationBits'
+	if size := len(p.AggregationBits); size > 2048 {
+		err = ssz.ErrBytesLengthFn("PendingAttestation.AggregationBits", size, 2048)
+		return
+	}
+	dst = append(dst, p.AggregationBits...)
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the PendingAttestation object
 func (p *PendingAttestation) UnmarshalSSZ(buf []byte) error {
+	return p.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the PendingAttestation object with the decoding options
+func (p *PendingAttestation) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size < 148 {
@@ -938,15 +2050,15 @@ func (p *PendingAttestation) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 148 {
-		return ssz.ErrInvalidVariableOffset
+	if err = opts.ValidateFirstOffset(o0, 148); err != nil {
+		return err
 	}
 
 	// Field (1) 'Data'
 	if p.Data == nil {
 		p.Data = new(AttestationData)
 	}
-	if err = p.Data.UnmarshalSSZ(buf[4:132]); err != nil {
+	if err = p.Data.UnmarshalSSZWithOptions(buf[4:132], opts); err != nil {
 		return err
 	}
 
@@ -980,6 +2092,16 @@ func (p *PendingAttestation) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the PendingAttestation object
+func (p *PendingAttestation) MinSSZSize() uint64 {
+	return 149
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the PendingAttestation object
+func (p *PendingAttestation) MaxSSZSize() uint64 {
+	return 405
+}
+
 // HashTreeRoot ssz hashes the PendingAttestation object
 func (p *PendingAttestation) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(p)
@@ -1014,11 +2136,122 @@ func (p *PendingAttestation) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded PendingAttestation object without decoding it
+func (p *PendingAttestation) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(p, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded PendingAttestation object with a hasher
+func (p *PendingAttestation) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 148 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'AggregationBits'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = ssz.ValidateFirstOffset(o0, 148); err != nil {
+		return err
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'AggregationBits'
+	{
+		buf := tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 2048); err != nil {
+			return err
+		}
+		hh.PutBitlist(buf, 2048)
+	}
+
+	// Field (1) 'Data'
+	if err = (*AttestationData)(nil).HashTreeRootSSZWith(hh, buf[4:132]); err != nil {
+		return err
+	}
+
+	// Field (2) 'InclusionDelay'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[132:140]))
+
+	// Field (3) 'ProposerIndex'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[140:148]))
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the PendingAttestation object
 func (p *PendingAttestation) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(p)
 }
 
+const (
+	// PendingAttestationAggregationBitsGIndex is the generalized index of the 'AggregationBits' field
+	PendingAttestationAggregationBitsGIndex ssz.GIndex = 4
+	// PendingAttestationDataGIndex is the generalized index of the 'Data' field
+	PendingAttestationDataGIndex ssz.GIndex = 5
+	// PendingAttestationInclusionDelayGIndex is the generalized index of the 'InclusionDelay' field
+	PendingAttestationInclusionDelayGIndex ssz.GIndex = 6
+	// PendingAttestationProposerIndexGIndex is the generalized index of the 'ProposerIndex' field
+	PendingAttestationProposerIndexGIndex ssz.GIndex = 7
+)
+
+// ProveAggregationBits returns a merkle proof of the 'AggregationBits' field of the PendingAttestation object
+func (p *PendingAttestation) ProveAggregationBits() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(p, PendingAttestationAggregationBitsGIndex)
+}
+
+// VerifyPendingAttestationAggregationBits verifies a merkle proof of the 'AggregationBits' field of a PendingAttestation object
+func VerifyPendingAttestationAggregationBits(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, PendingAttestationAggregationBitsGIndex)
+}
+
+// ProveData returns a merkle proof of the 'Data' field of the PendingAttestation object
+func (p *PendingAttestation) ProveData() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(p, PendingAttestationDataGIndex)
+}
+
+// VerifyPendingAttestationData verifies a merkle proof of the 'Data' field of a PendingAttestation object
+func VerifyPendingAttestationData(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, PendingAttestationDataGIndex)
+}
+
+// ProveInclusionDelay returns a merkle proof of the 'InclusionDelay' field of the PendingAttestation object
+func (p *PendingAttestation) ProveInclusionDelay() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(p, PendingAttestationInclusionDelayGIndex)
+}
+
+// VerifyPendingAttestationInclusionDelay verifies a merkle proof of the 'InclusionDelay' field of a PendingAttestation object
+func VerifyPendingAttestationInclusionDelay(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, PendingAttestationInclusionDelayGIndex)
+}
+
+// ProveProposerIndex returns a merkle proof of the 'ProposerIndex' field of the PendingAttestation object
+func (p *PendingAttestation) ProveProposerIndex() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(p, PendingAttestationProposerIndexGIndex)
+}
+
+// VerifyPendingAttestationProposerIndex verifies a merkle proof of the 'ProposerIndex' field of a PendingAttestation object
+func VerifyPendingAttestationProposerIndex(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, PendingAttestationProposerIndexGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the PendingAttestation object
+func (p *PendingAttestation) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "AggregationBits", Schema: ssz.SchemaBitlist(2048)},
+		&ssz.SchemaField{Name: "Data", Schema: (*AttestationData)(nil).SchemaSSZ()},
+		&ssz.SchemaField{Name: "InclusionDelay", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "ProposerIndex", Schema: ssz.SchemaUint(8)},
+	)
+}
+
 // MarshalSSZ ssz marshals the Fork object
 func (f *Fork) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(f)
@@ -1048,8 +2281,42 @@ func (f *Fork) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the Fork object to a writer
+func (f *Fork) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(f, writer)
+}
+
+// MarshalSSZToStream ssz marshals the Fork object to a target array that is flushed to a stream writer
+func (f *Fork) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'PreviousVersion'
+	if size := len(f.PreviousVersion); size != 4 {
+		err = ssz.ErrBytesLengthFn("Fork.PreviousVersion", size, 4)
+		return
+	}
+	dst = append(dst, f.PreviousVersion...)
+
+	// Field (1) 'CurrentVersion'
+	if size := len(f.CurrentVersion); size != 4 {
+		err = ssz.ErrBytesLengthFn("Fork.CurrentVersion", size, 4)
+		return
+	}
+	dst = append(dst, f.CurrentVersion...)
+
+	// Field (2) 'Epoch'
+	dst = ssz.MarshalUint64(dst, f.Epoch)
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the Fork object
 func (f *Fork) UnmarshalSSZ(buf []byte) error {
+	return f.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Fork object with the decoding options
+func (f *Fork) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 16 {
@@ -1080,6 +2347,16 @@ func (f *Fork) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Fork object
+func (f *Fork) MinSSZSize() uint64 {
+	return 16
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Fork object
+func (f *Fork) MaxSSZSize() uint64 {
+	return 16
+}
+
 // HashTreeRoot ssz hashes the Fork object
 func (f *Fork) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(f)
@@ -1110,17 +2387,92 @@ func (f *Fork) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
-// GetTree ssz hashes the Fork object
-func (f *Fork) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(f)
+// HashTreeRootSSZ ssz hashes the encoded Fork object without decoding it
+func (f *Fork) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(f, buf)
 }
 
-// MarshalSSZ ssz marshals the Validator object
-func (v *Validator) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(v)
-}
+// HashTreeRootSSZWith ssz hashes the encoded Fork object with a hasher
+func (f *Fork) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 16 {
+		return ssz.ErrSize
+	}
 
-// MarshalSSZTo ssz marshals the Validator object to a target array
+	indx := hh.Index()
+
+	// Field (0) 'PreviousVersion'
+	hh.PutBytes(buf[0:4])
+
+	// Field (1) 'CurrentVersion'
+	hh.PutBytes(buf[4:8])
+
+	// Field (2) 'Epoch'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[8:16]))
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Fork object
+func (f *Fork) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(f)
+}
+
+const (
+	// ForkPreviousVersionGIndex is the generalized index of the 'PreviousVersion' field
+	ForkPreviousVersionGIndex ssz.GIndex = 4
+	// ForkCurrentVersionGIndex is the generalized index of the 'CurrentVersion' field
+	ForkCurrentVersionGIndex ssz.GIndex = 5
+	// ForkEpochGIndex is the generalized index of the 'Epoch' field
+	ForkEpochGIndex ssz.GIndex = 6
+)
+
+// ProvePreviousVersion returns a merkle proof of the 'PreviousVersion' field of the Fork object
+func (f *Fork) ProvePreviousVersion() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(f, ForkPreviousVersionGIndex)
+}
+
+// VerifyForkPreviousVersion verifies a merkle proof of the 'PreviousVersion' field of a Fork object
+func VerifyForkPreviousVersion(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ForkPreviousVersionGIndex)
+}
+
+// ProveCurrentVersion returns a merkle proof of the 'CurrentVersion' field of the Fork object
+func (f *Fork) ProveCurrentVersion() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(f, ForkCurrentVersionGIndex)
+}
+
+// VerifyForkCurrentVersion verifies a merkle proof of the 'CurrentVersion' field of a Fork object
+func VerifyForkCurrentVersion(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ForkCurrentVersionGIndex)
+}
+
+// ProveEpoch returns a merkle proof of the 'Epoch' field of the Fork object
+func (f *Fork) ProveEpoch() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(f, ForkEpochGIndex)
+}
+
+// VerifyForkEpoch verifies a merkle proof of the 'Epoch' field of a Fork object
+func VerifyForkEpoch(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ForkEpochGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the Fork object
+func (f *Fork) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "PreviousVersion", Schema: ssz.SchemaBytes(4)},
+		&ssz.SchemaField{Name: "CurrentVersion", Schema: ssz.SchemaBytes(4)},
+		&ssz.SchemaField{Name: "Epoch", Schema: ssz.SchemaUint(8)},
+	)
+}
+
+// MarshalSSZ ssz marshals the Validator object
+func (v *Validator) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the Validator object to a target array
 func (v *Validator) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
 
@@ -1159,8 +2511,57 @@ func (v *Validator) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the Validator object to a writer
+func (v *Validator) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(v, writer)
+}
+
+// MarshalSSZToStream ssz marshals the Validator object to a target array that is flushed to a stream writer
+func (v *Validator) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Pubkey'
+	if size := len(v.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("Validator.Pubkey", size, 48)
+		return
+	}
+	dst = append(dst, v.Pubkey...)
+
+	// Field (1) 'WithdrawalCredentials'
+	if size := len(v.WithdrawalCredentials); size != 32 {
+		err = ssz.ErrBytesLengthFn("Validator.WithdrawalCredentials", size, 32)
+		return
+	}
+	dst = append(dst, v.WithdrawalCredentials...)
+
+	// Field (2) 'EffectiveBalance'
+	dst = ssz.MarshalUint64(dst, v.EffectiveBalance)
+
+	// Field (3) 'Slashed'
+	dst = ssz.MarshalBool(dst, v.Slashed)
+
+	// Field (4) 'ActivationEligibilityEpoch'
+	dst = ssz.MarshalUint64(dst, v.ActivationEligibilityEpoch)
+
+	// Field (5) 'ActivationEpoch'
+	dst = ssz.MarshalUint64(dst, v.ActivationEpoch)
+
+	// Field (6) 'ExitEpoch'
+	dst = ssz.MarshalUint64(dst, v.ExitEpoch)
+
+	// Field (7) 'WithdrawableEpoch'
+	dst = ssz.MarshalUint64(dst, v.WithdrawableEpoch)
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the Validator object
 func (v *Validator) UnmarshalSSZ(buf []byte) error {
+	return v.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Validator object with the decoding options
+func (v *Validator) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 121 {
@@ -1183,6 +2584,9 @@ func (v *Validator) UnmarshalSSZ(buf []byte) error {
 	v.EffectiveBalance = ssz.UnmarshallUint64(buf[80:88])
 
 	// Field (3) 'Slashed'
+	if err = opts.ValidateBool(buf[88:89]); err != nil {
+		return err
+	}
 	v.Slashed = ssz.UnmarshalBool(buf[88:89])
 
 	// Field (4) 'ActivationEligibilityEpoch'
@@ -1206,6 +2610,16 @@ func (v *Validator) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Validator object
+func (v *Validator) MinSSZSize() uint64 {
+	return 121
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Validator object
+func (v *Validator) MaxSSZSize() uint64 {
+	return 121
+}
+
 // HashTreeRoot ssz hashes the Validator object
 func (v *Validator) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(v)
@@ -1251,11 +2665,169 @@ func (v *Validator) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded Validator object without decoding it
+func (v *Validator) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(v, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded Validator object with a hasher
+func (v *Validator) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 121 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Pubkey'
+	hh.PutBytes(buf[0:48])
+
+	// Field (1) 'WithdrawalCredentials'
+	hh.PutBytes(buf[48:80])
+
+	// Field (2) 'EffectiveBalance'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[80:88]))
+
+	// Field (3) 'Slashed'
+	if err = ssz.ValidateBool(buf[88:89]); err != nil {
+		return err
+	}
+	hh.PutBool(ssz.UnmarshalBool(buf[88:89]))
+
+	// Field (4) 'ActivationEligibilityEpoch'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[89:97]))
+
+	// Field (5) 'ActivationEpoch'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[97:105]))
+
+	// Field (6) 'ExitEpoch'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[105:113]))
+
+	// Field (7) 'WithdrawableEpoch'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[113:121]))
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the Validator object
 func (v *Validator) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(v)
 }
 
+const (
+	// ValidatorPubkeyGIndex is the generalized index of the 'Pubkey' field
+	ValidatorPubkeyGIndex ssz.GIndex = 8
+	// ValidatorWithdrawalCredentialsGIndex is the generalized index of the 'WithdrawalCredentials' field
+	ValidatorWithdrawalCredentialsGIndex ssz.GIndex = 9
+	// ValidatorEffectiveBalanceGIndex is the generalized index of the 'EffectiveBalance' field
+	ValidatorEffectiveBalanceGIndex ssz.GIndex = 10
+	// ValidatorSlashedGIndex is the generalized index of the 'Slashed' field
+	ValidatorSlashedGIndex ssz.GIndex = 11
+	// ValidatorActivationEligibilityEpochGIndex is the generalized index of the 'ActivationEligibilityEpoch' field
+	ValidatorActivationEligibilityEpochGIndex ssz.GIndex = 12
+	// ValidatorActivationEpochGIndex is the generalized index of the 'ActivationEpoch' field
+	ValidatorActivationEpochGIndex ssz.GIndex = 13
+	// ValidatorExitEpochGIndex is the generalized index of the 'ExitEpoch' field
+	ValidatorExitEpochGIndex ssz.GIndex = 14
+	// ValidatorWithdrawableEpochGIndex is the generalized index of the 'WithdrawableEpoch' field
+	ValidatorWithdrawableEpochGIndex ssz.GIndex = 15
+)
+
+// ProvePubkey returns a merkle proof of the 'Pubkey' field of the Validator object
+func (v *Validator) ProvePubkey() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(v, ValidatorPubkeyGIndex)
+}
+
+// VerifyValidatorPubkey verifies a merkle proof of the 'Pubkey' field of a Validator object
+func VerifyValidatorPubkey(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ValidatorPubkeyGIndex)
+}
+
+// ProveWithdrawalCredentials returns a merkle proof of the 'WithdrawalCredentials' field of the Validator object
+func (v *Validator) ProveWithdrawalCredentials() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(v, ValidatorWithdrawalCredentialsGIndex)
+}
+
+// VerifyValidatorWithdrawalCredentials verifies a merkle proof of the 'WithdrawalCredentials' field of a Validator object
+func VerifyValidatorWithdrawalCredentials(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ValidatorWithdrawalCredentialsGIndex)
+}
+
+// ProveEffectiveBalance returns a merkle proof of the 'EffectiveBalance' field of the Validator object
+func (v *Validator) ProveEffectiveBalance() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(v, ValidatorEffectiveBalanceGIndex)
+}
+
+// VerifyValidatorEffectiveBalance verifies a merkle proof of the 'EffectiveBalance' field of a Validator object
+func VerifyValidatorEffectiveBalance(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ValidatorEffectiveBalanceGIndex)
+}
+
+// ProveSlashed returns a merkle proof of the 'Slashed' field of the Validator object
+func (v *Validator) ProveSlashed() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(v, ValidatorSlashedGIndex)
+}
+
+// VerifyValidatorSlashed verifies a merkle proof of the 'Slashed' field of a Validator object
+func VerifyValidatorSlashed(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ValidatorSlashedGIndex)
+}
+
+// ProveActivationEligibilityEpoch returns a merkle proof of the 'ActivationEligibilityEpoch' field of the Validator object
+func (v *Validator) ProveActivationEligibilityEpoch() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(v, ValidatorActivationEligibilityEpochGIndex)
+}
+
+// VerifyValidatorActivationEligibilityEpoch verifies a merkle proof of the 'ActivationEligibilityEpoch' field of a Validator object
+func VerifyValidatorActivationEligibilityEpoch(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ValidatorActivationEligibilityEpochGIndex)
+}
+
+// ProveActivationEpoch returns a merkle proof of the 'ActivationEpoch' field of the Validator object
+func (v *Validator) ProveActivationEpoch() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(v, ValidatorActivationEpochGIndex)
+}
+
+// VerifyValidatorActivationEpoch verifies a merkle proof of the 'ActivationEpoch' field of a Validator object
+func VerifyValidatorActivationEpoch(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ValidatorActivationEpochGIndex)
+}
+
+// ProveExitEpoch returns a merkle proof of the 'ExitEpoch' field of the Validator object
+func (v *Validator) ProveExitEpoch() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(v, ValidatorExitEpochGIndex)
+}
+
+// VerifyValidatorExitEpoch verifies a merkle proof of the 'ExitEpoch' field of a Validator object
+func VerifyValidatorExitEpoch(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ValidatorExitEpochGIndex)
+}
+
+// ProveWithdrawableEpoch returns a merkle proof of the 'WithdrawableEpoch' field of the Validator object
+func (v *Validator) ProveWithdrawableEpoch() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(v, ValidatorWithdrawableEpochGIndex)
+}
+
+// VerifyValidatorWithdrawableEpoch verifies a merkle proof of the 'WithdrawableEpoch' field of a Validator object
+func VerifyValidatorWithdrawableEpoch(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ValidatorWithdrawableEpochGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the Validator object
+func (v *Validator) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Pubkey", Schema: ssz.SchemaBytes(48)},
+		&ssz.SchemaField{Name: "WithdrawalCredentials", Schema: ssz.SchemaBytes(32)},
+		&ssz.SchemaField{Name: "EffectiveBalance", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "Slashed", Schema: ssz.SchemaBool()},
+		&ssz.SchemaField{Name: "ActivationEligibilityEpoch", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "ActivationEpoch", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "ExitEpoch", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "WithdrawableEpoch", Schema: ssz.SchemaUint(8)},
+	)
+}
+
 // MarshalSSZ ssz marshals the VoluntaryExit object
 func (v *VoluntaryExit) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(v)
@@ -1274,8 +2846,31 @@ func (v *VoluntaryExit) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the VoluntaryExit object to a writer
+func (v *VoluntaryExit) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(v, writer)
+}
+
+// MarshalSSZToStream ssz marshals the VoluntaryExit object to a target array that is flushed to a stream writer
+func (v *VoluntaryExit) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Epoch'
+	dst = ssz.MarshalUint64(dst, v.Epoch)
+
+	// Field (1) 'ValidatorIndex'
+	dst = ssz.MarshalUint64(dst, v.ValidatorIndex)
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the VoluntaryExit object
 func (v *VoluntaryExit) UnmarshalSSZ(buf []byte) error {
+	return v.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the VoluntaryExit object with the decoding options
+func (v *VoluntaryExit) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 16 {
@@ -1297,6 +2892,16 @@ func (v *VoluntaryExit) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the VoluntaryExit object
+func (v *VoluntaryExit) MinSSZSize() uint64 {
+	return 16
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the VoluntaryExit object
+func (v *VoluntaryExit) MaxSSZSize() uint64 {
+	return 16
+}
+
 // HashTreeRoot ssz hashes the VoluntaryExit object
 func (v *VoluntaryExit) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(v)
@@ -1316,11 +2921,70 @@ func (v *VoluntaryExit) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded VoluntaryExit object without decoding it
+func (v *VoluntaryExit) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(v, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded VoluntaryExit object with a hasher
+func (v *VoluntaryExit) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 16 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Epoch'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'ValidatorIndex'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[8:16]))
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the VoluntaryExit object
 func (v *VoluntaryExit) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(v)
 }
 
+const (
+	// VoluntaryExitEpochGIndex is the generalized index of the 'Epoch' field
+	VoluntaryExitEpochGIndex ssz.GIndex = 2
+	// VoluntaryExitValidatorIndexGIndex is the generalized index of the 'ValidatorIndex' field
+	VoluntaryExitValidatorIndexGIndex ssz.GIndex = 3
+)
+
+// ProveEpoch returns a merkle proof of the 'Epoch' field of the VoluntaryExit object
+func (v *VoluntaryExit) ProveEpoch() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(v, VoluntaryExitEpochGIndex)
+}
+
+// VerifyVoluntaryExitEpoch verifies a merkle proof of the 'Epoch' field of a VoluntaryExit object
+func VerifyVoluntaryExitEpoch(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, VoluntaryExitEpochGIndex)
+}
+
+// ProveValidatorIndex returns a merkle proof of the 'ValidatorIndex' field of the VoluntaryExit object
+func (v *VoluntaryExit) ProveValidatorIndex() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(v, VoluntaryExitValidatorIndexGIndex)
+}
+
+// VerifyVoluntaryExitValidatorIndex verifies a merkle proof of the 'ValidatorIndex' field of a VoluntaryExit object
+func VerifyVoluntaryExitValidatorIndex(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, VoluntaryExitValidatorIndexGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the VoluntaryExit object
+func (v *VoluntaryExit) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Epoch", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "ValidatorIndex", Schema: ssz.SchemaUint(8)},
+	)
+}
+
 // MarshalSSZ ssz marshals the SignedVoluntaryExit object
 func (s *SignedVoluntaryExit) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(s)
@@ -1344,8 +3008,36 @@ func (s *SignedVoluntaryExit) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the SignedVoluntaryExit object to a writer
+func (s *SignedVoluntaryExit) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(s, writer)
+}
+
+// MarshalSSZToStream ssz marshals the SignedVoluntaryExit object to a target array that is flushed to a stream writer
+func (s *SignedVoluntaryExit) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Exit'
+	if s.Exit == nil {
+		s.Exit = new(VoluntaryExit)
+	}
+	if dst, err = s.Exit.MarshalSSZToStream(dst, sw); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	dst = append(dst, s.Signature[:]...)
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the SignedVoluntaryExit object
 func (s *SignedVoluntaryExit) UnmarshalSSZ(buf []byte) error {
+	return s.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the SignedVoluntaryExit object with the decoding options
+func (s *SignedVoluntaryExit) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 112 {
@@ -1356,7 +3048,7 @@ func (s *SignedVoluntaryExit) UnmarshalSSZ(buf []byte) error {
 	if s.Exit == nil {
 		s.Exit = new(VoluntaryExit)
 	}
-	if err = s.Exit.UnmarshalSSZ(buf[0:16]); err != nil {
+	if err = s.Exit.UnmarshalSSZWithOptions(buf[0:16], opts); err != nil {
 		return err
 	}
 
@@ -1372,6 +3064,16 @@ func (s *SignedVoluntaryExit) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the SignedVoluntaryExit object
+func (s *SignedVoluntaryExit) MinSSZSize() uint64 {
+	return 112
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the SignedVoluntaryExit object
+func (s *SignedVoluntaryExit) MaxSSZSize() uint64 {
+	return 112
+}
+
 // HashTreeRoot ssz hashes the SignedVoluntaryExit object
 func (s *SignedVoluntaryExit) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(s)
@@ -1396,11 +3098,72 @@ func (s *SignedVoluntaryExit) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded SignedVoluntaryExit object without decoding it
+func (s *SignedVoluntaryExit) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(s, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded SignedVoluntaryExit object with a hasher
+func (s *SignedVoluntaryExit) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 112 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Exit'
+	if err = (*VoluntaryExit)(nil).HashTreeRootSSZWith(hh, buf[0:16]); err != nil {
+		return err
+	}
+
+	// Field (1) 'Signature'
+	hh.PutBytes(buf[16:112])
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the SignedVoluntaryExit object
 func (s *SignedVoluntaryExit) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(s)
 }
 
+const (
+	// SignedVoluntaryExitExitGIndex is the generalized index of the 'Exit' field
+	SignedVoluntaryExitExitGIndex ssz.GIndex = 2
+	// SignedVoluntaryExitSignatureGIndex is the generalized index of the 'Signature' field
+	SignedVoluntaryExitSignatureGIndex ssz.GIndex = 3
+)
+
+// ProveExit returns a merkle proof of the 'Exit' field of the SignedVoluntaryExit object
+func (s *SignedVoluntaryExit) ProveExit() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(s, SignedVoluntaryExitExitGIndex)
+}
+
+// VerifySignedVoluntaryExitExit verifies a merkle proof of the 'Exit' field of a SignedVoluntaryExit object
+func VerifySignedVoluntaryExitExit(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, SignedVoluntaryExitExitGIndex)
+}
+
+// ProveSignature returns a merkle proof of the 'Signature' field of the SignedVoluntaryExit object
+func (s *SignedVoluntaryExit) ProveSignature() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(s, SignedVoluntaryExitSignatureGIndex)
+}
+
+// VerifySignedVoluntaryExitSignature verifies a merkle proof of the 'Signature' field of a SignedVoluntaryExit object
+func VerifySignedVoluntaryExitSignature(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, SignedVoluntaryExitSignatureGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the SignedVoluntaryExit object
+func (s *SignedVoluntaryExit) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Exit", Schema: (*VoluntaryExit)(nil).SchemaSSZ()},
+		&ssz.SchemaField{Name: "Signature", Schema: ssz.SchemaBytes(96)},
+	)
+}
+
 // MarshalSSZ ssz marshals the Eth1Block object
 func (e *Eth1Block) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(e)
@@ -1426,8 +3189,38 @@ func (e *Eth1Block) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the Eth1Block object
+// MarshalSSZToWriter ssz marshals the Eth1Block object to a writer
+func (e *Eth1Block) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(e, writer)
+}
+
+// MarshalSSZToStream ssz marshals the Eth1Block object to a target array that is flushed to a stream writer
+func (e *Eth1Block) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Timestamp'
+	dst = ssz.MarshalUint64(dst, e.Timestamp)
+
+	// Field (1) 'DepositRoot'
+	if size := len(e.DepositRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("Eth1Block.DepositRoot", size, 32)
+		return
+	}
+	dst = append(dst, e.DepositRoot...)
+
+	// Field (2) 'DepositCount'
+	dst = ssz.MarshalUint64(dst, e.DepositCount)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Eth1Block object
 func (e *Eth1Block) UnmarshalSSZ(buf []byte) error {
+	return e.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Eth1Block object with the decoding options
+func (e *Eth1Block) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 48 {
@@ -1455,6 +3248,16 @@ func (e *Eth1Block) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Eth1Block object
+func (e *Eth1Block) MinSSZSize() uint64 {
+	return 48
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Eth1Block object
+func (e *Eth1Block) MaxSSZSize() uint64 {
+	return 48
+}
+
 // HashTreeRoot ssz hashes the Eth1Block object
 func (e *Eth1Block) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(e)
@@ -1481,11 +3284,86 @@ func (e *Eth1Block) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded Eth1Block object without decoding it
+func (e *Eth1Block) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(e, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded Eth1Block object with a hasher
+func (e *Eth1Block) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 48 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Timestamp'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'DepositRoot'
+	hh.PutBytes(buf[8:40])
+
+	// Field (2) 'DepositCount'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[40:48]))
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the Eth1Block object
 func (e *Eth1Block) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(e)
 }
 
+const (
+	// Eth1BlockTimestampGIndex is the generalized index of the 'Timestamp' field
+	Eth1BlockTimestampGIndex ssz.GIndex = 4
+	// Eth1BlockDepositRootGIndex is the generalized index of the 'DepositRoot' field
+	Eth1BlockDepositRootGIndex ssz.GIndex = 5
+	// Eth1BlockDepositCountGIndex is the generalized index of the 'DepositCount' field
+	Eth1BlockDepositCountGIndex ssz.GIndex = 6
+)
+
+// ProveTimestamp returns a merkle proof of the 'Timestamp' field of the Eth1Block object
+func (e *Eth1Block) ProveTimestamp() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, Eth1BlockTimestampGIndex)
+}
+
+// VerifyEth1BlockTimestamp verifies a merkle proof of the 'Timestamp' field of a Eth1Block object
+func VerifyEth1BlockTimestamp(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Eth1BlockTimestampGIndex)
+}
+
+// ProveDepositRoot returns a merkle proof of the 'DepositRoot' field of the Eth1Block object
+func (e *Eth1Block) ProveDepositRoot() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, Eth1BlockDepositRootGIndex)
+}
+
+// VerifyEth1BlockDepositRoot verifies a merkle proof of the 'DepositRoot' field of a Eth1Block object
+func VerifyEth1BlockDepositRoot(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Eth1BlockDepositRootGIndex)
+}
+
+// ProveDepositCount returns a merkle proof of the 'DepositCount' field of the Eth1Block object
+func (e *Eth1Block) ProveDepositCount() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, Eth1BlockDepositCountGIndex)
+}
+
+// VerifyEth1BlockDepositCount verifies a merkle proof of the 'DepositCount' field of a Eth1Block object
+func VerifyEth1BlockDepositCount(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Eth1BlockDepositCountGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the Eth1Block object
+func (e *Eth1Block) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Timestamp", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "DepositRoot", Schema: ssz.SchemaBytes(32)},
+		&ssz.SchemaField{Name: "DepositCount", Schema: ssz.SchemaUint(8)},
+	)
+}
+
 // MarshalSSZ ssz marshals the Eth1Data object
 func (e *Eth1Data) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(e)
@@ -1515,8 +3393,42 @@ func (e *Eth1Data) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the Eth1Data object to a writer
+func (e *Eth1Data) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(e, writer)
+}
+
+// MarshalSSZToStream ssz marshals the Eth1Data object to a target array that is flushed to a stream writer
+func (e *Eth1Data) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'DepositRoot'
+	if size := len(e.DepositRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("Eth1Data.DepositRoot", size, 32)
+		return
+	}
+	dst = append(dst, e.DepositRoot...)
+
+	// Field (1) 'DepositCount'
+	dst = ssz.MarshalUint64(dst, e.DepositCount)
+
+	// Field (2) 'BlockHash'
+	if size := len(e.BlockHash); size != 32 {
+		err = ssz.ErrBytesLengthFn("Eth1Data.BlockHash", size, 32)
+		return
+	}
+	dst = append(dst, e.BlockHash...)
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the Eth1Data object
 func (e *Eth1Data) UnmarshalSSZ(buf []byte) error {
+	return e.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Eth1Data object with the decoding options
+func (e *Eth1Data) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 72 {
@@ -1547,6 +3459,16 @@ func (e *Eth1Data) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Eth1Data object
+func (e *Eth1Data) MinSSZSize() uint64 {
+	return 72
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Eth1Data object
+func (e *Eth1Data) MaxSSZSize() uint64 {
+	return 72
+}
+
 // HashTreeRoot ssz hashes the Eth1Data object
 func (e *Eth1Data) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(e)
@@ -1577,11 +3499,86 @@ func (e *Eth1Data) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded Eth1Data object without decoding it
+func (e *Eth1Data) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(e, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded Eth1Data object with a hasher
+func (e *Eth1Data) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 72 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'DepositRoot'
+	hh.PutBytes(buf[0:32])
+
+	// Field (1) 'DepositCount'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[32:40]))
+
+	// Field (2) 'BlockHash'
+	hh.PutBytes(buf[40:72])
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the Eth1Data object
 func (e *Eth1Data) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(e)
 }
 
+const (
+	// Eth1DataDepositRootGIndex is the generalized index of the 'DepositRoot' field
+	Eth1DataDepositRootGIndex ssz.GIndex = 4
+	// Eth1DataDepositCountGIndex is the generalized index of the 'DepositCount' field
+	Eth1DataDepositCountGIndex ssz.GIndex = 5
+	// Eth1DataBlockHashGIndex is the generalized index of the 'BlockHash' field
+	Eth1DataBlockHashGIndex ssz.GIndex = 6
+)
+
+// ProveDepositRoot returns a merkle proof of the 'DepositRoot' field of the Eth1Data object
+func (e *Eth1Data) ProveDepositRoot() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, Eth1DataDepositRootGIndex)
+}
+
+// VerifyEth1DataDepositRoot verifies a merkle proof of the 'DepositRoot' field of a Eth1Data object
+func VerifyEth1DataDepositRoot(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Eth1DataDepositRootGIndex)
+}
+
+// ProveDepositCount returns a merkle proof of the 'DepositCount' field of the Eth1Data object
+func (e *Eth1Data) ProveDepositCount() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, Eth1DataDepositCountGIndex)
+}
+
+// VerifyEth1DataDepositCount verifies a merkle proof of the 'DepositCount' field of a Eth1Data object
+func VerifyEth1DataDepositCount(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Eth1DataDepositCountGIndex)
+}
+
+// ProveBlockHash returns a merkle proof of the 'BlockHash' field of the Eth1Data object
+func (e *Eth1Data) ProveBlockHash() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, Eth1DataBlockHashGIndex)
+}
+
+// VerifyEth1DataBlockHash verifies a merkle proof of the 'BlockHash' field of a Eth1Data object
+func VerifyEth1DataBlockHash(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Eth1DataBlockHashGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the Eth1Data object
+func (e *Eth1Data) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "DepositRoot", Schema: ssz.SchemaBytes(32)},
+		&ssz.SchemaField{Name: "DepositCount", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "BlockHash", Schema: ssz.SchemaBytes(32)},
+	)
+}
+
 // MarshalSSZ ssz marshals the SigningRoot object
 func (s *SigningRoot) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(s)
@@ -1608,8 +3605,39 @@ func (s *SigningRoot) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the SigningRoot object to a writer
+func (s *SigningRoot) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(s, writer)
+}
+
+// MarshalSSZToStream ssz marshals the SigningRoot object to a target array that is flushed to a stream writer
+func (s *SigningRoot) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'ObjectRoot'
+	if size := len(s.ObjectRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("SigningRoot.ObjectRoot", size, 32)
+		return
+	}
+	dst = append(dst, s.ObjectRoot...)
+
+	// Field (1) 'Domain'
+	if size := len(s.Domain); size != 8 {
+		err = ssz.ErrBytesLengthFn("SigningRoot.Domain", size, 8)
+		return
+	}
+	dst = append(dst, s.Domain...)
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the SigningRoot object
 func (s *SigningRoot) UnmarshalSSZ(buf []byte) error {
+	return s.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the SigningRoot object with the decoding options
+func (s *SigningRoot) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 40 {
@@ -1637,6 +3665,16 @@ func (s *SigningRoot) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the SigningRoot object
+func (s *SigningRoot) MinSSZSize() uint64 {
+	return 40
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the SigningRoot object
+func (s *SigningRoot) MaxSSZSize() uint64 {
+	return 40
+}
+
 // HashTreeRoot ssz hashes the SigningRoot object
 func (s *SigningRoot) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(s)
@@ -1664,11 +3702,70 @@ func (s *SigningRoot) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded SigningRoot object without decoding it
+func (s *SigningRoot) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(s, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded SigningRoot object with a hasher
+func (s *SigningRoot) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 40 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'ObjectRoot'
+	hh.PutBytes(buf[0:32])
+
+	// Field (1) 'Domain'
+	hh.PutBytes(buf[32:40])
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the SigningRoot object
 func (s *SigningRoot) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(s)
 }
 
+const (
+	// SigningRootObjectRootGIndex is the generalized index of the 'ObjectRoot' field
+	SigningRootObjectRootGIndex ssz.GIndex = 2
+	// SigningRootDomainGIndex is the generalized index of the 'Domain' field
+	SigningRootDomainGIndex ssz.GIndex = 3
+)
+
+// ProveObjectRoot returns a merkle proof of the 'ObjectRoot' field of the SigningRoot object
+func (s *SigningRoot) ProveObjectRoot() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(s, SigningRootObjectRootGIndex)
+}
+
+// VerifySigningRootObjectRoot verifies a merkle proof of the 'ObjectRoot' field of a SigningRoot object
+func VerifySigningRootObjectRoot(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, SigningRootObjectRootGIndex)
+}
+
+// ProveDomain returns a merkle proof of the 'Domain' field of the SigningRoot object
+func (s *SigningRoot) ProveDomain() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(s, SigningRootDomainGIndex)
+}
+
+// VerifySigningRootDomain verifies a merkle proof of the 'Domain' field of a SigningRoot object
+func VerifySigningRootDomain(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, SigningRootDomainGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the SigningRoot object
+func (s *SigningRoot) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "ObjectRoot", Schema: ssz.SchemaBytes(32)},
+		&ssz.SchemaField{Name: "Domain", Schema: ssz.SchemaBytes(8)},
+	)
+}
+
 // MarshalSSZ ssz marshals the HistoricalBatch object
 func (h *HistoricalBatch) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(h)
@@ -1685,6 +3782,41 @@ func (h *HistoricalBatch) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	}
 	for ii := 0; ii < 8192; ii++ {
 		dst = append(dst, h.BlockRoots[ii][:]...)
+
+	}
+
+	// Field (1) 'StateRoots'
+	if size := len(h.StateRoots); size != 8192 {
+		err = ssz.ErrVectorLengthFn("HistoricalBatch.StateRoots", size, 8192)
+		return
+	}
+	for ii := 0; ii < 8192; ii++ {
+		dst = append(dst, h.StateRoots[ii][:]...)
+
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the HistoricalBatch object to a writer
+func (h *HistoricalBatch) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(h, writer)
+}
+
+// MarshalSSZToStream ssz marshals the HistoricalBatch object to a target array that is flushed to a stream writer
+func (h *HistoricalBatch) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'BlockRoots'
+	if size := len(h.BlockRoots); size != 8192 {
+		err = ssz.ErrVectorLengthFn("HistoricalBatch.BlockRoots", size, 8192)
+		return
+	}
+	for ii := 0; ii < 8192; ii++ {
+		dst = append(dst, h.BlockRoots[ii][:]...)
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
 	}
 
 	// Field (1) 'StateRoots'
@@ -1694,6 +3826,9 @@ func (h *HistoricalBatch) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	}
 	for ii := 0; ii < 8192; ii++ {
 		dst = append(dst, h.StateRoots[ii][:]...)
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
 	}
 
 	return
@@ -1701,6 +3836,11 @@ func (h *HistoricalBatch) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the HistoricalBatch object
 func (h *HistoricalBatch) UnmarshalSSZ(buf []byte) error {
+	return h.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the HistoricalBatch object with the decoding options
+func (h *HistoricalBatch) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 524288 {
@@ -1728,6 +3868,16 @@ func (h *HistoricalBatch) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the HistoricalBatch object
+func (h *HistoricalBatch) MinSSZSize() uint64 {
+	return 524288
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the HistoricalBatch object
+func (h *HistoricalBatch) MaxSSZSize() uint64 {
+	return 524288
+}
+
 // HashTreeRoot ssz hashes the HistoricalBatch object
 func (h *HistoricalBatch) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(h)
@@ -1767,34 +3917,129 @@ func (h *HistoricalBatch) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
-// GetTree ssz hashes the HistoricalBatch object
-func (h *HistoricalBatch) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(h)
+// HashTreeRootSSZ ssz hashes the encoded HistoricalBatch object without decoding it
+func (h *HistoricalBatch) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(h, buf)
 }
 
-// MarshalSSZ ssz marshals the ProposerSlashing object
-func (p *ProposerSlashing) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(p)
-}
+// HashTreeRootSSZWith ssz hashes the encoded HistoricalBatch object with a hasher
+func (h *HistoricalBatch) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 524288 {
+		return ssz.ErrSize
+	}
 
-// MarshalSSZTo ssz marshals the ProposerSlashing object to a target array
-func (p *ProposerSlashing) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+	indx := hh.Index()
 
-	// Field (0) 'Header1'
-	if p.Header1 == nil {
-		p.Header1 = new(SignedBeaconBlockHeader)
-	}
-	if dst, err = p.Header1.MarshalSSZTo(dst); err != nil {
-		return
+	// Field (0) 'BlockRoots'
+	{
+		subIndx := hh.Index()
+		hh.Append(buf[0:262144])
+		hh.Merkleize(subIndx)
 	}
 
-	// Field (1) 'Header2'
-	if p.Header2 == nil {
-		p.Header2 = new(SignedBeaconBlockHeader)
-	}
-	if dst, err = p.Header2.MarshalSSZTo(dst); err != nil {
-		return
+	// Field (1) 'StateRoots'
+	{
+		subIndx := hh.Index()
+		hh.Append(buf[262144:524288])
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the HistoricalBatch object
+func (h *HistoricalBatch) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(h)
+}
+
+const (
+	// HistoricalBatchBlockRootsGIndex is the generalized index of the 'BlockRoots' field
+	HistoricalBatchBlockRootsGIndex ssz.GIndex = 2
+	// HistoricalBatchStateRootsGIndex is the generalized index of the 'StateRoots' field
+	HistoricalBatchStateRootsGIndex ssz.GIndex = 3
+)
+
+// ProveBlockRoots returns a merkle proof of the 'BlockRoots' field of the HistoricalBatch object
+func (h *HistoricalBatch) ProveBlockRoots() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(h, HistoricalBatchBlockRootsGIndex)
+}
+
+// VerifyHistoricalBatchBlockRoots verifies a merkle proof of the 'BlockRoots' field of a HistoricalBatch object
+func VerifyHistoricalBatchBlockRoots(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, HistoricalBatchBlockRootsGIndex)
+}
+
+// ProveStateRoots returns a merkle proof of the 'StateRoots' field of the HistoricalBatch object
+func (h *HistoricalBatch) ProveStateRoots() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(h, HistoricalBatchStateRootsGIndex)
+}
+
+// VerifyHistoricalBatchStateRoots verifies a merkle proof of the 'StateRoots' field of a HistoricalBatch object
+func VerifyHistoricalBatchStateRoots(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, HistoricalBatchStateRootsGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the HistoricalBatch object
+func (h *HistoricalBatch) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "BlockRoots", Schema: ssz.SchemaVector(ssz.SchemaBytes(32), 8192)},
+		&ssz.SchemaField{Name: "StateRoots", Schema: ssz.SchemaVector(ssz.SchemaBytes(32), 8192)},
+	)
+}
+
+// MarshalSSZ ssz marshals the ProposerSlashing object
+func (p *ProposerSlashing) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(p)
+}
+
+// MarshalSSZTo ssz marshals the ProposerSlashing object to a target array
+func (p *ProposerSlashing) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Header1'
+	if p.Header1 == nil {
+		p.Header1 = new(SignedBeaconBlockHeader)
+	}
+	if dst, err = p.Header1.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Header2'
+	if p.Header2 == nil {
+		p.Header2 = new(SignedBeaconBlockHeader)
+	}
+	if dst, err = p.Header2.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the ProposerSlashing object to a writer
+func (p *ProposerSlashing) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(p, writer)
+}
+
+// MarshalSSZToStream ssz marshals the ProposerSlashing object to a target array that is flushed to a stream writer
+func (p *ProposerSlashing) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Header1'
+	if p.Header1 == nil {
+		p.Header1 = new(SignedBeaconBlockHeader)
+	}
+	if dst, err = p.Header1.MarshalSSZToStream(dst, sw); err != nil {
+		return
+	}
+
+	// Field (1) 'Header2'
+	if p.Header2 == nil {
+		p.Header2 = new(SignedBeaconBlockHeader)
+	}
+	if dst, err = p.Header2.MarshalSSZToStream(dst, sw); err != nil {
+		return
 	}
 
 	return
@@ -1802,6 +4047,11 @@ func (p *ProposerSlashing) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the ProposerSlashing object
 func (p *ProposerSlashing) UnmarshalSSZ(buf []byte) error {
+	return p.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the ProposerSlashing object with the decoding options
+func (p *ProposerSlashing) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 416 {
@@ -1812,7 +4062,7 @@ func (p *ProposerSlashing) UnmarshalSSZ(buf []byte) error {
 	if p.Header1 == nil {
 		p.Header1 = new(SignedBeaconBlockHeader)
 	}
-	if err = p.Header1.UnmarshalSSZ(buf[0:208]); err != nil {
+	if err = p.Header1.UnmarshalSSZWithOptions(buf[0:208], opts); err != nil {
 		return err
 	}
 
@@ -1820,7 +4070,7 @@ func (p *ProposerSlashing) UnmarshalSSZ(buf []byte) error {
 	if p.Header2 == nil {
 		p.Header2 = new(SignedBeaconBlockHeader)
 	}
-	if err = p.Header2.UnmarshalSSZ(buf[208:416]); err != nil {
+	if err = p.Header2.UnmarshalSSZWithOptions(buf[208:416], opts); err != nil {
 		return err
 	}
 
@@ -1833,6 +4083,16 @@ func (p *ProposerSlashing) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the ProposerSlashing object
+func (p *ProposerSlashing) MinSSZSize() uint64 {
+	return 416
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the ProposerSlashing object
+func (p *ProposerSlashing) MaxSSZSize() uint64 {
+	return 416
+}
+
 // HashTreeRoot ssz hashes the ProposerSlashing object
 func (p *ProposerSlashing) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(p)
@@ -1862,11 +4122,74 @@ func (p *ProposerSlashing) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded ProposerSlashing object without decoding it
+func (p *ProposerSlashing) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(p, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded ProposerSlashing object with a hasher
+func (p *ProposerSlashing) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 416 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Header1'
+	if err = (*SignedBeaconBlockHeader)(nil).HashTreeRootSSZWith(hh, buf[0:208]); err != nil {
+		return err
+	}
+
+	// Field (1) 'Header2'
+	if err = (*SignedBeaconBlockHeader)(nil).HashTreeRootSSZWith(hh, buf[208:416]); err != nil {
+		return err
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the ProposerSlashing object
 func (p *ProposerSlashing) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(p)
 }
 
+const (
+	// ProposerSlashingHeader1GIndex is the generalized index of the 'Header1' field
+	ProposerSlashingHeader1GIndex ssz.GIndex = 2
+	// ProposerSlashingHeader2GIndex is the generalized index of the 'Header2' field
+	ProposerSlashingHeader2GIndex ssz.GIndex = 3
+)
+
+// ProveHeader1 returns a merkle proof of the 'Header1' field of the ProposerSlashing object
+func (p *ProposerSlashing) ProveHeader1() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(p, ProposerSlashingHeader1GIndex)
+}
+
+// VerifyProposerSlashingHeader1 verifies a merkle proof of the 'Header1' field of a ProposerSlashing object
+func VerifyProposerSlashingHeader1(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ProposerSlashingHeader1GIndex)
+}
+
+// ProveHeader2 returns a merkle proof of the 'Header2' field of the ProposerSlashing object
+func (p *ProposerSlashing) ProveHeader2() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(p, ProposerSlashingHeader2GIndex)
+}
+
+// VerifyProposerSlashingHeader2 verifies a merkle proof of the 'Header2' field of a ProposerSlashing object
+func VerifyProposerSlashingHeader2(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ProposerSlashingHeader2GIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the ProposerSlashing object
+func (p *ProposerSlashing) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Header1", Schema: (*SignedBeaconBlockHeader)(nil).SchemaSSZ()},
+		&ssz.SchemaField{Name: "Header2", Schema: (*SignedBeaconBlockHeader)(nil).SchemaSSZ()},
+	)
+}
+
 // MarshalSSZ ssz marshals the AttesterSlashing object
 func (a *AttesterSlashing) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(a)
@@ -1900,8 +4223,46 @@ func (a *AttesterSlashing) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the AttesterSlashing object to a writer
+func (a *AttesterSlashing) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(a, writer)
+}
+
+// MarshalSSZToStream ssz marshals the AttesterSlashing object to a target array that is flushed to a stream writer
+func (a *AttesterSlashing) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(8)
+
+	// Offset (0) 'Attestation1'
+	dst = ssz.WriteOffset(dst, offset)
+	if a.Attestation1 == nil {
+		a.Attestation1 = new(IndexedAttestation)
+	}
+	offset += a.Attestation1.SizeSSZ()
+
+	// Offset (1) 'Attestation2'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Attestation1'
+	if dst, err = a.Attestation1.MarshalSSZToStream(dst, sw); err != nil {
+		return
+	}
+
+	// Field (1) 'Attestation2'
+	if dst, err = a.Attestation2.MarshalSSZToStream(dst, sw); err != nil {
+		return
+	}
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the AttesterSlashing object
 func (a *AttesterSlashing) UnmarshalSSZ(buf []byte) error {
+	return a.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the AttesterSlashing object with the decoding options
+func (a *AttesterSlashing) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size < 8 {
@@ -1916,8 +4277,8 @@ func (a *AttesterSlashing) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 8 {
-		return ssz.ErrInvalidVariableOffset
+	if err = opts.ValidateFirstOffset(o0, 8); err != nil {
+		return err
 	}
 
 	// Offset (1) 'Attestation2'
@@ -1931,7 +4292,7 @@ func (a *AttesterSlashing) UnmarshalSSZ(buf []byte) error {
 		if a.Attestation1 == nil {
 			a.Attestation1 = new(IndexedAttestation)
 		}
-		if err = a.Attestation1.UnmarshalSSZ(buf); err != nil {
+		if err = a.Attestation1.UnmarshalSSZWithOptions(buf, opts); err != nil {
 			return err
 		}
 	}
@@ -1942,7 +4303,7 @@ func (a *AttesterSlashing) UnmarshalSSZ(buf []byte) error {
 		if a.Attestation2 == nil {
 			a.Attestation2 = new(IndexedAttestation)
 		}
-		if err = a.Attestation2.UnmarshalSSZ(buf); err != nil {
+		if err = a.Attestation2.UnmarshalSSZWithOptions(buf, opts); err != nil {
 			return err
 		}
 	}
@@ -1968,6 +4329,16 @@ func (a *AttesterSlashing) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the AttesterSlashing object
+func (a *AttesterSlashing) MinSSZSize() uint64 {
+	return 464
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the AttesterSlashing object
+func (a *AttesterSlashing) MaxSSZSize() uint64 {
+	return 33232
+}
+
 // HashTreeRoot ssz hashes the AttesterSlashing object
 func (a *AttesterSlashing) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(a)
@@ -1991,1453 +4362,14602 @@ func (a *AttesterSlashing) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
-// GetTree ssz hashes the AttesterSlashing object
-func (a *AttesterSlashing) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(a)
-}
-
-// MarshalSSZ ssz marshals the BeaconBlock object
-func (b *BeaconBlock) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(b)
-}
-
-// MarshalSSZTo ssz marshals the BeaconBlock object to a target array
-func (b *BeaconBlock) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := int(84)
-
-	// Field (0) 'Slot'
-	dst = ssz.MarshalUint64(dst, b.Slot)
-
-	// Field (1) 'ProposerIndex'
-	dst = ssz.MarshalUint64(dst, b.ProposerIndex)
-
-	// Field (2) 'ParentRoot'
-	if size := len(b.ParentRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("BeaconBlock.ParentRoot", size, 32)
-		return
-	}
-	dst = append(dst, b.ParentRoot...)
-
-	// Field (3) 'StateRoot'
-	if size := len(b.StateRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("BeaconBlock.StateRoot", size, 32)
-		return
-	}
-	dst = append(dst, b.StateRoot...)
-
-	// Offset (4) 'Body'
-	dst = ssz.WriteOffset(dst, offset)
-
-	// Field (4) 'Body'
-	if dst, err = b.Body.MarshalSSZTo(dst); err != nil {
-		return
-	}
-
-	return
+// HashTreeRootSSZ ssz hashes the encoded AttesterSlashing object without decoding it
+func (a *AttesterSlashing) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(a, buf)
 }
 
-// UnmarshalSSZ ssz unmarshals the BeaconBlock object
-func (b *BeaconBlock) UnmarshalSSZ(buf []byte) error {
-	var err error
+// HashTreeRootSSZWith ssz hashes the encoded AttesterSlashing object with a hasher
+func (a *AttesterSlashing) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
 	size := uint64(len(buf))
-	if size < 84 {
+	if size < 8 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o4 uint64
-
-	// Field (0) 'Slot'
-	b.Slot = ssz.UnmarshallUint64(buf[0:8])
-
-	// Field (1) 'ProposerIndex'
-	b.ProposerIndex = ssz.UnmarshallUint64(buf[8:16])
+	var o0, o1 uint64
 
-	// Field (2) 'ParentRoot'
-	if cap(b.ParentRoot) == 0 {
-		b.ParentRoot = make([]byte, 0, len(buf[16:48]))
+	// Offset (0) 'Attestation1'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
 	}
-	b.ParentRoot = append(b.ParentRoot, buf[16:48]...)
 
-	// Field (3) 'StateRoot'
-	if cap(b.StateRoot) == 0 {
-		b.StateRoot = make([]byte, 0, len(buf[48:80]))
+	if err = ssz.ValidateFirstOffset(o0, 8); err != nil {
+		return err
 	}
-	b.StateRoot = append(b.StateRoot, buf[48:80]...)
 
-	// Offset (4) 'Body'
-	if o4 = ssz.ReadOffset(buf[80:84]); o4 > size {
+	// Offset (1) 'Attestation2'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
 		return ssz.ErrOffset
 	}
 
-	if o4 < 84 {
-		return ssz.ErrInvalidVariableOffset
-	}
+	indx := hh.Index()
 
-	// Field (4) 'Body'
+	// Field (0) 'Attestation1'
 	{
-		buf = tail[o4:]
-		if b.Body == nil {
-			b.Body = new(BeaconBlockBodyPhase0)
-		}
-		if err = b.Body.UnmarshalSSZ(buf); err != nil {
+		buf := tail[o0:o1]
+		if err = (*IndexedAttestation)(nil).HashTreeRootSSZWith(hh, buf); err != nil {
 			return err
 		}
 	}
-	return err
-}
 
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlock object
-func (b *BeaconBlock) SizeSSZ() (size int) {
-	size = 84
-
-	// Field (4) 'Body'
-	if b.Body == nil {
-		b.Body = new(BeaconBlockBodyPhase0)
+	// Field (1) 'Attestation2'
+	{
+		buf := tail[o1:]
+		if err = (*IndexedAttestation)(nil).HashTreeRootSSZWith(hh, buf); err != nil {
+			return err
+		}
 	}
-	size += b.Body.SizeSSZ()
 
+	hh.Merkleize(indx)
 	return
 }
 
-// HashTreeRoot ssz hashes the BeaconBlock object
-func (b *BeaconBlock) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(b)
+// GetTree ssz hashes the AttesterSlashing object
+func (a *AttesterSlashing) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(a)
 }
 
-// HashTreeRootWith ssz hashes the BeaconBlock object with a hasher
-func (b *BeaconBlock) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
-
-	// Field (0) 'Slot'
-	hh.PutUint64(b.Slot)
-
-	// Field (1) 'ProposerIndex'
-	hh.PutUint64(b.ProposerIndex)
-
-	// Field (2) 'ParentRoot'
-	if size := len(b.ParentRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("BeaconBlock.ParentRoot", size, 32)
-		return
-	}
-	hh.PutBytes(b.ParentRoot)
+const (
+	// AttesterSlashingAttestation1GIndex is the generalized index of the 'Attestation1' field
+	AttesterSlashingAttestation1GIndex ssz.GIndex = 2
+	// AttesterSlashingAttestation2GIndex is the generalized index of the 'Attestation2' field
+	AttesterSlashingAttestation2GIndex ssz.GIndex = 3
+)
 
-	// Field (3) 'StateRoot'
-	if size := len(b.StateRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("BeaconBlock.StateRoot", size, 32)
-		return
-	}
-	hh.PutBytes(b.StateRoot)
+// ProveAttestation1 returns a merkle proof of the 'Attestation1' field of the AttesterSlashing object
+func (a *AttesterSlashing) ProveAttestation1() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(a, AttesterSlashingAttestation1GIndex)
+}
 
-	// Field (4) 'Body'
-	if err = b.Body.HashTreeRootWith(hh); err != nil {
-		return
-	}
+// VerifyAttesterSlashingAttestation1 verifies a merkle proof of the 'Attestation1' field of a AttesterSlashing object
+func VerifyAttesterSlashingAttestation1(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, AttesterSlashingAttestation1GIndex)
+}
 
-	hh.Merkleize(indx)
-	return
+// ProveAttestation2 returns a merkle proof of the 'Attestation2' field of the AttesterSlashing object
+func (a *AttesterSlashing) ProveAttestation2() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(a, AttesterSlashingAttestation2GIndex)
 }
 
-// GetTree ssz hashes the BeaconBlock object
-func (b *BeaconBlock) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(b)
+// VerifyAttesterSlashingAttestation2 verifies a merkle proof of the 'Attestation2' field of a AttesterSlashing object
+func VerifyAttesterSlashingAttestation2(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, AttesterSlashingAttestation2GIndex)
 }
 
-// MarshalSSZ ssz marshals the SignedBeaconBlock object
-func (s *SignedBeaconBlock) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(s)
+// SchemaSSZ returns the schema of the tree of the AttesterSlashing object
+func (a *AttesterSlashing) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Attestation1", Schema: (*IndexedAttestation)(nil).SchemaSSZ()},
+		&ssz.SchemaField{Name: "Attestation2", Schema: (*IndexedAttestation)(nil).SchemaSSZ()},
+	)
 }
 
-// MarshalSSZTo ssz marshals the SignedBeaconBlock object to a target array
-func (s *SignedBeaconBlock) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZ ssz marshals the BeaconBlock object
+func (b *BeaconBlock) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BeaconBlock object to a target array
+func (b *BeaconBlock) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	return b.MarshalSSZToFork(buf, ssz.ForkDeneb)
+}
+
+// MarshalSSZFork ssz marshals the BeaconBlock object for a given fork
+func (b *BeaconBlock) MarshalSSZFork(fork ssz.Fork) ([]byte, error) {
+	return ssz.MarshalSSZFork(b, fork)
+}
+
+// MarshalSSZToFork ssz marshals the BeaconBlock object to a target array for a given fork
+func (b *BeaconBlock) MarshalSSZToFork(buf []byte, fork ssz.Fork) (dst []byte, err error) {
 	dst = buf
-	offset := int(100)
+	switch {
+	default:
+		offset := int(84)
+		// Field (0) 'Slot'
+		dst = ssz.MarshalUint64(dst, b.Slot)
 
-	// Offset (0) 'Block'
-	dst = ssz.WriteOffset(dst, offset)
+		// Field (1) 'ProposerIndex'
+		dst = ssz.MarshalUint64(dst, b.ProposerIndex)
 
-	// Field (1) 'Signature'
-	if size := len(s.Signature); size != 96 {
-		err = ssz.ErrBytesLengthFn("SignedBeaconBlock.Signature", size, 96)
-		return
-	}
-	dst = append(dst, s.Signature...)
+		// Field (2) 'ParentRoot'
+		dst = append(dst, b.ParentRoot[:]...)
+
+		// Field (3) 'StateRoot'
+		dst = append(dst, b.StateRoot[:]...)
+
+		// Offset (4) 'Body'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (4) 'Body'
+		if dst, err = b.Body.MarshalSSZToFork(dst, fork); err != nil {
+			return
+		}
 
-	// Field (0) 'Block'
-	if dst, err = s.Block.MarshalSSZTo(dst); err != nil {
-		return
 	}
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the BeaconBlock object to a writer
+func (b *BeaconBlock) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(b, writer)
+}
+
+// MarshalSSZToStream ssz marshals the BeaconBlock object to a target array that is flushed to a stream writer
+func (b *BeaconBlock) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	return b.MarshalSSZToStreamFork(buf, sw, ssz.ForkDeneb)
+}
+
+// MarshalSSZToWriterFork ssz marshals the BeaconBlock object to a writer for a given fork
+func (b *BeaconBlock) MarshalSSZToWriterFork(writer io.Writer, fork ssz.Fork) (int, error) {
+	return ssz.MarshalSSZToWriterFork(b, writer, fork)
+}
 
+// MarshalSSZToStreamFork ssz marshals the BeaconBlock object to a target array that is flushed to a stream writer for a given fork
+func (b *BeaconBlock) MarshalSSZToStreamFork(buf []byte, sw *ssz.StreamWriter, fork ssz.Fork) (dst []byte, err error) {
+	dst = buf
+	switch {
+	default:
+		offset := int(84)
+		// Field (0) 'Slot'
+		dst = ssz.MarshalUint64(dst, b.Slot)
+
+		// Field (1) 'ProposerIndex'
+		dst = ssz.MarshalUint64(dst, b.ProposerIndex)
+
+		// Field (2) 'ParentRoot'
+		dst = append(dst, b.ParentRoot[:]...)
+
+		// Field (3) 'StateRoot'
+		dst = append(dst, b.StateRoot[:]...)
+
+		// Offset (4) 'Body'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (4) 'Body'
+		if dst, err = b.Body.MarshalSSZToStreamFork(dst, sw, fork); err != nil {
+			return
+		}
+
+	}
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the SignedBeaconBlock object
-func (s *SignedBeaconBlock) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the BeaconBlock object
+func (b *BeaconBlock) UnmarshalSSZ(buf []byte) error {
+	return b.UnmarshalSSZForkWithOptions(buf, ssz.ForkDeneb, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the BeaconBlock object with the decoding options
+func (b *BeaconBlock) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	return b.UnmarshalSSZForkWithOptions(buf, ssz.ForkDeneb, opts)
+}
+
+// UnmarshalSSZFork ssz unmarshals the BeaconBlock object for a given fork
+func (b *BeaconBlock) UnmarshalSSZFork(buf []byte, fork ssz.Fork) error {
+	return b.UnmarshalSSZForkWithOptions(buf, fork, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZForkWithOptions ssz unmarshals the BeaconBlock object for a given fork with the decoding options
+func (b *BeaconBlock) UnmarshalSSZForkWithOptions(buf []byte, fork ssz.Fork, opts ssz.UnmarshalOptions) error {
 	var err error
-	size := uint64(len(buf))
-	if size < 100 {
-		return ssz.ErrSize
-	}
+	switch {
+	default:
+		size := uint64(len(buf))
+		if size < 84 {
+			return ssz.ErrSize
+		}
 
-	tail := buf
-	var o0 uint64
+		tail := buf
+		var o4 uint64
 
-	// Offset (0) 'Block'
-	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
-		return ssz.ErrOffset
-	}
+		// Field (0) 'Slot'
+		b.Slot = ssz.UnmarshallUint64(buf[0:8])
 
-	if o0 < 100 {
-		return ssz.ErrInvalidVariableOffset
-	}
+		// Field (1) 'ProposerIndex'
+		b.ProposerIndex = ssz.UnmarshallUint64(buf[8:16])
 
-	// Field (1) 'Signature'
-	if cap(s.Signature) == 0 {
-		s.Signature = make([]byte, 0, len(buf[4:100]))
-	}
-	s.Signature = append(s.Signature, buf[4:100]...)
+		// Field (2) 'ParentRoot'
+		copy(b.ParentRoot[:], buf[16:48])
 
-	// Field (0) 'Block'
-	{
-		buf = tail[o0:]
-		if s.Block == nil {
-			s.Block = new(BeaconBlock)
+		// Field (3) 'StateRoot'
+		copy(b.StateRoot[:], buf[48:80])
+
+		// Offset (4) 'Body'
+		if o4 = ssz.ReadOffset(buf[80:84]); o4 > size {
+			return ssz.ErrOffset
 		}
-		if err = s.Block.UnmarshalSSZ(buf); err != nil {
+
+		if err = opts.ValidateFirstOffset(o4, 84); err != nil {
 			return err
 		}
+
+		// Field (4) 'Body'
+		{
+			buf = tail[o4:]
+			if b.Body == nil {
+				b.Body = new(BeaconBlockBody)
+			}
+			if err = b.Body.UnmarshalSSZForkWithOptions(buf, fork, opts); err != nil {
+				return err
+			}
+		}
 	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the SignedBeaconBlock object
-func (s *SignedBeaconBlock) SizeSSZ() (size int) {
-	size = 100
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlock object
+func (b *BeaconBlock) SizeSSZ() (size int) {
+	return b.SizeSSZFork(ssz.ForkDeneb)
+}
 
-	// Field (0) 'Block'
-	if s.Block == nil {
-		s.Block = new(BeaconBlock)
+// SizeSSZFork returns the ssz encoded size in bytes for the BeaconBlock object for a given fork
+func (b *BeaconBlock) SizeSSZFork(fork ssz.Fork) (size int) {
+	switch {
+	default:
+		size = 84
+		// Field (4) 'Body'
+		if b.Body == nil {
+			b.Body = new(BeaconBlockBody)
+		}
+		size += b.Body.SizeSSZFork(fork)
 	}
-	size += s.Block.SizeSSZ()
-
 	return
 }
 
-// HashTreeRoot ssz hashes the SignedBeaconBlock object
-func (s *SignedBeaconBlock) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(s)
+// MinSSZSize returns the minimum ssz encoded size in bytes for the BeaconBlock object
+func (b *BeaconBlock) MinSSZSize() uint64 {
+	return b.MinSSZSizeFork(ssz.ForkDeneb)
 }
 
-// HashTreeRootWith ssz hashes the SignedBeaconBlock object with a hasher
-func (s *SignedBeaconBlock) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
-
-	// Field (0) 'Block'
-	if err = s.Block.HashTreeRootWith(hh); err != nil {
-		return
+// MinSSZSizeFork returns the minimum ssz encoded size in bytes for the BeaconBlock object for a given fork
+func (b *BeaconBlock) MinSSZSizeFork(fork ssz.Fork) uint64 {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 1004
+	case fork >= ssz.ForkCapella:
+		return 984
+	case fork >= ssz.ForkBellatrix:
+		return 976
+	case fork >= ssz.ForkAltair:
+		return 464
+	default:
+		return 304
 	}
+}
 
-	// Field (1) 'Signature'
-	if size := len(s.Signature); size != 96 {
-		err = ssz.ErrBytesLengthFn("SignedBeaconBlock.Signature", size, 96)
-		return
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the BeaconBlock object
+func (b *BeaconBlock) MaxSSZSize() uint64 {
+	return b.MaxSSZSizeFork(ssz.ForkDeneb)
+}
+
+// MaxSSZSizeFork returns the maximum ssz encoded size in bytes for the BeaconBlock object for a given fork
+func (b *BeaconBlock) MaxSSZSizeFork(fork ssz.Fork) uint64 {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 1125899911395380
+	case fork >= ssz.ForkCapella:
+		return 1125899911198752
+	case fork >= ssz.ForkBellatrix:
+		return 1125899911195288
+	case fork >= ssz.ForkAltair:
+		return 157816
+	default:
+		return 157656
 	}
-	hh.PutBytes(s.Signature)
+}
 
-	hh.Merkleize(indx)
-	return
+// HashTreeRoot ssz hashes the BeaconBlock object
+func (b *BeaconBlock) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
 }
 
-// GetTree ssz hashes the SignedBeaconBlock object
-func (s *SignedBeaconBlock) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(s)
+// HashTreeRootWith ssz hashes the BeaconBlock object with a hasher
+func (b *BeaconBlock) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	return b.HashTreeRootWithFork(hh, ssz.ForkDeneb)
 }
 
-// MarshalSSZ ssz marshals the Transfer object
-func (t *Transfer) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(t)
+// HashTreeRootFork ssz hashes the BeaconBlock object for a given fork
+func (b *BeaconBlock) HashTreeRootFork(fork ssz.Fork) ([32]byte, error) {
+	return ssz.HashWithDefaultHasherFork(b, fork)
 }
 
-// MarshalSSZTo ssz marshals the Transfer object to a target array
-func (t *Transfer) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+// HashTreeRootWithFork ssz hashes the BeaconBlock object with a hasher for a given fork
+func (b *BeaconBlock) HashTreeRootWithFork(hh ssz.HashWalker, fork ssz.Fork) (err error) {
+	switch {
+	default:
+		indx := hh.Index()
 
-	// Field (0) 'Sender'
-	dst = ssz.MarshalUint64(dst, t.Sender)
+		// Field (0) 'Slot'
+		hh.PutUint64(b.Slot)
 
-	// Field (1) 'Recipient'
-	dst = ssz.MarshalUint64(dst, t.Recipient)
+		// Field (1) 'ProposerIndex'
+		hh.PutUint64(b.ProposerIndex)
 
-	// Field (2) 'Amount'
-	dst = ssz.MarshalUint64(dst, t.Amount)
+		// Field (2) 'ParentRoot'
+		hh.PutBytes(b.ParentRoot[:])
 
-	// Field (3) 'Fee'
-	dst = ssz.MarshalUint64(dst, t.Fee)
+		// Field (3) 'StateRoot'
+		hh.PutBytes(b.StateRoot[:])
 
-	// Field (4) 'Slot'
-	dst = ssz.MarshalUint64(dst, t.Slot)
+		// Field (4) 'Body'
+		if err = b.Body.HashTreeRootWithFork(hh, fork); err != nil {
+			return
+		}
 
-	// Field (5) 'Pubkey'
-	if size := len(t.Pubkey); size != 48 {
-		err = ssz.ErrBytesLengthFn("Transfer.Pubkey", size, 48)
-		return
+		hh.Merkleize(indx)
 	}
-	dst = append(dst, t.Pubkey...)
+	return
+}
 
-	// Field (6) 'Signature'
-	if size := len(t.Signature); size != 96 {
-		err = ssz.ErrBytesLengthFn("Transfer.Signature", size, 96)
-		return
-	}
-	dst = append(dst, t.Signature...)
+// HashTreeRootSSZ ssz hashes the encoded BeaconBlock object without decoding it
+func (b *BeaconBlock) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(b, buf)
+}
 
-	return
+// HashTreeRootSSZWith ssz hashes the encoded BeaconBlock object with a hasher
+func (b *BeaconBlock) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	return b.HashTreeRootSSZWithFork(hh, buf, ssz.ForkDeneb)
 }
 
-// UnmarshalSSZ ssz unmarshals the Transfer object
-func (t *Transfer) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 184 {
-		return ssz.ErrSize
-	}
+// HashTreeRootSSZFork ssz hashes the encoded BeaconBlock object for a given fork without decoding it
+func (b *BeaconBlock) HashTreeRootSSZFork(buf []byte, fork ssz.Fork) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasherFork(b, buf, fork)
+}
 
-	// Field (0) 'Sender'
-	t.Sender = ssz.UnmarshallUint64(buf[0:8])
+// HashTreeRootSSZWithFork ssz hashes the encoded BeaconBlock object with a hasher for a given fork
+func (b *BeaconBlock) HashTreeRootSSZWithFork(hh ssz.HashWalker, buf []byte, fork ssz.Fork) (err error) {
+	switch {
+	default:
+		size := uint64(len(buf))
+		if size < 84 {
+			return ssz.ErrSize
+		}
 
-	// Field (1) 'Recipient'
-	t.Recipient = ssz.UnmarshallUint64(buf[8:16])
+		tail := buf
+		var o4 uint64
 
-	// Field (2) 'Amount'
-	t.Amount = ssz.UnmarshallUint64(buf[16:24])
+		// Offset (4) 'Body'
+		if o4 = ssz.ReadOffset(buf[80:84]); o4 > size {
+			return ssz.ErrOffset
+		}
 
-	// Field (3) 'Fee'
-	t.Fee = ssz.UnmarshallUint64(buf[24:32])
+		if err = ssz.ValidateFirstOffset(o4, 84); err != nil {
+			return err
+		}
 
-	// Field (4) 'Slot'
-	t.Slot = ssz.UnmarshallUint64(buf[32:40])
+		indx := hh.Index()
 
-	// Field (5) 'Pubkey'
-	if cap(t.Pubkey) == 0 {
-		t.Pubkey = make([]byte, 0, len(buf[40:88]))
-	}
-	t.Pubkey = append(t.Pubkey, buf[40:88]...)
+		// Field (0) 'Slot'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
 
-	// Field (6) 'Signature'
-	if cap(t.Signature) == 0 {
-		t.Signature = make([]byte, 0, len(buf[88:184]))
-	}
-	t.Signature = append(t.Signature, buf[88:184]...)
+		// Field (1) 'ProposerIndex'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[8:16]))
 
-	return err
-}
+		// Field (2) 'ParentRoot'
+		hh.PutBytes(buf[16:48])
 
-// SizeSSZ returns the ssz encoded size in bytes for the Transfer object
-func (t *Transfer) SizeSSZ() (size int) {
-	size = 184
+		// Field (3) 'StateRoot'
+		hh.PutBytes(buf[48:80])
+
+		// Field (4) 'Body'
+		{
+			buf := tail[o4:]
+			if err = (*BeaconBlockBody)(nil).HashTreeRootSSZWithFork(hh, buf, fork); err != nil {
+				return err
+			}
+		}
+
+		hh.Merkleize(indx)
+	}
 	return
 }
 
-// HashTreeRoot ssz hashes the Transfer object
-func (t *Transfer) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(t)
+// GetTree ssz hashes the BeaconBlock object
+func (b *BeaconBlock) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
 }
 
-// HashTreeRootWith ssz hashes the Transfer object with a hasher
-func (t *Transfer) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
+// GetTreeFork ssz hashes the BeaconBlock object for a given fork
+func (b *BeaconBlock) GetTreeFork(fork ssz.Fork) (*ssz.Node, error) {
+	return ssz.ProofTreeFork(b, fork)
+}
 
-	// Field (0) 'Sender'
-	hh.PutUint64(t.Sender)
+const (
+	// BeaconBlockSlotGIndex is the generalized index of the 'Slot' field at the deneb fork
+	BeaconBlockSlotGIndex ssz.GIndex = 8
+	// BeaconBlockProposerIndexGIndex is the generalized index of the 'ProposerIndex' field at the deneb fork
+	BeaconBlockProposerIndexGIndex ssz.GIndex = 9
+	// BeaconBlockParentRootGIndex is the generalized index of the 'ParentRoot' field at the deneb fork
+	BeaconBlockParentRootGIndex ssz.GIndex = 10
+	// BeaconBlockStateRootGIndex is the generalized index of the 'StateRoot' field at the deneb fork
+	BeaconBlockStateRootGIndex ssz.GIndex = 11
+	// BeaconBlockBodyGIndex is the generalized index of the 'Body' field at the deneb fork
+	BeaconBlockBodyGIndex ssz.GIndex = 12
+)
 
-	// Field (1) 'Recipient'
-	hh.PutUint64(t.Recipient)
+// ProveSlot returns a merkle proof of the 'Slot' field of the BeaconBlock object
+func (b *BeaconBlock) ProveSlot() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconBlockSlotGIndex)
+}
 
-	// Field (2) 'Amount'
-	hh.PutUint64(t.Amount)
+// VerifyBeaconBlockSlot verifies a merkle proof of the 'Slot' field of a BeaconBlock object
+func VerifyBeaconBlockSlot(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconBlockSlotGIndex)
+}
 
-	// Field (3) 'Fee'
-	hh.PutUint64(t.Fee)
+// ProveProposerIndex returns a merkle proof of the 'ProposerIndex' field of the BeaconBlock object
+func (b *BeaconBlock) ProveProposerIndex() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconBlockProposerIndexGIndex)
+}
 
-	// Field (4) 'Slot'
-	hh.PutUint64(t.Slot)
+// VerifyBeaconBlockProposerIndex verifies a merkle proof of the 'ProposerIndex' field of a BeaconBlock object
+func VerifyBeaconBlockProposerIndex(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconBlockProposerIndexGIndex)
+}
 
-	// Field (5) 'Pubkey'
-	if size := len(t.Pubkey); size != 48 {
-		err = ssz.ErrBytesLengthFn("Transfer.Pubkey", size, 48)
-		return
-	}
-	hh.PutBytes(t.Pubkey)
+// ProveParentRoot returns a merkle proof of the 'ParentRoot' field of the BeaconBlock object
+func (b *BeaconBlock) ProveParentRoot() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconBlockParentRootGIndex)
+}
 
-	// Field (6) 'Signature'
-	if size := len(t.Signature); size != 96 {
-		err = ssz.ErrBytesLengthFn("Transfer.Signature", size, 96)
-		return
-	}
-	hh.PutBytes(t.Signature)
+// VerifyBeaconBlockParentRoot verifies a merkle proof of the 'ParentRoot' field of a BeaconBlock object
+func VerifyBeaconBlockParentRoot(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconBlockParentRootGIndex)
+}
 
-	hh.Merkleize(indx)
-	return
+// ProveStateRoot returns a merkle proof of the 'StateRoot' field of the BeaconBlock object
+func (b *BeaconBlock) ProveStateRoot() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconBlockStateRootGIndex)
 }
 
-// GetTree ssz hashes the Transfer object
-func (t *Transfer) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(t)
+// VerifyBeaconBlockStateRoot verifies a merkle proof of the 'StateRoot' field of a BeaconBlock object
+func VerifyBeaconBlockStateRoot(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconBlockStateRootGIndex)
 }
 
-// MarshalSSZ ssz marshals the BeaconState object
-func (b *BeaconState) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(b)
+// ProveBody returns a merkle proof of the 'Body' field of the BeaconBlock object
+func (b *BeaconBlock) ProveBody() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconBlockBodyGIndex)
 }
 
-// MarshalSSZTo ssz marshals the BeaconState object to a target array
-func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := int(2687377)
+// VerifyBeaconBlockBody verifies a merkle proof of the 'Body' field of a BeaconBlock object
+func VerifyBeaconBlockBody(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconBlockBodyGIndex)
+}
 
-	// Field (0) 'GenesisTime'
-	dst = ssz.MarshalUint64(dst, b.GenesisTime)
+// BeaconBlockSlotGIndexFork returns the generalized index of the 'Slot' field for a given fork
+func BeaconBlockSlotGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	return 8, nil
+}
 
-	// Field (1) 'GenesisValidatorsRoot'
-	if size := len(b.GenesisValidatorsRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("BeaconState.GenesisValidatorsRoot", size, 32)
-		return
+// ProveSlotFork returns a merkle proof of the 'Slot' field of the BeaconBlock object for a given fork
+func (b *BeaconBlock) ProveSlotFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconBlockSlotGIndexFork(fork)
+	if err != nil {
+		return nil, err
 	}
-	dst = append(dst, b.GenesisValidatorsRoot...)
-
-	// Field (2) 'Slot'
-	dst = ssz.MarshalUint64(dst, b.Slot)
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
 
-	// Field (3) 'Fork'
-	if b.Fork == nil {
-		b.Fork = new(Fork)
+// VerifyBeaconBlockSlotFork verifies a merkle proof of the 'Slot' field of a BeaconBlock object for a given fork
+func VerifyBeaconBlockSlotFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconBlockSlotGIndexFork(fork)
+	if err != nil {
+		return false, err
 	}
-	if dst, err = b.Fork.MarshalSSZTo(dst); err != nil {
-		return
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconBlockProposerIndexGIndexFork returns the generalized index of the 'ProposerIndex' field for a given fork
+func BeaconBlockProposerIndexGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	return 9, nil
+}
+
+// ProveProposerIndexFork returns a merkle proof of the 'ProposerIndex' field of the BeaconBlock object for a given fork
+func (b *BeaconBlock) ProveProposerIndexFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconBlockProposerIndexGIndexFork(fork)
+	if err != nil {
+		return nil, err
 	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
 
-	// Field (4) 'LatestBlockHeader'
-	if b.LatestBlockHeader == nil {
-		b.LatestBlockHeader = new(BeaconBlockHeader)
+// VerifyBeaconBlockProposerIndexFork verifies a merkle proof of the 'ProposerIndex' field of a BeaconBlock object for a given fork
+func VerifyBeaconBlockProposerIndexFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconBlockProposerIndexGIndexFork(fork)
+	if err != nil {
+		return false, err
 	}
-	if dst, err = b.LatestBlockHeader.MarshalSSZTo(dst); err != nil {
-		return
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconBlockParentRootGIndexFork returns the generalized index of the 'ParentRoot' field for a given fork
+func BeaconBlockParentRootGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	return 10, nil
+}
+
+// ProveParentRootFork returns a merkle proof of the 'ParentRoot' field of the BeaconBlock object for a given fork
+func (b *BeaconBlock) ProveParentRootFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconBlockParentRootGIndexFork(fork)
+	if err != nil {
+		return nil, err
 	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
 
-	// Field (5) 'BlockRoots'
-	if size := len(b.BlockRoots); size != 8192 {
-		err = ssz.ErrVectorLengthFn("BeaconState.BlockRoots", size, 8192)
-		return
-	}
-	for ii := 0; ii < 8192; ii++ {
-		if size := len(b.BlockRoots[ii]); size != 32 {
-			err = ssz.ErrBytesLengthFn("BeaconState.BlockRoots[ii]", size, 32)
-			return
-		}
-		dst = append(dst, b.BlockRoots[ii]...)
-	}
-
-	// Field (6) 'StateRoots'
-	if size := len(b.StateRoots); size != 8192 {
-		err = ssz.ErrVectorLengthFn("BeaconState.StateRoots", size, 8192)
-		return
-	}
-	for ii := 0; ii < 8192; ii++ {
-		if size := len(b.StateRoots[ii]); size != 32 {
-			err = ssz.ErrBytesLengthFn("BeaconState.StateRoots[ii]", size, 32)
-			return
-		}
-		dst = append(dst, b.StateRoots[ii]...)
+// VerifyBeaconBlockParentRootFork verifies a merkle proof of the 'ParentRoot' field of a BeaconBlock object for a given fork
+func VerifyBeaconBlockParentRootFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconBlockParentRootGIndexFork(fork)
+	if err != nil {
+		return false, err
 	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
 
-	// Offset (7) 'HistoricalRoots'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.HistoricalRoots) * 32
+// BeaconBlockStateRootGIndexFork returns the generalized index of the 'StateRoot' field for a given fork
+func BeaconBlockStateRootGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	return 11, nil
+}
 
-	// Field (8) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
-		return
+// ProveStateRootFork returns a merkle proof of the 'StateRoot' field of the BeaconBlock object for a given fork
+func (b *BeaconBlock) ProveStateRootFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconBlockStateRootGIndexFork(fork)
+	if err != nil {
+		return nil, err
 	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
 
-	// Offset (9) 'Eth1DataVotes'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Eth1DataVotes) * 72
-
-	// Field (10) 'Eth1DepositIndex'
-	dst = ssz.MarshalUint64(dst, b.Eth1DepositIndex)
-
-	// Offset (11) 'Validators'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Validators) * 121
-
-	// Offset (12) 'Balances'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Balances) * 8
-
-	// Field (13) 'RandaoMixes'
-	if size := len(b.RandaoMixes); size != 65536 {
-		err = ssz.ErrVectorLengthFn("BeaconState.RandaoMixes", size, 65536)
-		return
-	}
-	for ii := 0; ii < 65536; ii++ {
-		if size := len(b.RandaoMixes[ii]); size != 32 {
-			err = ssz.ErrBytesLengthFn("BeaconState.RandaoMixes[ii]", size, 32)
-			return
-		}
-		dst = append(dst, b.RandaoMixes[ii]...)
+// VerifyBeaconBlockStateRootFork verifies a merkle proof of the 'StateRoot' field of a BeaconBlock object for a given fork
+func VerifyBeaconBlockStateRootFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconBlockStateRootGIndexFork(fork)
+	if err != nil {
+		return false, err
 	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
 
-	// Field (14) 'Slashings'
-	if size := len(b.Slashings); size != 8192 {
-		err = ssz.ErrVectorLengthFn("BeaconState.Slashings", size, 8192)
-		return
-	}
-	for ii := 0; ii < 8192; ii++ {
-		dst = ssz.MarshalUint64(dst, b.Slashings[ii])
+// BeaconBlockBodyGIndexFork returns the generalized index of the 'Body' field for a given fork
+func BeaconBlockBodyGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	return 12, nil
+}
+
+// ProveBodyFork returns a merkle proof of the 'Body' field of the BeaconBlock object for a given fork
+func (b *BeaconBlock) ProveBodyFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconBlockBodyGIndexFork(fork)
+	if err != nil {
+		return nil, err
 	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
 
-	// Offset (15) 'PreviousEpochAttestations'
-	dst = ssz.WriteOffset(dst, offset)
-	for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
-		offset += 4
-		offset += b.PreviousEpochAttestations[ii].SizeSSZ()
+// VerifyBeaconBlockBodyFork verifies a merkle proof of the 'Body' field of a BeaconBlock object for a given fork
+func VerifyBeaconBlockBodyFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconBlockBodyGIndexFork(fork)
+	if err != nil {
+		return false, err
 	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
 
-	// Offset (16) 'CurrentEpochAttestations'
-	dst = ssz.WriteOffset(dst, offset)
+// SchemaSSZ returns the schema of the tree of the BeaconBlock object
+func (b *BeaconBlock) SchemaSSZ() *ssz.Schema {
+	return b.SchemaSSZFork(ssz.ForkDeneb)
+}
 
-	// Field (17) 'JustificationBits'
-	if size := len(b.JustificationBits); size != 1 {
-		err = ssz.ErrBytesLengthFn("BeaconState.JustificationBits", size, 1)
-		return
+// SchemaSSZFork returns the schema of the tree of the BeaconBlock object for a given fork
+func (b *BeaconBlock) SchemaSSZFork(fork ssz.Fork) *ssz.Schema {
+	switch {
+	default:
+		return ssz.SchemaContainer(
+			&ssz.SchemaField{Name: "Slot", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "ProposerIndex", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "ParentRoot", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "StateRoot", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "Body", Schema: (*BeaconBlockBody)(nil).SchemaSSZFork(fork)},
+		)
 	}
-	dst = append(dst, b.JustificationBits...)
+}
 
-	// Field (18) 'PreviousJustifiedCheckpoint'
-	if b.PreviousJustifiedCheckpoint == nil {
-		b.PreviousJustifiedCheckpoint = new(Checkpoint)
-	}
-	if dst, err = b.PreviousJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
-		return
-	}
+// MarshalSSZ ssz marshals the SignedBeaconBlock object
+func (s *SignedBeaconBlock) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
 
-	// Field (19) 'CurrentJustifiedCheckpoint'
-	if b.CurrentJustifiedCheckpoint == nil {
-		b.CurrentJustifiedCheckpoint = new(Checkpoint)
-	}
-	if dst, err = b.CurrentJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
-		return
-	}
+// MarshalSSZTo ssz marshals the SignedBeaconBlock object to a target array
+func (s *SignedBeaconBlock) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	return s.MarshalSSZToFork(buf, ssz.ForkDeneb)
+}
 
-	// Field (20) 'FinalizedCheckpoint'
-	if b.FinalizedCheckpoint == nil {
-		b.FinalizedCheckpoint = new(Checkpoint)
-	}
-	if dst, err = b.FinalizedCheckpoint.MarshalSSZTo(dst); err != nil {
-		return
-	}
+// MarshalSSZFork ssz marshals the SignedBeaconBlock object for a given fork
+func (s *SignedBeaconBlock) MarshalSSZFork(fork ssz.Fork) ([]byte, error) {
+	return ssz.MarshalSSZFork(s, fork)
+}
 
-	// Field (7) 'HistoricalRoots'
-	if size := len(b.HistoricalRoots); size > 16777216 {
-		err = ssz.ErrListTooBigFn("BeaconState.HistoricalRoots", size, 16777216)
-		return
-	}
-	for ii := 0; ii < len(b.HistoricalRoots); ii++ {
-		if size := len(b.HistoricalRoots[ii]); size != 32 {
-			err = ssz.ErrBytesLengthFn("BeaconState.HistoricalRoots[ii]", size, 32)
+// MarshalSSZToFork ssz marshals the SignedBeaconBlock object to a target array for a given fork
+func (s *SignedBeaconBlock) MarshalSSZToFork(buf []byte, fork ssz.Fork) (dst []byte, err error) {
+	dst = buf
+	switch {
+	default:
+		offset := int(100)
+		// Offset (0) 'Block'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (1) 'Signature'
+		if size := len(s.Signature); size != 96 {
+			err = ssz.ErrBytesLengthFn("SignedBeaconBlock.Signature", size, 96)
 			return
 		}
-		dst = append(dst, b.HistoricalRoots[ii]...)
-	}
+		dst = append(dst, s.Signature...)
 
-	// Field (9) 'Eth1DataVotes'
-	if size := len(b.Eth1DataVotes); size > 2048 {
-		err = ssz.ErrListTooBigFn("BeaconState.Eth1DataVotes", size, 2048)
-		return
-	}
-	for ii := 0; ii < len(b.Eth1DataVotes); ii++ {
-		if dst, err = b.Eth1DataVotes[ii].MarshalSSZTo(dst); err != nil {
+		// Field (0) 'Block'
+		if dst, err = s.Block.MarshalSSZToFork(dst, fork); err != nil {
 			return
 		}
-	}
 
-	// Field (11) 'Validators'
-	if size := len(b.Validators); size > 1099511627776 {
-		err = ssz.ErrListTooBigFn("BeaconState.Validators", size, 1099511627776)
-		return
-	}
-	for ii := 0; ii < len(b.Validators); ii++ {
-		if dst, err = b.Validators[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
 	}
+	return
+}
 
-	// Field (12) 'Balances'
-	if size := len(b.Balances); size > 1099511627776 {
-		err = ssz.ErrListTooBigFn("BeaconState.Balances", size, 1099511627776)
-		return
-	}
-	for ii := 0; ii < len(b.Balances); ii++ {
-		dst = ssz.MarshalUint64(dst, b.Balances[ii])
-	}
+// MarshalSSZToWriter ssz marshals the SignedBeaconBlock object to a writer
+func (s *SignedBeaconBlock) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(s, writer)
+}
 
-	// Field (15) 'PreviousEpochAttestations'
-	if size := len(b.PreviousEpochAttestations); size > 4096 {
-		err = ssz.ErrListTooBigFn("BeaconState.PreviousEpochAttestations", size, 4096)
-		return
-	}
-	{
-		offset = 4 * len(b.PreviousEpochAttestations)
-		for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += b.PreviousEpochAttestations[ii].SizeSSZ()
-		}
-	}
-	for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
-		if dst, err = b.PreviousEpochAttestations[ii].MarshalSSZTo(dst); err != nil {
+// MarshalSSZToStream ssz marshals the SignedBeaconBlock object to a target array that is flushed to a stream writer
+func (s *SignedBeaconBlock) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	return s.MarshalSSZToStreamFork(buf, sw, ssz.ForkDeneb)
+}
+
+// MarshalSSZToWriterFork ssz marshals the SignedBeaconBlock object to a writer for a given fork
+func (s *SignedBeaconBlock) MarshalSSZToWriterFork(writer io.Writer, fork ssz.Fork) (int, error) {
+	return ssz.MarshalSSZToWriterFork(s, writer, fork)
+}
+
+// MarshalSSZToStreamFork ssz marshals the SignedBeaconBlock object to a target array that is flushed to a stream writer for a given fork
+func (s *SignedBeaconBlock) MarshalSSZToStreamFork(buf []byte, sw *ssz.StreamWriter, fork ssz.Fork) (dst []byte, err error) {
+	dst = buf
+	switch {
+	default:
+		offset := int(100)
+		// Offset (0) 'Block'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (1) 'Signature'
+		if size := len(s.Signature); size != 96 {
+			err = ssz.ErrBytesLengthFn("SignedBeaconBlock.Signature", size, 96)
 			return
 		}
-	}
+		dst = append(dst, s.Signature...)
 
-	// Field (16) 'CurrentEpochAttestations'
-	if size := len(b.CurrentEpochAttestations); size > 4096 {
-		err = ssz.ErrListTooBigFn("BeaconState.CurrentEpochAttestations", size, 4096)
-		return
-	}
-	{
-		offset = 4 * len(b.CurrentEpochAttestations)
-		for ii := 0; ii < len(b.CurrentEpochAttestations); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += b.CurrentEpochAttestations[ii].SizeSSZ()
-		}
-	}
-	for ii := 0; ii < len(b.CurrentEpochAttestations); ii++ {
-		if dst, err = b.CurrentEpochAttestations[ii].MarshalSSZTo(dst); err != nil {
+		// Field (0) 'Block'
+		if dst, err = s.Block.MarshalSSZToStreamFork(dst, sw, fork); err != nil {
 			return
 		}
-	}
 
+	}
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the BeaconState object
-func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size < 2687377 {
-		return ssz.ErrSize
-	}
+// UnmarshalSSZ ssz unmarshals the SignedBeaconBlock object
+func (s *SignedBeaconBlock) UnmarshalSSZ(buf []byte) error {
+	return s.UnmarshalSSZForkWithOptions(buf, ssz.ForkDeneb, ssz.UnmarshalOptions{})
+}
 
-	tail := buf
-	var o7, o9, o11, o12, o15, o16 uint64
+// UnmarshalSSZWithOptions ssz unmarshals the SignedBeaconBlock object with the decoding options
+func (s *SignedBeaconBlock) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	return s.UnmarshalSSZForkWithOptions(buf, ssz.ForkDeneb, opts)
+}
 
-	// Field (0) 'GenesisTime'
-	b.GenesisTime = ssz.UnmarshallUint64(buf[0:8])
+// UnmarshalSSZFork ssz unmarshals the SignedBeaconBlock object for a given fork
+func (s *SignedBeaconBlock) UnmarshalSSZFork(buf []byte, fork ssz.Fork) error {
+	return s.UnmarshalSSZForkWithOptions(buf, fork, ssz.UnmarshalOptions{})
+}
 
-	// Field (1) 'GenesisValidatorsRoot'
-	if cap(b.GenesisValidatorsRoot) == 0 {
-		b.GenesisValidatorsRoot = make([]byte, 0, len(buf[8:40]))
-	}
-	b.GenesisValidatorsRoot = append(b.GenesisValidatorsRoot, buf[8:40]...)
+// UnmarshalSSZForkWithOptions ssz unmarshals the SignedBeaconBlock object for a given fork with the decoding options
+func (s *SignedBeaconBlock) UnmarshalSSZForkWithOptions(buf []byte, fork ssz.Fork, opts ssz.UnmarshalOptions) error {
+	var err error
+	switch {
+	default:
+		size := uint64(len(buf))
+		if size < 100 {
+			return ssz.ErrSize
+		}
 
-	// Field (2) 'Slot'
-	b.Slot = ssz.UnmarshallUint64(buf[40:48])
+		tail := buf
+		var o0 uint64
 
-	// Field (3) 'Fork'
-	if b.Fork == nil {
-		b.Fork = new(Fork)
-	}
-	if err = b.Fork.UnmarshalSSZ(buf[48:64]); err != nil {
-		return err
-	}
+		// Offset (0) 'Block'
+		if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+			return ssz.ErrOffset
+		}
 
-	// Field (4) 'LatestBlockHeader'
-	if b.LatestBlockHeader == nil {
-		b.LatestBlockHeader = new(BeaconBlockHeader)
-	}
-	if err = b.LatestBlockHeader.UnmarshalSSZ(buf[64:176]); err != nil {
-		return err
-	}
+		if err = opts.ValidateFirstOffset(o0, 100); err != nil {
+			return err
+		}
 
-	// Field (5) 'BlockRoots'
-	b.BlockRoots = make([][]byte, 8192)
-	for ii := 0; ii < 8192; ii++ {
-		if cap(b.BlockRoots[ii]) == 0 {
-			b.BlockRoots[ii] = make([]byte, 0, len(buf[176:262320][ii*32:(ii+1)*32]))
+		// Field (1) 'Signature'
+		if cap(s.Signature) == 0 {
+			s.Signature = make([]byte, 0, len(buf[4:100]))
 		}
-		b.BlockRoots[ii] = append(b.BlockRoots[ii], buf[176:262320][ii*32:(ii+1)*32]...)
-	}
+		s.Signature = append(s.Signature, buf[4:100]...)
 
-	// Field (6) 'StateRoots'
-	b.StateRoots = make([][]byte, 8192)
-	for ii := 0; ii < 8192; ii++ {
-		if cap(b.StateRoots[ii]) == 0 {
-			b.StateRoots[ii] = make([]byte, 0, len(buf[262320:524464][ii*32:(ii+1)*32]))
+		// Field (0) 'Block'
+		{
+			buf = tail[o0:]
+			if s.Block == nil {
+				s.Block = new(BeaconBlock)
+			}
+			if err = s.Block.UnmarshalSSZForkWithOptions(buf, fork, opts); err != nil {
+				return err
+			}
 		}
-		b.StateRoots[ii] = append(b.StateRoots[ii], buf[262320:524464][ii*32:(ii+1)*32]...)
 	}
+	return err
+}
 
-	// Offset (7) 'HistoricalRoots'
-	if o7 = ssz.ReadOffset(buf[524464:524468]); o7 > size {
-		return ssz.ErrOffset
-	}
+// SizeSSZ returns the ssz encoded size in bytes for the SignedBeaconBlock object
+func (s *SignedBeaconBlock) SizeSSZ() (size int) {
+	return s.SizeSSZFork(ssz.ForkDeneb)
+}
 
-	if o7 < 2687377 {
-		return ssz.ErrInvalidVariableOffset
+// SizeSSZFork returns the ssz encoded size in bytes for the SignedBeaconBlock object for a given fork
+func (s *SignedBeaconBlock) SizeSSZFork(fork ssz.Fork) (size int) {
+	switch {
+	default:
+		size = 100
+		// Field (0) 'Block'
+		if s.Block == nil {
+			s.Block = new(BeaconBlock)
+		}
+		size += s.Block.SizeSSZFork(fork)
 	}
+	return
+}
 
-	// Field (8) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if err = b.Eth1Data.UnmarshalSSZ(buf[524468:524540]); err != nil {
-		return err
-	}
+// MinSSZSize returns the minimum ssz encoded size in bytes for the SignedBeaconBlock object
+func (s *SignedBeaconBlock) MinSSZSize() uint64 {
+	return s.MinSSZSizeFork(ssz.ForkDeneb)
+}
 
-	// Offset (9) 'Eth1DataVotes'
-	if o9 = ssz.ReadOffset(buf[524540:524544]); o9 > size || o7 > o9 {
-		return ssz.ErrOffset
+// MinSSZSizeFork returns the minimum ssz encoded size in bytes for the SignedBeaconBlock object for a given fork
+func (s *SignedBeaconBlock) MinSSZSizeFork(fork ssz.Fork) uint64 {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 1104
+	case fork >= ssz.ForkCapella:
+		return 1084
+	case fork >= ssz.ForkBellatrix:
+		return 1076
+	case fork >= ssz.ForkAltair:
+		return 564
+	default:
+		return 404
 	}
+}
 
-	// Field (10) 'Eth1DepositIndex'
-	b.Eth1DepositIndex = ssz.UnmarshallUint64(buf[524544:524552])
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the SignedBeaconBlock object
+func (s *SignedBeaconBlock) MaxSSZSize() uint64 {
+	return s.MaxSSZSizeFork(ssz.ForkDeneb)
+}
 
-	// Offset (11) 'Validators'
-	if o11 = ssz.ReadOffset(buf[524552:524556]); o11 > size || o9 > o11 {
-		return ssz.ErrOffset
+// MaxSSZSizeFork returns the maximum ssz encoded size in bytes for the SignedBeaconBlock object for a given fork
+func (s *SignedBeaconBlock) MaxSSZSizeFork(fork ssz.Fork) uint64 {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 1125899911395480
+	case fork >= ssz.ForkCapella:
+		return 1125899911198852
+	case fork >= ssz.ForkBellatrix:
+		return 1125899911195388
+	case fork >= ssz.ForkAltair:
+		return 157916
+	default:
+		return 157756
 	}
+}
 
-	// Offset (12) 'Balances'
-	if o12 = ssz.ReadOffset(buf[524556:524560]); o12 > size || o11 > o12 {
-		return ssz.ErrOffset
-	}
+// HashTreeRoot ssz hashes the SignedBeaconBlock object
+func (s *SignedBeaconBlock) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
 
-	// Field (13) 'RandaoMixes'
-	b.RandaoMixes = make([][]byte, 65536)
-	for ii := 0; ii < 65536; ii++ {
-		if cap(b.RandaoMixes[ii]) == 0 {
-			b.RandaoMixes[ii] = make([]byte, 0, len(buf[524560:2621712][ii*32:(ii+1)*32]))
-		}
-		b.RandaoMixes[ii] = append(b.RandaoMixes[ii], buf[524560:2621712][ii*32:(ii+1)*32]...)
-	}
+// HashTreeRootWith ssz hashes the SignedBeaconBlock object with a hasher
+func (s *SignedBeaconBlock) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	return s.HashTreeRootWithFork(hh, ssz.ForkDeneb)
+}
 
-	// Field (14) 'Slashings'
-	b.Slashings = ssz.ExtendUint64(b.Slashings, 8192)
-	for ii := 0; ii < 8192; ii++ {
-		b.Slashings[ii] = ssz.UnmarshallUint64(buf[2621712:2687248][ii*8 : (ii+1)*8])
-	}
+// HashTreeRootFork ssz hashes the SignedBeaconBlock object for a given fork
+func (s *SignedBeaconBlock) HashTreeRootFork(fork ssz.Fork) ([32]byte, error) {
+	return ssz.HashWithDefaultHasherFork(s, fork)
+}
 
-	// Offset (15) 'PreviousEpochAttestations'
-	if o15 = ssz.ReadOffset(buf[2687248:2687252]); o15 > size || o12 > o15 {
-		return ssz.ErrOffset
-	}
+// HashTreeRootWithFork ssz hashes the SignedBeaconBlock object with a hasher for a given fork
+func (s *SignedBeaconBlock) HashTreeRootWithFork(hh ssz.HashWalker, fork ssz.Fork) (err error) {
+	switch {
+	default:
+		indx := hh.Index()
 
-	// Offset (16) 'CurrentEpochAttestations'
-	if o16 = ssz.ReadOffset(buf[2687252:2687256]); o16 > size || o15 > o16 {
-		return ssz.ErrOffset
-	}
+		// Field (0) 'Block'
+		if err = s.Block.HashTreeRootWithFork(hh, fork); err != nil {
+			return
+		}
 
-	// Field (17) 'JustificationBits'
-	if cap(b.JustificationBits) == 0 {
-		b.JustificationBits = make([]byte, 0, len(buf[2687256:2687257]))
-	}
-	b.JustificationBits = append(b.JustificationBits, buf[2687256:2687257]...)
+		// Field (1) 'Signature'
+		if size := len(s.Signature); size != 96 {
+			err = ssz.ErrBytesLengthFn("SignedBeaconBlock.Signature", size, 96)
+			return
+		}
+		hh.PutBytes(s.Signature)
 
-	// Field (18) 'PreviousJustifiedCheckpoint'
-	if b.PreviousJustifiedCheckpoint == nil {
-		b.PreviousJustifiedCheckpoint = new(Checkpoint)
-	}
-	if err = b.PreviousJustifiedCheckpoint.UnmarshalSSZ(buf[2687257:2687297]); err != nil {
-		return err
+		hh.Merkleize(indx)
 	}
+	return
+}
 
-	// Field (19) 'CurrentJustifiedCheckpoint'
-	if b.CurrentJustifiedCheckpoint == nil {
-		b.CurrentJustifiedCheckpoint = new(Checkpoint)
-	}
-	if err = b.CurrentJustifiedCheckpoint.UnmarshalSSZ(buf[2687297:2687337]); err != nil {
-		return err
-	}
+// HashTreeRootSSZ ssz hashes the encoded SignedBeaconBlock object without decoding it
+func (s *SignedBeaconBlock) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(s, buf)
+}
 
-	// Field (20) 'FinalizedCheckpoint'
-	if b.FinalizedCheckpoint == nil {
-		b.FinalizedCheckpoint = new(Checkpoint)
-	}
-	if err = b.FinalizedCheckpoint.UnmarshalSSZ(buf[2687337:2687377]); err != nil {
-		return err
-	}
+// HashTreeRootSSZWith ssz hashes the encoded SignedBeaconBlock object with a hasher
+func (s *SignedBeaconBlock) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	return s.HashTreeRootSSZWithFork(hh, buf, ssz.ForkDeneb)
+}
 
-	// Field (7) 'HistoricalRoots'
-	{
-		buf = tail[o7:o9]
-		num, err := ssz.DivideInt2(len(buf), 32, 16777216)
-		if err != nil {
-			return err
-		}
-		b.HistoricalRoots = make([][]byte, num)
-		for ii := 0; ii < num; ii++ {
-			if cap(b.HistoricalRoots[ii]) == 0 {
-				b.HistoricalRoots[ii] = make([]byte, 0, len(buf[ii*32:(ii+1)*32]))
-			}
-			b.HistoricalRoots[ii] = append(b.HistoricalRoots[ii], buf[ii*32:(ii+1)*32]...)
-		}
-	}
+// HashTreeRootSSZFork ssz hashes the encoded SignedBeaconBlock object for a given fork without decoding it
+func (s *SignedBeaconBlock) HashTreeRootSSZFork(buf []byte, fork ssz.Fork) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasherFork(s, buf, fork)
+}
 
-	// Field (9) 'Eth1DataVotes'
-	{
-		buf = tail[o9:o11]
-		num, err := ssz.DivideInt2(len(buf), 72, 2048)
-		if err != nil {
-			return err
-		}
-		b.Eth1DataVotes = make([]*Eth1Data, num)
-		for ii := 0; ii < num; ii++ {
-			if b.Eth1DataVotes[ii] == nil {
-				b.Eth1DataVotes[ii] = new(Eth1Data)
-			}
-			if err = b.Eth1DataVotes[ii].UnmarshalSSZ(buf[ii*72 : (ii+1)*72]); err != nil {
-				return err
-			}
+// HashTreeRootSSZWithFork ssz hashes the encoded SignedBeaconBlock object with a hasher for a given fork
+func (s *SignedBeaconBlock) HashTreeRootSSZWithFork(hh ssz.HashWalker, buf []byte, fork ssz.Fork) (err error) {
+	switch {
+	default:
+		size := uint64(len(buf))
+		if size < 100 {
+			return ssz.ErrSize
 		}
-	}
 
-	// Field (11) 'Validators'
-	{
-		buf = tail[o11:o12]
-		num, err := ssz.DivideInt2(len(buf), 121, 1099511627776)
-		if err != nil {
-			return err
-		}
-		b.Validators = make([]*Validator, num)
-		for ii := 0; ii < num; ii++ {
-			if b.Validators[ii] == nil {
-				b.Validators[ii] = new(Validator)
-			}
-			if err = b.Validators[ii].UnmarshalSSZ(buf[ii*121 : (ii+1)*121]); err != nil {
-				return err
-			}
-		}
-	}
+		tail := buf
+		var o0 uint64
 
-	// Field (12) 'Balances'
-	{
-		buf = tail[o12:o15]
-		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
-		if err != nil {
-			return err
-		}
-		b.Balances = ssz.ExtendUint64(b.Balances, num)
-		for ii := 0; ii < num; ii++ {
-			b.Balances[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		// Offset (0) 'Block'
+		if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+			return ssz.ErrOffset
 		}
-	}
 
-	// Field (15) 'PreviousEpochAttestations'
-	{
-		buf = tail[o15:o16]
-		num, err := ssz.DecodeDynamicLength(buf, 4096)
-		if err != nil {
-			return err
-		}
-		b.PreviousEpochAttestations = make([]*PendingAttestation, num)
-		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
-			if b.PreviousEpochAttestations[indx] == nil {
-				b.PreviousEpochAttestations[indx] = new(PendingAttestation)
-			}
-			if err = b.PreviousEpochAttestations[indx].UnmarshalSSZ(buf); err != nil {
-				return err
-			}
-			return nil
-		})
-		if err != nil {
+		if err = ssz.ValidateFirstOffset(o0, 100); err != nil {
 			return err
 		}
-	}
 
-	// Field (16) 'CurrentEpochAttestations'
-	{
-		buf = tail[o16:]
-		num, err := ssz.DecodeDynamicLength(buf, 4096)
-		if err != nil {
-			return err
-		}
-		b.CurrentEpochAttestations = make([]*PendingAttestation, num)
-		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
-			if b.CurrentEpochAttestations[indx] == nil {
-				b.CurrentEpochAttestations[indx] = new(PendingAttestation)
-			}
-			if err = b.CurrentEpochAttestations[indx].UnmarshalSSZ(buf); err != nil {
+		indx := hh.Index()
+
+		// Field (0) 'Block'
+		{
+			buf := tail[o0:]
+			if err = (*BeaconBlock)(nil).HashTreeRootSSZWithFork(hh, buf, fork); err != nil {
 				return err
 			}
-			return nil
-		})
-		if err != nil {
-			return err
 		}
+
+		// Field (1) 'Signature'
+		hh.PutBytes(buf[4:100])
+
+		hh.Merkleize(indx)
 	}
-	return err
+	return
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconState object
-func (b *BeaconState) SizeSSZ() (size int) {
-	size = 2687377
+// GetTree ssz hashes the SignedBeaconBlock object
+func (s *SignedBeaconBlock) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
 
-	// Field (7) 'HistoricalRoots'
-	size += len(b.HistoricalRoots) * 32
+// GetTreeFork ssz hashes the SignedBeaconBlock object for a given fork
+func (s *SignedBeaconBlock) GetTreeFork(fork ssz.Fork) (*ssz.Node, error) {
+	return ssz.ProofTreeFork(s, fork)
+}
 
-	// Field (9) 'Eth1DataVotes'
-	size += len(b.Eth1DataVotes) * 72
+const (
+	// SignedBeaconBlockBlockGIndex is the generalized index of the 'Block' field at the deneb fork
+	SignedBeaconBlockBlockGIndex ssz.GIndex = 2
+	// SignedBeaconBlockSignatureGIndex is the generalized index of the 'Signature' field at the deneb fork
+	SignedBeaconBlockSignatureGIndex ssz.GIndex = 3
+)
 
-	// Field (11) 'Validators'
-	size += len(b.Validators) * 121
+// ProveBlock returns a merkle proof of the 'Block' field of the SignedBeaconBlock object
+func (s *SignedBeaconBlock) ProveBlock() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(s, SignedBeaconBlockBlockGIndex)
+}
 
-	// Field (12) 'Balances'
-	size += len(b.Balances) * 8
+// VerifySignedBeaconBlockBlock verifies a merkle proof of the 'Block' field of a SignedBeaconBlock object
+func VerifySignedBeaconBlockBlock(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, SignedBeaconBlockBlockGIndex)
+}
 
-	// Field (15) 'PreviousEpochAttestations'
-	for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
-		size += 4
-		size += b.PreviousEpochAttestations[ii].SizeSSZ()
-	}
+// ProveSignature returns a merkle proof of the 'Signature' field of the SignedBeaconBlock object
+func (s *SignedBeaconBlock) ProveSignature() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(s, SignedBeaconBlockSignatureGIndex)
+}
+
+// VerifySignedBeaconBlockSignature verifies a merkle proof of the 'Signature' field of a SignedBeaconBlock object
+func VerifySignedBeaconBlockSignature(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, SignedBeaconBlockSignatureGIndex)
+}
+
+// SignedBeaconBlockBlockGIndexFork returns the generalized index of the 'Block' field for a given fork
+func SignedBeaconBlockBlockGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	return 2, nil
+}
+
+// ProveBlockFork returns a merkle proof of the 'Block' field of the SignedBeaconBlock object for a given fork
+func (s *SignedBeaconBlock) ProveBlockFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := SignedBeaconBlockBlockGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(s, gindex, fork)
+}
+
+// VerifySignedBeaconBlockBlockFork verifies a merkle proof of the 'Block' field of a SignedBeaconBlock object for a given fork
+func VerifySignedBeaconBlockBlockFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := SignedBeaconBlockBlockGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// SignedBeaconBlockSignatureGIndexFork returns the generalized index of the 'Signature' field for a given fork
+func SignedBeaconBlockSignatureGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	return 3, nil
+}
+
+// ProveSignatureFork returns a merkle proof of the 'Signature' field of the SignedBeaconBlock object for a given fork
+func (s *SignedBeaconBlock) ProveSignatureFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := SignedBeaconBlockSignatureGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(s, gindex, fork)
+}
+
+// VerifySignedBeaconBlockSignatureFork verifies a merkle proof of the 'Signature' field of a SignedBeaconBlock object for a given fork
+func VerifySignedBeaconBlockSignatureFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := SignedBeaconBlockSignatureGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// SchemaSSZ returns the schema of the tree of the SignedBeaconBlock object
+func (s *SignedBeaconBlock) SchemaSSZ() *ssz.Schema {
+	return s.SchemaSSZFork(ssz.ForkDeneb)
+}
+
+// SchemaSSZFork returns the schema of the tree of the SignedBeaconBlock object for a given fork
+func (s *SignedBeaconBlock) SchemaSSZFork(fork ssz.Fork) *ssz.Schema {
+	switch {
+	default:
+		return ssz.SchemaContainer(
+			&ssz.SchemaField{Name: "Block", Schema: (*BeaconBlock)(nil).SchemaSSZFork(fork)},
+			&ssz.SchemaField{Name: "Signature", Schema: ssz.SchemaBytes(96)},
+		)
+	}
+}
+
+// MarshalSSZ ssz marshals the Transfer object
+func (t *Transfer) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(t)
+}
+
+// MarshalSSZTo ssz marshals the Transfer object to a target array
+func (t *Transfer) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Sender'
+	dst = ssz.MarshalUint64(dst, t.Sender)
+
+	// Field (1) 'Recipient'
+	dst = ssz.MarshalUint64(dst, t.Recipient)
+
+	// Field (2) 'Amount'
+	dst = ssz.MarshalUint64(dst, t.Amount)
+
+	// Field (3) 'Fee'
+	dst = ssz.MarshalUint64(dst, t.Fee)
+
+	// Field (4) 'Slot'
+	dst = ssz.MarshalUint64(dst, t.Slot)
+
+	// Field (5) 'Pubkey'
+	if size := len(t.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("Transfer.Pubkey", size, 48)
+		return
+	}
+	dst = append(dst, t.Pubkey...)
+
+	// Field (6) 'Signature'
+	if size := len(t.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("Transfer.Signature", size, 96)
+		return
+	}
+	dst = append(dst, t.Signature...)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the Transfer object to a writer
+func (t *Transfer) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(t, writer)
+}
+
+// MarshalSSZToStream ssz marshals the Transfer object to a target array that is flushed to a stream writer
+func (t *Transfer) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Sender'
+	dst = ssz.MarshalUint64(dst, t.Sender)
+
+	// Field (1) 'Recipient'
+	dst = ssz.MarshalUint64(dst, t.Recipient)
+
+	// Field (2) 'Amount'
+	dst = ssz.MarshalUint64(dst, t.Amount)
+
+	// Field (3) 'Fee'
+	dst = ssz.MarshalUint64(dst, t.Fee)
+
+	// Field (4) 'Slot'
+	dst = ssz.MarshalUint64(dst, t.Slot)
+
+	// Field (5) 'Pubkey'
+	if size := len(t.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("Transfer.Pubkey", size, 48)
+		return
+	}
+	dst = append(dst, t.Pubkey...)
+
+	// Field (6) 'Signature'
+	if size := len(t.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("Transfer.Signature", size, 96)
+		return
+	}
+	dst = append(dst, t.Signature...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Transfer object
+func (t *Transfer) UnmarshalSSZ(buf []byte) error {
+	return t.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Transfer object with the decoding options
+func (t *Transfer) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 184 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Sender'
+	t.Sender = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Recipient'
+	t.Recipient = ssz.UnmarshallUint64(buf[8:16])
+
+	// Field (2) 'Amount'
+	t.Amount = ssz.UnmarshallUint64(buf[16:24])
+
+	// Field (3) 'Fee'
+	t.Fee = ssz.UnmarshallUint64(buf[24:32])
+
+	// Field (4) 'Slot'
+	t.Slot = ssz.UnmarshallUint64(buf[32:40])
+
+	// Field (5) 'Pubkey'
+	if cap(t.Pubkey) == 0 {
+		t.Pubkey = make([]byte, 0, len(buf[40:88]))
+	}
+	t.Pubkey = append(t.Pubkey, buf[40:88]...)
+
+	// Field (6) 'Signature'
+	if cap(t.Signature) == 0 {
+		t.Signature = make([]byte, 0, len(buf[88:184]))
+	}
+	t.Signature = append(t.Signature, buf[88:184]...)
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Transfer object
+func (t *Transfer) SizeSSZ() (size int) {
+	size = 184
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Transfer object
+func (t *Transfer) MinSSZSize() uint64 {
+	return 184
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Transfer object
+func (t *Transfer) MaxSSZSize() uint64 {
+	return 184
+}
+
+// HashTreeRoot ssz hashes the Transfer object
+func (t *Transfer) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(t)
+}
+
+// HashTreeRootWith ssz hashes the Transfer object with a hasher
+func (t *Transfer) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Sender'
+	hh.PutUint64(t.Sender)
+
+	// Field (1) 'Recipient'
+	hh.PutUint64(t.Recipient)
+
+	// Field (2) 'Amount'
+	hh.PutUint64(t.Amount)
+
+	// Field (3) 'Fee'
+	hh.PutUint64(t.Fee)
+
+	// Field (4) 'Slot'
+	hh.PutUint64(t.Slot)
+
+	// Field (5) 'Pubkey'
+	if size := len(t.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("Transfer.Pubkey", size, 48)
+		return
+	}
+	hh.PutBytes(t.Pubkey)
+
+	// Field (6) 'Signature'
+	if size := len(t.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("Transfer.Signature", size, 96)
+		return
+	}
+	hh.PutBytes(t.Signature)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded Transfer object without decoding it
+func (t *Transfer) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(t, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded Transfer object with a hasher
+func (t *Transfer) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 184 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Sender'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'Recipient'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[8:16]))
+
+	// Field (2) 'Amount'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[16:24]))
+
+	// Field (3) 'Fee'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[24:32]))
+
+	// Field (4) 'Slot'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[32:40]))
+
+	// Field (5) 'Pubkey'
+	hh.PutBytes(buf[40:88])
+
+	// Field (6) 'Signature'
+	hh.PutBytes(buf[88:184])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Transfer object
+func (t *Transfer) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(t)
+}
+
+const (
+	// TransferSenderGIndex is the generalized index of the 'Sender' field
+	TransferSenderGIndex ssz.GIndex = 8
+	// TransferRecipientGIndex is the generalized index of the 'Recipient' field
+	TransferRecipientGIndex ssz.GIndex = 9
+	// TransferAmountGIndex is the generalized index of the 'Amount' field
+	TransferAmountGIndex ssz.GIndex = 10
+	// TransferFeeGIndex is the generalized index of the 'Fee' field
+	TransferFeeGIndex ssz.GIndex = 11
+	// TransferSlotGIndex is the generalized index of the 'Slot' field
+	TransferSlotGIndex ssz.GIndex = 12
+	// TransferPubkeyGIndex is the generalized index of the 'Pubkey' field
+	TransferPubkeyGIndex ssz.GIndex = 13
+	// TransferSignatureGIndex is the generalized index of the 'Signature' field
+	TransferSignatureGIndex ssz.GIndex = 14
+)
+
+// ProveSender returns a merkle proof of the 'Sender' field of the Transfer object
+func (t *Transfer) ProveSender() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(t, TransferSenderGIndex)
+}
+
+// VerifyTransferSender verifies a merkle proof of the 'Sender' field of a Transfer object
+func VerifyTransferSender(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, TransferSenderGIndex)
+}
+
+// ProveRecipient returns a merkle proof of the 'Recipient' field of the Transfer object
+func (t *Transfer) ProveRecipient() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(t, TransferRecipientGIndex)
+}
+
+// VerifyTransferRecipient verifies a merkle proof of the 'Recipient' field of a Transfer object
+func VerifyTransferRecipient(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, TransferRecipientGIndex)
+}
+
+// ProveAmount returns a merkle proof of the 'Amount' field of the Transfer object
+func (t *Transfer) ProveAmount() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(t, TransferAmountGIndex)
+}
+
+// VerifyTransferAmount verifies a merkle proof of the 'Amount' field of a Transfer object
+func VerifyTransferAmount(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, TransferAmountGIndex)
+}
+
+// ProveFee returns a merkle proof of the 'Fee' field of the Transfer object
+func (t *Transfer) ProveFee() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(t, TransferFeeGIndex)
+}
+
+// VerifyTransferFee verifies a merkle proof of the 'Fee' field of a Transfer object
+func VerifyTransferFee(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, TransferFeeGIndex)
+}
+
+// ProveSlot returns a merkle proof of the 'Slot' field of the Transfer object
+func (t *Transfer) ProveSlot() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(t, TransferSlotGIndex)
+}
+
+// VerifyTransferSlot verifies a merkle proof of the 'Slot' field of a Transfer object
+func VerifyTransferSlot(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, TransferSlotGIndex)
+}
+
+// ProvePubkey returns a merkle proof of the 'Pubkey' field of the Transfer object
+func (t *Transfer) ProvePubkey() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(t, TransferPubkeyGIndex)
+}
+
+// VerifyTransferPubkey verifies a merkle proof of the 'Pubkey' field of a Transfer object
+func VerifyTransferPubkey(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, TransferPubkeyGIndex)
+}
+
+// ProveSignature returns a merkle proof of the 'Signature' field of the Transfer object
+func (t *Transfer) ProveSignature() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(t, TransferSignatureGIndex)
+}
+
+// VerifyTransferSignature verifies a merkle proof of the 'Signature' field of a Transfer object
+func VerifyTransferSignature(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, TransferSignatureGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the Transfer object
+func (t *Transfer) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Sender", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "Recipient", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "Amount", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "Fee", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "Slot", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "Pubkey", Schema: ssz.SchemaBytes(48)},
+		&ssz.SchemaField{Name: "Signature", Schema: ssz.SchemaBytes(96)},
+	)
+}
+
+// MarshalSSZ ssz marshals the BeaconState object
+func (b *BeaconState) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BeaconState object to a target array
+func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	return b.MarshalSSZToFork(buf, ssz.ForkDeneb)
+}
+
+// MarshalSSZFork ssz marshals the BeaconState object for a given fork
+func (b *BeaconState) MarshalSSZFork(fork ssz.Fork) ([]byte, error) {
+	return ssz.MarshalSSZFork(b, fork)
+}
+
+// MarshalSSZToFork ssz marshals the BeaconState object to a target array for a given fork
+func (b *BeaconState) MarshalSSZToFork(buf []byte, fork ssz.Fork) (dst []byte, err error) {
+	dst = buf
+	switch {
+	case fork >= ssz.ForkCapella:
+		offset := int(2736653)
+		// Field (0) 'GenesisTime'
+		dst = ssz.MarshalUint64(dst, b.GenesisTime)
+
+		// Field (1) 'GenesisValidatorsRoot'
+		dst = append(dst, b.GenesisValidatorsRoot[:]...)
+
+		// Field (2) 'Slot'
+		dst = ssz.MarshalUint64(dst, b.Slot)
+
+		// Field (3) 'Fork'
+		if b.Fork == nil {
+			b.Fork = new(Fork)
+		}
+		if dst, err = b.Fork.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Field (4) 'LatestBlockHeader'
+		if b.LatestBlockHeader == nil {
+			b.LatestBlockHeader = new(BeaconBlockHeader)
+		}
+		if dst, err = b.LatestBlockHeader.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Field (5) 'BlockRoots'
+		for ii := 0; ii < 8192; ii++ {
+			dst = append(dst, b.BlockRoots[ii][:]...)
+
+		}
+
+		// Field (6) 'StateRoots'
+		for ii := 0; ii < 8192; ii++ {
+			dst = append(dst, b.StateRoots[ii][:]...)
+
+		}
+
+		// Offset (7) 'HistoricalRoots'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.HistoricalRoots) * 32
+
+		// Field (8) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Offset (9) 'Eth1DataVotes'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.Eth1DataVotes) * 72
+
+		// Field (10) 'Eth1DepositIndex'
+		dst = ssz.MarshalUint64(dst, b.Eth1DepositIndex)
+
+		// Offset (11) 'Validators'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.Validators) * 121
+
+		// Offset (12) 'Balances'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.Balances) * 8
+
+		// Field (13) 'RandaoMixes'
+		for ii := 0; ii < 65536; ii++ {
+			dst = append(dst, b.RandaoMixes[ii][:]...)
+
+		}
+
+		// Field (14) 'Slashings'
+		if size := len(b.Slashings); size != 8192 {
+			err = ssz.ErrVectorLengthFn("BeaconState.Slashings", size, 8192)
+			return
+		}
+		for ii := 0; ii < 8192; ii++ {
+			dst = ssz.MarshalUint64(dst, b.Slashings[ii])
+
+		}
+
+		// Offset (15) 'PreviousEpochParticipation'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.PreviousEpochParticipation)
+
+		// Offset (16) 'CurrentEpochParticipation'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.CurrentEpochParticipation)
+
+		// Field (17) 'JustificationBits'
+		if size := len(b.JustificationBits); size != 1 {
+			err = ssz.ErrBytesLengthFn("BeaconState.JustificationBits", size, 1)
+			return
+		}
+		dst = append(dst, []byte(b.JustificationBits)...)
+
+		// Field (18) 'PreviousJustifiedCheckpoint'
+		if b.PreviousJustifiedCheckpoint == nil {
+			b.PreviousJustifiedCheckpoint = new(Checkpoint)
+		}
+		if dst, err = b.PreviousJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Field (19) 'CurrentJustifiedCheckpoint'
+		if b.CurrentJustifiedCheckpoint == nil {
+			b.CurrentJustifiedCheckpoint = new(Checkpoint)
+		}
+		if dst, err = b.CurrentJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Field (20) 'FinalizedCheckpoint'
+		if b.FinalizedCheckpoint == nil {
+			b.FinalizedCheckpoint = new(Checkpoint)
+		}
+		if dst, err = b.FinalizedCheckpoint.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Offset (21) 'InactivityScores'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.InactivityScores) * 8
+
+		// Field (22) 'CurrentSyncCommittee'
+		if b.CurrentSyncCommittee == nil {
+			b.CurrentSyncCommittee = new(SyncCommittee)
+		}
+		if dst, err = b.CurrentSyncCommittee.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Field (23) 'NextSyncCommittee'
+		if b.NextSyncCommittee == nil {
+			b.NextSyncCommittee = new(SyncCommittee)
+		}
+		if dst, err = b.NextSyncCommittee.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Offset (24) 'LatestExecutionPayloadHeader'
+		dst = ssz.WriteOffset(dst, offset)
+		if b.LatestExecutionPayloadHeader == nil {
+			b.LatestExecutionPayloadHeader = new(ExecutionPayloadHeader)
+		}
+		offset += b.LatestExecutionPayloadHeader.SizeSSZFork(fork)
+
+		// Field (25) 'NextWithdrawalIndex'
+		dst = ssz.MarshalUint64(dst, b.NextWithdrawalIndex)
+
+		// Field (26) 'NextWithdrawalValidatorIndex'
+		dst = ssz.MarshalUint64(dst, b.NextWithdrawalValidatorIndex)
+
+		// Offset (27) 'HistoricalSummaries'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (7) 'HistoricalRoots'
+		if size := len(b.HistoricalRoots); size > 16777216 {
+			err = ssz.ErrListTooBigFn("BeaconState.HistoricalRoots", size, 16777216)
+			return
+		}
+		for ii := 0; ii < len(b.HistoricalRoots); ii++ {
+			if size := len(b.HistoricalRoots[ii]); size != 32 {
+				err = ssz.ErrBytesLengthFn("BeaconState.HistoricalRoots[ii]", size, 32)
+				return
+			}
+			dst = append(dst, b.HistoricalRoots[ii]...)
+
+		}
+
+		// Field (9) 'Eth1DataVotes'
+		if size := len(b.Eth1DataVotes); size > 2048 {
+			err = ssz.ErrListTooBigFn("BeaconState.Eth1DataVotes", size, 2048)
+			return
+		}
+		for ii := 0; ii < len(b.Eth1DataVotes); ii++ {
+			if dst, err = b.Eth1DataVotes[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+		// Field (11) 'Validators'
+		if size := len(b.Validators); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconState.Validators", size, 1099511627776)
+			return
+		}
+		for ii := 0; ii < len(b.Validators); ii++ {
+			if dst, err = b.Validators[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+		// Field (12) 'Balances'
+		if size := len(b.Balances); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconState.Balances", size, 1099511627776)
+			return
+		}
+		for ii := 0; ii < len(b.Balances); ii++ {
+			dst = ssz.MarshalUint64(dst, b.Balances[ii])
+
+		}
+
+		// Field (15) 'PreviousEpochParticipation'
+		if size := len(b.PreviousEpochParticipation); size > 1099511627776 {
+			err = ssz.ErrBytesLengthFn("BeaconState.PreviousEpochParticipation", size, 1099511627776)
+			return
+		}
+		dst = append(dst, b.PreviousEpochParticipation...)
+
+		// Field (16) 'CurrentEpochParticipation'
+		if size := len(b.CurrentEpochParticipation); size > 1099511627776 {
+			err = ssz.ErrBytesLengthFn("BeaconState.CurrentEpochParticipation", size, 1099511627776)
+			return
+		}
+		dst = append(dst, b.CurrentEpochParticipation...)
+
+		// Field (21) 'InactivityScores'
+		if size := len(b.InactivityScores); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconState.InactivityScores", size, 1099511627776)
+			return
+		}
+		for ii := 0; ii < len(b.InactivityScores); ii++ {
+			dst = ssz.MarshalUint64(dst, b.InactivityScores[ii])
+
+		}
+
+		// Field (24) 'LatestExecutionPayloadHeader'
+		if dst, err = b.LatestExecutionPayloadHeader.MarshalSSZToFork(dst, fork); err != nil {
+			return
+		}
+
+		// Field (27) 'HistoricalSummaries'
+		if size := len(b.HistoricalSummaries); size > 16777216 {
+			err = ssz.ErrListTooBigFn("BeaconState.HistoricalSummaries", size, 16777216)
+			return
+		}
+		for ii := 0; ii < len(b.HistoricalSummaries); ii++ {
+			if dst, err = b.HistoricalSummaries[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+	case fork >= ssz.ForkBellatrix:
+		offset := int(2736633)
+		// Field (0) 'GenesisTime'
+		dst = ssz.MarshalUint64(dst, b.GenesisTime)
+
+		// Field (1) 'GenesisValidatorsRoot'
+		dst = append(dst, b.GenesisValidatorsRoot[:]...)
+
+		// Field (2) 'Slot'
+		dst = ssz.MarshalUint64(dst, b.Slot)
+
+		// Field (3) 'Fork'
+		if b.Fork == nil {
+			b.Fork = new(Fork)
+		}
+		if dst, err = b.Fork.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Field (4) 'LatestBlockHeader'
+		if b.LatestBlockHeader == nil {
+			b.LatestBlockHeader = new(BeaconBlockHeader)
+		}
+		if dst, err = b.LatestBlockHeader.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Field (5) 'BlockRoots'
+		for ii := 0; ii < 8192; ii++ {
+			dst = append(dst, b.BlockRoots[ii][:]...)
+
+		}
+
+		// Field (6) 'StateRoots'
+		for ii := 0; ii < 8192; ii++ {
+			dst = append(dst, b.StateRoots[ii][:]...)
+
+		}
+
+		// Offset (7) 'HistoricalRoots'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.HistoricalRoots) * 32
+
+		// Field (8) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Offset (9) 'Eth1DataVotes'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.Eth1DataVotes) * 72
+
+		// Field (10) 'Eth1DepositIndex'
+		dst = ssz.MarshalUint64(dst, b.Eth1DepositIndex)
+
+		// Offset (11) 'Validators'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.Validators) * 121
+
+		// Offset (12) 'Balances'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.Balances) * 8
+
+		// Field (13) 'RandaoMixes'
+		for ii := 0; ii < 65536; ii++ {
+			dst = append(dst, b.RandaoMixes[ii][:]...)
+
+		}
+
+		// Field (14) 'Slashings'
+		if size := len(b.Slashings); size != 8192 {
+			err = ssz.ErrVectorLengthFn("BeaconState.Slashings", size, 8192)
+			return
+		}
+		for ii := 0; ii < 8192; ii++ {
+			dst = ssz.MarshalUint64(dst, b.Slashings[ii])
+
+		}
+
+		// Offset (15) 'PreviousEpochParticipation'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.PreviousEpochParticipation)
+
+		// Offset (16) 'CurrentEpochParticipation'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.CurrentEpochParticipation)
+
+		// Field (17) 'JustificationBits'
+		if size := len(b.JustificationBits); size != 1 {
+			err = ssz.ErrBytesLengthFn("BeaconState.JustificationBits", size, 1)
+			return
+		}
+		dst = append(dst, []byte(b.JustificationBits)...)
+
+		// Field (18) 'PreviousJustifiedCheckpoint'
+		if b.PreviousJustifiedCheckpoint == nil {
+			b.PreviousJustifiedCheckpoint = new(Checkpoint)
+		}
+		if dst, err = b.PreviousJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Field (19) 'CurrentJustifiedCheckpoint'
+		if b.CurrentJustifiedCheckpoint == nil {
+			b.CurrentJustifiedCheckpoint = new(Checkpoint)
+		}
+		if dst, err = b.CurrentJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Field (20) 'FinalizedCheckpoint'
+		if b.FinalizedCheckpoint == nil {
+			b.FinalizedCheckpoint = new(Checkpoint)
+		}
+		if dst, err = b.FinalizedCheckpoint.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Offset (21) 'InactivityScores'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.InactivityScores) * 8
+
+		// Field (22) 'CurrentSyncCommittee'
+		if b.CurrentSyncCommittee == nil {
+			b.CurrentSyncCommittee = new(SyncCommittee)
+		}
+		if dst, err = b.CurrentSyncCommittee.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Field (23) 'NextSyncCommittee'
+		if b.NextSyncCommittee == nil {
+			b.NextSyncCommittee = new(SyncCommittee)
+		}
+		if dst, err = b.NextSyncCommittee.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Offset (24) 'LatestExecutionPayloadHeader'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (7) 'HistoricalRoots'
+		if size := len(b.HistoricalRoots); size > 16777216 {
+			err = ssz.ErrListTooBigFn("BeaconState.HistoricalRoots", size, 16777216)
+			return
+		}
+		for ii := 0; ii < len(b.HistoricalRoots); ii++ {
+			if size := len(b.HistoricalRoots[ii]); size != 32 {
+				err = ssz.ErrBytesLengthFn("BeaconState.HistoricalRoots[ii]", size, 32)
+				return
+			}
+			dst = append(dst, b.HistoricalRoots[ii]...)
+
+		}
+
+		// Field (9) 'Eth1DataVotes'
+		if size := len(b.Eth1DataVotes); size > 2048 {
+			err = ssz.ErrListTooBigFn("BeaconState.Eth1DataVotes", size, 2048)
+			return
+		}
+		for ii := 0; ii < len(b.Eth1DataVotes); ii++ {
+			if dst, err = b.Eth1DataVotes[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+		// Field (11) 'Validators'
+		if size := len(b.Validators); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconState.Validators", size, 1099511627776)
+			return
+		}
+		for ii := 0; ii < len(b.Validators); ii++ {
+			if dst, err = b.Validators[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+		// Field (12) 'Balances'
+		if size := len(b.Balances); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconState.Balances", size, 1099511627776)
+			return
+		}
+		for ii := 0; ii < len(b.Balances); ii++ {
+			dst = ssz.MarshalUint64(dst, b.Balances[ii])
+
+		}
+
+		// Field (15) 'PreviousEpochParticipation'
+		if size := len(b.PreviousEpochParticipation); size > 1099511627776 {
+			err = ssz.ErrBytesLengthFn("BeaconState.PreviousEpochParticipation", size, 1099511627776)
+			return
+		}
+		dst = append(dst, b.PreviousEpochParticipation...)
+
+		// Field (16) 'CurrentEpochParticipation'
+		if size := len(b.CurrentEpochParticipation); size > 1099511627776 {
+			err = ssz.ErrBytesLengthFn("BeaconState.CurrentEpochParticipation", size, 1099511627776)
+			return
+		}
+		dst = append(dst, b.CurrentEpochParticipation...)
+
+		// Field (21) 'InactivityScores'
+		if size := len(b.InactivityScores); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconState.InactivityScores", size, 1099511627776)
+			return
+		}
+		for ii := 0; ii < len(b.InactivityScores); ii++ {
+			dst = ssz.MarshalUint64(dst, b.InactivityScores[ii])
+
+		}
+
+		// Field (24) 'LatestExecutionPayloadHeader'
+		if dst, err = b.LatestExecutionPayloadHeader.MarshalSSZToFork(dst, fork); err != nil {
+			return
+		}
+
+	case fork >= ssz.ForkAltair:
+		offset := int(2736629)
+		// Field (0) 'GenesisTime'
+		dst = ssz.MarshalUint64(dst, b.GenesisTime)
+
+		// Field (1) 'GenesisValidatorsRoot'
+		dst = append(dst, b.GenesisValidatorsRoot[:]...)
+
+		// Field (2) 'Slot'
+		dst = ssz.MarshalUint64(dst, b.Slot)
+
+		// Field (3) 'Fork'
+		if b.Fork == nil {
+			b.Fork = new(Fork)
+		}
+		if dst, err = b.Fork.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Field (4) 'LatestBlockHeader'
+		if b.LatestBlockHeader == nil {
+			b.LatestBlockHeader = new(BeaconBlockHeader)
+		}
+		if dst, err = b.LatestBlockHeader.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Field (5) 'BlockRoots'
+		for ii := 0; ii < 8192; ii++ {
+			dst = append(dst, b.BlockRoots[ii][:]...)
+
+		}
+
+		// Field (6) 'StateRoots'
+		for ii := 0; ii < 8192; ii++ {
+			dst = append(dst, b.StateRoots[ii][:]...)
+
+		}
+
+		// Offset (7) 'HistoricalRoots'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.HistoricalRoots) * 32
+
+		// Field (8) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Offset (9) 'Eth1DataVotes'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.Eth1DataVotes) * 72
+
+		// Field (10) 'Eth1DepositIndex'
+		dst = ssz.MarshalUint64(dst, b.Eth1DepositIndex)
+
+		// Offset (11) 'Validators'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.Validators) * 121
+
+		// Offset (12) 'Balances'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.Balances) * 8
+
+		// Field (13) 'RandaoMixes'
+		for ii := 0; ii < 65536; ii++ {
+			dst = append(dst, b.RandaoMixes[ii][:]...)
+
+		}
+
+		// Field (14) 'Slashings'
+		if size := len(b.Slashings); size != 8192 {
+			err = ssz.ErrVectorLengthFn("BeaconState.Slashings", size, 8192)
+			return
+		}
+		for ii := 0; ii < 8192; ii++ {
+			dst = ssz.MarshalUint64(dst, b.Slashings[ii])
+
+		}
+
+		// Offset (15) 'PreviousEpochParticipation'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.PreviousEpochParticipation)
+
+		// Offset (16) 'CurrentEpochParticipation'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.CurrentEpochParticipation)
+
+		// Field (17) 'JustificationBits'
+		if size := len(b.JustificationBits); size != 1 {
+			err = ssz.ErrBytesLengthFn("BeaconState.JustificationBits", size, 1)
+			return
+		}
+		dst = append(dst, []byte(b.JustificationBits)...)
+
+		// Field (18) 'PreviousJustifiedCheckpoint'
+		if b.PreviousJustifiedCheckpoint == nil {
+			b.PreviousJustifiedCheckpoint = new(Checkpoint)
+		}
+		if dst, err = b.PreviousJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Field (19) 'CurrentJustifiedCheckpoint'
+		if b.CurrentJustifiedCheckpoint == nil {
+			b.CurrentJustifiedCheckpoint = new(Checkpoint)
+		}
+		if dst, err = b.CurrentJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Field (20) 'FinalizedCheckpoint'
+		if b.FinalizedCheckpoint == nil {
+			b.FinalizedCheckpoint = new(Checkpoint)
+		}
+		if dst, err = b.FinalizedCheckpoint.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Offset (21) 'InactivityScores'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (22) 'CurrentSyncCommittee'
+		if b.CurrentSyncCommittee == nil {
+			b.CurrentSyncCommittee = new(SyncCommittee)
+		}
+		if dst, err = b.CurrentSyncCommittee.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Field (23) 'NextSyncCommittee'
+		if b.NextSyncCommittee == nil {
+			b.NextSyncCommittee = new(SyncCommittee)
+		}
+		if dst, err = b.NextSyncCommittee.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Field (7) 'HistoricalRoots'
+		if size := len(b.HistoricalRoots); size > 16777216 {
+			err = ssz.ErrListTooBigFn("BeaconState.HistoricalRoots", size, 16777216)
+			return
+		}
+		for ii := 0; ii < len(b.HistoricalRoots); ii++ {
+			if size := len(b.HistoricalRoots[ii]); size != 32 {
+				err = ssz.ErrBytesLengthFn("BeaconState.HistoricalRoots[ii]", size, 32)
+				return
+			}
+			dst = append(dst, b.HistoricalRoots[ii]...)
+
+		}
+
+		// Field (9) 'Eth1DataVotes'
+		if size := len(b.Eth1DataVotes); size > 2048 {
+			err = ssz.ErrListTooBigFn("BeaconState.Eth1DataVotes", size, 2048)
+			return
+		}
+		for ii := 0; ii < len(b.Eth1DataVotes); ii++ {
+			if dst, err = b.Eth1DataVotes[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+		// Field (11) 'Validators'
+		if size := len(b.Validators); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconState.Validators", size, 1099511627776)
+			return
+		}
+		for ii := 0; ii < len(b.Validators); ii++ {
+			if dst, err = b.Validators[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+		// Field (12) 'Balances'
+		if size := len(b.Balances); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconState.Balances", size, 1099511627776)
+			return
+		}
+		for ii := 0; ii < len(b.Balances); ii++ {
+			dst = ssz.MarshalUint64(dst, b.Balances[ii])
+
+		}
+
+		// Field (15) 'PreviousEpochParticipation'
+		if size := len(b.PreviousEpochParticipation); size > 1099511627776 {
+			err = ssz.ErrBytesLengthFn("BeaconState.PreviousEpochParticipation", size, 1099511627776)
+			return
+		}
+		dst = append(dst, b.PreviousEpochParticipation...)
+
+		// Field (16) 'CurrentEpochParticipation'
+		if size := len(b.CurrentEpochParticipation); size > 1099511627776 {
+			err = ssz.ErrBytesLengthFn("BeaconState.CurrentEpochParticipation", size, 1099511627776)
+			return
+		}
+		dst = append(dst, b.CurrentEpochParticipation...)
+
+		// Field (21) 'InactivityScores'
+		if size := len(b.InactivityScores); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconState.InactivityScores", size, 1099511627776)
+			return
+		}
+		for ii := 0; ii < len(b.InactivityScores); ii++ {
+			dst = ssz.MarshalUint64(dst, b.InactivityScores[ii])
+
+		}
+
+	default:
+		offset := int(2687377)
+		// Field (0) 'GenesisTime'
+		dst = ssz.MarshalUint64(dst, b.GenesisTime)
+
+		// Field (1) 'GenesisValidatorsRoot'
+		dst = append(dst, b.GenesisValidatorsRoot[:]...)
+
+		// Field (2) 'Slot'
+		dst = ssz.MarshalUint64(dst, b.Slot)
+
+		// Field (3) 'Fork'
+		if b.Fork == nil {
+			b.Fork = new(Fork)
+		}
+		if dst, err = b.Fork.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Field (4) 'LatestBlockHeader'
+		if b.LatestBlockHeader == nil {
+			b.LatestBlockHeader = new(BeaconBlockHeader)
+		}
+		if dst, err = b.LatestBlockHeader.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Field (5) 'BlockRoots'
+		for ii := 0; ii < 8192; ii++ {
+			dst = append(dst, b.BlockRoots[ii][:]...)
+
+		}
+
+		// Field (6) 'StateRoots'
+		for ii := 0; ii < 8192; ii++ {
+			dst = append(dst, b.StateRoots[ii][:]...)
+
+		}
+
+		// Offset (7) 'HistoricalRoots'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.HistoricalRoots) * 32
+
+		// Field (8) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Offset (9) 'Eth1DataVotes'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.Eth1DataVotes) * 72
+
+		// Field (10) 'Eth1DepositIndex'
+		dst = ssz.MarshalUint64(dst, b.Eth1DepositIndex)
+
+		// Offset (11) 'Validators'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.Validators) * 121
+
+		// Offset (12) 'Balances'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.Balances) * 8
+
+		// Field (13) 'RandaoMixes'
+		for ii := 0; ii < 65536; ii++ {
+			dst = append(dst, b.RandaoMixes[ii][:]...)
+
+		}
+
+		// Field (14) 'Slashings'
+		if size := len(b.Slashings); size != 8192 {
+			err = ssz.ErrVectorLengthFn("BeaconState.Slashings", size, 8192)
+			return
+		}
+		for ii := 0; ii < 8192; ii++ {
+			dst = ssz.MarshalUint64(dst, b.Slashings[ii])
+
+		}
+
+		// Offset (15) 'PreviousEpochAttestations'
+		dst = ssz.WriteOffset(dst, offset)
+		for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
+			offset += 4
+			offset += b.PreviousEpochAttestations[ii].SizeSSZ()
+		}
+
+		// Offset (16) 'CurrentEpochAttestations'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (17) 'JustificationBits'
+		if size := len(b.JustificationBits); size != 1 {
+			err = ssz.ErrBytesLengthFn("BeaconState.JustificationBits", size, 1)
+			return
+		}
+		dst = append(dst, []byte(b.JustificationBits)...)
+
+		// Field (18) 'PreviousJustifiedCheckpoint'
+		if b.PreviousJustifiedCheckpoint == nil {
+			b.PreviousJustifiedCheckpoint = new(Checkpoint)
+		}
+		if dst, err = b.PreviousJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Field (19) 'CurrentJustifiedCheckpoint'
+		if b.CurrentJustifiedCheckpoint == nil {
+			b.CurrentJustifiedCheckpoint = new(Checkpoint)
+		}
+		if dst, err = b.CurrentJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Field (20) 'FinalizedCheckpoint'
+		if b.FinalizedCheckpoint == nil {
+			b.FinalizedCheckpoint = new(Checkpoint)
+		}
+		if dst, err = b.FinalizedCheckpoint.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Field (7) 'HistoricalRoots'
+		if size := len(b.HistoricalRoots); size > 16777216 {
+			err = ssz.ErrListTooBigFn("BeaconState.HistoricalRoots", size, 16777216)
+			return
+		}
+		for ii := 0; ii < len(b.HistoricalRoots); ii++ {
+			if size := len(b.HistoricalRoots[ii]); size != 32 {
+				err = ssz.ErrBytesLengthFn("BeaconState.HistoricalRoots[ii]", size, 32)
+				return
+			}
+			dst = append(dst, b.HistoricalRoots[ii]...)
+
+		}
+
+		// Field (9) 'Eth1DataVotes'
+		if size := len(b.Eth1DataVotes); size > 2048 {
+			err = ssz.ErrListTooBigFn("BeaconState.Eth1DataVotes", size, 2048)
+			return
+		}
+		for ii := 0; ii < len(b.Eth1DataVotes); ii++ {
+			if dst, err = b.Eth1DataVotes[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+		// Field (11) 'Validators'
+		if size := len(b.Validators); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconState.Validators", size, 1099511627776)
+			return
+		}
+		for ii := 0; ii < len(b.Validators); ii++ {
+			if dst, err = b.Validators[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+		// Field (12) 'Balances'
+		if size := len(b.Balances); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconState.Balances", size, 1099511627776)
+			return
+		}
+		for ii := 0; ii < len(b.Balances); ii++ {
+			dst = ssz.MarshalUint64(dst, b.Balances[ii])
+
+		}
+
+		// Field (15) 'PreviousEpochAttestations'
+		if size := len(b.PreviousEpochAttestations); size > 4096 {
+			err = ssz.ErrListTooBigFn("BeaconState.PreviousEpochAttestations", size, 4096)
+			return
+		}
+		{
+			offset = 4 * len(b.PreviousEpochAttestations)
+			for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
+				dst = ssz.WriteOffset(dst, offset)
+				offset += b.PreviousEpochAttestations[ii].SizeSSZ()
+
+			}
+		}
+		for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
+			if dst, err = b.PreviousEpochAttestations[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+		// Field (16) 'CurrentEpochAttestations'
+		if size := len(b.CurrentEpochAttestations); size > 4096 {
+			err = ssz.ErrListTooBigFn("BeaconState.CurrentEpochAttestations", size, 4096)
+			return
+		}
+		{
+			offset = 4 * len(b.CurrentEpochAttestations)
+			for ii := 0; ii < len(b.CurrentEpochAttestations); ii++ {
+				dst = ssz.WriteOffset(dst, offset)
+				offset += b.CurrentEpochAttestations[ii].SizeSSZ()
+
+			}
+		}
+		for ii := 0; ii < len(b.CurrentEpochAttestations); ii++ {
+			if dst, err = b.CurrentEpochAttestations[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+	}
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the BeaconState object to a writer
+func (b *BeaconState) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(b, writer)
+}
+
+// MarshalSSZToStream ssz marshals the BeaconState object to a target array that is flushed to a stream writer
+func (b *BeaconState) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	return b.MarshalSSZToStreamFork(buf, sw, ssz.ForkDeneb)
+}
+
+// MarshalSSZToWriterFork ssz marshals the BeaconState object to a writer for a given fork
+func (b *BeaconState) MarshalSSZToWriterFork(writer io.Writer, fork ssz.Fork) (int, error) {
+	return ssz.MarshalSSZToWriterFork(b, writer, fork)
+}
+
+// MarshalSSZToStreamFork ssz marshals the BeaconState object to a target array that is flushed to a stream writer for a given fork
+func (b *BeaconState) MarshalSSZToStreamFork(buf []byte, sw *ssz.StreamWriter, fork ssz.Fork) (dst []byte, err error) {
+	dst = buf
+	switch {
+	case fork >= ssz.ForkCapella:
+		offset := int(2736653)
+		// Field (0) 'GenesisTime'
+		dst = ssz.MarshalUint64(dst, b.GenesisTime)
+
+		// Field (1) 'GenesisValidatorsRoot'
+		dst = append(dst, b.GenesisValidatorsRoot[:]...)
+
+		// Field (2) 'Slot'
+		dst = ssz.MarshalUint64(dst, b.Slot)
+
+		// Field (3) 'Fork'
+		if b.Fork == nil {
+			b.Fork = new(Fork)
+		}
+		if dst, err = b.Fork.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Field (4) 'LatestBlockHeader'
+		if b.LatestBlockHeader == nil {
+			b.LatestBlockHeader = new(BeaconBlockHeader)
+		}
+		if dst, err = b.LatestBlockHeader.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Field (5) 'BlockRoots'
+		for ii := 0; ii < 8192; ii++ {
+			dst = append(dst, b.BlockRoots[ii][:]...)
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (6) 'StateRoots'
+		for ii := 0; ii < 8192; ii++ {
+			dst = append(dst, b.StateRoots[ii][:]...)
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Offset (7) 'HistoricalRoots'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.HistoricalRoots) * 32
+
+		// Field (8) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if dst, err = b.Eth1Data.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Offset (9) 'Eth1DataVotes'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.Eth1DataVotes) * 72
+
+		// Field (10) 'Eth1DepositIndex'
+		dst = ssz.MarshalUint64(dst, b.Eth1DepositIndex)
+
+		// Offset (11) 'Validators'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.Validators) * 121
+
+		// Offset (12) 'Balances'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.Balances) * 8
+
+		// Field (13) 'RandaoMixes'
+		for ii := 0; ii < 65536; ii++ {
+			dst = append(dst, b.RandaoMixes[ii][:]...)
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (14) 'Slashings'
+		if size := len(b.Slashings); size != 8192 {
+			err = ssz.ErrVectorLengthFn("BeaconState.Slashings", size, 8192)
+			return
+		}
+		for ii := 0; ii < 8192; ii++ {
+			dst = ssz.MarshalUint64(dst, b.Slashings[ii])
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Offset (15) 'PreviousEpochParticipation'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.PreviousEpochParticipation)
+
+		// Offset (16) 'CurrentEpochParticipation'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.CurrentEpochParticipation)
+
+		// Field (17) 'JustificationBits'
+		if size := len(b.JustificationBits); size != 1 {
+			err = ssz.ErrBytesLengthFn("BeaconState.JustificationBits", size, 1)
+			return
+		}
+		dst = append(dst, []byte(b.JustificationBits)...)
+
+		// Field (18) 'PreviousJustifiedCheckpoint'
+		if b.PreviousJustifiedCheckpoint == nil {
+			b.PreviousJustifiedCheckpoint = new(Checkpoint)
+		}
+		if dst, err = b.PreviousJustifiedCheckpoint.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Field (19) 'CurrentJustifiedCheckpoint'
+		if b.CurrentJustifiedCheckpoint == nil {
+			b.CurrentJustifiedCheckpoint = new(Checkpoint)
+		}
+		if dst, err = b.CurrentJustifiedCheckpoint.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Field (20) 'FinalizedCheckpoint'
+		if b.FinalizedCheckpoint == nil {
+			b.FinalizedCheckpoint = new(Checkpoint)
+		}
+		if dst, err = b.FinalizedCheckpoint.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Offset (21) 'InactivityScores'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.InactivityScores) * 8
+
+		// Field (22) 'CurrentSyncCommittee'
+		if b.CurrentSyncCommittee == nil {
+			b.CurrentSyncCommittee = new(SyncCommittee)
+		}
+		if dst, err = b.CurrentSyncCommittee.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Field (23) 'NextSyncCommittee'
+		if b.NextSyncCommittee == nil {
+			b.NextSyncCommittee = new(SyncCommittee)
+		}
+		if dst, err = b.NextSyncCommittee.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Offset (24) 'LatestExecutionPayloadHeader'
+		dst = ssz.WriteOffset(dst, offset)
+		if b.LatestExecutionPayloadHeader == nil {
+			b.LatestExecutionPayloadHeader = new(ExecutionPayloadHeader)
+		}
+		offset += b.LatestExecutionPayloadHeader.SizeSSZFork(fork)
+
+		// Field (25) 'NextWithdrawalIndex'
+		dst = ssz.MarshalUint64(dst, b.NextWithdrawalIndex)
+
+		// Field (26) 'NextWithdrawalValidatorIndex'
+		dst = ssz.MarshalUint64(dst, b.NextWithdrawalValidatorIndex)
+
+		// Offset (27) 'HistoricalSummaries'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (7) 'HistoricalRoots'
+		if size := len(b.HistoricalRoots); size > 16777216 {
+			err = ssz.ErrListTooBigFn("BeaconState.HistoricalRoots", size, 16777216)
+			return
+		}
+		for ii := 0; ii < len(b.HistoricalRoots); ii++ {
+			if size := len(b.HistoricalRoots[ii]); size != 32 {
+				err = ssz.ErrBytesLengthFn("BeaconState.HistoricalRoots[ii]", size, 32)
+				return
+			}
+			dst = append(dst, b.HistoricalRoots[ii]...)
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (9) 'Eth1DataVotes'
+		if size := len(b.Eth1DataVotes); size > 2048 {
+			err = ssz.ErrListTooBigFn("BeaconState.Eth1DataVotes", size, 2048)
+			return
+		}
+		for ii := 0; ii < len(b.Eth1DataVotes); ii++ {
+			if dst, err = b.Eth1DataVotes[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (11) 'Validators'
+		if size := len(b.Validators); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconState.Validators", size, 1099511627776)
+			return
+		}
+		for ii := 0; ii < len(b.Validators); ii++ {
+			if dst, err = b.Validators[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (12) 'Balances'
+		if size := len(b.Balances); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconState.Balances", size, 1099511627776)
+			return
+		}
+		for ii := 0; ii < len(b.Balances); ii++ {
+			dst = ssz.MarshalUint64(dst, b.Balances[ii])
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (15) 'PreviousEpochParticipation'
+		if size := len(b.PreviousEpochParticipation); size > 1099511627776 {
+			err = ssz.ErrBytesLengthFn("BeaconState.PreviousEpochParticipation", size, 1099511627776)
+			return
+		}
+		if dst, err = sw.Append(dst, b.PreviousEpochParticipation); err != nil {
+			return
+		}
+
+		// Field (16) 'CurrentEpochParticipation'
+		if size := len(b.CurrentEpochParticipation); size > 1099511627776 {
+			err = ssz.ErrBytesLengthFn("BeaconState.CurrentEpochParticipation", size, 1099511627776)
+			return
+		}
+		if dst, err = sw.Append(dst, b.CurrentEpochParticipation); err != nil {
+			return
+		}
+
+		// Field (21) 'InactivityScores'
+		if size := len(b.InactivityScores); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconState.InactivityScores", size, 1099511627776)
+			return
+		}
+		for ii := 0; ii < len(b.InactivityScores); ii++ {
+			dst = ssz.MarshalUint64(dst, b.InactivityScores[ii])
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (24) 'LatestExecutionPayloadHeader'
+		if dst, err = b.LatestExecutionPayloadHeader.MarshalSSZToStreamFork(dst, sw, fork); err != nil {
+			return
+		}
+
+		// Field (27) 'HistoricalSummaries'
+		if size := len(b.HistoricalSummaries); size > 16777216 {
+			err = ssz.ErrListTooBigFn("BeaconState.HistoricalSummaries", size, 16777216)
+			return
+		}
+		for ii := 0; ii < len(b.HistoricalSummaries); ii++ {
+			if dst, err = b.HistoricalSummaries[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+	case fork >= ssz.ForkBellatrix:
+		offset := int(2736633)
+		// Field (0) 'GenesisTime'
+		dst = ssz.MarshalUint64(dst, b.GenesisTime)
+
+		// Field (1) 'GenesisValidatorsRoot'
+		dst = append(dst, b.GenesisValidatorsRoot[:]...)
+
+		// Field (2) 'Slot'
+		dst = ssz.MarshalUint64(dst, b.Slot)
+
+		// Field (3) 'Fork'
+		if b.Fork == nil {
+			b.Fork = new(Fork)
+		}
+		if dst, err = b.Fork.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Field (4) 'LatestBlockHeader'
+		if b.LatestBlockHeader == nil {
+			b.LatestBlockHeader = new(BeaconBlockHeader)
+		}
+		if dst, err = b.LatestBlockHeader.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Field (5) 'BlockRoots'
+		for ii := 0; ii < 8192; ii++ {
+			dst = append(dst, b.BlockRoots[ii][:]...)
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (6) 'StateRoots'
+		for ii := 0; ii < 8192; ii++ {
+			dst = append(dst, b.StateRoots[ii][:]...)
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Offset (7) 'HistoricalRoots'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.HistoricalRoots) * 32
+
+		// Field (8) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if dst, err = b.Eth1Data.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Offset (9) 'Eth1DataVotes'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.Eth1DataVotes) * 72
+
+		// Field (10) 'Eth1DepositIndex'
+		dst = ssz.MarshalUint64(dst, b.Eth1DepositIndex)
+
+		// Offset (11) 'Validators'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.Validators) * 121
+
+		// Offset (12) 'Balances'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.Balances) * 8
+
+		// Field (13) 'RandaoMixes'
+		for ii := 0; ii < 65536; ii++ {
+			dst = append(dst, b.RandaoMixes[ii][:]...)
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (14) 'Slashings'
+		if size := len(b.Slashings); size != 8192 {
+			err = ssz.ErrVectorLengthFn("BeaconState.Slashings", size, 8192)
+			return
+		}
+		for ii := 0; ii < 8192; ii++ {
+			dst = ssz.MarshalUint64(dst, b.Slashings[ii])
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Offset (15) 'PreviousEpochParticipation'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.PreviousEpochParticipation)
+
+		// Offset (16) 'CurrentEpochParticipation'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.CurrentEpochParticipation)
+
+		// Field (17) 'JustificationBits'
+		if size := len(b.JustificationBits); size != 1 {
+			err = ssz.ErrBytesLengthFn("BeaconState.JustificationBits", size, 1)
+			return
+		}
+		dst = append(dst, []byte(b.JustificationBits)...)
+
+		// Field (18) 'PreviousJustifiedCheckpoint'
+		if b.PreviousJustifiedCheckpoint == nil {
+			b.PreviousJustifiedCheckpoint = new(Checkpoint)
+		}
+		if dst, err = b.PreviousJustifiedCheckpoint.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Field (19) 'CurrentJustifiedCheckpoint'
+		if b.CurrentJustifiedCheckpoint == nil {
+			b.CurrentJustifiedCheckpoint = new(Checkpoint)
+		}
+		if dst, err = b.CurrentJustifiedCheckpoint.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Field (20) 'FinalizedCheckpoint'
+		if b.FinalizedCheckpoint == nil {
+			b.FinalizedCheckpoint = new(Checkpoint)
+		}
+		if dst, err = b.FinalizedCheckpoint.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Offset (21) 'InactivityScores'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.InactivityScores) * 8
+
+		// Field (22) 'CurrentSyncCommittee'
+		if b.CurrentSyncCommittee == nil {
+			b.CurrentSyncCommittee = new(SyncCommittee)
+		}
+		if dst, err = b.CurrentSyncCommittee.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Field (23) 'NextSyncCommittee'
+		if b.NextSyncCommittee == nil {
+			b.NextSyncCommittee = new(SyncCommittee)
+		}
+		if dst, err = b.NextSyncCommittee.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Offset (24) 'LatestExecutionPayloadHeader'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (7) 'HistoricalRoots'
+		if size := len(b.HistoricalRoots); size > 16777216 {
+			err = ssz.ErrListTooBigFn("BeaconState.HistoricalRoots", size, 16777216)
+			return
+		}
+		for ii := 0; ii < len(b.HistoricalRoots); ii++ {
+			if size := len(b.HistoricalRoots[ii]); size != 32 {
+				err = ssz.ErrBytesLengthFn("BeaconState.HistoricalRoots[ii]", size, 32)
+				return
+			}
+			dst = append(dst, b.HistoricalRoots[ii]...)
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (9) 'Eth1DataVotes'
+		if size := len(b.Eth1DataVotes); size > 2048 {
+			err = ssz.ErrListTooBigFn("BeaconState.Eth1DataVotes", size, 2048)
+			return
+		}
+		for ii := 0; ii < len(b.Eth1DataVotes); ii++ {
+			if dst, err = b.Eth1DataVotes[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (11) 'Validators'
+		if size := len(b.Validators); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconState.Validators", size, 1099511627776)
+			return
+		}
+		for ii := 0; ii < len(b.Validators); ii++ {
+			if dst, err = b.Validators[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (12) 'Balances'
+		if size := len(b.Balances); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconState.Balances", size, 1099511627776)
+			return
+		}
+		for ii := 0; ii < len(b.Balances); ii++ {
+			dst = ssz.MarshalUint64(dst, b.Balances[ii])
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (15) 'PreviousEpochParticipation'
+		if size := len(b.PreviousEpochParticipation); size > 1099511627776 {
+			err = ssz.ErrBytesLengthFn("BeaconState.PreviousEpochParticipation", size, 1099511627776)
+			return
+		}
+		if dst, err = sw.Append(dst, b.PreviousEpochParticipation); err != nil {
+			return
+		}
+
+		// Field (16) 'CurrentEpochParticipation'
+		if size := len(b.CurrentEpochParticipation); size > 1099511627776 {
+			err = ssz.ErrBytesLengthFn("BeaconState.CurrentEpochParticipation", size, 1099511627776)
+			return
+		}
+		if dst, err = sw.Append(dst, b.CurrentEpochParticipation); err != nil {
+			return
+		}
+
+		// Field (21) 'InactivityScores'
+		if size := len(b.InactivityScores); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconState.InactivityScores", size, 1099511627776)
+			return
+		}
+		for ii := 0; ii < len(b.InactivityScores); ii++ {
+			dst = ssz.MarshalUint64(dst, b.InactivityScores[ii])
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (24) 'LatestExecutionPayloadHeader'
+		if dst, err = b.LatestExecutionPayloadHeader.MarshalSSZToStreamFork(dst, sw, fork); err != nil {
+			return
+		}
+
+	case fork >= ssz.ForkAltair:
+		offset := int(2736629)
+		// Field (0) 'GenesisTime'
+		dst = ssz.MarshalUint64(dst, b.GenesisTime)
+
+		// Field (1) 'GenesisValidatorsRoot'
+		dst = append(dst, b.GenesisValidatorsRoot[:]...)
+
+		// Field (2) 'Slot'
+		dst = ssz.MarshalUint64(dst, b.Slot)
+
+		// Field (3) 'Fork'
+		if b.Fork == nil {
+			b.Fork = new(Fork)
+		}
+		if dst, err = b.Fork.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Field (4) 'LatestBlockHeader'
+		if b.LatestBlockHeader == nil {
+			b.LatestBlockHeader = new(BeaconBlockHeader)
+		}
+		if dst, err = b.LatestBlockHeader.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Field (5) 'BlockRoots'
+		for ii := 0; ii < 8192; ii++ {
+			dst = append(dst, b.BlockRoots[ii][:]...)
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (6) 'StateRoots'
+		for ii := 0; ii < 8192; ii++ {
+			dst = append(dst, b.StateRoots[ii][:]...)
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Offset (7) 'HistoricalRoots'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.HistoricalRoots) * 32
+
+		// Field (8) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if dst, err = b.Eth1Data.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Offset (9) 'Eth1DataVotes'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.Eth1DataVotes) * 72
+
+		// Field (10) 'Eth1DepositIndex'
+		dst = ssz.MarshalUint64(dst, b.Eth1DepositIndex)
+
+		// Offset (11) 'Validators'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.Validators) * 121
+
+		// Offset (12) 'Balances'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.Balances) * 8
+
+		// Field (13) 'RandaoMixes'
+		for ii := 0; ii < 65536; ii++ {
+			dst = append(dst, b.RandaoMixes[ii][:]...)
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (14) 'Slashings'
+		if size := len(b.Slashings); size != 8192 {
+			err = ssz.ErrVectorLengthFn("BeaconState.Slashings", size, 8192)
+			return
+		}
+		for ii := 0; ii < 8192; ii++ {
+			dst = ssz.MarshalUint64(dst, b.Slashings[ii])
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Offset (15) 'PreviousEpochParticipation'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.PreviousEpochParticipation)
+
+		// Offset (16) 'CurrentEpochParticipation'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.CurrentEpochParticipation)
+
+		// Field (17) 'JustificationBits'
+		if size := len(b.JustificationBits); size != 1 {
+			err = ssz.ErrBytesLengthFn("BeaconState.JustificationBits", size, 1)
+			return
+		}
+		dst = append(dst, []byte(b.JustificationBits)...)
+
+		// Field (18) 'PreviousJustifiedCheckpoint'
+		if b.PreviousJustifiedCheckpoint == nil {
+			b.PreviousJustifiedCheckpoint = new(Checkpoint)
+		}
+		if dst, err = b.PreviousJustifiedCheckpoint.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Field (19) 'CurrentJustifiedCheckpoint'
+		if b.CurrentJustifiedCheckpoint == nil {
+			b.CurrentJustifiedCheckpoint = new(Checkpoint)
+		}
+		if dst, err = b.CurrentJustifiedCheckpoint.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Field (20) 'FinalizedCheckpoint'
+		if b.FinalizedCheckpoint == nil {
+			b.FinalizedCheckpoint = new(Checkpoint)
+		}
+		if dst, err = b.FinalizedCheckpoint.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Offset (21) 'InactivityScores'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (22) 'CurrentSyncCommittee'
+		if b.CurrentSyncCommittee == nil {
+			b.CurrentSyncCommittee = new(SyncCommittee)
+		}
+		if dst, err = b.CurrentSyncCommittee.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Field (23) 'NextSyncCommittee'
+		if b.NextSyncCommittee == nil {
+			b.NextSyncCommittee = new(SyncCommittee)
+		}
+		if dst, err = b.NextSyncCommittee.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Field (7) 'HistoricalRoots'
+		if size := len(b.HistoricalRoots); size > 16777216 {
+			err = ssz.ErrListTooBigFn("BeaconState.HistoricalRoots", size, 16777216)
+			return
+		}
+		for ii := 0; ii < len(b.HistoricalRoots); ii++ {
+			if size := len(b.HistoricalRoots[ii]); size != 32 {
+				err = ssz.ErrBytesLengthFn("BeaconState.HistoricalRoots[ii]", size, 32)
+				return
+			}
+			dst = append(dst, b.HistoricalRoots[ii]...)
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (9) 'Eth1DataVotes'
+		if size := len(b.Eth1DataVotes); size > 2048 {
+			err = ssz.ErrListTooBigFn("BeaconState.Eth1DataVotes", size, 2048)
+			return
+		}
+		for ii := 0; ii < len(b.Eth1DataVotes); ii++ {
+			if dst, err = b.Eth1DataVotes[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (11) 'Validators'
+		if size := len(b.Validators); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconState.Validators", size, 1099511627776)
+			return
+		}
+		for ii := 0; ii < len(b.Validators); ii++ {
+			if dst, err = b.Validators[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (12) 'Balances'
+		if size := len(b.Balances); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconState.Balances", size, 1099511627776)
+			return
+		}
+		for ii := 0; ii < len(b.Balances); ii++ {
+			dst = ssz.MarshalUint64(dst, b.Balances[ii])
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (15) 'PreviousEpochParticipation'
+		if size := len(b.PreviousEpochParticipation); size > 1099511627776 {
+			err = ssz.ErrBytesLengthFn("BeaconState.PreviousEpochParticipation", size, 1099511627776)
+			return
+		}
+		if dst, err = sw.Append(dst, b.PreviousEpochParticipation); err != nil {
+			return
+		}
+
+		// Field (16) 'CurrentEpochParticipation'
+		if size := len(b.CurrentEpochParticipation); size > 1099511627776 {
+			err = ssz.ErrBytesLengthFn("BeaconState.CurrentEpochParticipation", size, 1099511627776)
+			return
+		}
+		if dst, err = sw.Append(dst, b.CurrentEpochParticipation); err != nil {
+			return
+		}
+
+		// Field (21) 'InactivityScores'
+		if size := len(b.InactivityScores); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconState.InactivityScores", size, 1099511627776)
+			return
+		}
+		for ii := 0; ii < len(b.InactivityScores); ii++ {
+			dst = ssz.MarshalUint64(dst, b.InactivityScores[ii])
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+	default:
+		offset := int(2687377)
+		// Field (0) 'GenesisTime'
+		dst = ssz.MarshalUint64(dst, b.GenesisTime)
+
+		// Field (1) 'GenesisValidatorsRoot'
+		dst = append(dst, b.GenesisValidatorsRoot[:]...)
+
+		// Field (2) 'Slot'
+		dst = ssz.MarshalUint64(dst, b.Slot)
+
+		// Field (3) 'Fork'
+		if b.Fork == nil {
+			b.Fork = new(Fork)
+		}
+		if dst, err = b.Fork.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Field (4) 'LatestBlockHeader'
+		if b.LatestBlockHeader == nil {
+			b.LatestBlockHeader = new(BeaconBlockHeader)
+		}
+		if dst, err = b.LatestBlockHeader.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Field (5) 'BlockRoots'
+		for ii := 0; ii < 8192; ii++ {
+			dst = append(dst, b.BlockRoots[ii][:]...)
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (6) 'StateRoots'
+		for ii := 0; ii < 8192; ii++ {
+			dst = append(dst, b.StateRoots[ii][:]...)
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Offset (7) 'HistoricalRoots'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.HistoricalRoots) * 32
+
+		// Field (8) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if dst, err = b.Eth1Data.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Offset (9) 'Eth1DataVotes'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.Eth1DataVotes) * 72
+
+		// Field (10) 'Eth1DepositIndex'
+		dst = ssz.MarshalUint64(dst, b.Eth1DepositIndex)
+
+		// Offset (11) 'Validators'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.Validators) * 121
+
+		// Offset (12) 'Balances'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.Balances) * 8
+
+		// Field (13) 'RandaoMixes'
+		for ii := 0; ii < 65536; ii++ {
+			dst = append(dst, b.RandaoMixes[ii][:]...)
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (14) 'Slashings'
+		if size := len(b.Slashings); size != 8192 {
+			err = ssz.ErrVectorLengthFn("BeaconState.Slashings", size, 8192)
+			return
+		}
+		for ii := 0; ii < 8192; ii++ {
+			dst = ssz.MarshalUint64(dst, b.Slashings[ii])
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Offset (15) 'PreviousEpochAttestations'
+		dst = ssz.WriteOffset(dst, offset)
+		for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
+			offset += 4
+			offset += b.PreviousEpochAttestations[ii].SizeSSZ()
+		}
+
+		// Offset (16) 'CurrentEpochAttestations'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (17) 'JustificationBits'
+		if size := len(b.JustificationBits); size != 1 {
+			err = ssz.ErrBytesLengthFn("BeaconState.JustificationBits", size, 1)
+			return
+		}
+		dst = append(dst, []byte(b.JustificationBits)...)
+
+		// Field (18) 'PreviousJustifiedCheckpoint'
+		if b.PreviousJustifiedCheckpoint == nil {
+			b.PreviousJustifiedCheckpoint = new(Checkpoint)
+		}
+		if dst, err = b.PreviousJustifiedCheckpoint.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Field (19) 'CurrentJustifiedCheckpoint'
+		if b.CurrentJustifiedCheckpoint == nil {
+			b.CurrentJustifiedCheckpoint = new(Checkpoint)
+		}
+		if dst, err = b.CurrentJustifiedCheckpoint.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Field (20) 'FinalizedCheckpoint'
+		if b.FinalizedCheckpoint == nil {
+			b.FinalizedCheckpoint = new(Checkpoint)
+		}
+		if dst, err = b.FinalizedCheckpoint.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Field (7) 'HistoricalRoots'
+		if size := len(b.HistoricalRoots); size > 16777216 {
+			err = ssz.ErrListTooBigFn("BeaconState.HistoricalRoots", size, 16777216)
+			return
+		}
+		for ii := 0; ii < len(b.HistoricalRoots); ii++ {
+			if size := len(b.HistoricalRoots[ii]); size != 32 {
+				err = ssz.ErrBytesLengthFn("BeaconState.HistoricalRoots[ii]", size, 32)
+				return
+			}
+			dst = append(dst, b.HistoricalRoots[ii]...)
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (9) 'Eth1DataVotes'
+		if size := len(b.Eth1DataVotes); size > 2048 {
+			err = ssz.ErrListTooBigFn("BeaconState.Eth1DataVotes", size, 2048)
+			return
+		}
+		for ii := 0; ii < len(b.Eth1DataVotes); ii++ {
+			if dst, err = b.Eth1DataVotes[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (11) 'Validators'
+		if size := len(b.Validators); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconState.Validators", size, 1099511627776)
+			return
+		}
+		for ii := 0; ii < len(b.Validators); ii++ {
+			if dst, err = b.Validators[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (12) 'Balances'
+		if size := len(b.Balances); size > 1099511627776 {
+			err = ssz.ErrListTooBigFn("BeaconState.Balances", size, 1099511627776)
+			return
+		}
+		for ii := 0; ii < len(b.Balances); ii++ {
+			dst = ssz.MarshalUint64(dst, b.Balances[ii])
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (15) 'PreviousEpochAttestations'
+		if size := len(b.PreviousEpochAttestations); size > 4096 {
+			err = ssz.ErrListTooBigFn("BeaconState.PreviousEpochAttestations", size, 4096)
+			return
+		}
+		{
+			offset = 4 * len(b.PreviousEpochAttestations)
+			for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
+				dst = ssz.WriteOffset(dst, offset)
+				offset += b.PreviousEpochAttestations[ii].SizeSSZ()
+				if dst, err = sw.Flush(dst); err != nil {
+					return
+				}
+			}
+		}
+		for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
+			if dst, err = b.PreviousEpochAttestations[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (16) 'CurrentEpochAttestations'
+		if size := len(b.CurrentEpochAttestations); size > 4096 {
+			err = ssz.ErrListTooBigFn("BeaconState.CurrentEpochAttestations", size, 4096)
+			return
+		}
+		{
+			offset = 4 * len(b.CurrentEpochAttestations)
+			for ii := 0; ii < len(b.CurrentEpochAttestations); ii++ {
+				dst = ssz.WriteOffset(dst, offset)
+				offset += b.CurrentEpochAttestations[ii].SizeSSZ()
+				if dst, err = sw.Flush(dst); err != nil {
+					return
+				}
+			}
+		}
+		for ii := 0; ii < len(b.CurrentEpochAttestations); ii++ {
+			if dst, err = b.CurrentEpochAttestations[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+	}
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BeaconState object
+func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
+	return b.UnmarshalSSZForkWithOptions(buf, ssz.ForkDeneb, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the BeaconState object with the decoding options
+func (b *BeaconState) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	return b.UnmarshalSSZForkWithOptions(buf, ssz.ForkDeneb, opts)
+}
+
+// UnmarshalSSZFork ssz unmarshals the BeaconState object for a given fork
+func (b *BeaconState) UnmarshalSSZFork(buf []byte, fork ssz.Fork) error {
+	return b.UnmarshalSSZForkWithOptions(buf, fork, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZForkWithOptions ssz unmarshals the BeaconState object for a given fork with the decoding options
+func (b *BeaconState) UnmarshalSSZForkWithOptions(buf []byte, fork ssz.Fork, opts ssz.UnmarshalOptions) error {
+	var err error
+	switch {
+	case fork >= ssz.ForkCapella:
+		size := uint64(len(buf))
+		if size < 2736653 {
+			return ssz.ErrSize
+		}
+
+		tail := buf
+		var o7, o9, o11, o12, o15, o16, o21, o24, o27 uint64
+
+		// Field (0) 'GenesisTime'
+		b.GenesisTime = ssz.UnmarshallUint64(buf[0:8])
+
+		// Field (1) 'GenesisValidatorsRoot'
+		copy(b.GenesisValidatorsRoot[:], buf[8:40])
+
+		// Field (2) 'Slot'
+		b.Slot = ssz.UnmarshallUint64(buf[40:48])
+
+		// Field (3) 'Fork'
+		if b.Fork == nil {
+			b.Fork = new(Fork)
+		}
+		if err = b.Fork.UnmarshalSSZWithOptions(buf[48:64], opts); err != nil {
+			return err
+		}
+
+		// Field (4) 'LatestBlockHeader'
+		if b.LatestBlockHeader == nil {
+			b.LatestBlockHeader = new(BeaconBlockHeader)
+		}
+		if err = b.LatestBlockHeader.UnmarshalSSZWithOptions(buf[64:176], opts); err != nil {
+			return err
+		}
+
+		// Field (5) 'BlockRoots'
+
+		for ii := 0; ii < 8192; ii++ {
+			copy(b.BlockRoots[ii][:], buf[176:262320][ii*32:(ii+1)*32])
+		}
+
+		// Field (6) 'StateRoots'
+
+		for ii := 0; ii < 8192; ii++ {
+			copy(b.StateRoots[ii][:], buf[262320:524464][ii*32:(ii+1)*32])
+		}
+
+		// Offset (7) 'HistoricalRoots'
+		if o7 = ssz.ReadOffset(buf[524464:524468]); o7 > size {
+			return ssz.ErrOffset
+		}
+
+		if err = opts.ValidateFirstOffset(o7, 2736653); err != nil {
+			return err
+		}
+
+		// Field (8) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if err = b.Eth1Data.UnmarshalSSZWithOptions(buf[524468:524540], opts); err != nil {
+			return err
+		}
+
+		// Offset (9) 'Eth1DataVotes'
+		if o9 = ssz.ReadOffset(buf[524540:524544]); o9 > size || o7 > o9 {
+			return ssz.ErrOffset
+		}
+
+		// Field (10) 'Eth1DepositIndex'
+		b.Eth1DepositIndex = ssz.UnmarshallUint64(buf[524544:524552])
+
+		// Offset (11) 'Validators'
+		if o11 = ssz.ReadOffset(buf[524552:524556]); o11 > size || o9 > o11 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (12) 'Balances'
+		if o12 = ssz.ReadOffset(buf[524556:524560]); o12 > size || o11 > o12 {
+			return ssz.ErrOffset
+		}
+
+		// Field (13) 'RandaoMixes'
+
+		for ii := 0; ii < 65536; ii++ {
+			copy(b.RandaoMixes[ii][:], buf[524560:2621712][ii*32:(ii+1)*32])
+		}
+
+		// Field (14) 'Slashings'
+		b.Slashings = ssz.ExtendUint64(b.Slashings, 8192)
+		for ii := 0; ii < 8192; ii++ {
+			b.Slashings[ii] = ssz.UnmarshallUint64(buf[2621712:2687248][ii*8 : (ii+1)*8])
+		}
+
+		// Offset (15) 'PreviousEpochParticipation'
+		if o15 = ssz.ReadOffset(buf[2687248:2687252]); o15 > size || o12 > o15 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (16) 'CurrentEpochParticipation'
+		if o16 = ssz.ReadOffset(buf[2687252:2687256]); o16 > size || o15 > o16 {
+			return ssz.ErrOffset
+		}
+
+		// Field (17) 'JustificationBits'
+		if err = opts.ValidateBitvector(buf[2687256:2687257], 4); err != nil {
+			return err
+		}
+		if cap(b.JustificationBits) == 0 {
+			b.JustificationBits = bitfield.Bitvector4(make([]byte, 0, len(buf[2687256:2687257])))
+		}
+		b.JustificationBits = append(b.JustificationBits, buf[2687256:2687257]...)
+
+		// Field (18) 'PreviousJustifiedCheckpoint'
+		if b.PreviousJustifiedCheckpoint == nil {
+			b.PreviousJustifiedCheckpoint = new(Checkpoint)
+		}
+		if err = b.PreviousJustifiedCheckpoint.UnmarshalSSZWithOptions(buf[2687257:2687297], opts); err != nil {
+			return err
+		}
+
+		// Field (19) 'CurrentJustifiedCheckpoint'
+		if b.CurrentJustifiedCheckpoint == nil {
+			b.CurrentJustifiedCheckpoint = new(Checkpoint)
+		}
+		if err = b.CurrentJustifiedCheckpoint.UnmarshalSSZWithOptions(buf[2687297:2687337], opts); err != nil {
+			return err
+		}
+
+		// Field (20) 'FinalizedCheckpoint'
+		if b.FinalizedCheckpoint == nil {
+			b.FinalizedCheckpoint = new(Checkpoint)
+		}
+		if err = b.FinalizedCheckpoint.UnmarshalSSZWithOptions(buf[2687337:2687377], opts); err != nil {
+			return err
+		}
+
+		// Offset (21) 'InactivityScores'
+		if o21 = ssz.ReadOffset(buf[2687377:2687381]); o21 > size || o16 > o21 {
+			return ssz.ErrOffset
+		}
+
+		// Field (22) 'CurrentSyncCommittee'
+		if b.CurrentSyncCommittee == nil {
+			b.CurrentSyncCommittee = new(SyncCommittee)
+		}
+		if err = b.CurrentSyncCommittee.UnmarshalSSZWithOptions(buf[2687381:2712005], opts); err != nil {
+			return err
+		}
+
+		// Field (23) 'NextSyncCommittee'
+		if b.NextSyncCommittee == nil {
+			b.NextSyncCommittee = new(SyncCommittee)
+		}
+		if err = b.NextSyncCommittee.UnmarshalSSZWithOptions(buf[2712005:2736629], opts); err != nil {
+			return err
+		}
+
+		// Offset (24) 'LatestExecutionPayloadHeader'
+		if o24 = ssz.ReadOffset(buf[2736629:2736633]); o24 > size || o21 > o24 {
+			return ssz.ErrOffset
+		}
+
+		// Field (25) 'NextWithdrawalIndex'
+		b.NextWithdrawalIndex = ssz.UnmarshallUint64(buf[2736633:2736641])
+
+		// Field (26) 'NextWithdrawalValidatorIndex'
+		b.NextWithdrawalValidatorIndex = ssz.UnmarshallUint64(buf[2736641:2736649])
+
+		// Offset (27) 'HistoricalSummaries'
+		if o27 = ssz.ReadOffset(buf[2736649:2736653]); o27 > size || o24 > o27 {
+			return ssz.ErrOffset
+		}
+
+		// Field (7) 'HistoricalRoots'
+		{
+			buf = tail[o7:o9]
+			num, err := ssz.DivideInt2(len(buf), 32, 16777216)
+			if err != nil {
+				return err
+			}
+			b.HistoricalRoots = make([][]byte, num)
+			for ii := 0; ii < num; ii++ {
+				if cap(b.HistoricalRoots[ii]) == 0 {
+					b.HistoricalRoots[ii] = make([]byte, 0, len(buf[ii*32:(ii+1)*32]))
+				}
+				b.HistoricalRoots[ii] = append(b.HistoricalRoots[ii], buf[ii*32:(ii+1)*32]...)
+			}
+		}
+
+		// Field (9) 'Eth1DataVotes'
+		{
+			buf = tail[o9:o11]
+			num, err := ssz.DivideInt2(len(buf), 72, 2048)
+			if err != nil {
+				return err
+			}
+			b.Eth1DataVotes = make([]*Eth1Data, num)
+			for ii := 0; ii < num; ii++ {
+				if b.Eth1DataVotes[ii] == nil {
+					b.Eth1DataVotes[ii] = new(Eth1Data)
+				}
+				if err = b.Eth1DataVotes[ii].UnmarshalSSZWithOptions(buf[ii*72:(ii+1)*72], opts); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Field (11) 'Validators'
+		{
+			buf = tail[o11:o12]
+			num, err := ssz.DivideInt2(len(buf), 121, 1099511627776)
+			if err != nil {
+				return err
+			}
+			b.Validators = make([]*Validator, num)
+			for ii := 0; ii < num; ii++ {
+				if b.Validators[ii] == nil {
+					b.Validators[ii] = new(Validator)
+				}
+				if err = b.Validators[ii].UnmarshalSSZWithOptions(buf[ii*121:(ii+1)*121], opts); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Field (12) 'Balances'
+		{
+			buf = tail[o12:o15]
+			num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+			if err != nil {
+				return err
+			}
+			b.Balances = ssz.ExtendUint64(b.Balances, num)
+			for ii := 0; ii < num; ii++ {
+				b.Balances[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+			}
+		}
+
+		// Field (15) 'PreviousEpochParticipation'
+		{
+			buf = tail[o15:o16]
+			if len(buf) > 1099511627776 {
+				return ssz.ErrBytesLength
+			}
+			if cap(b.PreviousEpochParticipation) == 0 {
+				b.PreviousEpochParticipation = make([]byte, 0, len(buf))
+			}
+			b.PreviousEpochParticipation = append(b.PreviousEpochParticipation, buf...)
+		}
+
+		// Field (16) 'CurrentEpochParticipation'
+		{
+			buf = tail[o16:o21]
+			if len(buf) > 1099511627776 {
+				return ssz.ErrBytesLength
+			}
+			if cap(b.CurrentEpochParticipation) == 0 {
+				b.CurrentEpochParticipation = make([]byte, 0, len(buf))
+			}
+			b.CurrentEpochParticipation = append(b.CurrentEpochParticipation, buf...)
+		}
+
+		// Field (21) 'InactivityScores'
+		{
+			buf = tail[o21:o24]
+			num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+			if err != nil {
+				return err
+			}
+			b.InactivityScores = ssz.ExtendUint64(b.InactivityScores, num)
+			for ii := 0; ii < num; ii++ {
+				b.InactivityScores[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+			}
+		}
+
+		// Field (24) 'LatestExecutionPayloadHeader'
+		{
+			buf = tail[o24:o27]
+			if b.LatestExecutionPayloadHeader == nil {
+				b.LatestExecutionPayloadHeader = new(ExecutionPayloadHeader)
+			}
+			if err = b.LatestExecutionPayloadHeader.UnmarshalSSZForkWithOptions(buf, fork, opts); err != nil {
+				return err
+			}
+		}
+
+		// Field (27) 'HistoricalSummaries'
+		{
+			buf = tail[o27:]
+			num, err := ssz.DivideInt2(len(buf), 64, 16777216)
+			if err != nil {
+				return err
+			}
+			b.HistoricalSummaries = make([]*HistoricalSummary, num)
+			for ii := 0; ii < num; ii++ {
+				if b.HistoricalSummaries[ii] == nil {
+					b.HistoricalSummaries[ii] = new(HistoricalSummary)
+				}
+				if err = b.HistoricalSummaries[ii].UnmarshalSSZWithOptions(buf[ii*64:(ii+1)*64], opts); err != nil {
+					return err
+				}
+			}
+		}
+	case fork >= ssz.ForkBellatrix:
+		size := uint64(len(buf))
+		if size < 2736633 {
+			return ssz.ErrSize
+		}
+
+		tail := buf
+		var o7, o9, o11, o12, o15, o16, o21, o24 uint64
+
+		// Field (0) 'GenesisTime'
+		b.GenesisTime = ssz.UnmarshallUint64(buf[0:8])
+
+		// Field (1) 'GenesisValidatorsRoot'
+		copy(b.GenesisValidatorsRoot[:], buf[8:40])
+
+		// Field (2) 'Slot'
+		b.Slot = ssz.UnmarshallUint64(buf[40:48])
+
+		// Field (3) 'Fork'
+		if b.Fork == nil {
+			b.Fork = new(Fork)
+		}
+		if err = b.Fork.UnmarshalSSZWithOptions(buf[48:64], opts); err != nil {
+			return err
+		}
+
+		// Field (4) 'LatestBlockHeader'
+		if b.LatestBlockHeader == nil {
+			b.LatestBlockHeader = new(BeaconBlockHeader)
+		}
+		if err = b.LatestBlockHeader.UnmarshalSSZWithOptions(buf[64:176], opts); err != nil {
+			return err
+		}
+
+		// Field (5) 'BlockRoots'
+
+		for ii := 0; ii < 8192; ii++ {
+			copy(b.BlockRoots[ii][:], buf[176:262320][ii*32:(ii+1)*32])
+		}
+
+		// Field (6) 'StateRoots'
+
+		for ii := 0; ii < 8192; ii++ {
+			copy(b.StateRoots[ii][:], buf[262320:524464][ii*32:(ii+1)*32])
+		}
+
+		// Offset (7) 'HistoricalRoots'
+		if o7 = ssz.ReadOffset(buf[524464:524468]); o7 > size {
+			return ssz.ErrOffset
+		}
+
+		if err = opts.ValidateFirstOffset(o7, 2736633); err != nil {
+			return err
+		}
+
+		// Field (8) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if err = b.Eth1Data.UnmarshalSSZWithOptions(buf[524468:524540], opts); err != nil {
+			return err
+		}
+
+		// Offset (9) 'Eth1DataVotes'
+		if o9 = ssz.ReadOffset(buf[524540:524544]); o9 > size || o7 > o9 {
+			return ssz.ErrOffset
+		}
+
+		// Field (10) 'Eth1DepositIndex'
+		b.Eth1DepositIndex = ssz.UnmarshallUint64(buf[524544:524552])
+
+		// Offset (11) 'Validators'
+		if o11 = ssz.ReadOffset(buf[524552:524556]); o11 > size || o9 > o11 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (12) 'Balances'
+		if o12 = ssz.ReadOffset(buf[524556:524560]); o12 > size || o11 > o12 {
+			return ssz.ErrOffset
+		}
+
+		// Field (13) 'RandaoMixes'
+
+		for ii := 0; ii < 65536; ii++ {
+			copy(b.RandaoMixes[ii][:], buf[524560:2621712][ii*32:(ii+1)*32])
+		}
+
+		// Field (14) 'Slashings'
+		b.Slashings = ssz.ExtendUint64(b.Slashings, 8192)
+		for ii := 0; ii < 8192; ii++ {
+			b.Slashings[ii] = ssz.UnmarshallUint64(buf[2621712:2687248][ii*8 : (ii+1)*8])
+		}
+
+		// Offset (15) 'PreviousEpochParticipation'
+		if o15 = ssz.ReadOffset(buf[2687248:2687252]); o15 > size || o12 > o15 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (16) 'CurrentEpochParticipation'
+		if o16 = ssz.ReadOffset(buf[2687252:2687256]); o16 > size || o15 > o16 {
+			return ssz.ErrOffset
+		}
+
+		// Field (17) 'JustificationBits'
+		if err = opts.ValidateBitvector(buf[2687256:2687257], 4); err != nil {
+			return err
+		}
+		if cap(b.JustificationBits) == 0 {
+			b.JustificationBits = bitfield.Bitvector4(make([]byte, 0, len(buf[2687256:2687257])))
+		}
+		b.JustificationBits = append(b.JustificationBits, buf[2687256:2687257]...)
+
+		// Field (18) 'PreviousJustifiedCheckpoint'
+		if b.PreviousJustifiedCheckpoint == nil {
+			b.PreviousJustifiedCheckpoint = new(Checkpoint)
+		}
+		if err = b.PreviousJustifiedCheckpoint.UnmarshalSSZWithOptions(buf[2687257:2687297], opts); err != nil {
+			return err
+		}
+
+		// Field (19) 'CurrentJustifiedCheckpoint'
+		if b.CurrentJustifiedCheckpoint == nil {
+			b.CurrentJustifiedCheckpoint = new(Checkpoint)
+		}
+		if err = b.CurrentJustifiedCheckpoint.UnmarshalSSZWithOptions(buf[2687297:2687337], opts); err != nil {
+			return err
+		}
+
+		// Field (20) 'FinalizedCheckpoint'
+		if b.FinalizedCheckpoint == nil {
+			b.FinalizedCheckpoint = new(Checkpoint)
+		}
+		if err = b.FinalizedCheckpoint.UnmarshalSSZWithOptions(buf[2687337:2687377], opts); err != nil {
+			return err
+		}
+
+		// Offset (21) 'InactivityScores'
+		if o21 = ssz.ReadOffset(buf[2687377:2687381]); o21 > size || o16 > o21 {
+			return ssz.ErrOffset
+		}
+
+		// Field (22) 'CurrentSyncCommittee'
+		if b.CurrentSyncCommittee == nil {
+			b.CurrentSyncCommittee = new(SyncCommittee)
+		}
+		if err = b.CurrentSyncCommittee.UnmarshalSSZWithOptions(buf[2687381:2712005], opts); err != nil {
+			return err
+		}
+
+		// Field (23) 'NextSyncCommittee'
+		if b.NextSyncCommittee == nil {
+			b.NextSyncCommittee = new(SyncCommittee)
+		}
+		if err = b.NextSyncCommittee.UnmarshalSSZWithOptions(buf[2712005:2736629], opts); err != nil {
+			return err
+		}
+
+		// Offset (24) 'LatestExecutionPayloadHeader'
+		if o24 = ssz.ReadOffset(buf[2736629:2736633]); o24 > size || o21 > o24 {
+			return ssz.ErrOffset
+		}
+
+		// Field (7) 'HistoricalRoots'
+		{
+			buf = tail[o7:o9]
+			num, err := ssz.DivideInt2(len(buf), 32, 16777216)
+			if err != nil {
+				return err
+			}
+			b.HistoricalRoots = make([][]byte, num)
+			for ii := 0; ii < num; ii++ {
+				if cap(b.HistoricalRoots[ii]) == 0 {
+					b.HistoricalRoots[ii] = make([]byte, 0, len(buf[ii*32:(ii+1)*32]))
+				}
+				b.HistoricalRoots[ii] = append(b.HistoricalRoots[ii], buf[ii*32:(ii+1)*32]...)
+			}
+		}
+
+		// Field (9) 'Eth1DataVotes'
+		{
+			buf = tail[o9:o11]
+			num, err := ssz.DivideInt2(len(buf), 72, 2048)
+			if err != nil {
+				return err
+			}
+			b.Eth1DataVotes = make([]*Eth1Data, num)
+			for ii := 0; ii < num; ii++ {
+				if b.Eth1DataVotes[ii] == nil {
+					b.Eth1DataVotes[ii] = new(Eth1Data)
+				}
+				if err = b.Eth1DataVotes[ii].UnmarshalSSZWithOptions(buf[ii*72:(ii+1)*72], opts); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Field (11) 'Validators'
+		{
+			buf = tail[o11:o12]
+			num, err := ssz.DivideInt2(len(buf), 121, 1099511627776)
+			if err != nil {
+				return err
+			}
+			b.Validators = make([]*Validator, num)
+			for ii := 0; ii < num; ii++ {
+				if b.Validators[ii] == nil {
+					b.Validators[ii] = new(Validator)
+				}
+				if err = b.Validators[ii].UnmarshalSSZWithOptions(buf[ii*121:(ii+1)*121], opts); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Field (12) 'Balances'
+		{
+			buf = tail[o12:o15]
+			num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+			if err != nil {
+				return err
+			}
+			b.Balances = ssz.ExtendUint64(b.Balances, num)
+			for ii := 0; ii < num; ii++ {
+				b.Balances[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+			}
+		}
+
+		// Field (15) 'PreviousEpochParticipation'
+		{
+			buf = tail[o15:o16]
+			if len(buf) > 1099511627776 {
+				return ssz.ErrBytesLength
+			}
+			if cap(b.PreviousEpochParticipation) == 0 {
+				b.PreviousEpochParticipation = make([]byte, 0, len(buf))
+			}
+			b.PreviousEpochParticipation = append(b.PreviousEpochParticipation, buf...)
+		}
+
+		// Field (16) 'CurrentEpochParticipation'
+		{
+			buf = tail[o16:o21]
+			if len(buf) > 1099511627776 {
+				return ssz.ErrBytesLength
+			}
+			if cap(b.CurrentEpochParticipation) == 0 {
+				b.CurrentEpochParticipation = make([]byte, 0, len(buf))
+			}
+			b.CurrentEpochParticipation = append(b.CurrentEpochParticipation, buf...)
+		}
+
+		// Field (21) 'InactivityScores'
+		{
+			buf = tail[o21:o24]
+			num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+			if err != nil {
+				return err
+			}
+			b.InactivityScores = ssz.ExtendUint64(b.InactivityScores, num)
+			for ii := 0; ii < num; ii++ {
+				b.InactivityScores[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+			}
+		}
+
+		// Field (24) 'LatestExecutionPayloadHeader'
+		{
+			buf = tail[o24:]
+			if b.LatestExecutionPayloadHeader == nil {
+				b.LatestExecutionPayloadHeader = new(ExecutionPayloadHeader)
+			}
+			if err = b.LatestExecutionPayloadHeader.UnmarshalSSZForkWithOptions(buf, fork, opts); err != nil {
+				return err
+			}
+		}
+	case fork >= ssz.ForkAltair:
+		size := uint64(len(buf))
+		if size < 2736629 {
+			return ssz.ErrSize
+		}
+
+		tail := buf
+		var o7, o9, o11, o12, o15, o16, o21 uint64
+
+		// Field (0) 'GenesisTime'
+		b.GenesisTime = ssz.UnmarshallUint64(buf[0:8])
+
+		// Field (1) 'GenesisValidatorsRoot'
+		copy(b.GenesisValidatorsRoot[:], buf[8:40])
+
+		// Field (2) 'Slot'
+		b.Slot = ssz.UnmarshallUint64(buf[40:48])
+
+		// Field (3) 'Fork'
+		if b.Fork == nil {
+			b.Fork = new(Fork)
+		}
+		if err = b.Fork.UnmarshalSSZWithOptions(buf[48:64], opts); err != nil {
+			return err
+		}
+
+		// Field (4) 'LatestBlockHeader'
+		if b.LatestBlockHeader == nil {
+			b.LatestBlockHeader = new(BeaconBlockHeader)
+		}
+		if err = b.LatestBlockHeader.UnmarshalSSZWithOptions(buf[64:176], opts); err != nil {
+			return err
+		}
+
+		// Field (5) 'BlockRoots'
+
+		for ii := 0; ii < 8192; ii++ {
+			copy(b.BlockRoots[ii][:], buf[176:262320][ii*32:(ii+1)*32])
+		}
+
+		// Field (6) 'StateRoots'
+
+		for ii := 0; ii < 8192; ii++ {
+			copy(b.StateRoots[ii][:], buf[262320:524464][ii*32:(ii+1)*32])
+		}
+
+		// Offset (7) 'HistoricalRoots'
+		if o7 = ssz.ReadOffset(buf[524464:524468]); o7 > size {
+			return ssz.ErrOffset
+		}
+
+		if err = opts.ValidateFirstOffset(o7, 2736629); err != nil {
+			return err
+		}
+
+		// Field (8) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if err = b.Eth1Data.UnmarshalSSZWithOptions(buf[524468:524540], opts); err != nil {
+			return err
+		}
+
+		// Offset (9) 'Eth1DataVotes'
+		if o9 = ssz.ReadOffset(buf[524540:524544]); o9 > size || o7 > o9 {
+			return ssz.ErrOffset
+		}
+
+		// Field (10) 'Eth1DepositIndex'
+		b.Eth1DepositIndex = ssz.UnmarshallUint64(buf[524544:524552])
+
+		// Offset (11) 'Validators'
+		if o11 = ssz.ReadOffset(buf[524552:524556]); o11 > size || o9 > o11 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (12) 'Balances'
+		if o12 = ssz.ReadOffset(buf[524556:524560]); o12 > size || o11 > o12 {
+			return ssz.ErrOffset
+		}
+
+		// Field (13) 'RandaoMixes'
+
+		for ii := 0; ii < 65536; ii++ {
+			copy(b.RandaoMixes[ii][:], buf[524560:2621712][ii*32:(ii+1)*32])
+		}
+
+		// Field (14) 'Slashings'
+		b.Slashings = ssz.ExtendUint64(b.Slashings, 8192)
+		for ii := 0; ii < 8192; ii++ {
+			b.Slashings[ii] = ssz.UnmarshallUint64(buf[2621712:2687248][ii*8 : (ii+1)*8])
+		}
+
+		// Offset (15) 'PreviousEpochParticipation'
+		if o15 = ssz.ReadOffset(buf[2687248:2687252]); o15 > size || o12 > o15 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (16) 'CurrentEpochParticipation'
+		if o16 = ssz.ReadOffset(buf[2687252:2687256]); o16 > size || o15 > o16 {
+			return ssz.ErrOffset
+		}
+
+		// Field (17) 'JustificationBits'
+		if err = opts.ValidateBitvector(buf[2687256:2687257], 4); err != nil {
+			return err
+		}
+		if cap(b.JustificationBits) == 0 {
+			b.JustificationBits = bitfield.Bitvector4(make([]byte, 0, len(buf[2687256:2687257])))
+		}
+		b.JustificationBits = append(b.JustificationBits, buf[2687256:2687257]...)
+
+		// Field (18) 'PreviousJustifiedCheckpoint'
+		if b.PreviousJustifiedCheckpoint == nil {
+			b.PreviousJustifiedCheckpoint = new(Checkpoint)
+		}
+		if err = b.PreviousJustifiedCheckpoint.UnmarshalSSZWithOptions(buf[2687257:2687297], opts); err != nil {
+			return err
+		}
+
+		// Field (19) 'CurrentJustifiedCheckpoint'
+		if b.CurrentJustifiedCheckpoint == nil {
+			b.CurrentJustifiedCheckpoint = new(Checkpoint)
+		}
+		if err = b.CurrentJustifiedCheckpoint.UnmarshalSSZWithOptions(buf[2687297:2687337], opts); err != nil {
+			return err
+		}
+
+		// Field (20) 'FinalizedCheckpoint'
+		if b.FinalizedCheckpoint == nil {
+			b.FinalizedCheckpoint = new(Checkpoint)
+		}
+		if err = b.FinalizedCheckpoint.UnmarshalSSZWithOptions(buf[2687337:2687377], opts); err != nil {
+			return err
+		}
+
+		// Offset (21) 'InactivityScores'
+		if o21 = ssz.ReadOffset(buf[2687377:2687381]); o21 > size || o16 > o21 {
+			return ssz.ErrOffset
+		}
+
+		// Field (22) 'CurrentSyncCommittee'
+		if b.CurrentSyncCommittee == nil {
+			b.CurrentSyncCommittee = new(SyncCommittee)
+		}
+		if err = b.CurrentSyncCommittee.UnmarshalSSZWithOptions(buf[2687381:2712005], opts); err != nil {
+			return err
+		}
+
+		// Field (23) 'NextSyncCommittee'
+		if b.NextSyncCommittee == nil {
+			b.NextSyncCommittee = new(SyncCommittee)
+		}
+		if err = b.NextSyncCommittee.UnmarshalSSZWithOptions(buf[2712005:2736629], opts); err != nil {
+			return err
+		}
+
+		// Field (7) 'HistoricalRoots'
+		{
+			buf = tail[o7:o9]
+			num, err := ssz.DivideInt2(len(buf), 32, 16777216)
+			if err != nil {
+				return err
+			}
+			b.HistoricalRoots = make([][]byte, num)
+			for ii := 0; ii < num; ii++ {
+				if cap(b.HistoricalRoots[ii]) == 0 {
+					b.HistoricalRoots[ii] = make([]byte, 0, len(buf[ii*32:(ii+1)*32]))
+				}
+				b.HistoricalRoots[ii] = append(b.HistoricalRoots[ii], buf[ii*32:(ii+1)*32]...)
+			}
+		}
+
+		// Field (9) 'Eth1DataVotes'
+		{
+			buf = tail[o9:o11]
+			num, err := ssz.DivideInt2(len(buf), 72, 2048)
+			if err != nil {
+				return err
+			}
+			b.Eth1DataVotes = make([]*Eth1Data, num)
+			for ii := 0; ii < num; ii++ {
+				if b.Eth1DataVotes[ii] == nil {
+					b.Eth1DataVotes[ii] = new(Eth1Data)
+				}
+				if err = b.Eth1DataVotes[ii].UnmarshalSSZWithOptions(buf[ii*72:(ii+1)*72], opts); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Field (11) 'Validators'
+		{
+			buf = tail[o11:o12]
+			num, err := ssz.DivideInt2(len(buf), 121, 1099511627776)
+			if err != nil {
+				return err
+			}
+			b.Validators = make([]*Validator, num)
+			for ii := 0; ii < num; ii++ {
+				if b.Validators[ii] == nil {
+					b.Validators[ii] = new(Validator)
+				}
+				if err = b.Validators[ii].UnmarshalSSZWithOptions(buf[ii*121:(ii+1)*121], opts); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Field (12) 'Balances'
+		{
+			buf = tail[o12:o15]
+			num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+			if err != nil {
+				return err
+			}
+			b.Balances = ssz.ExtendUint64(b.Balances, num)
+			for ii := 0; ii < num; ii++ {
+				b.Balances[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+			}
+		}
+
+		// Field (15) 'PreviousEpochParticipation'
+		{
+			buf = tail[o15:o16]
+			if len(buf) > 1099511627776 {
+				return ssz.ErrBytesLength
+			}
+			if cap(b.PreviousEpochParticipation) == 0 {
+				b.PreviousEpochParticipation = make([]byte, 0, len(buf))
+			}
+			b.PreviousEpochParticipation = append(b.PreviousEpochParticipation, buf...)
+		}
+
+		// Field (16) 'CurrentEpochParticipation'
+		{
+			buf = tail[o16:o21]
+			if len(buf) > 1099511627776 {
+				return ssz.ErrBytesLength
+			}
+			if cap(b.CurrentEpochParticipation) == 0 {
+				b.CurrentEpochParticipation = make([]byte, 0, len(buf))
+			}
+			b.CurrentEpochParticipation = append(b.CurrentEpochParticipation, buf...)
+		}
+
+		// Field (21) 'InactivityScores'
+		{
+			buf = tail[o21:]
+			num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+			if err != nil {
+				return err
+			}
+			b.InactivityScores = ssz.ExtendUint64(b.InactivityScores, num)
+			for ii := 0; ii < num; ii++ {
+				b.InactivityScores[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+			}
+		}
+	default:
+		size := uint64(len(buf))
+		if size < 2687377 {
+			return ssz.ErrSize
+		}
+
+		tail := buf
+		var o7, o9, o11, o12, o15, o16 uint64
+
+		// Field (0) 'GenesisTime'
+		b.GenesisTime = ssz.UnmarshallUint64(buf[0:8])
+
+		// Field (1) 'GenesisValidatorsRoot'
+		copy(b.GenesisValidatorsRoot[:], buf[8:40])
+
+		// Field (2) 'Slot'
+		b.Slot = ssz.UnmarshallUint64(buf[40:48])
+
+		// Field (3) 'Fork'
+		if b.Fork == nil {
+			b.Fork = new(Fork)
+		}
+		if err = b.Fork.UnmarshalSSZWithOptions(buf[48:64], opts); err != nil {
+			return err
+		}
+
+		// Field (4) 'LatestBlockHeader'
+		if b.LatestBlockHeader == nil {
+			b.LatestBlockHeader = new(BeaconBlockHeader)
+		}
+		if err = b.LatestBlockHeader.UnmarshalSSZWithOptions(buf[64:176], opts); err != nil {
+			return err
+		}
+
+		// Field (5) 'BlockRoots'
+
+		for ii := 0; ii < 8192; ii++ {
+			copy(b.BlockRoots[ii][:], buf[176:262320][ii*32:(ii+1)*32])
+		}
+
+		// Field (6) 'StateRoots'
+
+		for ii := 0; ii < 8192; ii++ {
+			copy(b.StateRoots[ii][:], buf[262320:524464][ii*32:(ii+1)*32])
+		}
+
+		// Offset (7) 'HistoricalRoots'
+		if o7 = ssz.ReadOffset(buf[524464:524468]); o7 > size {
+			return ssz.ErrOffset
+		}
+
+		if err = opts.ValidateFirstOffset(o7, 2687377); err != nil {
+			return err
+		}
+
+		// Field (8) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if err = b.Eth1Data.UnmarshalSSZWithOptions(buf[524468:524540], opts); err != nil {
+			return err
+		}
+
+		// Offset (9) 'Eth1DataVotes'
+		if o9 = ssz.ReadOffset(buf[524540:524544]); o9 > size || o7 > o9 {
+			return ssz.ErrOffset
+		}
+
+		// Field (10) 'Eth1DepositIndex'
+		b.Eth1DepositIndex = ssz.UnmarshallUint64(buf[524544:524552])
+
+		// Offset (11) 'Validators'
+		if o11 = ssz.ReadOffset(buf[524552:524556]); o11 > size || o9 > o11 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (12) 'Balances'
+		if o12 = ssz.ReadOffset(buf[524556:524560]); o12 > size || o11 > o12 {
+			return ssz.ErrOffset
+		}
+
+		// Field (13) 'RandaoMixes'
+
+		for ii := 0; ii < 65536; ii++ {
+			copy(b.RandaoMixes[ii][:], buf[524560:2621712][ii*32:(ii+1)*32])
+		}
+
+		// Field (14) 'Slashings'
+		b.Slashings = ssz.ExtendUint64(b.Slashings, 8192)
+		for ii := 0; ii < 8192; ii++ {
+			b.Slashings[ii] = ssz.UnmarshallUint64(buf[2621712:2687248][ii*8 : (ii+1)*8])
+		}
+
+		// Offset (15) 'PreviousEpochAttestations'
+		if o15 = ssz.ReadOffset(buf[2687248:2687252]); o15 > size || o12 > o15 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (16) 'CurrentEpochAttestations'
+		if o16 = ssz.ReadOffset(buf[2687252:2687256]); o16 > size || o15 > o16 {
+			return ssz.ErrOffset
+		}
+
+		// Field (17) 'JustificationBits'
+		if err = opts.ValidateBitvector(buf[2687256:2687257], 4); err != nil {
+			return err
+		}
+		if cap(b.JustificationBits) == 0 {
+			b.JustificationBits = bitfield.Bitvector4(make([]byte, 0, len(buf[2687256:2687257])))
+		}
+		b.JustificationBits = append(b.JustificationBits, buf[2687256:2687257]...)
+
+		// Field (18) 'PreviousJustifiedCheckpoint'
+		if b.PreviousJustifiedCheckpoint == nil {
+			b.PreviousJustifiedCheckpoint = new(Checkpoint)
+		}
+		if err = b.PreviousJustifiedCheckpoint.UnmarshalSSZWithOptions(buf[2687257:2687297], opts); err != nil {
+			return err
+		}
+
+		// Field (19) 'CurrentJustifiedCheckpoint'
+		if b.CurrentJustifiedCheckpoint == nil {
+			b.CurrentJustifiedCheckpoint = new(Checkpoint)
+		}
+		if err = b.CurrentJustifiedCheckpoint.UnmarshalSSZWithOptions(buf[2687297:2687337], opts); err != nil {
+			return err
+		}
+
+		// Field (20) 'FinalizedCheckpoint'
+		if b.FinalizedCheckpoint == nil {
+			b.FinalizedCheckpoint = new(Checkpoint)
+		}
+		if err = b.FinalizedCheckpoint.UnmarshalSSZWithOptions(buf[2687337:2687377], opts); err != nil {
+			return err
+		}
+
+		// Field (7) 'HistoricalRoots'
+		{
+			buf = tail[o7:o9]
+			num, err := ssz.DivideInt2(len(buf), 32, 16777216)
+			if err != nil {
+				return err
+			}
+			b.HistoricalRoots = make([][]byte, num)
+			for ii := 0; ii < num; ii++ {
+				if cap(b.HistoricalRoots[ii]) == 0 {
+					b.HistoricalRoots[ii] = make([]byte, 0, len(buf[ii*32:(ii+1)*32]))
+				}
+				b.HistoricalRoots[ii] = append(b.HistoricalRoots[ii], buf[ii*32:(ii+1)*32]...)
+			}
+		}
+
+		// Field (9) 'Eth1DataVotes'
+		{
+			buf = tail[o9:o11]
+			num, err := ssz.DivideInt2(len(buf), 72, 2048)
+			if err != nil {
+				return err
+			}
+			b.Eth1DataVotes = make([]*Eth1Data, num)
+			for ii := 0; ii < num; ii++ {
+				if b.Eth1DataVotes[ii] == nil {
+					b.Eth1DataVotes[ii] = new(Eth1Data)
+				}
+				if err = b.Eth1DataVotes[ii].UnmarshalSSZWithOptions(buf[ii*72:(ii+1)*72], opts); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Field (11) 'Validators'
+		{
+			buf = tail[o11:o12]
+			num, err := ssz.DivideInt2(len(buf), 121, 1099511627776)
+			if err != nil {
+				return err
+			}
+			b.Validators = make([]*Validator, num)
+			for ii := 0; ii < num; ii++ {
+				if b.Validators[ii] == nil {
+					b.Validators[ii] = new(Validator)
+				}
+				if err = b.Validators[ii].UnmarshalSSZWithOptions(buf[ii*121:(ii+1)*121], opts); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Field (12) 'Balances'
+		{
+			buf = tail[o12:o15]
+			num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+			if err != nil {
+				return err
+			}
+			b.Balances = ssz.ExtendUint64(b.Balances, num)
+			for ii := 0; ii < num; ii++ {
+				b.Balances[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+			}
+		}
+
+		// Field (15) 'PreviousEpochAttestations'
+		{
+			buf = tail[o15:o16]
+			num, err := opts.DecodeDynamicLength(buf, 4096)
+			if err != nil {
+				return err
+			}
+			b.PreviousEpochAttestations = make([]*PendingAttestation, num)
+			err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+				if b.PreviousEpochAttestations[indx] == nil {
+					b.PreviousEpochAttestations[indx] = new(PendingAttestation)
+				}
+				if err = b.PreviousEpochAttestations[indx].UnmarshalSSZWithOptions(buf, opts); err != nil {
+					return err
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		// Field (16) 'CurrentEpochAttestations'
+		{
+			buf = tail[o16:]
+			num, err := opts.DecodeDynamicLength(buf, 4096)
+			if err != nil {
+				return err
+			}
+			b.CurrentEpochAttestations = make([]*PendingAttestation, num)
+			err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+				if b.CurrentEpochAttestations[indx] == nil {
+					b.CurrentEpochAttestations[indx] = new(PendingAttestation)
+				}
+				if err = b.CurrentEpochAttestations[indx].UnmarshalSSZWithOptions(buf, opts); err != nil {
+					return err
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconState object
+func (b *BeaconState) SizeSSZ() (size int) {
+	return b.SizeSSZFork(ssz.ForkDeneb)
+}
+
+// SizeSSZFork returns the ssz encoded size in bytes for the BeaconState object for a given fork
+func (b *BeaconState) SizeSSZFork(fork ssz.Fork) (size int) {
+	switch {
+	case fork >= ssz.ForkCapella:
+		size = 2736653
+		// Field (7) 'HistoricalRoots'
+		size += len(b.HistoricalRoots) * 32
+
+		// Field (9) 'Eth1DataVotes'
+		size += len(b.Eth1DataVotes) * 72
+
+		// Field (11) 'Validators'
+		size += len(b.Validators) * 121
+
+		// Field (12) 'Balances'
+		size += len(b.Balances) * 8
+
+		// Field (15) 'PreviousEpochParticipation'
+		size += len(b.PreviousEpochParticipation)
+
+		// Field (16) 'CurrentEpochParticipation'
+		size += len(b.CurrentEpochParticipation)
+
+		// Field (21) 'InactivityScores'
+		size += len(b.InactivityScores) * 8
+
+		// Field (24) 'LatestExecutionPayloadHeader'
+		if b.LatestExecutionPayloadHeader == nil {
+			b.LatestExecutionPayloadHeader = new(ExecutionPayloadHeader)
+		}
+		size += b.LatestExecutionPayloadHeader.SizeSSZFork(fork)
+
+		// Field (27) 'HistoricalSummaries'
+		size += len(b.HistoricalSummaries) * 64
+	case fork >= ssz.ForkBellatrix:
+		size = 2736633
+		// Field (7) 'HistoricalRoots'
+		size += len(b.HistoricalRoots) * 32
+
+		// Field (9) 'Eth1DataVotes'
+		size += len(b.Eth1DataVotes) * 72
+
+		// Field (11) 'Validators'
+		size += len(b.Validators) * 121
+
+		// Field (12) 'Balances'
+		size += len(b.Balances) * 8
+
+		// Field (15) 'PreviousEpochParticipation'
+		size += len(b.PreviousEpochParticipation)
+
+		// Field (16) 'CurrentEpochParticipation'
+		size += len(b.CurrentEpochParticipation)
+
+		// Field (21) 'InactivityScores'
+		size += len(b.InactivityScores) * 8
+
+		// Field (24) 'LatestExecutionPayloadHeader'
+		if b.LatestExecutionPayloadHeader == nil {
+			b.LatestExecutionPayloadHeader = new(ExecutionPayloadHeader)
+		}
+		size += b.LatestExecutionPayloadHeader.SizeSSZFork(fork)
+	case fork >= ssz.ForkAltair:
+		size = 2736629
+		// Field (7) 'HistoricalRoots'
+		size += len(b.HistoricalRoots) * 32
+
+		// Field (9) 'Eth1DataVotes'
+		size += len(b.Eth1DataVotes) * 72
+
+		// Field (11) 'Validators'
+		size += len(b.Validators) * 121
+
+		// Field (12) 'Balances'
+		size += len(b.Balances) * 8
+
+		// Field (15) 'PreviousEpochParticipation'
+		size += len(b.PreviousEpochParticipation)
+
+		// Field (16) 'CurrentEpochParticipation'
+		size += len(b.CurrentEpochParticipation)
+
+		// Field (21) 'InactivityScores'
+		size += len(b.InactivityScores) * 8
+	default:
+		size = 2687377
+		// Field (7) 'HistoricalRoots'
+		size += len(b.HistoricalRoots) * 32
+
+		// Field (9) 'Eth1DataVotes'
+		size += len(b.Eth1DataVotes) * 72
+
+		// Field (11) 'Validators'
+		size += len(b.Validators) * 121
+
+		// Field (12) 'Balances'
+		size += len(b.Balances) * 8
+
+		// Field (15) 'PreviousEpochAttestations'
+		for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
+			size += 4
+			size += b.PreviousEpochAttestations[ii].SizeSSZ()
+		}
+
+		// Field (16) 'CurrentEpochAttestations'
+		for ii := 0; ii < len(b.CurrentEpochAttestations); ii++ {
+			size += 4
+			size += b.CurrentEpochAttestations[ii].SizeSSZ()
+		}
+	}
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the BeaconState object
+func (b *BeaconState) MinSSZSize() uint64 {
+	return b.MinSSZSizeFork(ssz.ForkDeneb)
+}
+
+// MinSSZSizeFork returns the minimum ssz encoded size in bytes for the BeaconState object for a given fork
+func (b *BeaconState) MinSSZSizeFork(fork ssz.Fork) uint64 {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 2737237
+	case fork >= ssz.ForkCapella:
+		return 2737221
+	case fork >= ssz.ForkBellatrix:
+		return 2737169
+	case fork >= ssz.ForkAltair:
+		return 2736629
+	default:
+		return 2687377
+	}
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the BeaconState object
+func (b *BeaconState) MaxSSZSize() uint64 {
+	return b.MaxSSZSizeFork(ssz.ForkDeneb)
+}
+
+// MaxSSZSizeFork returns the maximum ssz encoded size in bytes for the BeaconState object for a given fork
+func (b *BeaconState) MaxSSZSizeFork(fork ssz.Fork) uint64 {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 152833729758325
+	case fork >= ssz.ForkCapella:
+		return 152833729758309
+	case fork >= ssz.ForkBellatrix:
+		return 152832656016433
+	case fork >= ssz.ForkAltair:
+		return 152832656015861
+	default:
+		return 141837543039377
+	}
+}
+
+// HashTreeRoot ssz hashes the BeaconState object
+func (b *BeaconState) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BeaconState object with a hasher
+func (b *BeaconState) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	return b.HashTreeRootWithFork(hh, ssz.ForkDeneb)
+}
+
+// HashTreeRootFork ssz hashes the BeaconState object for a given fork
+func (b *BeaconState) HashTreeRootFork(fork ssz.Fork) ([32]byte, error) {
+	return ssz.HashWithDefaultHasherFork(b, fork)
+}
+
+// HashTreeRootWithFork ssz hashes the BeaconState object with a hasher for a given fork
+func (b *BeaconState) HashTreeRootWithFork(hh ssz.HashWalker, fork ssz.Fork) (err error) {
+	switch {
+	case fork >= ssz.ForkCapella:
+		indx := hh.Index()
+
+		// Field (0) 'GenesisTime'
+		hh.PutUint64(b.GenesisTime)
+
+		// Field (1) 'GenesisValidatorsRoot'
+		hh.PutBytes(b.GenesisValidatorsRoot[:])
+
+		// Field (2) 'Slot'
+		hh.PutUint64(b.Slot)
+
+		// Field (3) 'Fork'
+		if b.Fork == nil {
+			b.Fork = new(Fork)
+		}
+		if err = b.Fork.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (4) 'LatestBlockHeader'
+		if b.LatestBlockHeader == nil {
+			b.LatestBlockHeader = new(BeaconBlockHeader)
+		}
+		if err = b.LatestBlockHeader.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (5) 'BlockRoots'
+		{
+			subIndx := hh.Index()
+			for _, i := range b.BlockRoots {
+				hh.Append(i[:])
+			}
+			hh.Merkleize(subIndx)
+		}
+
+		// Field (6) 'StateRoots'
+		{
+			subIndx := hh.Index()
+			for _, i := range b.StateRoots {
+				hh.Append(i[:])
+			}
+			hh.Merkleize(subIndx)
+		}
+
+		// Field (7) 'HistoricalRoots'
+		{
+			if size := len(b.HistoricalRoots); size > 16777216 {
+				err = ssz.ErrListTooBigFn("BeaconState.HistoricalRoots", size, 16777216)
+				return
+			}
+			subIndx := hh.Index()
+			for _, i := range b.HistoricalRoots {
+				if len(i) != 32 {
+					err = ssz.ErrBytesLength
+					return
+				}
+				hh.Append(i)
+			}
+			numItems := uint64(len(b.HistoricalRoots))
+			hh.MerkleizeWithMixin(subIndx, numItems, 16777216)
+		}
+
+		// Field (8) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (9) 'Eth1DataVotes'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.Eth1DataVotes))
+			if num > 2048 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.Eth1DataVotes {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 2048)
+		}
+
+		// Field (10) 'Eth1DepositIndex'
+		hh.PutUint64(b.Eth1DepositIndex)
+
+		// Field (11) 'Validators'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.Validators))
+			if num > 1099511627776 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.Validators {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
+		}
+
+		// Field (12) 'Balances'
+		{
+			if size := len(b.Balances); size > 1099511627776 {
+				err = ssz.ErrListTooBigFn("BeaconState.Balances", size, 1099511627776)
+				return
+			}
+			subIndx := hh.Index()
+			for _, i := range b.Balances {
+				hh.AppendUint64(i)
+			}
+			hh.FillUpTo32()
+			numItems := uint64(len(b.Balances))
+			hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+		}
+
+		// Field (13) 'RandaoMixes'
+		{
+			subIndx := hh.Index()
+			for _, i := range b.RandaoMixes {
+				hh.Append(i[:])
+			}
+			hh.Merkleize(subIndx)
+		}
+
+		// Field (14) 'Slashings'
+		{
+			if size := len(b.Slashings); size != 8192 {
+				err = ssz.ErrVectorLengthFn("BeaconState.Slashings", size, 8192)
+				return
+			}
+			subIndx := hh.Index()
+			for _, i := range b.Slashings {
+				hh.AppendUint64(i)
+			}
+			hh.Merkleize(subIndx)
+		}
+
+		// Field (15) 'PreviousEpochParticipation'
+		{
+			elemIndx := hh.Index()
+			byteLen := uint64(len(b.PreviousEpochParticipation))
+			if byteLen > 1099511627776 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			hh.Append(b.PreviousEpochParticipation)
+			hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
+		}
+
+		// Field (16) 'CurrentEpochParticipation'
+		{
+			elemIndx := hh.Index()
+			byteLen := uint64(len(b.CurrentEpochParticipation))
+			if byteLen > 1099511627776 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			hh.Append(b.CurrentEpochParticipation)
+			hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
+		}
+
+		// Field (17) 'JustificationBits'
+		if size := len(b.JustificationBits); size != 1 {
+			err = ssz.ErrBytesLengthFn("BeaconState.JustificationBits", size, 1)
+			return
+		}
+		hh.PutBytes([]byte(b.JustificationBits))
+
+		// Field (18) 'PreviousJustifiedCheckpoint'
+		if b.PreviousJustifiedCheckpoint == nil {
+			b.PreviousJustifiedCheckpoint = new(Checkpoint)
+		}
+		if err = b.PreviousJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (19) 'CurrentJustifiedCheckpoint'
+		if b.CurrentJustifiedCheckpoint == nil {
+			b.CurrentJustifiedCheckpoint = new(Checkpoint)
+		}
+		if err = b.CurrentJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (20) 'FinalizedCheckpoint'
+		if b.FinalizedCheckpoint == nil {
+			b.FinalizedCheckpoint = new(Checkpoint)
+		}
+		if err = b.FinalizedCheckpoint.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (21) 'InactivityScores'
+		{
+			if size := len(b.InactivityScores); size > 1099511627776 {
+				err = ssz.ErrListTooBigFn("BeaconState.InactivityScores", size, 1099511627776)
+				return
+			}
+			subIndx := hh.Index()
+			for _, i := range b.InactivityScores {
+				hh.AppendUint64(i)
+			}
+			hh.FillUpTo32()
+			numItems := uint64(len(b.InactivityScores))
+			hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+		}
+
+		// Field (22) 'CurrentSyncCommittee'
+		if b.CurrentSyncCommittee == nil {
+			b.CurrentSyncCommittee = new(SyncCommittee)
+		}
+		if err = b.CurrentSyncCommittee.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (23) 'NextSyncCommittee'
+		if b.NextSyncCommittee == nil {
+			b.NextSyncCommittee = new(SyncCommittee)
+		}
+		if err = b.NextSyncCommittee.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (24) 'LatestExecutionPayloadHeader'
+		if err = b.LatestExecutionPayloadHeader.HashTreeRootWithFork(hh, fork); err != nil {
+			return
+		}
+
+		// Field (25) 'NextWithdrawalIndex'
+		hh.PutUint64(b.NextWithdrawalIndex)
+
+		// Field (26) 'NextWithdrawalValidatorIndex'
+		hh.PutUint64(b.NextWithdrawalValidatorIndex)
+
+		// Field (27) 'HistoricalSummaries'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.HistoricalSummaries))
+			if num > 16777216 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.HistoricalSummaries {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 16777216)
+		}
+
+		hh.Merkleize(indx)
+	case fork >= ssz.ForkBellatrix:
+		indx := hh.Index()
+
+		// Field (0) 'GenesisTime'
+		hh.PutUint64(b.GenesisTime)
+
+		// Field (1) 'GenesisValidatorsRoot'
+		hh.PutBytes(b.GenesisValidatorsRoot[:])
+
+		// Field (2) 'Slot'
+		hh.PutUint64(b.Slot)
+
+		// Field (3) 'Fork'
+		if b.Fork == nil {
+			b.Fork = new(Fork)
+		}
+		if err = b.Fork.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (4) 'LatestBlockHeader'
+		if b.LatestBlockHeader == nil {
+			b.LatestBlockHeader = new(BeaconBlockHeader)
+		}
+		if err = b.LatestBlockHeader.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (5) 'BlockRoots'
+		{
+			subIndx := hh.Index()
+			for _, i := range b.BlockRoots {
+				hh.Append(i[:])
+			}
+			hh.Merkleize(subIndx)
+		}
+
+		// Field (6) 'StateRoots'
+		{
+			subIndx := hh.Index()
+			for _, i := range b.StateRoots {
+				hh.Append(i[:])
+			}
+			hh.Merkleize(subIndx)
+		}
+
+		// Field (7) 'HistoricalRoots'
+		{
+			if size := len(b.HistoricalRoots); size > 16777216 {
+				err = ssz.ErrListTooBigFn("BeaconState.HistoricalRoots", size, 16777216)
+				return
+			}
+			subIndx := hh.Index()
+			for _, i := range b.HistoricalRoots {
+				if len(i) != 32 {
+					err = ssz.ErrBytesLength
+					return
+				}
+				hh.Append(i)
+			}
+			numItems := uint64(len(b.HistoricalRoots))
+			hh.MerkleizeWithMixin(subIndx, numItems, 16777216)
+		}
+
+		// Field (8) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (9) 'Eth1DataVotes'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.Eth1DataVotes))
+			if num > 2048 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.Eth1DataVotes {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 2048)
+		}
+
+		// Field (10) 'Eth1DepositIndex'
+		hh.PutUint64(b.Eth1DepositIndex)
+
+		// Field (11) 'Validators'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.Validators))
+			if num > 1099511627776 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.Validators {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
+		}
+
+		// Field (12) 'Balances'
+		{
+			if size := len(b.Balances); size > 1099511627776 {
+				err = ssz.ErrListTooBigFn("BeaconState.Balances", size, 1099511627776)
+				return
+			}
+			subIndx := hh.Index()
+			for _, i := range b.Balances {
+				hh.AppendUint64(i)
+			}
+			hh.FillUpTo32()
+			numItems := uint64(len(b.Balances))
+			hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+		}
+
+		// Field (13) 'RandaoMixes'
+		{
+			subIndx := hh.Index()
+			for _, i := range b.RandaoMixes {
+				hh.Append(i[:])
+			}
+			hh.Merkleize(subIndx)
+		}
+
+		// Field (14) 'Slashings'
+		{
+			if size := len(b.Slashings); size != 8192 {
+				err = ssz.ErrVectorLengthFn("BeaconState.Slashings", size, 8192)
+				return
+			}
+			subIndx := hh.Index()
+			for _, i := range b.Slashings {
+				hh.AppendUint64(i)
+			}
+			hh.Merkleize(subIndx)
+		}
+
+		// Field (15) 'PreviousEpochParticipation'
+		{
+			elemIndx := hh.Index()
+			byteLen := uint64(len(b.PreviousEpochParticipation))
+			if byteLen > 1099511627776 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			hh.Append(b.PreviousEpochParticipation)
+			hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
+		}
+
+		// Field (16) 'CurrentEpochParticipation'
+		{
+			elemIndx := hh.Index()
+			byteLen := uint64(len(b.CurrentEpochParticipation))
+			if byteLen > 1099511627776 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			hh.Append(b.CurrentEpochParticipation)
+			hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
+		}
+
+		// Field (17) 'JustificationBits'
+		if size := len(b.JustificationBits); size != 1 {
+			err = ssz.ErrBytesLengthFn("BeaconState.JustificationBits", size, 1)
+			return
+		}
+		hh.PutBytes([]byte(b.JustificationBits))
+
+		// Field (18) 'PreviousJustifiedCheckpoint'
+		if b.PreviousJustifiedCheckpoint == nil {
+			b.PreviousJustifiedCheckpoint = new(Checkpoint)
+		}
+		if err = b.PreviousJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (19) 'CurrentJustifiedCheckpoint'
+		if b.CurrentJustifiedCheckpoint == nil {
+			b.CurrentJustifiedCheckpoint = new(Checkpoint)
+		}
+		if err = b.CurrentJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (20) 'FinalizedCheckpoint'
+		if b.FinalizedCheckpoint == nil {
+			b.FinalizedCheckpoint = new(Checkpoint)
+		}
+		if err = b.FinalizedCheckpoint.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (21) 'InactivityScores'
+		{
+			if size := len(b.InactivityScores); size > 1099511627776 {
+				err = ssz.ErrListTooBigFn("BeaconState.InactivityScores", size, 1099511627776)
+				return
+			}
+			subIndx := hh.Index()
+			for _, i := range b.InactivityScores {
+				hh.AppendUint64(i)
+			}
+			hh.FillUpTo32()
+			numItems := uint64(len(b.InactivityScores))
+			hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+		}
+
+		// Field (22) 'CurrentSyncCommittee'
+		if b.CurrentSyncCommittee == nil {
+			b.CurrentSyncCommittee = new(SyncCommittee)
+		}
+		if err = b.CurrentSyncCommittee.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (23) 'NextSyncCommittee'
+		if b.NextSyncCommittee == nil {
+			b.NextSyncCommittee = new(SyncCommittee)
+		}
+		if err = b.NextSyncCommittee.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (24) 'LatestExecutionPayloadHeader'
+		if err = b.LatestExecutionPayloadHeader.HashTreeRootWithFork(hh, fork); err != nil {
+			return
+		}
+
+		hh.Merkleize(indx)
+	case fork >= ssz.ForkAltair:
+		indx := hh.Index()
+
+		// Field (0) 'GenesisTime'
+		hh.PutUint64(b.GenesisTime)
+
+		// Field (1) 'GenesisValidatorsRoot'
+		hh.PutBytes(b.GenesisValidatorsRoot[:])
+
+		// Field (2) 'Slot'
+		hh.PutUint64(b.Slot)
+
+		// Field (3) 'Fork'
+		if b.Fork == nil {
+			b.Fork = new(Fork)
+		}
+		if err = b.Fork.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (4) 'LatestBlockHeader'
+		if b.LatestBlockHeader == nil {
+			b.LatestBlockHeader = new(BeaconBlockHeader)
+		}
+		if err = b.LatestBlockHeader.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (5) 'BlockRoots'
+		{
+			subIndx := hh.Index()
+			for _, i := range b.BlockRoots {
+				hh.Append(i[:])
+			}
+			hh.Merkleize(subIndx)
+		}
+
+		// Field (6) 'StateRoots'
+		{
+			subIndx := hh.Index()
+			for _, i := range b.StateRoots {
+				hh.Append(i[:])
+			}
+			hh.Merkleize(subIndx)
+		}
+
+		// Field (7) 'HistoricalRoots'
+		{
+			if size := len(b.HistoricalRoots); size > 16777216 {
+				err = ssz.ErrListTooBigFn("BeaconState.HistoricalRoots", size, 16777216)
+				return
+			}
+			subIndx := hh.Index()
+			for _, i := range b.HistoricalRoots {
+				if len(i) != 32 {
+					err = ssz.ErrBytesLength
+					return
+				}
+				hh.Append(i)
+			}
+			numItems := uint64(len(b.HistoricalRoots))
+			hh.MerkleizeWithMixin(subIndx, numItems, 16777216)
+		}
+
+		// Field (8) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (9) 'Eth1DataVotes'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.Eth1DataVotes))
+			if num > 2048 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.Eth1DataVotes {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 2048)
+		}
+
+		// Field (10) 'Eth1DepositIndex'
+		hh.PutUint64(b.Eth1DepositIndex)
+
+		// Field (11) 'Validators'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.Validators))
+			if num > 1099511627776 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.Validators {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
+		}
+
+		// Field (12) 'Balances'
+		{
+			if size := len(b.Balances); size > 1099511627776 {
+				err = ssz.ErrListTooBigFn("BeaconState.Balances", size, 1099511627776)
+				return
+			}
+			subIndx := hh.Index()
+			for _, i := range b.Balances {
+				hh.AppendUint64(i)
+			}
+			hh.FillUpTo32()
+			numItems := uint64(len(b.Balances))
+			hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+		}
+
+		// Field (13) 'RandaoMixes'
+		{
+			subIndx := hh.Index()
+			for _, i := range b.RandaoMixes {
+				hh.Append(i[:])
+			}
+			hh.Merkleize(subIndx)
+		}
+
+		// Field (14) 'Slashings'
+		{
+			if size := len(b.Slashings); size != 8192 {
+				err = ssz.ErrVectorLengthFn("BeaconState.Slashings", size, 8192)
+				return
+			}
+			subIndx := hh.Index()
+			for _, i := range b.Slashings {
+				hh.AppendUint64(i)
+			}
+			hh.Merkleize(subIndx)
+		}
+
+		// Field (15) 'PreviousEpochParticipation'
+		{
+			elemIndx := hh.Index()
+			byteLen := uint64(len(b.PreviousEpochParticipation))
+			if byteLen > 1099511627776 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			hh.Append(b.PreviousEpochParticipation)
+			hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
+		}
+
+		// Field (16) 'CurrentEpochParticipation'
+		{
+			elemIndx := hh.Index()
+			byteLen := uint64(len(b.CurrentEpochParticipation))
+			if byteLen > 1099511627776 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			hh.Append(b.CurrentEpochParticipation)
+			hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
+		}
+
+		// Field (17) 'JustificationBits'
+		if size := len(b.JustificationBits); size != 1 {
+			err = ssz.ErrBytesLengthFn("BeaconState.JustificationBits", size, 1)
+			return
+		}
+		hh.PutBytes([]byte(b.JustificationBits))
+
+		// Field (18) 'PreviousJustifiedCheckpoint'
+		if b.PreviousJustifiedCheckpoint == nil {
+			b.PreviousJustifiedCheckpoint = new(Checkpoint)
+		}
+		if err = b.PreviousJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (19) 'CurrentJustifiedCheckpoint'
+		if b.CurrentJustifiedCheckpoint == nil {
+			b.CurrentJustifiedCheckpoint = new(Checkpoint)
+		}
+		if err = b.CurrentJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (20) 'FinalizedCheckpoint'
+		if b.FinalizedCheckpoint == nil {
+			b.FinalizedCheckpoint = new(Checkpoint)
+		}
+		if err = b.FinalizedCheckpoint.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (21) 'InactivityScores'
+		{
+			if size := len(b.InactivityScores); size > 1099511627776 {
+				err = ssz.ErrListTooBigFn("BeaconState.InactivityScores", size, 1099511627776)
+				return
+			}
+			subIndx := hh.Index()
+			for _, i := range b.InactivityScores {
+				hh.AppendUint64(i)
+			}
+			hh.FillUpTo32()
+			numItems := uint64(len(b.InactivityScores))
+			hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+		}
+
+		// Field (22) 'CurrentSyncCommittee'
+		if b.CurrentSyncCommittee == nil {
+			b.CurrentSyncCommittee = new(SyncCommittee)
+		}
+		if err = b.CurrentSyncCommittee.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (23) 'NextSyncCommittee'
+		if b.NextSyncCommittee == nil {
+			b.NextSyncCommittee = new(SyncCommittee)
+		}
+		if err = b.NextSyncCommittee.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		hh.Merkleize(indx)
+	default:
+		indx := hh.Index()
+
+		// Field (0) 'GenesisTime'
+		hh.PutUint64(b.GenesisTime)
+
+		// Field (1) 'GenesisValidatorsRoot'
+		hh.PutBytes(b.GenesisValidatorsRoot[:])
+
+		// Field (2) 'Slot'
+		hh.PutUint64(b.Slot)
+
+		// Field (3) 'Fork'
+		if b.Fork == nil {
+			b.Fork = new(Fork)
+		}
+		if err = b.Fork.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (4) 'LatestBlockHeader'
+		if b.LatestBlockHeader == nil {
+			b.LatestBlockHeader = new(BeaconBlockHeader)
+		}
+		if err = b.LatestBlockHeader.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (5) 'BlockRoots'
+		{
+			subIndx := hh.Index()
+			for _, i := range b.BlockRoots {
+				hh.Append(i[:])
+			}
+			hh.Merkleize(subIndx)
+		}
+
+		// Field (6) 'StateRoots'
+		{
+			subIndx := hh.Index()
+			for _, i := range b.StateRoots {
+				hh.Append(i[:])
+			}
+			hh.Merkleize(subIndx)
+		}
+
+		// Field (7) 'HistoricalRoots'
+		{
+			if size := len(b.HistoricalRoots); size > 16777216 {
+				err = ssz.ErrListTooBigFn("BeaconState.HistoricalRoots", size, 16777216)
+				return
+			}
+			subIndx := hh.Index()
+			for _, i := range b.HistoricalRoots {
+				if len(i) != 32 {
+					err = ssz.ErrBytesLength
+					return
+				}
+				hh.Append(i)
+			}
+			numItems := uint64(len(b.HistoricalRoots))
+			hh.MerkleizeWithMixin(subIndx, numItems, 16777216)
+		}
+
+		// Field (8) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (9) 'Eth1DataVotes'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.Eth1DataVotes))
+			if num > 2048 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.Eth1DataVotes {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 2048)
+		}
+
+		// Field (10) 'Eth1DepositIndex'
+		hh.PutUint64(b.Eth1DepositIndex)
+
+		// Field (11) 'Validators'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.Validators))
+			if num > 1099511627776 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.Validators {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
+		}
+
+		// Field (12) 'Balances'
+		{
+			if size := len(b.Balances); size > 1099511627776 {
+				err = ssz.ErrListTooBigFn("BeaconState.Balances", size, 1099511627776)
+				return
+			}
+			subIndx := hh.Index()
+			for _, i := range b.Balances {
+				hh.AppendUint64(i)
+			}
+			hh.FillUpTo32()
+			numItems := uint64(len(b.Balances))
+			hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+		}
+
+		// Field (13) 'RandaoMixes'
+		{
+			subIndx := hh.Index()
+			for _, i := range b.RandaoMixes {
+				hh.Append(i[:])
+			}
+			hh.Merkleize(subIndx)
+		}
+
+		// Field (14) 'Slashings'
+		{
+			if size := len(b.Slashings); size != 8192 {
+				err = ssz.ErrVectorLengthFn("BeaconState.Slashings", size, 8192)
+				return
+			}
+			subIndx := hh.Index()
+			for _, i := range b.Slashings {
+				hh.AppendUint64(i)
+			}
+			hh.Merkleize(subIndx)
+		}
+
+		// Field (15) 'PreviousEpochAttestations'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.PreviousEpochAttestations))
+			if num > 4096 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.PreviousEpochAttestations {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 4096)
+		}
+
+		// Field (16) 'CurrentEpochAttestations'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.CurrentEpochAttestations))
+			if num > 4096 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.CurrentEpochAttestations {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 4096)
+		}
+
+		// Field (17) 'JustificationBits'
+		if size := len(b.JustificationBits); size != 1 {
+			err = ssz.ErrBytesLengthFn("BeaconState.JustificationBits", size, 1)
+			return
+		}
+		hh.PutBytes([]byte(b.JustificationBits))
+
+		// Field (18) 'PreviousJustifiedCheckpoint'
+		if b.PreviousJustifiedCheckpoint == nil {
+			b.PreviousJustifiedCheckpoint = new(Checkpoint)
+		}
+		if err = b.PreviousJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (19) 'CurrentJustifiedCheckpoint'
+		if b.CurrentJustifiedCheckpoint == nil {
+			b.CurrentJustifiedCheckpoint = new(Checkpoint)
+		}
+		if err = b.CurrentJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (20) 'FinalizedCheckpoint'
+		if b.FinalizedCheckpoint == nil {
+			b.FinalizedCheckpoint = new(Checkpoint)
+		}
+		if err = b.FinalizedCheckpoint.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded BeaconState object without decoding it
+func (b *BeaconState) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(b, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded BeaconState object with a hasher
+func (b *BeaconState) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	return b.HashTreeRootSSZWithFork(hh, buf, ssz.ForkDeneb)
+}
+
+// HashTreeRootSSZFork ssz hashes the encoded BeaconState object for a given fork without decoding it
+func (b *BeaconState) HashTreeRootSSZFork(buf []byte, fork ssz.Fork) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasherFork(b, buf, fork)
+}
+
+// HashTreeRootSSZWithFork ssz hashes the encoded BeaconState object with a hasher for a given fork
+func (b *BeaconState) HashTreeRootSSZWithFork(hh ssz.HashWalker, buf []byte, fork ssz.Fork) (err error) {
+	switch {
+	case fork >= ssz.ForkCapella:
+		size := uint64(len(buf))
+		if size < 2736653 {
+			return ssz.ErrSize
+		}
+
+		tail := buf
+		var o7, o9, o11, o12, o15, o16, o21, o24, o27 uint64
+
+		// Offset (7) 'HistoricalRoots'
+		if o7 = ssz.ReadOffset(buf[524464:524468]); o7 > size {
+			return ssz.ErrOffset
+		}
+
+		if err = ssz.ValidateFirstOffset(o7, 2736653); err != nil {
+			return err
+		}
+
+		// Offset (9) 'Eth1DataVotes'
+		if o9 = ssz.ReadOffset(buf[524540:524544]); o9 > size || o7 > o9 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (11) 'Validators'
+		if o11 = ssz.ReadOffset(buf[524552:524556]); o11 > size || o9 > o11 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (12) 'Balances'
+		if o12 = ssz.ReadOffset(buf[524556:524560]); o12 > size || o11 > o12 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (15) 'PreviousEpochParticipation'
+		if o15 = ssz.ReadOffset(buf[2687248:2687252]); o15 > size || o12 > o15 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (16) 'CurrentEpochParticipation'
+		if o16 = ssz.ReadOffset(buf[2687252:2687256]); o16 > size || o15 > o16 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (21) 'InactivityScores'
+		if o21 = ssz.ReadOffset(buf[2687377:2687381]); o21 > size || o16 > o21 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (24) 'LatestExecutionPayloadHeader'
+		if o24 = ssz.ReadOffset(buf[2736629:2736633]); o24 > size || o21 > o24 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (27) 'HistoricalSummaries'
+		if o27 = ssz.ReadOffset(buf[2736649:2736653]); o27 > size || o24 > o27 {
+			return ssz.ErrOffset
+		}
+
+		indx := hh.Index()
+
+		// Field (0) 'GenesisTime'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
+
+		// Field (1) 'GenesisValidatorsRoot'
+		hh.PutBytes(buf[8:40])
+
+		// Field (2) 'Slot'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[40:48]))
+
+		// Field (3) 'Fork'
+		if err = (*Fork)(nil).HashTreeRootSSZWith(hh, buf[48:64]); err != nil {
+			return err
+		}
+
+		// Field (4) 'LatestBlockHeader'
+		if err = (*BeaconBlockHeader)(nil).HashTreeRootSSZWith(hh, buf[64:176]); err != nil {
+			return err
+		}
+
+		// Field (5) 'BlockRoots'
+		{
+			subIndx := hh.Index()
+			hh.Append(buf[176:262320])
+			hh.Merkleize(subIndx)
+		}
+
+		// Field (6) 'StateRoots'
+		{
+			subIndx := hh.Index()
+			hh.Append(buf[262320:524464])
+			hh.Merkleize(subIndx)
+		}
+
+		// Field (7) 'HistoricalRoots'
+		{
+			buf := tail[o7:o9]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 32, 16777216)
+				if err != nil {
+					return err
+				}
+				hh.Append(buf)
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 16777216)
+			}
+		}
+
+		// Field (8) 'Eth1Data'
+		if err = (*Eth1Data)(nil).HashTreeRootSSZWith(hh, buf[524468:524540]); err != nil {
+			return err
+		}
+
+		// Field (9) 'Eth1DataVotes'
+		{
+			buf := tail[o9:o11]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 72, 2048)
+				if err != nil {
+					return err
+				}
+				for ii := 0; ii < num; ii++ {
+					if err = (*Eth1Data)(nil).HashTreeRootSSZWith(hh, buf[ii*72:(ii+1)*72]); err != nil {
+						return err
+					}
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 2048)
+			}
+		}
+
+		// Field (10) 'Eth1DepositIndex'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[524544:524552]))
+
+		// Field (11) 'Validators'
+		{
+			buf := tail[o11:o12]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 121, 1099511627776)
+				if err != nil {
+					return err
+				}
+				for ii := 0; ii < num; ii++ {
+					if err = (*Validator)(nil).HashTreeRootSSZWith(hh, buf[ii*121:(ii+1)*121]); err != nil {
+						return err
+					}
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 1099511627776)
+			}
+		}
+
+		// Field (12) 'Balances'
+		{
+			buf := tail[o12:o15]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+				if err != nil {
+					return err
+				}
+				hh.Append(buf)
+				hh.FillUpTo32()
+				hh.MerkleizeWithMixin(subIndx, uint64(num), ssz.CalculateLimit(1099511627776, uint64(num), 8))
+			}
+		}
+
+		// Field (13) 'RandaoMixes'
+		{
+			subIndx := hh.Index()
+			hh.Append(buf[524560:2621712])
+			hh.Merkleize(subIndx)
+		}
+
+		// Field (14) 'Slashings'
+		{
+			subIndx := hh.Index()
+			hh.Append(buf[2621712:2687248])
+			hh.Merkleize(subIndx)
+		}
+
+		// Field (15) 'PreviousEpochParticipation'
+		{
+			buf := tail[o15:o16]
+			if len(buf) > 1099511627776 {
+				return ssz.ErrBytesLength
+			}
+			{
+				elemIndx := hh.Index()
+				hh.Append(buf)
+				hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (1099511627776+31)/32)
+			}
+		}
+
+		// Field (16) 'CurrentEpochParticipation'
+		{
+			buf := tail[o16:o21]
+			if len(buf) > 1099511627776 {
+				return ssz.ErrBytesLength
+			}
+			{
+				elemIndx := hh.Index()
+				hh.Append(buf)
+				hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (1099511627776+31)/32)
+			}
+		}
+
+		// Field (17) 'JustificationBits'
+		if err = ssz.ValidateBitvector(buf[2687256:2687257], 4); err != nil {
+			return err
+		}
+		hh.PutBytes(buf[2687256:2687257])
+
+		// Field (18) 'PreviousJustifiedCheckpoint'
+		if err = (*Checkpoint)(nil).HashTreeRootSSZWith(hh, buf[2687257:2687297]); err != nil {
+			return err
+		}
+
+		// Field (19) 'CurrentJustifiedCheckpoint'
+		if err = (*Checkpoint)(nil).HashTreeRootSSZWith(hh, buf[2687297:2687337]); err != nil {
+			return err
+		}
+
+		// Field (20) 'FinalizedCheckpoint'
+		if err = (*Checkpoint)(nil).HashTreeRootSSZWith(hh, buf[2687337:2687377]); err != nil {
+			return err
+		}
+
+		// Field (21) 'InactivityScores'
+		{
+			buf := tail[o21:o24]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+				if err != nil {
+					return err
+				}
+				hh.Append(buf)
+				hh.FillUpTo32()
+				hh.MerkleizeWithMixin(subIndx, uint64(num), ssz.CalculateLimit(1099511627776, uint64(num), 8))
+			}
+		}
+
+		// Field (22) 'CurrentSyncCommittee'
+		if err = (*SyncCommittee)(nil).HashTreeRootSSZWith(hh, buf[2687381:2712005]); err != nil {
+			return err
+		}
+
+		// Field (23) 'NextSyncCommittee'
+		if err = (*SyncCommittee)(nil).HashTreeRootSSZWith(hh, buf[2712005:2736629]); err != nil {
+			return err
+		}
+
+		// Field (24) 'LatestExecutionPayloadHeader'
+		{
+			buf := tail[o24:o27]
+			if err = (*ExecutionPayloadHeader)(nil).HashTreeRootSSZWithFork(hh, buf, fork); err != nil {
+				return err
+			}
+		}
+
+		// Field (25) 'NextWithdrawalIndex'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[2736633:2736641]))
+
+		// Field (26) 'NextWithdrawalValidatorIndex'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[2736641:2736649]))
+
+		// Field (27) 'HistoricalSummaries'
+		{
+			buf := tail[o27:]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 64, 16777216)
+				if err != nil {
+					return err
+				}
+				for ii := 0; ii < num; ii++ {
+					if err = (*HistoricalSummary)(nil).HashTreeRootSSZWith(hh, buf[ii*64:(ii+1)*64]); err != nil {
+						return err
+					}
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 16777216)
+			}
+		}
+
+		hh.Merkleize(indx)
+	case fork >= ssz.ForkBellatrix:
+		size := uint64(len(buf))
+		if size < 2736633 {
+			return ssz.ErrSize
+		}
+
+		tail := buf
+		var o7, o9, o11, o12, o15, o16, o21, o24 uint64
+
+		// Offset (7) 'HistoricalRoots'
+		if o7 = ssz.ReadOffset(buf[524464:524468]); o7 > size {
+			return ssz.ErrOffset
+		}
+
+		if err = ssz.ValidateFirstOffset(o7, 2736633); err != nil {
+			return err
+		}
+
+		// Offset (9) 'Eth1DataVotes'
+		if o9 = ssz.ReadOffset(buf[524540:524544]); o9 > size || o7 > o9 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (11) 'Validators'
+		if o11 = ssz.ReadOffset(buf[524552:524556]); o11 > size || o9 > o11 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (12) 'Balances'
+		if o12 = ssz.ReadOffset(buf[524556:524560]); o12 > size || o11 > o12 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (15) 'PreviousEpochParticipation'
+		if o15 = ssz.ReadOffset(buf[2687248:2687252]); o15 > size || o12 > o15 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (16) 'CurrentEpochParticipation'
+		if o16 = ssz.ReadOffset(buf[2687252:2687256]); o16 > size || o15 > o16 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (21) 'InactivityScores'
+		if o21 = ssz.ReadOffset(buf[2687377:2687381]); o21 > size || o16 > o21 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (24) 'LatestExecutionPayloadHeader'
+		if o24 = ssz.ReadOffset(buf[2736629:2736633]); o24 > size || o21 > o24 {
+			return ssz.ErrOffset
+		}
+
+		indx := hh.Index()
+
+		// Field (0) 'GenesisTime'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
+
+		// Field (1) 'GenesisValidatorsRoot'
+		hh.PutBytes(buf[8:40])
+
+		// Field (2) 'Slot'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[40:48]))
+
+		// Field (3) 'Fork'
+		if err = (*Fork)(nil).HashTreeRootSSZWith(hh, buf[48:64]); err != nil {
+			return err
+		}
+
+		// Field (4) 'LatestBlockHeader'
+		if err = (*BeaconBlockHeader)(nil).HashTreeRootSSZWith(hh, buf[64:176]); err != nil {
+			return err
+		}
+
+		// Field (5) 'BlockRoots'
+		{
+			subIndx := hh.Index()
+			hh.Append(buf[176:262320])
+			hh.Merkleize(subIndx)
+		}
+
+		// Field (6) 'StateRoots'
+		{
+			subIndx := hh.Index()
+			hh.Append(buf[262320:524464])
+			hh.Merkleize(subIndx)
+		}
+
+		// Field (7) 'HistoricalRoots'
+		{
+			buf := tail[o7:o9]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 32, 16777216)
+				if err != nil {
+					return err
+				}
+				hh.Append(buf)
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 16777216)
+			}
+		}
+
+		// Field (8) 'Eth1Data'
+		if err = (*Eth1Data)(nil).HashTreeRootSSZWith(hh, buf[524468:524540]); err != nil {
+			return err
+		}
+
+		// Field (9) 'Eth1DataVotes'
+		{
+			buf := tail[o9:o11]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 72, 2048)
+				if err != nil {
+					return err
+				}
+				for ii := 0; ii < num; ii++ {
+					if err = (*Eth1Data)(nil).HashTreeRootSSZWith(hh, buf[ii*72:(ii+1)*72]); err != nil {
+						return err
+					}
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 2048)
+			}
+		}
+
+		// Field (10) 'Eth1DepositIndex'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[524544:524552]))
+
+		// Field (11) 'Validators'
+		{
+			buf := tail[o11:o12]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 121, 1099511627776)
+				if err != nil {
+					return err
+				}
+				for ii := 0; ii < num; ii++ {
+					if err = (*Validator)(nil).HashTreeRootSSZWith(hh, buf[ii*121:(ii+1)*121]); err != nil {
+						return err
+					}
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 1099511627776)
+			}
+		}
+
+		// Field (12) 'Balances'
+		{
+			buf := tail[o12:o15]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+				if err != nil {
+					return err
+				}
+				hh.Append(buf)
+				hh.FillUpTo32()
+				hh.MerkleizeWithMixin(subIndx, uint64(num), ssz.CalculateLimit(1099511627776, uint64(num), 8))
+			}
+		}
+
+		// Field (13) 'RandaoMixes'
+		{
+			subIndx := hh.Index()
+			hh.Append(buf[524560:2621712])
+			hh.Merkleize(subIndx)
+		}
+
+		// Field (14) 'Slashings'
+		{
+			subIndx := hh.Index()
+			hh.Append(buf[2621712:2687248])
+			hh.Merkleize(subIndx)
+		}
+
+		// Field (15) 'PreviousEpochParticipation'
+		{
+			buf := tail[o15:o16]
+			if len(buf) > 1099511627776 {
+				return ssz.ErrBytesLength
+			}
+			{
+				elemIndx := hh.Index()
+				hh.Append(buf)
+				hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (1099511627776+31)/32)
+			}
+		}
+
+		// Field (16) 'CurrentEpochParticipation'
+		{
+			buf := tail[o16:o21]
+			if len(buf) > 1099511627776 {
+				return ssz.ErrBytesLength
+			}
+			{
+				elemIndx := hh.Index()
+				hh.Append(buf)
+				hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (1099511627776+31)/32)
+			}
+		}
+
+		// Field (17) 'JustificationBits'
+		if err = ssz.ValidateBitvector(buf[2687256:2687257], 4); err != nil {
+			return err
+		}
+		hh.PutBytes(buf[2687256:2687257])
+
+		// Field (18) 'PreviousJustifiedCheckpoint'
+		if err = (*Checkpoint)(nil).HashTreeRootSSZWith(hh, buf[2687257:2687297]); err != nil {
+			return err
+		}
+
+		// Field (19) 'CurrentJustifiedCheckpoint'
+		if err = (*Checkpoint)(nil).HashTreeRootSSZWith(hh, buf[2687297:2687337]); err != nil {
+			return err
+		}
+
+		// Field (20) 'FinalizedCheckpoint'
+		if err = (*Checkpoint)(nil).HashTreeRootSSZWith(hh, buf[2687337:2687377]); err != nil {
+			return err
+		}
+
+		// Field (21) 'InactivityScores'
+		{
+			buf := tail[o21:o24]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+				if err != nil {
+					return err
+				}
+				hh.Append(buf)
+				hh.FillUpTo32()
+				hh.MerkleizeWithMixin(subIndx, uint64(num), ssz.CalculateLimit(1099511627776, uint64(num), 8))
+			}
+		}
+
+		// Field (22) 'CurrentSyncCommittee'
+		if err = (*SyncCommittee)(nil).HashTreeRootSSZWith(hh, buf[2687381:2712005]); err != nil {
+			return err
+		}
+
+		// Field (23) 'NextSyncCommittee'
+		if err = (*SyncCommittee)(nil).HashTreeRootSSZWith(hh, buf[2712005:2736629]); err != nil {
+			return err
+		}
+
+		// Field (24) 'LatestExecutionPayloadHeader'
+		{
+			buf := tail[o24:]
+			if err = (*ExecutionPayloadHeader)(nil).HashTreeRootSSZWithFork(hh, buf, fork); err != nil {
+				return err
+			}
+		}
+
+		hh.Merkleize(indx)
+	case fork >= ssz.ForkAltair:
+		size := uint64(len(buf))
+		if size < 2736629 {
+			return ssz.ErrSize
+		}
+
+		tail := buf
+		var o7, o9, o11, o12, o15, o16, o21 uint64
+
+		// Offset (7) 'HistoricalRoots'
+		if o7 = ssz.ReadOffset(buf[524464:524468]); o7 > size {
+			return ssz.ErrOffset
+		}
+
+		if err = ssz.ValidateFirstOffset(o7, 2736629); err != nil {
+			return err
+		}
+
+		// Offset (9) 'Eth1DataVotes'
+		if o9 = ssz.ReadOffset(buf[524540:524544]); o9 > size || o7 > o9 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (11) 'Validators'
+		if o11 = ssz.ReadOffset(buf[524552:524556]); o11 > size || o9 > o11 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (12) 'Balances'
+		if o12 = ssz.ReadOffset(buf[524556:524560]); o12 > size || o11 > o12 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (15) 'PreviousEpochParticipation'
+		if o15 = ssz.ReadOffset(buf[2687248:2687252]); o15 > size || o12 > o15 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (16) 'CurrentEpochParticipation'
+		if o16 = ssz.ReadOffset(buf[2687252:2687256]); o16 > size || o15 > o16 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (21) 'InactivityScores'
+		if o21 = ssz.ReadOffset(buf[2687377:2687381]); o21 > size || o16 > o21 {
+			return ssz.ErrOffset
+		}
+
+		indx := hh.Index()
+
+		// Field (0) 'GenesisTime'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
+
+		// Field (1) 'GenesisValidatorsRoot'
+		hh.PutBytes(buf[8:40])
+
+		// Field (2) 'Slot'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[40:48]))
+
+		// Field (3) 'Fork'
+		if err = (*Fork)(nil).HashTreeRootSSZWith(hh, buf[48:64]); err != nil {
+			return err
+		}
+
+		// Field (4) 'LatestBlockHeader'
+		if err = (*BeaconBlockHeader)(nil).HashTreeRootSSZWith(hh, buf[64:176]); err != nil {
+			return err
+		}
+
+		// Field (5) 'BlockRoots'
+		{
+			subIndx := hh.Index()
+			hh.Append(buf[176:262320])
+			hh.Merkleize(subIndx)
+		}
+
+		// Field (6) 'StateRoots'
+		{
+			subIndx := hh.Index()
+			hh.Append(buf[262320:524464])
+			hh.Merkleize(subIndx)
+		}
+
+		// Field (7) 'HistoricalRoots'
+		{
+			buf := tail[o7:o9]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 32, 16777216)
+				if err != nil {
+					return err
+				}
+				hh.Append(buf)
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 16777216)
+			}
+		}
+
+		// Field (8) 'Eth1Data'
+		if err = (*Eth1Data)(nil).HashTreeRootSSZWith(hh, buf[524468:524540]); err != nil {
+			return err
+		}
+
+		// Field (9) 'Eth1DataVotes'
+		{
+			buf := tail[o9:o11]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 72, 2048)
+				if err != nil {
+					return err
+				}
+				for ii := 0; ii < num; ii++ {
+					if err = (*Eth1Data)(nil).HashTreeRootSSZWith(hh, buf[ii*72:(ii+1)*72]); err != nil {
+						return err
+					}
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 2048)
+			}
+		}
+
+		// Field (10) 'Eth1DepositIndex'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[524544:524552]))
+
+		// Field (11) 'Validators'
+		{
+			buf := tail[o11:o12]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 121, 1099511627776)
+				if err != nil {
+					return err
+				}
+				for ii := 0; ii < num; ii++ {
+					if err = (*Validator)(nil).HashTreeRootSSZWith(hh, buf[ii*121:(ii+1)*121]); err != nil {
+						return err
+					}
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 1099511627776)
+			}
+		}
+
+		// Field (12) 'Balances'
+		{
+			buf := tail[o12:o15]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+				if err != nil {
+					return err
+				}
+				hh.Append(buf)
+				hh.FillUpTo32()
+				hh.MerkleizeWithMixin(subIndx, uint64(num), ssz.CalculateLimit(1099511627776, uint64(num), 8))
+			}
+		}
+
+		// Field (13) 'RandaoMixes'
+		{
+			subIndx := hh.Index()
+			hh.Append(buf[524560:2621712])
+			hh.Merkleize(subIndx)
+		}
+
+		// Field (14) 'Slashings'
+		{
+			subIndx := hh.Index()
+			hh.Append(buf[2621712:2687248])
+			hh.Merkleize(subIndx)
+		}
+
+		// Field (15) 'PreviousEpochParticipation'
+		{
+			buf := tail[o15:o16]
+			if len(buf) > 1099511627776 {
+				return ssz.ErrBytesLength
+			}
+			{
+				elemIndx := hh.Index()
+				hh.Append(buf)
+				hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (1099511627776+31)/32)
+			}
+		}
+
+		// Field (16) 'CurrentEpochParticipation'
+		{
+			buf := tail[o16:o21]
+			if len(buf) > 1099511627776 {
+				return ssz.ErrBytesLength
+			}
+			{
+				elemIndx := hh.Index()
+				hh.Append(buf)
+				hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (1099511627776+31)/32)
+			}
+		}
+
+		// Field (17) 'JustificationBits'
+		if err = ssz.ValidateBitvector(buf[2687256:2687257], 4); err != nil {
+			return err
+		}
+		hh.PutBytes(buf[2687256:2687257])
+
+		// Field (18) 'PreviousJustifiedCheckpoint'
+		if err = (*Checkpoint)(nil).HashTreeRootSSZWith(hh, buf[2687257:2687297]); err != nil {
+			return err
+		}
+
+		// Field (19) 'CurrentJustifiedCheckpoint'
+		if err = (*Checkpoint)(nil).HashTreeRootSSZWith(hh, buf[2687297:2687337]); err != nil {
+			return err
+		}
+
+		// Field (20) 'FinalizedCheckpoint'
+		if err = (*Checkpoint)(nil).HashTreeRootSSZWith(hh, buf[2687337:2687377]); err != nil {
+			return err
+		}
+
+		// Field (21) 'InactivityScores'
+		{
+			buf := tail[o21:]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+				if err != nil {
+					return err
+				}
+				hh.Append(buf)
+				hh.FillUpTo32()
+				hh.MerkleizeWithMixin(subIndx, uint64(num), ssz.CalculateLimit(1099511627776, uint64(num), 8))
+			}
+		}
+
+		// Field (22) 'CurrentSyncCommittee'
+		if err = (*SyncCommittee)(nil).HashTreeRootSSZWith(hh, buf[2687381:2712005]); err != nil {
+			return err
+		}
+
+		// Field (23) 'NextSyncCommittee'
+		if err = (*SyncCommittee)(nil).HashTreeRootSSZWith(hh, buf[2712005:2736629]); err != nil {
+			return err
+		}
+
+		hh.Merkleize(indx)
+	default:
+		size := uint64(len(buf))
+		if size < 2687377 {
+			return ssz.ErrSize
+		}
+
+		tail := buf
+		var o7, o9, o11, o12, o15, o16 uint64
+
+		// Offset (7) 'HistoricalRoots'
+		if o7 = ssz.ReadOffset(buf[524464:524468]); o7 > size {
+			return ssz.ErrOffset
+		}
+
+		if err = ssz.ValidateFirstOffset(o7, 2687377); err != nil {
+			return err
+		}
+
+		// Offset (9) 'Eth1DataVotes'
+		if o9 = ssz.ReadOffset(buf[524540:524544]); o9 > size || o7 > o9 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (11) 'Validators'
+		if o11 = ssz.ReadOffset(buf[524552:524556]); o11 > size || o9 > o11 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (12) 'Balances'
+		if o12 = ssz.ReadOffset(buf[524556:524560]); o12 > size || o11 > o12 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (15) 'PreviousEpochAttestations'
+		if o15 = ssz.ReadOffset(buf[2687248:2687252]); o15 > size || o12 > o15 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (16) 'CurrentEpochAttestations'
+		if o16 = ssz.ReadOffset(buf[2687252:2687256]); o16 > size || o15 > o16 {
+			return ssz.ErrOffset
+		}
+
+		indx := hh.Index()
+
+		// Field (0) 'GenesisTime'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
+
+		// Field (1) 'GenesisValidatorsRoot'
+		hh.PutBytes(buf[8:40])
+
+		// Field (2) 'Slot'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[40:48]))
+
+		// Field (3) 'Fork'
+		if err = (*Fork)(nil).HashTreeRootSSZWith(hh, buf[48:64]); err != nil {
+			return err
+		}
+
+		// Field (4) 'LatestBlockHeader'
+		if err = (*BeaconBlockHeader)(nil).HashTreeRootSSZWith(hh, buf[64:176]); err != nil {
+			return err
+		}
+
+		// Field (5) 'BlockRoots'
+		{
+			subIndx := hh.Index()
+			hh.Append(buf[176:262320])
+			hh.Merkleize(subIndx)
+		}
+
+		// Field (6) 'StateRoots'
+		{
+			subIndx := hh.Index()
+			hh.Append(buf[262320:524464])
+			hh.Merkleize(subIndx)
+		}
+
+		// Field (7) 'HistoricalRoots'
+		{
+			buf := tail[o7:o9]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 32, 16777216)
+				if err != nil {
+					return err
+				}
+				hh.Append(buf)
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 16777216)
+			}
+		}
+
+		// Field (8) 'Eth1Data'
+		if err = (*Eth1Data)(nil).HashTreeRootSSZWith(hh, buf[524468:524540]); err != nil {
+			return err
+		}
+
+		// Field (9) 'Eth1DataVotes'
+		{
+			buf := tail[o9:o11]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 72, 2048)
+				if err != nil {
+					return err
+				}
+				for ii := 0; ii < num; ii++ {
+					if err = (*Eth1Data)(nil).HashTreeRootSSZWith(hh, buf[ii*72:(ii+1)*72]); err != nil {
+						return err
+					}
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 2048)
+			}
+		}
+
+		// Field (10) 'Eth1DepositIndex'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[524544:524552]))
+
+		// Field (11) 'Validators'
+		{
+			buf := tail[o11:o12]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 121, 1099511627776)
+				if err != nil {
+					return err
+				}
+				for ii := 0; ii < num; ii++ {
+					if err = (*Validator)(nil).HashTreeRootSSZWith(hh, buf[ii*121:(ii+1)*121]); err != nil {
+						return err
+					}
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 1099511627776)
+			}
+		}
+
+		// Field (12) 'Balances'
+		{
+			buf := tail[o12:o15]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
+				if err != nil {
+					return err
+				}
+				hh.Append(buf)
+				hh.FillUpTo32()
+				hh.MerkleizeWithMixin(subIndx, uint64(num), ssz.CalculateLimit(1099511627776, uint64(num), 8))
+			}
+		}
+
+		// Field (13) 'RandaoMixes'
+		{
+			subIndx := hh.Index()
+			hh.Append(buf[524560:2621712])
+			hh.Merkleize(subIndx)
+		}
+
+		// Field (14) 'Slashings'
+		{
+			subIndx := hh.Index()
+			hh.Append(buf[2621712:2687248])
+			hh.Merkleize(subIndx)
+		}
+
+		// Field (15) 'PreviousEpochAttestations'
+		{
+			buf := tail[o15:o16]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DecodeDynamicLength(buf, 4096)
+				if err != nil {
+					return err
+				}
+				err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+					if err = (*PendingAttestation)(nil).HashTreeRootSSZWith(hh, buf); err != nil {
+						return err
+					}
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 4096)
+			}
+		}
+
+		// Field (16) 'CurrentEpochAttestations'
+		{
+			buf := tail[o16:]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DecodeDynamicLength(buf, 4096)
+				if err != nil {
+					return err
+				}
+				err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+					if err = (*PendingAttestation)(nil).HashTreeRootSSZWith(hh, buf); err != nil {
+						return err
+					}
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 4096)
+			}
+		}
+
+		// Field (17) 'JustificationBits'
+		if err = ssz.ValidateBitvector(buf[2687256:2687257], 4); err != nil {
+			return err
+		}
+		hh.PutBytes(buf[2687256:2687257])
+
+		// Field (18) 'PreviousJustifiedCheckpoint'
+		if err = (*Checkpoint)(nil).HashTreeRootSSZWith(hh, buf[2687257:2687297]); err != nil {
+			return err
+		}
+
+		// Field (19) 'CurrentJustifiedCheckpoint'
+		if err = (*Checkpoint)(nil).HashTreeRootSSZWith(hh, buf[2687297:2687337]); err != nil {
+			return err
+		}
+
+		// Field (20) 'FinalizedCheckpoint'
+		if err = (*Checkpoint)(nil).HashTreeRootSSZWith(hh, buf[2687337:2687377]); err != nil {
+			return err
+		}
+
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// GetTree ssz hashes the BeaconState object
+func (b *BeaconState) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// GetTreeFork ssz hashes the BeaconState object for a given fork
+func (b *BeaconState) GetTreeFork(fork ssz.Fork) (*ssz.Node, error) {
+	return ssz.ProofTreeFork(b, fork)
+}
+
+const (
+	// BeaconStateGenesisTimeGIndex is the generalized index of the 'GenesisTime' field at the deneb fork
+	BeaconStateGenesisTimeGIndex ssz.GIndex = 32
+	// BeaconStateGenesisValidatorsRootGIndex is the generalized index of the 'GenesisValidatorsRoot' field at the deneb fork
+	BeaconStateGenesisValidatorsRootGIndex ssz.GIndex = 33
+	// BeaconStateSlotGIndex is the generalized index of the 'Slot' field at the deneb fork
+	BeaconStateSlotGIndex ssz.GIndex = 34
+	// BeaconStateForkGIndex is the generalized index of the 'Fork' field at the deneb fork
+	BeaconStateForkGIndex ssz.GIndex = 35
+	// BeaconStateLatestBlockHeaderGIndex is the generalized index of the 'LatestBlockHeader' field at the deneb fork
+	BeaconStateLatestBlockHeaderGIndex ssz.GIndex = 36
+	// BeaconStateBlockRootsGIndex is the generalized index of the 'BlockRoots' field at the deneb fork
+	BeaconStateBlockRootsGIndex ssz.GIndex = 37
+	// BeaconStateStateRootsGIndex is the generalized index of the 'StateRoots' field at the deneb fork
+	BeaconStateStateRootsGIndex ssz.GIndex = 38
+	// BeaconStateHistoricalRootsGIndex is the generalized index of the 'HistoricalRoots' field at the deneb fork
+	BeaconStateHistoricalRootsGIndex ssz.GIndex = 39
+	// BeaconStateEth1DataGIndex is the generalized index of the 'Eth1Data' field at the deneb fork
+	BeaconStateEth1DataGIndex ssz.GIndex = 40
+	// BeaconStateEth1DataVotesGIndex is the generalized index of the 'Eth1DataVotes' field at the deneb fork
+	BeaconStateEth1DataVotesGIndex ssz.GIndex = 41
+	// BeaconStateEth1DepositIndexGIndex is the generalized index of the 'Eth1DepositIndex' field at the deneb fork
+	BeaconStateEth1DepositIndexGIndex ssz.GIndex = 42
+	// BeaconStateValidatorsGIndex is the generalized index of the 'Validators' field at the deneb fork
+	BeaconStateValidatorsGIndex ssz.GIndex = 43
+	// BeaconStateBalancesGIndex is the generalized index of the 'Balances' field at the deneb fork
+	BeaconStateBalancesGIndex ssz.GIndex = 44
+	// BeaconStateRandaoMixesGIndex is the generalized index of the 'RandaoMixes' field at the deneb fork
+	BeaconStateRandaoMixesGIndex ssz.GIndex = 45
+	// BeaconStateSlashingsGIndex is the generalized index of the 'Slashings' field at the deneb fork
+	BeaconStateSlashingsGIndex ssz.GIndex = 46
+	// BeaconStatePreviousEpochParticipationGIndex is the generalized index of the 'PreviousEpochParticipation' field at the deneb fork
+	BeaconStatePreviousEpochParticipationGIndex ssz.GIndex = 47
+	// BeaconStateCurrentEpochParticipationGIndex is the generalized index of the 'CurrentEpochParticipation' field at the deneb fork
+	BeaconStateCurrentEpochParticipationGIndex ssz.GIndex = 48
+	// BeaconStateJustificationBitsGIndex is the generalized index of the 'JustificationBits' field at the deneb fork
+	BeaconStateJustificationBitsGIndex ssz.GIndex = 49
+	// BeaconStatePreviousJustifiedCheckpointGIndex is the generalized index of the 'PreviousJustifiedCheckpoint' field at the deneb fork
+	BeaconStatePreviousJustifiedCheckpointGIndex ssz.GIndex = 50
+	// BeaconStateCurrentJustifiedCheckpointGIndex is the generalized index of the 'CurrentJustifiedCheckpoint' field at the deneb fork
+	BeaconStateCurrentJustifiedCheckpointGIndex ssz.GIndex = 51
+	// BeaconStateFinalizedCheckpointGIndex is the generalized index of the 'FinalizedCheckpoint' field at the deneb fork
+	BeaconStateFinalizedCheckpointGIndex ssz.GIndex = 52
+	// BeaconStateInactivityScoresGIndex is the generalized index of the 'InactivityScores' field at the deneb fork
+	BeaconStateInactivityScoresGIndex ssz.GIndex = 53
+	// BeaconStateCurrentSyncCommitteeGIndex is the generalized index of the 'CurrentSyncCommittee' field at the deneb fork
+	BeaconStateCurrentSyncCommitteeGIndex ssz.GIndex = 54
+	// BeaconStateNextSyncCommitteeGIndex is the generalized index of the 'NextSyncCommittee' field at the deneb fork
+	BeaconStateNextSyncCommitteeGIndex ssz.GIndex = 55
+	// BeaconStateLatestExecutionPayloadHeaderGIndex is the generalized index of the 'LatestExecutionPayloadHeader' field at the deneb fork
+	BeaconStateLatestExecutionPayloadHeaderGIndex ssz.GIndex = 56
+	// BeaconStateNextWithdrawalIndexGIndex is the generalized index of the 'NextWithdrawalIndex' field at the deneb fork
+	BeaconStateNextWithdrawalIndexGIndex ssz.GIndex = 57
+	// BeaconStateNextWithdrawalValidatorIndexGIndex is the generalized index of the 'NextWithdrawalValidatorIndex' field at the deneb fork
+	BeaconStateNextWithdrawalValidatorIndexGIndex ssz.GIndex = 58
+	// BeaconStateHistoricalSummariesGIndex is the generalized index of the 'HistoricalSummaries' field at the deneb fork
+	BeaconStateHistoricalSummariesGIndex ssz.GIndex = 59
+)
+
+// ProveGenesisTime returns a merkle proof of the 'GenesisTime' field of the BeaconState object
+func (b *BeaconState) ProveGenesisTime() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconStateGenesisTimeGIndex)
+}
+
+// VerifyBeaconStateGenesisTime verifies a merkle proof of the 'GenesisTime' field of a BeaconState object
+func VerifyBeaconStateGenesisTime(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconStateGenesisTimeGIndex)
+}
+
+// ProveGenesisValidatorsRoot returns a merkle proof of the 'GenesisValidatorsRoot' field of the BeaconState object
+func (b *BeaconState) ProveGenesisValidatorsRoot() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconStateGenesisValidatorsRootGIndex)
+}
+
+// VerifyBeaconStateGenesisValidatorsRoot verifies a merkle proof of the 'GenesisValidatorsRoot' field of a BeaconState object
+func VerifyBeaconStateGenesisValidatorsRoot(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconStateGenesisValidatorsRootGIndex)
+}
+
+// ProveSlot returns a merkle proof of the 'Slot' field of the BeaconState object
+func (b *BeaconState) ProveSlot() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconStateSlotGIndex)
+}
+
+// VerifyBeaconStateSlot verifies a merkle proof of the 'Slot' field of a BeaconState object
+func VerifyBeaconStateSlot(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconStateSlotGIndex)
+}
+
+// ProveFork returns a merkle proof of the 'Fork' field of the BeaconState object
+func (b *BeaconState) ProveFork() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconStateForkGIndex)
+}
+
+// VerifyBeaconStateFork verifies a merkle proof of the 'Fork' field of a BeaconState object
+func VerifyBeaconStateFork(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconStateForkGIndex)
+}
+
+// ProveLatestBlockHeader returns a merkle proof of the 'LatestBlockHeader' field of the BeaconState object
+func (b *BeaconState) ProveLatestBlockHeader() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconStateLatestBlockHeaderGIndex)
+}
+
+// VerifyBeaconStateLatestBlockHeader verifies a merkle proof of the 'LatestBlockHeader' field of a BeaconState object
+func VerifyBeaconStateLatestBlockHeader(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconStateLatestBlockHeaderGIndex)
+}
+
+// ProveBlockRoots returns a merkle proof of the 'BlockRoots' field of the BeaconState object
+func (b *BeaconState) ProveBlockRoots() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconStateBlockRootsGIndex)
+}
+
+// VerifyBeaconStateBlockRoots verifies a merkle proof of the 'BlockRoots' field of a BeaconState object
+func VerifyBeaconStateBlockRoots(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconStateBlockRootsGIndex)
+}
+
+// ProveStateRoots returns a merkle proof of the 'StateRoots' field of the BeaconState object
+func (b *BeaconState) ProveStateRoots() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconStateStateRootsGIndex)
+}
+
+// VerifyBeaconStateStateRoots verifies a merkle proof of the 'StateRoots' field of a BeaconState object
+func VerifyBeaconStateStateRoots(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconStateStateRootsGIndex)
+}
+
+// ProveHistoricalRoots returns a merkle proof of the 'HistoricalRoots' field of the BeaconState object
+func (b *BeaconState) ProveHistoricalRoots() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconStateHistoricalRootsGIndex)
+}
+
+// VerifyBeaconStateHistoricalRoots verifies a merkle proof of the 'HistoricalRoots' field of a BeaconState object
+func VerifyBeaconStateHistoricalRoots(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconStateHistoricalRootsGIndex)
+}
+
+// ProveEth1Data returns a merkle proof of the 'Eth1Data' field of the BeaconState object
+func (b *BeaconState) ProveEth1Data() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconStateEth1DataGIndex)
+}
+
+// VerifyBeaconStateEth1Data verifies a merkle proof of the 'Eth1Data' field of a BeaconState object
+func VerifyBeaconStateEth1Data(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconStateEth1DataGIndex)
+}
+
+// ProveEth1DataVotes returns a merkle proof of the 'Eth1DataVotes' field of the BeaconState object
+func (b *BeaconState) ProveEth1DataVotes() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconStateEth1DataVotesGIndex)
+}
+
+// VerifyBeaconStateEth1DataVotes verifies a merkle proof of the 'Eth1DataVotes' field of a BeaconState object
+func VerifyBeaconStateEth1DataVotes(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconStateEth1DataVotesGIndex)
+}
+
+// ProveEth1DepositIndex returns a merkle proof of the 'Eth1DepositIndex' field of the BeaconState object
+func (b *BeaconState) ProveEth1DepositIndex() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconStateEth1DepositIndexGIndex)
+}
+
+// VerifyBeaconStateEth1DepositIndex verifies a merkle proof of the 'Eth1DepositIndex' field of a BeaconState object
+func VerifyBeaconStateEth1DepositIndex(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconStateEth1DepositIndexGIndex)
+}
+
+// ProveValidators returns a merkle proof of the 'Validators' field of the BeaconState object
+func (b *BeaconState) ProveValidators() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconStateValidatorsGIndex)
+}
+
+// VerifyBeaconStateValidators verifies a merkle proof of the 'Validators' field of a BeaconState object
+func VerifyBeaconStateValidators(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconStateValidatorsGIndex)
+}
+
+// ProveBalances returns a merkle proof of the 'Balances' field of the BeaconState object
+func (b *BeaconState) ProveBalances() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconStateBalancesGIndex)
+}
+
+// VerifyBeaconStateBalances verifies a merkle proof of the 'Balances' field of a BeaconState object
+func VerifyBeaconStateBalances(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconStateBalancesGIndex)
+}
+
+// ProveRandaoMixes returns a merkle proof of the 'RandaoMixes' field of the BeaconState object
+func (b *BeaconState) ProveRandaoMixes() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconStateRandaoMixesGIndex)
+}
+
+// VerifyBeaconStateRandaoMixes verifies a merkle proof of the 'RandaoMixes' field of a BeaconState object
+func VerifyBeaconStateRandaoMixes(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconStateRandaoMixesGIndex)
+}
+
+// ProveSlashings returns a merkle proof of the 'Slashings' field of the BeaconState object
+func (b *BeaconState) ProveSlashings() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconStateSlashingsGIndex)
+}
+
+// VerifyBeaconStateSlashings verifies a merkle proof of the 'Slashings' field of a BeaconState object
+func VerifyBeaconStateSlashings(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconStateSlashingsGIndex)
+}
+
+// ProvePreviousEpochParticipation returns a merkle proof of the 'PreviousEpochParticipation' field of the BeaconState object
+func (b *BeaconState) ProvePreviousEpochParticipation() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconStatePreviousEpochParticipationGIndex)
+}
+
+// VerifyBeaconStatePreviousEpochParticipation verifies a merkle proof of the 'PreviousEpochParticipation' field of a BeaconState object
+func VerifyBeaconStatePreviousEpochParticipation(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconStatePreviousEpochParticipationGIndex)
+}
+
+// ProveCurrentEpochParticipation returns a merkle proof of the 'CurrentEpochParticipation' field of the BeaconState object
+func (b *BeaconState) ProveCurrentEpochParticipation() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconStateCurrentEpochParticipationGIndex)
+}
+
+// VerifyBeaconStateCurrentEpochParticipation verifies a merkle proof of the 'CurrentEpochParticipation' field of a BeaconState object
+func VerifyBeaconStateCurrentEpochParticipation(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconStateCurrentEpochParticipationGIndex)
+}
+
+// ProveJustificationBits returns a merkle proof of the 'JustificationBits' field of the BeaconState object
+func (b *BeaconState) ProveJustificationBits() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconStateJustificationBitsGIndex)
+}
+
+// VerifyBeaconStateJustificationBits verifies a merkle proof of the 'JustificationBits' field of a BeaconState object
+func VerifyBeaconStateJustificationBits(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconStateJustificationBitsGIndex)
+}
+
+// ProvePreviousJustifiedCheckpoint returns a merkle proof of the 'PreviousJustifiedCheckpoint' field of the BeaconState object
+func (b *BeaconState) ProvePreviousJustifiedCheckpoint() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconStatePreviousJustifiedCheckpointGIndex)
+}
+
+// VerifyBeaconStatePreviousJustifiedCheckpoint verifies a merkle proof of the 'PreviousJustifiedCheckpoint' field of a BeaconState object
+func VerifyBeaconStatePreviousJustifiedCheckpoint(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconStatePreviousJustifiedCheckpointGIndex)
+}
+
+// ProveCurrentJustifiedCheckpoint returns a merkle proof of the 'CurrentJustifiedCheckpoint' field of the BeaconState object
+func (b *BeaconState) ProveCurrentJustifiedCheckpoint() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconStateCurrentJustifiedCheckpointGIndex)
+}
+
+// VerifyBeaconStateCurrentJustifiedCheckpoint verifies a merkle proof of the 'CurrentJustifiedCheckpoint' field of a BeaconState object
+func VerifyBeaconStateCurrentJustifiedCheckpoint(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconStateCurrentJustifiedCheckpointGIndex)
+}
+
+// ProveFinalizedCheckpoint returns a merkle proof of the 'FinalizedCheckpoint' field of the BeaconState object
+func (b *BeaconState) ProveFinalizedCheckpoint() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconStateFinalizedCheckpointGIndex)
+}
+
+// VerifyBeaconStateFinalizedCheckpoint verifies a merkle proof of the 'FinalizedCheckpoint' field of a BeaconState object
+func VerifyBeaconStateFinalizedCheckpoint(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconStateFinalizedCheckpointGIndex)
+}
+
+// ProveInactivityScores returns a merkle proof of the 'InactivityScores' field of the BeaconState object
+func (b *BeaconState) ProveInactivityScores() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconStateInactivityScoresGIndex)
+}
+
+// VerifyBeaconStateInactivityScores verifies a merkle proof of the 'InactivityScores' field of a BeaconState object
+func VerifyBeaconStateInactivityScores(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconStateInactivityScoresGIndex)
+}
+
+// ProveCurrentSyncCommittee returns a merkle proof of the 'CurrentSyncCommittee' field of the BeaconState object
+func (b *BeaconState) ProveCurrentSyncCommittee() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconStateCurrentSyncCommitteeGIndex)
+}
+
+// VerifyBeaconStateCurrentSyncCommittee verifies a merkle proof of the 'CurrentSyncCommittee' field of a BeaconState object
+func VerifyBeaconStateCurrentSyncCommittee(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconStateCurrentSyncCommitteeGIndex)
+}
+
+// ProveNextSyncCommittee returns a merkle proof of the 'NextSyncCommittee' field of the BeaconState object
+func (b *BeaconState) ProveNextSyncCommittee() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconStateNextSyncCommitteeGIndex)
+}
+
+// VerifyBeaconStateNextSyncCommittee verifies a merkle proof of the 'NextSyncCommittee' field of a BeaconState object
+func VerifyBeaconStateNextSyncCommittee(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconStateNextSyncCommitteeGIndex)
+}
+
+// ProveLatestExecutionPayloadHeader returns a merkle proof of the 'LatestExecutionPayloadHeader' field of the BeaconState object
+func (b *BeaconState) ProveLatestExecutionPayloadHeader() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconStateLatestExecutionPayloadHeaderGIndex)
+}
+
+// VerifyBeaconStateLatestExecutionPayloadHeader verifies a merkle proof of the 'LatestExecutionPayloadHeader' field of a BeaconState object
+func VerifyBeaconStateLatestExecutionPayloadHeader(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconStateLatestExecutionPayloadHeaderGIndex)
+}
+
+// ProveNextWithdrawalIndex returns a merkle proof of the 'NextWithdrawalIndex' field of the BeaconState object
+func (b *BeaconState) ProveNextWithdrawalIndex() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconStateNextWithdrawalIndexGIndex)
+}
+
+// VerifyBeaconStateNextWithdrawalIndex verifies a merkle proof of the 'NextWithdrawalIndex' field of a BeaconState object
+func VerifyBeaconStateNextWithdrawalIndex(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconStateNextWithdrawalIndexGIndex)
+}
+
+// ProveNextWithdrawalValidatorIndex returns a merkle proof of the 'NextWithdrawalValidatorIndex' field of the BeaconState object
+func (b *BeaconState) ProveNextWithdrawalValidatorIndex() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconStateNextWithdrawalValidatorIndexGIndex)
+}
+
+// VerifyBeaconStateNextWithdrawalValidatorIndex verifies a merkle proof of the 'NextWithdrawalValidatorIndex' field of a BeaconState object
+func VerifyBeaconStateNextWithdrawalValidatorIndex(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconStateNextWithdrawalValidatorIndexGIndex)
+}
+
+// ProveHistoricalSummaries returns a merkle proof of the 'HistoricalSummaries' field of the BeaconState object
+func (b *BeaconState) ProveHistoricalSummaries() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconStateHistoricalSummariesGIndex)
+}
+
+// VerifyBeaconStateHistoricalSummaries verifies a merkle proof of the 'HistoricalSummaries' field of a BeaconState object
+func VerifyBeaconStateHistoricalSummaries(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconStateHistoricalSummariesGIndex)
+}
+
+// BeaconStateGenesisTimeGIndexFork returns the generalized index of the 'GenesisTime' field for a given fork
+func BeaconStateGenesisTimeGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	return 32, nil
+}
+
+// ProveGenesisTimeFork returns a merkle proof of the 'GenesisTime' field of the BeaconState object for a given fork
+func (b *BeaconState) ProveGenesisTimeFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconStateGenesisTimeGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconStateGenesisTimeFork verifies a merkle proof of the 'GenesisTime' field of a BeaconState object for a given fork
+func VerifyBeaconStateGenesisTimeFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconStateGenesisTimeGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconStateGenesisValidatorsRootGIndexFork returns the generalized index of the 'GenesisValidatorsRoot' field for a given fork
+func BeaconStateGenesisValidatorsRootGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	return 33, nil
+}
+
+// ProveGenesisValidatorsRootFork returns a merkle proof of the 'GenesisValidatorsRoot' field of the BeaconState object for a given fork
+func (b *BeaconState) ProveGenesisValidatorsRootFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconStateGenesisValidatorsRootGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconStateGenesisValidatorsRootFork verifies a merkle proof of the 'GenesisValidatorsRoot' field of a BeaconState object for a given fork
+func VerifyBeaconStateGenesisValidatorsRootFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconStateGenesisValidatorsRootGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconStateSlotGIndexFork returns the generalized index of the 'Slot' field for a given fork
+func BeaconStateSlotGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	return 34, nil
+}
+
+// ProveSlotFork returns a merkle proof of the 'Slot' field of the BeaconState object for a given fork
+func (b *BeaconState) ProveSlotFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconStateSlotGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconStateSlotFork verifies a merkle proof of the 'Slot' field of a BeaconState object for a given fork
+func VerifyBeaconStateSlotFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconStateSlotGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconStateForkGIndexFork returns the generalized index of the 'Fork' field for a given fork
+func BeaconStateForkGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	return 35, nil
+}
+
+// ProveForkFork returns a merkle proof of the 'Fork' field of the BeaconState object for a given fork
+func (b *BeaconState) ProveForkFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconStateForkGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconStateForkFork verifies a merkle proof of the 'Fork' field of a BeaconState object for a given fork
+func VerifyBeaconStateForkFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconStateForkGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconStateLatestBlockHeaderGIndexFork returns the generalized index of the 'LatestBlockHeader' field for a given fork
+func BeaconStateLatestBlockHeaderGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	return 36, nil
+}
+
+// ProveLatestBlockHeaderFork returns a merkle proof of the 'LatestBlockHeader' field of the BeaconState object for a given fork
+func (b *BeaconState) ProveLatestBlockHeaderFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconStateLatestBlockHeaderGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconStateLatestBlockHeaderFork verifies a merkle proof of the 'LatestBlockHeader' field of a BeaconState object for a given fork
+func VerifyBeaconStateLatestBlockHeaderFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconStateLatestBlockHeaderGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconStateBlockRootsGIndexFork returns the generalized index of the 'BlockRoots' field for a given fork
+func BeaconStateBlockRootsGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	return 37, nil
+}
+
+// ProveBlockRootsFork returns a merkle proof of the 'BlockRoots' field of the BeaconState object for a given fork
+func (b *BeaconState) ProveBlockRootsFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconStateBlockRootsGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconStateBlockRootsFork verifies a merkle proof of the 'BlockRoots' field of a BeaconState object for a given fork
+func VerifyBeaconStateBlockRootsFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconStateBlockRootsGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconStateStateRootsGIndexFork returns the generalized index of the 'StateRoots' field for a given fork
+func BeaconStateStateRootsGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	return 38, nil
+}
+
+// ProveStateRootsFork returns a merkle proof of the 'StateRoots' field of the BeaconState object for a given fork
+func (b *BeaconState) ProveStateRootsFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconStateStateRootsGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconStateStateRootsFork verifies a merkle proof of the 'StateRoots' field of a BeaconState object for a given fork
+func VerifyBeaconStateStateRootsFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconStateStateRootsGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconStateHistoricalRootsGIndexFork returns the generalized index of the 'HistoricalRoots' field for a given fork
+func BeaconStateHistoricalRootsGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	return 39, nil
+}
+
+// ProveHistoricalRootsFork returns a merkle proof of the 'HistoricalRoots' field of the BeaconState object for a given fork
+func (b *BeaconState) ProveHistoricalRootsFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconStateHistoricalRootsGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconStateHistoricalRootsFork verifies a merkle proof of the 'HistoricalRoots' field of a BeaconState object for a given fork
+func VerifyBeaconStateHistoricalRootsFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconStateHistoricalRootsGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconStateEth1DataGIndexFork returns the generalized index of the 'Eth1Data' field for a given fork
+func BeaconStateEth1DataGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	return 40, nil
+}
+
+// ProveEth1DataFork returns a merkle proof of the 'Eth1Data' field of the BeaconState object for a given fork
+func (b *BeaconState) ProveEth1DataFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconStateEth1DataGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconStateEth1DataFork verifies a merkle proof of the 'Eth1Data' field of a BeaconState object for a given fork
+func VerifyBeaconStateEth1DataFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconStateEth1DataGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconStateEth1DataVotesGIndexFork returns the generalized index of the 'Eth1DataVotes' field for a given fork
+func BeaconStateEth1DataVotesGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	return 41, nil
+}
+
+// ProveEth1DataVotesFork returns a merkle proof of the 'Eth1DataVotes' field of the BeaconState object for a given fork
+func (b *BeaconState) ProveEth1DataVotesFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconStateEth1DataVotesGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconStateEth1DataVotesFork verifies a merkle proof of the 'Eth1DataVotes' field of a BeaconState object for a given fork
+func VerifyBeaconStateEth1DataVotesFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconStateEth1DataVotesGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconStateEth1DepositIndexGIndexFork returns the generalized index of the 'Eth1DepositIndex' field for a given fork
+func BeaconStateEth1DepositIndexGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	return 42, nil
+}
+
+// ProveEth1DepositIndexFork returns a merkle proof of the 'Eth1DepositIndex' field of the BeaconState object for a given fork
+func (b *BeaconState) ProveEth1DepositIndexFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconStateEth1DepositIndexGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconStateEth1DepositIndexFork verifies a merkle proof of the 'Eth1DepositIndex' field of a BeaconState object for a given fork
+func VerifyBeaconStateEth1DepositIndexFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconStateEth1DepositIndexGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconStateValidatorsGIndexFork returns the generalized index of the 'Validators' field for a given fork
+func BeaconStateValidatorsGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	return 43, nil
+}
+
+// ProveValidatorsFork returns a merkle proof of the 'Validators' field of the BeaconState object for a given fork
+func (b *BeaconState) ProveValidatorsFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconStateValidatorsGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconStateValidatorsFork verifies a merkle proof of the 'Validators' field of a BeaconState object for a given fork
+func VerifyBeaconStateValidatorsFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconStateValidatorsGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconStateBalancesGIndexFork returns the generalized index of the 'Balances' field for a given fork
+func BeaconStateBalancesGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	return 44, nil
+}
+
+// ProveBalancesFork returns a merkle proof of the 'Balances' field of the BeaconState object for a given fork
+func (b *BeaconState) ProveBalancesFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconStateBalancesGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconStateBalancesFork verifies a merkle proof of the 'Balances' field of a BeaconState object for a given fork
+func VerifyBeaconStateBalancesFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconStateBalancesGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconStateRandaoMixesGIndexFork returns the generalized index of the 'RandaoMixes' field for a given fork
+func BeaconStateRandaoMixesGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	return 45, nil
+}
+
+// ProveRandaoMixesFork returns a merkle proof of the 'RandaoMixes' field of the BeaconState object for a given fork
+func (b *BeaconState) ProveRandaoMixesFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconStateRandaoMixesGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconStateRandaoMixesFork verifies a merkle proof of the 'RandaoMixes' field of a BeaconState object for a given fork
+func VerifyBeaconStateRandaoMixesFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconStateRandaoMixesGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconStateSlashingsGIndexFork returns the generalized index of the 'Slashings' field for a given fork
+func BeaconStateSlashingsGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	return 46, nil
+}
+
+// ProveSlashingsFork returns a merkle proof of the 'Slashings' field of the BeaconState object for a given fork
+func (b *BeaconState) ProveSlashingsFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconStateSlashingsGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconStateSlashingsFork verifies a merkle proof of the 'Slashings' field of a BeaconState object for a given fork
+func VerifyBeaconStateSlashingsFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconStateSlashingsGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconStatePreviousEpochAttestationsGIndexFork returns the generalized index of the 'PreviousEpochAttestations' field for a given fork
+func BeaconStatePreviousEpochAttestationsGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkAltair:
+		return 0, ssz.ErrForkField
+	default:
+		return 47, nil
+	}
+}
+
+// ProvePreviousEpochAttestationsFork returns a merkle proof of the 'PreviousEpochAttestations' field of the BeaconState object for a given fork
+func (b *BeaconState) ProvePreviousEpochAttestationsFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconStatePreviousEpochAttestationsGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconStatePreviousEpochAttestationsFork verifies a merkle proof of the 'PreviousEpochAttestations' field of a BeaconState object for a given fork
+func VerifyBeaconStatePreviousEpochAttestationsFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconStatePreviousEpochAttestationsGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconStateCurrentEpochAttestationsGIndexFork returns the generalized index of the 'CurrentEpochAttestations' field for a given fork
+func BeaconStateCurrentEpochAttestationsGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkAltair:
+		return 0, ssz.ErrForkField
+	default:
+		return 48, nil
+	}
+}
+
+// ProveCurrentEpochAttestationsFork returns a merkle proof of the 'CurrentEpochAttestations' field of the BeaconState object for a given fork
+func (b *BeaconState) ProveCurrentEpochAttestationsFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconStateCurrentEpochAttestationsGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconStateCurrentEpochAttestationsFork verifies a merkle proof of the 'CurrentEpochAttestations' field of a BeaconState object for a given fork
+func VerifyBeaconStateCurrentEpochAttestationsFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconStateCurrentEpochAttestationsGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconStatePreviousEpochParticipationGIndexFork returns the generalized index of the 'PreviousEpochParticipation' field for a given fork
+func BeaconStatePreviousEpochParticipationGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkAltair:
+		return 47, nil
+	default:
+		return 0, ssz.ErrForkField
+	}
+}
+
+// ProvePreviousEpochParticipationFork returns a merkle proof of the 'PreviousEpochParticipation' field of the BeaconState object for a given fork
+func (b *BeaconState) ProvePreviousEpochParticipationFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconStatePreviousEpochParticipationGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconStatePreviousEpochParticipationFork verifies a merkle proof of the 'PreviousEpochParticipation' field of a BeaconState object for a given fork
+func VerifyBeaconStatePreviousEpochParticipationFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconStatePreviousEpochParticipationGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconStateCurrentEpochParticipationGIndexFork returns the generalized index of the 'CurrentEpochParticipation' field for a given fork
+func BeaconStateCurrentEpochParticipationGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkAltair:
+		return 48, nil
+	default:
+		return 0, ssz.ErrForkField
+	}
+}
+
+// ProveCurrentEpochParticipationFork returns a merkle proof of the 'CurrentEpochParticipation' field of the BeaconState object for a given fork
+func (b *BeaconState) ProveCurrentEpochParticipationFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconStateCurrentEpochParticipationGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconStateCurrentEpochParticipationFork verifies a merkle proof of the 'CurrentEpochParticipation' field of a BeaconState object for a given fork
+func VerifyBeaconStateCurrentEpochParticipationFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconStateCurrentEpochParticipationGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconStateJustificationBitsGIndexFork returns the generalized index of the 'JustificationBits' field for a given fork
+func BeaconStateJustificationBitsGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	return 49, nil
+}
+
+// ProveJustificationBitsFork returns a merkle proof of the 'JustificationBits' field of the BeaconState object for a given fork
+func (b *BeaconState) ProveJustificationBitsFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconStateJustificationBitsGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconStateJustificationBitsFork verifies a merkle proof of the 'JustificationBits' field of a BeaconState object for a given fork
+func VerifyBeaconStateJustificationBitsFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconStateJustificationBitsGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconStatePreviousJustifiedCheckpointGIndexFork returns the generalized index of the 'PreviousJustifiedCheckpoint' field for a given fork
+func BeaconStatePreviousJustifiedCheckpointGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	return 50, nil
+}
+
+// ProvePreviousJustifiedCheckpointFork returns a merkle proof of the 'PreviousJustifiedCheckpoint' field of the BeaconState object for a given fork
+func (b *BeaconState) ProvePreviousJustifiedCheckpointFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconStatePreviousJustifiedCheckpointGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconStatePreviousJustifiedCheckpointFork verifies a merkle proof of the 'PreviousJustifiedCheckpoint' field of a BeaconState object for a given fork
+func VerifyBeaconStatePreviousJustifiedCheckpointFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconStatePreviousJustifiedCheckpointGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconStateCurrentJustifiedCheckpointGIndexFork returns the generalized index of the 'CurrentJustifiedCheckpoint' field for a given fork
+func BeaconStateCurrentJustifiedCheckpointGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	return 51, nil
+}
+
+// ProveCurrentJustifiedCheckpointFork returns a merkle proof of the 'CurrentJustifiedCheckpoint' field of the BeaconState object for a given fork
+func (b *BeaconState) ProveCurrentJustifiedCheckpointFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconStateCurrentJustifiedCheckpointGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconStateCurrentJustifiedCheckpointFork verifies a merkle proof of the 'CurrentJustifiedCheckpoint' field of a BeaconState object for a given fork
+func VerifyBeaconStateCurrentJustifiedCheckpointFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconStateCurrentJustifiedCheckpointGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconStateFinalizedCheckpointGIndexFork returns the generalized index of the 'FinalizedCheckpoint' field for a given fork
+func BeaconStateFinalizedCheckpointGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	return 52, nil
+}
+
+// ProveFinalizedCheckpointFork returns a merkle proof of the 'FinalizedCheckpoint' field of the BeaconState object for a given fork
+func (b *BeaconState) ProveFinalizedCheckpointFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconStateFinalizedCheckpointGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconStateFinalizedCheckpointFork verifies a merkle proof of the 'FinalizedCheckpoint' field of a BeaconState object for a given fork
+func VerifyBeaconStateFinalizedCheckpointFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconStateFinalizedCheckpointGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconStateInactivityScoresGIndexFork returns the generalized index of the 'InactivityScores' field for a given fork
+func BeaconStateInactivityScoresGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkAltair:
+		return 53, nil
+	default:
+		return 0, ssz.ErrForkField
+	}
+}
+
+// ProveInactivityScoresFork returns a merkle proof of the 'InactivityScores' field of the BeaconState object for a given fork
+func (b *BeaconState) ProveInactivityScoresFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconStateInactivityScoresGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconStateInactivityScoresFork verifies a merkle proof of the 'InactivityScores' field of a BeaconState object for a given fork
+func VerifyBeaconStateInactivityScoresFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconStateInactivityScoresGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconStateCurrentSyncCommitteeGIndexFork returns the generalized index of the 'CurrentSyncCommittee' field for a given fork
+func BeaconStateCurrentSyncCommitteeGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkAltair:
+		return 54, nil
+	default:
+		return 0, ssz.ErrForkField
+	}
+}
+
+// ProveCurrentSyncCommitteeFork returns a merkle proof of the 'CurrentSyncCommittee' field of the BeaconState object for a given fork
+func (b *BeaconState) ProveCurrentSyncCommitteeFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconStateCurrentSyncCommitteeGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconStateCurrentSyncCommitteeFork verifies a merkle proof of the 'CurrentSyncCommittee' field of a BeaconState object for a given fork
+func VerifyBeaconStateCurrentSyncCommitteeFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconStateCurrentSyncCommitteeGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconStateNextSyncCommitteeGIndexFork returns the generalized index of the 'NextSyncCommittee' field for a given fork
+func BeaconStateNextSyncCommitteeGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkAltair:
+		return 55, nil
+	default:
+		return 0, ssz.ErrForkField
+	}
+}
+
+// ProveNextSyncCommitteeFork returns a merkle proof of the 'NextSyncCommittee' field of the BeaconState object for a given fork
+func (b *BeaconState) ProveNextSyncCommitteeFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconStateNextSyncCommitteeGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconStateNextSyncCommitteeFork verifies a merkle proof of the 'NextSyncCommittee' field of a BeaconState object for a given fork
+func VerifyBeaconStateNextSyncCommitteeFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconStateNextSyncCommitteeGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconStateLatestExecutionPayloadHeaderGIndexFork returns the generalized index of the 'LatestExecutionPayloadHeader' field for a given fork
+func BeaconStateLatestExecutionPayloadHeaderGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkBellatrix:
+		return 56, nil
+	default:
+		return 0, ssz.ErrForkField
+	}
+}
+
+// ProveLatestExecutionPayloadHeaderFork returns a merkle proof of the 'LatestExecutionPayloadHeader' field of the BeaconState object for a given fork
+func (b *BeaconState) ProveLatestExecutionPayloadHeaderFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconStateLatestExecutionPayloadHeaderGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconStateLatestExecutionPayloadHeaderFork verifies a merkle proof of the 'LatestExecutionPayloadHeader' field of a BeaconState object for a given fork
+func VerifyBeaconStateLatestExecutionPayloadHeaderFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconStateLatestExecutionPayloadHeaderGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconStateNextWithdrawalIndexGIndexFork returns the generalized index of the 'NextWithdrawalIndex' field for a given fork
+func BeaconStateNextWithdrawalIndexGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkCapella:
+		return 57, nil
+	default:
+		return 0, ssz.ErrForkField
+	}
+}
+
+// ProveNextWithdrawalIndexFork returns a merkle proof of the 'NextWithdrawalIndex' field of the BeaconState object for a given fork
+func (b *BeaconState) ProveNextWithdrawalIndexFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconStateNextWithdrawalIndexGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconStateNextWithdrawalIndexFork verifies a merkle proof of the 'NextWithdrawalIndex' field of a BeaconState object for a given fork
+func VerifyBeaconStateNextWithdrawalIndexFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconStateNextWithdrawalIndexGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconStateNextWithdrawalValidatorIndexGIndexFork returns the generalized index of the 'NextWithdrawalValidatorIndex' field for a given fork
+func BeaconStateNextWithdrawalValidatorIndexGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkCapella:
+		return 58, nil
+	default:
+		return 0, ssz.ErrForkField
+	}
+}
+
+// ProveNextWithdrawalValidatorIndexFork returns a merkle proof of the 'NextWithdrawalValidatorIndex' field of the BeaconState object for a given fork
+func (b *BeaconState) ProveNextWithdrawalValidatorIndexFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconStateNextWithdrawalValidatorIndexGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconStateNextWithdrawalValidatorIndexFork verifies a merkle proof of the 'NextWithdrawalValidatorIndex' field of a BeaconState object for a given fork
+func VerifyBeaconStateNextWithdrawalValidatorIndexFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconStateNextWithdrawalValidatorIndexGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconStateHistoricalSummariesGIndexFork returns the generalized index of the 'HistoricalSummaries' field for a given fork
+func BeaconStateHistoricalSummariesGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkCapella:
+		return 59, nil
+	default:
+		return 0, ssz.ErrForkField
+	}
+}
+
+// ProveHistoricalSummariesFork returns a merkle proof of the 'HistoricalSummaries' field of the BeaconState object for a given fork
+func (b *BeaconState) ProveHistoricalSummariesFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconStateHistoricalSummariesGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconStateHistoricalSummariesFork verifies a merkle proof of the 'HistoricalSummaries' field of a BeaconState object for a given fork
+func VerifyBeaconStateHistoricalSummariesFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconStateHistoricalSummariesGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// SchemaSSZ returns the schema of the tree of the BeaconState object
+func (b *BeaconState) SchemaSSZ() *ssz.Schema {
+	return b.SchemaSSZFork(ssz.ForkDeneb)
+}
+
+// SchemaSSZFork returns the schema of the tree of the BeaconState object for a given fork
+func (b *BeaconState) SchemaSSZFork(fork ssz.Fork) *ssz.Schema {
+	switch {
+	case fork >= ssz.ForkCapella:
+		return ssz.SchemaContainer(
+			&ssz.SchemaField{Name: "GenesisTime", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "GenesisValidatorsRoot", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "Slot", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "Fork", Schema: (*Fork)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "LatestBlockHeader", Schema: (*BeaconBlockHeader)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "BlockRoots", Schema: ssz.SchemaVector(ssz.SchemaBytes(32), 8192)},
+			&ssz.SchemaField{Name: "StateRoots", Schema: ssz.SchemaVector(ssz.SchemaBytes(32), 8192)},
+			&ssz.SchemaField{Name: "HistoricalRoots", Schema: ssz.SchemaList(ssz.SchemaBytes(32), 16777216)},
+			&ssz.SchemaField{Name: "Eth1Data", Schema: (*Eth1Data)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "Eth1DataVotes", Schema: ssz.SchemaList((*Eth1Data)(nil).SchemaSSZ(), 2048)},
+			&ssz.SchemaField{Name: "Eth1DepositIndex", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "Validators", Schema: ssz.SchemaList((*Validator)(nil).SchemaSSZ(), 1099511627776)},
+			&ssz.SchemaField{Name: "Balances", Schema: ssz.SchemaList(ssz.SchemaUint(8), 1099511627776)},
+			&ssz.SchemaField{Name: "RandaoMixes", Schema: ssz.SchemaVector(ssz.SchemaBytes(32), 65536)},
+			&ssz.SchemaField{Name: "Slashings", Schema: ssz.SchemaVector(ssz.SchemaUint(8), 8192)},
+			&ssz.SchemaField{Name: "PreviousEpochParticipation", Schema: ssz.SchemaList(ssz.SchemaUint(1), 1099511627776)},
+			&ssz.SchemaField{Name: "CurrentEpochParticipation", Schema: ssz.SchemaList(ssz.SchemaUint(1), 1099511627776)},
+			&ssz.SchemaField{Name: "JustificationBits", Schema: ssz.SchemaBitvector(4)},
+			&ssz.SchemaField{Name: "PreviousJustifiedCheckpoint", Schema: (*Checkpoint)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "CurrentJustifiedCheckpoint", Schema: (*Checkpoint)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "FinalizedCheckpoint", Schema: (*Checkpoint)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "InactivityScores", Schema: ssz.SchemaList(ssz.SchemaUint(8), 1099511627776)},
+			&ssz.SchemaField{Name: "CurrentSyncCommittee", Schema: (*SyncCommittee)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "NextSyncCommittee", Schema: (*SyncCommittee)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "LatestExecutionPayloadHeader", Schema: (*ExecutionPayloadHeader)(nil).SchemaSSZFork(fork)},
+			&ssz.SchemaField{Name: "NextWithdrawalIndex", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "NextWithdrawalValidatorIndex", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "HistoricalSummaries", Schema: ssz.SchemaList((*HistoricalSummary)(nil).SchemaSSZ(), 16777216)},
+		)
+	case fork >= ssz.ForkBellatrix:
+		return ssz.SchemaContainer(
+			&ssz.SchemaField{Name: "GenesisTime", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "GenesisValidatorsRoot", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "Slot", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "Fork", Schema: (*Fork)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "LatestBlockHeader", Schema: (*BeaconBlockHeader)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "BlockRoots", Schema: ssz.SchemaVector(ssz.SchemaBytes(32), 8192)},
+			&ssz.SchemaField{Name: "StateRoots", Schema: ssz.SchemaVector(ssz.SchemaBytes(32), 8192)},
+			&ssz.SchemaField{Name: "HistoricalRoots", Schema: ssz.SchemaList(ssz.SchemaBytes(32), 16777216)},
+			&ssz.SchemaField{Name: "Eth1Data", Schema: (*Eth1Data)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "Eth1DataVotes", Schema: ssz.SchemaList((*Eth1Data)(nil).SchemaSSZ(), 2048)},
+			&ssz.SchemaField{Name: "Eth1DepositIndex", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "Validators", Schema: ssz.SchemaList((*Validator)(nil).SchemaSSZ(), 1099511627776)},
+			&ssz.SchemaField{Name: "Balances", Schema: ssz.SchemaList(ssz.SchemaUint(8), 1099511627776)},
+			&ssz.SchemaField{Name: "RandaoMixes", Schema: ssz.SchemaVector(ssz.SchemaBytes(32), 65536)},
+			&ssz.SchemaField{Name: "Slashings", Schema: ssz.SchemaVector(ssz.SchemaUint(8), 8192)},
+			&ssz.SchemaField{Name: "PreviousEpochParticipation", Schema: ssz.SchemaList(ssz.SchemaUint(1), 1099511627776)},
+			&ssz.SchemaField{Name: "CurrentEpochParticipation", Schema: ssz.SchemaList(ssz.SchemaUint(1), 1099511627776)},
+			&ssz.SchemaField{Name: "JustificationBits", Schema: ssz.SchemaBitvector(4)},
+			&ssz.SchemaField{Name: "PreviousJustifiedCheckpoint", Schema: (*Checkpoint)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "CurrentJustifiedCheckpoint", Schema: (*Checkpoint)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "FinalizedCheckpoint", Schema: (*Checkpoint)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "InactivityScores", Schema: ssz.SchemaList(ssz.SchemaUint(8), 1099511627776)},
+			&ssz.SchemaField{Name: "CurrentSyncCommittee", Schema: (*SyncCommittee)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "NextSyncCommittee", Schema: (*SyncCommittee)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "LatestExecutionPayloadHeader", Schema: (*ExecutionPayloadHeader)(nil).SchemaSSZFork(fork)},
+		)
+	case fork >= ssz.ForkAltair:
+		return ssz.SchemaContainer(
+			&ssz.SchemaField{Name: "GenesisTime", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "GenesisValidatorsRoot", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "Slot", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "Fork", Schema: (*Fork)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "LatestBlockHeader", Schema: (*BeaconBlockHeader)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "BlockRoots", Schema: ssz.SchemaVector(ssz.SchemaBytes(32), 8192)},
+			&ssz.SchemaField{Name: "StateRoots", Schema: ssz.SchemaVector(ssz.SchemaBytes(32), 8192)},
+			&ssz.SchemaField{Name: "HistoricalRoots", Schema: ssz.SchemaList(ssz.SchemaBytes(32), 16777216)},
+			&ssz.SchemaField{Name: "Eth1Data", Schema: (*Eth1Data)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "Eth1DataVotes", Schema: ssz.SchemaList((*Eth1Data)(nil).SchemaSSZ(), 2048)},
+			&ssz.SchemaField{Name: "Eth1DepositIndex", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "Validators", Schema: ssz.SchemaList((*Validator)(nil).SchemaSSZ(), 1099511627776)},
+			&ssz.SchemaField{Name: "Balances", Schema: ssz.SchemaList(ssz.SchemaUint(8), 1099511627776)},
+			&ssz.SchemaField{Name: "RandaoMixes", Schema: ssz.SchemaVector(ssz.SchemaBytes(32), 65536)},
+			&ssz.SchemaField{Name: "Slashings", Schema: ssz.SchemaVector(ssz.SchemaUint(8), 8192)},
+			&ssz.SchemaField{Name: "PreviousEpochParticipation", Schema: ssz.SchemaList(ssz.SchemaUint(1), 1099511627776)},
+			&ssz.SchemaField{Name: "CurrentEpochParticipation", Schema: ssz.SchemaList(ssz.SchemaUint(1), 1099511627776)},
+			&ssz.SchemaField{Name: "JustificationBits", Schema: ssz.SchemaBitvector(4)},
+			&ssz.SchemaField{Name: "PreviousJustifiedCheckpoint", Schema: (*Checkpoint)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "CurrentJustifiedCheckpoint", Schema: (*Checkpoint)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "FinalizedCheckpoint", Schema: (*Checkpoint)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "InactivityScores", Schema: ssz.SchemaList(ssz.SchemaUint(8), 1099511627776)},
+			&ssz.SchemaField{Name: "CurrentSyncCommittee", Schema: (*SyncCommittee)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "NextSyncCommittee", Schema: (*SyncCommittee)(nil).SchemaSSZ()},
+		)
+	default:
+		return ssz.SchemaContainer(
+			&ssz.SchemaField{Name: "GenesisTime", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "GenesisValidatorsRoot", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "Slot", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "Fork", Schema: (*Fork)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "LatestBlockHeader", Schema: (*BeaconBlockHeader)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "BlockRoots", Schema: ssz.SchemaVector(ssz.SchemaBytes(32), 8192)},
+			&ssz.SchemaField{Name: "StateRoots", Schema: ssz.SchemaVector(ssz.SchemaBytes(32), 8192)},
+			&ssz.SchemaField{Name: "HistoricalRoots", Schema: ssz.SchemaList(ssz.SchemaBytes(32), 16777216)},
+			&ssz.SchemaField{Name: "Eth1Data", Schema: (*Eth1Data)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "Eth1DataVotes", Schema: ssz.SchemaList((*Eth1Data)(nil).SchemaSSZ(), 2048)},
+			&ssz.SchemaField{Name: "Eth1DepositIndex", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "Validators", Schema: ssz.SchemaList((*Validator)(nil).SchemaSSZ(), 1099511627776)},
+			&ssz.SchemaField{Name: "Balances", Schema: ssz.SchemaList(ssz.SchemaUint(8), 1099511627776)},
+			&ssz.SchemaField{Name: "RandaoMixes", Schema: ssz.SchemaVector(ssz.SchemaBytes(32), 65536)},
+			&ssz.SchemaField{Name: "Slashings", Schema: ssz.SchemaVector(ssz.SchemaUint(8), 8192)},
+			&ssz.SchemaField{Name: "PreviousEpochAttestations", Schema: ssz.SchemaList((*PendingAttestation)(nil).SchemaSSZ(), 4096)},
+			&ssz.SchemaField{Name: "CurrentEpochAttestations", Schema: ssz.SchemaList((*PendingAttestation)(nil).SchemaSSZ(), 4096)},
+			&ssz.SchemaField{Name: "JustificationBits", Schema: ssz.SchemaBitvector(4)},
+			&ssz.SchemaField{Name: "PreviousJustifiedCheckpoint", Schema: (*Checkpoint)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "CurrentJustifiedCheckpoint", Schema: (*Checkpoint)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "FinalizedCheckpoint", Schema: (*Checkpoint)(nil).SchemaSSZ()},
+		)
+	}
+}
+
+// MarshalSSZ ssz marshals the BeaconBlockBody object
+func (b *BeaconBlockBody) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BeaconBlockBody object to a target array
+func (b *BeaconBlockBody) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	return b.MarshalSSZToFork(buf, ssz.ForkDeneb)
+}
+
+// MarshalSSZFork ssz marshals the BeaconBlockBody object for a given fork
+func (b *BeaconBlockBody) MarshalSSZFork(fork ssz.Fork) ([]byte, error) {
+	return ssz.MarshalSSZFork(b, fork)
+}
+
+// MarshalSSZToFork ssz marshals the BeaconBlockBody object to a target array for a given fork
+func (b *BeaconBlockBody) MarshalSSZToFork(buf []byte, fork ssz.Fork) (dst []byte, err error) {
+	dst = buf
+	switch {
+	case fork >= ssz.ForkDeneb:
+		offset := int(392)
+		// Field (0) 'RandaoReveal'
+		if size := len(b.RandaoReveal); size != 96 {
+			err = ssz.ErrBytesLengthFn("BeaconBlockBody.RandaoReveal", size, 96)
+			return
+		}
+		dst = append(dst, b.RandaoReveal...)
+
+		// Field (1) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Field (2) 'Graffiti'
+		dst = append(dst, b.Graffiti[:]...)
+
+		// Offset (3) 'ProposerSlashings'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.ProposerSlashings) * 416
+
+		// Offset (4) 'AttesterSlashings'
+		dst = ssz.WriteOffset(dst, offset)
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			offset += 4
+			offset += b.AttesterSlashings[ii].SizeSSZ()
+		}
+
+		// Offset (5) 'Attestations'
+		dst = ssz.WriteOffset(dst, offset)
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			offset += 4
+			offset += b.Attestations[ii].SizeSSZ()
+		}
+
+		// Offset (6) 'Deposits'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.Deposits) * 1240
+
+		// Offset (7) 'VoluntaryExits'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.VoluntaryExits) * 112
+
+		// Field (8) 'SyncAggregate'
+		if b.SyncAggregate == nil {
+			b.SyncAggregate = new(SyncAggregate)
+		}
+		if dst, err = b.SyncAggregate.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Offset (9) 'ExecutionPayload'
+		dst = ssz.WriteOffset(dst, offset)
+		if b.ExecutionPayload == nil {
+			b.ExecutionPayload = new(ExecutionPayload)
+		}
+		offset += b.ExecutionPayload.SizeSSZFork(fork)
+
+		// Offset (10) 'BlsToExecutionChanges'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.BlsToExecutionChanges) * 172
+
+		// Offset (11) 'BlobKzgCommitments'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (3) 'ProposerSlashings'
+		if size := len(b.ProposerSlashings); size > 16 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.ProposerSlashings", size, 16)
+			return
+		}
+		for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+			if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+		// Field (4) 'AttesterSlashings'
+		if size := len(b.AttesterSlashings); size > 2 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.AttesterSlashings", size, 2)
+			return
+		}
+		{
+			offset = 4 * len(b.AttesterSlashings)
+			for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+				dst = ssz.WriteOffset(dst, offset)
+				offset += b.AttesterSlashings[ii].SizeSSZ()
+
+			}
+		}
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			if dst, err = b.AttesterSlashings[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+		// Field (5) 'Attestations'
+		if size := len(b.Attestations); size > 128 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.Attestations", size, 128)
+			return
+		}
+		{
+			offset = 4 * len(b.Attestations)
+			for ii := 0; ii < len(b.Attestations); ii++ {
+				dst = ssz.WriteOffset(dst, offset)
+				offset += b.Attestations[ii].SizeSSZ()
+
+			}
+		}
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			if dst, err = b.Attestations[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+		// Field (6) 'Deposits'
+		if size := len(b.Deposits); size > 16 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.Deposits", size, 16)
+			return
+		}
+		for ii := 0; ii < len(b.Deposits); ii++ {
+			if dst, err = b.Deposits[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+		// Field (7) 'VoluntaryExits'
+		if size := len(b.VoluntaryExits); size > 16 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.VoluntaryExits", size, 16)
+			return
+		}
+		for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+			if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+		// Field (9) 'ExecutionPayload'
+		if dst, err = b.ExecutionPayload.MarshalSSZToFork(dst, fork); err != nil {
+			return
+		}
+
+		// Field (10) 'BlsToExecutionChanges'
+		if size := len(b.BlsToExecutionChanges); size > 16 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.BlsToExecutionChanges", size, 16)
+			return
+		}
+		for ii := 0; ii < len(b.BlsToExecutionChanges); ii++ {
+			if dst, err = b.BlsToExecutionChanges[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+		// Field (11) 'BlobKzgCommitments'
+		if size := len(b.BlobKzgCommitments); size > 4096 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.BlobKzgCommitments", size, 4096)
+			return
+		}
+		for ii := 0; ii < len(b.BlobKzgCommitments); ii++ {
+			dst = append(dst, b.BlobKzgCommitments[ii][:]...)
+
+		}
+
+	case fork >= ssz.ForkCapella:
+		offset := int(388)
+		// Field (0) 'RandaoReveal'
+		if size := len(b.RandaoReveal); size != 96 {
+			err = ssz.ErrBytesLengthFn("BeaconBlockBody.RandaoReveal", size, 96)
+			return
+		}
+		dst = append(dst, b.RandaoReveal...)
+
+		// Field (1) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Field (2) 'Graffiti'
+		dst = append(dst, b.Graffiti[:]...)
+
+		// Offset (3) 'ProposerSlashings'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.ProposerSlashings) * 416
+
+		// Offset (4) 'AttesterSlashings'
+		dst = ssz.WriteOffset(dst, offset)
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			offset += 4
+			offset += b.AttesterSlashings[ii].SizeSSZ()
+		}
+
+		// Offset (5) 'Attestations'
+		dst = ssz.WriteOffset(dst, offset)
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			offset += 4
+			offset += b.Attestations[ii].SizeSSZ()
+		}
+
+		// Offset (6) 'Deposits'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.Deposits) * 1240
+
+		// Offset (7) 'VoluntaryExits'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.VoluntaryExits) * 112
+
+		// Field (8) 'SyncAggregate'
+		if b.SyncAggregate == nil {
+			b.SyncAggregate = new(SyncAggregate)
+		}
+		if dst, err = b.SyncAggregate.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Offset (9) 'ExecutionPayload'
+		dst = ssz.WriteOffset(dst, offset)
+		if b.ExecutionPayload == nil {
+			b.ExecutionPayload = new(ExecutionPayload)
+		}
+		offset += b.ExecutionPayload.SizeSSZFork(fork)
+
+		// Offset (10) 'BlsToExecutionChanges'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (3) 'ProposerSlashings'
+		if size := len(b.ProposerSlashings); size > 16 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.ProposerSlashings", size, 16)
+			return
+		}
+		for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+			if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+		// Field (4) 'AttesterSlashings'
+		if size := len(b.AttesterSlashings); size > 2 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.AttesterSlashings", size, 2)
+			return
+		}
+		{
+			offset = 4 * len(b.AttesterSlashings)
+			for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+				dst = ssz.WriteOffset(dst, offset)
+				offset += b.AttesterSlashings[ii].SizeSSZ()
+
+			}
+		}
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			if dst, err = b.AttesterSlashings[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+		// Field (5) 'Attestations'
+		if size := len(b.Attestations); size > 128 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.Attestations", size, 128)
+			return
+		}
+		{
+			offset = 4 * len(b.Attestations)
+			for ii := 0; ii < len(b.Attestations); ii++ {
+				dst = ssz.WriteOffset(dst, offset)
+				offset += b.Attestations[ii].SizeSSZ()
+
+			}
+		}
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			if dst, err = b.Attestations[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+		// Field (6) 'Deposits'
+		if size := len(b.Deposits); size > 16 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.Deposits", size, 16)
+			return
+		}
+		for ii := 0; ii < len(b.Deposits); ii++ {
+			if dst, err = b.Deposits[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+		// Field (7) 'VoluntaryExits'
+		if size := len(b.VoluntaryExits); size > 16 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.VoluntaryExits", size, 16)
+			return
+		}
+		for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+			if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+		// Field (9) 'ExecutionPayload'
+		if dst, err = b.ExecutionPayload.MarshalSSZToFork(dst, fork); err != nil {
+			return
+		}
+
+		// Field (10) 'BlsToExecutionChanges'
+		if size := len(b.BlsToExecutionChanges); size > 16 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.BlsToExecutionChanges", size, 16)
+			return
+		}
+		for ii := 0; ii < len(b.BlsToExecutionChanges); ii++ {
+			if dst, err = b.BlsToExecutionChanges[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+	case fork >= ssz.ForkBellatrix:
+		offset := int(384)
+		// Field (0) 'RandaoReveal'
+		if size := len(b.RandaoReveal); size != 96 {
+			err = ssz.ErrBytesLengthFn("BeaconBlockBody.RandaoReveal", size, 96)
+			return
+		}
+		dst = append(dst, b.RandaoReveal...)
+
+		// Field (1) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Field (2) 'Graffiti'
+		dst = append(dst, b.Graffiti[:]...)
+
+		// Offset (3) 'ProposerSlashings'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.ProposerSlashings) * 416
+
+		// Offset (4) 'AttesterSlashings'
+		dst = ssz.WriteOffset(dst, offset)
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			offset += 4
+			offset += b.AttesterSlashings[ii].SizeSSZ()
+		}
+
+		// Offset (5) 'Attestations'
+		dst = ssz.WriteOffset(dst, offset)
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			offset += 4
+			offset += b.Attestations[ii].SizeSSZ()
+		}
+
+		// Offset (6) 'Deposits'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.Deposits) * 1240
+
+		// Offset (7) 'VoluntaryExits'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.VoluntaryExits) * 112
+
+		// Field (8) 'SyncAggregate'
+		if b.SyncAggregate == nil {
+			b.SyncAggregate = new(SyncAggregate)
+		}
+		if dst, err = b.SyncAggregate.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Offset (9) 'ExecutionPayload'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (3) 'ProposerSlashings'
+		if size := len(b.ProposerSlashings); size > 16 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.ProposerSlashings", size, 16)
+			return
+		}
+		for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+			if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+		// Field (4) 'AttesterSlashings'
+		if size := len(b.AttesterSlashings); size > 2 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.AttesterSlashings", size, 2)
+			return
+		}
+		{
+			offset = 4 * len(b.AttesterSlashings)
+			for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+				dst = ssz.WriteOffset(dst, offset)
+				offset += b.AttesterSlashings[ii].SizeSSZ()
+
+			}
+		}
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			if dst, err = b.AttesterSlashings[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+		// Field (5) 'Attestations'
+		if size := len(b.Attestations); size > 128 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.Attestations", size, 128)
+			return
+		}
+		{
+			offset = 4 * len(b.Attestations)
+			for ii := 0; ii < len(b.Attestations); ii++ {
+				dst = ssz.WriteOffset(dst, offset)
+				offset += b.Attestations[ii].SizeSSZ()
+
+			}
+		}
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			if dst, err = b.Attestations[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+		// Field (6) 'Deposits'
+		if size := len(b.Deposits); size > 16 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.Deposits", size, 16)
+			return
+		}
+		for ii := 0; ii < len(b.Deposits); ii++ {
+			if dst, err = b.Deposits[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+		// Field (7) 'VoluntaryExits'
+		if size := len(b.VoluntaryExits); size > 16 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.VoluntaryExits", size, 16)
+			return
+		}
+		for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+			if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+		// Field (9) 'ExecutionPayload'
+		if dst, err = b.ExecutionPayload.MarshalSSZToFork(dst, fork); err != nil {
+			return
+		}
+
+	case fork >= ssz.ForkAltair:
+		offset := int(380)
+		// Field (0) 'RandaoReveal'
+		if size := len(b.RandaoReveal); size != 96 {
+			err = ssz.ErrBytesLengthFn("BeaconBlockBody.RandaoReveal", size, 96)
+			return
+		}
+		dst = append(dst, b.RandaoReveal...)
+
+		// Field (1) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Field (2) 'Graffiti'
+		dst = append(dst, b.Graffiti[:]...)
+
+		// Offset (3) 'ProposerSlashings'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.ProposerSlashings) * 416
+
+		// Offset (4) 'AttesterSlashings'
+		dst = ssz.WriteOffset(dst, offset)
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			offset += 4
+			offset += b.AttesterSlashings[ii].SizeSSZ()
+		}
+
+		// Offset (5) 'Attestations'
+		dst = ssz.WriteOffset(dst, offset)
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			offset += 4
+			offset += b.Attestations[ii].SizeSSZ()
+		}
+
+		// Offset (6) 'Deposits'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.Deposits) * 1240
+
+		// Offset (7) 'VoluntaryExits'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (8) 'SyncAggregate'
+		if b.SyncAggregate == nil {
+			b.SyncAggregate = new(SyncAggregate)
+		}
+		if dst, err = b.SyncAggregate.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Field (3) 'ProposerSlashings'
+		if size := len(b.ProposerSlashings); size > 16 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.ProposerSlashings", size, 16)
+			return
+		}
+		for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+			if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+		// Field (4) 'AttesterSlashings'
+		if size := len(b.AttesterSlashings); size > 2 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.AttesterSlashings", size, 2)
+			return
+		}
+		{
+			offset = 4 * len(b.AttesterSlashings)
+			for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+				dst = ssz.WriteOffset(dst, offset)
+				offset += b.AttesterSlashings[ii].SizeSSZ()
+
+			}
+		}
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			if dst, err = b.AttesterSlashings[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+		// Field (5) 'Attestations'
+		if size := len(b.Attestations); size > 128 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.Attestations", size, 128)
+			return
+		}
+		{
+			offset = 4 * len(b.Attestations)
+			for ii := 0; ii < len(b.Attestations); ii++ {
+				dst = ssz.WriteOffset(dst, offset)
+				offset += b.Attestations[ii].SizeSSZ()
+
+			}
+		}
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			if dst, err = b.Attestations[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+		// Field (6) 'Deposits'
+		if size := len(b.Deposits); size > 16 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.Deposits", size, 16)
+			return
+		}
+		for ii := 0; ii < len(b.Deposits); ii++ {
+			if dst, err = b.Deposits[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+		// Field (7) 'VoluntaryExits'
+		if size := len(b.VoluntaryExits); size > 16 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.VoluntaryExits", size, 16)
+			return
+		}
+		for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+			if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+	default:
+		offset := int(220)
+		// Field (0) 'RandaoReveal'
+		if size := len(b.RandaoReveal); size != 96 {
+			err = ssz.ErrBytesLengthFn("BeaconBlockBody.RandaoReveal", size, 96)
+			return
+		}
+		dst = append(dst, b.RandaoReveal...)
+
+		// Field (1) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+		// Field (2) 'Graffiti'
+		dst = append(dst, b.Graffiti[:]...)
+
+		// Offset (3) 'ProposerSlashings'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.ProposerSlashings) * 416
+
+		// Offset (4) 'AttesterSlashings'
+		dst = ssz.WriteOffset(dst, offset)
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			offset += 4
+			offset += b.AttesterSlashings[ii].SizeSSZ()
+		}
+
+		// Offset (5) 'Attestations'
+		dst = ssz.WriteOffset(dst, offset)
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			offset += 4
+			offset += b.Attestations[ii].SizeSSZ()
+		}
+
+		// Offset (6) 'Deposits'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.Deposits) * 1240
+
+		// Offset (7) 'VoluntaryExits'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (3) 'ProposerSlashings'
+		if size := len(b.ProposerSlashings); size > 16 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.ProposerSlashings", size, 16)
+			return
+		}
+		for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+			if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+		// Field (4) 'AttesterSlashings'
+		if size := len(b.AttesterSlashings); size > 2 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.AttesterSlashings", size, 2)
+			return
+		}
+		{
+			offset = 4 * len(b.AttesterSlashings)
+			for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+				dst = ssz.WriteOffset(dst, offset)
+				offset += b.AttesterSlashings[ii].SizeSSZ()
+
+			}
+		}
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			if dst, err = b.AttesterSlashings[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+		// Field (5) 'Attestations'
+		if size := len(b.Attestations); size > 128 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.Attestations", size, 128)
+			return
+		}
+		{
+			offset = 4 * len(b.Attestations)
+			for ii := 0; ii < len(b.Attestations); ii++ {
+				dst = ssz.WriteOffset(dst, offset)
+				offset += b.Attestations[ii].SizeSSZ()
+
+			}
+		}
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			if dst, err = b.Attestations[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+		// Field (6) 'Deposits'
+		if size := len(b.Deposits); size > 16 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.Deposits", size, 16)
+			return
+		}
+		for ii := 0; ii < len(b.Deposits); ii++ {
+			if dst, err = b.Deposits[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+		// Field (7) 'VoluntaryExits'
+		if size := len(b.VoluntaryExits); size > 16 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.VoluntaryExits", size, 16)
+			return
+		}
+		for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+			if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
+
+		}
+
+	}
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the BeaconBlockBody object to a writer
+func (b *BeaconBlockBody) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(b, writer)
+}
+
+// MarshalSSZToStream ssz marshals the BeaconBlockBody object to a target array that is flushed to a stream writer
+func (b *BeaconBlockBody) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	return b.MarshalSSZToStreamFork(buf, sw, ssz.ForkDeneb)
+}
+
+// MarshalSSZToWriterFork ssz marshals the BeaconBlockBody object to a writer for a given fork
+func (b *BeaconBlockBody) MarshalSSZToWriterFork(writer io.Writer, fork ssz.Fork) (int, error) {
+	return ssz.MarshalSSZToWriterFork(b, writer, fork)
+}
+
+// MarshalSSZToStreamFork ssz marshals the BeaconBlockBody object to a target array that is flushed to a stream writer for a given fork
+func (b *BeaconBlockBody) MarshalSSZToStreamFork(buf []byte, sw *ssz.StreamWriter, fork ssz.Fork) (dst []byte, err error) {
+	dst = buf
+	switch {
+	case fork >= ssz.ForkDeneb:
+		offset := int(392)
+		// Field (0) 'RandaoReveal'
+		if size := len(b.RandaoReveal); size != 96 {
+			err = ssz.ErrBytesLengthFn("BeaconBlockBody.RandaoReveal", size, 96)
+			return
+		}
+		dst = append(dst, b.RandaoReveal...)
+
+		// Field (1) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if dst, err = b.Eth1Data.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Field (2) 'Graffiti'
+		dst = append(dst, b.Graffiti[:]...)
+
+		// Offset (3) 'ProposerSlashings'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.ProposerSlashings) * 416
+
+		// Offset (4) 'AttesterSlashings'
+		dst = ssz.WriteOffset(dst, offset)
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			offset += 4
+			offset += b.AttesterSlashings[ii].SizeSSZ()
+		}
+
+		// Offset (5) 'Attestations'
+		dst = ssz.WriteOffset(dst, offset)
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			offset += 4
+			offset += b.Attestations[ii].SizeSSZ()
+		}
+
+		// Offset (6) 'Deposits'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.Deposits) * 1240
+
+		// Offset (7) 'VoluntaryExits'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.VoluntaryExits) * 112
+
+		// Field (8) 'SyncAggregate'
+		if b.SyncAggregate == nil {
+			b.SyncAggregate = new(SyncAggregate)
+		}
+		if dst, err = b.SyncAggregate.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Offset (9) 'ExecutionPayload'
+		dst = ssz.WriteOffset(dst, offset)
+		if b.ExecutionPayload == nil {
+			b.ExecutionPayload = new(ExecutionPayload)
+		}
+		offset += b.ExecutionPayload.SizeSSZFork(fork)
+
+		// Offset (10) 'BlsToExecutionChanges'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.BlsToExecutionChanges) * 172
+
+		// Offset (11) 'BlobKzgCommitments'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (3) 'ProposerSlashings'
+		if size := len(b.ProposerSlashings); size > 16 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.ProposerSlashings", size, 16)
+			return
+		}
+		for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+			if dst, err = b.ProposerSlashings[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (4) 'AttesterSlashings'
+		if size := len(b.AttesterSlashings); size > 2 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.AttesterSlashings", size, 2)
+			return
+		}
+		{
+			offset = 4 * len(b.AttesterSlashings)
+			for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+				dst = ssz.WriteOffset(dst, offset)
+				offset += b.AttesterSlashings[ii].SizeSSZ()
+				if dst, err = sw.Flush(dst); err != nil {
+					return
+				}
+			}
+		}
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			if dst, err = b.AttesterSlashings[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (5) 'Attestations'
+		if size := len(b.Attestations); size > 128 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.Attestations", size, 128)
+			return
+		}
+		{
+			offset = 4 * len(b.Attestations)
+			for ii := 0; ii < len(b.Attestations); ii++ {
+				dst = ssz.WriteOffset(dst, offset)
+				offset += b.Attestations[ii].SizeSSZ()
+				if dst, err = sw.Flush(dst); err != nil {
+					return
+				}
+			}
+		}
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			if dst, err = b.Attestations[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (6) 'Deposits'
+		if size := len(b.Deposits); size > 16 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.Deposits", size, 16)
+			return
+		}
+		for ii := 0; ii < len(b.Deposits); ii++ {
+			if dst, err = b.Deposits[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (7) 'VoluntaryExits'
+		if size := len(b.VoluntaryExits); size > 16 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.VoluntaryExits", size, 16)
+			return
+		}
+		for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+			if dst, err = b.VoluntaryExits[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (9) 'ExecutionPayload'
+		if dst, err = b.ExecutionPayload.MarshalSSZToStreamFork(dst, sw, fork); err != nil {
+			return
+		}
+
+		// Field (10) 'BlsToExecutionChanges'
+		if size := len(b.BlsToExecutionChanges); size > 16 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.BlsToExecutionChanges", size, 16)
+			return
+		}
+		for ii := 0; ii < len(b.BlsToExecutionChanges); ii++ {
+			if dst, err = b.BlsToExecutionChanges[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (11) 'BlobKzgCommitments'
+		if size := len(b.BlobKzgCommitments); size > 4096 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.BlobKzgCommitments", size, 4096)
+			return
+		}
+		for ii := 0; ii < len(b.BlobKzgCommitments); ii++ {
+			dst = append(dst, b.BlobKzgCommitments[ii][:]...)
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+	case fork >= ssz.ForkCapella:
+		offset := int(388)
+		// Field (0) 'RandaoReveal'
+		if size := len(b.RandaoReveal); size != 96 {
+			err = ssz.ErrBytesLengthFn("BeaconBlockBody.RandaoReveal", size, 96)
+			return
+		}
+		dst = append(dst, b.RandaoReveal...)
+
+		// Field (1) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if dst, err = b.Eth1Data.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Field (2) 'Graffiti'
+		dst = append(dst, b.Graffiti[:]...)
+
+		// Offset (3) 'ProposerSlashings'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.ProposerSlashings) * 416
+
+		// Offset (4) 'AttesterSlashings'
+		dst = ssz.WriteOffset(dst, offset)
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			offset += 4
+			offset += b.AttesterSlashings[ii].SizeSSZ()
+		}
+
+		// Offset (5) 'Attestations'
+		dst = ssz.WriteOffset(dst, offset)
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			offset += 4
+			offset += b.Attestations[ii].SizeSSZ()
+		}
+
+		// Offset (6) 'Deposits'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.Deposits) * 1240
+
+		// Offset (7) 'VoluntaryExits'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.VoluntaryExits) * 112
+
+		// Field (8) 'SyncAggregate'
+		if b.SyncAggregate == nil {
+			b.SyncAggregate = new(SyncAggregate)
+		}
+		if dst, err = b.SyncAggregate.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Offset (9) 'ExecutionPayload'
+		dst = ssz.WriteOffset(dst, offset)
+		if b.ExecutionPayload == nil {
+			b.ExecutionPayload = new(ExecutionPayload)
+		}
+		offset += b.ExecutionPayload.SizeSSZFork(fork)
+
+		// Offset (10) 'BlsToExecutionChanges'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (3) 'ProposerSlashings'
+		if size := len(b.ProposerSlashings); size > 16 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.ProposerSlashings", size, 16)
+			return
+		}
+		for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+			if dst, err = b.ProposerSlashings[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (4) 'AttesterSlashings'
+		if size := len(b.AttesterSlashings); size > 2 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.AttesterSlashings", size, 2)
+			return
+		}
+		{
+			offset = 4 * len(b.AttesterSlashings)
+			for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+				dst = ssz.WriteOffset(dst, offset)
+				offset += b.AttesterSlashings[ii].SizeSSZ()
+				if dst, err = sw.Flush(dst); err != nil {
+					return
+				}
+			}
+		}
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			if dst, err = b.AttesterSlashings[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (5) 'Attestations'
+		if size := len(b.Attestations); size > 128 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.Attestations", size, 128)
+			return
+		}
+		{
+			offset = 4 * len(b.Attestations)
+			for ii := 0; ii < len(b.Attestations); ii++ {
+				dst = ssz.WriteOffset(dst, offset)
+				offset += b.Attestations[ii].SizeSSZ()
+				if dst, err = sw.Flush(dst); err != nil {
+					return
+				}
+			}
+		}
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			if dst, err = b.Attestations[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (6) 'Deposits'
+		if size := len(b.Deposits); size > 16 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.Deposits", size, 16)
+			return
+		}
+		for ii := 0; ii < len(b.Deposits); ii++ {
+			if dst, err = b.Deposits[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (7) 'VoluntaryExits'
+		if size := len(b.VoluntaryExits); size > 16 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.VoluntaryExits", size, 16)
+			return
+		}
+		for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+			if dst, err = b.VoluntaryExits[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (9) 'ExecutionPayload'
+		if dst, err = b.ExecutionPayload.MarshalSSZToStreamFork(dst, sw, fork); err != nil {
+			return
+		}
+
+		// Field (10) 'BlsToExecutionChanges'
+		if size := len(b.BlsToExecutionChanges); size > 16 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.BlsToExecutionChanges", size, 16)
+			return
+		}
+		for ii := 0; ii < len(b.BlsToExecutionChanges); ii++ {
+			if dst, err = b.BlsToExecutionChanges[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+	case fork >= ssz.ForkBellatrix:
+		offset := int(384)
+		// Field (0) 'RandaoReveal'
+		if size := len(b.RandaoReveal); size != 96 {
+			err = ssz.ErrBytesLengthFn("BeaconBlockBody.RandaoReveal", size, 96)
+			return
+		}
+		dst = append(dst, b.RandaoReveal...)
+
+		// Field (1) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if dst, err = b.Eth1Data.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Field (2) 'Graffiti'
+		dst = append(dst, b.Graffiti[:]...)
+
+		// Offset (3) 'ProposerSlashings'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.ProposerSlashings) * 416
+
+		// Offset (4) 'AttesterSlashings'
+		dst = ssz.WriteOffset(dst, offset)
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			offset += 4
+			offset += b.AttesterSlashings[ii].SizeSSZ()
+		}
+
+		// Offset (5) 'Attestations'
+		dst = ssz.WriteOffset(dst, offset)
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			offset += 4
+			offset += b.Attestations[ii].SizeSSZ()
+		}
+
+		// Offset (6) 'Deposits'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.Deposits) * 1240
+
+		// Offset (7) 'VoluntaryExits'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.VoluntaryExits) * 112
+
+		// Field (8) 'SyncAggregate'
+		if b.SyncAggregate == nil {
+			b.SyncAggregate = new(SyncAggregate)
+		}
+		if dst, err = b.SyncAggregate.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Offset (9) 'ExecutionPayload'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (3) 'ProposerSlashings'
+		if size := len(b.ProposerSlashings); size > 16 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.ProposerSlashings", size, 16)
+			return
+		}
+		for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+			if dst, err = b.ProposerSlashings[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (4) 'AttesterSlashings'
+		if size := len(b.AttesterSlashings); size > 2 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.AttesterSlashings", size, 2)
+			return
+		}
+		{
+			offset = 4 * len(b.AttesterSlashings)
+			for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+				dst = ssz.WriteOffset(dst, offset)
+				offset += b.AttesterSlashings[ii].SizeSSZ()
+				if dst, err = sw.Flush(dst); err != nil {
+					return
+				}
+			}
+		}
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			if dst, err = b.AttesterSlashings[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (5) 'Attestations'
+		if size := len(b.Attestations); size > 128 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.Attestations", size, 128)
+			return
+		}
+		{
+			offset = 4 * len(b.Attestations)
+			for ii := 0; ii < len(b.Attestations); ii++ {
+				dst = ssz.WriteOffset(dst, offset)
+				offset += b.Attestations[ii].SizeSSZ()
+				if dst, err = sw.Flush(dst); err != nil {
+					return
+				}
+			}
+		}
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			if dst, err = b.Attestations[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (6) 'Deposits'
+		if size := len(b.Deposits); size > 16 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.Deposits", size, 16)
+			return
+		}
+		for ii := 0; ii < len(b.Deposits); ii++ {
+			if dst, err = b.Deposits[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (7) 'VoluntaryExits'
+		if size := len(b.VoluntaryExits); size > 16 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.VoluntaryExits", size, 16)
+			return
+		}
+		for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+			if dst, err = b.VoluntaryExits[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (9) 'ExecutionPayload'
+		if dst, err = b.ExecutionPayload.MarshalSSZToStreamFork(dst, sw, fork); err != nil {
+			return
+		}
+
+	case fork >= ssz.ForkAltair:
+		offset := int(380)
+		// Field (0) 'RandaoReveal'
+		if size := len(b.RandaoReveal); size != 96 {
+			err = ssz.ErrBytesLengthFn("BeaconBlockBody.RandaoReveal", size, 96)
+			return
+		}
+		dst = append(dst, b.RandaoReveal...)
+
+		// Field (1) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if dst, err = b.Eth1Data.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Field (2) 'Graffiti'
+		dst = append(dst, b.Graffiti[:]...)
+
+		// Offset (3) 'ProposerSlashings'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.ProposerSlashings) * 416
+
+		// Offset (4) 'AttesterSlashings'
+		dst = ssz.WriteOffset(dst, offset)
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			offset += 4
+			offset += b.AttesterSlashings[ii].SizeSSZ()
+		}
+
+		// Offset (5) 'Attestations'
+		dst = ssz.WriteOffset(dst, offset)
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			offset += 4
+			offset += b.Attestations[ii].SizeSSZ()
+		}
+
+		// Offset (6) 'Deposits'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.Deposits) * 1240
+
+		// Offset (7) 'VoluntaryExits'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (8) 'SyncAggregate'
+		if b.SyncAggregate == nil {
+			b.SyncAggregate = new(SyncAggregate)
+		}
+		if dst, err = b.SyncAggregate.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Field (3) 'ProposerSlashings'
+		if size := len(b.ProposerSlashings); size > 16 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.ProposerSlashings", size, 16)
+			return
+		}
+		for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+			if dst, err = b.ProposerSlashings[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (4) 'AttesterSlashings'
+		if size := len(b.AttesterSlashings); size > 2 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.AttesterSlashings", size, 2)
+			return
+		}
+		{
+			offset = 4 * len(b.AttesterSlashings)
+			for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+				dst = ssz.WriteOffset(dst, offset)
+				offset += b.AttesterSlashings[ii].SizeSSZ()
+				if dst, err = sw.Flush(dst); err != nil {
+					return
+				}
+			}
+		}
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			if dst, err = b.AttesterSlashings[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (5) 'Attestations'
+		if size := len(b.Attestations); size > 128 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.Attestations", size, 128)
+			return
+		}
+		{
+			offset = 4 * len(b.Attestations)
+			for ii := 0; ii < len(b.Attestations); ii++ {
+				dst = ssz.WriteOffset(dst, offset)
+				offset += b.Attestations[ii].SizeSSZ()
+				if dst, err = sw.Flush(dst); err != nil {
+					return
+				}
+			}
+		}
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			if dst, err = b.Attestations[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (6) 'Deposits'
+		if size := len(b.Deposits); size > 16 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.Deposits", size, 16)
+			return
+		}
+		for ii := 0; ii < len(b.Deposits); ii++ {
+			if dst, err = b.Deposits[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (7) 'VoluntaryExits'
+		if size := len(b.VoluntaryExits); size > 16 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.VoluntaryExits", size, 16)
+			return
+		}
+		for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+			if dst, err = b.VoluntaryExits[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+	default:
+		offset := int(220)
+		// Field (0) 'RandaoReveal'
+		if size := len(b.RandaoReveal); size != 96 {
+			err = ssz.ErrBytesLengthFn("BeaconBlockBody.RandaoReveal", size, 96)
+			return
+		}
+		dst = append(dst, b.RandaoReveal...)
+
+		// Field (1) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if dst, err = b.Eth1Data.MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+
+		// Field (2) 'Graffiti'
+		dst = append(dst, b.Graffiti[:]...)
+
+		// Offset (3) 'ProposerSlashings'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.ProposerSlashings) * 416
+
+		// Offset (4) 'AttesterSlashings'
+		dst = ssz.WriteOffset(dst, offset)
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			offset += 4
+			offset += b.AttesterSlashings[ii].SizeSSZ()
+		}
+
+		// Offset (5) 'Attestations'
+		dst = ssz.WriteOffset(dst, offset)
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			offset += 4
+			offset += b.Attestations[ii].SizeSSZ()
+		}
+
+		// Offset (6) 'Deposits'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(b.Deposits) * 1240
+
+		// Offset (7) 'VoluntaryExits'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (3) 'ProposerSlashings'
+		if size := len(b.ProposerSlashings); size > 16 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.ProposerSlashings", size, 16)
+			return
+		}
+		for ii := 0; ii < len(b.ProposerSlashings); ii++ {
+			if dst, err = b.ProposerSlashings[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (4) 'AttesterSlashings'
+		if size := len(b.AttesterSlashings); size > 2 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.AttesterSlashings", size, 2)
+			return
+		}
+		{
+			offset = 4 * len(b.AttesterSlashings)
+			for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+				dst = ssz.WriteOffset(dst, offset)
+				offset += b.AttesterSlashings[ii].SizeSSZ()
+				if dst, err = sw.Flush(dst); err != nil {
+					return
+				}
+			}
+		}
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			if dst, err = b.AttesterSlashings[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (5) 'Attestations'
+		if size := len(b.Attestations); size > 128 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.Attestations", size, 128)
+			return
+		}
+		{
+			offset = 4 * len(b.Attestations)
+			for ii := 0; ii < len(b.Attestations); ii++ {
+				dst = ssz.WriteOffset(dst, offset)
+				offset += b.Attestations[ii].SizeSSZ()
+				if dst, err = sw.Flush(dst); err != nil {
+					return
+				}
+			}
+		}
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			if dst, err = b.Attestations[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (6) 'Deposits'
+		if size := len(b.Deposits); size > 16 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.Deposits", size, 16)
+			return
+		}
+		for ii := 0; ii < len(b.Deposits); ii++ {
+			if dst, err = b.Deposits[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+		// Field (7) 'VoluntaryExits'
+		if size := len(b.VoluntaryExits); size > 16 {
+			err = ssz.ErrListTooBigFn("BeaconBlockBody.VoluntaryExits", size, 16)
+			return
+		}
+		for ii := 0; ii < len(b.VoluntaryExits); ii++ {
+			if dst, err = b.VoluntaryExits[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+	}
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BeaconBlockBody object
+func (b *BeaconBlockBody) UnmarshalSSZ(buf []byte) error {
+	return b.UnmarshalSSZForkWithOptions(buf, ssz.ForkDeneb, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the BeaconBlockBody object with the decoding options
+func (b *BeaconBlockBody) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	return b.UnmarshalSSZForkWithOptions(buf, ssz.ForkDeneb, opts)
+}
+
+// UnmarshalSSZFork ssz unmarshals the BeaconBlockBody object for a given fork
+func (b *BeaconBlockBody) UnmarshalSSZFork(buf []byte, fork ssz.Fork) error {
+	return b.UnmarshalSSZForkWithOptions(buf, fork, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZForkWithOptions ssz unmarshals the BeaconBlockBody object for a given fork with the decoding options
+func (b *BeaconBlockBody) UnmarshalSSZForkWithOptions(buf []byte, fork ssz.Fork, opts ssz.UnmarshalOptions) error {
+	var err error
+	switch {
+	case fork >= ssz.ForkDeneb:
+		size := uint64(len(buf))
+		if size < 392 {
+			return ssz.ErrSize
+		}
+
+		tail := buf
+		var o3, o4, o5, o6, o7, o9, o10, o11 uint64
+
+		// Field (0) 'RandaoReveal'
+		if cap(b.RandaoReveal) == 0 {
+			b.RandaoReveal = make([]byte, 0, len(buf[0:96]))
+		}
+		b.RandaoReveal = append(b.RandaoReveal, buf[0:96]...)
+
+		// Field (1) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if err = b.Eth1Data.UnmarshalSSZWithOptions(buf[96:168], opts); err != nil {
+			return err
+		}
+
+		// Field (2) 'Graffiti'
+		copy(b.Graffiti[:], buf[168:200])
+
+		// Offset (3) 'ProposerSlashings'
+		if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
+			return ssz.ErrOffset
+		}
+
+		if err = opts.ValidateFirstOffset(o3, 392); err != nil {
+			return err
+		}
+
+		// Offset (4) 'AttesterSlashings'
+		if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (5) 'Attestations'
+		if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (6) 'Deposits'
+		if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (7) 'VoluntaryExits'
+		if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
+			return ssz.ErrOffset
+		}
+
+		// Field (8) 'SyncAggregate'
+		if b.SyncAggregate == nil {
+			b.SyncAggregate = new(SyncAggregate)
+		}
+		if err = b.SyncAggregate.UnmarshalSSZWithOptions(buf[220:380], opts); err != nil {
+			return err
+		}
+
+		// Offset (9) 'ExecutionPayload'
+		if o9 = ssz.ReadOffset(buf[380:384]); o9 > size || o7 > o9 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (10) 'BlsToExecutionChanges'
+		if o10 = ssz.ReadOffset(buf[384:388]); o10 > size || o9 > o10 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (11) 'BlobKzgCommitments'
+		if o11 = ssz.ReadOffset(buf[388:392]); o11 > size || o10 > o11 {
+			return ssz.ErrOffset
+		}
+
+		// Field (3) 'ProposerSlashings'
+		{
+			buf = tail[o3:o4]
+			num, err := ssz.DivideInt2(len(buf), 416, 16)
+			if err != nil {
+				return err
+			}
+			b.ProposerSlashings = make([]*ProposerSlashing, num)
+			for ii := 0; ii < num; ii++ {
+				if b.ProposerSlashings[ii] == nil {
+					b.ProposerSlashings[ii] = new(ProposerSlashing)
+				}
+				if err = b.ProposerSlashings[ii].UnmarshalSSZWithOptions(buf[ii*416:(ii+1)*416], opts); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Field (4) 'AttesterSlashings'
+		{
+			buf = tail[o4:o5]
+			num, err := opts.DecodeDynamicLength(buf, 2)
+			if err != nil {
+				return err
+			}
+			b.AttesterSlashings = make([]*AttesterSlashing, num)
+			err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+				if b.AttesterSlashings[indx] == nil {
+					b.AttesterSlashings[indx] = new(AttesterSlashing)
+				}
+				if err = b.AttesterSlashings[indx].UnmarshalSSZWithOptions(buf, opts); err != nil {
+					return err
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		// Field (5) 'Attestations'
+		{
+			buf = tail[o5:o6]
+			num, err := opts.DecodeDynamicLength(buf, 128)
+			if err != nil {
+				return err
+			}
+			b.Attestations = make([]*Attestation, num)
+			err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+				if b.Attestations[indx] == nil {
+					b.Attestations[indx] = new(Attestation)
+				}
+				if err = b.Attestations[indx].UnmarshalSSZWithOptions(buf, opts); err != nil {
+					return err
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		// Field (6) 'Deposits'
+		{
+			buf = tail[o6:o7]
+			num, err := ssz.DivideInt2(len(buf), 1240, 16)
+			if err != nil {
+				return err
+			}
+			b.Deposits = make([]*Deposit, num)
+			for ii := 0; ii < num; ii++ {
+				if b.Deposits[ii] == nil {
+					b.Deposits[ii] = new(Deposit)
+				}
+				if err = b.Deposits[ii].UnmarshalSSZWithOptions(buf[ii*1240:(ii+1)*1240], opts); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Field (7) 'VoluntaryExits'
+		{
+			buf = tail[o7:o9]
+			num, err := ssz.DivideInt2(len(buf), 112, 16)
+			if err != nil {
+				return err
+			}
+			b.VoluntaryExits = make([]*SignedVoluntaryExit, num)
+			for ii := 0; ii < num; ii++ {
+				if b.VoluntaryExits[ii] == nil {
+					b.VoluntaryExits[ii] = new(SignedVoluntaryExit)
+				}
+				if err = b.VoluntaryExits[ii].UnmarshalSSZWithOptions(buf[ii*112:(ii+1)*112], opts); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Field (9) 'ExecutionPayload'
+		{
+			buf = tail[o9:o10]
+			if b.ExecutionPayload == nil {
+				b.ExecutionPayload = new(ExecutionPayload)
+			}
+			if err = b.ExecutionPayload.UnmarshalSSZForkWithOptions(buf, fork, opts); err != nil {
+				return err
+			}
+		}
+
+		// Field (10) 'BlsToExecutionChanges'
+		{
+			buf = tail[o10:o11]
+			num, err := ssz.DivideInt2(len(buf), 172, 16)
+			if err != nil {
+				return err
+			}
+			b.BlsToExecutionChanges = make([]*SignedBLSToExecutionChange, num)
+			for ii := 0; ii < num; ii++ {
+				if b.BlsToExecutionChanges[ii] == nil {
+					b.BlsToExecutionChanges[ii] = new(SignedBLSToExecutionChange)
+				}
+				if err = b.BlsToExecutionChanges[ii].UnmarshalSSZWithOptions(buf[ii*172:(ii+1)*172], opts); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Field (11) 'BlobKzgCommitments'
+		{
+			buf = tail[o11:]
+			num, err := ssz.DivideInt2(len(buf), 48, 4096)
+			if err != nil {
+				return err
+			}
+			b.BlobKzgCommitments = make([][48]byte, num)
+			for ii := 0; ii < num; ii++ {
+				copy(b.BlobKzgCommitments[ii][:], buf[ii*48:(ii+1)*48])
+			}
+		}
+	case fork >= ssz.ForkCapella:
+		size := uint64(len(buf))
+		if size < 388 {
+			return ssz.ErrSize
+		}
+
+		tail := buf
+		var o3, o4, o5, o6, o7, o9, o10 uint64
+
+		// Field (0) 'RandaoReveal'
+		if cap(b.RandaoReveal) == 0 {
+			b.RandaoReveal = make([]byte, 0, len(buf[0:96]))
+		}
+		b.RandaoReveal = append(b.RandaoReveal, buf[0:96]...)
+
+		// Field (1) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if err = b.Eth1Data.UnmarshalSSZWithOptions(buf[96:168], opts); err != nil {
+			return err
+		}
+
+		// Field (2) 'Graffiti'
+		copy(b.Graffiti[:], buf[168:200])
+
+		// Offset (3) 'ProposerSlashings'
+		if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
+			return ssz.ErrOffset
+		}
+
+		if err = opts.ValidateFirstOffset(o3, 388); err != nil {
+			return err
+		}
+
+		// Offset (4) 'AttesterSlashings'
+		if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (5) 'Attestations'
+		if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (6) 'Deposits'
+		if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (7) 'VoluntaryExits'
+		if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
+			return ssz.ErrOffset
+		}
+
+		// Field (8) 'SyncAggregate'
+		if b.SyncAggregate == nil {
+			b.SyncAggregate = new(SyncAggregate)
+		}
+		if err = b.SyncAggregate.UnmarshalSSZWithOptions(buf[220:380], opts); err != nil {
+			return err
+		}
+
+		// Offset (9) 'ExecutionPayload'
+		if o9 = ssz.ReadOffset(buf[380:384]); o9 > size || o7 > o9 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (10) 'BlsToExecutionChanges'
+		if o10 = ssz.ReadOffset(buf[384:388]); o10 > size || o9 > o10 {
+			return ssz.ErrOffset
+		}
+
+		// Field (3) 'ProposerSlashings'
+		{
+			buf = tail[o3:o4]
+			num, err := ssz.DivideInt2(len(buf), 416, 16)
+			if err != nil {
+				return err
+			}
+			b.ProposerSlashings = make([]*ProposerSlashing, num)
+			for ii := 0; ii < num; ii++ {
+				if b.ProposerSlashings[ii] == nil {
+					b.ProposerSlashings[ii] = new(ProposerSlashing)
+				}
+				if err = b.ProposerSlashings[ii].UnmarshalSSZWithOptions(buf[ii*416:(ii+1)*416], opts); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Field (4) 'AttesterSlashings'
+		{
+			buf = tail[o4:o5]
+			num, err := opts.DecodeDynamicLength(buf, 2)
+			if err != nil {
+				return err
+			}
+			b.AttesterSlashings = make([]*AttesterSlashing, num)
+			err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+				if b.AttesterSlashings[indx] == nil {
+					b.AttesterSlashings[indx] = new(AttesterSlashing)
+				}
+				if err = b.AttesterSlashings[indx].UnmarshalSSZWithOptions(buf, opts); err != nil {
+					return err
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		// Field (5) 'Attestations'
+		{
+			buf = tail[o5:o6]
+			num, err := opts.DecodeDynamicLength(buf, 128)
+			if err != nil {
+				return err
+			}
+			b.Attestations = make([]*Attestation, num)
+			err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+				if b.Attestations[indx] == nil {
+					b.Attestations[indx] = new(Attestation)
+				}
+				if err = b.Attestations[indx].UnmarshalSSZWithOptions(buf, opts); err != nil {
+					return err
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		// Field (6) 'Deposits'
+		{
+			buf = tail[o6:o7]
+			num, err := ssz.DivideInt2(len(buf), 1240, 16)
+			if err != nil {
+				return err
+			}
+			b.Deposits = make([]*Deposit, num)
+			for ii := 0; ii < num; ii++ {
+				if b.Deposits[ii] == nil {
+					b.Deposits[ii] = new(Deposit)
+				}
+				if err = b.Deposits[ii].UnmarshalSSZWithOptions(buf[ii*1240:(ii+1)*1240], opts); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Field (7) 'VoluntaryExits'
+		{
+			buf = tail[o7:o9]
+			num, err := ssz.DivideInt2(len(buf), 112, 16)
+			if err != nil {
+				return err
+			}
+			b.VoluntaryExits = make([]*SignedVoluntaryExit, num)
+			for ii := 0; ii < num; ii++ {
+				if b.VoluntaryExits[ii] == nil {
+					b.VoluntaryExits[ii] = new(SignedVoluntaryExit)
+				}
+				if err = b.VoluntaryExits[ii].UnmarshalSSZWithOptions(buf[ii*112:(ii+1)*112], opts); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Field (9) 'ExecutionPayload'
+		{
+			buf = tail[o9:o10]
+			if b.ExecutionPayload == nil {
+				b.ExecutionPayload = new(ExecutionPayload)
+			}
+			if err = b.ExecutionPayload.UnmarshalSSZForkWithOptions(buf, fork, opts); err != nil {
+				return err
+			}
+		}
+
+		// Field (10) 'BlsToExecutionChanges'
+		{
+			buf = tail[o10:]
+			num, err := ssz.DivideInt2(len(buf), 172, 16)
+			if err != nil {
+				return err
+			}
+			b.BlsToExecutionChanges = make([]*SignedBLSToExecutionChange, num)
+			for ii := 0; ii < num; ii++ {
+				if b.BlsToExecutionChanges[ii] == nil {
+					b.BlsToExecutionChanges[ii] = new(SignedBLSToExecutionChange)
+				}
+				if err = b.BlsToExecutionChanges[ii].UnmarshalSSZWithOptions(buf[ii*172:(ii+1)*172], opts); err != nil {
+					return err
+				}
+			}
+		}
+	case fork >= ssz.ForkBellatrix:
+		size := uint64(len(buf))
+		if size < 384 {
+			return ssz.ErrSize
+		}
+
+		tail := buf
+		var o3, o4, o5, o6, o7, o9 uint64
+
+		// Field (0) 'RandaoReveal'
+		if cap(b.RandaoReveal) == 0 {
+			b.RandaoReveal = make([]byte, 0, len(buf[0:96]))
+		}
+		b.RandaoReveal = append(b.RandaoReveal, buf[0:96]...)
+
+		// Field (1) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if err = b.Eth1Data.UnmarshalSSZWithOptions(buf[96:168], opts); err != nil {
+			return err
+		}
+
+		// Field (2) 'Graffiti'
+		copy(b.Graffiti[:], buf[168:200])
+
+		// Offset (3) 'ProposerSlashings'
+		if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
+			return ssz.ErrOffset
+		}
+
+		if err = opts.ValidateFirstOffset(o3, 384); err != nil {
+			return err
+		}
+
+		// Offset (4) 'AttesterSlashings'
+		if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (5) 'Attestations'
+		if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (6) 'Deposits'
+		if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (7) 'VoluntaryExits'
+		if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
+			return ssz.ErrOffset
+		}
+
+		// Field (8) 'SyncAggregate'
+		if b.SyncAggregate == nil {
+			b.SyncAggregate = new(SyncAggregate)
+		}
+		if err = b.SyncAggregate.UnmarshalSSZWithOptions(buf[220:380], opts); err != nil {
+			return err
+		}
+
+		// Offset (9) 'ExecutionPayload'
+		if o9 = ssz.ReadOffset(buf[380:384]); o9 > size || o7 > o9 {
+			return ssz.ErrOffset
+		}
+
+		// Field (3) 'ProposerSlashings'
+		{
+			buf = tail[o3:o4]
+			num, err := ssz.DivideInt2(len(buf), 416, 16)
+			if err != nil {
+				return err
+			}
+			b.ProposerSlashings = make([]*ProposerSlashing, num)
+			for ii := 0; ii < num; ii++ {
+				if b.ProposerSlashings[ii] == nil {
+					b.ProposerSlashings[ii] = new(ProposerSlashing)
+				}
+				if err = b.ProposerSlashings[ii].UnmarshalSSZWithOptions(buf[ii*416:(ii+1)*416], opts); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Field (4) 'AttesterSlashings'
+		{
+			buf = tail[o4:o5]
+			num, err := opts.DecodeDynamicLength(buf, 2)
+			if err != nil {
+				return err
+			}
+			b.AttesterSlashings = make([]*AttesterSlashing, num)
+			err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+				if b.AttesterSlashings[indx] == nil {
+					b.AttesterSlashings[indx] = new(AttesterSlashing)
+				}
+				if err = b.AttesterSlashings[indx].UnmarshalSSZWithOptions(buf, opts); err != nil {
+					return err
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		// Field (5) 'Attestations'
+		{
+			buf = tail[o5:o6]
+			num, err := opts.DecodeDynamicLength(buf, 128)
+			if err != nil {
+				return err
+			}
+			b.Attestations = make([]*Attestation, num)
+			err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+				if b.Attestations[indx] == nil {
+					b.Attestations[indx] = new(Attestation)
+				}
+				if err = b.Attestations[indx].UnmarshalSSZWithOptions(buf, opts); err != nil {
+					return err
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		// Field (6) 'Deposits'
+		{
+			buf = tail[o6:o7]
+			num, err := ssz.DivideInt2(len(buf), 1240, 16)
+			if err != nil {
+				return err
+			}
+			b.Deposits = make([]*Deposit, num)
+			for ii := 0; ii < num; ii++ {
+				if b.Deposits[ii] == nil {
+					b.Deposits[ii] = new(Deposit)
+				}
+				if err = b.Deposits[ii].UnmarshalSSZWithOptions(buf[ii*1240:(ii+1)*1240], opts); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Field (7) 'VoluntaryExits'
+		{
+			buf = tail[o7:o9]
+			num, err := ssz.DivideInt2(len(buf), 112, 16)
+			if err != nil {
+				return err
+			}
+			b.VoluntaryExits = make([]*SignedVoluntaryExit, num)
+			for ii := 0; ii < num; ii++ {
+				if b.VoluntaryExits[ii] == nil {
+					b.VoluntaryExits[ii] = new(SignedVoluntaryExit)
+				}
+				if err = b.VoluntaryExits[ii].UnmarshalSSZWithOptions(buf[ii*112:(ii+1)*112], opts); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Field (9) 'ExecutionPayload'
+		{
+			buf = tail[o9:]
+			if b.ExecutionPayload == nil {
+				b.ExecutionPayload = new(ExecutionPayload)
+			}
+			if err = b.ExecutionPayload.UnmarshalSSZForkWithOptions(buf, fork, opts); err != nil {
+				return err
+			}
+		}
+	case fork >= ssz.ForkAltair:
+		size := uint64(len(buf))
+		if size < 380 {
+			return ssz.ErrSize
+		}
+
+		tail := buf
+		var o3, o4, o5, o6, o7 uint64
+
+		// Field (0) 'RandaoReveal'
+		if cap(b.RandaoReveal) == 0 {
+			b.RandaoReveal = make([]byte, 0, len(buf[0:96]))
+		}
+		b.RandaoReveal = append(b.RandaoReveal, buf[0:96]...)
+
+		// Field (1) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if err = b.Eth1Data.UnmarshalSSZWithOptions(buf[96:168], opts); err != nil {
+			return err
+		}
+
+		// Field (2) 'Graffiti'
+		copy(b.Graffiti[:], buf[168:200])
+
+		// Offset (3) 'ProposerSlashings'
+		if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
+			return ssz.ErrOffset
+		}
+
+		if err = opts.ValidateFirstOffset(o3, 380); err != nil {
+			return err
+		}
+
+		// Offset (4) 'AttesterSlashings'
+		if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (5) 'Attestations'
+		if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (6) 'Deposits'
+		if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (7) 'VoluntaryExits'
+		if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
+			return ssz.ErrOffset
+		}
+
+		// Field (8) 'SyncAggregate'
+		if b.SyncAggregate == nil {
+			b.SyncAggregate = new(SyncAggregate)
+		}
+		if err = b.SyncAggregate.UnmarshalSSZWithOptions(buf[220:380], opts); err != nil {
+			return err
+		}
+
+		// Field (3) 'ProposerSlashings'
+		{
+			buf = tail[o3:o4]
+			num, err := ssz.DivideInt2(len(buf), 416, 16)
+			if err != nil {
+				return err
+			}
+			b.ProposerSlashings = make([]*ProposerSlashing, num)
+			for ii := 0; ii < num; ii++ {
+				if b.ProposerSlashings[ii] == nil {
+					b.ProposerSlashings[ii] = new(ProposerSlashing)
+				}
+				if err = b.ProposerSlashings[ii].UnmarshalSSZWithOptions(buf[ii*416:(ii+1)*416], opts); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Field (4) 'AttesterSlashings'
+		{
+			buf = tail[o4:o5]
+			num, err := opts.DecodeDynamicLength(buf, 2)
+			if err != nil {
+				return err
+			}
+			b.AttesterSlashings = make([]*AttesterSlashing, num)
+			err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+				if b.AttesterSlashings[indx] == nil {
+					b.AttesterSlashings[indx] = new(AttesterSlashing)
+				}
+				if err = b.AttesterSlashings[indx].UnmarshalSSZWithOptions(buf, opts); err != nil {
+					return err
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		// Field (5) 'Attestations'
+		{
+			buf = tail[o5:o6]
+			num, err := opts.DecodeDynamicLength(buf, 128)
+			if err != nil {
+				return err
+			}
+			b.Attestations = make([]*Attestation, num)
+			err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+				if b.Attestations[indx] == nil {
+					b.Attestations[indx] = new(Attestation)
+				}
+				if err = b.Attestations[indx].UnmarshalSSZWithOptions(buf, opts); err != nil {
+					return err
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		// Field (6) 'Deposits'
+		{
+			buf = tail[o6:o7]
+			num, err := ssz.DivideInt2(len(buf), 1240, 16)
+			if err != nil {
+				return err
+			}
+			b.Deposits = make([]*Deposit, num)
+			for ii := 0; ii < num; ii++ {
+				if b.Deposits[ii] == nil {
+					b.Deposits[ii] = new(Deposit)
+				}
+				if err = b.Deposits[ii].UnmarshalSSZWithOptions(buf[ii*1240:(ii+1)*1240], opts); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Field (7) 'VoluntaryExits'
+		{
+			buf = tail[o7:]
+			num, err := ssz.DivideInt2(len(buf), 112, 16)
+			if err != nil {
+				return err
+			}
+			b.VoluntaryExits = make([]*SignedVoluntaryExit, num)
+			for ii := 0; ii < num; ii++ {
+				if b.VoluntaryExits[ii] == nil {
+					b.VoluntaryExits[ii] = new(SignedVoluntaryExit)
+				}
+				if err = b.VoluntaryExits[ii].UnmarshalSSZWithOptions(buf[ii*112:(ii+1)*112], opts); err != nil {
+					return err
+				}
+			}
+		}
+	default:
+		size := uint64(len(buf))
+		if size < 220 {
+			return ssz.ErrSize
+		}
+
+		tail := buf
+		var o3, o4, o5, o6, o7 uint64
+
+		// Field (0) 'RandaoReveal'
+		if cap(b.RandaoReveal) == 0 {
+			b.RandaoReveal = make([]byte, 0, len(buf[0:96]))
+		}
+		b.RandaoReveal = append(b.RandaoReveal, buf[0:96]...)
+
+		// Field (1) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if err = b.Eth1Data.UnmarshalSSZWithOptions(buf[96:168], opts); err != nil {
+			return err
+		}
+
+		// Field (2) 'Graffiti'
+		copy(b.Graffiti[:], buf[168:200])
+
+		// Offset (3) 'ProposerSlashings'
+		if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
+			return ssz.ErrOffset
+		}
+
+		if err = opts.ValidateFirstOffset(o3, 220); err != nil {
+			return err
+		}
+
+		// Offset (4) 'AttesterSlashings'
+		if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (5) 'Attestations'
+		if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (6) 'Deposits'
+		if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (7) 'VoluntaryExits'
+		if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
+			return ssz.ErrOffset
+		}
+
+		// Field (3) 'ProposerSlashings'
+		{
+			buf = tail[o3:o4]
+			num, err := ssz.DivideInt2(len(buf), 416, 16)
+			if err != nil {
+				return err
+			}
+			b.ProposerSlashings = make([]*ProposerSlashing, num)
+			for ii := 0; ii < num; ii++ {
+				if b.ProposerSlashings[ii] == nil {
+					b.ProposerSlashings[ii] = new(ProposerSlashing)
+				}
+				if err = b.ProposerSlashings[ii].UnmarshalSSZWithOptions(buf[ii*416:(ii+1)*416], opts); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Field (4) 'AttesterSlashings'
+		{
+			buf = tail[o4:o5]
+			num, err := opts.DecodeDynamicLength(buf, 2)
+			if err != nil {
+				return err
+			}
+			b.AttesterSlashings = make([]*AttesterSlashing, num)
+			err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+				if b.AttesterSlashings[indx] == nil {
+					b.AttesterSlashings[indx] = new(AttesterSlashing)
+				}
+				if err = b.AttesterSlashings[indx].UnmarshalSSZWithOptions(buf, opts); err != nil {
+					return err
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		// Field (5) 'Attestations'
+		{
+			buf = tail[o5:o6]
+			num, err := opts.DecodeDynamicLength(buf, 128)
+			if err != nil {
+				return err
+			}
+			b.Attestations = make([]*Attestation, num)
+			err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+				if b.Attestations[indx] == nil {
+					b.Attestations[indx] = new(Attestation)
+				}
+				if err = b.Attestations[indx].UnmarshalSSZWithOptions(buf, opts); err != nil {
+					return err
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		// Field (6) 'Deposits'
+		{
+			buf = tail[o6:o7]
+			num, err := ssz.DivideInt2(len(buf), 1240, 16)
+			if err != nil {
+				return err
+			}
+			b.Deposits = make([]*Deposit, num)
+			for ii := 0; ii < num; ii++ {
+				if b.Deposits[ii] == nil {
+					b.Deposits[ii] = new(Deposit)
+				}
+				if err = b.Deposits[ii].UnmarshalSSZWithOptions(buf[ii*1240:(ii+1)*1240], opts); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Field (7) 'VoluntaryExits'
+		{
+			buf = tail[o7:]
+			num, err := ssz.DivideInt2(len(buf), 112, 16)
+			if err != nil {
+				return err
+			}
+			b.VoluntaryExits = make([]*SignedVoluntaryExit, num)
+			for ii := 0; ii < num; ii++ {
+				if b.VoluntaryExits[ii] == nil {
+					b.VoluntaryExits[ii] = new(SignedVoluntaryExit)
+				}
+				if err = b.VoluntaryExits[ii].UnmarshalSSZWithOptions(buf[ii*112:(ii+1)*112], opts); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBody object
+func (b *BeaconBlockBody) SizeSSZ() (size int) {
+	return b.SizeSSZFork(ssz.ForkDeneb)
+}
+
+// SizeSSZFork returns the ssz encoded size in bytes for the BeaconBlockBody object for a given fork
+func (b *BeaconBlockBody) SizeSSZFork(fork ssz.Fork) (size int) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		size = 392
+		// Field (3) 'ProposerSlashings'
+		size += len(b.ProposerSlashings) * 416
+
+		// Field (4) 'AttesterSlashings'
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			size += 4
+			size += b.AttesterSlashings[ii].SizeSSZ()
+		}
+
+		// Field (5) 'Attestations'
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			size += 4
+			size += b.Attestations[ii].SizeSSZ()
+		}
+
+		// Field (6) 'Deposits'
+		size += len(b.Deposits) * 1240
+
+		// Field (7) 'VoluntaryExits'
+		size += len(b.VoluntaryExits) * 112
+
+		// Field (9) 'ExecutionPayload'
+		if b.ExecutionPayload == nil {
+			b.ExecutionPayload = new(ExecutionPayload)
+		}
+		size += b.ExecutionPayload.SizeSSZFork(fork)
+
+		// Field (10) 'BlsToExecutionChanges'
+		size += len(b.BlsToExecutionChanges) * 172
+
+		// Field (11) 'BlobKzgCommitments'
+		size += len(b.BlobKzgCommitments) * 48
+	case fork >= ssz.ForkCapella:
+		size = 388
+		// Field (3) 'ProposerSlashings'
+		size += len(b.ProposerSlashings) * 416
+
+		// Field (4) 'AttesterSlashings'
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			size += 4
+			size += b.AttesterSlashings[ii].SizeSSZ()
+		}
+
+		// Field (5) 'Attestations'
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			size += 4
+			size += b.Attestations[ii].SizeSSZ()
+		}
+
+		// Field (6) 'Deposits'
+		size += len(b.Deposits) * 1240
+
+		// Field (7) 'VoluntaryExits'
+		size += len(b.VoluntaryExits) * 112
+
+		// Field (9) 'ExecutionPayload'
+		if b.ExecutionPayload == nil {
+			b.ExecutionPayload = new(ExecutionPayload)
+		}
+		size += b.ExecutionPayload.SizeSSZFork(fork)
+
+		// Field (10) 'BlsToExecutionChanges'
+		size += len(b.BlsToExecutionChanges) * 172
+	case fork >= ssz.ForkBellatrix:
+		size = 384
+		// Field (3) 'ProposerSlashings'
+		size += len(b.ProposerSlashings) * 416
+
+		// Field (4) 'AttesterSlashings'
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			size += 4
+			size += b.AttesterSlashings[ii].SizeSSZ()
+		}
+
+		// Field (5) 'Attestations'
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			size += 4
+			size += b.Attestations[ii].SizeSSZ()
+		}
+
+		// Field (6) 'Deposits'
+		size += len(b.Deposits) * 1240
+
+		// Field (7) 'VoluntaryExits'
+		size += len(b.VoluntaryExits) * 112
+
+		// Field (9) 'ExecutionPayload'
+		if b.ExecutionPayload == nil {
+			b.ExecutionPayload = new(ExecutionPayload)
+		}
+		size += b.ExecutionPayload.SizeSSZFork(fork)
+	case fork >= ssz.ForkAltair:
+		size = 380
+		// Field (3) 'ProposerSlashings'
+		size += len(b.ProposerSlashings) * 416
+
+		// Field (4) 'AttesterSlashings'
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			size += 4
+			size += b.AttesterSlashings[ii].SizeSSZ()
+		}
+
+		// Field (5) 'Attestations'
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			size += 4
+			size += b.Attestations[ii].SizeSSZ()
+		}
+
+		// Field (6) 'Deposits'
+		size += len(b.Deposits) * 1240
+
+		// Field (7) 'VoluntaryExits'
+		size += len(b.VoluntaryExits) * 112
+	default:
+		size = 220
+		// Field (3) 'ProposerSlashings'
+		size += len(b.ProposerSlashings) * 416
+
+		// Field (4) 'AttesterSlashings'
+		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
+			size += 4
+			size += b.AttesterSlashings[ii].SizeSSZ()
+		}
+
+		// Field (5) 'Attestations'
+		for ii := 0; ii < len(b.Attestations); ii++ {
+			size += 4
+			size += b.Attestations[ii].SizeSSZ()
+		}
+
+		// Field (6) 'Deposits'
+		size += len(b.Deposits) * 1240
+
+		// Field (7) 'VoluntaryExits'
+		size += len(b.VoluntaryExits) * 112
+	}
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the BeaconBlockBody object
+func (b *BeaconBlockBody) MinSSZSize() uint64 {
+	return b.MinSSZSizeFork(ssz.ForkDeneb)
+}
+
+// MinSSZSizeFork returns the minimum ssz encoded size in bytes for the BeaconBlockBody object for a given fork
+func (b *BeaconBlockBody) MinSSZSizeFork(fork ssz.Fork) uint64 {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 920
+	case fork >= ssz.ForkCapella:
+		return 900
+	case fork >= ssz.ForkBellatrix:
+		return 892
+	case fork >= ssz.ForkAltair:
+		return 380
+	default:
+		return 220
+	}
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the BeaconBlockBody object
+func (b *BeaconBlockBody) MaxSSZSize() uint64 {
+	return b.MaxSSZSizeFork(ssz.ForkDeneb)
+}
+
+// MaxSSZSizeFork returns the maximum ssz encoded size in bytes for the BeaconBlockBody object for a given fork
+func (b *BeaconBlockBody) MaxSSZSizeFork(fork ssz.Fork) uint64 {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 1125899911395296
+	case fork >= ssz.ForkCapella:
+		return 1125899911198668
+	case fork >= ssz.ForkBellatrix:
+		return 1125899911195204
+	case fork >= ssz.ForkAltair:
+		return 157732
+	default:
+		return 157572
+	}
+}
+
+// HashTreeRoot ssz hashes the BeaconBlockBody object
+func (b *BeaconBlockBody) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BeaconBlockBody object with a hasher
+func (b *BeaconBlockBody) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	return b.HashTreeRootWithFork(hh, ssz.ForkDeneb)
+}
+
+// HashTreeRootFork ssz hashes the BeaconBlockBody object for a given fork
+func (b *BeaconBlockBody) HashTreeRootFork(fork ssz.Fork) ([32]byte, error) {
+	return ssz.HashWithDefaultHasherFork(b, fork)
+}
+
+// HashTreeRootWithFork ssz hashes the BeaconBlockBody object with a hasher for a given fork
+func (b *BeaconBlockBody) HashTreeRootWithFork(hh ssz.HashWalker, fork ssz.Fork) (err error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		indx := hh.Index()
+
+		// Field (0) 'RandaoReveal'
+		if size := len(b.RandaoReveal); size != 96 {
+			err = ssz.ErrBytesLengthFn("BeaconBlockBody.RandaoReveal", size, 96)
+			return
+		}
+		hh.PutBytes(b.RandaoReveal)
+
+		// Field (1) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (2) 'Graffiti'
+		hh.PutBytes(b.Graffiti[:])
+
+		// Field (3) 'ProposerSlashings'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.ProposerSlashings))
+			if num > 16 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.ProposerSlashings {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+
+		// Field (4) 'AttesterSlashings'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.AttesterSlashings))
+			if num > 2 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.AttesterSlashings {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 2)
+		}
+
+		// Field (5) 'Attestations'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.Attestations))
+			if num > 128 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.Attestations {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 128)
+		}
+
+		// Field (6) 'Deposits'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.Deposits))
+			if num > 16 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.Deposits {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+
+		// Field (7) 'VoluntaryExits'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.VoluntaryExits))
+			if num > 16 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.VoluntaryExits {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+
+		// Field (8) 'SyncAggregate'
+		if b.SyncAggregate == nil {
+			b.SyncAggregate = new(SyncAggregate)
+		}
+		if err = b.SyncAggregate.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (9) 'ExecutionPayload'
+		if err = b.ExecutionPayload.HashTreeRootWithFork(hh, fork); err != nil {
+			return
+		}
+
+		// Field (10) 'BlsToExecutionChanges'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.BlsToExecutionChanges))
+			if num > 16 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.BlsToExecutionChanges {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+
+		// Field (11) 'BlobKzgCommitments'
+		{
+			if size := len(b.BlobKzgCommitments); size > 4096 {
+				err = ssz.ErrListTooBigFn("BeaconBlockBody.BlobKzgCommitments", size, 4096)
+				return
+			}
+			subIndx := hh.Index()
+			for _, i := range b.BlobKzgCommitments {
+				hh.PutBytes(i[:])
+			}
+			numItems := uint64(len(b.BlobKzgCommitments))
+			hh.MerkleizeWithMixin(subIndx, numItems, 4096)
+		}
+
+		hh.Merkleize(indx)
+	case fork >= ssz.ForkCapella:
+		indx := hh.Index()
+
+		// Field (0) 'RandaoReveal'
+		if size := len(b.RandaoReveal); size != 96 {
+			err = ssz.ErrBytesLengthFn("BeaconBlockBody.RandaoReveal", size, 96)
+			return
+		}
+		hh.PutBytes(b.RandaoReveal)
+
+		// Field (1) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (2) 'Graffiti'
+		hh.PutBytes(b.Graffiti[:])
+
+		// Field (3) 'ProposerSlashings'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.ProposerSlashings))
+			if num > 16 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.ProposerSlashings {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+
+		// Field (4) 'AttesterSlashings'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.AttesterSlashings))
+			if num > 2 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.AttesterSlashings {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 2)
+		}
+
+		// Field (5) 'Attestations'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.Attestations))
+			if num > 128 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.Attestations {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 128)
+		}
+
+		// Field (6) 'Deposits'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.Deposits))
+			if num > 16 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.Deposits {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+
+		// Field (7) 'VoluntaryExits'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.VoluntaryExits))
+			if num > 16 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.VoluntaryExits {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+
+		// Field (8) 'SyncAggregate'
+		if b.SyncAggregate == nil {
+			b.SyncAggregate = new(SyncAggregate)
+		}
+		if err = b.SyncAggregate.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (9) 'ExecutionPayload'
+		if err = b.ExecutionPayload.HashTreeRootWithFork(hh, fork); err != nil {
+			return
+		}
+
+		// Field (10) 'BlsToExecutionChanges'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.BlsToExecutionChanges))
+			if num > 16 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.BlsToExecutionChanges {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+
+		hh.Merkleize(indx)
+	case fork >= ssz.ForkBellatrix:
+		indx := hh.Index()
+
+		// Field (0) 'RandaoReveal'
+		if size := len(b.RandaoReveal); size != 96 {
+			err = ssz.ErrBytesLengthFn("BeaconBlockBody.RandaoReveal", size, 96)
+			return
+		}
+		hh.PutBytes(b.RandaoReveal)
+
+		// Field (1) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (2) 'Graffiti'
+		hh.PutBytes(b.Graffiti[:])
+
+		// Field (3) 'ProposerSlashings'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.ProposerSlashings))
+			if num > 16 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.ProposerSlashings {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+
+		// Field (4) 'AttesterSlashings'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.AttesterSlashings))
+			if num > 2 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.AttesterSlashings {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 2)
+		}
+
+		// Field (5) 'Attestations'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.Attestations))
+			if num > 128 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.Attestations {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 128)
+		}
+
+		// Field (6) 'Deposits'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.Deposits))
+			if num > 16 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.Deposits {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+
+		// Field (7) 'VoluntaryExits'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.VoluntaryExits))
+			if num > 16 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.VoluntaryExits {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+
+		// Field (8) 'SyncAggregate'
+		if b.SyncAggregate == nil {
+			b.SyncAggregate = new(SyncAggregate)
+		}
+		if err = b.SyncAggregate.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (9) 'ExecutionPayload'
+		if err = b.ExecutionPayload.HashTreeRootWithFork(hh, fork); err != nil {
+			return
+		}
+
+		hh.Merkleize(indx)
+	case fork >= ssz.ForkAltair:
+		indx := hh.Index()
+
+		// Field (0) 'RandaoReveal'
+		if size := len(b.RandaoReveal); size != 96 {
+			err = ssz.ErrBytesLengthFn("BeaconBlockBody.RandaoReveal", size, 96)
+			return
+		}
+		hh.PutBytes(b.RandaoReveal)
+
+		// Field (1) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (2) 'Graffiti'
+		hh.PutBytes(b.Graffiti[:])
+
+		// Field (3) 'ProposerSlashings'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.ProposerSlashings))
+			if num > 16 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.ProposerSlashings {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+
+		// Field (4) 'AttesterSlashings'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.AttesterSlashings))
+			if num > 2 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.AttesterSlashings {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 2)
+		}
+
+		// Field (5) 'Attestations'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.Attestations))
+			if num > 128 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.Attestations {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 128)
+		}
+
+		// Field (6) 'Deposits'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.Deposits))
+			if num > 16 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.Deposits {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+
+		// Field (7) 'VoluntaryExits'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.VoluntaryExits))
+			if num > 16 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.VoluntaryExits {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+
+		// Field (8) 'SyncAggregate'
+		if b.SyncAggregate == nil {
+			b.SyncAggregate = new(SyncAggregate)
+		}
+		if err = b.SyncAggregate.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		hh.Merkleize(indx)
+	default:
+		indx := hh.Index()
+
+		// Field (0) 'RandaoReveal'
+		if size := len(b.RandaoReveal); size != 96 {
+			err = ssz.ErrBytesLengthFn("BeaconBlockBody.RandaoReveal", size, 96)
+			return
+		}
+		hh.PutBytes(b.RandaoReveal)
+
+		// Field (1) 'Eth1Data'
+		if b.Eth1Data == nil {
+			b.Eth1Data = new(Eth1Data)
+		}
+		if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+			return
+		}
+
+		// Field (2) 'Graffiti'
+		hh.PutBytes(b.Graffiti[:])
+
+		// Field (3) 'ProposerSlashings'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.ProposerSlashings))
+			if num > 16 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.ProposerSlashings {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+
+		// Field (4) 'AttesterSlashings'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.AttesterSlashings))
+			if num > 2 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.AttesterSlashings {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 2)
+		}
+
+		// Field (5) 'Attestations'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.Attestations))
+			if num > 128 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.Attestations {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 128)
+		}
+
+		// Field (6) 'Deposits'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.Deposits))
+			if num > 16 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.Deposits {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+
+		// Field (7) 'VoluntaryExits'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(b.VoluntaryExits))
+			if num > 16 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range b.VoluntaryExits {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 16)
+		}
+
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded BeaconBlockBody object without decoding it
+func (b *BeaconBlockBody) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(b, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded BeaconBlockBody object with a hasher
+func (b *BeaconBlockBody) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	return b.HashTreeRootSSZWithFork(hh, buf, ssz.ForkDeneb)
+}
+
+// HashTreeRootSSZFork ssz hashes the encoded BeaconBlockBody object for a given fork without decoding it
+func (b *BeaconBlockBody) HashTreeRootSSZFork(buf []byte, fork ssz.Fork) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasherFork(b, buf, fork)
+}
+
+// HashTreeRootSSZWithFork ssz hashes the encoded BeaconBlockBody object with a hasher for a given fork
+func (b *BeaconBlockBody) HashTreeRootSSZWithFork(hh ssz.HashWalker, buf []byte, fork ssz.Fork) (err error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		size := uint64(len(buf))
+		if size < 392 {
+			return ssz.ErrSize
+		}
+
+		tail := buf
+		var o3, o4, o5, o6, o7, o9, o10, o11 uint64
+
+		// Offset (3) 'ProposerSlashings'
+		if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
+			return ssz.ErrOffset
+		}
+
+		if err = ssz.ValidateFirstOffset(o3, 392); err != nil {
+			return err
+		}
+
+		// Offset (4) 'AttesterSlashings'
+		if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (5) 'Attestations'
+		if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (6) 'Deposits'
+		if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (7) 'VoluntaryExits'
+		if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (9) 'ExecutionPayload'
+		if o9 = ssz.ReadOffset(buf[380:384]); o9 > size || o7 > o9 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (10) 'BlsToExecutionChanges'
+		if o10 = ssz.ReadOffset(buf[384:388]); o10 > size || o9 > o10 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (11) 'BlobKzgCommitments'
+		if o11 = ssz.ReadOffset(buf[388:392]); o11 > size || o10 > o11 {
+			return ssz.ErrOffset
+		}
+
+		indx := hh.Index()
+
+		// Field (0) 'RandaoReveal'
+		hh.PutBytes(buf[0:96])
+
+		// Field (1) 'Eth1Data'
+		if err = (*Eth1Data)(nil).HashTreeRootSSZWith(hh, buf[96:168]); err != nil {
+			return err
+		}
+
+		// Field (2) 'Graffiti'
+		hh.PutBytes(buf[168:200])
+
+		// Field (3) 'ProposerSlashings'
+		{
+			buf := tail[o3:o4]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 416, 16)
+				if err != nil {
+					return err
+				}
+				for ii := 0; ii < num; ii++ {
+					if err = (*ProposerSlashing)(nil).HashTreeRootSSZWith(hh, buf[ii*416:(ii+1)*416]); err != nil {
+						return err
+					}
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 16)
+			}
+		}
+
+		// Field (4) 'AttesterSlashings'
+		{
+			buf := tail[o4:o5]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DecodeDynamicLength(buf, 2)
+				if err != nil {
+					return err
+				}
+				err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+					if err = (*AttesterSlashing)(nil).HashTreeRootSSZWith(hh, buf); err != nil {
+						return err
+					}
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 2)
+			}
+		}
+
+		// Field (5) 'Attestations'
+		{
+			buf := tail[o5:o6]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DecodeDynamicLength(buf, 128)
+				if err != nil {
+					return err
+				}
+				err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+					if err = (*Attestation)(nil).HashTreeRootSSZWith(hh, buf); err != nil {
+						return err
+					}
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 128)
+			}
+		}
+
+		// Field (6) 'Deposits'
+		{
+			buf := tail[o6:o7]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 1240, 16)
+				if err != nil {
+					return err
+				}
+				for ii := 0; ii < num; ii++ {
+					if err = (*Deposit)(nil).HashTreeRootSSZWith(hh, buf[ii*1240:(ii+1)*1240]); err != nil {
+						return err
+					}
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 16)
+			}
+		}
+
+		// Field (7) 'VoluntaryExits'
+		{
+			buf := tail[o7:o9]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 112, 16)
+				if err != nil {
+					return err
+				}
+				for ii := 0; ii < num; ii++ {
+					if err = (*SignedVoluntaryExit)(nil).HashTreeRootSSZWith(hh, buf[ii*112:(ii+1)*112]); err != nil {
+						return err
+					}
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 16)
+			}
+		}
+
+		// Field (8) 'SyncAggregate'
+		if err = (*SyncAggregate)(nil).HashTreeRootSSZWith(hh, buf[220:380]); err != nil {
+			return err
+		}
+
+		// Field (9) 'ExecutionPayload'
+		{
+			buf := tail[o9:o10]
+			if err = (*ExecutionPayload)(nil).HashTreeRootSSZWithFork(hh, buf, fork); err != nil {
+				return err
+			}
+		}
+
+		// Field (10) 'BlsToExecutionChanges'
+		{
+			buf := tail[o10:o11]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 172, 16)
+				if err != nil {
+					return err
+				}
+				for ii := 0; ii < num; ii++ {
+					if err = (*SignedBLSToExecutionChange)(nil).HashTreeRootSSZWith(hh, buf[ii*172:(ii+1)*172]); err != nil {
+						return err
+					}
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 16)
+			}
+		}
+
+		// Field (11) 'BlobKzgCommitments'
+		{
+			buf := tail[o11:]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 48, 4096)
+				if err != nil {
+					return err
+				}
+				for ii := 0; ii < len(buf); ii += 48 {
+					hh.PutBytes(buf[ii : ii+48])
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 4096)
+			}
+		}
+
+		hh.Merkleize(indx)
+	case fork >= ssz.ForkCapella:
+		size := uint64(len(buf))
+		if size < 388 {
+			return ssz.ErrSize
+		}
+
+		tail := buf
+		var o3, o4, o5, o6, o7, o9, o10 uint64
+
+		// Offset (3) 'ProposerSlashings'
+		if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
+			return ssz.ErrOffset
+		}
+
+		if err = ssz.ValidateFirstOffset(o3, 388); err != nil {
+			return err
+		}
+
+		// Offset (4) 'AttesterSlashings'
+		if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (5) 'Attestations'
+		if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (6) 'Deposits'
+		if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (7) 'VoluntaryExits'
+		if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (9) 'ExecutionPayload'
+		if o9 = ssz.ReadOffset(buf[380:384]); o9 > size || o7 > o9 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (10) 'BlsToExecutionChanges'
+		if o10 = ssz.ReadOffset(buf[384:388]); o10 > size || o9 > o10 {
+			return ssz.ErrOffset
+		}
+
+		indx := hh.Index()
+
+		// Field (0) 'RandaoReveal'
+		hh.PutBytes(buf[0:96])
+
+		// Field (1) 'Eth1Data'
+		if err = (*Eth1Data)(nil).HashTreeRootSSZWith(hh, buf[96:168]); err != nil {
+			return err
+		}
+
+		// Field (2) 'Graffiti'
+		hh.PutBytes(buf[168:200])
+
+		// Field (3) 'ProposerSlashings'
+		{
+			buf := tail[o3:o4]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 416, 16)
+				if err != nil {
+					return err
+				}
+				for ii := 0; ii < num; ii++ {
+					if err = (*ProposerSlashing)(nil).HashTreeRootSSZWith(hh, buf[ii*416:(ii+1)*416]); err != nil {
+						return err
+					}
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 16)
+			}
+		}
+
+		// Field (4) 'AttesterSlashings'
+		{
+			buf := tail[o4:o5]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DecodeDynamicLength(buf, 2)
+				if err != nil {
+					return err
+				}
+				err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+					if err = (*AttesterSlashing)(nil).HashTreeRootSSZWith(hh, buf); err != nil {
+						return err
+					}
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 2)
+			}
+		}
+
+		// Field (5) 'Attestations'
+		{
+			buf := tail[o5:o6]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DecodeDynamicLength(buf, 128)
+				if err != nil {
+					return err
+				}
+				err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+					if err = (*Attestation)(nil).HashTreeRootSSZWith(hh, buf); err != nil {
+						return err
+					}
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 128)
+			}
+		}
+
+		// Field (6) 'Deposits'
+		{
+			buf := tail[o6:o7]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 1240, 16)
+				if err != nil {
+					return err
+				}
+				for ii := 0; ii < num; ii++ {
+					if err = (*Deposit)(nil).HashTreeRootSSZWith(hh, buf[ii*1240:(ii+1)*1240]); err != nil {
+						return err
+					}
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 16)
+			}
+		}
+
+		// Field (7) 'VoluntaryExits'
+		{
+			buf := tail[o7:o9]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 112, 16)
+				if err != nil {
+					return err
+				}
+				for ii := 0; ii < num; ii++ {
+					if err = (*SignedVoluntaryExit)(nil).HashTreeRootSSZWith(hh, buf[ii*112:(ii+1)*112]); err != nil {
+						return err
+					}
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 16)
+			}
+		}
+
+		// Field (8) 'SyncAggregate'
+		if err = (*SyncAggregate)(nil).HashTreeRootSSZWith(hh, buf[220:380]); err != nil {
+			return err
+		}
+
+		// Field (9) 'ExecutionPayload'
+		{
+			buf := tail[o9:o10]
+			if err = (*ExecutionPayload)(nil).HashTreeRootSSZWithFork(hh, buf, fork); err != nil {
+				return err
+			}
+		}
+
+		// Field (10) 'BlsToExecutionChanges'
+		{
+			buf := tail[o10:]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 172, 16)
+				if err != nil {
+					return err
+				}
+				for ii := 0; ii < num; ii++ {
+					if err = (*SignedBLSToExecutionChange)(nil).HashTreeRootSSZWith(hh, buf[ii*172:(ii+1)*172]); err != nil {
+						return err
+					}
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 16)
+			}
+		}
+
+		hh.Merkleize(indx)
+	case fork >= ssz.ForkBellatrix:
+		size := uint64(len(buf))
+		if size < 384 {
+			return ssz.ErrSize
+		}
+
+		tail := buf
+		var o3, o4, o5, o6, o7, o9 uint64
+
+		// Offset (3) 'ProposerSlashings'
+		if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
+			return ssz.ErrOffset
+		}
+
+		if err = ssz.ValidateFirstOffset(o3, 384); err != nil {
+			return err
+		}
+
+		// Offset (4) 'AttesterSlashings'
+		if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (5) 'Attestations'
+		if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (6) 'Deposits'
+		if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (7) 'VoluntaryExits'
+		if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (9) 'ExecutionPayload'
+		if o9 = ssz.ReadOffset(buf[380:384]); o9 > size || o7 > o9 {
+			return ssz.ErrOffset
+		}
+
+		indx := hh.Index()
+
+		// Field (0) 'RandaoReveal'
+		hh.PutBytes(buf[0:96])
+
+		// Field (1) 'Eth1Data'
+		if err = (*Eth1Data)(nil).HashTreeRootSSZWith(hh, buf[96:168]); err != nil {
+			return err
+		}
+
+		// Field (2) 'Graffiti'
+		hh.PutBytes(buf[168:200])
+
+		// Field (3) 'ProposerSlashings'
+		{
+			buf := tail[o3:o4]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 416, 16)
+				if err != nil {
+					return err
+				}
+				for ii := 0; ii < num; ii++ {
+					if err = (*ProposerSlashing)(nil).HashTreeRootSSZWith(hh, buf[ii*416:(ii+1)*416]); err != nil {
+						return err
+					}
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 16)
+			}
+		}
+
+		// Field (4) 'AttesterSlashings'
+		{
+			buf := tail[o4:o5]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DecodeDynamicLength(buf, 2)
+				if err != nil {
+					return err
+				}
+				err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+					if err = (*AttesterSlashing)(nil).HashTreeRootSSZWith(hh, buf); err != nil {
+						return err
+					}
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 2)
+			}
+		}
+
+		// Field (5) 'Attestations'
+		{
+			buf := tail[o5:o6]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DecodeDynamicLength(buf, 128)
+				if err != nil {
+					return err
+				}
+				err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+					if err = (*Attestation)(nil).HashTreeRootSSZWith(hh, buf); err != nil {
+						return err
+					}
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 128)
+			}
+		}
+
+		// Field (6) 'Deposits'
+		{
+			buf := tail[o6:o7]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 1240, 16)
+				if err != nil {
+					return err
+				}
+				for ii := 0; ii < num; ii++ {
+					if err = (*Deposit)(nil).HashTreeRootSSZWith(hh, buf[ii*1240:(ii+1)*1240]); err != nil {
+						return err
+					}
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 16)
+			}
+		}
+
+		// Field (7) 'VoluntaryExits'
+		{
+			buf := tail[o7:o9]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 112, 16)
+				if err != nil {
+					return err
+				}
+				for ii := 0; ii < num; ii++ {
+					if err = (*SignedVoluntaryExit)(nil).HashTreeRootSSZWith(hh, buf[ii*112:(ii+1)*112]); err != nil {
+						return err
+					}
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 16)
+			}
+		}
+
+		// Field (8) 'SyncAggregate'
+		if err = (*SyncAggregate)(nil).HashTreeRootSSZWith(hh, buf[220:380]); err != nil {
+			return err
+		}
+
+		// Field (9) 'ExecutionPayload'
+		{
+			buf := tail[o9:]
+			if err = (*ExecutionPayload)(nil).HashTreeRootSSZWithFork(hh, buf, fork); err != nil {
+				return err
+			}
+		}
+
+		hh.Merkleize(indx)
+	case fork >= ssz.ForkAltair:
+		size := uint64(len(buf))
+		if size < 380 {
+			return ssz.ErrSize
+		}
+
+		tail := buf
+		var o3, o4, o5, o6, o7 uint64
+
+		// Offset (3) 'ProposerSlashings'
+		if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
+			return ssz.ErrOffset
+		}
+
+		if err = ssz.ValidateFirstOffset(o3, 380); err != nil {
+			return err
+		}
+
+		// Offset (4) 'AttesterSlashings'
+		if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (5) 'Attestations'
+		if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (6) 'Deposits'
+		if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (7) 'VoluntaryExits'
+		if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
+			return ssz.ErrOffset
+		}
+
+		indx := hh.Index()
+
+		// Field (0) 'RandaoReveal'
+		hh.PutBytes(buf[0:96])
+
+		// Field (1) 'Eth1Data'
+		if err = (*Eth1Data)(nil).HashTreeRootSSZWith(hh, buf[96:168]); err != nil {
+			return err
+		}
+
+		// Field (2) 'Graffiti'
+		hh.PutBytes(buf[168:200])
+
+		// Field (3) 'ProposerSlashings'
+		{
+			buf := tail[o3:o4]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 416, 16)
+				if err != nil {
+					return err
+				}
+				for ii := 0; ii < num; ii++ {
+					if err = (*ProposerSlashing)(nil).HashTreeRootSSZWith(hh, buf[ii*416:(ii+1)*416]); err != nil {
+						return err
+					}
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 16)
+			}
+		}
+
+		// Field (4) 'AttesterSlashings'
+		{
+			buf := tail[o4:o5]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DecodeDynamicLength(buf, 2)
+				if err != nil {
+					return err
+				}
+				err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+					if err = (*AttesterSlashing)(nil).HashTreeRootSSZWith(hh, buf); err != nil {
+						return err
+					}
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 2)
+			}
+		}
+
+		// Field (5) 'Attestations'
+		{
+			buf := tail[o5:o6]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DecodeDynamicLength(buf, 128)
+				if err != nil {
+					return err
+				}
+				err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+					if err = (*Attestation)(nil).HashTreeRootSSZWith(hh, buf); err != nil {
+						return err
+					}
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 128)
+			}
+		}
+
+		// Field (6) 'Deposits'
+		{
+			buf := tail[o6:o7]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 1240, 16)
+				if err != nil {
+					return err
+				}
+				for ii := 0; ii < num; ii++ {
+					if err = (*Deposit)(nil).HashTreeRootSSZWith(hh, buf[ii*1240:(ii+1)*1240]); err != nil {
+						return err
+					}
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 16)
+			}
+		}
+
+		// Field (7) 'VoluntaryExits'
+		{
+			buf := tail[o7:]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 112, 16)
+				if err != nil {
+					return err
+				}
+				for ii := 0; ii < num; ii++ {
+					if err = (*SignedVoluntaryExit)(nil).HashTreeRootSSZWith(hh, buf[ii*112:(ii+1)*112]); err != nil {
+						return err
+					}
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 16)
+			}
+		}
+
+		// Field (8) 'SyncAggregate'
+		if err = (*SyncAggregate)(nil).HashTreeRootSSZWith(hh, buf[220:380]); err != nil {
+			return err
+		}
+
+		hh.Merkleize(indx)
+	default:
+		size := uint64(len(buf))
+		if size < 220 {
+			return ssz.ErrSize
+		}
+
+		tail := buf
+		var o3, o4, o5, o6, o7 uint64
+
+		// Offset (3) 'ProposerSlashings'
+		if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
+			return ssz.ErrOffset
+		}
+
+		if err = ssz.ValidateFirstOffset(o3, 220); err != nil {
+			return err
+		}
+
+		// Offset (4) 'AttesterSlashings'
+		if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (5) 'Attestations'
+		if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (6) 'Deposits'
+		if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (7) 'VoluntaryExits'
+		if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
+			return ssz.ErrOffset
+		}
+
+		indx := hh.Index()
+
+		// Field (0) 'RandaoReveal'
+		hh.PutBytes(buf[0:96])
+
+		// Field (1) 'Eth1Data'
+		if err = (*Eth1Data)(nil).HashTreeRootSSZWith(hh, buf[96:168]); err != nil {
+			return err
+		}
+
+		// Field (2) 'Graffiti'
+		hh.PutBytes(buf[168:200])
+
+		// Field (3) 'ProposerSlashings'
+		{
+			buf := tail[o3:o4]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 416, 16)
+				if err != nil {
+					return err
+				}
+				for ii := 0; ii < num; ii++ {
+					if err = (*ProposerSlashing)(nil).HashTreeRootSSZWith(hh, buf[ii*416:(ii+1)*416]); err != nil {
+						return err
+					}
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 16)
+			}
+		}
+
+		// Field (4) 'AttesterSlashings'
+		{
+			buf := tail[o4:o5]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DecodeDynamicLength(buf, 2)
+				if err != nil {
+					return err
+				}
+				err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+					if err = (*AttesterSlashing)(nil).HashTreeRootSSZWith(hh, buf); err != nil {
+						return err
+					}
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 2)
+			}
+		}
+
+		// Field (5) 'Attestations'
+		{
+			buf := tail[o5:o6]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DecodeDynamicLength(buf, 128)
+				if err != nil {
+					return err
+				}
+				err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+					if err = (*Attestation)(nil).HashTreeRootSSZWith(hh, buf); err != nil {
+						return err
+					}
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 128)
+			}
+		}
+
+		// Field (6) 'Deposits'
+		{
+			buf := tail[o6:o7]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 1240, 16)
+				if err != nil {
+					return err
+				}
+				for ii := 0; ii < num; ii++ {
+					if err = (*Deposit)(nil).HashTreeRootSSZWith(hh, buf[ii*1240:(ii+1)*1240]); err != nil {
+						return err
+					}
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 16)
+			}
+		}
+
+		// Field (7) 'VoluntaryExits'
+		{
+			buf := tail[o7:]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 112, 16)
+				if err != nil {
+					return err
+				}
+				for ii := 0; ii < num; ii++ {
+					if err = (*SignedVoluntaryExit)(nil).HashTreeRootSSZWith(hh, buf[ii*112:(ii+1)*112]); err != nil {
+						return err
+					}
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 16)
+			}
+		}
+
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// GetTree ssz hashes the BeaconBlockBody object
+func (b *BeaconBlockBody) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+// GetTreeFork ssz hashes the BeaconBlockBody object for a given fork
+func (b *BeaconBlockBody) GetTreeFork(fork ssz.Fork) (*ssz.Node, error) {
+	return ssz.ProofTreeFork(b, fork)
+}
+
+const (
+	// BeaconBlockBodyRandaoRevealGIndex is the generalized index of the 'RandaoReveal' field at the deneb fork
+	BeaconBlockBodyRandaoRevealGIndex ssz.GIndex = 16
+	// BeaconBlockBodyEth1DataGIndex is the generalized index of the 'Eth1Data' field at the deneb fork
+	BeaconBlockBodyEth1DataGIndex ssz.GIndex = 17
+	// BeaconBlockBodyGraffitiGIndex is the generalized index of the 'Graffiti' field at the deneb fork
+	BeaconBlockBodyGraffitiGIndex ssz.GIndex = 18
+	// BeaconBlockBodyProposerSlashingsGIndex is the generalized index of the 'ProposerSlashings' field at the deneb fork
+	BeaconBlockBodyProposerSlashingsGIndex ssz.GIndex = 19
+	// BeaconBlockBodyAttesterSlashingsGIndex is the generalized index of the 'AttesterSlashings' field at the deneb fork
+	BeaconBlockBodyAttesterSlashingsGIndex ssz.GIndex = 20
+	// BeaconBlockBodyAttestationsGIndex is the generalized index of the 'Attestations' field at the deneb fork
+	BeaconBlockBodyAttestationsGIndex ssz.GIndex = 21
+	// BeaconBlockBodyDepositsGIndex is the generalized index of the 'Deposits' field at the deneb fork
+	BeaconBlockBodyDepositsGIndex ssz.GIndex = 22
+	// BeaconBlockBodyVoluntaryExitsGIndex is the generalized index of the 'VoluntaryExits' field at the deneb fork
+	BeaconBlockBodyVoluntaryExitsGIndex ssz.GIndex = 23
+	// BeaconBlockBodySyncAggregateGIndex is the generalized index of the 'SyncAggregate' field at the deneb fork
+	BeaconBlockBodySyncAggregateGIndex ssz.GIndex = 24
+	// BeaconBlockBodyExecutionPayloadGIndex is the generalized index of the 'ExecutionPayload' field at the deneb fork
+	BeaconBlockBodyExecutionPayloadGIndex ssz.GIndex = 25
+	// BeaconBlockBodyBlsToExecutionChangesGIndex is the generalized index of the 'BlsToExecutionChanges' field at the deneb fork
+	BeaconBlockBodyBlsToExecutionChangesGIndex ssz.GIndex = 26
+	// BeaconBlockBodyBlobKzgCommitmentsGIndex is the generalized index of the 'BlobKzgCommitments' field at the deneb fork
+	BeaconBlockBodyBlobKzgCommitmentsGIndex ssz.GIndex = 27
+)
+
+// ProveRandaoReveal returns a merkle proof of the 'RandaoReveal' field of the BeaconBlockBody object
+func (b *BeaconBlockBody) ProveRandaoReveal() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconBlockBodyRandaoRevealGIndex)
+}
+
+// VerifyBeaconBlockBodyRandaoReveal verifies a merkle proof of the 'RandaoReveal' field of a BeaconBlockBody object
+func VerifyBeaconBlockBodyRandaoReveal(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconBlockBodyRandaoRevealGIndex)
+}
+
+// ProveEth1Data returns a merkle proof of the 'Eth1Data' field of the BeaconBlockBody object
+func (b *BeaconBlockBody) ProveEth1Data() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconBlockBodyEth1DataGIndex)
+}
+
+// VerifyBeaconBlockBodyEth1Data verifies a merkle proof of the 'Eth1Data' field of a BeaconBlockBody object
+func VerifyBeaconBlockBodyEth1Data(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconBlockBodyEth1DataGIndex)
+}
+
+// ProveGraffiti returns a merkle proof of the 'Graffiti' field of the BeaconBlockBody object
+func (b *BeaconBlockBody) ProveGraffiti() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconBlockBodyGraffitiGIndex)
+}
+
+// VerifyBeaconBlockBodyGraffiti verifies a merkle proof of the 'Graffiti' field of a BeaconBlockBody object
+func VerifyBeaconBlockBodyGraffiti(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconBlockBodyGraffitiGIndex)
+}
+
+// ProveProposerSlashings returns a merkle proof of the 'ProposerSlashings' field of the BeaconBlockBody object
+func (b *BeaconBlockBody) ProveProposerSlashings() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconBlockBodyProposerSlashingsGIndex)
+}
+
+// VerifyBeaconBlockBodyProposerSlashings verifies a merkle proof of the 'ProposerSlashings' field of a BeaconBlockBody object
+func VerifyBeaconBlockBodyProposerSlashings(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconBlockBodyProposerSlashingsGIndex)
+}
+
+// ProveAttesterSlashings returns a merkle proof of the 'AttesterSlashings' field of the BeaconBlockBody object
+func (b *BeaconBlockBody) ProveAttesterSlashings() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconBlockBodyAttesterSlashingsGIndex)
+}
+
+// VerifyBeaconBlockBodyAttesterSlashings verifies a merkle proof of the 'AttesterSlashings' field of a BeaconBlockBody object
+func VerifyBeaconBlockBodyAttesterSlashings(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconBlockBodyAttesterSlashingsGIndex)
+}
+
+// ProveAttestations returns a merkle proof of the 'Attestations' field of the BeaconBlockBody object
+func (b *BeaconBlockBody) ProveAttestations() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconBlockBodyAttestationsGIndex)
+}
+
+// VerifyBeaconBlockBodyAttestations verifies a merkle proof of the 'Attestations' field of a BeaconBlockBody object
+func VerifyBeaconBlockBodyAttestations(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconBlockBodyAttestationsGIndex)
+}
+
+// ProveDeposits returns a merkle proof of the 'Deposits' field of the BeaconBlockBody object
+func (b *BeaconBlockBody) ProveDeposits() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconBlockBodyDepositsGIndex)
+}
+
+// VerifyBeaconBlockBodyDeposits verifies a merkle proof of the 'Deposits' field of a BeaconBlockBody object
+func VerifyBeaconBlockBodyDeposits(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconBlockBodyDepositsGIndex)
+}
+
+// ProveVoluntaryExits returns a merkle proof of the 'VoluntaryExits' field of the BeaconBlockBody object
+func (b *BeaconBlockBody) ProveVoluntaryExits() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconBlockBodyVoluntaryExitsGIndex)
+}
+
+// VerifyBeaconBlockBodyVoluntaryExits verifies a merkle proof of the 'VoluntaryExits' field of a BeaconBlockBody object
+func VerifyBeaconBlockBodyVoluntaryExits(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconBlockBodyVoluntaryExitsGIndex)
+}
+
+// ProveSyncAggregate returns a merkle proof of the 'SyncAggregate' field of the BeaconBlockBody object
+func (b *BeaconBlockBody) ProveSyncAggregate() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconBlockBodySyncAggregateGIndex)
+}
+
+// VerifyBeaconBlockBodySyncAggregate verifies a merkle proof of the 'SyncAggregate' field of a BeaconBlockBody object
+func VerifyBeaconBlockBodySyncAggregate(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconBlockBodySyncAggregateGIndex)
+}
+
+// ProveExecutionPayload returns a merkle proof of the 'ExecutionPayload' field of the BeaconBlockBody object
+func (b *BeaconBlockBody) ProveExecutionPayload() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconBlockBodyExecutionPayloadGIndex)
+}
+
+// VerifyBeaconBlockBodyExecutionPayload verifies a merkle proof of the 'ExecutionPayload' field of a BeaconBlockBody object
+func VerifyBeaconBlockBodyExecutionPayload(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconBlockBodyExecutionPayloadGIndex)
+}
+
+// ProveBlsToExecutionChanges returns a merkle proof of the 'BlsToExecutionChanges' field of the BeaconBlockBody object
+func (b *BeaconBlockBody) ProveBlsToExecutionChanges() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconBlockBodyBlsToExecutionChangesGIndex)
+}
+
+// VerifyBeaconBlockBodyBlsToExecutionChanges verifies a merkle proof of the 'BlsToExecutionChanges' field of a BeaconBlockBody object
+func VerifyBeaconBlockBodyBlsToExecutionChanges(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconBlockBodyBlsToExecutionChangesGIndex)
+}
+
+// ProveBlobKzgCommitments returns a merkle proof of the 'BlobKzgCommitments' field of the BeaconBlockBody object
+func (b *BeaconBlockBody) ProveBlobKzgCommitments() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconBlockBodyBlobKzgCommitmentsGIndex)
+}
+
+// VerifyBeaconBlockBodyBlobKzgCommitments verifies a merkle proof of the 'BlobKzgCommitments' field of a BeaconBlockBody object
+func VerifyBeaconBlockBodyBlobKzgCommitments(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconBlockBodyBlobKzgCommitmentsGIndex)
+}
+
+// BeaconBlockBodyRandaoRevealGIndexFork returns the generalized index of the 'RandaoReveal' field for a given fork
+func BeaconBlockBodyRandaoRevealGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkAltair:
+		return 16, nil
+	default:
+		return 8, nil
+	}
+}
+
+// ProveRandaoRevealFork returns a merkle proof of the 'RandaoReveal' field of the BeaconBlockBody object for a given fork
+func (b *BeaconBlockBody) ProveRandaoRevealFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconBlockBodyRandaoRevealGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconBlockBodyRandaoRevealFork verifies a merkle proof of the 'RandaoReveal' field of a BeaconBlockBody object for a given fork
+func VerifyBeaconBlockBodyRandaoRevealFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconBlockBodyRandaoRevealGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconBlockBodyEth1DataGIndexFork returns the generalized index of the 'Eth1Data' field for a given fork
+func BeaconBlockBodyEth1DataGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkAltair:
+		return 17, nil
+	default:
+		return 9, nil
+	}
+}
+
+// ProveEth1DataFork returns a merkle proof of the 'Eth1Data' field of the BeaconBlockBody object for a given fork
+func (b *BeaconBlockBody) ProveEth1DataFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconBlockBodyEth1DataGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconBlockBodyEth1DataFork verifies a merkle proof of the 'Eth1Data' field of a BeaconBlockBody object for a given fork
+func VerifyBeaconBlockBodyEth1DataFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconBlockBodyEth1DataGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconBlockBodyGraffitiGIndexFork returns the generalized index of the 'Graffiti' field for a given fork
+func BeaconBlockBodyGraffitiGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkAltair:
+		return 18, nil
+	default:
+		return 10, nil
+	}
+}
+
+// ProveGraffitiFork returns a merkle proof of the 'Graffiti' field of the BeaconBlockBody object for a given fork
+func (b *BeaconBlockBody) ProveGraffitiFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconBlockBodyGraffitiGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconBlockBodyGraffitiFork verifies a merkle proof of the 'Graffiti' field of a BeaconBlockBody object for a given fork
+func VerifyBeaconBlockBodyGraffitiFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconBlockBodyGraffitiGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconBlockBodyProposerSlashingsGIndexFork returns the generalized index of the 'ProposerSlashings' field for a given fork
+func BeaconBlockBodyProposerSlashingsGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkAltair:
+		return 19, nil
+	default:
+		return 11, nil
+	}
+}
+
+// ProveProposerSlashingsFork returns a merkle proof of the 'ProposerSlashings' field of the BeaconBlockBody object for a given fork
+func (b *BeaconBlockBody) ProveProposerSlashingsFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconBlockBodyProposerSlashingsGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconBlockBodyProposerSlashingsFork verifies a merkle proof of the 'ProposerSlashings' field of a BeaconBlockBody object for a given fork
+func VerifyBeaconBlockBodyProposerSlashingsFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconBlockBodyProposerSlashingsGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconBlockBodyAttesterSlashingsGIndexFork returns the generalized index of the 'AttesterSlashings' field for a given fork
+func BeaconBlockBodyAttesterSlashingsGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkAltair:
+		return 20, nil
+	default:
+		return 12, nil
+	}
+}
+
+// ProveAttesterSlashingsFork returns a merkle proof of the 'AttesterSlashings' field of the BeaconBlockBody object for a given fork
+func (b *BeaconBlockBody) ProveAttesterSlashingsFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconBlockBodyAttesterSlashingsGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconBlockBodyAttesterSlashingsFork verifies a merkle proof of the 'AttesterSlashings' field of a BeaconBlockBody object for a given fork
+func VerifyBeaconBlockBodyAttesterSlashingsFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconBlockBodyAttesterSlashingsGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconBlockBodyAttestationsGIndexFork returns the generalized index of the 'Attestations' field for a given fork
+func BeaconBlockBodyAttestationsGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkAltair:
+		return 21, nil
+	default:
+		return 13, nil
+	}
+}
+
+// ProveAttestationsFork returns a merkle proof of the 'Attestations' field of the BeaconBlockBody object for a given fork
+func (b *BeaconBlockBody) ProveAttestationsFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconBlockBodyAttestationsGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconBlockBodyAttestationsFork verifies a merkle proof of the 'Attestations' field of a BeaconBlockBody object for a given fork
+func VerifyBeaconBlockBodyAttestationsFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconBlockBodyAttestationsGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconBlockBodyDepositsGIndexFork returns the generalized index of the 'Deposits' field for a given fork
+func BeaconBlockBodyDepositsGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkAltair:
+		return 22, nil
+	default:
+		return 14, nil
+	}
+}
+
+// ProveDepositsFork returns a merkle proof of the 'Deposits' field of the BeaconBlockBody object for a given fork
+func (b *BeaconBlockBody) ProveDepositsFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconBlockBodyDepositsGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconBlockBodyDepositsFork verifies a merkle proof of the 'Deposits' field of a BeaconBlockBody object for a given fork
+func VerifyBeaconBlockBodyDepositsFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconBlockBodyDepositsGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconBlockBodyVoluntaryExitsGIndexFork returns the generalized index of the 'VoluntaryExits' field for a given fork
+func BeaconBlockBodyVoluntaryExitsGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkAltair:
+		return 23, nil
+	default:
+		return 15, nil
+	}
+}
+
+// ProveVoluntaryExitsFork returns a merkle proof of the 'VoluntaryExits' field of the BeaconBlockBody object for a given fork
+func (b *BeaconBlockBody) ProveVoluntaryExitsFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconBlockBodyVoluntaryExitsGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconBlockBodyVoluntaryExitsFork verifies a merkle proof of the 'VoluntaryExits' field of a BeaconBlockBody object for a given fork
+func VerifyBeaconBlockBodyVoluntaryExitsFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconBlockBodyVoluntaryExitsGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconBlockBodySyncAggregateGIndexFork returns the generalized index of the 'SyncAggregate' field for a given fork
+func BeaconBlockBodySyncAggregateGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkAltair:
+		return 24, nil
+	default:
+		return 0, ssz.ErrForkField
+	}
+}
+
+// ProveSyncAggregateFork returns a merkle proof of the 'SyncAggregate' field of the BeaconBlockBody object for a given fork
+func (b *BeaconBlockBody) ProveSyncAggregateFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconBlockBodySyncAggregateGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconBlockBodySyncAggregateFork verifies a merkle proof of the 'SyncAggregate' field of a BeaconBlockBody object for a given fork
+func VerifyBeaconBlockBodySyncAggregateFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconBlockBodySyncAggregateGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconBlockBodyExecutionPayloadGIndexFork returns the generalized index of the 'ExecutionPayload' field for a given fork
+func BeaconBlockBodyExecutionPayloadGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkBellatrix:
+		return 25, nil
+	default:
+		return 0, ssz.ErrForkField
+	}
+}
+
+// ProveExecutionPayloadFork returns a merkle proof of the 'ExecutionPayload' field of the BeaconBlockBody object for a given fork
+func (b *BeaconBlockBody) ProveExecutionPayloadFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconBlockBodyExecutionPayloadGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconBlockBodyExecutionPayloadFork verifies a merkle proof of the 'ExecutionPayload' field of a BeaconBlockBody object for a given fork
+func VerifyBeaconBlockBodyExecutionPayloadFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconBlockBodyExecutionPayloadGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconBlockBodyBlsToExecutionChangesGIndexFork returns the generalized index of the 'BlsToExecutionChanges' field for a given fork
+func BeaconBlockBodyBlsToExecutionChangesGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkCapella:
+		return 26, nil
+	default:
+		return 0, ssz.ErrForkField
+	}
+}
+
+// ProveBlsToExecutionChangesFork returns a merkle proof of the 'BlsToExecutionChanges' field of the BeaconBlockBody object for a given fork
+func (b *BeaconBlockBody) ProveBlsToExecutionChangesFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconBlockBodyBlsToExecutionChangesGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconBlockBodyBlsToExecutionChangesFork verifies a merkle proof of the 'BlsToExecutionChanges' field of a BeaconBlockBody object for a given fork
+func VerifyBeaconBlockBodyBlsToExecutionChangesFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconBlockBodyBlsToExecutionChangesGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// BeaconBlockBodyBlobKzgCommitmentsGIndexFork returns the generalized index of the 'BlobKzgCommitments' field for a given fork
+func BeaconBlockBodyBlobKzgCommitmentsGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 27, nil
+	default:
+		return 0, ssz.ErrForkField
+	}
+}
+
+// ProveBlobKzgCommitmentsFork returns a merkle proof of the 'BlobKzgCommitments' field of the BeaconBlockBody object for a given fork
+func (b *BeaconBlockBody) ProveBlobKzgCommitmentsFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := BeaconBlockBodyBlobKzgCommitmentsGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(b, gindex, fork)
+}
+
+// VerifyBeaconBlockBodyBlobKzgCommitmentsFork verifies a merkle proof of the 'BlobKzgCommitments' field of a BeaconBlockBody object for a given fork
+func VerifyBeaconBlockBodyBlobKzgCommitmentsFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := BeaconBlockBodyBlobKzgCommitmentsGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// SchemaSSZ returns the schema of the tree of the BeaconBlockBody object
+func (b *BeaconBlockBody) SchemaSSZ() *ssz.Schema {
+	return b.SchemaSSZFork(ssz.ForkDeneb)
+}
+
+// SchemaSSZFork returns the schema of the tree of the BeaconBlockBody object for a given fork
+func (b *BeaconBlockBody) SchemaSSZFork(fork ssz.Fork) *ssz.Schema {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return ssz.SchemaContainer(
+			&ssz.SchemaField{Name: "RandaoReveal", Schema: ssz.SchemaBytes(96)},
+			&ssz.SchemaField{Name: "Eth1Data", Schema: (*Eth1Data)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "Graffiti", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "ProposerSlashings", Schema: ssz.SchemaList((*ProposerSlashing)(nil).SchemaSSZ(), 16)},
+			&ssz.SchemaField{Name: "AttesterSlashings", Schema: ssz.SchemaList((*AttesterSlashing)(nil).SchemaSSZ(), 2)},
+			&ssz.SchemaField{Name: "Attestations", Schema: ssz.SchemaList((*Attestation)(nil).SchemaSSZ(), 128)},
+			&ssz.SchemaField{Name: "Deposits", Schema: ssz.SchemaList((*Deposit)(nil).SchemaSSZ(), 16)},
+			&ssz.SchemaField{Name: "VoluntaryExits", Schema: ssz.SchemaList((*SignedVoluntaryExit)(nil).SchemaSSZ(), 16)},
+			&ssz.SchemaField{Name: "SyncAggregate", Schema: (*SyncAggregate)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "ExecutionPayload", Schema: (*ExecutionPayload)(nil).SchemaSSZFork(fork)},
+			&ssz.SchemaField{Name: "BlsToExecutionChanges", Schema: ssz.SchemaList((*SignedBLSToExecutionChange)(nil).SchemaSSZ(), 16)},
+			&ssz.SchemaField{Name: "BlobKzgCommitments", Schema: ssz.SchemaList(ssz.SchemaBytes(48), 4096)},
+		)
+	case fork >= ssz.ForkCapella:
+		return ssz.SchemaContainer(
+			&ssz.SchemaField{Name: "RandaoReveal", Schema: ssz.SchemaBytes(96)},
+			&ssz.SchemaField{Name: "Eth1Data", Schema: (*Eth1Data)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "Graffiti", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "ProposerSlashings", Schema: ssz.SchemaList((*ProposerSlashing)(nil).SchemaSSZ(), 16)},
+			&ssz.SchemaField{Name: "AttesterSlashings", Schema: ssz.SchemaList((*AttesterSlashing)(nil).SchemaSSZ(), 2)},
+			&ssz.SchemaField{Name: "Attestations", Schema: ssz.SchemaList((*Attestation)(nil).SchemaSSZ(), 128)},
+			&ssz.SchemaField{Name: "Deposits", Schema: ssz.SchemaList((*Deposit)(nil).SchemaSSZ(), 16)},
+			&ssz.SchemaField{Name: "VoluntaryExits", Schema: ssz.SchemaList((*SignedVoluntaryExit)(nil).SchemaSSZ(), 16)},
+			&ssz.SchemaField{Name: "SyncAggregate", Schema: (*SyncAggregate)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "ExecutionPayload", Schema: (*ExecutionPayload)(nil).SchemaSSZFork(fork)},
+			&ssz.SchemaField{Name: "BlsToExecutionChanges", Schema: ssz.SchemaList((*SignedBLSToExecutionChange)(nil).SchemaSSZ(), 16)},
+		)
+	case fork >= ssz.ForkBellatrix:
+		return ssz.SchemaContainer(
+			&ssz.SchemaField{Name: "RandaoReveal", Schema: ssz.SchemaBytes(96)},
+			&ssz.SchemaField{Name: "Eth1Data", Schema: (*Eth1Data)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "Graffiti", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "ProposerSlashings", Schema: ssz.SchemaList((*ProposerSlashing)(nil).SchemaSSZ(), 16)},
+			&ssz.SchemaField{Name: "AttesterSlashings", Schema: ssz.SchemaList((*AttesterSlashing)(nil).SchemaSSZ(), 2)},
+			&ssz.SchemaField{Name: "Attestations", Schema: ssz.SchemaList((*Attestation)(nil).SchemaSSZ(), 128)},
+			&ssz.SchemaField{Name: "Deposits", Schema: ssz.SchemaList((*Deposit)(nil).SchemaSSZ(), 16)},
+			&ssz.SchemaField{Name: "VoluntaryExits", Schema: ssz.SchemaList((*SignedVoluntaryExit)(nil).SchemaSSZ(), 16)},
+			&ssz.SchemaField{Name: "SyncAggregate", Schema: (*SyncAggregate)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "ExecutionPayload", Schema: (*ExecutionPayload)(nil).SchemaSSZFork(fork)},
+		)
+	case fork >= ssz.ForkAltair:
+		return ssz.SchemaContainer(
+			&ssz.SchemaField{Name: "RandaoReveal", Schema: ssz.SchemaBytes(96)},
+			&ssz.SchemaField{Name: "Eth1Data", Schema: (*Eth1Data)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "Graffiti", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "ProposerSlashings", Schema: ssz.SchemaList((*ProposerSlashing)(nil).SchemaSSZ(), 16)},
+			&ssz.SchemaField{Name: "AttesterSlashings", Schema: ssz.SchemaList((*AttesterSlashing)(nil).SchemaSSZ(), 2)},
+			&ssz.SchemaField{Name: "Attestations", Schema: ssz.SchemaList((*Attestation)(nil).SchemaSSZ(), 128)},
+			&ssz.SchemaField{Name: "Deposits", Schema: ssz.SchemaList((*Deposit)(nil).SchemaSSZ(), 16)},
+			&ssz.SchemaField{Name: "VoluntaryExits", Schema: ssz.SchemaList((*SignedVoluntaryExit)(nil).SchemaSSZ(), 16)},
+			&ssz.SchemaField{Name: "SyncAggregate", Schema: (*SyncAggregate)(nil).SchemaSSZ()},
+		)
+	default:
+		return ssz.SchemaContainer(
+			&ssz.SchemaField{Name: "RandaoReveal", Schema: ssz.SchemaBytes(96)},
+			&ssz.SchemaField{Name: "Eth1Data", Schema: (*Eth1Data)(nil).SchemaSSZ()},
+			&ssz.SchemaField{Name: "Graffiti", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "ProposerSlashings", Schema: ssz.SchemaList((*ProposerSlashing)(nil).SchemaSSZ(), 16)},
+			&ssz.SchemaField{Name: "AttesterSlashings", Schema: ssz.SchemaList((*AttesterSlashing)(nil).SchemaSSZ(), 2)},
+			&ssz.SchemaField{Name: "Attestations", Schema: ssz.SchemaList((*Attestation)(nil).SchemaSSZ(), 128)},
+			&ssz.SchemaField{Name: "Deposits", Schema: ssz.SchemaList((*Deposit)(nil).SchemaSSZ(), 16)},
+			&ssz.SchemaField{Name: "VoluntaryExits", Schema: ssz.SchemaList((*SignedVoluntaryExit)(nil).SchemaSSZ(), 16)},
+		)
+	}
+}
+
+// MarshalSSZ ssz marshals the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SignedBeaconBlockHeader object to a target array
+func (s *SignedBeaconBlockHeader) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Header'
+	if s.Header == nil {
+		s.Header = new(BeaconBlockHeader)
+	}
+	if dst, err = s.Header.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("SignedBeaconBlockHeader.Signature", size, 96)
+		return
+	}
+	dst = append(dst, s.Signature...)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the SignedBeaconBlockHeader object to a writer
+func (s *SignedBeaconBlockHeader) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(s, writer)
+}
+
+// MarshalSSZToStream ssz marshals the SignedBeaconBlockHeader object to a target array that is flushed to a stream writer
+func (s *SignedBeaconBlockHeader) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Header'
+	if s.Header == nil {
+		s.Header = new(BeaconBlockHeader)
+	}
+	if dst, err = s.Header.MarshalSSZToStream(dst, sw); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("SignedBeaconBlockHeader.Signature", size, 96)
+		return
+	}
+	dst = append(dst, s.Signature...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) UnmarshalSSZ(buf []byte) error {
+	return s.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the SignedBeaconBlockHeader object with the decoding options
+func (s *SignedBeaconBlockHeader) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 208 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Header'
+	if s.Header == nil {
+		s.Header = new(BeaconBlockHeader)
+	}
+	if err = s.Header.UnmarshalSSZWithOptions(buf[0:112], opts); err != nil {
+		return err
+	}
+
+	// Field (1) 'Signature'
+	if cap(s.Signature) == 0 {
+		s.Signature = make([]byte, 0, len(buf[112:208]))
+	}
+	s.Signature = append(s.Signature, buf[112:208]...)
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) SizeSSZ() (size int) {
+	size = 208
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) MinSSZSize() uint64 {
+	return 208
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) MaxSSZSize() uint64 {
+	return 208
+}
+
+// HashTreeRoot ssz hashes the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SignedBeaconBlockHeader object with a hasher
+func (s *SignedBeaconBlockHeader) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Header'
+	if s.Header == nil {
+		s.Header = new(BeaconBlockHeader)
+	}
+	if err = s.Header.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'Signature'
+	if size := len(s.Signature); size != 96 {
+		err = ssz.ErrBytesLengthFn("SignedBeaconBlockHeader.Signature", size, 96)
+		return
+	}
+	hh.PutBytes(s.Signature)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded SignedBeaconBlockHeader object without decoding it
+func (s *SignedBeaconBlockHeader) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(s, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded SignedBeaconBlockHeader object with a hasher
+func (s *SignedBeaconBlockHeader) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 208 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Header'
+	if err = (*BeaconBlockHeader)(nil).HashTreeRootSSZWith(hh, buf[0:112]); err != nil {
+		return err
+	}
+
+	// Field (1) 'Signature'
+	hh.PutBytes(buf[112:208])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+const (
+	// SignedBeaconBlockHeaderHeaderGIndex is the generalized index of the 'Header' field
+	SignedBeaconBlockHeaderHeaderGIndex ssz.GIndex = 2
+	// SignedBeaconBlockHeaderSignatureGIndex is the generalized index of the 'Signature' field
+	SignedBeaconBlockHeaderSignatureGIndex ssz.GIndex = 3
+)
+
+// ProveHeader returns a merkle proof of the 'Header' field of the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) ProveHeader() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(s, SignedBeaconBlockHeaderHeaderGIndex)
+}
+
+// VerifySignedBeaconBlockHeaderHeader verifies a merkle proof of the 'Header' field of a SignedBeaconBlockHeader object
+func VerifySignedBeaconBlockHeaderHeader(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, SignedBeaconBlockHeaderHeaderGIndex)
+}
+
+// ProveSignature returns a merkle proof of the 'Signature' field of the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) ProveSignature() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(s, SignedBeaconBlockHeaderSignatureGIndex)
+}
+
+// VerifySignedBeaconBlockHeaderSignature verifies a merkle proof of the 'Signature' field of a SignedBeaconBlockHeader object
+func VerifySignedBeaconBlockHeaderSignature(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, SignedBeaconBlockHeaderSignatureGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the SignedBeaconBlockHeader object
+func (s *SignedBeaconBlockHeader) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Header", Schema: (*BeaconBlockHeader)(nil).SchemaSSZ()},
+		&ssz.SchemaField{Name: "Signature", Schema: ssz.SchemaBytes(96)},
+	)
+}
+
+// MarshalSSZ ssz marshals the BeaconBlockHeader object
+func (b *BeaconBlockHeader) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BeaconBlockHeader object to a target array
+func (b *BeaconBlockHeader) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, b.Slot)
+
+	// Field (1) 'ProposerIndex'
+	dst = ssz.MarshalUint64(dst, b.ProposerIndex)
+
+	// Field (2) 'ParentRoot'
+	if size := len(b.ParentRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("BeaconBlockHeader.ParentRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.ParentRoot...)
+
+	// Field (3) 'StateRoot'
+	if size := len(b.StateRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("BeaconBlockHeader.StateRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.StateRoot...)
+
+	// Field (4) 'BodyRoot'
+	if size := len(b.BodyRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("BeaconBlockHeader.BodyRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.BodyRoot...)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the BeaconBlockHeader object to a writer
+func (b *BeaconBlockHeader) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(b, writer)
+}
+
+// MarshalSSZToStream ssz marshals the BeaconBlockHeader object to a target array that is flushed to a stream writer
+func (b *BeaconBlockHeader) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, b.Slot)
+
+	// Field (1) 'ProposerIndex'
+	dst = ssz.MarshalUint64(dst, b.ProposerIndex)
+
+	// Field (2) 'ParentRoot'
+	if size := len(b.ParentRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("BeaconBlockHeader.ParentRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.ParentRoot...)
+
+	// Field (3) 'StateRoot'
+	if size := len(b.StateRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("BeaconBlockHeader.StateRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.StateRoot...)
+
+	// Field (4) 'BodyRoot'
+	if size := len(b.BodyRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("BeaconBlockHeader.BodyRoot", size, 32)
+		return
+	}
+	dst = append(dst, b.BodyRoot...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BeaconBlockHeader object
+func (b *BeaconBlockHeader) UnmarshalSSZ(buf []byte) error {
+	return b.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the BeaconBlockHeader object with the decoding options
+func (b *BeaconBlockHeader) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 112 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Slot'
+	b.Slot = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'ProposerIndex'
+	b.ProposerIndex = ssz.UnmarshallUint64(buf[8:16])
+
+	// Field (2) 'ParentRoot'
+	if cap(b.ParentRoot) == 0 {
+		b.ParentRoot = make([]byte, 0, len(buf[16:48]))
+	}
+	b.ParentRoot = append(b.ParentRoot, buf[16:48]...)
+
+	// Field (3) 'StateRoot'
+	if cap(b.StateRoot) == 0 {
+		b.StateRoot = make([]byte, 0, len(buf[48:80]))
+	}
+	b.StateRoot = append(b.StateRoot, buf[48:80]...)
+
+	// Field (4) 'BodyRoot'
+	if cap(b.BodyRoot) == 0 {
+		b.BodyRoot = make([]byte, 0, len(buf[80:112]))
+	}
+	b.BodyRoot = append(b.BodyRoot, buf[80:112]...)
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockHeader object
+func (b *BeaconBlockHeader) SizeSSZ() (size int) {
+	size = 112
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the BeaconBlockHeader object
+func (b *BeaconBlockHeader) MinSSZSize() uint64 {
+	return 112
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the BeaconBlockHeader object
+func (b *BeaconBlockHeader) MaxSSZSize() uint64 {
+	return 112
+}
+
+// HashTreeRoot ssz hashes the BeaconBlockHeader object
+func (b *BeaconBlockHeader) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BeaconBlockHeader object with a hasher
+func (b *BeaconBlockHeader) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(b.Slot)
+
+	// Field (1) 'ProposerIndex'
+	hh.PutUint64(b.ProposerIndex)
+
+	// Field (2) 'ParentRoot'
+	if size := len(b.ParentRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("BeaconBlockHeader.ParentRoot", size, 32)
+		return
+	}
+	hh.PutBytes(b.ParentRoot)
+
+	// Field (3) 'StateRoot'
+	if size := len(b.StateRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("BeaconBlockHeader.StateRoot", size, 32)
+		return
+	}
+	hh.PutBytes(b.StateRoot)
+
+	// Field (4) 'BodyRoot'
+	if size := len(b.BodyRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("BeaconBlockHeader.BodyRoot", size, 32)
+		return
+	}
+	hh.PutBytes(b.BodyRoot)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded BeaconBlockHeader object without decoding it
+func (b *BeaconBlockHeader) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(b, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded BeaconBlockHeader object with a hasher
+func (b *BeaconBlockHeader) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 112 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'ProposerIndex'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[8:16]))
+
+	// Field (2) 'ParentRoot'
+	hh.PutBytes(buf[16:48])
+
+	// Field (3) 'StateRoot'
+	hh.PutBytes(buf[48:80])
+
+	// Field (4) 'BodyRoot'
+	hh.PutBytes(buf[80:112])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the BeaconBlockHeader object
+func (b *BeaconBlockHeader) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+const (
+	// BeaconBlockHeaderSlotGIndex is the generalized index of the 'Slot' field
+	BeaconBlockHeaderSlotGIndex ssz.GIndex = 8
+	// BeaconBlockHeaderProposerIndexGIndex is the generalized index of the 'ProposerIndex' field
+	BeaconBlockHeaderProposerIndexGIndex ssz.GIndex = 9
+	// BeaconBlockHeaderParentRootGIndex is the generalized index of the 'ParentRoot' field
+	BeaconBlockHeaderParentRootGIndex ssz.GIndex = 10
+	// BeaconBlockHeaderStateRootGIndex is the generalized index of the 'StateRoot' field
+	BeaconBlockHeaderStateRootGIndex ssz.GIndex = 11
+	// BeaconBlockHeaderBodyRootGIndex is the generalized index of the 'BodyRoot' field
+	BeaconBlockHeaderBodyRootGIndex ssz.GIndex = 12
+)
+
+// ProveSlot returns a merkle proof of the 'Slot' field of the BeaconBlockHeader object
+func (b *BeaconBlockHeader) ProveSlot() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconBlockHeaderSlotGIndex)
+}
+
+// VerifyBeaconBlockHeaderSlot verifies a merkle proof of the 'Slot' field of a BeaconBlockHeader object
+func VerifyBeaconBlockHeaderSlot(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconBlockHeaderSlotGIndex)
+}
+
+// ProveProposerIndex returns a merkle proof of the 'ProposerIndex' field of the BeaconBlockHeader object
+func (b *BeaconBlockHeader) ProveProposerIndex() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconBlockHeaderProposerIndexGIndex)
+}
+
+// VerifyBeaconBlockHeaderProposerIndex verifies a merkle proof of the 'ProposerIndex' field of a BeaconBlockHeader object
+func VerifyBeaconBlockHeaderProposerIndex(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconBlockHeaderProposerIndexGIndex)
+}
+
+// ProveParentRoot returns a merkle proof of the 'ParentRoot' field of the BeaconBlockHeader object
+func (b *BeaconBlockHeader) ProveParentRoot() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconBlockHeaderParentRootGIndex)
+}
+
+// VerifyBeaconBlockHeaderParentRoot verifies a merkle proof of the 'ParentRoot' field of a BeaconBlockHeader object
+func VerifyBeaconBlockHeaderParentRoot(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconBlockHeaderParentRootGIndex)
+}
+
+// ProveStateRoot returns a merkle proof of the 'StateRoot' field of the BeaconBlockHeader object
+func (b *BeaconBlockHeader) ProveStateRoot() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconBlockHeaderStateRootGIndex)
+}
+
+// VerifyBeaconBlockHeaderStateRoot verifies a merkle proof of the 'StateRoot' field of a BeaconBlockHeader object
+func VerifyBeaconBlockHeaderStateRoot(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconBlockHeaderStateRootGIndex)
+}
+
+// ProveBodyRoot returns a merkle proof of the 'BodyRoot' field of the BeaconBlockHeader object
+func (b *BeaconBlockHeader) ProveBodyRoot() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BeaconBlockHeaderBodyRootGIndex)
+}
+
+// VerifyBeaconBlockHeaderBodyRoot verifies a merkle proof of the 'BodyRoot' field of a BeaconBlockHeader object
+func VerifyBeaconBlockHeaderBodyRoot(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BeaconBlockHeaderBodyRootGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the BeaconBlockHeader object
+func (b *BeaconBlockHeader) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Slot", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "ProposerIndex", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "ParentRoot", Schema: ssz.SchemaBytes(32)},
+		&ssz.SchemaField{Name: "StateRoot", Schema: ssz.SchemaBytes(32)},
+		&ssz.SchemaField{Name: "BodyRoot", Schema: ssz.SchemaBytes(32)},
+	)
+}
+
+// MarshalSSZ ssz marshals the ErrorResponse object
+func (e *ErrorResponse) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(e)
+}
+
+// MarshalSSZTo ssz marshals the ErrorResponse object to a target array
+func (e *ErrorResponse) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
+
+	// Offset (0) 'Message'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Message'
+	if size := len(e.Message); size > 256 {
+		err = ssz.ErrBytesLengthFn("ErrorResponse.Message", size, 256)
+		return
+	}
+	dst = append(dst, e.Message...)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the ErrorResponse object to a writer
+func (e *ErrorResponse) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(e, writer)
+}
+
+// MarshalSSZToStream ssz marshals the ErrorResponse object to a target array that is flushed to a stream writer
+func (e *ErrorResponse) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
+
+	// Offset (0) 'Message'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Message'
+	if size := len(e.Message); size > 256 {
+		err = ssz.ErrBytesLengthFn("ErrorResponse.Message", size, 256)
+		return
+	}
+	if dst, err = sw.Append(dst, e.Message); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ErrorResponse object
+func (e *ErrorResponse) UnmarshalSSZ(buf []byte) error {
+	return e.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the ErrorResponse object with the decoding options
+func (e *ErrorResponse) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Message'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = opts.ValidateFirstOffset(o0, 4); err != nil {
+		return err
+	}
+
+	// Field (0) 'Message'
+	{
+		buf = tail[o0:]
+		if len(buf) > 256 {
+			return ssz.ErrBytesLength
+		}
+		if cap(e.Message) == 0 {
+			e.Message = make([]byte, 0, len(buf))
+		}
+		e.Message = append(e.Message, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ErrorResponse object
+func (e *ErrorResponse) SizeSSZ() (size int) {
+	size = 4
+
+	// Field (0) 'Message'
+	size += len(e.Message)
+
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the ErrorResponse object
+func (e *ErrorResponse) MinSSZSize() uint64 {
+	return 4
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the ErrorResponse object
+func (e *ErrorResponse) MaxSSZSize() uint64 {
+	return 260
+}
+
+// HashTreeRoot ssz hashes the ErrorResponse object
+func (e *ErrorResponse) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(e)
+}
+
+// HashTreeRootWith ssz hashes the ErrorResponse object with a hasher
+func (e *ErrorResponse) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Message'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(e.Message))
+		if byteLen > 256 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(e.Message)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (256+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded ErrorResponse object without decoding it
+func (e *ErrorResponse) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(e, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded ErrorResponse object with a hasher
+func (e *ErrorResponse) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Message'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = ssz.ValidateFirstOffset(o0, 4); err != nil {
+		return err
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Message'
+	{
+		buf := tail[o0:]
+		if len(buf) > 256 {
+			return ssz.ErrBytesLength
+		}
+		{
+			elemIndx := hh.Index()
+			hh.Append(buf)
+			hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (256+31)/32)
+		}
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ErrorResponse object
+func (e *ErrorResponse) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(e)
+}
+
+const (
+	// ErrorResponseMessageGIndex is the generalized index of the 'Message' field
+	ErrorResponseMessageGIndex ssz.GIndex = 1
+)
+
+// ProveMessage returns a merkle proof of the 'Message' field of the ErrorResponse object
+func (e *ErrorResponse) ProveMessage() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ErrorResponseMessageGIndex)
+}
+
+// VerifyErrorResponseMessage verifies a merkle proof of the 'Message' field of a ErrorResponse object
+func VerifyErrorResponseMessage(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ErrorResponseMessageGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the ErrorResponse object
+func (e *ErrorResponse) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Message", Schema: ssz.SchemaList(ssz.SchemaUint(1), 256)},
+	)
+}
+
+// MarshalSSZ ssz marshals the Dummy object
+func (d *Dummy) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(d)
+}
+
+// MarshalSSZTo ssz marshals the Dummy object to a target array
+func (d *Dummy) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the Dummy object to a writer
+func (d *Dummy) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(d, writer)
+}
+
+// MarshalSSZToStream ssz marshals the Dummy object to a target array that is flushed to a stream writer
+func (d *Dummy) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Dummy object
+func (d *Dummy) UnmarshalSSZ(buf []byte) error {
+	return d.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Dummy object with the decoding options
+func (d *Dummy) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 0 {
+		return ssz.ErrSize
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Dummy object
+func (d *Dummy) SizeSSZ() (size int) {
+	size = 0
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Dummy object
+func (d *Dummy) MinSSZSize() uint64 {
+	return 0
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Dummy object
+func (d *Dummy) MaxSSZSize() uint64 {
+	return 0
+}
+
+// HashTreeRoot ssz hashes the Dummy object
+func (d *Dummy) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(d)
+}
+
+// HashTreeRootWith ssz hashes the Dummy object with a hasher
+func (d *Dummy) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded Dummy object without decoding it
+func (d *Dummy) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(d, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded Dummy object with a hasher
+func (d *Dummy) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 0 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Dummy object
+func (d *Dummy) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(d)
+}
+
+// SchemaSSZ returns the schema of the tree of the Dummy object
+func (d *Dummy) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer()
+}
+
+// MarshalSSZ ssz marshals the SyncCommittee object
+func (s *SyncCommittee) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SyncCommittee object to a target array
+func (s *SyncCommittee) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'PubKeys'
+	if size := len(s.PubKeys); size != 512 {
+		err = ssz.ErrVectorLengthFn("SyncCommittee.PubKeys", size, 512)
+		return
+	}
+	for ii := 0; ii < 512; ii++ {
+		if size := len(s.PubKeys[ii]); size != 48 {
+			err = ssz.ErrBytesLengthFn("SyncCommittee.PubKeys[ii]", size, 48)
+			return
+		}
+		dst = append(dst, s.PubKeys[ii]...)
+
+	}
+
+	// Field (1) 'AggregatePubKey'
+	dst = append(dst, s.AggregatePubKey[:]...)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the SyncCommittee object to a writer
+func (s *SyncCommittee) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(s, writer)
+}
+
+// MarshalSSZToStream ssz marshals the SyncCommittee object to a target array that is flushed to a stream writer
+func (s *SyncCommittee) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'PubKeys'
+	if size := len(s.PubKeys); size != 512 {
+		err = ssz.ErrVectorLengthFn("SyncCommittee.PubKeys", size, 512)
+		return
+	}
+	for ii := 0; ii < 512; ii++ {
+		if size := len(s.PubKeys[ii]); size != 48 {
+			err = ssz.ErrBytesLengthFn("SyncCommittee.PubKeys[ii]", size, 48)
+			return
+		}
+		dst = append(dst, s.PubKeys[ii]...)
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (1) 'AggregatePubKey'
+	dst = append(dst, s.AggregatePubKey[:]...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SyncCommittee object
+func (s *SyncCommittee) UnmarshalSSZ(buf []byte) error {
+	return s.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the SyncCommittee object with the decoding options
+func (s *SyncCommittee) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 24624 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'PubKeys'
+	s.PubKeys = make([][]byte, 512)
+	for ii := 0; ii < 512; ii++ {
+		if cap(s.PubKeys[ii]) == 0 {
+			s.PubKeys[ii] = make([]byte, 0, len(buf[0:24576][ii*48:(ii+1)*48]))
+		}
+		s.PubKeys[ii] = append(s.PubKeys[ii], buf[0:24576][ii*48:(ii+1)*48]...)
+	}
+
+	// Field (1) 'AggregatePubKey'
+	copy(s.AggregatePubKey[:], buf[24576:24624])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SyncCommittee object
+func (s *SyncCommittee) SizeSSZ() (size int) {
+	size = 24624
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the SyncCommittee object
+func (s *SyncCommittee) MinSSZSize() uint64 {
+	return 24624
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the SyncCommittee object
+func (s *SyncCommittee) MaxSSZSize() uint64 {
+	return 24624
+}
+
+// HashTreeRoot ssz hashes the SyncCommittee object
+func (s *SyncCommittee) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SyncCommittee object with a hasher
+func (s *SyncCommittee) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'PubKeys'
+	{
+		if size := len(s.PubKeys); size != 512 {
+			err = ssz.ErrVectorLengthFn("SyncCommittee.PubKeys", size, 512)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range s.PubKeys {
+			if len(i) != 48 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.PutBytes(i)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (1) 'AggregatePubKey'
+	hh.PutBytes(s.AggregatePubKey[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded SyncCommittee object without decoding it
+func (s *SyncCommittee) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(s, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded SyncCommittee object with a hasher
+func (s *SyncCommittee) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 24624 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'PubKeys'
+	{
+		subIndx := hh.Index()
+		for ii := 0; ii < len(buf[0:24576]); ii += 48 {
+			hh.PutBytes(buf[0:24576][ii : ii+48])
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (1) 'AggregatePubKey'
+	hh.PutBytes(buf[24576:24624])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the SyncCommittee object
+func (s *SyncCommittee) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+const (
+	// SyncCommitteePubKeysGIndex is the generalized index of the 'PubKeys' field
+	SyncCommitteePubKeysGIndex ssz.GIndex = 2
+	// SyncCommitteeAggregatePubKeyGIndex is the generalized index of the 'AggregatePubKey' field
+	SyncCommitteeAggregatePubKeyGIndex ssz.GIndex = 3
+)
+
+// ProvePubKeys returns a merkle proof of the 'PubKeys' field of the SyncCommittee object
+func (s *SyncCommittee) ProvePubKeys() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(s, SyncCommitteePubKeysGIndex)
+}
+
+// VerifySyncCommitteePubKeys verifies a merkle proof of the 'PubKeys' field of a SyncCommittee object
+func VerifySyncCommitteePubKeys(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, SyncCommitteePubKeysGIndex)
+}
+
+// ProveAggregatePubKey returns a merkle proof of the 'AggregatePubKey' field of the SyncCommittee object
+func (s *SyncCommittee) ProveAggregatePubKey() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(s, SyncCommitteeAggregatePubKeyGIndex)
+}
+
+// VerifySyncCommitteeAggregatePubKey verifies a merkle proof of the 'AggregatePubKey' field of a SyncCommittee object
+func VerifySyncCommitteeAggregatePubKey(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, SyncCommitteeAggregatePubKeyGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the SyncCommittee object
+func (s *SyncCommittee) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "PubKeys", Schema: ssz.SchemaVector(ssz.SchemaBytes(48), 512)},
+		&ssz.SchemaField{Name: "AggregatePubKey", Schema: ssz.SchemaBytes(48)},
+	)
+}
+
+// MarshalSSZ ssz marshals the SyncAggregate object
+func (s *SyncAggregate) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SyncAggregate object to a target array
+func (s *SyncAggregate) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'SyncCommiteeBits'
+	if size := len(s.SyncCommiteeBits); size != 64 {
+		err = ssz.ErrBytesLengthFn("SyncAggregate.SyncCommiteeBits", size, 64)
+		return
+	}
+	dst = append(dst, s.SyncCommiteeBits...)
+
+	// Field (1) 'SyncCommiteeSignature'
+	dst = append(dst, s.SyncCommiteeSignature[:]...)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the SyncAggregate object to a writer
+func (s *SyncAggregate) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(s, writer)
+}
+
+// MarshalSSZToStream ssz marshals the SyncAggregate object to a target array that is flushed to a stream writer
+func (s *SyncAggregate) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'SyncCommiteeBits'
+	if size := len(s.SyncCommiteeBits); size != 64 {
+		err = ssz.ErrBytesLengthFn("SyncAggregate.SyncCommiteeBits", size, 64)
+		return
+	}
+	dst = append(dst, s.SyncCommiteeBits...)
+
+	// Field (1) 'SyncCommiteeSignature'
+	dst = append(dst, s.SyncCommiteeSignature[:]...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SyncAggregate object
+func (s *SyncAggregate) UnmarshalSSZ(buf []byte) error {
+	return s.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the SyncAggregate object with the decoding options
+func (s *SyncAggregate) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 160 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'SyncCommiteeBits'
+	if cap(s.SyncCommiteeBits) == 0 {
+		s.SyncCommiteeBits = make([]byte, 0, len(buf[0:64]))
+	}
+	s.SyncCommiteeBits = append(s.SyncCommiteeBits, buf[0:64]...)
+
+	// Field (1) 'SyncCommiteeSignature'
+	copy(s.SyncCommiteeSignature[:], buf[64:160])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SyncAggregate object
+func (s *SyncAggregate) SizeSSZ() (size int) {
+	size = 160
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the SyncAggregate object
+func (s *SyncAggregate) MinSSZSize() uint64 {
+	return 160
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the SyncAggregate object
+func (s *SyncAggregate) MaxSSZSize() uint64 {
+	return 160
+}
+
+// HashTreeRoot ssz hashes the SyncAggregate object
+func (s *SyncAggregate) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SyncAggregate object with a hasher
+func (s *SyncAggregate) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'SyncCommiteeBits'
+	if size := len(s.SyncCommiteeBits); size != 64 {
+		err = ssz.ErrBytesLengthFn("SyncAggregate.SyncCommiteeBits", size, 64)
+		return
+	}
+	hh.PutBytes(s.SyncCommiteeBits)
+
+	// Field (1) 'SyncCommiteeSignature'
+	hh.PutBytes(s.SyncCommiteeSignature[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded SyncAggregate object without decoding it
+func (s *SyncAggregate) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(s, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded SyncAggregate object with a hasher
+func (s *SyncAggregate) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 160 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'SyncCommiteeBits'
+	hh.PutBytes(buf[0:64])
+
+	// Field (1) 'SyncCommiteeSignature'
+	hh.PutBytes(buf[64:160])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the SyncAggregate object
+func (s *SyncAggregate) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+const (
+	// SyncAggregateSyncCommiteeBitsGIndex is the generalized index of the 'SyncCommiteeBits' field
+	SyncAggregateSyncCommiteeBitsGIndex ssz.GIndex = 2
+	// SyncAggregateSyncCommiteeSignatureGIndex is the generalized index of the 'SyncCommiteeSignature' field
+	SyncAggregateSyncCommiteeSignatureGIndex ssz.GIndex = 3
+)
+
+// ProveSyncCommiteeBits returns a merkle proof of the 'SyncCommiteeBits' field of the SyncAggregate object
+func (s *SyncAggregate) ProveSyncCommiteeBits() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(s, SyncAggregateSyncCommiteeBitsGIndex)
+}
+
+// VerifySyncAggregateSyncCommiteeBits verifies a merkle proof of the 'SyncCommiteeBits' field of a SyncAggregate object
+func VerifySyncAggregateSyncCommiteeBits(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, SyncAggregateSyncCommiteeBitsGIndex)
+}
+
+// ProveSyncCommiteeSignature returns a merkle proof of the 'SyncCommiteeSignature' field of the SyncAggregate object
+func (s *SyncAggregate) ProveSyncCommiteeSignature() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(s, SyncAggregateSyncCommiteeSignatureGIndex)
+}
+
+// VerifySyncAggregateSyncCommiteeSignature verifies a merkle proof of the 'SyncCommiteeSignature' field of a SyncAggregate object
+func VerifySyncAggregateSyncCommiteeSignature(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, SyncAggregateSyncCommiteeSignatureGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the SyncAggregate object
+func (s *SyncAggregate) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "SyncCommiteeBits", Schema: ssz.SchemaBytes(64)},
+		&ssz.SchemaField{Name: "SyncCommiteeSignature", Schema: ssz.SchemaBytes(96)},
+	)
+}
+
+// MarshalSSZ ssz marshals the ExecutionPayload object
+func (e *ExecutionPayload) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(e)
+}
+
+// MarshalSSZTo ssz marshals the ExecutionPayload object to a target array
+func (e *ExecutionPayload) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	return e.MarshalSSZToFork(buf, ssz.ForkDeneb)
+}
+
+// MarshalSSZFork ssz marshals the ExecutionPayload object for a given fork
+func (e *ExecutionPayload) MarshalSSZFork(fork ssz.Fork) ([]byte, error) {
+	return ssz.MarshalSSZFork(e, fork)
+}
+
+// MarshalSSZToFork ssz marshals the ExecutionPayload object to a target array for a given fork
+func (e *ExecutionPayload) MarshalSSZToFork(buf []byte, fork ssz.Fork) (dst []byte, err error) {
+	dst = buf
+	switch {
+	case fork >= ssz.ForkDeneb:
+		offset := int(528)
+		// Field (0) 'ParentHash'
+		dst = append(dst, e.ParentHash[:]...)
+
+		// Field (1) 'FeeRecipient'
+		dst = append(dst, e.FeeRecipient[:]...)
+
+		// Field (2) 'StateRoot'
+		dst = append(dst, e.StateRoot[:]...)
+
+		// Field (3) 'ReceiptsRoot'
+		dst = append(dst, e.ReceiptsRoot[:]...)
+
+		// Field (4) 'LogsBloom'
+		dst = append(dst, e.LogsBloom[:]...)
+
+		// Field (5) 'PrevRandao'
+		dst = append(dst, e.PrevRandao[:]...)
+
+		// Field (6) 'BlockNumber'
+		dst = ssz.MarshalUint64(dst, e.BlockNumber)
+
+		// Field (7) 'GasLimit'
+		dst = ssz.MarshalUint64(dst, e.GasLimit)
+
+		// Field (8) 'GasUsed'
+		dst = ssz.MarshalUint64(dst, e.GasUsed)
+
+		// Field (9) 'Timestamp'
+		dst = ssz.MarshalUint64(dst, e.Timestamp)
+
+		// Offset (10) 'ExtraData'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(e.ExtraData)
+
+		// Field (11) 'BaseFeePerGas'
+		dst = append(dst, e.BaseFeePerGas[:]...)
+
+		// Field (12) 'BlockHash'
+		dst = append(dst, e.BlockHash[:]...)
+
+		// Offset (13) 'Transactions'
+		dst = ssz.WriteOffset(dst, offset)
+		for ii := 0; ii < len(e.Transactions); ii++ {
+			offset += 4
+			offset += len(e.Transactions[ii])
+		}
+
+		// Offset (14) 'Withdrawals'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (15) 'BlobGasUsed'
+		dst = ssz.MarshalUint64(dst, e.BlobGasUsed)
+
+		// Field (16) 'ExcessBlobGas'
+		dst = ssz.MarshalUint64(dst, e.ExcessBlobGas)
+
+		// Field (10) 'ExtraData'
+		if size := len(e.ExtraData); size > 32 {
+			err = ssz.ErrBytesLengthFn("ExecutionPayload.ExtraData", size, 32)
+			return
+		}
+		dst = append(dst, e.ExtraData...)
+
+		// Field (13) 'Transactions'
+		if size := len(e.Transactions); size > 1048576 {
+			err = ssz.ErrListTooBigFn("ExecutionPayload.Transactions", size, 1048576)
+			return
+		}
+		{
+			offset = 4 * len(e.Transactions)
+			for ii := 0; ii < len(e.Transactions); ii++ {
+				dst = ssz.WriteOffset(dst, offset)
+				offset += len(e.Transactions[ii])
+
+			}
+		}
+		for ii := 0; ii < len(e.Transactions); ii++ {
+			if size := len(e.Transactions[ii]); size > 1073741824 {
+				err = ssz.ErrBytesLengthFn("ExecutionPayload.Transactions[ii]", size, 1073741824)
+				return
+			}
+			dst = append(dst, e.Transactions[ii]...)
 
-	// Field (16) 'CurrentEpochAttestations'
-	for ii := 0; ii < len(b.CurrentEpochAttestations); ii++ {
-		size += 4
-		size += b.CurrentEpochAttestations[ii].SizeSSZ()
-	}
+		}
 
-	return
-}
+		// Field (14) 'Withdrawals'
+		if size := len(e.Withdrawals); size > 16 {
+			err = ssz.ErrListTooBigFn("ExecutionPayload.Withdrawals", size, 16)
+			return
+		}
+		for ii := 0; ii < len(e.Withdrawals); ii++ {
+			if dst, err = e.Withdrawals[ii].MarshalSSZTo(dst); err != nil {
+				return
+			}
 
-// HashTreeRoot ssz hashes the BeaconState object
-func (b *BeaconState) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(b)
-}
+		}
 
-// HashTreeRootWith ssz hashes the BeaconState object with a hasher
-func (b *BeaconState) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
+	case fork >= ssz.ForkCapella:
+		offset := int(512)
+		// Field (0) 'ParentHash'
+		dst = append(dst, e.ParentHash[:]...)
 
-	// Field (0) 'GenesisTime'
-	hh.PutUint64(b.GenesisTime)
+		// Field (1) 'FeeRecipient'
+		dst = append(dst, e.FeeRecipient[:]...)
 
-	// Field (1) 'GenesisValidatorsRoot'
-	if size := len(b.GenesisValidatorsRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("BeaconState.GenesisValidatorsRoot", size, 32)
-		return
-	}
-	hh.PutBytes(b.GenesisValidatorsRoot)
+		// Field (2) 'StateRoot'
+		dst = append(dst, e.StateRoot[:]...)
 
-	// Field (2) 'Slot'
-	hh.PutUint64(b.Slot)
+		// Field (3) 'ReceiptsRoot'
+		dst = append(dst, e.ReceiptsRoot[:]...)
 
-	// Field (3) 'Fork'
-	if b.Fork == nil {
-		b.Fork = new(Fork)
-	}
-	if err = b.Fork.HashTreeRootWith(hh); err != nil {
-		return
-	}
+		// Field (4) 'LogsBloom'
+		dst = append(dst, e.LogsBloom[:]...)
 
-	// Field (4) 'LatestBlockHeader'
-	if b.LatestBlockHeader == nil {
-		b.LatestBlockHeader = new(BeaconBlockHeader)
-	}
-	if err = b.LatestBlockHeader.HashTreeRootWith(hh); err != nil {
-		return
-	}
+		// Field (5) 'PrevRandao'
+		dst = append(dst, e.PrevRandao[:]...)
 
-	// Field (5) 'BlockRoots'
-	{
-		if size := len(b.BlockRoots); size != 8192 {
-			err = ssz.ErrVectorLengthFn("BeaconState.BlockRoots", size, 8192)
-			return
+		// Field (6) 'BlockNumber'
+		dst = ssz.MarshalUint64(dst, e.BlockNumber)
+
+		// Field (7) 'GasLimit'
+		dst = ssz.MarshalUint64(dst, e.GasLimit)
+
+		// Field (8) 'GasUsed'
+		dst = ssz.MarshalUint64(dst, e.GasUsed)
+
+		// Field (9) 'Timestamp'
+		dst = ssz.MarshalUint64(dst, e.Timestamp)
+
+		// Offset (10) 'ExtraData'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(e.ExtraData)
+
+		// Field (11) 'BaseFeePerGas'
+		dst = append(dst, e.BaseFeePerGas[:]...)
+
+		// Field (12) 'BlockHash'
+		dst = append(dst, e.BlockHash[:]...)
+
+		// Offset (13) 'Transactions'
+		dst = ssz.WriteOffset(dst, offset)
+		for ii := 0; ii < len(e.Transactions); ii++ {
+			offset += 4
+			offset += len(e.Transactions[ii])
 		}
-		subIndx := hh.Index()
-		for _, i := range b.BlockRoots {
-			if len(i) != 32 {
-				err = ssz.ErrBytesLength
-				return
-			}
-			hh.Append(i)
+
+		// Offset (14) 'Withdrawals'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (10) 'ExtraData'
+		if size := len(e.ExtraData); size > 32 {
+			err = ssz.ErrBytesLengthFn("ExecutionPayload.ExtraData", size, 32)
+			return
 		}
-		hh.Merkleize(subIndx)
-	}
+		dst = append(dst, e.ExtraData...)
 
-	// Field (6) 'StateRoots'
-	{
-		if size := len(b.StateRoots); size != 8192 {
-			err = ssz.ErrVectorLengthFn("BeaconState.StateRoots", size, 8192)
+		// Field (13) 'Transactions'
+		if size := len(e.Transactions); size > 1048576 {
+			err = ssz.ErrListTooBigFn("ExecutionPayload.Transactions", size, 1048576)
 			return
 		}
-		subIndx := hh.Index()
-		for _, i := range b.StateRoots {
-			if len(i) != 32 {
-				err = ssz.ErrBytesLength
+		{
+			offset = 4 * len(e.Transactions)
+			for ii := 0; ii < len(e.Transactions); ii++ {
+				dst = ssz.WriteOffset(dst, offset)
+				offset += len(e.Transactions[ii])
+
+			}
+		}
+		for ii := 0; ii < len(e.Transactions); ii++ {
+			if size := len(e.Transactions[ii]); size > 1073741824 {
+				err = ssz.ErrBytesLengthFn("ExecutionPayload.Transactions[ii]", size, 1073741824)
 				return
 			}
-			hh.Append(i)
+			dst = append(dst, e.Transactions[ii]...)
+
 		}
-		hh.Merkleize(subIndx)
-	}
 
-	// Field (7) 'HistoricalRoots'
-	{
-		if size := len(b.HistoricalRoots); size > 16777216 {
-			err = ssz.ErrListTooBigFn("BeaconState.HistoricalRoots", size, 16777216)
+		// Field (14) 'Withdrawals'
+		if size := len(e.Withdrawals); size > 16 {
+			err = ssz.ErrListTooBigFn("ExecutionPayload.Withdrawals", size, 16)
 			return
 		}
-		subIndx := hh.Index()
-		for _, i := range b.HistoricalRoots {
-			if len(i) != 32 {
-				err = ssz.ErrBytesLength
+		for ii := 0; ii < len(e.Withdrawals); ii++ {
+			if dst, err = e.Withdrawals[ii].MarshalSSZTo(dst); err != nil {
 				return
 			}
-			hh.Append(i)
+
 		}
-		numItems := uint64(len(b.HistoricalRoots))
-		hh.MerkleizeWithMixin(subIndx, numItems, 16777216)
-	}
 
-	// Field (8) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
-		return
-	}
+	default:
+		offset := int(508)
+		// Field (0) 'ParentHash'
+		dst = append(dst, e.ParentHash[:]...)
 
-	// Field (9) 'Eth1DataVotes'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.Eth1DataVotes))
-		if num > 2048 {
-			err = ssz.ErrIncorrectListSize
+		// Field (1) 'FeeRecipient'
+		dst = append(dst, e.FeeRecipient[:]...)
+
+		// Field (2) 'StateRoot'
+		dst = append(dst, e.StateRoot[:]...)
+
+		// Field (3) 'ReceiptsRoot'
+		dst = append(dst, e.ReceiptsRoot[:]...)
+
+		// Field (4) 'LogsBloom'
+		dst = append(dst, e.LogsBloom[:]...)
+
+		// Field (5) 'PrevRandao'
+		dst = append(dst, e.PrevRandao[:]...)
+
+		// Field (6) 'BlockNumber'
+		dst = ssz.MarshalUint64(dst, e.BlockNumber)
+
+		// Field (7) 'GasLimit'
+		dst = ssz.MarshalUint64(dst, e.GasLimit)
+
+		// Field (8) 'GasUsed'
+		dst = ssz.MarshalUint64(dst, e.GasUsed)
+
+		// Field (9) 'Timestamp'
+		dst = ssz.MarshalUint64(dst, e.Timestamp)
+
+		// Offset (10) 'ExtraData'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(e.ExtraData)
+
+		// Field (11) 'BaseFeePerGas'
+		dst = append(dst, e.BaseFeePerGas[:]...)
+
+		// Field (12) 'BlockHash'
+		dst = append(dst, e.BlockHash[:]...)
+
+		// Offset (13) 'Transactions'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (10) 'ExtraData'
+		if size := len(e.ExtraData); size > 32 {
+			err = ssz.ErrBytesLengthFn("ExecutionPayload.ExtraData", size, 32)
 			return
 		}
-		for _, elem := range b.Eth1DataVotes {
-			if err = elem.HashTreeRootWith(hh); err != nil {
+		dst = append(dst, e.ExtraData...)
+
+		// Field (13) 'Transactions'
+		if size := len(e.Transactions); size > 1048576 {
+			err = ssz.ErrListTooBigFn("ExecutionPayload.Transactions", size, 1048576)
+			return
+		}
+		{
+			offset = 4 * len(e.Transactions)
+			for ii := 0; ii < len(e.Transactions); ii++ {
+				dst = ssz.WriteOffset(dst, offset)
+				offset += len(e.Transactions[ii])
+
+			}
+		}
+		for ii := 0; ii < len(e.Transactions); ii++ {
+			if size := len(e.Transactions[ii]); size > 1073741824 {
+				err = ssz.ErrBytesLengthFn("ExecutionPayload.Transactions[ii]", size, 1073741824)
 				return
 			}
+			dst = append(dst, e.Transactions[ii]...)
+
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 2048)
+
 	}
+	return
+}
 
-	// Field (10) 'Eth1DepositIndex'
-	hh.PutUint64(b.Eth1DepositIndex)
+// MarshalSSZToWriter ssz marshals the ExecutionPayload object to a writer
+func (e *ExecutionPayload) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(e, writer)
+}
 
-	// Field (11) 'Validators'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.Validators))
-		if num > 1099511627776 {
-			err = ssz.ErrIncorrectListSize
+// MarshalSSZToStream ssz marshals the ExecutionPayload object to a target array that is flushed to a stream writer
+func (e *ExecutionPayload) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	return e.MarshalSSZToStreamFork(buf, sw, ssz.ForkDeneb)
+}
+
+// MarshalSSZToWriterFork ssz marshals the ExecutionPayload object to a writer for a given fork
+func (e *ExecutionPayload) MarshalSSZToWriterFork(writer io.Writer, fork ssz.Fork) (int, error) {
+	return ssz.MarshalSSZToWriterFork(e, writer, fork)
+}
+
+// MarshalSSZToStreamFork ssz marshals the ExecutionPayload object to a target array that is flushed to a stream writer for a given fork
+func (e *ExecutionPayload) MarshalSSZToStreamFork(buf []byte, sw *ssz.StreamWriter, fork ssz.Fork) (dst []byte, err error) {
+	dst = buf
+	switch {
+	case fork >= ssz.ForkDeneb:
+		offset := int(528)
+		// Field (0) 'ParentHash'
+		dst = append(dst, e.ParentHash[:]...)
+
+		// Field (1) 'FeeRecipient'
+		dst = append(dst, e.FeeRecipient[:]...)
+
+		// Field (2) 'StateRoot'
+		dst = append(dst, e.StateRoot[:]...)
+
+		// Field (3) 'ReceiptsRoot'
+		dst = append(dst, e.ReceiptsRoot[:]...)
+
+		// Field (4) 'LogsBloom'
+		dst = append(dst, e.LogsBloom[:]...)
+
+		// Field (5) 'PrevRandao'
+		dst = append(dst, e.PrevRandao[:]...)
+
+		// Field (6) 'BlockNumber'
+		dst = ssz.MarshalUint64(dst, e.BlockNumber)
+
+		// Field (7) 'GasLimit'
+		dst = ssz.MarshalUint64(dst, e.GasLimit)
+
+		// Field (8) 'GasUsed'
+		dst = ssz.MarshalUint64(dst, e.GasUsed)
+
+		// Field (9) 'Timestamp'
+		dst = ssz.MarshalUint64(dst, e.Timestamp)
+
+		// Offset (10) 'ExtraData'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(e.ExtraData)
+
+		// Field (11) 'BaseFeePerGas'
+		dst = append(dst, e.BaseFeePerGas[:]...)
+
+		// Field (12) 'BlockHash'
+		dst = append(dst, e.BlockHash[:]...)
+
+		// Offset (13) 'Transactions'
+		dst = ssz.WriteOffset(dst, offset)
+		for ii := 0; ii < len(e.Transactions); ii++ {
+			offset += 4
+			offset += len(e.Transactions[ii])
+		}
+
+		// Offset (14) 'Withdrawals'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (15) 'BlobGasUsed'
+		dst = ssz.MarshalUint64(dst, e.BlobGasUsed)
+
+		// Field (16) 'ExcessBlobGas'
+		dst = ssz.MarshalUint64(dst, e.ExcessBlobGas)
+
+		// Field (10) 'ExtraData'
+		if size := len(e.ExtraData); size > 32 {
+			err = ssz.ErrBytesLengthFn("ExecutionPayload.ExtraData", size, 32)
 			return
 		}
-		for _, elem := range b.Validators {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+		if dst, err = sw.Append(dst, e.ExtraData); err != nil {
+			return
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
-	}
 
-	// Field (12) 'Balances'
-	{
-		if size := len(b.Balances); size > 1099511627776 {
-			err = ssz.ErrListTooBigFn("BeaconState.Balances", size, 1099511627776)
+		// Field (13) 'Transactions'
+		if size := len(e.Transactions); size > 1048576 {
+			err = ssz.ErrListTooBigFn("ExecutionPayload.Transactions", size, 1048576)
 			return
 		}
-		subIndx := hh.Index()
-		for _, i := range b.Balances {
-			hh.AppendUint64(i)
+		{
+			offset = 4 * len(e.Transactions)
+			for ii := 0; ii < len(e.Transactions); ii++ {
+				dst = ssz.WriteOffset(dst, offset)
+				offset += len(e.Transactions[ii])
+				if dst, err = sw.Flush(dst); err != nil {
+					return
+				}
+			}
+		}
+		for ii := 0; ii < len(e.Transactions); ii++ {
+			if size := len(e.Transactions[ii]); size > 1073741824 {
+				err = ssz.ErrBytesLengthFn("ExecutionPayload.Transactions[ii]", size, 1073741824)
+				return
+			}
+			if dst, err = sw.Append(dst, e.Transactions[ii]); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
 		}
-		hh.FillUpTo32()
-		numItems := uint64(len(b.Balances))
-		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
-	}
 
-	// Field (13) 'RandaoMixes'
-	{
-		if size := len(b.RandaoMixes); size != 65536 {
-			err = ssz.ErrVectorLengthFn("BeaconState.RandaoMixes", size, 65536)
+		// Field (14) 'Withdrawals'
+		if size := len(e.Withdrawals); size > 16 {
+			err = ssz.ErrListTooBigFn("ExecutionPayload.Withdrawals", size, 16)
 			return
 		}
-		subIndx := hh.Index()
-		for _, i := range b.RandaoMixes {
-			if len(i) != 32 {
-				err = ssz.ErrBytesLength
+		for ii := 0; ii < len(e.Withdrawals); ii++ {
+			if dst, err = e.Withdrawals[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
 				return
 			}
-			hh.Append(i)
 		}
-		hh.Merkleize(subIndx)
-	}
 
-	// Field (14) 'Slashings'
-	{
-		if size := len(b.Slashings); size != 8192 {
-			err = ssz.ErrVectorLengthFn("BeaconState.Slashings", size, 8192)
+	case fork >= ssz.ForkCapella:
+		offset := int(512)
+		// Field (0) 'ParentHash'
+		dst = append(dst, e.ParentHash[:]...)
+
+		// Field (1) 'FeeRecipient'
+		dst = append(dst, e.FeeRecipient[:]...)
+
+		// Field (2) 'StateRoot'
+		dst = append(dst, e.StateRoot[:]...)
+
+		// Field (3) 'ReceiptsRoot'
+		dst = append(dst, e.ReceiptsRoot[:]...)
+
+		// Field (4) 'LogsBloom'
+		dst = append(dst, e.LogsBloom[:]...)
+
+		// Field (5) 'PrevRandao'
+		dst = append(dst, e.PrevRandao[:]...)
+
+		// Field (6) 'BlockNumber'
+		dst = ssz.MarshalUint64(dst, e.BlockNumber)
+
+		// Field (7) 'GasLimit'
+		dst = ssz.MarshalUint64(dst, e.GasLimit)
+
+		// Field (8) 'GasUsed'
+		dst = ssz.MarshalUint64(dst, e.GasUsed)
+
+		// Field (9) 'Timestamp'
+		dst = ssz.MarshalUint64(dst, e.Timestamp)
+
+		// Offset (10) 'ExtraData'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(e.ExtraData)
+
+		// Field (11) 'BaseFeePerGas'
+		dst = append(dst, e.BaseFeePerGas[:]...)
+
+		// Field (12) 'BlockHash'
+		dst = append(dst, e.BlockHash[:]...)
+
+		// Offset (13) 'Transactions'
+		dst = ssz.WriteOffset(dst, offset)
+		for ii := 0; ii < len(e.Transactions); ii++ {
+			offset += 4
+			offset += len(e.Transactions[ii])
+		}
+
+		// Offset (14) 'Withdrawals'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (10) 'ExtraData'
+		if size := len(e.ExtraData); size > 32 {
+			err = ssz.ErrBytesLengthFn("ExecutionPayload.ExtraData", size, 32)
 			return
 		}
-		subIndx := hh.Index()
-		for _, i := range b.Slashings {
-			hh.AppendUint64(i)
+		if dst, err = sw.Append(dst, e.ExtraData); err != nil {
+			return
 		}
-		hh.Merkleize(subIndx)
-	}
 
-	// Field (15) 'PreviousEpochAttestations'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.PreviousEpochAttestations))
-		if num > 4096 {
-			err = ssz.ErrIncorrectListSize
+		// Field (13) 'Transactions'
+		if size := len(e.Transactions); size > 1048576 {
+			err = ssz.ErrListTooBigFn("ExecutionPayload.Transactions", size, 1048576)
 			return
 		}
-		for _, elem := range b.PreviousEpochAttestations {
-			if err = elem.HashTreeRootWith(hh); err != nil {
+		{
+			offset = 4 * len(e.Transactions)
+			for ii := 0; ii < len(e.Transactions); ii++ {
+				dst = ssz.WriteOffset(dst, offset)
+				offset += len(e.Transactions[ii])
+				if dst, err = sw.Flush(dst); err != nil {
+					return
+				}
+			}
+		}
+		for ii := 0; ii < len(e.Transactions); ii++ {
+			if size := len(e.Transactions[ii]); size > 1073741824 {
+				err = ssz.ErrBytesLengthFn("ExecutionPayload.Transactions[ii]", size, 1073741824)
+				return
+			}
+			if dst, err = sw.Append(dst, e.Transactions[ii]); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
 				return
 			}
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 4096)
-	}
 
-	// Field (16) 'CurrentEpochAttestations'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.CurrentEpochAttestations))
-		if num > 4096 {
-			err = ssz.ErrIncorrectListSize
+		// Field (14) 'Withdrawals'
+		if size := len(e.Withdrawals); size > 16 {
+			err = ssz.ErrListTooBigFn("ExecutionPayload.Withdrawals", size, 16)
 			return
 		}
-		for _, elem := range b.CurrentEpochAttestations {
-			if err = elem.HashTreeRootWith(hh); err != nil {
+		for ii := 0; ii < len(e.Withdrawals); ii++ {
+			if dst, err = e.Withdrawals[ii].MarshalSSZToStream(dst, sw); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
 				return
 			}
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 4096)
-	}
 
-	// Field (17) 'JustificationBits'
-	if size := len(b.JustificationBits); size != 1 {
-		err = ssz.ErrBytesLengthFn("BeaconState.JustificationBits", size, 1)
-		return
-	}
-	hh.PutBytes(b.JustificationBits)
+	default:
+		offset := int(508)
+		// Field (0) 'ParentHash'
+		dst = append(dst, e.ParentHash[:]...)
 
-	// Field (18) 'PreviousJustifiedCheckpoint'
-	if b.PreviousJustifiedCheckpoint == nil {
-		b.PreviousJustifiedCheckpoint = new(Checkpoint)
-	}
-	if err = b.PreviousJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
-		return
-	}
+		// Field (1) 'FeeRecipient'
+		dst = append(dst, e.FeeRecipient[:]...)
 
-	// Field (19) 'CurrentJustifiedCheckpoint'
-	if b.CurrentJustifiedCheckpoint == nil {
-		b.CurrentJustifiedCheckpoint = new(Checkpoint)
-	}
-	if err = b.CurrentJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
-		return
-	}
+		// Field (2) 'StateRoot'
+		dst = append(dst, e.StateRoot[:]...)
 
-	// Field (20) 'FinalizedCheckpoint'
-	if b.FinalizedCheckpoint == nil {
-		b.FinalizedCheckpoint = new(Checkpoint)
-	}
-	if err = b.FinalizedCheckpoint.HashTreeRootWith(hh); err != nil {
-		return
-	}
+		// Field (3) 'ReceiptsRoot'
+		dst = append(dst, e.ReceiptsRoot[:]...)
 
-	hh.Merkleize(indx)
+		// Field (4) 'LogsBloom'
+		dst = append(dst, e.LogsBloom[:]...)
+
+		// Field (5) 'PrevRandao'
+		dst = append(dst, e.PrevRandao[:]...)
+
+		// Field (6) 'BlockNumber'
+		dst = ssz.MarshalUint64(dst, e.BlockNumber)
+
+		// Field (7) 'GasLimit'
+		dst = ssz.MarshalUint64(dst, e.GasLimit)
+
+		// Field (8) 'GasUsed'
+		dst = ssz.MarshalUint64(dst, e.GasUsed)
+
+		// Field (9) 'Timestamp'
+		dst = ssz.MarshalUint64(dst, e.Timestamp)
+
+		// Offset (10) 'ExtraData'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(e.ExtraData)
+
+		// Field (11) 'BaseFeePerGas'
+		dst = append(dst, e.BaseFeePerGas[:]...)
+
+		// Field (12) 'BlockHash'
+		dst = append(dst, e.BlockHash[:]...)
+
+		// Offset (13) 'Transactions'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (10) 'ExtraData'
+		if size := len(e.ExtraData); size > 32 {
+			err = ssz.ErrBytesLengthFn("ExecutionPayload.ExtraData", size, 32)
+			return
+		}
+		if dst, err = sw.Append(dst, e.ExtraData); err != nil {
+			return
+		}
+
+		// Field (13) 'Transactions'
+		if size := len(e.Transactions); size > 1048576 {
+			err = ssz.ErrListTooBigFn("ExecutionPayload.Transactions", size, 1048576)
+			return
+		}
+		{
+			offset = 4 * len(e.Transactions)
+			for ii := 0; ii < len(e.Transactions); ii++ {
+				dst = ssz.WriteOffset(dst, offset)
+				offset += len(e.Transactions[ii])
+				if dst, err = sw.Flush(dst); err != nil {
+					return
+				}
+			}
+		}
+		for ii := 0; ii < len(e.Transactions); ii++ {
+			if size := len(e.Transactions[ii]); size > 1073741824 {
+				err = ssz.ErrBytesLengthFn("ExecutionPayload.Transactions[ii]", size, 1073741824)
+				return
+			}
+			if dst, err = sw.Append(dst, e.Transactions[ii]); err != nil {
+				return
+			}
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+	}
 	return
 }
 
-// GetTree ssz hashes the BeaconState object
-func (b *BeaconState) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(b)
+// UnmarshalSSZ ssz unmarshals the ExecutionPayload object
+func (e *ExecutionPayload) UnmarshalSSZ(buf []byte) error {
+	return e.UnmarshalSSZForkWithOptions(buf, ssz.ForkDeneb, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the ExecutionPayload object with the decoding options
+func (e *ExecutionPayload) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	return e.UnmarshalSSZForkWithOptions(buf, ssz.ForkDeneb, opts)
 }
 
-// MarshalSSZ ssz marshals the BeaconBlockBodyPhase0 object
-func (b *BeaconBlockBodyPhase0) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(b)
+// UnmarshalSSZFork ssz unmarshals the ExecutionPayload object for a given fork
+func (e *ExecutionPayload) UnmarshalSSZFork(buf []byte, fork ssz.Fork) error {
+	return e.UnmarshalSSZForkWithOptions(buf, fork, ssz.UnmarshalOptions{})
 }
 
-// MarshalSSZTo ssz marshals the BeaconBlockBodyPhase0 object to a target array
-func (b *BeaconBlockBodyPhase0) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := int(220)
+// UnmarshalSSZForkWithOptions ssz unmarshals the ExecutionPayload object for a given fork with the decoding options
+func (e *ExecutionPayload) UnmarshalSSZForkWithOptions(buf []byte, fork ssz.Fork, opts ssz.UnmarshalOptions) error {
+	var err error
+	switch {
+	case fork >= ssz.ForkDeneb:
+		size := uint64(len(buf))
+		if size < 528 {
+			return ssz.ErrSize
+		}
 
-	// Field (0) 'RandaoReveal'
-	if size := len(b.RandaoReveal); size != 96 {
-		err = ssz.ErrBytesLengthFn("BeaconBlockBodyPhase0.RandaoReveal", size, 96)
-		return
-	}
-	dst = append(dst, b.RandaoReveal...)
+		tail := buf
+		var o10, o13, o14 uint64
 
-	// Field (1) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
-		return
-	}
+		// Field (0) 'ParentHash'
+		copy(e.ParentHash[:], buf[0:32])
 
-	// Field (2) 'Graffiti'
-	dst = append(dst, b.Graffiti[:]...)
+		// Field (1) 'FeeRecipient'
+		copy(e.FeeRecipient[:], buf[32:52])
 
-	// Offset (3) 'ProposerSlashings'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.ProposerSlashings) * 416
+		// Field (2) 'StateRoot'
+		copy(e.StateRoot[:], buf[52:84])
 
-	// Offset (4) 'AttesterSlashings'
-	dst = ssz.WriteOffset(dst, offset)
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		offset += 4
-		offset += b.AttesterSlashings[ii].SizeSSZ()
-	}
+		// Field (3) 'ReceiptsRoot'
+		copy(e.ReceiptsRoot[:], buf[84:116])
 
-	// Offset (5) 'Attestations'
-	dst = ssz.WriteOffset(dst, offset)
-	for ii := 0; ii < len(b.Attestations); ii++ {
-		offset += 4
-		offset += b.Attestations[ii].SizeSSZ()
-	}
+		// Field (4) 'LogsBloom'
+		copy(e.LogsBloom[:], buf[116:372])
 
-	// Offset (6) 'Deposits'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Deposits) * 1240
+		// Field (5) 'PrevRandao'
+		copy(e.PrevRandao[:], buf[372:404])
 
-	// Offset (7) 'VoluntaryExits'
-	dst = ssz.WriteOffset(dst, offset)
+		// Field (6) 'BlockNumber'
+		e.BlockNumber = ssz.UnmarshallUint64(buf[404:412])
 
-	// Field (3) 'ProposerSlashings'
-	if size := len(b.ProposerSlashings); size > 16 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyPhase0.ProposerSlashings", size, 16)
-		return
-	}
-	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
-		if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
-			return
+		// Field (7) 'GasLimit'
+		e.GasLimit = ssz.UnmarshallUint64(buf[412:420])
+
+		// Field (8) 'GasUsed'
+		e.GasUsed = ssz.UnmarshallUint64(buf[420:428])
+
+		// Field (9) 'Timestamp'
+		e.Timestamp = ssz.UnmarshallUint64(buf[428:436])
+
+		// Offset (10) 'ExtraData'
+		if o10 = ssz.ReadOffset(buf[436:440]); o10 > size {
+			return ssz.ErrOffset
 		}
-	}
 
-	// Field (4) 'AttesterSlashings'
-	if size := len(b.AttesterSlashings); size > 2 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyPhase0.AttesterSlashings", size, 2)
-		return
-	}
-	{
-		offset = 4 * len(b.AttesterSlashings)
-		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += b.AttesterSlashings[ii].SizeSSZ()
+		if err = opts.ValidateFirstOffset(o10, 528); err != nil {
+			return err
 		}
-	}
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		if dst, err = b.AttesterSlashings[ii].MarshalSSZTo(dst); err != nil {
-			return
+
+		// Field (11) 'BaseFeePerGas'
+		copy(e.BaseFeePerGas[:], buf[440:472])
+
+		// Field (12) 'BlockHash'
+		copy(e.BlockHash[:], buf[472:504])
+
+		// Offset (13) 'Transactions'
+		if o13 = ssz.ReadOffset(buf[504:508]); o13 > size || o10 > o13 {
+			return ssz.ErrOffset
 		}
-	}
 
-	// Field (5) 'Attestations'
-	if size := len(b.Attestations); size > 128 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyPhase0.Attestations", size, 128)
-		return
-	}
-	{
-		offset = 4 * len(b.Attestations)
-		for ii := 0; ii < len(b.Attestations); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += b.Attestations[ii].SizeSSZ()
+		// Offset (14) 'Withdrawals'
+		if o14 = ssz.ReadOffset(buf[508:512]); o14 > size || o13 > o14 {
+			return ssz.ErrOffset
 		}
-	}
-	for ii := 0; ii < len(b.Attestations); ii++ {
-		if dst, err = b.Attestations[ii].MarshalSSZTo(dst); err != nil {
-			return
+
+		// Field (15) 'BlobGasUsed'
+		e.BlobGasUsed = ssz.UnmarshallUint64(buf[512:520])
+
+		// Field (16) 'ExcessBlobGas'
+		e.ExcessBlobGas = ssz.UnmarshallUint64(buf[520:528])
+
+		// Field (10) 'ExtraData'
+		{
+			buf = tail[o10:o13]
+			if len(buf) > 32 {
+				return ssz.ErrBytesLength
+			}
+			if cap(e.ExtraData) == 0 {
+				e.ExtraData = make([]byte, 0, len(buf))
+			}
+			e.ExtraData = append(e.ExtraData, buf...)
 		}
-	}
 
-	// Field (6) 'Deposits'
-	if size := len(b.Deposits); size > 16 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyPhase0.Deposits", size, 16)
-		return
-	}
-	for ii := 0; ii < len(b.Deposits); ii++ {
-		if dst, err = b.Deposits[ii].MarshalSSZTo(dst); err != nil {
-			return
+		// Field (13) 'Transactions'
+		{
+			buf = tail[o13:o14]
+			num, err := opts.DecodeDynamicLength(buf, 1048576)
+			if err != nil {
+				return err
+			}
+			e.Transactions = make([][]byte, num)
+			err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+				if len(buf) > 1073741824 {
+					return ssz.ErrBytesLength
+				}
+				if cap(e.Transactions[indx]) == 0 {
+					e.Transactions[indx] = make([]byte, 0, len(buf))
+				}
+				e.Transactions[indx] = append(e.Transactions[indx], buf...)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
 		}
-	}
 
-	// Field (7) 'VoluntaryExits'
-	if size := len(b.VoluntaryExits); size > 16 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyPhase0.VoluntaryExits", size, 16)
-		return
-	}
-	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
-		if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
-			return
+		// Field (14) 'Withdrawals'
+		{
+			buf = tail[o14:]
+			num, err := ssz.DivideInt2(len(buf), 44, 16)
+			if err != nil {
+				return err
+			}
+			e.Withdrawals = make([]*Withdrawal, num)
+			for ii := 0; ii < num; ii++ {
+				if e.Withdrawals[ii] == nil {
+					e.Withdrawals[ii] = new(Withdrawal)
+				}
+				if err = e.Withdrawals[ii].UnmarshalSSZWithOptions(buf[ii*44:(ii+1)*44], opts); err != nil {
+					return err
+				}
+			}
+		}
+	case fork >= ssz.ForkCapella:
+		size := uint64(len(buf))
+		if size < 512 {
+			return ssz.ErrSize
 		}
-	}
 
-	return
-}
+		tail := buf
+		var o10, o13, o14 uint64
 
-// UnmarshalSSZ ssz unmarshals the BeaconBlockBodyPhase0 object
-func (b *BeaconBlockBodyPhase0) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size < 220 {
-		return ssz.ErrSize
-	}
+		// Field (0) 'ParentHash'
+		copy(e.ParentHash[:], buf[0:32])
 
-	tail := buf
-	var o3, o4, o5, o6, o7 uint64
+		// Field (1) 'FeeRecipient'
+		copy(e.FeeRecipient[:], buf[32:52])
 
-	// Field (0) 'RandaoReveal'
-	if cap(b.RandaoReveal) == 0 {
-		b.RandaoReveal = make([]byte, 0, len(buf[0:96]))
-	}
-	b.RandaoReveal = append(b.RandaoReveal, buf[0:96]...)
+		// Field (2) 'StateRoot'
+		copy(e.StateRoot[:], buf[52:84])
 
-	// Field (1) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if err = b.Eth1Data.UnmarshalSSZ(buf[96:168]); err != nil {
-		return err
-	}
+		// Field (3) 'ReceiptsRoot'
+		copy(e.ReceiptsRoot[:], buf[84:116])
 
-	// Field (2) 'Graffiti'
-	copy(b.Graffiti[:], buf[168:200])
+		// Field (4) 'LogsBloom'
+		copy(e.LogsBloom[:], buf[116:372])
 
-	// Offset (3) 'ProposerSlashings'
-	if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
-		return ssz.ErrOffset
-	}
+		// Field (5) 'PrevRandao'
+		copy(e.PrevRandao[:], buf[372:404])
 
-	if o3 < 220 {
-		return ssz.ErrInvalidVariableOffset
-	}
+		// Field (6) 'BlockNumber'
+		e.BlockNumber = ssz.UnmarshallUint64(buf[404:412])
 
-	// Offset (4) 'AttesterSlashings'
-	if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
-		return ssz.ErrOffset
-	}
+		// Field (7) 'GasLimit'
+		e.GasLimit = ssz.UnmarshallUint64(buf[412:420])
 
-	// Offset (5) 'Attestations'
-	if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
-		return ssz.ErrOffset
-	}
+		// Field (8) 'GasUsed'
+		e.GasUsed = ssz.UnmarshallUint64(buf[420:428])
 
-	// Offset (6) 'Deposits'
-	if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
-		return ssz.ErrOffset
-	}
+		// Field (9) 'Timestamp'
+		e.Timestamp = ssz.UnmarshallUint64(buf[428:436])
 
-	// Offset (7) 'VoluntaryExits'
-	if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
-		return ssz.ErrOffset
-	}
+		// Offset (10) 'ExtraData'
+		if o10 = ssz.ReadOffset(buf[436:440]); o10 > size {
+			return ssz.ErrOffset
+		}
 
-	// Field (3) 'ProposerSlashings'
-	{
-		buf = tail[o3:o4]
-		num, err := ssz.DivideInt2(len(buf), 416, 16)
-		if err != nil {
+		if err = opts.ValidateFirstOffset(o10, 512); err != nil {
 			return err
 		}
-		b.ProposerSlashings = make([]*ProposerSlashing, num)
-		for ii := 0; ii < num; ii++ {
-			if b.ProposerSlashings[ii] == nil {
-				b.ProposerSlashings[ii] = new(ProposerSlashing)
+
+		// Field (11) 'BaseFeePerGas'
+		copy(e.BaseFeePerGas[:], buf[440:472])
+
+		// Field (12) 'BlockHash'
+		copy(e.BlockHash[:], buf[472:504])
+
+		// Offset (13) 'Transactions'
+		if o13 = ssz.ReadOffset(buf[504:508]); o13 > size || o10 > o13 {
+			return ssz.ErrOffset
+		}
+
+		// Offset (14) 'Withdrawals'
+		if o14 = ssz.ReadOffset(buf[508:512]); o14 > size || o13 > o14 {
+			return ssz.ErrOffset
+		}
+
+		// Field (10) 'ExtraData'
+		{
+			buf = tail[o10:o13]
+			if len(buf) > 32 {
+				return ssz.ErrBytesLength
 			}
-			if err = b.ProposerSlashings[ii].UnmarshalSSZ(buf[ii*416 : (ii+1)*416]); err != nil {
-				return err
+			if cap(e.ExtraData) == 0 {
+				e.ExtraData = make([]byte, 0, len(buf))
 			}
+			e.ExtraData = append(e.ExtraData, buf...)
 		}
-	}
 
-	// Field (4) 'AttesterSlashings'
-	{
-		buf = tail[o4:o5]
-		num, err := ssz.DecodeDynamicLength(buf, 2)
-		if err != nil {
-			return err
-		}
-		b.AttesterSlashings = make([]*AttesterSlashing, num)
-		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
-			if b.AttesterSlashings[indx] == nil {
-				b.AttesterSlashings[indx] = new(AttesterSlashing)
+		// Field (13) 'Transactions'
+		{
+			buf = tail[o13:o14]
+			num, err := opts.DecodeDynamicLength(buf, 1048576)
+			if err != nil {
+				return err
 			}
-			if err = b.AttesterSlashings[indx].UnmarshalSSZ(buf); err != nil {
+			e.Transactions = make([][]byte, num)
+			err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+				if len(buf) > 1073741824 {
+					return ssz.ErrBytesLength
+				}
+				if cap(e.Transactions[indx]) == 0 {
+					e.Transactions[indx] = make([]byte, 0, len(buf))
+				}
+				e.Transactions[indx] = append(e.Transactions[indx], buf...)
+				return nil
+			})
+			if err != nil {
 				return err
 			}
-			return nil
-		})
-		if err != nil {
-			return err
 		}
-	}
 
-	// Field (5) 'Attestations'
-	{
-		buf = tail[o5:o6]
-		num, err := ssz.DecodeDynamicLength(buf, 128)
-		if err != nil {
-			return err
-		}
-		b.Attestations = make([]*Attestation, num)
-		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
-			if b.Attestations[indx] == nil {
-				b.Attestations[indx] = new(Attestation)
-			}
-			if err = b.Attestations[indx].UnmarshalSSZ(buf); err != nil {
+		// Field (14) 'Withdrawals'
+		{
+			buf = tail[o14:]
+			num, err := ssz.DivideInt2(len(buf), 44, 16)
+			if err != nil {
 				return err
 			}
-			return nil
-		})
-		if err != nil {
-			return err
+			e.Withdrawals = make([]*Withdrawal, num)
+			for ii := 0; ii < num; ii++ {
+				if e.Withdrawals[ii] == nil {
+					e.Withdrawals[ii] = new(Withdrawal)
+				}
+				if err = e.Withdrawals[ii].UnmarshalSSZWithOptions(buf[ii*44:(ii+1)*44], opts); err != nil {
+					return err
+				}
+			}
+		}
+	default:
+		size := uint64(len(buf))
+		if size < 508 {
+			return ssz.ErrSize
 		}
-	}
 
-	// Field (6) 'Deposits'
-	{
-		buf = tail[o6:o7]
-		num, err := ssz.DivideInt2(len(buf), 1240, 16)
-		if err != nil {
+		tail := buf
+		var o10, o13 uint64
+
+		// Field (0) 'ParentHash'
+		copy(e.ParentHash[:], buf[0:32])
+
+		// Field (1) 'FeeRecipient'
+		copy(e.FeeRecipient[:], buf[32:52])
+
+		// Field (2) 'StateRoot'
+		copy(e.StateRoot[:], buf[52:84])
+
+		// Field (3) 'ReceiptsRoot'
+		copy(e.ReceiptsRoot[:], buf[84:116])
+
+		// Field (4) 'LogsBloom'
+		copy(e.LogsBloom[:], buf[116:372])
+
+		// Field (5) 'PrevRandao'
+		copy(e.PrevRandao[:], buf[372:404])
+
+		// Field (6) 'BlockNumber'
+		e.BlockNumber = ssz.UnmarshallUint64(buf[404:412])
+
+		// Field (7) 'GasLimit'
+		e.GasLimit = ssz.UnmarshallUint64(buf[412:420])
+
+		// Field (8) 'GasUsed'
+		e.GasUsed = ssz.UnmarshallUint64(buf[420:428])
+
+		// Field (9) 'Timestamp'
+		e.Timestamp = ssz.UnmarshallUint64(buf[428:436])
+
+		// Offset (10) 'ExtraData'
+		if o10 = ssz.ReadOffset(buf[436:440]); o10 > size {
+			return ssz.ErrOffset
+		}
+
+		if err = opts.ValidateFirstOffset(o10, 508); err != nil {
 			return err
 		}
-		b.Deposits = make([]*Deposit, num)
-		for ii := 0; ii < num; ii++ {
-			if b.Deposits[ii] == nil {
-				b.Deposits[ii] = new(Deposit)
+
+		// Field (11) 'BaseFeePerGas'
+		copy(e.BaseFeePerGas[:], buf[440:472])
+
+		// Field (12) 'BlockHash'
+		copy(e.BlockHash[:], buf[472:504])
+
+		// Offset (13) 'Transactions'
+		if o13 = ssz.ReadOffset(buf[504:508]); o13 > size || o10 > o13 {
+			return ssz.ErrOffset
+		}
+
+		// Field (10) 'ExtraData'
+		{
+			buf = tail[o10:o13]
+			if len(buf) > 32 {
+				return ssz.ErrBytesLength
 			}
-			if err = b.Deposits[ii].UnmarshalSSZ(buf[ii*1240 : (ii+1)*1240]); err != nil {
-				return err
+			if cap(e.ExtraData) == 0 {
+				e.ExtraData = make([]byte, 0, len(buf))
 			}
+			e.ExtraData = append(e.ExtraData, buf...)
 		}
-	}
 
-	// Field (7) 'VoluntaryExits'
-	{
-		buf = tail[o7:]
-		num, err := ssz.DivideInt2(len(buf), 112, 16)
-		if err != nil {
-			return err
-		}
-		b.VoluntaryExits = make([]*SignedVoluntaryExit, num)
-		for ii := 0; ii < num; ii++ {
-			if b.VoluntaryExits[ii] == nil {
-				b.VoluntaryExits[ii] = new(SignedVoluntaryExit)
+		// Field (13) 'Transactions'
+		{
+			buf = tail[o13:]
+			num, err := opts.DecodeDynamicLength(buf, 1048576)
+			if err != nil {
+				return err
 			}
-			if err = b.VoluntaryExits[ii].UnmarshalSSZ(buf[ii*112 : (ii+1)*112]); err != nil {
+			e.Transactions = make([][]byte, num)
+			err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+				if len(buf) > 1073741824 {
+					return ssz.ErrBytesLength
+				}
+				if cap(e.Transactions[indx]) == 0 {
+					e.Transactions[indx] = make([]byte, 0, len(buf))
+				}
+				e.Transactions[indx] = append(e.Transactions[indx], buf...)
+				return nil
+			})
+			if err != nil {
 				return err
 			}
 		}
@@ -3445,4989 +18965,5480 @@ func (b *BeaconBlockBodyPhase0) UnmarshalSSZ(buf []byte) error {
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBodyPhase0 object
-func (b *BeaconBlockBodyPhase0) SizeSSZ() (size int) {
-	size = 220
+// SizeSSZ returns the ssz encoded size in bytes for the ExecutionPayload object
+func (e *ExecutionPayload) SizeSSZ() (size int) {
+	return e.SizeSSZFork(ssz.ForkDeneb)
+}
 
-	// Field (3) 'ProposerSlashings'
-	size += len(b.ProposerSlashings) * 416
+// SizeSSZFork returns the ssz encoded size in bytes for the ExecutionPayload object for a given fork
+func (e *ExecutionPayload) SizeSSZFork(fork ssz.Fork) (size int) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		size = 528
+		// Field (10) 'ExtraData'
+		size += len(e.ExtraData)
 
-	// Field (4) 'AttesterSlashings'
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		size += 4
-		size += b.AttesterSlashings[ii].SizeSSZ()
-	}
+		// Field (13) 'Transactions'
+		for ii := 0; ii < len(e.Transactions); ii++ {
+			size += 4
+			size += len(e.Transactions[ii])
+		}
 
-	// Field (5) 'Attestations'
-	for ii := 0; ii < len(b.Attestations); ii++ {
-		size += 4
-		size += b.Attestations[ii].SizeSSZ()
-	}
+		// Field (14) 'Withdrawals'
+		size += len(e.Withdrawals) * 44
+	case fork >= ssz.ForkCapella:
+		size = 512
+		// Field (10) 'ExtraData'
+		size += len(e.ExtraData)
 
-	// Field (6) 'Deposits'
-	size += len(b.Deposits) * 1240
+		// Field (13) 'Transactions'
+		for ii := 0; ii < len(e.Transactions); ii++ {
+			size += 4
+			size += len(e.Transactions[ii])
+		}
 
-	// Field (7) 'VoluntaryExits'
-	size += len(b.VoluntaryExits) * 112
+		// Field (14) 'Withdrawals'
+		size += len(e.Withdrawals) * 44
+	default:
+		size = 508
+		// Field (10) 'ExtraData'
+		size += len(e.ExtraData)
 
+		// Field (13) 'Transactions'
+		for ii := 0; ii < len(e.Transactions); ii++ {
+			size += 4
+			size += len(e.Transactions[ii])
+		}
+	}
 	return
 }
 
-// HashTreeRoot ssz hashes the BeaconBlockBodyPhase0 object
-func (b *BeaconBlockBodyPhase0) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(b)
+// MinSSZSize returns the minimum ssz encoded size in bytes for the ExecutionPayload object
+func (e *ExecutionPayload) MinSSZSize() uint64 {
+	return e.MinSSZSizeFork(ssz.ForkDeneb)
 }
 
-// HashTreeRootWith ssz hashes the BeaconBlockBodyPhase0 object with a hasher
-func (b *BeaconBlockBodyPhase0) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
-
-	// Field (0) 'RandaoReveal'
-	if size := len(b.RandaoReveal); size != 96 {
-		err = ssz.ErrBytesLengthFn("BeaconBlockBodyPhase0.RandaoReveal", size, 96)
-		return
+// MinSSZSizeFork returns the minimum ssz encoded size in bytes for the ExecutionPayload object for a given fork
+func (e *ExecutionPayload) MinSSZSizeFork(fork ssz.Fork) uint64 {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 528
+	case fork >= ssz.ForkCapella:
+		return 512
+	default:
+		return 508
 	}
-	hh.PutBytes(b.RandaoReveal)
+}
 
-	// Field (1) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
-		return
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the ExecutionPayload object
+func (e *ExecutionPayload) MaxSSZSize() uint64 {
+	return e.MaxSSZSizeFork(ssz.ForkDeneb)
+}
+
+// MaxSSZSizeFork returns the maximum ssz encoded size in bytes for the ExecutionPayload object for a given fork
+func (e *ExecutionPayload) MaxSSZSizeFork(fork ssz.Fork) uint64 {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 1125899911038192
+	case fork >= ssz.ForkCapella:
+		return 1125899911038176
+	default:
+		return 1125899911037468
 	}
+}
 
-	// Field (2) 'Graffiti'
-	hh.PutBytes(b.Graffiti[:])
+// HashTreeRoot ssz hashes the ExecutionPayload object
+func (e *ExecutionPayload) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(e)
+}
 
-	// Field (3) 'ProposerSlashings'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.ProposerSlashings))
-		if num > 16 {
-			err = ssz.ErrIncorrectListSize
-			return
+// HashTreeRootWith ssz hashes the ExecutionPayload object with a hasher
+func (e *ExecutionPayload) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	return e.HashTreeRootWithFork(hh, ssz.ForkDeneb)
+}
+
+// HashTreeRootFork ssz hashes the ExecutionPayload object for a given fork
+func (e *ExecutionPayload) HashTreeRootFork(fork ssz.Fork) ([32]byte, error) {
+	return ssz.HashWithDefaultHasherFork(e, fork)
+}
+
+// HashTreeRootWithFork ssz hashes the ExecutionPayload object with a hasher for a given fork
+func (e *ExecutionPayload) HashTreeRootWithFork(hh ssz.HashWalker, fork ssz.Fork) (err error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		indx := hh.Index()
+
+		// Field (0) 'ParentHash'
+		hh.PutBytes(e.ParentHash[:])
+
+		// Field (1) 'FeeRecipient'
+		hh.PutBytes(e.FeeRecipient[:])
+
+		// Field (2) 'StateRoot'
+		hh.PutBytes(e.StateRoot[:])
+
+		// Field (3) 'ReceiptsRoot'
+		hh.PutBytes(e.ReceiptsRoot[:])
+
+		// Field (4) 'LogsBloom'
+		hh.PutBytes(e.LogsBloom[:])
+
+		// Field (5) 'PrevRandao'
+		hh.PutBytes(e.PrevRandao[:])
+
+		// Field (6) 'BlockNumber'
+		hh.PutUint64(e.BlockNumber)
+
+		// Field (7) 'GasLimit'
+		hh.PutUint64(e.GasLimit)
+
+		// Field (8) 'GasUsed'
+		hh.PutUint64(e.GasUsed)
+
+		// Field (9) 'Timestamp'
+		hh.PutUint64(e.Timestamp)
+
+		// Field (10) 'ExtraData'
+		{
+			elemIndx := hh.Index()
+			byteLen := uint64(len(e.ExtraData))
+			if byteLen > 32 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			hh.Append(e.ExtraData)
+			hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
 		}
-		for _, elem := range b.ProposerSlashings {
-			if err = elem.HashTreeRootWith(hh); err != nil {
+
+		// Field (11) 'BaseFeePerGas'
+		hh.PutBytes(e.BaseFeePerGas[:])
+
+		// Field (12) 'BlockHash'
+		hh.PutBytes(e.BlockHash[:])
+
+		// Field (13) 'Transactions'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(e.Transactions))
+			if num > 1048576 {
+				err = ssz.ErrIncorrectListSize
 				return
 			}
+			for _, elem := range e.Transactions {
+				{
+					elemIndx := hh.Index()
+					byteLen := uint64(len(elem))
+					if byteLen > 1073741824 {
+						err = ssz.ErrIncorrectListSize
+						return
+					}
+					hh.AppendBytes32(elem)
+					hh.MerkleizeWithMixin(elemIndx, byteLen, (1073741824+31)/32)
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 1048576)
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 16)
-	}
 
-	// Field (4) 'AttesterSlashings'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.AttesterSlashings))
-		if num > 2 {
-			err = ssz.ErrIncorrectListSize
-			return
+		// Field (14) 'Withdrawals'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(e.Withdrawals))
+			if num > 16 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			for _, elem := range e.Withdrawals {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 16)
 		}
-		for _, elem := range b.AttesterSlashings {
-			if err = elem.HashTreeRootWith(hh); err != nil {
+
+		// Field (15) 'BlobGasUsed'
+		hh.PutUint64(e.BlobGasUsed)
+
+		// Field (16) 'ExcessBlobGas'
+		hh.PutUint64(e.ExcessBlobGas)
+
+		hh.Merkleize(indx)
+	case fork >= ssz.ForkCapella:
+		indx := hh.Index()
+
+		// Field (0) 'ParentHash'
+		hh.PutBytes(e.ParentHash[:])
+
+		// Field (1) 'FeeRecipient'
+		hh.PutBytes(e.FeeRecipient[:])
+
+		// Field (2) 'StateRoot'
+		hh.PutBytes(e.StateRoot[:])
+
+		// Field (3) 'ReceiptsRoot'
+		hh.PutBytes(e.ReceiptsRoot[:])
+
+		// Field (4) 'LogsBloom'
+		hh.PutBytes(e.LogsBloom[:])
+
+		// Field (5) 'PrevRandao'
+		hh.PutBytes(e.PrevRandao[:])
+
+		// Field (6) 'BlockNumber'
+		hh.PutUint64(e.BlockNumber)
+
+		// Field (7) 'GasLimit'
+		hh.PutUint64(e.GasLimit)
+
+		// Field (8) 'GasUsed'
+		hh.PutUint64(e.GasUsed)
+
+		// Field (9) 'Timestamp'
+		hh.PutUint64(e.Timestamp)
+
+		// Field (10) 'ExtraData'
+		{
+			elemIndx := hh.Index()
+			byteLen := uint64(len(e.ExtraData))
+			if byteLen > 32 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			hh.Append(e.ExtraData)
+			hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+		}
+
+		// Field (11) 'BaseFeePerGas'
+		hh.PutBytes(e.BaseFeePerGas[:])
+
+		// Field (12) 'BlockHash'
+		hh.PutBytes(e.BlockHash[:])
+
+		// Field (13) 'Transactions'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(e.Transactions))
+			if num > 1048576 {
+				err = ssz.ErrIncorrectListSize
 				return
 			}
+			for _, elem := range e.Transactions {
+				{
+					elemIndx := hh.Index()
+					byteLen := uint64(len(elem))
+					if byteLen > 1073741824 {
+						err = ssz.ErrIncorrectListSize
+						return
+					}
+					hh.AppendBytes32(elem)
+					hh.MerkleizeWithMixin(elemIndx, byteLen, (1073741824+31)/32)
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 1048576)
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 2)
-	}
 
-	// Field (5) 'Attestations'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.Attestations))
-		if num > 128 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.Attestations {
-			if err = elem.HashTreeRootWith(hh); err != nil {
+		// Field (14) 'Withdrawals'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(e.Withdrawals))
+			if num > 16 {
+				err = ssz.ErrIncorrectListSize
 				return
 			}
+			for _, elem := range e.Withdrawals {
+				if err = elem.HashTreeRootWith(hh); err != nil {
+					return
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 16)
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 128)
-	}
 
-	// Field (6) 'Deposits'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.Deposits))
-		if num > 16 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.Deposits {
-			if err = elem.HashTreeRootWith(hh); err != nil {
+		hh.Merkleize(indx)
+	default:
+		indx := hh.Index()
+
+		// Field (0) 'ParentHash'
+		hh.PutBytes(e.ParentHash[:])
+
+		// Field (1) 'FeeRecipient'
+		hh.PutBytes(e.FeeRecipient[:])
+
+		// Field (2) 'StateRoot'
+		hh.PutBytes(e.StateRoot[:])
+
+		// Field (3) 'ReceiptsRoot'
+		hh.PutBytes(e.ReceiptsRoot[:])
+
+		// Field (4) 'LogsBloom'
+		hh.PutBytes(e.LogsBloom[:])
+
+		// Field (5) 'PrevRandao'
+		hh.PutBytes(e.PrevRandao[:])
+
+		// Field (6) 'BlockNumber'
+		hh.PutUint64(e.BlockNumber)
+
+		// Field (7) 'GasLimit'
+		hh.PutUint64(e.GasLimit)
+
+		// Field (8) 'GasUsed'
+		hh.PutUint64(e.GasUsed)
+
+		// Field (9) 'Timestamp'
+		hh.PutUint64(e.Timestamp)
+
+		// Field (10) 'ExtraData'
+		{
+			elemIndx := hh.Index()
+			byteLen := uint64(len(e.ExtraData))
+			if byteLen > 32 {
+				err = ssz.ErrIncorrectListSize
 				return
 			}
+			hh.Append(e.ExtraData)
+			hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 16)
-	}
 
-	// Field (7) 'VoluntaryExits'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.VoluntaryExits))
-		if num > 16 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.VoluntaryExits {
-			if err = elem.HashTreeRootWith(hh); err != nil {
+		// Field (11) 'BaseFeePerGas'
+		hh.PutBytes(e.BaseFeePerGas[:])
+
+		// Field (12) 'BlockHash'
+		hh.PutBytes(e.BlockHash[:])
+
+		// Field (13) 'Transactions'
+		{
+			subIndx := hh.Index()
+			num := uint64(len(e.Transactions))
+			if num > 1048576 {
+				err = ssz.ErrIncorrectListSize
 				return
 			}
+			for _, elem := range e.Transactions {
+				{
+					elemIndx := hh.Index()
+					byteLen := uint64(len(elem))
+					if byteLen > 1073741824 {
+						err = ssz.ErrIncorrectListSize
+						return
+					}
+					hh.AppendBytes32(elem)
+					hh.MerkleizeWithMixin(elemIndx, byteLen, (1073741824+31)/32)
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, num, 1048576)
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 16)
-	}
 
-	hh.Merkleize(indx)
+		hh.Merkleize(indx)
+	}
 	return
 }
 
-// GetTree ssz hashes the BeaconBlockBodyPhase0 object
-func (b *BeaconBlockBodyPhase0) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(b)
+// HashTreeRootSSZ ssz hashes the encoded ExecutionPayload object without decoding it
+func (e *ExecutionPayload) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(e, buf)
 }
 
-// MarshalSSZ ssz marshals the BeaconBlockBodyAltair object
-func (b *BeaconBlockBodyAltair) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(b)
+// HashTreeRootSSZWith ssz hashes the encoded ExecutionPayload object with a hasher
+func (e *ExecutionPayload) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	return e.HashTreeRootSSZWithFork(hh, buf, ssz.ForkDeneb)
 }
 
-// MarshalSSZTo ssz marshals the BeaconBlockBodyAltair object to a target array
-func (b *BeaconBlockBodyAltair) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := int(380)
+// HashTreeRootSSZFork ssz hashes the encoded ExecutionPayload object for a given fork without decoding it
+func (e *ExecutionPayload) HashTreeRootSSZFork(buf []byte, fork ssz.Fork) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasherFork(e, buf, fork)
+}
 
-	// Field (0) 'RandaoReveal'
-	if size := len(b.RandaoReveal); size != 96 {
-		err = ssz.ErrBytesLengthFn("BeaconBlockBodyAltair.RandaoReveal", size, 96)
-		return
-	}
-	dst = append(dst, b.RandaoReveal...)
+// HashTreeRootSSZWithFork ssz hashes the encoded ExecutionPayload object with a hasher for a given fork
+func (e *ExecutionPayload) HashTreeRootSSZWithFork(hh ssz.HashWalker, buf []byte, fork ssz.Fork) (err error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		size := uint64(len(buf))
+		if size < 528 {
+			return ssz.ErrSize
+		}
 
-	// Field (1) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
-		return
-	}
+		tail := buf
+		var o10, o13, o14 uint64
 
-	// Field (2) 'Graffiti'
-	dst = append(dst, b.Graffiti[:]...)
+		// Offset (10) 'ExtraData'
+		if o10 = ssz.ReadOffset(buf[436:440]); o10 > size {
+			return ssz.ErrOffset
+		}
 
-	// Offset (3) 'ProposerSlashings'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.ProposerSlashings) * 416
+		if err = ssz.ValidateFirstOffset(o10, 528); err != nil {
+			return err
+		}
 
-	// Offset (4) 'AttesterSlashings'
-	dst = ssz.WriteOffset(dst, offset)
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		offset += 4
-		offset += b.AttesterSlashings[ii].SizeSSZ()
-	}
+		// Offset (13) 'Transactions'
+		if o13 = ssz.ReadOffset(buf[504:508]); o13 > size || o10 > o13 {
+			return ssz.ErrOffset
+		}
 
-	// Offset (5) 'Attestations'
-	dst = ssz.WriteOffset(dst, offset)
-	for ii := 0; ii < len(b.Attestations); ii++ {
-		offset += 4
-		offset += b.Attestations[ii].SizeSSZ()
-	}
+		// Offset (14) 'Withdrawals'
+		if o14 = ssz.ReadOffset(buf[508:512]); o14 > size || o13 > o14 {
+			return ssz.ErrOffset
+		}
 
-	// Offset (6) 'Deposits'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Deposits) * 1240
+		indx := hh.Index()
 
-	// Offset (7) 'VoluntaryExits'
-	dst = ssz.WriteOffset(dst, offset)
+		// Field (0) 'ParentHash'
+		hh.PutBytes(buf[0:32])
 
-	// Field (8) 'SyncAggregate'
-	if b.SyncAggregate == nil {
-		b.SyncAggregate = new(SyncAggregate)
-	}
-	if dst, err = b.SyncAggregate.MarshalSSZTo(dst); err != nil {
-		return
-	}
+		// Field (1) 'FeeRecipient'
+		hh.PutBytes(buf[32:52])
 
-	// Field (3) 'ProposerSlashings'
-	if size := len(b.ProposerSlashings); size > 16 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyAltair.ProposerSlashings", size, 16)
-		return
-	}
-	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
-		if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
-	}
+		// Field (2) 'StateRoot'
+		hh.PutBytes(buf[52:84])
 
-	// Field (4) 'AttesterSlashings'
-	if size := len(b.AttesterSlashings); size > 2 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyAltair.AttesterSlashings", size, 2)
-		return
-	}
-	{
-		offset = 4 * len(b.AttesterSlashings)
-		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += b.AttesterSlashings[ii].SizeSSZ()
+		// Field (3) 'ReceiptsRoot'
+		hh.PutBytes(buf[84:116])
+
+		// Field (4) 'LogsBloom'
+		hh.PutBytes(buf[116:372])
+
+		// Field (5) 'PrevRandao'
+		hh.PutBytes(buf[372:404])
+
+		// Field (6) 'BlockNumber'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[404:412]))
+
+		// Field (7) 'GasLimit'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[412:420]))
+
+		// Field (8) 'GasUsed'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[420:428]))
+
+		// Field (9) 'Timestamp'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[428:436]))
+
+		// Field (10) 'ExtraData'
+		{
+			buf := tail[o10:o13]
+			if len(buf) > 32 {
+				return ssz.ErrBytesLength
+			}
+			{
+				elemIndx := hh.Index()
+				hh.Append(buf)
+				hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (32+31)/32)
+			}
 		}
-	}
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		if dst, err = b.AttesterSlashings[ii].MarshalSSZTo(dst); err != nil {
-			return
+
+		// Field (11) 'BaseFeePerGas'
+		hh.PutBytes(buf[440:472])
+
+		// Field (12) 'BlockHash'
+		hh.PutBytes(buf[472:504])
+
+		// Field (13) 'Transactions'
+		{
+			buf := tail[o13:o14]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DecodeDynamicLength(buf, 1048576)
+				if err != nil {
+					return err
+				}
+				err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+					if len(buf) > 1073741824 {
+						return ssz.ErrBytesLength
+					}
+					{
+						elemIndx := hh.Index()
+						hh.AppendBytes32(buf)
+						hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (1073741824+31)/32)
+					}
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 1048576)
+			}
 		}
-	}
 
-	// Field (5) 'Attestations'
-	if size := len(b.Attestations); size > 128 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyAltair.Attestations", size, 128)
-		return
-	}
-	{
-		offset = 4 * len(b.Attestations)
-		for ii := 0; ii < len(b.Attestations); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += b.Attestations[ii].SizeSSZ()
+		// Field (14) 'Withdrawals'
+		{
+			buf := tail[o14:]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 44, 16)
+				if err != nil {
+					return err
+				}
+				for ii := 0; ii < num; ii++ {
+					if err = (*Withdrawal)(nil).HashTreeRootSSZWith(hh, buf[ii*44:(ii+1)*44]); err != nil {
+						return err
+					}
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 16)
+			}
 		}
-	}
-	for ii := 0; ii < len(b.Attestations); ii++ {
-		if dst, err = b.Attestations[ii].MarshalSSZTo(dst); err != nil {
-			return
+
+		// Field (15) 'BlobGasUsed'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[512:520]))
+
+		// Field (16) 'ExcessBlobGas'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[520:528]))
+
+		hh.Merkleize(indx)
+	case fork >= ssz.ForkCapella:
+		size := uint64(len(buf))
+		if size < 512 {
+			return ssz.ErrSize
 		}
-	}
 
-	// Field (6) 'Deposits'
-	if size := len(b.Deposits); size > 16 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyAltair.Deposits", size, 16)
-		return
-	}
-	for ii := 0; ii < len(b.Deposits); ii++ {
-		if dst, err = b.Deposits[ii].MarshalSSZTo(dst); err != nil {
-			return
+		tail := buf
+		var o10, o13, o14 uint64
+
+		// Offset (10) 'ExtraData'
+		if o10 = ssz.ReadOffset(buf[436:440]); o10 > size {
+			return ssz.ErrOffset
 		}
-	}
 
-	// Field (7) 'VoluntaryExits'
-	if size := len(b.VoluntaryExits); size > 16 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyAltair.VoluntaryExits", size, 16)
-		return
-	}
-	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
-		if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
-			return
+		if err = ssz.ValidateFirstOffset(o10, 512); err != nil {
+			return err
 		}
-	}
 
-	return
-}
+		// Offset (13) 'Transactions'
+		if o13 = ssz.ReadOffset(buf[504:508]); o13 > size || o10 > o13 {
+			return ssz.ErrOffset
+		}
 
-// UnmarshalSSZ ssz unmarshals the BeaconBlockBodyAltair object
-func (b *BeaconBlockBodyAltair) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size < 380 {
-		return ssz.ErrSize
-	}
+		// Offset (14) 'Withdrawals'
+		if o14 = ssz.ReadOffset(buf[508:512]); o14 > size || o13 > o14 {
+			return ssz.ErrOffset
+		}
 
-	tail := buf
-	var o3, o4, o5, o6, o7 uint64
+		indx := hh.Index()
 
-	// Field (0) 'RandaoReveal'
-	if cap(b.RandaoReveal) == 0 {
-		b.RandaoReveal = make([]byte, 0, len(buf[0:96]))
-	}
-	b.RandaoReveal = append(b.RandaoReveal, buf[0:96]...)
+		// Field (0) 'ParentHash'
+		hh.PutBytes(buf[0:32])
 
-	// Field (1) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if err = b.Eth1Data.UnmarshalSSZ(buf[96:168]); err != nil {
-		return err
-	}
+		// Field (1) 'FeeRecipient'
+		hh.PutBytes(buf[32:52])
 
-	// Field (2) 'Graffiti'
-	copy(b.Graffiti[:], buf[168:200])
+		// Field (2) 'StateRoot'
+		hh.PutBytes(buf[52:84])
 
-	// Offset (3) 'ProposerSlashings'
-	if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
-		return ssz.ErrOffset
-	}
+		// Field (3) 'ReceiptsRoot'
+		hh.PutBytes(buf[84:116])
 
-	if o3 < 380 {
-		return ssz.ErrInvalidVariableOffset
-	}
+		// Field (4) 'LogsBloom'
+		hh.PutBytes(buf[116:372])
 
-	// Offset (4) 'AttesterSlashings'
-	if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
-		return ssz.ErrOffset
-	}
+		// Field (5) 'PrevRandao'
+		hh.PutBytes(buf[372:404])
 
-	// Offset (5) 'Attestations'
-	if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
-		return ssz.ErrOffset
-	}
+		// Field (6) 'BlockNumber'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[404:412]))
 
-	// Offset (6) 'Deposits'
-	if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
-		return ssz.ErrOffset
-	}
+		// Field (7) 'GasLimit'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[412:420]))
 
-	// Offset (7) 'VoluntaryExits'
-	if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
-		return ssz.ErrOffset
-	}
+		// Field (8) 'GasUsed'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[420:428]))
 
-	// Field (8) 'SyncAggregate'
-	if b.SyncAggregate == nil {
-		b.SyncAggregate = new(SyncAggregate)
-	}
-	if err = b.SyncAggregate.UnmarshalSSZ(buf[220:380]); err != nil {
-		return err
-	}
+		// Field (9) 'Timestamp'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[428:436]))
 
-	// Field (3) 'ProposerSlashings'
-	{
-		buf = tail[o3:o4]
-		num, err := ssz.DivideInt2(len(buf), 416, 16)
-		if err != nil {
-			return err
-		}
-		b.ProposerSlashings = make([]*ProposerSlashing, num)
-		for ii := 0; ii < num; ii++ {
-			if b.ProposerSlashings[ii] == nil {
-				b.ProposerSlashings[ii] = new(ProposerSlashing)
+		// Field (10) 'ExtraData'
+		{
+			buf := tail[o10:o13]
+			if len(buf) > 32 {
+				return ssz.ErrBytesLength
 			}
-			if err = b.ProposerSlashings[ii].UnmarshalSSZ(buf[ii*416 : (ii+1)*416]); err != nil {
-				return err
+			{
+				elemIndx := hh.Index()
+				hh.Append(buf)
+				hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (32+31)/32)
 			}
 		}
-	}
 
-	// Field (4) 'AttesterSlashings'
-	{
-		buf = tail[o4:o5]
-		num, err := ssz.DecodeDynamicLength(buf, 2)
-		if err != nil {
-			return err
-		}
-		b.AttesterSlashings = make([]*AttesterSlashing, num)
-		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
-			if b.AttesterSlashings[indx] == nil {
-				b.AttesterSlashings[indx] = new(AttesterSlashing)
+		// Field (11) 'BaseFeePerGas'
+		hh.PutBytes(buf[440:472])
+
+		// Field (12) 'BlockHash'
+		hh.PutBytes(buf[472:504])
+
+		// Field (13) 'Transactions'
+		{
+			buf := tail[o13:o14]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DecodeDynamicLength(buf, 1048576)
+				if err != nil {
+					return err
+				}
+				err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+					if len(buf) > 1073741824 {
+						return ssz.ErrBytesLength
+					}
+					{
+						elemIndx := hh.Index()
+						hh.AppendBytes32(buf)
+						hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (1073741824+31)/32)
+					}
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 1048576)
 			}
-			if err = b.AttesterSlashings[indx].UnmarshalSSZ(buf); err != nil {
-				return err
+		}
+
+		// Field (14) 'Withdrawals'
+		{
+			buf := tail[o14:]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 44, 16)
+				if err != nil {
+					return err
+				}
+				for ii := 0; ii < num; ii++ {
+					if err = (*Withdrawal)(nil).HashTreeRootSSZWith(hh, buf[ii*44:(ii+1)*44]); err != nil {
+						return err
+					}
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 16)
 			}
-			return nil
-		})
-		if err != nil {
-			return err
 		}
-	}
 
-	// Field (5) 'Attestations'
-	{
-		buf = tail[o5:o6]
-		num, err := ssz.DecodeDynamicLength(buf, 128)
-		if err != nil {
-			return err
+		hh.Merkleize(indx)
+	default:
+		size := uint64(len(buf))
+		if size < 508 {
+			return ssz.ErrSize
 		}
-		b.Attestations = make([]*Attestation, num)
-		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
-			if b.Attestations[indx] == nil {
-				b.Attestations[indx] = new(Attestation)
-			}
-			if err = b.Attestations[indx].UnmarshalSSZ(buf); err != nil {
-				return err
-			}
-			return nil
-		})
-		if err != nil {
-			return err
+
+		tail := buf
+		var o10, o13 uint64
+
+		// Offset (10) 'ExtraData'
+		if o10 = ssz.ReadOffset(buf[436:440]); o10 > size {
+			return ssz.ErrOffset
 		}
-	}
 
-	// Field (6) 'Deposits'
-	{
-		buf = tail[o6:o7]
-		num, err := ssz.DivideInt2(len(buf), 1240, 16)
-		if err != nil {
+		if err = ssz.ValidateFirstOffset(o10, 508); err != nil {
 			return err
 		}
-		b.Deposits = make([]*Deposit, num)
-		for ii := 0; ii < num; ii++ {
-			if b.Deposits[ii] == nil {
-				b.Deposits[ii] = new(Deposit)
+
+		// Offset (13) 'Transactions'
+		if o13 = ssz.ReadOffset(buf[504:508]); o13 > size || o10 > o13 {
+			return ssz.ErrOffset
+		}
+
+		indx := hh.Index()
+
+		// Field (0) 'ParentHash'
+		hh.PutBytes(buf[0:32])
+
+		// Field (1) 'FeeRecipient'
+		hh.PutBytes(buf[32:52])
+
+		// Field (2) 'StateRoot'
+		hh.PutBytes(buf[52:84])
+
+		// Field (3) 'ReceiptsRoot'
+		hh.PutBytes(buf[84:116])
+
+		// Field (4) 'LogsBloom'
+		hh.PutBytes(buf[116:372])
+
+		// Field (5) 'PrevRandao'
+		hh.PutBytes(buf[372:404])
+
+		// Field (6) 'BlockNumber'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[404:412]))
+
+		// Field (7) 'GasLimit'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[412:420]))
+
+		// Field (8) 'GasUsed'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[420:428]))
+
+		// Field (9) 'Timestamp'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[428:436]))
+
+		// Field (10) 'ExtraData'
+		{
+			buf := tail[o10:o13]
+			if len(buf) > 32 {
+				return ssz.ErrBytesLength
 			}
-			if err = b.Deposits[ii].UnmarshalSSZ(buf[ii*1240 : (ii+1)*1240]); err != nil {
-				return err
+			{
+				elemIndx := hh.Index()
+				hh.Append(buf)
+				hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (32+31)/32)
 			}
 		}
-	}
 
-	// Field (7) 'VoluntaryExits'
-	{
-		buf = tail[o7:]
-		num, err := ssz.DivideInt2(len(buf), 112, 16)
-		if err != nil {
-			return err
-		}
-		b.VoluntaryExits = make([]*SignedVoluntaryExit, num)
-		for ii := 0; ii < num; ii++ {
-			if b.VoluntaryExits[ii] == nil {
-				b.VoluntaryExits[ii] = new(SignedVoluntaryExit)
-			}
-			if err = b.VoluntaryExits[ii].UnmarshalSSZ(buf[ii*112 : (ii+1)*112]); err != nil {
-				return err
+		// Field (11) 'BaseFeePerGas'
+		hh.PutBytes(buf[440:472])
+
+		// Field (12) 'BlockHash'
+		hh.PutBytes(buf[472:504])
+
+		// Field (13) 'Transactions'
+		{
+			buf := tail[o13:]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DecodeDynamicLength(buf, 1048576)
+				if err != nil {
+					return err
+				}
+				err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+					if len(buf) > 1073741824 {
+						return ssz.ErrBytesLength
+					}
+					{
+						elemIndx := hh.Index()
+						hh.AppendBytes32(buf)
+						hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (1073741824+31)/32)
+					}
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), 1048576)
 			}
 		}
+
+		hh.Merkleize(indx)
 	}
-	return err
+	return
+}
+
+// GetTree ssz hashes the ExecutionPayload object
+func (e *ExecutionPayload) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(e)
+}
+
+// GetTreeFork ssz hashes the ExecutionPayload object for a given fork
+func (e *ExecutionPayload) GetTreeFork(fork ssz.Fork) (*ssz.Node, error) {
+	return ssz.ProofTreeFork(e, fork)
+}
+
+const (
+	// ExecutionPayloadParentHashGIndex is the generalized index of the 'ParentHash' field at the deneb fork
+	ExecutionPayloadParentHashGIndex ssz.GIndex = 32
+	// ExecutionPayloadFeeRecipientGIndex is the generalized index of the 'FeeRecipient' field at the deneb fork
+	ExecutionPayloadFeeRecipientGIndex ssz.GIndex = 33
+	// ExecutionPayloadStateRootGIndex is the generalized index of the 'StateRoot' field at the deneb fork
+	ExecutionPayloadStateRootGIndex ssz.GIndex = 34
+	// ExecutionPayloadReceiptsRootGIndex is the generalized index of the 'ReceiptsRoot' field at the deneb fork
+	ExecutionPayloadReceiptsRootGIndex ssz.GIndex = 35
+	// ExecutionPayloadLogsBloomGIndex is the generalized index of the 'LogsBloom' field at the deneb fork
+	ExecutionPayloadLogsBloomGIndex ssz.GIndex = 36
+	// ExecutionPayloadPrevRandaoGIndex is the generalized index of the 'PrevRandao' field at the deneb fork
+	ExecutionPayloadPrevRandaoGIndex ssz.GIndex = 37
+	// ExecutionPayloadBlockNumberGIndex is the generalized index of the 'BlockNumber' field at the deneb fork
+	ExecutionPayloadBlockNumberGIndex ssz.GIndex = 38
+	// ExecutionPayloadGasLimitGIndex is the generalized index of the 'GasLimit' field at the deneb fork
+	ExecutionPayloadGasLimitGIndex ssz.GIndex = 39
+	// ExecutionPayloadGasUsedGIndex is the generalized index of the 'GasUsed' field at the deneb fork
+	ExecutionPayloadGasUsedGIndex ssz.GIndex = 40
+	// ExecutionPayloadTimestampGIndex is the generalized index of the 'Timestamp' field at the deneb fork
+	ExecutionPayloadTimestampGIndex ssz.GIndex = 41
+	// ExecutionPayloadExtraDataGIndex is the generalized index of the 'ExtraData' field at the deneb fork
+	ExecutionPayloadExtraDataGIndex ssz.GIndex = 42
+	// ExecutionPayloadBaseFeePerGasGIndex is the generalized index of the 'BaseFeePerGas' field at the deneb fork
+	ExecutionPayloadBaseFeePerGasGIndex ssz.GIndex = 43
+	// ExecutionPayloadBlockHashGIndex is the generalized index of the 'BlockHash' field at the deneb fork
+	ExecutionPayloadBlockHashGIndex ssz.GIndex = 44
+	// ExecutionPayloadTransactionsGIndex is the generalized index of the 'Transactions' field at the deneb fork
+	ExecutionPayloadTransactionsGIndex ssz.GIndex = 45
+	// ExecutionPayloadWithdrawalsGIndex is the generalized index of the 'Withdrawals' field at the deneb fork
+	ExecutionPayloadWithdrawalsGIndex ssz.GIndex = 46
+	// ExecutionPayloadBlobGasUsedGIndex is the generalized index of the 'BlobGasUsed' field at the deneb fork
+	ExecutionPayloadBlobGasUsedGIndex ssz.GIndex = 47
+	// ExecutionPayloadExcessBlobGasGIndex is the generalized index of the 'ExcessBlobGas' field at the deneb fork
+	ExecutionPayloadExcessBlobGasGIndex ssz.GIndex = 48
+)
+
+// ProveParentHash returns a merkle proof of the 'ParentHash' field of the ExecutionPayload object
+func (e *ExecutionPayload) ProveParentHash() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ExecutionPayloadParentHashGIndex)
+}
+
+// VerifyExecutionPayloadParentHash verifies a merkle proof of the 'ParentHash' field of a ExecutionPayload object
+func VerifyExecutionPayloadParentHash(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ExecutionPayloadParentHashGIndex)
+}
+
+// ProveFeeRecipient returns a merkle proof of the 'FeeRecipient' field of the ExecutionPayload object
+func (e *ExecutionPayload) ProveFeeRecipient() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ExecutionPayloadFeeRecipientGIndex)
+}
+
+// VerifyExecutionPayloadFeeRecipient verifies a merkle proof of the 'FeeRecipient' field of a ExecutionPayload object
+func VerifyExecutionPayloadFeeRecipient(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ExecutionPayloadFeeRecipientGIndex)
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBodyAltair object
-func (b *BeaconBlockBodyAltair) SizeSSZ() (size int) {
-	size = 380
+// ProveStateRoot returns a merkle proof of the 'StateRoot' field of the ExecutionPayload object
+func (e *ExecutionPayload) ProveStateRoot() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ExecutionPayloadStateRootGIndex)
+}
 
-	// Field (3) 'ProposerSlashings'
-	size += len(b.ProposerSlashings) * 416
+// VerifyExecutionPayloadStateRoot verifies a merkle proof of the 'StateRoot' field of a ExecutionPayload object
+func VerifyExecutionPayloadStateRoot(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ExecutionPayloadStateRootGIndex)
+}
 
-	// Field (4) 'AttesterSlashings'
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		size += 4
-		size += b.AttesterSlashings[ii].SizeSSZ()
-	}
+// ProveReceiptsRoot returns a merkle proof of the 'ReceiptsRoot' field of the ExecutionPayload object
+func (e *ExecutionPayload) ProveReceiptsRoot() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ExecutionPayloadReceiptsRootGIndex)
+}
 
-	// Field (5) 'Attestations'
-	for ii := 0; ii < len(b.Attestations); ii++ {
-		size += 4
-		size += b.Attestations[ii].SizeSSZ()
-	}
+// VerifyExecutionPayloadReceiptsRoot verifies a merkle proof of the 'ReceiptsRoot' field of a ExecutionPayload object
+func VerifyExecutionPayloadReceiptsRoot(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ExecutionPayloadReceiptsRootGIndex)
+}
 
-	// Field (6) 'Deposits'
-	size += len(b.Deposits) * 1240
+// ProveLogsBloom returns a merkle proof of the 'LogsBloom' field of the ExecutionPayload object
+func (e *ExecutionPayload) ProveLogsBloom() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ExecutionPayloadLogsBloomGIndex)
+}
 
-	// Field (7) 'VoluntaryExits'
-	size += len(b.VoluntaryExits) * 112
+// VerifyExecutionPayloadLogsBloom verifies a merkle proof of the 'LogsBloom' field of a ExecutionPayload object
+func VerifyExecutionPayloadLogsBloom(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ExecutionPayloadLogsBloomGIndex)
+}
 
-	return
+// ProvePrevRandao returns a merkle proof of the 'PrevRandao' field of the ExecutionPayload object
+func (e *ExecutionPayload) ProvePrevRandao() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ExecutionPayloadPrevRandaoGIndex)
 }
 
-// HashTreeRoot ssz hashes the BeaconBlockBodyAltair object
-func (b *BeaconBlockBodyAltair) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(b)
+// VerifyExecutionPayloadPrevRandao verifies a merkle proof of the 'PrevRandao' field of a ExecutionPayload object
+func VerifyExecutionPayloadPrevRandao(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ExecutionPayloadPrevRandaoGIndex)
 }
 
-// HashTreeRootWith ssz hashes the BeaconBlockBodyAltair object with a hasher
-func (b *BeaconBlockBodyAltair) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
+// ProveBlockNumber returns a merkle proof of the 'BlockNumber' field of the ExecutionPayload object
+func (e *ExecutionPayload) ProveBlockNumber() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ExecutionPayloadBlockNumberGIndex)
+}
 
-	// Field (0) 'RandaoReveal'
-	if size := len(b.RandaoReveal); size != 96 {
-		err = ssz.ErrBytesLengthFn("BeaconBlockBodyAltair.RandaoReveal", size, 96)
-		return
-	}
-	hh.PutBytes(b.RandaoReveal)
+// VerifyExecutionPayloadBlockNumber verifies a merkle proof of the 'BlockNumber' field of a ExecutionPayload object
+func VerifyExecutionPayloadBlockNumber(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ExecutionPayloadBlockNumberGIndex)
+}
 
-	// Field (1) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
-		return
-	}
+// ProveGasLimit returns a merkle proof of the 'GasLimit' field of the ExecutionPayload object
+func (e *ExecutionPayload) ProveGasLimit() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ExecutionPayloadGasLimitGIndex)
+}
 
-	// Field (2) 'Graffiti'
-	hh.PutBytes(b.Graffiti[:])
+// VerifyExecutionPayloadGasLimit verifies a merkle proof of the 'GasLimit' field of a ExecutionPayload object
+func VerifyExecutionPayloadGasLimit(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ExecutionPayloadGasLimitGIndex)
+}
 
-	// Field (3) 'ProposerSlashings'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.ProposerSlashings))
-		if num > 16 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.ProposerSlashings {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, 16)
-	}
+// ProveGasUsed returns a merkle proof of the 'GasUsed' field of the ExecutionPayload object
+func (e *ExecutionPayload) ProveGasUsed() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ExecutionPayloadGasUsedGIndex)
+}
 
-	// Field (4) 'AttesterSlashings'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.AttesterSlashings))
-		if num > 2 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.AttesterSlashings {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, 2)
-	}
+// VerifyExecutionPayloadGasUsed verifies a merkle proof of the 'GasUsed' field of a ExecutionPayload object
+func VerifyExecutionPayloadGasUsed(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ExecutionPayloadGasUsedGIndex)
+}
 
-	// Field (5) 'Attestations'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.Attestations))
-		if num > 128 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.Attestations {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, 128)
-	}
+// ProveTimestamp returns a merkle proof of the 'Timestamp' field of the ExecutionPayload object
+func (e *ExecutionPayload) ProveTimestamp() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ExecutionPayloadTimestampGIndex)
+}
 
-	// Field (6) 'Deposits'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.Deposits))
-		if num > 16 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.Deposits {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, 16)
-	}
+// VerifyExecutionPayloadTimestamp verifies a merkle proof of the 'Timestamp' field of a ExecutionPayload object
+func VerifyExecutionPayloadTimestamp(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ExecutionPayloadTimestampGIndex)
+}
 
-	// Field (7) 'VoluntaryExits'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.VoluntaryExits))
-		if num > 16 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.VoluntaryExits {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, 16)
-	}
+// ProveExtraData returns a merkle proof of the 'ExtraData' field of the ExecutionPayload object
+func (e *ExecutionPayload) ProveExtraData() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ExecutionPayloadExtraDataGIndex)
+}
 
-	// Field (8) 'SyncAggregate'
-	if b.SyncAggregate == nil {
-		b.SyncAggregate = new(SyncAggregate)
-	}
-	if err = b.SyncAggregate.HashTreeRootWith(hh); err != nil {
-		return
-	}
+// VerifyExecutionPayloadExtraData verifies a merkle proof of the 'ExtraData' field of a ExecutionPayload object
+func VerifyExecutionPayloadExtraData(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ExecutionPayloadExtraDataGIndex)
+}
 
-	hh.Merkleize(indx)
-	return
+// ProveBaseFeePerGas returns a merkle proof of the 'BaseFeePerGas' field of the ExecutionPayload object
+func (e *ExecutionPayload) ProveBaseFeePerGas() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ExecutionPayloadBaseFeePerGasGIndex)
 }
 
-// GetTree ssz hashes the BeaconBlockBodyAltair object
-func (b *BeaconBlockBodyAltair) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(b)
+// VerifyExecutionPayloadBaseFeePerGas verifies a merkle proof of the 'BaseFeePerGas' field of a ExecutionPayload object
+func VerifyExecutionPayloadBaseFeePerGas(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ExecutionPayloadBaseFeePerGasGIndex)
 }
 
-// MarshalSSZ ssz marshals the BeaconBlockBodyBellatrix object
-func (b *BeaconBlockBodyBellatrix) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(b)
+// ProveBlockHash returns a merkle proof of the 'BlockHash' field of the ExecutionPayload object
+func (e *ExecutionPayload) ProveBlockHash() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ExecutionPayloadBlockHashGIndex)
 }
 
-// MarshalSSZTo ssz marshals the BeaconBlockBodyBellatrix object to a target array
-func (b *BeaconBlockBodyBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := int(384)
+// VerifyExecutionPayloadBlockHash verifies a merkle proof of the 'BlockHash' field of a ExecutionPayload object
+func VerifyExecutionPayloadBlockHash(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ExecutionPayloadBlockHashGIndex)
+}
 
-	// Field (0) 'RandaoReveal'
-	if size := len(b.RandaoReveal); size != 96 {
-		err = ssz.ErrBytesLengthFn("BeaconBlockBodyBellatrix.RandaoReveal", size, 96)
-		return
-	}
-	dst = append(dst, b.RandaoReveal...)
+// ProveTransactions returns a merkle proof of the 'Transactions' field of the ExecutionPayload object
+func (e *ExecutionPayload) ProveTransactions() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ExecutionPayloadTransactionsGIndex)
+}
 
-	// Field (1) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
-		return
-	}
+// VerifyExecutionPayloadTransactions verifies a merkle proof of the 'Transactions' field of a ExecutionPayload object
+func VerifyExecutionPayloadTransactions(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ExecutionPayloadTransactionsGIndex)
+}
 
-	// Field (2) 'Graffiti'
-	dst = append(dst, b.Graffiti[:]...)
+// ProveWithdrawals returns a merkle proof of the 'Withdrawals' field of the ExecutionPayload object
+func (e *ExecutionPayload) ProveWithdrawals() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ExecutionPayloadWithdrawalsGIndex)
+}
 
-	// Offset (3) 'ProposerSlashings'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.ProposerSlashings) * 416
+// VerifyExecutionPayloadWithdrawals verifies a merkle proof of the 'Withdrawals' field of a ExecutionPayload object
+func VerifyExecutionPayloadWithdrawals(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ExecutionPayloadWithdrawalsGIndex)
+}
 
-	// Offset (4) 'AttesterSlashings'
-	dst = ssz.WriteOffset(dst, offset)
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		offset += 4
-		offset += b.AttesterSlashings[ii].SizeSSZ()
-	}
+// ProveBlobGasUsed returns a merkle proof of the 'BlobGasUsed' field of the ExecutionPayload object
+func (e *ExecutionPayload) ProveBlobGasUsed() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ExecutionPayloadBlobGasUsedGIndex)
+}
 
-	// Offset (5) 'Attestations'
-	dst = ssz.WriteOffset(dst, offset)
-	for ii := 0; ii < len(b.Attestations); ii++ {
-		offset += 4
-		offset += b.Attestations[ii].SizeSSZ()
-	}
+// VerifyExecutionPayloadBlobGasUsed verifies a merkle proof of the 'BlobGasUsed' field of a ExecutionPayload object
+func VerifyExecutionPayloadBlobGasUsed(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ExecutionPayloadBlobGasUsedGIndex)
+}
 
-	// Offset (6) 'Deposits'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Deposits) * 1240
+// ProveExcessBlobGas returns a merkle proof of the 'ExcessBlobGas' field of the ExecutionPayload object
+func (e *ExecutionPayload) ProveExcessBlobGas() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ExecutionPayloadExcessBlobGasGIndex)
+}
 
-	// Offset (7) 'VoluntaryExits'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.VoluntaryExits) * 112
+// VerifyExecutionPayloadExcessBlobGas verifies a merkle proof of the 'ExcessBlobGas' field of a ExecutionPayload object
+func VerifyExecutionPayloadExcessBlobGas(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ExecutionPayloadExcessBlobGasGIndex)
+}
 
-	// Field (8) 'SyncAggregate'
-	if b.SyncAggregate == nil {
-		b.SyncAggregate = new(SyncAggregate)
-	}
-	if dst, err = b.SyncAggregate.MarshalSSZTo(dst); err != nil {
-		return
+// ExecutionPayloadParentHashGIndexFork returns the generalized index of the 'ParentHash' field for a given fork
+func ExecutionPayloadParentHashGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 32, nil
+	default:
+		return 16, nil
 	}
+}
 
-	// Offset (9) 'ExecutionPayload'
-	dst = ssz.WriteOffset(dst, offset)
-
-	// Field (3) 'ProposerSlashings'
-	if size := len(b.ProposerSlashings); size > 16 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyBellatrix.ProposerSlashings", size, 16)
-		return
-	}
-	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
-		if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
+// ProveParentHashFork returns a merkle proof of the 'ParentHash' field of the ExecutionPayload object for a given fork
+func (e *ExecutionPayload) ProveParentHashFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ExecutionPayloadParentHashGIndexFork(fork)
+	if err != nil {
+		return nil, err
 	}
+	return ssz.ProveGIndexFork(e, gindex, fork)
+}
 
-	// Field (4) 'AttesterSlashings'
-	if size := len(b.AttesterSlashings); size > 2 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyBellatrix.AttesterSlashings", size, 2)
-		return
-	}
-	{
-		offset = 4 * len(b.AttesterSlashings)
-		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += b.AttesterSlashings[ii].SizeSSZ()
-		}
-	}
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		if dst, err = b.AttesterSlashings[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
+// VerifyExecutionPayloadParentHashFork verifies a merkle proof of the 'ParentHash' field of a ExecutionPayload object for a given fork
+func VerifyExecutionPayloadParentHashFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ExecutionPayloadParentHashGIndexFork(fork)
+	if err != nil {
+		return false, err
 	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
 
-	// Field (5) 'Attestations'
-	if size := len(b.Attestations); size > 128 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyBellatrix.Attestations", size, 128)
-		return
-	}
-	{
-		offset = 4 * len(b.Attestations)
-		for ii := 0; ii < len(b.Attestations); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += b.Attestations[ii].SizeSSZ()
-		}
-	}
-	for ii := 0; ii < len(b.Attestations); ii++ {
-		if dst, err = b.Attestations[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
+// ExecutionPayloadFeeRecipientGIndexFork returns the generalized index of the 'FeeRecipient' field for a given fork
+func ExecutionPayloadFeeRecipientGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 33, nil
+	default:
+		return 17, nil
 	}
+}
 
-	// Field (6) 'Deposits'
-	if size := len(b.Deposits); size > 16 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyBellatrix.Deposits", size, 16)
-		return
-	}
-	for ii := 0; ii < len(b.Deposits); ii++ {
-		if dst, err = b.Deposits[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
+// ProveFeeRecipientFork returns a merkle proof of the 'FeeRecipient' field of the ExecutionPayload object for a given fork
+func (e *ExecutionPayload) ProveFeeRecipientFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ExecutionPayloadFeeRecipientGIndexFork(fork)
+	if err != nil {
+		return nil, err
 	}
+	return ssz.ProveGIndexFork(e, gindex, fork)
+}
 
-	// Field (7) 'VoluntaryExits'
-	if size := len(b.VoluntaryExits); size > 16 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyBellatrix.VoluntaryExits", size, 16)
-		return
-	}
-	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
-		if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
+// VerifyExecutionPayloadFeeRecipientFork verifies a merkle proof of the 'FeeRecipient' field of a ExecutionPayload object for a given fork
+func VerifyExecutionPayloadFeeRecipientFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ExecutionPayloadFeeRecipientGIndexFork(fork)
+	if err != nil {
+		return false, err
 	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
 
-	// Field (9) 'ExecutionPayload'
-	if dst, err = b.ExecutionPayload.MarshalSSZTo(dst); err != nil {
-		return
+// ExecutionPayloadStateRootGIndexFork returns the generalized index of the 'StateRoot' field for a given fork
+func ExecutionPayloadStateRootGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 34, nil
+	default:
+		return 18, nil
 	}
-
-	return
 }
 
-// UnmarshalSSZ ssz unmarshals the BeaconBlockBodyBellatrix object
-func (b *BeaconBlockBodyBellatrix) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size < 384 {
-		return ssz.ErrSize
+// ProveStateRootFork returns a merkle proof of the 'StateRoot' field of the ExecutionPayload object for a given fork
+func (e *ExecutionPayload) ProveStateRootFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ExecutionPayloadStateRootGIndexFork(fork)
+	if err != nil {
+		return nil, err
 	}
+	return ssz.ProveGIndexFork(e, gindex, fork)
+}
 
-	tail := buf
-	var o3, o4, o5, o6, o7, o9 uint64
-
-	// Field (0) 'RandaoReveal'
-	if cap(b.RandaoReveal) == 0 {
-		b.RandaoReveal = make([]byte, 0, len(buf[0:96]))
+// VerifyExecutionPayloadStateRootFork verifies a merkle proof of the 'StateRoot' field of a ExecutionPayload object for a given fork
+func VerifyExecutionPayloadStateRootFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ExecutionPayloadStateRootGIndexFork(fork)
+	if err != nil {
+		return false, err
 	}
-	b.RandaoReveal = append(b.RandaoReveal, buf[0:96]...)
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
 
-	// Field (1) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if err = b.Eth1Data.UnmarshalSSZ(buf[96:168]); err != nil {
-		return err
+// ExecutionPayloadReceiptsRootGIndexFork returns the generalized index of the 'ReceiptsRoot' field for a given fork
+func ExecutionPayloadReceiptsRootGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 35, nil
+	default:
+		return 19, nil
 	}
+}
 
-	// Field (2) 'Graffiti'
-	copy(b.Graffiti[:], buf[168:200])
-
-	// Offset (3) 'ProposerSlashings'
-	if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
-		return ssz.ErrOffset
+// ProveReceiptsRootFork returns a merkle proof of the 'ReceiptsRoot' field of the ExecutionPayload object for a given fork
+func (e *ExecutionPayload) ProveReceiptsRootFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ExecutionPayloadReceiptsRootGIndexFork(fork)
+	if err != nil {
+		return nil, err
 	}
+	return ssz.ProveGIndexFork(e, gindex, fork)
+}
 
-	if o3 < 384 {
-		return ssz.ErrInvalidVariableOffset
+// VerifyExecutionPayloadReceiptsRootFork verifies a merkle proof of the 'ReceiptsRoot' field of a ExecutionPayload object for a given fork
+func VerifyExecutionPayloadReceiptsRootFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ExecutionPayloadReceiptsRootGIndexFork(fork)
+	if err != nil {
+		return false, err
 	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
 
-	// Offset (4) 'AttesterSlashings'
-	if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
-		return ssz.ErrOffset
+// ExecutionPayloadLogsBloomGIndexFork returns the generalized index of the 'LogsBloom' field for a given fork
+func ExecutionPayloadLogsBloomGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 36, nil
+	default:
+		return 20, nil
 	}
+}
 
-	// Offset (5) 'Attestations'
-	if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
-		return ssz.ErrOffset
+// ProveLogsBloomFork returns a merkle proof of the 'LogsBloom' field of the ExecutionPayload object for a given fork
+func (e *ExecutionPayload) ProveLogsBloomFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ExecutionPayloadLogsBloomGIndexFork(fork)
+	if err != nil {
+		return nil, err
 	}
+	return ssz.ProveGIndexFork(e, gindex, fork)
+}
 
-	// Offset (6) 'Deposits'
-	if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
-		return ssz.ErrOffset
+// VerifyExecutionPayloadLogsBloomFork verifies a merkle proof of the 'LogsBloom' field of a ExecutionPayload object for a given fork
+func VerifyExecutionPayloadLogsBloomFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ExecutionPayloadLogsBloomGIndexFork(fork)
+	if err != nil {
+		return false, err
 	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
 
-	// Offset (7) 'VoluntaryExits'
-	if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
-		return ssz.ErrOffset
+// ExecutionPayloadPrevRandaoGIndexFork returns the generalized index of the 'PrevRandao' field for a given fork
+func ExecutionPayloadPrevRandaoGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 37, nil
+	default:
+		return 21, nil
 	}
+}
 
-	// Field (8) 'SyncAggregate'
-	if b.SyncAggregate == nil {
-		b.SyncAggregate = new(SyncAggregate)
+// ProvePrevRandaoFork returns a merkle proof of the 'PrevRandao' field of the ExecutionPayload object for a given fork
+func (e *ExecutionPayload) ProvePrevRandaoFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ExecutionPayloadPrevRandaoGIndexFork(fork)
+	if err != nil {
+		return nil, err
 	}
-	if err = b.SyncAggregate.UnmarshalSSZ(buf[220:380]); err != nil {
-		return err
+	return ssz.ProveGIndexFork(e, gindex, fork)
+}
+
+// VerifyExecutionPayloadPrevRandaoFork verifies a merkle proof of the 'PrevRandao' field of a ExecutionPayload object for a given fork
+func VerifyExecutionPayloadPrevRandaoFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ExecutionPayloadPrevRandaoGIndexFork(fork)
+	if err != nil {
+		return false, err
 	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
 
-	// Offset (9) 'ExecutionPayload'
-	if o9 = ssz.ReadOffset(buf[380:384]); o9 > size || o7 > o9 {
-		return ssz.ErrOffset
+// ExecutionPayloadBlockNumberGIndexFork returns the generalized index of the 'BlockNumber' field for a given fork
+func ExecutionPayloadBlockNumberGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 38, nil
+	default:
+		return 22, nil
 	}
+}
 
-	// Field (3) 'ProposerSlashings'
-	{
-		buf = tail[o3:o4]
-		num, err := ssz.DivideInt2(len(buf), 416, 16)
-		if err != nil {
-			return err
-		}
-		b.ProposerSlashings = make([]*ProposerSlashing, num)
-		for ii := 0; ii < num; ii++ {
-			if b.ProposerSlashings[ii] == nil {
-				b.ProposerSlashings[ii] = new(ProposerSlashing)
-			}
-			if err = b.ProposerSlashings[ii].UnmarshalSSZ(buf[ii*416 : (ii+1)*416]); err != nil {
-				return err
-			}
-		}
+// ProveBlockNumberFork returns a merkle proof of the 'BlockNumber' field of the ExecutionPayload object for a given fork
+func (e *ExecutionPayload) ProveBlockNumberFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ExecutionPayloadBlockNumberGIndexFork(fork)
+	if err != nil {
+		return nil, err
 	}
+	return ssz.ProveGIndexFork(e, gindex, fork)
+}
 
-	// Field (4) 'AttesterSlashings'
-	{
-		buf = tail[o4:o5]
-		num, err := ssz.DecodeDynamicLength(buf, 2)
-		if err != nil {
-			return err
-		}
-		b.AttesterSlashings = make([]*AttesterSlashing, num)
-		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
-			if b.AttesterSlashings[indx] == nil {
-				b.AttesterSlashings[indx] = new(AttesterSlashing)
-			}
-			if err = b.AttesterSlashings[indx].UnmarshalSSZ(buf); err != nil {
-				return err
-			}
-			return nil
-		})
-		if err != nil {
-			return err
-		}
+// VerifyExecutionPayloadBlockNumberFork verifies a merkle proof of the 'BlockNumber' field of a ExecutionPayload object for a given fork
+func VerifyExecutionPayloadBlockNumberFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ExecutionPayloadBlockNumberGIndexFork(fork)
+	if err != nil {
+		return false, err
 	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
 
-	// Field (5) 'Attestations'
-	{
-		buf = tail[o5:o6]
-		num, err := ssz.DecodeDynamicLength(buf, 128)
-		if err != nil {
-			return err
-		}
-		b.Attestations = make([]*Attestation, num)
-		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
-			if b.Attestations[indx] == nil {
-				b.Attestations[indx] = new(Attestation)
-			}
-			if err = b.Attestations[indx].UnmarshalSSZ(buf); err != nil {
-				return err
-			}
-			return nil
-		})
-		if err != nil {
-			return err
-		}
+// ExecutionPayloadGasLimitGIndexFork returns the generalized index of the 'GasLimit' field for a given fork
+func ExecutionPayloadGasLimitGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 39, nil
+	default:
+		return 23, nil
 	}
+}
 
-	// Field (6) 'Deposits'
-	{
-		buf = tail[o6:o7]
-		num, err := ssz.DivideInt2(len(buf), 1240, 16)
-		if err != nil {
-			return err
-		}
-		b.Deposits = make([]*Deposit, num)
-		for ii := 0; ii < num; ii++ {
-			if b.Deposits[ii] == nil {
-				b.Deposits[ii] = new(Deposit)
-			}
-			if err = b.Deposits[ii].UnmarshalSSZ(buf[ii*1240 : (ii+1)*1240]); err != nil {
-				return err
-			}
-		}
+// ProveGasLimitFork returns a merkle proof of the 'GasLimit' field of the ExecutionPayload object for a given fork
+func (e *ExecutionPayload) ProveGasLimitFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ExecutionPayloadGasLimitGIndexFork(fork)
+	if err != nil {
+		return nil, err
 	}
+	return ssz.ProveGIndexFork(e, gindex, fork)
+}
 
-	// Field (7) 'VoluntaryExits'
-	{
-		buf = tail[o7:o9]
-		num, err := ssz.DivideInt2(len(buf), 112, 16)
-		if err != nil {
-			return err
-		}
-		b.VoluntaryExits = make([]*SignedVoluntaryExit, num)
-		for ii := 0; ii < num; ii++ {
-			if b.VoluntaryExits[ii] == nil {
-				b.VoluntaryExits[ii] = new(SignedVoluntaryExit)
-			}
-			if err = b.VoluntaryExits[ii].UnmarshalSSZ(buf[ii*112 : (ii+1)*112]); err != nil {
-				return err
-			}
-		}
+// VerifyExecutionPayloadGasLimitFork verifies a merkle proof of the 'GasLimit' field of a ExecutionPayload object for a given fork
+func VerifyExecutionPayloadGasLimitFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ExecutionPayloadGasLimitGIndexFork(fork)
+	if err != nil {
+		return false, err
 	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
 
-	// Field (9) 'ExecutionPayload'
-	{
-		buf = tail[o9:]
-		if b.ExecutionPayload == nil {
-			b.ExecutionPayload = new(ExecutionPayload)
-		}
-		if err = b.ExecutionPayload.UnmarshalSSZ(buf); err != nil {
-			return err
-		}
+// ExecutionPayloadGasUsedGIndexFork returns the generalized index of the 'GasUsed' field for a given fork
+func ExecutionPayloadGasUsedGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 40, nil
+	default:
+		return 24, nil
 	}
-	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBodyBellatrix object
-func (b *BeaconBlockBodyBellatrix) SizeSSZ() (size int) {
-	size = 384
+// ProveGasUsedFork returns a merkle proof of the 'GasUsed' field of the ExecutionPayload object for a given fork
+func (e *ExecutionPayload) ProveGasUsedFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ExecutionPayloadGasUsedGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(e, gindex, fork)
+}
 
-	// Field (3) 'ProposerSlashings'
-	size += len(b.ProposerSlashings) * 416
+// VerifyExecutionPayloadGasUsedFork verifies a merkle proof of the 'GasUsed' field of a ExecutionPayload object for a given fork
+func VerifyExecutionPayloadGasUsedFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ExecutionPayloadGasUsedGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
 
-	// Field (4) 'AttesterSlashings'
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		size += 4
-		size += b.AttesterSlashings[ii].SizeSSZ()
+// ExecutionPayloadTimestampGIndexFork returns the generalized index of the 'Timestamp' field for a given fork
+func ExecutionPayloadTimestampGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 41, nil
+	default:
+		return 25, nil
 	}
+}
 
-	// Field (5) 'Attestations'
-	for ii := 0; ii < len(b.Attestations); ii++ {
-		size += 4
-		size += b.Attestations[ii].SizeSSZ()
+// ProveTimestampFork returns a merkle proof of the 'Timestamp' field of the ExecutionPayload object for a given fork
+func (e *ExecutionPayload) ProveTimestampFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ExecutionPayloadTimestampGIndexFork(fork)
+	if err != nil {
+		return nil, err
 	}
+	return ssz.ProveGIndexFork(e, gindex, fork)
+}
 
-	// Field (6) 'Deposits'
-	size += len(b.Deposits) * 1240
+// VerifyExecutionPayloadTimestampFork verifies a merkle proof of the 'Timestamp' field of a ExecutionPayload object for a given fork
+func VerifyExecutionPayloadTimestampFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ExecutionPayloadTimestampGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
 
-	// Field (7) 'VoluntaryExits'
-	size += len(b.VoluntaryExits) * 112
+// ExecutionPayloadExtraDataGIndexFork returns the generalized index of the 'ExtraData' field for a given fork
+func ExecutionPayloadExtraDataGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 42, nil
+	default:
+		return 26, nil
+	}
+}
 
-	// Field (9) 'ExecutionPayload'
-	if b.ExecutionPayload == nil {
-		b.ExecutionPayload = new(ExecutionPayload)
+// ProveExtraDataFork returns a merkle proof of the 'ExtraData' field of the ExecutionPayload object for a given fork
+func (e *ExecutionPayload) ProveExtraDataFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ExecutionPayloadExtraDataGIndexFork(fork)
+	if err != nil {
+		return nil, err
 	}
-	size += b.ExecutionPayload.SizeSSZ()
+	return ssz.ProveGIndexFork(e, gindex, fork)
+}
 
-	return
+// VerifyExecutionPayloadExtraDataFork verifies a merkle proof of the 'ExtraData' field of a ExecutionPayload object for a given fork
+func VerifyExecutionPayloadExtraDataFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ExecutionPayloadExtraDataGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
 }
 
-// HashTreeRoot ssz hashes the BeaconBlockBodyBellatrix object
-func (b *BeaconBlockBodyBellatrix) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(b)
+// ExecutionPayloadBaseFeePerGasGIndexFork returns the generalized index of the 'BaseFeePerGas' field for a given fork
+func ExecutionPayloadBaseFeePerGasGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 43, nil
+	default:
+		return 27, nil
+	}
 }
 
-// HashTreeRootWith ssz hashes the BeaconBlockBodyBellatrix object with a hasher
-func (b *BeaconBlockBodyBellatrix) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
+// ProveBaseFeePerGasFork returns a merkle proof of the 'BaseFeePerGas' field of the ExecutionPayload object for a given fork
+func (e *ExecutionPayload) ProveBaseFeePerGasFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ExecutionPayloadBaseFeePerGasGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(e, gindex, fork)
+}
 
-	// Field (0) 'RandaoReveal'
-	if size := len(b.RandaoReveal); size != 96 {
-		err = ssz.ErrBytesLengthFn("BeaconBlockBodyBellatrix.RandaoReveal", size, 96)
-		return
+// VerifyExecutionPayloadBaseFeePerGasFork verifies a merkle proof of the 'BaseFeePerGas' field of a ExecutionPayload object for a given fork
+func VerifyExecutionPayloadBaseFeePerGasFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ExecutionPayloadBaseFeePerGasGIndexFork(fork)
+	if err != nil {
+		return false, err
 	}
-	hh.PutBytes(b.RandaoReveal)
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
 
-	// Field (1) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
+// ExecutionPayloadBlockHashGIndexFork returns the generalized index of the 'BlockHash' field for a given fork
+func ExecutionPayloadBlockHashGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 44, nil
+	default:
+		return 28, nil
 	}
-	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
-		return
+}
+
+// ProveBlockHashFork returns a merkle proof of the 'BlockHash' field of the ExecutionPayload object for a given fork
+func (e *ExecutionPayload) ProveBlockHashFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ExecutionPayloadBlockHashGIndexFork(fork)
+	if err != nil {
+		return nil, err
 	}
+	return ssz.ProveGIndexFork(e, gindex, fork)
+}
 
-	// Field (2) 'Graffiti'
-	hh.PutBytes(b.Graffiti[:])
+// VerifyExecutionPayloadBlockHashFork verifies a merkle proof of the 'BlockHash' field of a ExecutionPayload object for a given fork
+func VerifyExecutionPayloadBlockHashFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ExecutionPayloadBlockHashGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
 
-	// Field (3) 'ProposerSlashings'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.ProposerSlashings))
-		if num > 16 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.ProposerSlashings {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, 16)
+// ExecutionPayloadTransactionsGIndexFork returns the generalized index of the 'Transactions' field for a given fork
+func ExecutionPayloadTransactionsGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 45, nil
+	default:
+		return 29, nil
 	}
+}
 
-	// Field (4) 'AttesterSlashings'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.AttesterSlashings))
-		if num > 2 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.AttesterSlashings {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, 2)
+// ProveTransactionsFork returns a merkle proof of the 'Transactions' field of the ExecutionPayload object for a given fork
+func (e *ExecutionPayload) ProveTransactionsFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ExecutionPayloadTransactionsGIndexFork(fork)
+	if err != nil {
+		return nil, err
 	}
+	return ssz.ProveGIndexFork(e, gindex, fork)
+}
 
-	// Field (5) 'Attestations'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.Attestations))
-		if num > 128 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.Attestations {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, 128)
+// VerifyExecutionPayloadTransactionsFork verifies a merkle proof of the 'Transactions' field of a ExecutionPayload object for a given fork
+func VerifyExecutionPayloadTransactionsFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ExecutionPayloadTransactionsGIndexFork(fork)
+	if err != nil {
+		return false, err
 	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
 
-	// Field (6) 'Deposits'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.Deposits))
-		if num > 16 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.Deposits {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, 16)
+// ExecutionPayloadWithdrawalsGIndexFork returns the generalized index of the 'Withdrawals' field for a given fork
+func ExecutionPayloadWithdrawalsGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 46, nil
+	case fork >= ssz.ForkCapella:
+		return 30, nil
+	default:
+		return 0, ssz.ErrForkField
 	}
+}
 
-	// Field (7) 'VoluntaryExits'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.VoluntaryExits))
-		if num > 16 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.VoluntaryExits {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, 16)
+// ProveWithdrawalsFork returns a merkle proof of the 'Withdrawals' field of the ExecutionPayload object for a given fork
+func (e *ExecutionPayload) ProveWithdrawalsFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ExecutionPayloadWithdrawalsGIndexFork(fork)
+	if err != nil {
+		return nil, err
 	}
+	return ssz.ProveGIndexFork(e, gindex, fork)
+}
 
-	// Field (8) 'SyncAggregate'
-	if b.SyncAggregate == nil {
-		b.SyncAggregate = new(SyncAggregate)
+// VerifyExecutionPayloadWithdrawalsFork verifies a merkle proof of the 'Withdrawals' field of a ExecutionPayload object for a given fork
+func VerifyExecutionPayloadWithdrawalsFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ExecutionPayloadWithdrawalsGIndexFork(fork)
+	if err != nil {
+		return false, err
 	}
-	if err = b.SyncAggregate.HashTreeRootWith(hh); err != nil {
-		return
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// ExecutionPayloadBlobGasUsedGIndexFork returns the generalized index of the 'BlobGasUsed' field for a given fork
+func ExecutionPayloadBlobGasUsedGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 47, nil
+	default:
+		return 0, ssz.ErrForkField
 	}
+}
 
-	// Field (9) 'ExecutionPayload'
-	if err = b.ExecutionPayload.HashTreeRootWith(hh); err != nil {
-		return
+// ProveBlobGasUsedFork returns a merkle proof of the 'BlobGasUsed' field of the ExecutionPayload object for a given fork
+func (e *ExecutionPayload) ProveBlobGasUsedFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ExecutionPayloadBlobGasUsedGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(e, gindex, fork)
+}
+
+// VerifyExecutionPayloadBlobGasUsedFork verifies a merkle proof of the 'BlobGasUsed' field of a ExecutionPayload object for a given fork
+func VerifyExecutionPayloadBlobGasUsedFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ExecutionPayloadBlobGasUsedGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// ExecutionPayloadExcessBlobGasGIndexFork returns the generalized index of the 'ExcessBlobGas' field for a given fork
+func ExecutionPayloadExcessBlobGasGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 48, nil
+	default:
+		return 0, ssz.ErrForkField
+	}
+}
+
+// ProveExcessBlobGasFork returns a merkle proof of the 'ExcessBlobGas' field of the ExecutionPayload object for a given fork
+func (e *ExecutionPayload) ProveExcessBlobGasFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ExecutionPayloadExcessBlobGasGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(e, gindex, fork)
+}
+
+// VerifyExecutionPayloadExcessBlobGasFork verifies a merkle proof of the 'ExcessBlobGas' field of a ExecutionPayload object for a given fork
+func VerifyExecutionPayloadExcessBlobGasFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ExecutionPayloadExcessBlobGasGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// SchemaSSZ returns the schema of the tree of the ExecutionPayload object
+func (e *ExecutionPayload) SchemaSSZ() *ssz.Schema {
+	return e.SchemaSSZFork(ssz.ForkDeneb)
+}
+
+// SchemaSSZFork returns the schema of the tree of the ExecutionPayload object for a given fork
+func (e *ExecutionPayload) SchemaSSZFork(fork ssz.Fork) *ssz.Schema {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return ssz.SchemaContainer(
+			&ssz.SchemaField{Name: "ParentHash", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "FeeRecipient", Schema: ssz.SchemaBytes(20)},
+			&ssz.SchemaField{Name: "StateRoot", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "ReceiptsRoot", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "LogsBloom", Schema: ssz.SchemaBytes(256)},
+			&ssz.SchemaField{Name: "PrevRandao", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "BlockNumber", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "GasLimit", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "GasUsed", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "Timestamp", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "ExtraData", Schema: ssz.SchemaList(ssz.SchemaUint(1), 32)},
+			&ssz.SchemaField{Name: "BaseFeePerGas", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "BlockHash", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "Transactions", Schema: ssz.SchemaList(ssz.SchemaList(ssz.SchemaUint(1), 1073741824), 1048576)},
+			&ssz.SchemaField{Name: "Withdrawals", Schema: ssz.SchemaList((*Withdrawal)(nil).SchemaSSZ(), 16)},
+			&ssz.SchemaField{Name: "BlobGasUsed", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "ExcessBlobGas", Schema: ssz.SchemaUint(8)},
+		)
+	case fork >= ssz.ForkCapella:
+		return ssz.SchemaContainer(
+			&ssz.SchemaField{Name: "ParentHash", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "FeeRecipient", Schema: ssz.SchemaBytes(20)},
+			&ssz.SchemaField{Name: "StateRoot", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "ReceiptsRoot", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "LogsBloom", Schema: ssz.SchemaBytes(256)},
+			&ssz.SchemaField{Name: "PrevRandao", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "BlockNumber", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "GasLimit", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "GasUsed", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "Timestamp", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "ExtraData", Schema: ssz.SchemaList(ssz.SchemaUint(1), 32)},
+			&ssz.SchemaField{Name: "BaseFeePerGas", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "BlockHash", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "Transactions", Schema: ssz.SchemaList(ssz.SchemaList(ssz.SchemaUint(1), 1073741824), 1048576)},
+			&ssz.SchemaField{Name: "Withdrawals", Schema: ssz.SchemaList((*Withdrawal)(nil).SchemaSSZ(), 16)},
+		)
+	default:
+		return ssz.SchemaContainer(
+			&ssz.SchemaField{Name: "ParentHash", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "FeeRecipient", Schema: ssz.SchemaBytes(20)},
+			&ssz.SchemaField{Name: "StateRoot", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "ReceiptsRoot", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "LogsBloom", Schema: ssz.SchemaBytes(256)},
+			&ssz.SchemaField{Name: "PrevRandao", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "BlockNumber", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "GasLimit", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "GasUsed", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "Timestamp", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "ExtraData", Schema: ssz.SchemaList(ssz.SchemaUint(1), 32)},
+			&ssz.SchemaField{Name: "BaseFeePerGas", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "BlockHash", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "Transactions", Schema: ssz.SchemaList(ssz.SchemaList(ssz.SchemaUint(1), 1073741824), 1048576)},
+		)
 	}
+}
 
-	hh.Merkleize(indx)
-	return
+// MarshalSSZ ssz marshals the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(e)
 }
 
-// GetTree ssz hashes the BeaconBlockBodyBellatrix object
-func (b *BeaconBlockBodyBellatrix) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(b)
+// MarshalSSZTo ssz marshals the ExecutionPayloadHeader object to a target array
+func (e *ExecutionPayloadHeader) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	return e.MarshalSSZToFork(buf, ssz.ForkDeneb)
 }
 
-// MarshalSSZ ssz marshals the BeaconStateAltair object
-func (b *BeaconStateAltair) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(b)
+// MarshalSSZFork ssz marshals the ExecutionPayloadHeader object for a given fork
+func (e *ExecutionPayloadHeader) MarshalSSZFork(fork ssz.Fork) ([]byte, error) {
+	return ssz.MarshalSSZFork(e, fork)
 }
 
-// MarshalSSZTo ssz marshals the BeaconStateAltair object to a target array
-func (b *BeaconStateAltair) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZToFork ssz marshals the ExecutionPayloadHeader object to a target array for a given fork
+func (e *ExecutionPayloadHeader) MarshalSSZToFork(buf []byte, fork ssz.Fork) (dst []byte, err error) {
 	dst = buf
-	offset := int(2736629)
+	switch {
+	case fork >= ssz.ForkDeneb:
+		offset := int(584)
+		// Field (0) 'ParentHash'
+		dst = append(dst, e.ParentHash[:]...)
 
-	// Field (0) 'GenesisTime'
-	dst = ssz.MarshalUint64(dst, b.GenesisTime)
+		// Field (1) 'FeeRecipient'
+		dst = append(dst, e.FeeRecipient[:]...)
 
-	// Field (1) 'GenesisValidatorsRoot'
-	if size := len(b.GenesisValidatorsRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("BeaconStateAltair.GenesisValidatorsRoot", size, 32)
-		return
-	}
-	dst = append(dst, b.GenesisValidatorsRoot...)
+		// Field (2) 'StateRoot'
+		dst = append(dst, e.StateRoot[:]...)
 
-	// Field (2) 'Slot'
-	dst = ssz.MarshalUint64(dst, b.Slot)
+		// Field (3) 'ReceiptsRoot'
+		dst = append(dst, e.ReceiptsRoot[:]...)
 
-	// Field (3) 'Fork'
-	if b.Fork == nil {
-		b.Fork = new(Fork)
-	}
-	if dst, err = b.Fork.MarshalSSZTo(dst); err != nil {
-		return
-	}
+		// Field (4) 'LogsBloom'
+		dst = append(dst, e.LogsBloom[:]...)
 
-	// Field (4) 'LatestBlockHeader'
-	if b.LatestBlockHeader == nil {
-		b.LatestBlockHeader = new(BeaconBlockHeader)
-	}
-	if dst, err = b.LatestBlockHeader.MarshalSSZTo(dst); err != nil {
-		return
-	}
+		// Field (5) 'PrevRandao'
+		dst = append(dst, e.PrevRandao[:]...)
 
-	// Field (5) 'BlockRoots'
-	if size := len(b.BlockRoots); size != 8192 {
-		err = ssz.ErrVectorLengthFn("BeaconStateAltair.BlockRoots", size, 8192)
-		return
-	}
-	for ii := 0; ii < 8192; ii++ {
-		if size := len(b.BlockRoots[ii]); size != 32 {
-			err = ssz.ErrBytesLengthFn("BeaconStateAltair.BlockRoots[ii]", size, 32)
-			return
-		}
-		dst = append(dst, b.BlockRoots[ii]...)
-	}
+		// Field (6) 'BlockNumber'
+		dst = ssz.MarshalUint64(dst, e.BlockNumber)
 
-	// Field (6) 'StateRoots'
-	if size := len(b.StateRoots); size != 8192 {
-		err = ssz.ErrVectorLengthFn("BeaconStateAltair.StateRoots", size, 8192)
-		return
-	}
-	for ii := 0; ii < 8192; ii++ {
-		if size := len(b.StateRoots[ii]); size != 32 {
-			err = ssz.ErrBytesLengthFn("BeaconStateAltair.StateRoots[ii]", size, 32)
+		// Field (7) 'GasLimit'
+		dst = ssz.MarshalUint64(dst, e.GasLimit)
+
+		// Field (8) 'GasUsed'
+		dst = ssz.MarshalUint64(dst, e.GasUsed)
+
+		// Field (9) 'Timestamp'
+		dst = ssz.MarshalUint64(dst, e.Timestamp)
+
+		// Offset (10) 'ExtraData'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (11) 'BaseFeePerGas'
+		dst = append(dst, e.BaseFeePerGas[:]...)
+
+		// Field (12) 'BlockHash'
+		dst = append(dst, e.BlockHash[:]...)
+
+		// Field (13) 'TransactionsRoot'
+		dst = append(dst, e.TransactionsRoot[:]...)
+
+		// Field (14) 'WithdrawalRoot'
+		dst = append(dst, e.WithdrawalRoot[:]...)
+
+		// Field (15) 'BlobGasUsed'
+		dst = ssz.MarshalUint64(dst, e.BlobGasUsed)
+
+		// Field (16) 'ExcessBlobGas'
+		dst = ssz.MarshalUint64(dst, e.ExcessBlobGas)
+
+		// Field (10) 'ExtraData'
+		if size := len(e.ExtraData); size > 32 {
+			err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.ExtraData", size, 32)
 			return
 		}
-		dst = append(dst, b.StateRoots[ii]...)
-	}
+		dst = append(dst, e.ExtraData...)
 
-	// Offset (7) 'HistoricalRoots'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.HistoricalRoots) * 32
+	case fork >= ssz.ForkCapella:
+		offset := int(568)
+		// Field (0) 'ParentHash'
+		dst = append(dst, e.ParentHash[:]...)
 
-	// Field (8) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
-		return
-	}
+		// Field (1) 'FeeRecipient'
+		dst = append(dst, e.FeeRecipient[:]...)
 
-	// Offset (9) 'Eth1DataVotes'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Eth1DataVotes) * 72
+		// Field (2) 'StateRoot'
+		dst = append(dst, e.StateRoot[:]...)
 
-	// Field (10) 'Eth1DepositIndex'
-	dst = ssz.MarshalUint64(dst, b.Eth1DepositIndex)
+		// Field (3) 'ReceiptsRoot'
+		dst = append(dst, e.ReceiptsRoot[:]...)
 
-	// Offset (11) 'Validators'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Validators) * 121
+		// Field (4) 'LogsBloom'
+		dst = append(dst, e.LogsBloom[:]...)
 
-	// Offset (12) 'Balances'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Balances) * 8
+		// Field (5) 'PrevRandao'
+		dst = append(dst, e.PrevRandao[:]...)
 
-	// Field (13) 'RandaoMixes'
-	if size := len(b.RandaoMixes); size != 65536 {
-		err = ssz.ErrVectorLengthFn("BeaconStateAltair.RandaoMixes", size, 65536)
-		return
-	}
-	for ii := 0; ii < 65536; ii++ {
-		if size := len(b.RandaoMixes[ii]); size != 32 {
-			err = ssz.ErrBytesLengthFn("BeaconStateAltair.RandaoMixes[ii]", size, 32)
+		// Field (6) 'BlockNumber'
+		dst = ssz.MarshalUint64(dst, e.BlockNumber)
+
+		// Field (7) 'GasLimit'
+		dst = ssz.MarshalUint64(dst, e.GasLimit)
+
+		// Field (8) 'GasUsed'
+		dst = ssz.MarshalUint64(dst, e.GasUsed)
+
+		// Field (9) 'Timestamp'
+		dst = ssz.MarshalUint64(dst, e.Timestamp)
+
+		// Offset (10) 'ExtraData'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (11) 'BaseFeePerGas'
+		dst = append(dst, e.BaseFeePerGas[:]...)
+
+		// Field (12) 'BlockHash'
+		dst = append(dst, e.BlockHash[:]...)
+
+		// Field (13) 'TransactionsRoot'
+		dst = append(dst, e.TransactionsRoot[:]...)
+
+		// Field (14) 'WithdrawalRoot'
+		dst = append(dst, e.WithdrawalRoot[:]...)
+
+		// Field (10) 'ExtraData'
+		if size := len(e.ExtraData); size > 32 {
+			err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.ExtraData", size, 32)
 			return
 		}
-		dst = append(dst, b.RandaoMixes[ii]...)
-	}
+		dst = append(dst, e.ExtraData...)
 
-	// Field (14) 'Slashings'
-	if size := len(b.Slashings); size != 8192 {
-		err = ssz.ErrVectorLengthFn("BeaconStateAltair.Slashings", size, 8192)
-		return
-	}
-	for ii := 0; ii < 8192; ii++ {
-		dst = ssz.MarshalUint64(dst, b.Slashings[ii])
-	}
+	default:
+		offset := int(536)
+		// Field (0) 'ParentHash'
+		dst = append(dst, e.ParentHash[:]...)
 
-	// Offset (15) 'PreviousEpochParticipation'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.PreviousEpochParticipation)
+		// Field (1) 'FeeRecipient'
+		dst = append(dst, e.FeeRecipient[:]...)
 
-	// Offset (16) 'CurrentEpochParticipation'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.CurrentEpochParticipation)
+		// Field (2) 'StateRoot'
+		dst = append(dst, e.StateRoot[:]...)
 
-	// Field (17) 'JustificationBits'
-	if size := len(b.JustificationBits); size != 1 {
-		err = ssz.ErrBytesLengthFn("BeaconStateAltair.JustificationBits", size, 1)
-		return
-	}
-	dst = append(dst, b.JustificationBits...)
+		// Field (3) 'ReceiptsRoot'
+		dst = append(dst, e.ReceiptsRoot[:]...)
 
-	// Field (18) 'PreviousJustifiedCheckpoint'
-	if b.PreviousJustifiedCheckpoint == nil {
-		b.PreviousJustifiedCheckpoint = new(Checkpoint)
-	}
-	if dst, err = b.PreviousJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
-		return
-	}
+		// Field (4) 'LogsBloom'
+		dst = append(dst, e.LogsBloom[:]...)
 
-	// Field (19) 'CurrentJustifiedCheckpoint'
-	if b.CurrentJustifiedCheckpoint == nil {
-		b.CurrentJustifiedCheckpoint = new(Checkpoint)
-	}
-	if dst, err = b.CurrentJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
-		return
-	}
+		// Field (5) 'PrevRandao'
+		dst = append(dst, e.PrevRandao[:]...)
 
-	// Field (20) 'FinalizedCheckpoint'
-	if b.FinalizedCheckpoint == nil {
-		b.FinalizedCheckpoint = new(Checkpoint)
-	}
-	if dst, err = b.FinalizedCheckpoint.MarshalSSZTo(dst); err != nil {
-		return
-	}
+		// Field (6) 'BlockNumber'
+		dst = ssz.MarshalUint64(dst, e.BlockNumber)
 
-	// Offset (21) 'InactivityScores'
-	dst = ssz.WriteOffset(dst, offset)
+		// Field (7) 'GasLimit'
+		dst = ssz.MarshalUint64(dst, e.GasLimit)
 
-	// Field (22) 'CurrentSyncCommittee'
-	if b.CurrentSyncCommittee == nil {
-		b.CurrentSyncCommittee = new(SyncCommittee)
-	}
-	if dst, err = b.CurrentSyncCommittee.MarshalSSZTo(dst); err != nil {
-		return
-	}
+		// Field (8) 'GasUsed'
+		dst = ssz.MarshalUint64(dst, e.GasUsed)
 
-	// Field (23) 'NextSyncCommittee'
-	if b.NextSyncCommittee == nil {
-		b.NextSyncCommittee = new(SyncCommittee)
-	}
-	if dst, err = b.NextSyncCommittee.MarshalSSZTo(dst); err != nil {
-		return
-	}
+		// Field (9) 'Timestamp'
+		dst = ssz.MarshalUint64(dst, e.Timestamp)
 
-	// Field (7) 'HistoricalRoots'
-	if size := len(b.HistoricalRoots); size > 16777216 {
-		err = ssz.ErrListTooBigFn("BeaconStateAltair.HistoricalRoots", size, 16777216)
-		return
-	}
-	for ii := 0; ii < len(b.HistoricalRoots); ii++ {
-		if size := len(b.HistoricalRoots[ii]); size != 32 {
-			err = ssz.ErrBytesLengthFn("BeaconStateAltair.HistoricalRoots[ii]", size, 32)
+		// Offset (10) 'ExtraData'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (11) 'BaseFeePerGas'
+		dst = append(dst, e.BaseFeePerGas[:]...)
+
+		// Field (12) 'BlockHash'
+		dst = append(dst, e.BlockHash[:]...)
+
+		// Field (13) 'TransactionsRoot'
+		dst = append(dst, e.TransactionsRoot[:]...)
+
+		// Field (10) 'ExtraData'
+		if size := len(e.ExtraData); size > 32 {
+			err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.ExtraData", size, 32)
 			return
 		}
-		dst = append(dst, b.HistoricalRoots[ii]...)
-	}
+		dst = append(dst, e.ExtraData...)
 
-	// Field (9) 'Eth1DataVotes'
-	if size := len(b.Eth1DataVotes); size > 2048 {
-		err = ssz.ErrListTooBigFn("BeaconStateAltair.Eth1DataVotes", size, 2048)
-		return
 	}
-	for ii := 0; ii < len(b.Eth1DataVotes); ii++ {
-		if dst, err = b.Eth1DataVotes[ii].MarshalSSZTo(dst); err != nil {
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the ExecutionPayloadHeader object to a writer
+func (e *ExecutionPayloadHeader) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(e, writer)
+}
+
+// MarshalSSZToStream ssz marshals the ExecutionPayloadHeader object to a target array that is flushed to a stream writer
+func (e *ExecutionPayloadHeader) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	return e.MarshalSSZToStreamFork(buf, sw, ssz.ForkDeneb)
+}
+
+// MarshalSSZToWriterFork ssz marshals the ExecutionPayloadHeader object to a writer for a given fork
+func (e *ExecutionPayloadHeader) MarshalSSZToWriterFork(writer io.Writer, fork ssz.Fork) (int, error) {
+	return ssz.MarshalSSZToWriterFork(e, writer, fork)
+}
+
+// MarshalSSZToStreamFork ssz marshals the ExecutionPayloadHeader object to a target array that is flushed to a stream writer for a given fork
+func (e *ExecutionPayloadHeader) MarshalSSZToStreamFork(buf []byte, sw *ssz.StreamWriter, fork ssz.Fork) (dst []byte, err error) {
+	dst = buf
+	switch {
+	case fork >= ssz.ForkDeneb:
+		offset := int(584)
+		// Field (0) 'ParentHash'
+		dst = append(dst, e.ParentHash[:]...)
+
+		// Field (1) 'FeeRecipient'
+		dst = append(dst, e.FeeRecipient[:]...)
+
+		// Field (2) 'StateRoot'
+		dst = append(dst, e.StateRoot[:]...)
+
+		// Field (3) 'ReceiptsRoot'
+		dst = append(dst, e.ReceiptsRoot[:]...)
+
+		// Field (4) 'LogsBloom'
+		dst = append(dst, e.LogsBloom[:]...)
+
+		// Field (5) 'PrevRandao'
+		dst = append(dst, e.PrevRandao[:]...)
+
+		// Field (6) 'BlockNumber'
+		dst = ssz.MarshalUint64(dst, e.BlockNumber)
+
+		// Field (7) 'GasLimit'
+		dst = ssz.MarshalUint64(dst, e.GasLimit)
+
+		// Field (8) 'GasUsed'
+		dst = ssz.MarshalUint64(dst, e.GasUsed)
+
+		// Field (9) 'Timestamp'
+		dst = ssz.MarshalUint64(dst, e.Timestamp)
+
+		// Offset (10) 'ExtraData'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (11) 'BaseFeePerGas'
+		dst = append(dst, e.BaseFeePerGas[:]...)
+
+		// Field (12) 'BlockHash'
+		dst = append(dst, e.BlockHash[:]...)
+
+		// Field (13) 'TransactionsRoot'
+		dst = append(dst, e.TransactionsRoot[:]...)
+
+		// Field (14) 'WithdrawalRoot'
+		dst = append(dst, e.WithdrawalRoot[:]...)
+
+		// Field (15) 'BlobGasUsed'
+		dst = ssz.MarshalUint64(dst, e.BlobGasUsed)
+
+		// Field (16) 'ExcessBlobGas'
+		dst = ssz.MarshalUint64(dst, e.ExcessBlobGas)
+
+		// Field (10) 'ExtraData'
+		if size := len(e.ExtraData); size > 32 {
+			err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.ExtraData", size, 32)
 			return
 		}
-	}
-
-	// Field (11) 'Validators'
-	if size := len(b.Validators); size > 1099511627776 {
-		err = ssz.ErrListTooBigFn("BeaconStateAltair.Validators", size, 1099511627776)
-		return
-	}
-	for ii := 0; ii < len(b.Validators); ii++ {
-		if dst, err = b.Validators[ii].MarshalSSZTo(dst); err != nil {
+		if dst, err = sw.Append(dst, e.ExtraData); err != nil {
 			return
 		}
-	}
 
-	// Field (12) 'Balances'
-	if size := len(b.Balances); size > 1099511627776 {
-		err = ssz.ErrListTooBigFn("BeaconStateAltair.Balances", size, 1099511627776)
-		return
-	}
-	for ii := 0; ii < len(b.Balances); ii++ {
-		dst = ssz.MarshalUint64(dst, b.Balances[ii])
-	}
+	case fork >= ssz.ForkCapella:
+		offset := int(568)
+		// Field (0) 'ParentHash'
+		dst = append(dst, e.ParentHash[:]...)
 
-	// Field (15) 'PreviousEpochParticipation'
-	if size := len(b.PreviousEpochParticipation); size > 1099511627776 {
-		err = ssz.ErrBytesLengthFn("BeaconStateAltair.PreviousEpochParticipation", size, 1099511627776)
-		return
-	}
-	dst = append(dst, b.PreviousEpochParticipation...)
+		// Field (1) 'FeeRecipient'
+		dst = append(dst, e.FeeRecipient[:]...)
 
-	// Field (16) 'CurrentEpochParticipation'
-	if size := len(b.CurrentEpochParticipation); size > 1099511627776 {
-		err = ssz.ErrBytesLengthFn("BeaconStateAltair.CurrentEpochParticipation", size, 1099511627776)
-		return
-	}
-	dst = append(dst, b.CurrentEpochParticipation...)
+		// Field (2) 'StateRoot'
+		dst = append(dst, e.StateRoot[:]...)
 
-	// Field (21) 'InactivityScores'
-	if size := len(b.InactivityScores); size > 1099511627776 {
-		err = ssz.ErrListTooBigFn("BeaconStateAltair.InactivityScores", size, 1099511627776)
-		return
-	}
-	for ii := 0; ii < len(b.InactivityScores); ii++ {
-		dst = ssz.MarshalUint64(dst, b.InactivityScores[ii])
-	}
+		// Field (3) 'ReceiptsRoot'
+		dst = append(dst, e.ReceiptsRoot[:]...)
 
-	return
-}
+		// Field (4) 'LogsBloom'
+		dst = append(dst, e.LogsBloom[:]...)
 
-// UnmarshalSSZ ssz unmarshals the BeaconStateAltair object
-func (b *BeaconStateAltair) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size < 2736629 {
-		return ssz.ErrSize
-	}
+		// Field (5) 'PrevRandao'
+		dst = append(dst, e.PrevRandao[:]...)
 
-	tail := buf
-	var o7, o9, o11, o12, o15, o16, o21 uint64
+		// Field (6) 'BlockNumber'
+		dst = ssz.MarshalUint64(dst, e.BlockNumber)
 
-	// Field (0) 'GenesisTime'
-	b.GenesisTime = ssz.UnmarshallUint64(buf[0:8])
+		// Field (7) 'GasLimit'
+		dst = ssz.MarshalUint64(dst, e.GasLimit)
 
-	// Field (1) 'GenesisValidatorsRoot'
-	if cap(b.GenesisValidatorsRoot) == 0 {
-		b.GenesisValidatorsRoot = make([]byte, 0, len(buf[8:40]))
-	}
-	b.GenesisValidatorsRoot = append(b.GenesisValidatorsRoot, buf[8:40]...)
+		// Field (8) 'GasUsed'
+		dst = ssz.MarshalUint64(dst, e.GasUsed)
 
-	// Field (2) 'Slot'
-	b.Slot = ssz.UnmarshallUint64(buf[40:48])
+		// Field (9) 'Timestamp'
+		dst = ssz.MarshalUint64(dst, e.Timestamp)
 
-	// Field (3) 'Fork'
-	if b.Fork == nil {
-		b.Fork = new(Fork)
-	}
-	if err = b.Fork.UnmarshalSSZ(buf[48:64]); err != nil {
-		return err
-	}
+		// Offset (10) 'ExtraData'
+		dst = ssz.WriteOffset(dst, offset)
 
-	// Field (4) 'LatestBlockHeader'
-	if b.LatestBlockHeader == nil {
-		b.LatestBlockHeader = new(BeaconBlockHeader)
-	}
-	if err = b.LatestBlockHeader.UnmarshalSSZ(buf[64:176]); err != nil {
-		return err
-	}
+		// Field (11) 'BaseFeePerGas'
+		dst = append(dst, e.BaseFeePerGas[:]...)
 
-	// Field (5) 'BlockRoots'
-	b.BlockRoots = make([][]byte, 8192)
-	for ii := 0; ii < 8192; ii++ {
-		if cap(b.BlockRoots[ii]) == 0 {
-			b.BlockRoots[ii] = make([]byte, 0, len(buf[176:262320][ii*32:(ii+1)*32]))
-		}
-		b.BlockRoots[ii] = append(b.BlockRoots[ii], buf[176:262320][ii*32:(ii+1)*32]...)
-	}
+		// Field (12) 'BlockHash'
+		dst = append(dst, e.BlockHash[:]...)
 
-	// Field (6) 'StateRoots'
-	b.StateRoots = make([][]byte, 8192)
-	for ii := 0; ii < 8192; ii++ {
-		if cap(b.StateRoots[ii]) == 0 {
-			b.StateRoots[ii] = make([]byte, 0, len(buf[262320:524464][ii*32:(ii+1)*32]))
+		// Field (13) 'TransactionsRoot'
+		dst = append(dst, e.TransactionsRoot[:]...)
+
+		// Field (14) 'WithdrawalRoot'
+		dst = append(dst, e.WithdrawalRoot[:]...)
+
+		// Field (10) 'ExtraData'
+		if size := len(e.ExtraData); size > 32 {
+			err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.ExtraData", size, 32)
+			return
+		}
+		if dst, err = sw.Append(dst, e.ExtraData); err != nil {
+			return
 		}
-		b.StateRoots[ii] = append(b.StateRoots[ii], buf[262320:524464][ii*32:(ii+1)*32]...)
-	}
 
-	// Offset (7) 'HistoricalRoots'
-	if o7 = ssz.ReadOffset(buf[524464:524468]); o7 > size {
-		return ssz.ErrOffset
-	}
+	default:
+		offset := int(536)
+		// Field (0) 'ParentHash'
+		dst = append(dst, e.ParentHash[:]...)
 
-	if o7 < 2736629 {
-		return ssz.ErrInvalidVariableOffset
-	}
+		// Field (1) 'FeeRecipient'
+		dst = append(dst, e.FeeRecipient[:]...)
 
-	// Field (8) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if err = b.Eth1Data.UnmarshalSSZ(buf[524468:524540]); err != nil {
-		return err
-	}
+		// Field (2) 'StateRoot'
+		dst = append(dst, e.StateRoot[:]...)
+
+		// Field (3) 'ReceiptsRoot'
+		dst = append(dst, e.ReceiptsRoot[:]...)
+
+		// Field (4) 'LogsBloom'
+		dst = append(dst, e.LogsBloom[:]...)
+
+		// Field (5) 'PrevRandao'
+		dst = append(dst, e.PrevRandao[:]...)
+
+		// Field (6) 'BlockNumber'
+		dst = ssz.MarshalUint64(dst, e.BlockNumber)
+
+		// Field (7) 'GasLimit'
+		dst = ssz.MarshalUint64(dst, e.GasLimit)
+
+		// Field (8) 'GasUsed'
+		dst = ssz.MarshalUint64(dst, e.GasUsed)
+
+		// Field (9) 'Timestamp'
+		dst = ssz.MarshalUint64(dst, e.Timestamp)
+
+		// Offset (10) 'ExtraData'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (11) 'BaseFeePerGas'
+		dst = append(dst, e.BaseFeePerGas[:]...)
+
+		// Field (12) 'BlockHash'
+		dst = append(dst, e.BlockHash[:]...)
+
+		// Field (13) 'TransactionsRoot'
+		dst = append(dst, e.TransactionsRoot[:]...)
+
+		// Field (10) 'ExtraData'
+		if size := len(e.ExtraData); size > 32 {
+			err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.ExtraData", size, 32)
+			return
+		}
+		if dst, err = sw.Append(dst, e.ExtraData); err != nil {
+			return
+		}
 
-	// Offset (9) 'Eth1DataVotes'
-	if o9 = ssz.ReadOffset(buf[524540:524544]); o9 > size || o7 > o9 {
-		return ssz.ErrOffset
 	}
+	return
+}
 
-	// Field (10) 'Eth1DepositIndex'
-	b.Eth1DepositIndex = ssz.UnmarshallUint64(buf[524544:524552])
+// UnmarshalSSZ ssz unmarshals the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) UnmarshalSSZ(buf []byte) error {
+	return e.UnmarshalSSZForkWithOptions(buf, ssz.ForkDeneb, ssz.UnmarshalOptions{})
+}
 
-	// Offset (11) 'Validators'
-	if o11 = ssz.ReadOffset(buf[524552:524556]); o11 > size || o9 > o11 {
-		return ssz.ErrOffset
-	}
+// UnmarshalSSZWithOptions ssz unmarshals the ExecutionPayloadHeader object with the decoding options
+func (e *ExecutionPayloadHeader) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	return e.UnmarshalSSZForkWithOptions(buf, ssz.ForkDeneb, opts)
+}
 
-	// Offset (12) 'Balances'
-	if o12 = ssz.ReadOffset(buf[524556:524560]); o12 > size || o11 > o12 {
-		return ssz.ErrOffset
-	}
+// UnmarshalSSZFork ssz unmarshals the ExecutionPayloadHeader object for a given fork
+func (e *ExecutionPayloadHeader) UnmarshalSSZFork(buf []byte, fork ssz.Fork) error {
+	return e.UnmarshalSSZForkWithOptions(buf, fork, ssz.UnmarshalOptions{})
+}
 
-	// Field (13) 'RandaoMixes'
-	b.RandaoMixes = make([][]byte, 65536)
-	for ii := 0; ii < 65536; ii++ {
-		if cap(b.RandaoMixes[ii]) == 0 {
-			b.RandaoMixes[ii] = make([]byte, 0, len(buf[524560:2621712][ii*32:(ii+1)*32]))
+// UnmarshalSSZForkWithOptions ssz unmarshals the ExecutionPayloadHeader object for a given fork with the decoding options
+func (e *ExecutionPayloadHeader) UnmarshalSSZForkWithOptions(buf []byte, fork ssz.Fork, opts ssz.UnmarshalOptions) error {
+	var err error
+	switch {
+	case fork >= ssz.ForkDeneb:
+		size := uint64(len(buf))
+		if size < 584 {
+			return ssz.ErrSize
 		}
-		b.RandaoMixes[ii] = append(b.RandaoMixes[ii], buf[524560:2621712][ii*32:(ii+1)*32]...)
-	}
 
-	// Field (14) 'Slashings'
-	b.Slashings = ssz.ExtendUint64(b.Slashings, 8192)
-	for ii := 0; ii < 8192; ii++ {
-		b.Slashings[ii] = ssz.UnmarshallUint64(buf[2621712:2687248][ii*8 : (ii+1)*8])
-	}
+		tail := buf
+		var o10 uint64
 
-	// Offset (15) 'PreviousEpochParticipation'
-	if o15 = ssz.ReadOffset(buf[2687248:2687252]); o15 > size || o12 > o15 {
-		return ssz.ErrOffset
-	}
+		// Field (0) 'ParentHash'
+		copy(e.ParentHash[:], buf[0:32])
 
-	// Offset (16) 'CurrentEpochParticipation'
-	if o16 = ssz.ReadOffset(buf[2687252:2687256]); o16 > size || o15 > o16 {
-		return ssz.ErrOffset
-	}
+		// Field (1) 'FeeRecipient'
+		copy(e.FeeRecipient[:], buf[32:52])
 
-	// Field (17) 'JustificationBits'
-	if cap(b.JustificationBits) == 0 {
-		b.JustificationBits = make([]byte, 0, len(buf[2687256:2687257]))
-	}
-	b.JustificationBits = append(b.JustificationBits, buf[2687256:2687257]...)
+		// Field (2) 'StateRoot'
+		copy(e.StateRoot[:], buf[52:84])
 
-	// Field (18) 'PreviousJustifiedCheckpoint'
-	if b.PreviousJustifiedCheckpoint == nil {
-		b.PreviousJustifiedCheckpoint = new(Checkpoint)
-	}
-	if err = b.PreviousJustifiedCheckpoint.UnmarshalSSZ(buf[2687257:2687297]); err != nil {
-		return err
-	}
+		// Field (3) 'ReceiptsRoot'
+		copy(e.ReceiptsRoot[:], buf[84:116])
 
-	// Field (19) 'CurrentJustifiedCheckpoint'
-	if b.CurrentJustifiedCheckpoint == nil {
-		b.CurrentJustifiedCheckpoint = new(Checkpoint)
-	}
-	if err = b.CurrentJustifiedCheckpoint.UnmarshalSSZ(buf[2687297:2687337]); err != nil {
-		return err
-	}
+		// Field (4) 'LogsBloom'
+		copy(e.LogsBloom[:], buf[116:372])
 
-	// Field (20) 'FinalizedCheckpoint'
-	if b.FinalizedCheckpoint == nil {
-		b.FinalizedCheckpoint = new(Checkpoint)
-	}
-	if err = b.FinalizedCheckpoint.UnmarshalSSZ(buf[2687337:2687377]); err != nil {
-		return err
-	}
+		// Field (5) 'PrevRandao'
+		copy(e.PrevRandao[:], buf[372:404])
 
-	// Offset (21) 'InactivityScores'
-	if o21 = ssz.ReadOffset(buf[2687377:2687381]); o21 > size || o16 > o21 {
-		return ssz.ErrOffset
-	}
+		// Field (6) 'BlockNumber'
+		e.BlockNumber = ssz.UnmarshallUint64(buf[404:412])
 
-	// Field (22) 'CurrentSyncCommittee'
-	if b.CurrentSyncCommittee == nil {
-		b.CurrentSyncCommittee = new(SyncCommittee)
-	}
-	if err = b.CurrentSyncCommittee.UnmarshalSSZ(buf[2687381:2712005]); err != nil {
-		return err
-	}
+		// Field (7) 'GasLimit'
+		e.GasLimit = ssz.UnmarshallUint64(buf[412:420])
 
-	// Field (23) 'NextSyncCommittee'
-	if b.NextSyncCommittee == nil {
-		b.NextSyncCommittee = new(SyncCommittee)
-	}
-	if err = b.NextSyncCommittee.UnmarshalSSZ(buf[2712005:2736629]); err != nil {
-		return err
-	}
+		// Field (8) 'GasUsed'
+		e.GasUsed = ssz.UnmarshallUint64(buf[420:428])
 
-	// Field (7) 'HistoricalRoots'
-	{
-		buf = tail[o7:o9]
-		num, err := ssz.DivideInt2(len(buf), 32, 16777216)
-		if err != nil {
-			return err
-		}
-		b.HistoricalRoots = make([][]byte, num)
-		for ii := 0; ii < num; ii++ {
-			if cap(b.HistoricalRoots[ii]) == 0 {
-				b.HistoricalRoots[ii] = make([]byte, 0, len(buf[ii*32:(ii+1)*32]))
-			}
-			b.HistoricalRoots[ii] = append(b.HistoricalRoots[ii], buf[ii*32:(ii+1)*32]...)
-		}
-	}
+		// Field (9) 'Timestamp'
+		e.Timestamp = ssz.UnmarshallUint64(buf[428:436])
 
-	// Field (9) 'Eth1DataVotes'
-	{
-		buf = tail[o9:o11]
-		num, err := ssz.DivideInt2(len(buf), 72, 2048)
-		if err != nil {
-			return err
-		}
-		b.Eth1DataVotes = make([]*Eth1Data, num)
-		for ii := 0; ii < num; ii++ {
-			if b.Eth1DataVotes[ii] == nil {
-				b.Eth1DataVotes[ii] = new(Eth1Data)
-			}
-			if err = b.Eth1DataVotes[ii].UnmarshalSSZ(buf[ii*72 : (ii+1)*72]); err != nil {
-				return err
-			}
+		// Offset (10) 'ExtraData'
+		if o10 = ssz.ReadOffset(buf[436:440]); o10 > size {
+			return ssz.ErrOffset
 		}
-	}
 
-	// Field (11) 'Validators'
-	{
-		buf = tail[o11:o12]
-		num, err := ssz.DivideInt2(len(buf), 121, 1099511627776)
-		if err != nil {
+		if err = opts.ValidateFirstOffset(o10, 584); err != nil {
 			return err
 		}
-		b.Validators = make([]*Validator, num)
-		for ii := 0; ii < num; ii++ {
-			if b.Validators[ii] == nil {
-				b.Validators[ii] = new(Validator)
-			}
-			if err = b.Validators[ii].UnmarshalSSZ(buf[ii*121 : (ii+1)*121]); err != nil {
-				return err
-			}
-		}
-	}
 
-	// Field (12) 'Balances'
-	{
-		buf = tail[o12:o15]
-		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
-		if err != nil {
-			return err
-		}
-		b.Balances = ssz.ExtendUint64(b.Balances, num)
-		for ii := 0; ii < num; ii++ {
-			b.Balances[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
-		}
-	}
+		// Field (11) 'BaseFeePerGas'
+		copy(e.BaseFeePerGas[:], buf[440:472])
 
-	// Field (15) 'PreviousEpochParticipation'
-	{
-		buf = tail[o15:o16]
-		if len(buf) > 1099511627776 {
-			return ssz.ErrBytesLength
-		}
-		if cap(b.PreviousEpochParticipation) == 0 {
-			b.PreviousEpochParticipation = make([]byte, 0, len(buf))
-		}
-		b.PreviousEpochParticipation = append(b.PreviousEpochParticipation, buf...)
-	}
+		// Field (12) 'BlockHash'
+		copy(e.BlockHash[:], buf[472:504])
 
-	// Field (16) 'CurrentEpochParticipation'
-	{
-		buf = tail[o16:o21]
-		if len(buf) > 1099511627776 {
-			return ssz.ErrBytesLength
-		}
-		if cap(b.CurrentEpochParticipation) == 0 {
-			b.CurrentEpochParticipation = make([]byte, 0, len(buf))
-		}
-		b.CurrentEpochParticipation = append(b.CurrentEpochParticipation, buf...)
-	}
+		// Field (13) 'TransactionsRoot'
+		copy(e.TransactionsRoot[:], buf[504:536])
 
-	// Field (21) 'InactivityScores'
-	{
-		buf = tail[o21:]
-		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
-		if err != nil {
-			return err
+		// Field (14) 'WithdrawalRoot'
+		copy(e.WithdrawalRoot[:], buf[536:568])
+
+		// Field (15) 'BlobGasUsed'
+		e.BlobGasUsed = ssz.UnmarshallUint64(buf[568:576])
+
+		// Field (16) 'ExcessBlobGas'
+		e.ExcessBlobGas = ssz.UnmarshallUint64(buf[576:584])
+
+		// Field (10) 'ExtraData'
+		{
+			buf = tail[o10:]
+			if len(buf) > 32 {
+				return ssz.ErrBytesLength
+			}
+			if cap(e.ExtraData) == 0 {
+				e.ExtraData = make([]byte, 0, len(buf))
+			}
+			e.ExtraData = append(e.ExtraData, buf...)
 		}
-		b.InactivityScores = ssz.ExtendUint64(b.InactivityScores, num)
-		for ii := 0; ii < num; ii++ {
-			b.InactivityScores[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+	case fork >= ssz.ForkCapella:
+		size := uint64(len(buf))
+		if size < 568 {
+			return ssz.ErrSize
 		}
-	}
-	return err
-}
 
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconStateAltair object
-func (b *BeaconStateAltair) SizeSSZ() (size int) {
-	size = 2736629
+		tail := buf
+		var o10 uint64
 
-	// Field (7) 'HistoricalRoots'
-	size += len(b.HistoricalRoots) * 32
+		// Field (0) 'ParentHash'
+		copy(e.ParentHash[:], buf[0:32])
 
-	// Field (9) 'Eth1DataVotes'
-	size += len(b.Eth1DataVotes) * 72
+		// Field (1) 'FeeRecipient'
+		copy(e.FeeRecipient[:], buf[32:52])
 
-	// Field (11) 'Validators'
-	size += len(b.Validators) * 121
+		// Field (2) 'StateRoot'
+		copy(e.StateRoot[:], buf[52:84])
 
-	// Field (12) 'Balances'
-	size += len(b.Balances) * 8
+		// Field (3) 'ReceiptsRoot'
+		copy(e.ReceiptsRoot[:], buf[84:116])
 
-	// Field (15) 'PreviousEpochParticipation'
-	size += len(b.PreviousEpochParticipation)
+		// Field (4) 'LogsBloom'
+		copy(e.LogsBloom[:], buf[116:372])
 
-	// Field (16) 'CurrentEpochParticipation'
-	size += len(b.CurrentEpochParticipation)
+		// Field (5) 'PrevRandao'
+		copy(e.PrevRandao[:], buf[372:404])
 
-	// Field (21) 'InactivityScores'
-	size += len(b.InactivityScores) * 8
+		// Field (6) 'BlockNumber'
+		e.BlockNumber = ssz.UnmarshallUint64(buf[404:412])
 
-	return
-}
+		// Field (7) 'GasLimit'
+		e.GasLimit = ssz.UnmarshallUint64(buf[412:420])
 
-// HashTreeRoot ssz hashes the BeaconStateAltair object
-func (b *BeaconStateAltair) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(b)
-}
+		// Field (8) 'GasUsed'
+		e.GasUsed = ssz.UnmarshallUint64(buf[420:428])
 
-// HashTreeRootWith ssz hashes the BeaconStateAltair object with a hasher
-func (b *BeaconStateAltair) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
+		// Field (9) 'Timestamp'
+		e.Timestamp = ssz.UnmarshallUint64(buf[428:436])
 
-	// Field (0) 'GenesisTime'
-	hh.PutUint64(b.GenesisTime)
+		// Offset (10) 'ExtraData'
+		if o10 = ssz.ReadOffset(buf[436:440]); o10 > size {
+			return ssz.ErrOffset
+		}
 
-	// Field (1) 'GenesisValidatorsRoot'
-	if size := len(b.GenesisValidatorsRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("BeaconStateAltair.GenesisValidatorsRoot", size, 32)
-		return
-	}
-	hh.PutBytes(b.GenesisValidatorsRoot)
+		if err = opts.ValidateFirstOffset(o10, 568); err != nil {
+			return err
+		}
 
-	// Field (2) 'Slot'
-	hh.PutUint64(b.Slot)
+		// Field (11) 'BaseFeePerGas'
+		copy(e.BaseFeePerGas[:], buf[440:472])
 
-	// Field (3) 'Fork'
-	if b.Fork == nil {
-		b.Fork = new(Fork)
-	}
-	if err = b.Fork.HashTreeRootWith(hh); err != nil {
-		return
-	}
+		// Field (12) 'BlockHash'
+		copy(e.BlockHash[:], buf[472:504])
 
-	// Field (4) 'LatestBlockHeader'
-	if b.LatestBlockHeader == nil {
-		b.LatestBlockHeader = new(BeaconBlockHeader)
-	}
-	if err = b.LatestBlockHeader.HashTreeRootWith(hh); err != nil {
-		return
-	}
+		// Field (13) 'TransactionsRoot'
+		copy(e.TransactionsRoot[:], buf[504:536])
 
-	// Field (5) 'BlockRoots'
-	{
-		if size := len(b.BlockRoots); size != 8192 {
-			err = ssz.ErrVectorLengthFn("BeaconStateAltair.BlockRoots", size, 8192)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.BlockRoots {
-			if len(i) != 32 {
-				err = ssz.ErrBytesLength
-				return
-			}
-			hh.Append(i)
-		}
-		hh.Merkleize(subIndx)
-	}
+		// Field (14) 'WithdrawalRoot'
+		copy(e.WithdrawalRoot[:], buf[536:568])
 
-	// Field (6) 'StateRoots'
-	{
-		if size := len(b.StateRoots); size != 8192 {
-			err = ssz.ErrVectorLengthFn("BeaconStateAltair.StateRoots", size, 8192)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.StateRoots {
-			if len(i) != 32 {
-				err = ssz.ErrBytesLength
-				return
+		// Field (10) 'ExtraData'
+		{
+			buf = tail[o10:]
+			if len(buf) > 32 {
+				return ssz.ErrBytesLength
 			}
-			hh.Append(i)
-		}
-		hh.Merkleize(subIndx)
-	}
-
-	// Field (7) 'HistoricalRoots'
-	{
-		if size := len(b.HistoricalRoots); size > 16777216 {
-			err = ssz.ErrListTooBigFn("BeaconStateAltair.HistoricalRoots", size, 16777216)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.HistoricalRoots {
-			if len(i) != 32 {
-				err = ssz.ErrBytesLength
-				return
+			if cap(e.ExtraData) == 0 {
+				e.ExtraData = make([]byte, 0, len(buf))
 			}
-			hh.Append(i)
+			e.ExtraData = append(e.ExtraData, buf...)
+		}
+	default:
+		size := uint64(len(buf))
+		if size < 536 {
+			return ssz.ErrSize
 		}
-		numItems := uint64(len(b.HistoricalRoots))
-		hh.MerkleizeWithMixin(subIndx, numItems, 16777216)
-	}
 
-	// Field (8) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
-		return
-	}
+		tail := buf
+		var o10 uint64
 
-	// Field (9) 'Eth1DataVotes'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.Eth1DataVotes))
-		if num > 2048 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.Eth1DataVotes {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, 2048)
-	}
+		// Field (0) 'ParentHash'
+		copy(e.ParentHash[:], buf[0:32])
 
-	// Field (10) 'Eth1DepositIndex'
-	hh.PutUint64(b.Eth1DepositIndex)
+		// Field (1) 'FeeRecipient'
+		copy(e.FeeRecipient[:], buf[32:52])
 
-	// Field (11) 'Validators'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.Validators))
-		if num > 1099511627776 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.Validators {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
-	}
+		// Field (2) 'StateRoot'
+		copy(e.StateRoot[:], buf[52:84])
 
-	// Field (12) 'Balances'
-	{
-		if size := len(b.Balances); size > 1099511627776 {
-			err = ssz.ErrListTooBigFn("BeaconStateAltair.Balances", size, 1099511627776)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.Balances {
-			hh.AppendUint64(i)
-		}
-		hh.FillUpTo32()
-		numItems := uint64(len(b.Balances))
-		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
-	}
+		// Field (3) 'ReceiptsRoot'
+		copy(e.ReceiptsRoot[:], buf[84:116])
 
-	// Field (13) 'RandaoMixes'
-	{
-		if size := len(b.RandaoMixes); size != 65536 {
-			err = ssz.ErrVectorLengthFn("BeaconStateAltair.RandaoMixes", size, 65536)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.RandaoMixes {
-			if len(i) != 32 {
-				err = ssz.ErrBytesLength
-				return
-			}
-			hh.Append(i)
-		}
-		hh.Merkleize(subIndx)
-	}
+		// Field (4) 'LogsBloom'
+		copy(e.LogsBloom[:], buf[116:372])
 
-	// Field (14) 'Slashings'
-	{
-		if size := len(b.Slashings); size != 8192 {
-			err = ssz.ErrVectorLengthFn("BeaconStateAltair.Slashings", size, 8192)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.Slashings {
-			hh.AppendUint64(i)
-		}
-		hh.Merkleize(subIndx)
-	}
+		// Field (5) 'PrevRandao'
+		copy(e.PrevRandao[:], buf[372:404])
 
-	// Field (15) 'PreviousEpochParticipation'
-	{
-		elemIndx := hh.Index()
-		byteLen := uint64(len(b.PreviousEpochParticipation))
-		if byteLen > 1099511627776 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		hh.Append(b.PreviousEpochParticipation)
-		hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
-	}
+		// Field (6) 'BlockNumber'
+		e.BlockNumber = ssz.UnmarshallUint64(buf[404:412])
 
-	// Field (16) 'CurrentEpochParticipation'
-	{
-		elemIndx := hh.Index()
-		byteLen := uint64(len(b.CurrentEpochParticipation))
-		if byteLen > 1099511627776 {
-			err = ssz.ErrIncorrectListSize
-			return
+		// Field (7) 'GasLimit'
+		e.GasLimit = ssz.UnmarshallUint64(buf[412:420])
+
+		// Field (8) 'GasUsed'
+		e.GasUsed = ssz.UnmarshallUint64(buf[420:428])
+
+		// Field (9) 'Timestamp'
+		e.Timestamp = ssz.UnmarshallUint64(buf[428:436])
+
+		// Offset (10) 'ExtraData'
+		if o10 = ssz.ReadOffset(buf[436:440]); o10 > size {
+			return ssz.ErrOffset
 		}
-		hh.Append(b.CurrentEpochParticipation)
-		hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
-	}
 
-	// Field (17) 'JustificationBits'
-	if size := len(b.JustificationBits); size != 1 {
-		err = ssz.ErrBytesLengthFn("BeaconStateAltair.JustificationBits", size, 1)
-		return
-	}
-	hh.PutBytes(b.JustificationBits)
+		if err = opts.ValidateFirstOffset(o10, 536); err != nil {
+			return err
+		}
 
-	// Field (18) 'PreviousJustifiedCheckpoint'
-	if b.PreviousJustifiedCheckpoint == nil {
-		b.PreviousJustifiedCheckpoint = new(Checkpoint)
-	}
-	if err = b.PreviousJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
-		return
-	}
+		// Field (11) 'BaseFeePerGas'
+		copy(e.BaseFeePerGas[:], buf[440:472])
 
-	// Field (19) 'CurrentJustifiedCheckpoint'
-	if b.CurrentJustifiedCheckpoint == nil {
-		b.CurrentJustifiedCheckpoint = new(Checkpoint)
-	}
-	if err = b.CurrentJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
-		return
-	}
+		// Field (12) 'BlockHash'
+		copy(e.BlockHash[:], buf[472:504])
 
-	// Field (20) 'FinalizedCheckpoint'
-	if b.FinalizedCheckpoint == nil {
-		b.FinalizedCheckpoint = new(Checkpoint)
-	}
-	if err = b.FinalizedCheckpoint.HashTreeRootWith(hh); err != nil {
-		return
-	}
+		// Field (13) 'TransactionsRoot'
+		copy(e.TransactionsRoot[:], buf[504:536])
 
-	// Field (21) 'InactivityScores'
-	{
-		if size := len(b.InactivityScores); size > 1099511627776 {
-			err = ssz.ErrListTooBigFn("BeaconStateAltair.InactivityScores", size, 1099511627776)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.InactivityScores {
-			hh.AppendUint64(i)
+		// Field (10) 'ExtraData'
+		{
+			buf = tail[o10:]
+			if len(buf) > 32 {
+				return ssz.ErrBytesLength
+			}
+			if cap(e.ExtraData) == 0 {
+				e.ExtraData = make([]byte, 0, len(buf))
+			}
+			e.ExtraData = append(e.ExtraData, buf...)
 		}
-		hh.FillUpTo32()
-		numItems := uint64(len(b.InactivityScores))
-		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
 	}
+	return err
+}
 
-	// Field (22) 'CurrentSyncCommittee'
-	if b.CurrentSyncCommittee == nil {
-		b.CurrentSyncCommittee = new(SyncCommittee)
-	}
-	if err = b.CurrentSyncCommittee.HashTreeRootWith(hh); err != nil {
-		return
+// SizeSSZ returns the ssz encoded size in bytes for the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) SizeSSZ() (size int) {
+	return e.SizeSSZFork(ssz.ForkDeneb)
+}
+
+// SizeSSZFork returns the ssz encoded size in bytes for the ExecutionPayloadHeader object for a given fork
+func (e *ExecutionPayloadHeader) SizeSSZFork(fork ssz.Fork) (size int) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		size = 584
+		// Field (10) 'ExtraData'
+		size += len(e.ExtraData)
+	case fork >= ssz.ForkCapella:
+		size = 568
+		// Field (10) 'ExtraData'
+		size += len(e.ExtraData)
+	default:
+		size = 536
+		// Field (10) 'ExtraData'
+		size += len(e.ExtraData)
 	}
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) MinSSZSize() uint64 {
+	return e.MinSSZSizeFork(ssz.ForkDeneb)
+}
 
-	// Field (23) 'NextSyncCommittee'
-	if b.NextSyncCommittee == nil {
-		b.NextSyncCommittee = new(SyncCommittee)
+// MinSSZSizeFork returns the minimum ssz encoded size in bytes for the ExecutionPayloadHeader object for a given fork
+func (e *ExecutionPayloadHeader) MinSSZSizeFork(fork ssz.Fork) uint64 {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 584
+	case fork >= ssz.ForkCapella:
+		return 568
+	default:
+		return 536
 	}
-	if err = b.NextSyncCommittee.HashTreeRootWith(hh); err != nil {
-		return
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) MaxSSZSize() uint64 {
+	return e.MaxSSZSizeFork(ssz.ForkDeneb)
+}
+
+// MaxSSZSizeFork returns the maximum ssz encoded size in bytes for the ExecutionPayloadHeader object for a given fork
+func (e *ExecutionPayloadHeader) MaxSSZSizeFork(fork ssz.Fork) uint64 {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 616
+	case fork >= ssz.ForkCapella:
+		return 600
+	default:
+		return 568
 	}
+}
 
-	hh.Merkleize(indx)
-	return
+// HashTreeRoot ssz hashes the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(e)
+}
+
+// HashTreeRootWith ssz hashes the ExecutionPayloadHeader object with a hasher
+func (e *ExecutionPayloadHeader) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	return e.HashTreeRootWithFork(hh, ssz.ForkDeneb)
 }
 
-// GetTree ssz hashes the BeaconStateAltair object
-func (b *BeaconStateAltair) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(b)
+// HashTreeRootFork ssz hashes the ExecutionPayloadHeader object for a given fork
+func (e *ExecutionPayloadHeader) HashTreeRootFork(fork ssz.Fork) ([32]byte, error) {
+	return ssz.HashWithDefaultHasherFork(e, fork)
 }
 
-// MarshalSSZ ssz marshals the BeaconStateBellatrix object
-func (b *BeaconStateBellatrix) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(b)
-}
+// HashTreeRootWithFork ssz hashes the ExecutionPayloadHeader object with a hasher for a given fork
+func (e *ExecutionPayloadHeader) HashTreeRootWithFork(hh ssz.HashWalker, fork ssz.Fork) (err error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		indx := hh.Index()
 
-// MarshalSSZTo ssz marshals the BeaconStateBellatrix object to a target array
-func (b *BeaconStateBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := int(2736633)
+		// Field (0) 'ParentHash'
+		hh.PutBytes(e.ParentHash[:])
 
-	// Field (0) 'GenesisTime'
-	dst = ssz.MarshalUint64(dst, b.GenesisTime)
+		// Field (1) 'FeeRecipient'
+		hh.PutBytes(e.FeeRecipient[:])
 
-	// Field (1) 'GenesisValidatorsRoot'
-	if size := len(b.GenesisValidatorsRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.GenesisValidatorsRoot", size, 32)
-		return
-	}
-	dst = append(dst, b.GenesisValidatorsRoot...)
+		// Field (2) 'StateRoot'
+		hh.PutBytes(e.StateRoot[:])
 
-	// Field (2) 'Slot'
-	dst = ssz.MarshalUint64(dst, b.Slot)
+		// Field (3) 'ReceiptsRoot'
+		hh.PutBytes(e.ReceiptsRoot[:])
 
-	// Field (3) 'Fork'
-	if b.Fork == nil {
-		b.Fork = new(Fork)
-	}
-	if dst, err = b.Fork.MarshalSSZTo(dst); err != nil {
-		return
-	}
+		// Field (4) 'LogsBloom'
+		hh.PutBytes(e.LogsBloom[:])
 
-	// Field (4) 'LatestBlockHeader'
-	if b.LatestBlockHeader == nil {
-		b.LatestBlockHeader = new(BeaconBlockHeader)
-	}
-	if dst, err = b.LatestBlockHeader.MarshalSSZTo(dst); err != nil {
-		return
-	}
+		// Field (5) 'PrevRandao'
+		hh.PutBytes(e.PrevRandao[:])
 
-	// Field (5) 'BlockRoots'
-	if size := len(b.BlockRoots); size != 8192 {
-		err = ssz.ErrVectorLengthFn("BeaconStateBellatrix.BlockRoots", size, 8192)
-		return
-	}
-	for ii := 0; ii < 8192; ii++ {
-		if size := len(b.BlockRoots[ii]); size != 32 {
-			err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.BlockRoots[ii]", size, 32)
-			return
-		}
-		dst = append(dst, b.BlockRoots[ii]...)
-	}
+		// Field (6) 'BlockNumber'
+		hh.PutUint64(e.BlockNumber)
 
-	// Field (6) 'StateRoots'
-	if size := len(b.StateRoots); size != 8192 {
-		err = ssz.ErrVectorLengthFn("BeaconStateBellatrix.StateRoots", size, 8192)
-		return
-	}
-	for ii := 0; ii < 8192; ii++ {
-		if size := len(b.StateRoots[ii]); size != 32 {
-			err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.StateRoots[ii]", size, 32)
-			return
-		}
-		dst = append(dst, b.StateRoots[ii]...)
-	}
+		// Field (7) 'GasLimit'
+		hh.PutUint64(e.GasLimit)
 
-	// Offset (7) 'HistoricalRoots'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.HistoricalRoots) * 32
+		// Field (8) 'GasUsed'
+		hh.PutUint64(e.GasUsed)
 
-	// Field (8) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
-		return
-	}
+		// Field (9) 'Timestamp'
+		hh.PutUint64(e.Timestamp)
 
-	// Offset (9) 'Eth1DataVotes'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Eth1DataVotes) * 72
+		// Field (10) 'ExtraData'
+		{
+			elemIndx := hh.Index()
+			byteLen := uint64(len(e.ExtraData))
+			if byteLen > 32 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			hh.Append(e.ExtraData)
+			hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+		}
 
-	// Field (10) 'Eth1DepositIndex'
-	dst = ssz.MarshalUint64(dst, b.Eth1DepositIndex)
+		// Field (11) 'BaseFeePerGas'
+		hh.PutBytes(e.BaseFeePerGas[:])
 
-	// Offset (11) 'Validators'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Validators) * 121
+		// Field (12) 'BlockHash'
+		hh.PutBytes(e.BlockHash[:])
 
-	// Offset (12) 'Balances'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Balances) * 8
+		// Field (13) 'TransactionsRoot'
+		hh.PutBytes(e.TransactionsRoot[:])
 
-	// Field (13) 'RandaoMixes'
-	if size := len(b.RandaoMixes); size != 65536 {
-		err = ssz.ErrVectorLengthFn("BeaconStateBellatrix.RandaoMixes", size, 65536)
-		return
-	}
-	for ii := 0; ii < 65536; ii++ {
-		if size := len(b.RandaoMixes[ii]); size != 32 {
-			err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.RandaoMixes[ii]", size, 32)
-			return
-		}
-		dst = append(dst, b.RandaoMixes[ii]...)
-	}
+		// Field (14) 'WithdrawalRoot'
+		hh.PutBytes(e.WithdrawalRoot[:])
 
-	// Field (14) 'Slashings'
-	if size := len(b.Slashings); size != 8192 {
-		err = ssz.ErrVectorLengthFn("BeaconStateBellatrix.Slashings", size, 8192)
-		return
-	}
-	for ii := 0; ii < 8192; ii++ {
-		dst = ssz.MarshalUint64(dst, b.Slashings[ii])
-	}
+		// Field (15) 'BlobGasUsed'
+		hh.PutUint64(e.BlobGasUsed)
 
-	// Offset (15) 'PreviousEpochParticipation'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.PreviousEpochParticipation)
+		// Field (16) 'ExcessBlobGas'
+		hh.PutUint64(e.ExcessBlobGas)
 
-	// Offset (16) 'CurrentEpochParticipation'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.CurrentEpochParticipation)
+		hh.Merkleize(indx)
+	case fork >= ssz.ForkCapella:
+		indx := hh.Index()
 
-	// Field (17) 'JustificationBits'
-	if size := len(b.JustificationBits); size != 1 {
-		err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.JustificationBits", size, 1)
-		return
-	}
-	dst = append(dst, b.JustificationBits...)
+		// Field (0) 'ParentHash'
+		hh.PutBytes(e.ParentHash[:])
 
-	// Field (18) 'PreviousJustifiedCheckpoint'
-	if b.PreviousJustifiedCheckpoint == nil {
-		b.PreviousJustifiedCheckpoint = new(Checkpoint)
-	}
-	if dst, err = b.PreviousJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
-		return
-	}
+		// Field (1) 'FeeRecipient'
+		hh.PutBytes(e.FeeRecipient[:])
 
-	// Field (19) 'CurrentJustifiedCheckpoint'
-	if b.CurrentJustifiedCheckpoint == nil {
-		b.CurrentJustifiedCheckpoint = new(Checkpoint)
-	}
-	if dst, err = b.CurrentJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
-		return
-	}
+		// Field (2) 'StateRoot'
+		hh.PutBytes(e.StateRoot[:])
 
-	// Field (20) 'FinalizedCheckpoint'
-	if b.FinalizedCheckpoint == nil {
-		b.FinalizedCheckpoint = new(Checkpoint)
-	}
-	if dst, err = b.FinalizedCheckpoint.MarshalSSZTo(dst); err != nil {
-		return
-	}
+		// Field (3) 'ReceiptsRoot'
+		hh.PutBytes(e.ReceiptsRoot[:])
 
-	// Offset (21) 'InactivityScores'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.InactivityScores) * 8
+		// Field (4) 'LogsBloom'
+		hh.PutBytes(e.LogsBloom[:])
 
-	// Field (22) 'CurrentSyncCommittee'
-	if b.CurrentSyncCommittee == nil {
-		b.CurrentSyncCommittee = new(SyncCommittee)
-	}
-	if dst, err = b.CurrentSyncCommittee.MarshalSSZTo(dst); err != nil {
-		return
-	}
+		// Field (5) 'PrevRandao'
+		hh.PutBytes(e.PrevRandao[:])
 
-	// Field (23) 'NextSyncCommittee'
-	if b.NextSyncCommittee == nil {
-		b.NextSyncCommittee = new(SyncCommittee)
-	}
-	if dst, err = b.NextSyncCommittee.MarshalSSZTo(dst); err != nil {
-		return
-	}
+		// Field (6) 'BlockNumber'
+		hh.PutUint64(e.BlockNumber)
 
-	// Offset (24) 'LatestExecutionPayloadHeader'
-	dst = ssz.WriteOffset(dst, offset)
+		// Field (7) 'GasLimit'
+		hh.PutUint64(e.GasLimit)
 
-	// Field (7) 'HistoricalRoots'
-	if size := len(b.HistoricalRoots); size > 16777216 {
-		err = ssz.ErrListTooBigFn("BeaconStateBellatrix.HistoricalRoots", size, 16777216)
-		return
-	}
-	for ii := 0; ii < len(b.HistoricalRoots); ii++ {
-		if size := len(b.HistoricalRoots[ii]); size != 32 {
-			err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.HistoricalRoots[ii]", size, 32)
-			return
-		}
-		dst = append(dst, b.HistoricalRoots[ii]...)
-	}
+		// Field (8) 'GasUsed'
+		hh.PutUint64(e.GasUsed)
 
-	// Field (9) 'Eth1DataVotes'
-	if size := len(b.Eth1DataVotes); size > 2048 {
-		err = ssz.ErrListTooBigFn("BeaconStateBellatrix.Eth1DataVotes", size, 2048)
-		return
-	}
-	for ii := 0; ii < len(b.Eth1DataVotes); ii++ {
-		if dst, err = b.Eth1DataVotes[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
-	}
+		// Field (9) 'Timestamp'
+		hh.PutUint64(e.Timestamp)
 
-	// Field (11) 'Validators'
-	if size := len(b.Validators); size > 1099511627776 {
-		err = ssz.ErrListTooBigFn("BeaconStateBellatrix.Validators", size, 1099511627776)
-		return
-	}
-	for ii := 0; ii < len(b.Validators); ii++ {
-		if dst, err = b.Validators[ii].MarshalSSZTo(dst); err != nil {
-			return
+		// Field (10) 'ExtraData'
+		{
+			elemIndx := hh.Index()
+			byteLen := uint64(len(e.ExtraData))
+			if byteLen > 32 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			hh.Append(e.ExtraData)
+			hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
 		}
-	}
 
-	// Field (12) 'Balances'
-	if size := len(b.Balances); size > 1099511627776 {
-		err = ssz.ErrListTooBigFn("BeaconStateBellatrix.Balances", size, 1099511627776)
-		return
-	}
-	for ii := 0; ii < len(b.Balances); ii++ {
-		dst = ssz.MarshalUint64(dst, b.Balances[ii])
-	}
+		// Field (11) 'BaseFeePerGas'
+		hh.PutBytes(e.BaseFeePerGas[:])
 
-	// Field (15) 'PreviousEpochParticipation'
-	if size := len(b.PreviousEpochParticipation); size > 1099511627776 {
-		err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.PreviousEpochParticipation", size, 1099511627776)
-		return
-	}
-	dst = append(dst, b.PreviousEpochParticipation...)
+		// Field (12) 'BlockHash'
+		hh.PutBytes(e.BlockHash[:])
 
-	// Field (16) 'CurrentEpochParticipation'
-	if size := len(b.CurrentEpochParticipation); size > 1099511627776 {
-		err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.CurrentEpochParticipation", size, 1099511627776)
-		return
-	}
-	dst = append(dst, b.CurrentEpochParticipation...)
+		// Field (13) 'TransactionsRoot'
+		hh.PutBytes(e.TransactionsRoot[:])
 
-	// Field (21) 'InactivityScores'
-	if size := len(b.InactivityScores); size > 1099511627776 {
-		err = ssz.ErrListTooBigFn("BeaconStateBellatrix.InactivityScores", size, 1099511627776)
-		return
-	}
-	for ii := 0; ii < len(b.InactivityScores); ii++ {
-		dst = ssz.MarshalUint64(dst, b.InactivityScores[ii])
-	}
+		// Field (14) 'WithdrawalRoot'
+		hh.PutBytes(e.WithdrawalRoot[:])
 
-	// Field (24) 'LatestExecutionPayloadHeader'
-	if dst, err = b.LatestExecutionPayloadHeader.MarshalSSZTo(dst); err != nil {
-		return
-	}
+		hh.Merkleize(indx)
+	default:
+		indx := hh.Index()
 
-	return
-}
+		// Field (0) 'ParentHash'
+		hh.PutBytes(e.ParentHash[:])
 
-// UnmarshalSSZ ssz unmarshals the BeaconStateBellatrix object
-func (b *BeaconStateBellatrix) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size < 2736633 {
-		return ssz.ErrSize
-	}
+		// Field (1) 'FeeRecipient'
+		hh.PutBytes(e.FeeRecipient[:])
 
-	tail := buf
-	var o7, o9, o11, o12, o15, o16, o21, o24 uint64
+		// Field (2) 'StateRoot'
+		hh.PutBytes(e.StateRoot[:])
 
-	// Field (0) 'GenesisTime'
-	b.GenesisTime = ssz.UnmarshallUint64(buf[0:8])
+		// Field (3) 'ReceiptsRoot'
+		hh.PutBytes(e.ReceiptsRoot[:])
 
-	// Field (1) 'GenesisValidatorsRoot'
-	if cap(b.GenesisValidatorsRoot) == 0 {
-		b.GenesisValidatorsRoot = make([]byte, 0, len(buf[8:40]))
-	}
-	b.GenesisValidatorsRoot = append(b.GenesisValidatorsRoot, buf[8:40]...)
+		// Field (4) 'LogsBloom'
+		hh.PutBytes(e.LogsBloom[:])
 
-	// Field (2) 'Slot'
-	b.Slot = ssz.UnmarshallUint64(buf[40:48])
+		// Field (5) 'PrevRandao'
+		hh.PutBytes(e.PrevRandao[:])
 
-	// Field (3) 'Fork'
-	if b.Fork == nil {
-		b.Fork = new(Fork)
-	}
-	if err = b.Fork.UnmarshalSSZ(buf[48:64]); err != nil {
-		return err
-	}
+		// Field (6) 'BlockNumber'
+		hh.PutUint64(e.BlockNumber)
 
-	// Field (4) 'LatestBlockHeader'
-	if b.LatestBlockHeader == nil {
-		b.LatestBlockHeader = new(BeaconBlockHeader)
-	}
-	if err = b.LatestBlockHeader.UnmarshalSSZ(buf[64:176]); err != nil {
-		return err
-	}
+		// Field (7) 'GasLimit'
+		hh.PutUint64(e.GasLimit)
 
-	// Field (5) 'BlockRoots'
-	b.BlockRoots = make([][]byte, 8192)
-	for ii := 0; ii < 8192; ii++ {
-		if cap(b.BlockRoots[ii]) == 0 {
-			b.BlockRoots[ii] = make([]byte, 0, len(buf[176:262320][ii*32:(ii+1)*32]))
-		}
-		b.BlockRoots[ii] = append(b.BlockRoots[ii], buf[176:262320][ii*32:(ii+1)*32]...)
-	}
+		// Field (8) 'GasUsed'
+		hh.PutUint64(e.GasUsed)
 
-	// Field (6) 'StateRoots'
-	b.StateRoots = make([][]byte, 8192)
-	for ii := 0; ii < 8192; ii++ {
-		if cap(b.StateRoots[ii]) == 0 {
-			b.StateRoots[ii] = make([]byte, 0, len(buf[262320:524464][ii*32:(ii+1)*32]))
+		// Field (9) 'Timestamp'
+		hh.PutUint64(e.Timestamp)
+
+		// Field (10) 'ExtraData'
+		{
+			elemIndx := hh.Index()
+			byteLen := uint64(len(e.ExtraData))
+			if byteLen > 32 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			hh.Append(e.ExtraData)
+			hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
 		}
-		b.StateRoots[ii] = append(b.StateRoots[ii], buf[262320:524464][ii*32:(ii+1)*32]...)
-	}
 
-	// Offset (7) 'HistoricalRoots'
-	if o7 = ssz.ReadOffset(buf[524464:524468]); o7 > size {
-		return ssz.ErrOffset
-	}
+		// Field (11) 'BaseFeePerGas'
+		hh.PutBytes(e.BaseFeePerGas[:])
 
-	if o7 < 2736633 {
-		return ssz.ErrInvalidVariableOffset
-	}
+		// Field (12) 'BlockHash'
+		hh.PutBytes(e.BlockHash[:])
 
-	// Field (8) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if err = b.Eth1Data.UnmarshalSSZ(buf[524468:524540]); err != nil {
-		return err
-	}
+		// Field (13) 'TransactionsRoot'
+		hh.PutBytes(e.TransactionsRoot[:])
 
-	// Offset (9) 'Eth1DataVotes'
-	if o9 = ssz.ReadOffset(buf[524540:524544]); o9 > size || o7 > o9 {
-		return ssz.ErrOffset
+		hh.Merkleize(indx)
 	}
+	return
+}
 
-	// Field (10) 'Eth1DepositIndex'
-	b.Eth1DepositIndex = ssz.UnmarshallUint64(buf[524544:524552])
+// HashTreeRootSSZ ssz hashes the encoded ExecutionPayloadHeader object without decoding it
+func (e *ExecutionPayloadHeader) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(e, buf)
+}
 
-	// Offset (11) 'Validators'
-	if o11 = ssz.ReadOffset(buf[524552:524556]); o11 > size || o9 > o11 {
-		return ssz.ErrOffset
-	}
+// HashTreeRootSSZWith ssz hashes the encoded ExecutionPayloadHeader object with a hasher
+func (e *ExecutionPayloadHeader) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	return e.HashTreeRootSSZWithFork(hh, buf, ssz.ForkDeneb)
+}
 
-	// Offset (12) 'Balances'
-	if o12 = ssz.ReadOffset(buf[524556:524560]); o12 > size || o11 > o12 {
-		return ssz.ErrOffset
-	}
+// HashTreeRootSSZFork ssz hashes the encoded ExecutionPayloadHeader object for a given fork without decoding it
+func (e *ExecutionPayloadHeader) HashTreeRootSSZFork(buf []byte, fork ssz.Fork) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasherFork(e, buf, fork)
+}
 
-	// Field (13) 'RandaoMixes'
-	b.RandaoMixes = make([][]byte, 65536)
-	for ii := 0; ii < 65536; ii++ {
-		if cap(b.RandaoMixes[ii]) == 0 {
-			b.RandaoMixes[ii] = make([]byte, 0, len(buf[524560:2621712][ii*32:(ii+1)*32]))
+// HashTreeRootSSZWithFork ssz hashes the encoded ExecutionPayloadHeader object with a hasher for a given fork
+func (e *ExecutionPayloadHeader) HashTreeRootSSZWithFork(hh ssz.HashWalker, buf []byte, fork ssz.Fork) (err error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		size := uint64(len(buf))
+		if size < 584 {
+			return ssz.ErrSize
 		}
-		b.RandaoMixes[ii] = append(b.RandaoMixes[ii], buf[524560:2621712][ii*32:(ii+1)*32]...)
-	}
 
-	// Field (14) 'Slashings'
-	b.Slashings = ssz.ExtendUint64(b.Slashings, 8192)
-	for ii := 0; ii < 8192; ii++ {
-		b.Slashings[ii] = ssz.UnmarshallUint64(buf[2621712:2687248][ii*8 : (ii+1)*8])
-	}
+		tail := buf
+		var o10 uint64
 
-	// Offset (15) 'PreviousEpochParticipation'
-	if o15 = ssz.ReadOffset(buf[2687248:2687252]); o15 > size || o12 > o15 {
-		return ssz.ErrOffset
-	}
+		// Offset (10) 'ExtraData'
+		if o10 = ssz.ReadOffset(buf[436:440]); o10 > size {
+			return ssz.ErrOffset
+		}
 
-	// Offset (16) 'CurrentEpochParticipation'
-	if o16 = ssz.ReadOffset(buf[2687252:2687256]); o16 > size || o15 > o16 {
-		return ssz.ErrOffset
-	}
+		if err = ssz.ValidateFirstOffset(o10, 584); err != nil {
+			return err
+		}
 
-	// Field (17) 'JustificationBits'
-	if cap(b.JustificationBits) == 0 {
-		b.JustificationBits = make([]byte, 0, len(buf[2687256:2687257]))
-	}
-	b.JustificationBits = append(b.JustificationBits, buf[2687256:2687257]...)
+		indx := hh.Index()
 
-	// Field (18) 'PreviousJustifiedCheckpoint'
-	if b.PreviousJustifiedCheckpoint == nil {
-		b.PreviousJustifiedCheckpoint = new(Checkpoint)
-	}
-	if err = b.PreviousJustifiedCheckpoint.UnmarshalSSZ(buf[2687257:2687297]); err != nil {
-		return err
-	}
+		// Field (0) 'ParentHash'
+		hh.PutBytes(buf[0:32])
 
-	// Field (19) 'CurrentJustifiedCheckpoint'
-	if b.CurrentJustifiedCheckpoint == nil {
-		b.CurrentJustifiedCheckpoint = new(Checkpoint)
-	}
-	if err = b.CurrentJustifiedCheckpoint.UnmarshalSSZ(buf[2687297:2687337]); err != nil {
-		return err
-	}
+		// Field (1) 'FeeRecipient'
+		hh.PutBytes(buf[32:52])
 
-	// Field (20) 'FinalizedCheckpoint'
-	if b.FinalizedCheckpoint == nil {
-		b.FinalizedCheckpoint = new(Checkpoint)
-	}
-	if err = b.FinalizedCheckpoint.UnmarshalSSZ(buf[2687337:2687377]); err != nil {
-		return err
-	}
+		// Field (2) 'StateRoot'
+		hh.PutBytes(buf[52:84])
 
-	// Offset (21) 'InactivityScores'
-	if o21 = ssz.ReadOffset(buf[2687377:2687381]); o21 > size || o16 > o21 {
-		return ssz.ErrOffset
-	}
+		// Field (3) 'ReceiptsRoot'
+		hh.PutBytes(buf[84:116])
 
-	// Field (22) 'CurrentSyncCommittee'
-	if b.CurrentSyncCommittee == nil {
-		b.CurrentSyncCommittee = new(SyncCommittee)
-	}
-	if err = b.CurrentSyncCommittee.UnmarshalSSZ(buf[2687381:2712005]); err != nil {
-		return err
-	}
+		// Field (4) 'LogsBloom'
+		hh.PutBytes(buf[116:372])
 
-	// Field (23) 'NextSyncCommittee'
-	if b.NextSyncCommittee == nil {
-		b.NextSyncCommittee = new(SyncCommittee)
-	}
-	if err = b.NextSyncCommittee.UnmarshalSSZ(buf[2712005:2736629]); err != nil {
-		return err
-	}
+		// Field (5) 'PrevRandao'
+		hh.PutBytes(buf[372:404])
 
-	// Offset (24) 'LatestExecutionPayloadHeader'
-	if o24 = ssz.ReadOffset(buf[2736629:2736633]); o24 > size || o21 > o24 {
-		return ssz.ErrOffset
-	}
+		// Field (6) 'BlockNumber'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[404:412]))
 
-	// Field (7) 'HistoricalRoots'
-	{
-		buf = tail[o7:o9]
-		num, err := ssz.DivideInt2(len(buf), 32, 16777216)
-		if err != nil {
-			return err
-		}
-		b.HistoricalRoots = make([][]byte, num)
-		for ii := 0; ii < num; ii++ {
-			if cap(b.HistoricalRoots[ii]) == 0 {
-				b.HistoricalRoots[ii] = make([]byte, 0, len(buf[ii*32:(ii+1)*32]))
+		// Field (7) 'GasLimit'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[412:420]))
+
+		// Field (8) 'GasUsed'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[420:428]))
+
+		// Field (9) 'Timestamp'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[428:436]))
+
+		// Field (10) 'ExtraData'
+		{
+			buf := tail[o10:]
+			if len(buf) > 32 {
+				return ssz.ErrBytesLength
+			}
+			{
+				elemIndx := hh.Index()
+				hh.Append(buf)
+				hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (32+31)/32)
 			}
-			b.HistoricalRoots[ii] = append(b.HistoricalRoots[ii], buf[ii*32:(ii+1)*32]...)
 		}
-	}
 
-	// Field (9) 'Eth1DataVotes'
-	{
-		buf = tail[o9:o11]
-		num, err := ssz.DivideInt2(len(buf), 72, 2048)
-		if err != nil {
-			return err
+		// Field (11) 'BaseFeePerGas'
+		hh.PutBytes(buf[440:472])
+
+		// Field (12) 'BlockHash'
+		hh.PutBytes(buf[472:504])
+
+		// Field (13) 'TransactionsRoot'
+		hh.PutBytes(buf[504:536])
+
+		// Field (14) 'WithdrawalRoot'
+		hh.PutBytes(buf[536:568])
+
+		// Field (15) 'BlobGasUsed'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[568:576]))
+
+		// Field (16) 'ExcessBlobGas'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[576:584]))
+
+		hh.Merkleize(indx)
+	case fork >= ssz.ForkCapella:
+		size := uint64(len(buf))
+		if size < 568 {
+			return ssz.ErrSize
 		}
-		b.Eth1DataVotes = make([]*Eth1Data, num)
-		for ii := 0; ii < num; ii++ {
-			if b.Eth1DataVotes[ii] == nil {
-				b.Eth1DataVotes[ii] = new(Eth1Data)
-			}
-			if err = b.Eth1DataVotes[ii].UnmarshalSSZ(buf[ii*72 : (ii+1)*72]); err != nil {
-				return err
-			}
+
+		tail := buf
+		var o10 uint64
+
+		// Offset (10) 'ExtraData'
+		if o10 = ssz.ReadOffset(buf[436:440]); o10 > size {
+			return ssz.ErrOffset
 		}
-	}
 
-	// Field (11) 'Validators'
-	{
-		buf = tail[o11:o12]
-		num, err := ssz.DivideInt2(len(buf), 121, 1099511627776)
-		if err != nil {
+		if err = ssz.ValidateFirstOffset(o10, 568); err != nil {
 			return err
 		}
-		b.Validators = make([]*Validator, num)
-		for ii := 0; ii < num; ii++ {
-			if b.Validators[ii] == nil {
-				b.Validators[ii] = new(Validator)
+
+		indx := hh.Index()
+
+		// Field (0) 'ParentHash'
+		hh.PutBytes(buf[0:32])
+
+		// Field (1) 'FeeRecipient'
+		hh.PutBytes(buf[32:52])
+
+		// Field (2) 'StateRoot'
+		hh.PutBytes(buf[52:84])
+
+		// Field (3) 'ReceiptsRoot'
+		hh.PutBytes(buf[84:116])
+
+		// Field (4) 'LogsBloom'
+		hh.PutBytes(buf[116:372])
+
+		// Field (5) 'PrevRandao'
+		hh.PutBytes(buf[372:404])
+
+		// Field (6) 'BlockNumber'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[404:412]))
+
+		// Field (7) 'GasLimit'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[412:420]))
+
+		// Field (8) 'GasUsed'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[420:428]))
+
+		// Field (9) 'Timestamp'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[428:436]))
+
+		// Field (10) 'ExtraData'
+		{
+			buf := tail[o10:]
+			if len(buf) > 32 {
+				return ssz.ErrBytesLength
 			}
-			if err = b.Validators[ii].UnmarshalSSZ(buf[ii*121 : (ii+1)*121]); err != nil {
-				return err
+			{
+				elemIndx := hh.Index()
+				hh.Append(buf)
+				hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (32+31)/32)
 			}
 		}
-	}
 
-	// Field (12) 'Balances'
-	{
-		buf = tail[o12:o15]
-		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
-		if err != nil {
-			return err
+		// Field (11) 'BaseFeePerGas'
+		hh.PutBytes(buf[440:472])
+
+		// Field (12) 'BlockHash'
+		hh.PutBytes(buf[472:504])
+
+		// Field (13) 'TransactionsRoot'
+		hh.PutBytes(buf[504:536])
+
+		// Field (14) 'WithdrawalRoot'
+		hh.PutBytes(buf[536:568])
+
+		hh.Merkleize(indx)
+	default:
+		size := uint64(len(buf))
+		if size < 536 {
+			return ssz.ErrSize
 		}
-		b.Balances = ssz.ExtendUint64(b.Balances, num)
-		for ii := 0; ii < num; ii++ {
-			b.Balances[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+
+		tail := buf
+		var o10 uint64
+
+		// Offset (10) 'ExtraData'
+		if o10 = ssz.ReadOffset(buf[436:440]); o10 > size {
+			return ssz.ErrOffset
 		}
-	}
 
-	// Field (15) 'PreviousEpochParticipation'
-	{
-		buf = tail[o15:o16]
-		if len(buf) > 1099511627776 {
-			return ssz.ErrBytesLength
+		if err = ssz.ValidateFirstOffset(o10, 536); err != nil {
+			return err
 		}
-		if cap(b.PreviousEpochParticipation) == 0 {
-			b.PreviousEpochParticipation = make([]byte, 0, len(buf))
+
+		indx := hh.Index()
+
+		// Field (0) 'ParentHash'
+		hh.PutBytes(buf[0:32])
+
+		// Field (1) 'FeeRecipient'
+		hh.PutBytes(buf[32:52])
+
+		// Field (2) 'StateRoot'
+		hh.PutBytes(buf[52:84])
+
+		// Field (3) 'ReceiptsRoot'
+		hh.PutBytes(buf[84:116])
+
+		// Field (4) 'LogsBloom'
+		hh.PutBytes(buf[116:372])
+
+		// Field (5) 'PrevRandao'
+		hh.PutBytes(buf[372:404])
+
+		// Field (6) 'BlockNumber'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[404:412]))
+
+		// Field (7) 'GasLimit'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[412:420]))
+
+		// Field (8) 'GasUsed'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[420:428]))
+
+		// Field (9) 'Timestamp'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[428:436]))
+
+		// Field (10) 'ExtraData'
+		{
+			buf := tail[o10:]
+			if len(buf) > 32 {
+				return ssz.ErrBytesLength
+			}
+			{
+				elemIndx := hh.Index()
+				hh.Append(buf)
+				hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (32+31)/32)
+			}
 		}
-		b.PreviousEpochParticipation = append(b.PreviousEpochParticipation, buf...)
+
+		// Field (11) 'BaseFeePerGas'
+		hh.PutBytes(buf[440:472])
+
+		// Field (12) 'BlockHash'
+		hh.PutBytes(buf[472:504])
+
+		// Field (13) 'TransactionsRoot'
+		hh.PutBytes(buf[504:536])
+
+		hh.Merkleize(indx)
 	}
+	return
+}
+
+// GetTree ssz hashes the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(e)
+}
+
+// GetTreeFork ssz hashes the ExecutionPayloadHeader object for a given fork
+func (e *ExecutionPayloadHeader) GetTreeFork(fork ssz.Fork) (*ssz.Node, error) {
+	return ssz.ProofTreeFork(e, fork)
+}
+
+const (
+	// ExecutionPayloadHeaderParentHashGIndex is the generalized index of the 'ParentHash' field at the deneb fork
+	ExecutionPayloadHeaderParentHashGIndex ssz.GIndex = 32
+	// ExecutionPayloadHeaderFeeRecipientGIndex is the generalized index of the 'FeeRecipient' field at the deneb fork
+	ExecutionPayloadHeaderFeeRecipientGIndex ssz.GIndex = 33
+	// ExecutionPayloadHeaderStateRootGIndex is the generalized index of the 'StateRoot' field at the deneb fork
+	ExecutionPayloadHeaderStateRootGIndex ssz.GIndex = 34
+	// ExecutionPayloadHeaderReceiptsRootGIndex is the generalized index of the 'ReceiptsRoot' field at the deneb fork
+	ExecutionPayloadHeaderReceiptsRootGIndex ssz.GIndex = 35
+	// ExecutionPayloadHeaderLogsBloomGIndex is the generalized index of the 'LogsBloom' field at the deneb fork
+	ExecutionPayloadHeaderLogsBloomGIndex ssz.GIndex = 36
+	// ExecutionPayloadHeaderPrevRandaoGIndex is the generalized index of the 'PrevRandao' field at the deneb fork
+	ExecutionPayloadHeaderPrevRandaoGIndex ssz.GIndex = 37
+	// ExecutionPayloadHeaderBlockNumberGIndex is the generalized index of the 'BlockNumber' field at the deneb fork
+	ExecutionPayloadHeaderBlockNumberGIndex ssz.GIndex = 38
+	// ExecutionPayloadHeaderGasLimitGIndex is the generalized index of the 'GasLimit' field at the deneb fork
+	ExecutionPayloadHeaderGasLimitGIndex ssz.GIndex = 39
+	// ExecutionPayloadHeaderGasUsedGIndex is the generalized index of the 'GasUsed' field at the deneb fork
+	ExecutionPayloadHeaderGasUsedGIndex ssz.GIndex = 40
+	// ExecutionPayloadHeaderTimestampGIndex is the generalized index of the 'Timestamp' field at the deneb fork
+	ExecutionPayloadHeaderTimestampGIndex ssz.GIndex = 41
+	// ExecutionPayloadHeaderExtraDataGIndex is the generalized index of the 'ExtraData' field at the deneb fork
+	ExecutionPayloadHeaderExtraDataGIndex ssz.GIndex = 42
+	// ExecutionPayloadHeaderBaseFeePerGasGIndex is the generalized index of the 'BaseFeePerGas' field at the deneb fork
+	ExecutionPayloadHeaderBaseFeePerGasGIndex ssz.GIndex = 43
+	// ExecutionPayloadHeaderBlockHashGIndex is the generalized index of the 'BlockHash' field at the deneb fork
+	ExecutionPayloadHeaderBlockHashGIndex ssz.GIndex = 44
+	// ExecutionPayloadHeaderTransactionsRootGIndex is the generalized index of the 'TransactionsRoot' field at the deneb fork
+	ExecutionPayloadHeaderTransactionsRootGIndex ssz.GIndex = 45
+	// ExecutionPayloadHeaderWithdrawalRootGIndex is the generalized index of the 'WithdrawalRoot' field at the deneb fork
+	ExecutionPayloadHeaderWithdrawalRootGIndex ssz.GIndex = 46
+	// ExecutionPayloadHeaderBlobGasUsedGIndex is the generalized index of the 'BlobGasUsed' field at the deneb fork
+	ExecutionPayloadHeaderBlobGasUsedGIndex ssz.GIndex = 47
+	// ExecutionPayloadHeaderExcessBlobGasGIndex is the generalized index of the 'ExcessBlobGas' field at the deneb fork
+	ExecutionPayloadHeaderExcessBlobGasGIndex ssz.GIndex = 48
+)
+
+// ProveParentHash returns a merkle proof of the 'ParentHash' field of the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) ProveParentHash() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ExecutionPayloadHeaderParentHashGIndex)
+}
 
-	// Field (16) 'CurrentEpochParticipation'
-	{
-		buf = tail[o16:o21]
-		if len(buf) > 1099511627776 {
-			return ssz.ErrBytesLength
-		}
-		if cap(b.CurrentEpochParticipation) == 0 {
-			b.CurrentEpochParticipation = make([]byte, 0, len(buf))
-		}
-		b.CurrentEpochParticipation = append(b.CurrentEpochParticipation, buf...)
-	}
+// VerifyExecutionPayloadHeaderParentHash verifies a merkle proof of the 'ParentHash' field of a ExecutionPayloadHeader object
+func VerifyExecutionPayloadHeaderParentHash(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ExecutionPayloadHeaderParentHashGIndex)
+}
 
-	// Field (21) 'InactivityScores'
-	{
-		buf = tail[o21:o24]
-		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
-		if err != nil {
-			return err
-		}
-		b.InactivityScores = ssz.ExtendUint64(b.InactivityScores, num)
-		for ii := 0; ii < num; ii++ {
-			b.InactivityScores[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
-		}
-	}
+// ProveFeeRecipient returns a merkle proof of the 'FeeRecipient' field of the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) ProveFeeRecipient() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ExecutionPayloadHeaderFeeRecipientGIndex)
+}
 
-	// Field (24) 'LatestExecutionPayloadHeader'
-	{
-		buf = tail[o24:]
-		if b.LatestExecutionPayloadHeader == nil {
-			b.LatestExecutionPayloadHeader = new(ExecutionPayloadHeader)
-		}
-		if err = b.LatestExecutionPayloadHeader.UnmarshalSSZ(buf); err != nil {
-			return err
-		}
-	}
-	return err
+// VerifyExecutionPayloadHeaderFeeRecipient verifies a merkle proof of the 'FeeRecipient' field of a ExecutionPayloadHeader object
+func VerifyExecutionPayloadHeaderFeeRecipient(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ExecutionPayloadHeaderFeeRecipientGIndex)
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconStateBellatrix object
-func (b *BeaconStateBellatrix) SizeSSZ() (size int) {
-	size = 2736633
+// ProveStateRoot returns a merkle proof of the 'StateRoot' field of the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) ProveStateRoot() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ExecutionPayloadHeaderStateRootGIndex)
+}
 
-	// Field (7) 'HistoricalRoots'
-	size += len(b.HistoricalRoots) * 32
+// VerifyExecutionPayloadHeaderStateRoot verifies a merkle proof of the 'StateRoot' field of a ExecutionPayloadHeader object
+func VerifyExecutionPayloadHeaderStateRoot(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ExecutionPayloadHeaderStateRootGIndex)
+}
 
-	// Field (9) 'Eth1DataVotes'
-	size += len(b.Eth1DataVotes) * 72
+// ProveReceiptsRoot returns a merkle proof of the 'ReceiptsRoot' field of the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) ProveReceiptsRoot() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ExecutionPayloadHeaderReceiptsRootGIndex)
+}
 
-	// Field (11) 'Validators'
-	size += len(b.Validators) * 121
+// VerifyExecutionPayloadHeaderReceiptsRoot verifies a merkle proof of the 'ReceiptsRoot' field of a ExecutionPayloadHeader object
+func VerifyExecutionPayloadHeaderReceiptsRoot(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ExecutionPayloadHeaderReceiptsRootGIndex)
+}
 
-	// Field (12) 'Balances'
-	size += len(b.Balances) * 8
+// ProveLogsBloom returns a merkle proof of the 'LogsBloom' field of the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) ProveLogsBloom() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ExecutionPayloadHeaderLogsBloomGIndex)
+}
 
-	// Field (15) 'PreviousEpochParticipation'
-	size += len(b.PreviousEpochParticipation)
+// VerifyExecutionPayloadHeaderLogsBloom verifies a merkle proof of the 'LogsBloom' field of a ExecutionPayloadHeader object
+func VerifyExecutionPayloadHeaderLogsBloom(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ExecutionPayloadHeaderLogsBloomGIndex)
+}
 
-	// Field (16) 'CurrentEpochParticipation'
-	size += len(b.CurrentEpochParticipation)
+// ProvePrevRandao returns a merkle proof of the 'PrevRandao' field of the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) ProvePrevRandao() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ExecutionPayloadHeaderPrevRandaoGIndex)
+}
 
-	// Field (21) 'InactivityScores'
-	size += len(b.InactivityScores) * 8
+// VerifyExecutionPayloadHeaderPrevRandao verifies a merkle proof of the 'PrevRandao' field of a ExecutionPayloadHeader object
+func VerifyExecutionPayloadHeaderPrevRandao(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ExecutionPayloadHeaderPrevRandaoGIndex)
+}
 
-	// Field (24) 'LatestExecutionPayloadHeader'
-	if b.LatestExecutionPayloadHeader == nil {
-		b.LatestExecutionPayloadHeader = new(ExecutionPayloadHeader)
-	}
-	size += b.LatestExecutionPayloadHeader.SizeSSZ()
+// ProveBlockNumber returns a merkle proof of the 'BlockNumber' field of the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) ProveBlockNumber() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ExecutionPayloadHeaderBlockNumberGIndex)
+}
 
-	return
+// VerifyExecutionPayloadHeaderBlockNumber verifies a merkle proof of the 'BlockNumber' field of a ExecutionPayloadHeader object
+func VerifyExecutionPayloadHeaderBlockNumber(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ExecutionPayloadHeaderBlockNumberGIndex)
 }
 
-// HashTreeRoot ssz hashes the BeaconStateBellatrix object
-func (b *BeaconStateBellatrix) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(b)
+// ProveGasLimit returns a merkle proof of the 'GasLimit' field of the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) ProveGasLimit() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ExecutionPayloadHeaderGasLimitGIndex)
 }
 
-// HashTreeRootWith ssz hashes the BeaconStateBellatrix object with a hasher
-func (b *BeaconStateBellatrix) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
+// VerifyExecutionPayloadHeaderGasLimit verifies a merkle proof of the 'GasLimit' field of a ExecutionPayloadHeader object
+func VerifyExecutionPayloadHeaderGasLimit(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ExecutionPayloadHeaderGasLimitGIndex)
+}
 
-	// Field (0) 'GenesisTime'
-	hh.PutUint64(b.GenesisTime)
+// ProveGasUsed returns a merkle proof of the 'GasUsed' field of the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) ProveGasUsed() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ExecutionPayloadHeaderGasUsedGIndex)
+}
 
-	// Field (1) 'GenesisValidatorsRoot'
-	if size := len(b.GenesisValidatorsRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.GenesisValidatorsRoot", size, 32)
-		return
-	}
-	hh.PutBytes(b.GenesisValidatorsRoot)
+// VerifyExecutionPayloadHeaderGasUsed verifies a merkle proof of the 'GasUsed' field of a ExecutionPayloadHeader object
+func VerifyExecutionPayloadHeaderGasUsed(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ExecutionPayloadHeaderGasUsedGIndex)
+}
 
-	// Field (2) 'Slot'
-	hh.PutUint64(b.Slot)
+// ProveTimestamp returns a merkle proof of the 'Timestamp' field of the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) ProveTimestamp() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ExecutionPayloadHeaderTimestampGIndex)
+}
 
-	// Field (3) 'Fork'
-	if b.Fork == nil {
-		b.Fork = new(Fork)
-	}
-	if err = b.Fork.HashTreeRootWith(hh); err != nil {
-		return
-	}
+// VerifyExecutionPayloadHeaderTimestamp verifies a merkle proof of the 'Timestamp' field of a ExecutionPayloadHeader object
+func VerifyExecutionPayloadHeaderTimestamp(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ExecutionPayloadHeaderTimestampGIndex)
+}
 
-	// Field (4) 'LatestBlockHeader'
-	if b.LatestBlockHeader == nil {
-		b.LatestBlockHeader = new(BeaconBlockHeader)
-	}
-	if err = b.LatestBlockHeader.HashTreeRootWith(hh); err != nil {
-		return
-	}
+// ProveExtraData returns a merkle proof of the 'ExtraData' field of the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) ProveExtraData() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ExecutionPayloadHeaderExtraDataGIndex)
+}
 
-	// Field (5) 'BlockRoots'
-	{
-		if size := len(b.BlockRoots); size != 8192 {
-			err = ssz.ErrVectorLengthFn("BeaconStateBellatrix.BlockRoots", size, 8192)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.BlockRoots {
-			if len(i) != 32 {
-				err = ssz.ErrBytesLength
-				return
-			}
-			hh.Append(i)
-		}
-		hh.Merkleize(subIndx)
-	}
+// VerifyExecutionPayloadHeaderExtraData verifies a merkle proof of the 'ExtraData' field of a ExecutionPayloadHeader object
+func VerifyExecutionPayloadHeaderExtraData(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ExecutionPayloadHeaderExtraDataGIndex)
+}
 
-	// Field (6) 'StateRoots'
-	{
-		if size := len(b.StateRoots); size != 8192 {
-			err = ssz.ErrVectorLengthFn("BeaconStateBellatrix.StateRoots", size, 8192)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.StateRoots {
-			if len(i) != 32 {
-				err = ssz.ErrBytesLength
-				return
-			}
-			hh.Append(i)
-		}
-		hh.Merkleize(subIndx)
-	}
+// ProveBaseFeePerGas returns a merkle proof of the 'BaseFeePerGas' field of the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) ProveBaseFeePerGas() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ExecutionPayloadHeaderBaseFeePerGasGIndex)
+}
 
-	// Field (7) 'HistoricalRoots'
-	{
-		if size := len(b.HistoricalRoots); size > 16777216 {
-			err = ssz.ErrListTooBigFn("BeaconStateBellatrix.HistoricalRoots", size, 16777216)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.HistoricalRoots {
-			if len(i) != 32 {
-				err = ssz.ErrBytesLength
-				return
-			}
-			hh.Append(i)
-		}
-		numItems := uint64(len(b.HistoricalRoots))
-		hh.MerkleizeWithMixin(subIndx, numItems, 16777216)
-	}
+// VerifyExecutionPayloadHeaderBaseFeePerGas verifies a merkle proof of the 'BaseFeePerGas' field of a ExecutionPayloadHeader object
+func VerifyExecutionPayloadHeaderBaseFeePerGas(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ExecutionPayloadHeaderBaseFeePerGasGIndex)
+}
 
-	// Field (8) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
-		return
-	}
+// ProveBlockHash returns a merkle proof of the 'BlockHash' field of the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) ProveBlockHash() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ExecutionPayloadHeaderBlockHashGIndex)
+}
 
-	// Field (9) 'Eth1DataVotes'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.Eth1DataVotes))
-		if num > 2048 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.Eth1DataVotes {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, 2048)
-	}
+// VerifyExecutionPayloadHeaderBlockHash verifies a merkle proof of the 'BlockHash' field of a ExecutionPayloadHeader object
+func VerifyExecutionPayloadHeaderBlockHash(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ExecutionPayloadHeaderBlockHashGIndex)
+}
 
-	// Field (10) 'Eth1DepositIndex'
-	hh.PutUint64(b.Eth1DepositIndex)
+// ProveTransactionsRoot returns a merkle proof of the 'TransactionsRoot' field of the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) ProveTransactionsRoot() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ExecutionPayloadHeaderTransactionsRootGIndex)
+}
 
-	// Field (11) 'Validators'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.Validators))
-		if num > 1099511627776 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.Validators {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
-	}
+// VerifyExecutionPayloadHeaderTransactionsRoot verifies a merkle proof of the 'TransactionsRoot' field of a ExecutionPayloadHeader object
+func VerifyExecutionPayloadHeaderTransactionsRoot(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ExecutionPayloadHeaderTransactionsRootGIndex)
+}
 
-	// Field (12) 'Balances'
-	{
-		if size := len(b.Balances); size > 1099511627776 {
-			err = ssz.ErrListTooBigFn("BeaconStateBellatrix.Balances", size, 1099511627776)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.Balances {
-			hh.AppendUint64(i)
-		}
-		hh.FillUpTo32()
-		numItems := uint64(len(b.Balances))
-		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
-	}
+// ProveWithdrawalRoot returns a merkle proof of the 'WithdrawalRoot' field of the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) ProveWithdrawalRoot() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ExecutionPayloadHeaderWithdrawalRootGIndex)
+}
 
-	// Field (13) 'RandaoMixes'
-	{
-		if size := len(b.RandaoMixes); size != 65536 {
-			err = ssz.ErrVectorLengthFn("BeaconStateBellatrix.RandaoMixes", size, 65536)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.RandaoMixes {
-			if len(i) != 32 {
-				err = ssz.ErrBytesLength
-				return
-			}
-			hh.Append(i)
-		}
-		hh.Merkleize(subIndx)
-	}
+// VerifyExecutionPayloadHeaderWithdrawalRoot verifies a merkle proof of the 'WithdrawalRoot' field of a ExecutionPayloadHeader object
+func VerifyExecutionPayloadHeaderWithdrawalRoot(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ExecutionPayloadHeaderWithdrawalRootGIndex)
+}
 
-	// Field (14) 'Slashings'
-	{
-		if size := len(b.Slashings); size != 8192 {
-			err = ssz.ErrVectorLengthFn("BeaconStateBellatrix.Slashings", size, 8192)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.Slashings {
-			hh.AppendUint64(i)
-		}
-		hh.Merkleize(subIndx)
-	}
+// ProveBlobGasUsed returns a merkle proof of the 'BlobGasUsed' field of the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) ProveBlobGasUsed() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ExecutionPayloadHeaderBlobGasUsedGIndex)
+}
 
-	// Field (15) 'PreviousEpochParticipation'
-	{
-		elemIndx := hh.Index()
-		byteLen := uint64(len(b.PreviousEpochParticipation))
-		if byteLen > 1099511627776 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		hh.Append(b.PreviousEpochParticipation)
-		hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
-	}
+// VerifyExecutionPayloadHeaderBlobGasUsed verifies a merkle proof of the 'BlobGasUsed' field of a ExecutionPayloadHeader object
+func VerifyExecutionPayloadHeaderBlobGasUsed(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ExecutionPayloadHeaderBlobGasUsedGIndex)
+}
 
-	// Field (16) 'CurrentEpochParticipation'
-	{
-		elemIndx := hh.Index()
-		byteLen := uint64(len(b.CurrentEpochParticipation))
-		if byteLen > 1099511627776 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		hh.Append(b.CurrentEpochParticipation)
-		hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
-	}
+// ProveExcessBlobGas returns a merkle proof of the 'ExcessBlobGas' field of the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) ProveExcessBlobGas() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(e, ExecutionPayloadHeaderExcessBlobGasGIndex)
+}
 
-	// Field (17) 'JustificationBits'
-	if size := len(b.JustificationBits); size != 1 {
-		err = ssz.ErrBytesLengthFn("BeaconStateBellatrix.JustificationBits", size, 1)
-		return
-	}
-	hh.PutBytes(b.JustificationBits)
+// VerifyExecutionPayloadHeaderExcessBlobGas verifies a merkle proof of the 'ExcessBlobGas' field of a ExecutionPayloadHeader object
+func VerifyExecutionPayloadHeaderExcessBlobGas(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ExecutionPayloadHeaderExcessBlobGasGIndex)
+}
 
-	// Field (18) 'PreviousJustifiedCheckpoint'
-	if b.PreviousJustifiedCheckpoint == nil {
-		b.PreviousJustifiedCheckpoint = new(Checkpoint)
-	}
-	if err = b.PreviousJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
-		return
+// ExecutionPayloadHeaderParentHashGIndexFork returns the generalized index of the 'ParentHash' field for a given fork
+func ExecutionPayloadHeaderParentHashGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 32, nil
+	default:
+		return 16, nil
 	}
+}
 
-	// Field (19) 'CurrentJustifiedCheckpoint'
-	if b.CurrentJustifiedCheckpoint == nil {
-		b.CurrentJustifiedCheckpoint = new(Checkpoint)
-	}
-	if err = b.CurrentJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
-		return
+// ProveParentHashFork returns a merkle proof of the 'ParentHash' field of the ExecutionPayloadHeader object for a given fork
+func (e *ExecutionPayloadHeader) ProveParentHashFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ExecutionPayloadHeaderParentHashGIndexFork(fork)
+	if err != nil {
+		return nil, err
 	}
+	return ssz.ProveGIndexFork(e, gindex, fork)
+}
 
-	// Field (20) 'FinalizedCheckpoint'
-	if b.FinalizedCheckpoint == nil {
-		b.FinalizedCheckpoint = new(Checkpoint)
-	}
-	if err = b.FinalizedCheckpoint.HashTreeRootWith(hh); err != nil {
-		return
+// VerifyExecutionPayloadHeaderParentHashFork verifies a merkle proof of the 'ParentHash' field of a ExecutionPayloadHeader object for a given fork
+func VerifyExecutionPayloadHeaderParentHashFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ExecutionPayloadHeaderParentHashGIndexFork(fork)
+	if err != nil {
+		return false, err
 	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
 
-	// Field (21) 'InactivityScores'
-	{
-		if size := len(b.InactivityScores); size > 1099511627776 {
-			err = ssz.ErrListTooBigFn("BeaconStateBellatrix.InactivityScores", size, 1099511627776)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.InactivityScores {
-			hh.AppendUint64(i)
-		}
-		hh.FillUpTo32()
-		numItems := uint64(len(b.InactivityScores))
-		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
+// ExecutionPayloadHeaderFeeRecipientGIndexFork returns the generalized index of the 'FeeRecipient' field for a given fork
+func ExecutionPayloadHeaderFeeRecipientGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 33, nil
+	default:
+		return 17, nil
 	}
+}
 
-	// Field (22) 'CurrentSyncCommittee'
-	if b.CurrentSyncCommittee == nil {
-		b.CurrentSyncCommittee = new(SyncCommittee)
-	}
-	if err = b.CurrentSyncCommittee.HashTreeRootWith(hh); err != nil {
-		return
+// ProveFeeRecipientFork returns a merkle proof of the 'FeeRecipient' field of the ExecutionPayloadHeader object for a given fork
+func (e *ExecutionPayloadHeader) ProveFeeRecipientFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ExecutionPayloadHeaderFeeRecipientGIndexFork(fork)
+	if err != nil {
+		return nil, err
 	}
+	return ssz.ProveGIndexFork(e, gindex, fork)
+}
 
-	// Field (23) 'NextSyncCommittee'
-	if b.NextSyncCommittee == nil {
-		b.NextSyncCommittee = new(SyncCommittee)
-	}
-	if err = b.NextSyncCommittee.HashTreeRootWith(hh); err != nil {
-		return
+// VerifyExecutionPayloadHeaderFeeRecipientFork verifies a merkle proof of the 'FeeRecipient' field of a ExecutionPayloadHeader object for a given fork
+func VerifyExecutionPayloadHeaderFeeRecipientFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ExecutionPayloadHeaderFeeRecipientGIndexFork(fork)
+	if err != nil {
+		return false, err
 	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
 
-	// Field (24) 'LatestExecutionPayloadHeader'
-	if err = b.LatestExecutionPayloadHeader.HashTreeRootWith(hh); err != nil {
-		return
+// ExecutionPayloadHeaderStateRootGIndexFork returns the generalized index of the 'StateRoot' field for a given fork
+func ExecutionPayloadHeaderStateRootGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 34, nil
+	default:
+		return 18, nil
 	}
-
-	hh.Merkleize(indx)
-	return
 }
 
-// GetTree ssz hashes the BeaconStateBellatrix object
-func (b *BeaconStateBellatrix) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(b)
+// ProveStateRootFork returns a merkle proof of the 'StateRoot' field of the ExecutionPayloadHeader object for a given fork
+func (e *ExecutionPayloadHeader) ProveStateRootFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ExecutionPayloadHeaderStateRootGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(e, gindex, fork)
 }
 
-// MarshalSSZ ssz marshals the SignedBeaconBlockHeader object
-func (s *SignedBeaconBlockHeader) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(s)
+// VerifyExecutionPayloadHeaderStateRootFork verifies a merkle proof of the 'StateRoot' field of a ExecutionPayloadHeader object for a given fork
+func VerifyExecutionPayloadHeaderStateRootFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ExecutionPayloadHeaderStateRootGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
 }
 
-// MarshalSSZTo ssz marshals the SignedBeaconBlockHeader object to a target array
-func (s *SignedBeaconBlockHeader) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-
-	// Field (0) 'Header'
-	if s.Header == nil {
-		s.Header = new(BeaconBlockHeader)
-	}
-	if dst, err = s.Header.MarshalSSZTo(dst); err != nil {
-		return
+// ExecutionPayloadHeaderReceiptsRootGIndexFork returns the generalized index of the 'ReceiptsRoot' field for a given fork
+func ExecutionPayloadHeaderReceiptsRootGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 35, nil
+	default:
+		return 19, nil
 	}
+}
 
-	// Field (1) 'Signature'
-	if size := len(s.Signature); size != 96 {
-		err = ssz.ErrBytesLengthFn("SignedBeaconBlockHeader.Signature", size, 96)
-		return
+// ProveReceiptsRootFork returns a merkle proof of the 'ReceiptsRoot' field of the ExecutionPayloadHeader object for a given fork
+func (e *ExecutionPayloadHeader) ProveReceiptsRootFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ExecutionPayloadHeaderReceiptsRootGIndexFork(fork)
+	if err != nil {
+		return nil, err
 	}
-	dst = append(dst, s.Signature...)
-
-	return
+	return ssz.ProveGIndexFork(e, gindex, fork)
 }
 
-// UnmarshalSSZ ssz unmarshals the SignedBeaconBlockHeader object
-func (s *SignedBeaconBlockHeader) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 208 {
-		return ssz.ErrSize
+// VerifyExecutionPayloadHeaderReceiptsRootFork verifies a merkle proof of the 'ReceiptsRoot' field of a ExecutionPayloadHeader object for a given fork
+func VerifyExecutionPayloadHeaderReceiptsRootFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ExecutionPayloadHeaderReceiptsRootGIndexFork(fork)
+	if err != nil {
+		return false, err
 	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
 
-	// Field (0) 'Header'
-	if s.Header == nil {
-		s.Header = new(BeaconBlockHeader)
-	}
-	if err = s.Header.UnmarshalSSZ(buf[0:112]); err != nil {
-		return err
+// ExecutionPayloadHeaderLogsBloomGIndexFork returns the generalized index of the 'LogsBloom' field for a given fork
+func ExecutionPayloadHeaderLogsBloomGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 36, nil
+	default:
+		return 20, nil
 	}
+}
 
-	// Field (1) 'Signature'
-	if cap(s.Signature) == 0 {
-		s.Signature = make([]byte, 0, len(buf[112:208]))
+// ProveLogsBloomFork returns a merkle proof of the 'LogsBloom' field of the ExecutionPayloadHeader object for a given fork
+func (e *ExecutionPayloadHeader) ProveLogsBloomFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ExecutionPayloadHeaderLogsBloomGIndexFork(fork)
+	if err != nil {
+		return nil, err
 	}
-	s.Signature = append(s.Signature, buf[112:208]...)
+	return ssz.ProveGIndexFork(e, gindex, fork)
+}
 
-	return err
+// VerifyExecutionPayloadHeaderLogsBloomFork verifies a merkle proof of the 'LogsBloom' field of a ExecutionPayloadHeader object for a given fork
+func VerifyExecutionPayloadHeaderLogsBloomFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ExecutionPayloadHeaderLogsBloomGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the SignedBeaconBlockHeader object
-func (s *SignedBeaconBlockHeader) SizeSSZ() (size int) {
-	size = 208
-	return
+// ExecutionPayloadHeaderPrevRandaoGIndexFork returns the generalized index of the 'PrevRandao' field for a given fork
+func ExecutionPayloadHeaderPrevRandaoGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 37, nil
+	default:
+		return 21, nil
+	}
 }
 
-// HashTreeRoot ssz hashes the SignedBeaconBlockHeader object
-func (s *SignedBeaconBlockHeader) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(s)
+// ProvePrevRandaoFork returns a merkle proof of the 'PrevRandao' field of the ExecutionPayloadHeader object for a given fork
+func (e *ExecutionPayloadHeader) ProvePrevRandaoFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ExecutionPayloadHeaderPrevRandaoGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(e, gindex, fork)
 }
 
-// HashTreeRootWith ssz hashes the SignedBeaconBlockHeader object with a hasher
-func (s *SignedBeaconBlockHeader) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
+// VerifyExecutionPayloadHeaderPrevRandaoFork verifies a merkle proof of the 'PrevRandao' field of a ExecutionPayloadHeader object for a given fork
+func VerifyExecutionPayloadHeaderPrevRandaoFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ExecutionPayloadHeaderPrevRandaoGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
 
-	// Field (0) 'Header'
-	if s.Header == nil {
-		s.Header = new(BeaconBlockHeader)
+// ExecutionPayloadHeaderBlockNumberGIndexFork returns the generalized index of the 'BlockNumber' field for a given fork
+func ExecutionPayloadHeaderBlockNumberGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 38, nil
+	default:
+		return 22, nil
+	}
+}
+
+// ProveBlockNumberFork returns a merkle proof of the 'BlockNumber' field of the ExecutionPayloadHeader object for a given fork
+func (e *ExecutionPayloadHeader) ProveBlockNumberFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ExecutionPayloadHeaderBlockNumberGIndexFork(fork)
+	if err != nil {
+		return nil, err
 	}
-	if err = s.Header.HashTreeRootWith(hh); err != nil {
-		return
+	return ssz.ProveGIndexFork(e, gindex, fork)
+}
+
+// VerifyExecutionPayloadHeaderBlockNumberFork verifies a merkle proof of the 'BlockNumber' field of a ExecutionPayloadHeader object for a given fork
+func VerifyExecutionPayloadHeaderBlockNumberFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ExecutionPayloadHeaderBlockNumberGIndexFork(fork)
+	if err != nil {
+		return false, err
 	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
 
-	// Field (1) 'Signature'
-	if size := len(s.Signature); size != 96 {
-		err = ssz.ErrBytesLengthFn("SignedBeaconBlockHeader.Signature", size, 96)
-		return
+// ExecutionPayloadHeaderGasLimitGIndexFork returns the generalized index of the 'GasLimit' field for a given fork
+func ExecutionPayloadHeaderGasLimitGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 39, nil
+	default:
+		return 23, nil
 	}
-	hh.PutBytes(s.Signature)
+}
 
-	hh.Merkleize(indx)
-	return
+// ProveGasLimitFork returns a merkle proof of the 'GasLimit' field of the ExecutionPayloadHeader object for a given fork
+func (e *ExecutionPayloadHeader) ProveGasLimitFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ExecutionPayloadHeaderGasLimitGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(e, gindex, fork)
 }
 
-// GetTree ssz hashes the SignedBeaconBlockHeader object
-func (s *SignedBeaconBlockHeader) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(s)
+// VerifyExecutionPayloadHeaderGasLimitFork verifies a merkle proof of the 'GasLimit' field of a ExecutionPayloadHeader object for a given fork
+func VerifyExecutionPayloadHeaderGasLimitFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ExecutionPayloadHeaderGasLimitGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
 }
 
-// MarshalSSZ ssz marshals the BeaconBlockHeader object
-func (b *BeaconBlockHeader) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(b)
+// ExecutionPayloadHeaderGasUsedGIndexFork returns the generalized index of the 'GasUsed' field for a given fork
+func ExecutionPayloadHeaderGasUsedGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 40, nil
+	default:
+		return 24, nil
+	}
 }
 
-// MarshalSSZTo ssz marshals the BeaconBlockHeader object to a target array
-func (b *BeaconBlockHeader) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+// ProveGasUsedFork returns a merkle proof of the 'GasUsed' field of the ExecutionPayloadHeader object for a given fork
+func (e *ExecutionPayloadHeader) ProveGasUsedFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ExecutionPayloadHeaderGasUsedGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(e, gindex, fork)
+}
 
-	// Field (0) 'Slot'
-	dst = ssz.MarshalUint64(dst, b.Slot)
+// VerifyExecutionPayloadHeaderGasUsedFork verifies a merkle proof of the 'GasUsed' field of a ExecutionPayloadHeader object for a given fork
+func VerifyExecutionPayloadHeaderGasUsedFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ExecutionPayloadHeaderGasUsedGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
 
-	// Field (1) 'ProposerIndex'
-	dst = ssz.MarshalUint64(dst, b.ProposerIndex)
+// ExecutionPayloadHeaderTimestampGIndexFork returns the generalized index of the 'Timestamp' field for a given fork
+func ExecutionPayloadHeaderTimestampGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 41, nil
+	default:
+		return 25, nil
+	}
+}
 
-	// Field (2) 'ParentRoot'
-	if size := len(b.ParentRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("BeaconBlockHeader.ParentRoot", size, 32)
-		return
+// ProveTimestampFork returns a merkle proof of the 'Timestamp' field of the ExecutionPayloadHeader object for a given fork
+func (e *ExecutionPayloadHeader) ProveTimestampFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ExecutionPayloadHeaderTimestampGIndexFork(fork)
+	if err != nil {
+		return nil, err
 	}
-	dst = append(dst, b.ParentRoot...)
+	return ssz.ProveGIndexFork(e, gindex, fork)
+}
 
-	// Field (3) 'StateRoot'
-	if size := len(b.StateRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("BeaconBlockHeader.StateRoot", size, 32)
-		return
+// VerifyExecutionPayloadHeaderTimestampFork verifies a merkle proof of the 'Timestamp' field of a ExecutionPayloadHeader object for a given fork
+func VerifyExecutionPayloadHeaderTimestampFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ExecutionPayloadHeaderTimestampGIndexFork(fork)
+	if err != nil {
+		return false, err
 	}
-	dst = append(dst, b.StateRoot...)
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
 
-	// Field (4) 'BodyRoot'
-	if size := len(b.BodyRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("BeaconBlockHeader.BodyRoot", size, 32)
-		return
+// ExecutionPayloadHeaderExtraDataGIndexFork returns the generalized index of the 'ExtraData' field for a given fork
+func ExecutionPayloadHeaderExtraDataGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 42, nil
+	default:
+		return 26, nil
 	}
-	dst = append(dst, b.BodyRoot...)
+}
 
-	return
+// ProveExtraDataFork returns a merkle proof of the 'ExtraData' field of the ExecutionPayloadHeader object for a given fork
+func (e *ExecutionPayloadHeader) ProveExtraDataFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ExecutionPayloadHeaderExtraDataGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(e, gindex, fork)
 }
 
-// UnmarshalSSZ ssz unmarshals the BeaconBlockHeader object
-func (b *BeaconBlockHeader) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 112 {
-		return ssz.ErrSize
+// VerifyExecutionPayloadHeaderExtraDataFork verifies a merkle proof of the 'ExtraData' field of a ExecutionPayloadHeader object for a given fork
+func VerifyExecutionPayloadHeaderExtraDataFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ExecutionPayloadHeaderExtraDataGIndexFork(fork)
+	if err != nil {
+		return false, err
 	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
 
-	// Field (0) 'Slot'
-	b.Slot = ssz.UnmarshallUint64(buf[0:8])
+// ExecutionPayloadHeaderBaseFeePerGasGIndexFork returns the generalized index of the 'BaseFeePerGas' field for a given fork
+func ExecutionPayloadHeaderBaseFeePerGasGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 43, nil
+	default:
+		return 27, nil
+	}
+}
 
-	// Field (1) 'ProposerIndex'
-	b.ProposerIndex = ssz.UnmarshallUint64(buf[8:16])
+// ProveBaseFeePerGasFork returns a merkle proof of the 'BaseFeePerGas' field of the ExecutionPayloadHeader object for a given fork
+func (e *ExecutionPayloadHeader) ProveBaseFeePerGasFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ExecutionPayloadHeaderBaseFeePerGasGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(e, gindex, fork)
+}
 
-	// Field (2) 'ParentRoot'
-	if cap(b.ParentRoot) == 0 {
-		b.ParentRoot = make([]byte, 0, len(buf[16:48]))
+// VerifyExecutionPayloadHeaderBaseFeePerGasFork verifies a merkle proof of the 'BaseFeePerGas' field of a ExecutionPayloadHeader object for a given fork
+func VerifyExecutionPayloadHeaderBaseFeePerGasFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ExecutionPayloadHeaderBaseFeePerGasGIndexFork(fork)
+	if err != nil {
+		return false, err
 	}
-	b.ParentRoot = append(b.ParentRoot, buf[16:48]...)
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
 
-	// Field (3) 'StateRoot'
-	if cap(b.StateRoot) == 0 {
-		b.StateRoot = make([]byte, 0, len(buf[48:80]))
+// ExecutionPayloadHeaderBlockHashGIndexFork returns the generalized index of the 'BlockHash' field for a given fork
+func ExecutionPayloadHeaderBlockHashGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 44, nil
+	default:
+		return 28, nil
 	}
-	b.StateRoot = append(b.StateRoot, buf[48:80]...)
+}
 
-	// Field (4) 'BodyRoot'
-	if cap(b.BodyRoot) == 0 {
-		b.BodyRoot = make([]byte, 0, len(buf[80:112]))
+// ProveBlockHashFork returns a merkle proof of the 'BlockHash' field of the ExecutionPayloadHeader object for a given fork
+func (e *ExecutionPayloadHeader) ProveBlockHashFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ExecutionPayloadHeaderBlockHashGIndexFork(fork)
+	if err != nil {
+		return nil, err
 	}
-	b.BodyRoot = append(b.BodyRoot, buf[80:112]...)
+	return ssz.ProveGIndexFork(e, gindex, fork)
+}
 
-	return err
+// VerifyExecutionPayloadHeaderBlockHashFork verifies a merkle proof of the 'BlockHash' field of a ExecutionPayloadHeader object for a given fork
+func VerifyExecutionPayloadHeaderBlockHashFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ExecutionPayloadHeaderBlockHashGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockHeader object
-func (b *BeaconBlockHeader) SizeSSZ() (size int) {
-	size = 112
-	return
+// ExecutionPayloadHeaderTransactionsRootGIndexFork returns the generalized index of the 'TransactionsRoot' field for a given fork
+func ExecutionPayloadHeaderTransactionsRootGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 45, nil
+	default:
+		return 29, nil
+	}
 }
 
-// HashTreeRoot ssz hashes the BeaconBlockHeader object
-func (b *BeaconBlockHeader) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(b)
+// ProveTransactionsRootFork returns a merkle proof of the 'TransactionsRoot' field of the ExecutionPayloadHeader object for a given fork
+func (e *ExecutionPayloadHeader) ProveTransactionsRootFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ExecutionPayloadHeaderTransactionsRootGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(e, gindex, fork)
 }
 
-// HashTreeRootWith ssz hashes the BeaconBlockHeader object with a hasher
-func (b *BeaconBlockHeader) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
+// VerifyExecutionPayloadHeaderTransactionsRootFork verifies a merkle proof of the 'TransactionsRoot' field of a ExecutionPayloadHeader object for a given fork
+func VerifyExecutionPayloadHeaderTransactionsRootFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ExecutionPayloadHeaderTransactionsRootGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
 
-	// Field (0) 'Slot'
-	hh.PutUint64(b.Slot)
+// ExecutionPayloadHeaderWithdrawalRootGIndexFork returns the generalized index of the 'WithdrawalRoot' field for a given fork
+func ExecutionPayloadHeaderWithdrawalRootGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 46, nil
+	case fork >= ssz.ForkCapella:
+		return 30, nil
+	default:
+		return 0, ssz.ErrForkField
+	}
+}
 
-	// Field (1) 'ProposerIndex'
-	hh.PutUint64(b.ProposerIndex)
+// ProveWithdrawalRootFork returns a merkle proof of the 'WithdrawalRoot' field of the ExecutionPayloadHeader object for a given fork
+func (e *ExecutionPayloadHeader) ProveWithdrawalRootFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ExecutionPayloadHeaderWithdrawalRootGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(e, gindex, fork)
+}
 
-	// Field (2) 'ParentRoot'
-	if size := len(b.ParentRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("BeaconBlockHeader.ParentRoot", size, 32)
-		return
+// VerifyExecutionPayloadHeaderWithdrawalRootFork verifies a merkle proof of the 'WithdrawalRoot' field of a ExecutionPayloadHeader object for a given fork
+func VerifyExecutionPayloadHeaderWithdrawalRootFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ExecutionPayloadHeaderWithdrawalRootGIndexFork(fork)
+	if err != nil {
+		return false, err
 	}
-	hh.PutBytes(b.ParentRoot)
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
 
-	// Field (3) 'StateRoot'
-	if size := len(b.StateRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("BeaconBlockHeader.StateRoot", size, 32)
-		return
+// ExecutionPayloadHeaderBlobGasUsedGIndexFork returns the generalized index of the 'BlobGasUsed' field for a given fork
+func ExecutionPayloadHeaderBlobGasUsedGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 47, nil
+	default:
+		return 0, ssz.ErrForkField
 	}
-	hh.PutBytes(b.StateRoot)
+}
 
-	// Field (4) 'BodyRoot'
-	if size := len(b.BodyRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("BeaconBlockHeader.BodyRoot", size, 32)
-		return
+// ProveBlobGasUsedFork returns a merkle proof of the 'BlobGasUsed' field of the ExecutionPayloadHeader object for a given fork
+func (e *ExecutionPayloadHeader) ProveBlobGasUsedFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ExecutionPayloadHeaderBlobGasUsedGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(e, gindex, fork)
+}
+
+// VerifyExecutionPayloadHeaderBlobGasUsedFork verifies a merkle proof of the 'BlobGasUsed' field of a ExecutionPayloadHeader object for a given fork
+func VerifyExecutionPayloadHeaderBlobGasUsedFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ExecutionPayloadHeaderBlobGasUsedGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// ExecutionPayloadHeaderExcessBlobGasGIndexFork returns the generalized index of the 'ExcessBlobGas' field for a given fork
+func ExecutionPayloadHeaderExcessBlobGasGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return 48, nil
+	default:
+		return 0, ssz.ErrForkField
+	}
+}
+
+// ProveExcessBlobGasFork returns a merkle proof of the 'ExcessBlobGas' field of the ExecutionPayloadHeader object for a given fork
+func (e *ExecutionPayloadHeader) ProveExcessBlobGasFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ExecutionPayloadHeaderExcessBlobGasGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(e, gindex, fork)
+}
+
+// VerifyExecutionPayloadHeaderExcessBlobGasFork verifies a merkle proof of the 'ExcessBlobGas' field of a ExecutionPayloadHeader object for a given fork
+func VerifyExecutionPayloadHeaderExcessBlobGasFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ExecutionPayloadHeaderExcessBlobGasGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// SchemaSSZ returns the schema of the tree of the ExecutionPayloadHeader object
+func (e *ExecutionPayloadHeader) SchemaSSZ() *ssz.Schema {
+	return e.SchemaSSZFork(ssz.ForkDeneb)
+}
+
+// SchemaSSZFork returns the schema of the tree of the ExecutionPayloadHeader object for a given fork
+func (e *ExecutionPayloadHeader) SchemaSSZFork(fork ssz.Fork) *ssz.Schema {
+	switch {
+	case fork >= ssz.ForkDeneb:
+		return ssz.SchemaContainer(
+			&ssz.SchemaField{Name: "ParentHash", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "FeeRecipient", Schema: ssz.SchemaBytes(20)},
+			&ssz.SchemaField{Name: "StateRoot", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "ReceiptsRoot", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "LogsBloom", Schema: ssz.SchemaBytes(256)},
+			&ssz.SchemaField{Name: "PrevRandao", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "BlockNumber", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "GasLimit", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "GasUsed", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "Timestamp", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "ExtraData", Schema: ssz.SchemaList(ssz.SchemaUint(1), 32)},
+			&ssz.SchemaField{Name: "BaseFeePerGas", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "BlockHash", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "TransactionsRoot", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "WithdrawalRoot", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "BlobGasUsed", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "ExcessBlobGas", Schema: ssz.SchemaUint(8)},
+		)
+	case fork >= ssz.ForkCapella:
+		return ssz.SchemaContainer(
+			&ssz.SchemaField{Name: "ParentHash", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "FeeRecipient", Schema: ssz.SchemaBytes(20)},
+			&ssz.SchemaField{Name: "StateRoot", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "ReceiptsRoot", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "LogsBloom", Schema: ssz.SchemaBytes(256)},
+			&ssz.SchemaField{Name: "PrevRandao", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "BlockNumber", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "GasLimit", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "GasUsed", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "Timestamp", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "ExtraData", Schema: ssz.SchemaList(ssz.SchemaUint(1), 32)},
+			&ssz.SchemaField{Name: "BaseFeePerGas", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "BlockHash", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "TransactionsRoot", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "WithdrawalRoot", Schema: ssz.SchemaBytes(32)},
+		)
+	default:
+		return ssz.SchemaContainer(
+			&ssz.SchemaField{Name: "ParentHash", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "FeeRecipient", Schema: ssz.SchemaBytes(20)},
+			&ssz.SchemaField{Name: "StateRoot", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "ReceiptsRoot", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "LogsBloom", Schema: ssz.SchemaBytes(256)},
+			&ssz.SchemaField{Name: "PrevRandao", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "BlockNumber", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "GasLimit", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "GasUsed", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "Timestamp", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "ExtraData", Schema: ssz.SchemaList(ssz.SchemaUint(1), 32)},
+			&ssz.SchemaField{Name: "BaseFeePerGas", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "BlockHash", Schema: ssz.SchemaBytes(32)},
+			&ssz.SchemaField{Name: "TransactionsRoot", Schema: ssz.SchemaBytes(32)},
+		)
 	}
-	hh.PutBytes(b.BodyRoot)
+}
 
-	hh.Merkleize(indx)
-	return
+// MarshalSSZ ssz marshals the BLSToExecutionChange object
+func (b *BLSToExecutionChange) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
 }
 
-// GetTree ssz hashes the BeaconBlockHeader object
-func (b *BeaconBlockHeader) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(b)
+// MarshalSSZTo ssz marshals the BLSToExecutionChange object to a target array
+func (b *BLSToExecutionChange) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'ValidatorIndex'
+	dst = ssz.MarshalUint64(dst, b.ValidatorIndex)
+
+	// Field (1) 'FromBLSPubKey'
+	dst = append(dst, b.FromBLSPubKey[:]...)
+
+	// Field (2) 'ToExecutionAddress'
+	dst = append(dst, b.ToExecutionAddress[:]...)
+
+	return
 }
 
-// MarshalSSZ ssz marshals the ErrorResponse object
-func (e *ErrorResponse) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(e)
+// MarshalSSZToWriter ssz marshals the BLSToExecutionChange object to a writer
+func (b *BLSToExecutionChange) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(b, writer)
 }
 
-// MarshalSSZTo ssz marshals the ErrorResponse object to a target array
-func (e *ErrorResponse) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZToStream ssz marshals the BLSToExecutionChange object to a target array that is flushed to a stream writer
+func (b *BLSToExecutionChange) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
 	dst = buf
-	offset := int(4)
 
-	// Offset (0) 'Message'
-	dst = ssz.WriteOffset(dst, offset)
+	// Field (0) 'ValidatorIndex'
+	dst = ssz.MarshalUint64(dst, b.ValidatorIndex)
 
-	// Field (0) 'Message'
-	if size := len(e.Message); size > 256 {
-		err = ssz.ErrBytesLengthFn("ErrorResponse.Message", size, 256)
-		return
-	}
-	dst = append(dst, e.Message...)
+	// Field (1) 'FromBLSPubKey'
+	dst = append(dst, b.FromBLSPubKey[:]...)
+
+	// Field (2) 'ToExecutionAddress'
+	dst = append(dst, b.ToExecutionAddress[:]...)
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the ErrorResponse object
-func (e *ErrorResponse) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the BLSToExecutionChange object
+func (b *BLSToExecutionChange) UnmarshalSSZ(buf []byte) error {
+	return b.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the BLSToExecutionChange object with the decoding options
+func (b *BLSToExecutionChange) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 4 {
+	if size != 76 {
 		return ssz.ErrSize
 	}
 
-	tail := buf
-	var o0 uint64
+	// Field (0) 'ValidatorIndex'
+	b.ValidatorIndex = ssz.UnmarshallUint64(buf[0:8])
 
-	// Offset (0) 'Message'
-	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
-		return ssz.ErrOffset
-	}
+	// Field (1) 'FromBLSPubKey'
+	copy(b.FromBLSPubKey[:], buf[8:56])
 
-	if o0 < 4 {
-		return ssz.ErrInvalidVariableOffset
-	}
+	// Field (2) 'ToExecutionAddress'
+	copy(b.ToExecutionAddress[:], buf[56:76])
 
-	// Field (0) 'Message'
-	{
-		buf = tail[o0:]
-		if len(buf) > 256 {
-			return ssz.ErrBytesLength
-		}
-		if cap(e.Message) == 0 {
-			e.Message = make([]byte, 0, len(buf))
-		}
-		e.Message = append(e.Message, buf...)
-	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the ErrorResponse object
-func (e *ErrorResponse) SizeSSZ() (size int) {
-	size = 4
-
-	// Field (0) 'Message'
-	size += len(e.Message)
-
+// SizeSSZ returns the ssz encoded size in bytes for the BLSToExecutionChange object
+func (b *BLSToExecutionChange) SizeSSZ() (size int) {
+	size = 76
 	return
 }
 
-// HashTreeRoot ssz hashes the ErrorResponse object
-func (e *ErrorResponse) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(e)
+// MinSSZSize returns the minimum ssz encoded size in bytes for the BLSToExecutionChange object
+func (b *BLSToExecutionChange) MinSSZSize() uint64 {
+	return 76
 }
 
-// HashTreeRootWith ssz hashes the ErrorResponse object with a hasher
-func (e *ErrorResponse) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
-
-	// Field (0) 'Message'
-	{
-		elemIndx := hh.Index()
-		byteLen := uint64(len(e.Message))
-		if byteLen > 256 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		hh.Append(e.Message)
-		hh.MerkleizeWithMixin(elemIndx, byteLen, (256+31)/32)
-	}
-
-	hh.Merkleize(indx)
-	return
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the BLSToExecutionChange object
+func (b *BLSToExecutionChange) MaxSSZSize() uint64 {
+	return 76
 }
 
-// GetTree ssz hashes the ErrorResponse object
-func (e *ErrorResponse) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(e)
+// HashTreeRoot ssz hashes the BLSToExecutionChange object
+func (b *BLSToExecutionChange) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
 }
 
-// MarshalSSZ ssz marshals the Dummy object
-func (d *Dummy) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(d)
-}
+// HashTreeRootWith ssz hashes the BLSToExecutionChange object with a hasher
+func (b *BLSToExecutionChange) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
 
-// MarshalSSZTo ssz marshals the Dummy object to a target array
-func (d *Dummy) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+	// Field (0) 'ValidatorIndex'
+	hh.PutUint64(b.ValidatorIndex)
+
+	// Field (1) 'FromBLSPubKey'
+	hh.PutBytes(b.FromBLSPubKey[:])
 
+	// Field (2) 'ToExecutionAddress'
+	hh.PutBytes(b.ToExecutionAddress[:])
+
+	hh.Merkleize(indx)
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the Dummy object
-func (d *Dummy) UnmarshalSSZ(buf []byte) error {
-	var err error
+// HashTreeRootSSZ ssz hashes the encoded BLSToExecutionChange object without decoding it
+func (b *BLSToExecutionChange) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(b, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded BLSToExecutionChange object with a hasher
+func (b *BLSToExecutionChange) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
 	size := uint64(len(buf))
-	if size != 0 {
+	if size != 76 {
 		return ssz.ErrSize
 	}
 
-	return err
-}
+	indx := hh.Index()
 
-// SizeSSZ returns the ssz encoded size in bytes for the Dummy object
-func (d *Dummy) SizeSSZ() (size int) {
-	size = 0
-	return
-}
+	// Field (0) 'ValidatorIndex'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
 
-// HashTreeRoot ssz hashes the Dummy object
-func (d *Dummy) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(d)
-}
+	// Field (1) 'FromBLSPubKey'
+	hh.PutBytes(buf[8:56])
 
-// HashTreeRootWith ssz hashes the Dummy object with a hasher
-func (d *Dummy) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
+	// Field (2) 'ToExecutionAddress'
+	hh.PutBytes(buf[56:76])
 
 	hh.Merkleize(indx)
 	return
 }
 
-// GetTree ssz hashes the Dummy object
-func (d *Dummy) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(d)
-}
-
-// MarshalSSZ ssz marshals the SyncCommittee object
-func (s *SyncCommittee) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(s)
+// GetTree ssz hashes the BLSToExecutionChange object
+func (b *BLSToExecutionChange) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
 }
 
-// MarshalSSZTo ssz marshals the SyncCommittee object to a target array
-func (s *SyncCommittee) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-
-	// Field (0) 'PubKeys'
-	if size := len(s.PubKeys); size != 512 {
-		err = ssz.ErrVectorLengthFn("SyncCommittee.PubKeys", size, 512)
-		return
-	}
-	for ii := 0; ii < 512; ii++ {
-		if size := len(s.PubKeys[ii]); size != 48 {
-			err = ssz.ErrBytesLengthFn("SyncCommittee.PubKeys[ii]", size, 48)
-			return
-		}
-		dst = append(dst, s.PubKeys[ii]...)
-	}
+const (
+	// BLSToExecutionChangeValidatorIndexGIndex is the generalized index of the 'ValidatorIndex' field
+	BLSToExecutionChangeValidatorIndexGIndex ssz.GIndex = 4
+	// BLSToExecutionChangeFromBLSPubKeyGIndex is the generalized index of the 'FromBLSPubKey' field
+	BLSToExecutionChangeFromBLSPubKeyGIndex ssz.GIndex = 5
+	// BLSToExecutionChangeToExecutionAddressGIndex is the generalized index of the 'ToExecutionAddress' field
+	BLSToExecutionChangeToExecutionAddressGIndex ssz.GIndex = 6
+)
 
-	// Field (1) 'AggregatePubKey'
-	dst = append(dst, s.AggregatePubKey[:]...)
+// ProveValidatorIndex returns a merkle proof of the 'ValidatorIndex' field of the BLSToExecutionChange object
+func (b *BLSToExecutionChange) ProveValidatorIndex() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BLSToExecutionChangeValidatorIndexGIndex)
+}
 
-	return
+// VerifyBLSToExecutionChangeValidatorIndex verifies a merkle proof of the 'ValidatorIndex' field of a BLSToExecutionChange object
+func VerifyBLSToExecutionChangeValidatorIndex(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BLSToExecutionChangeValidatorIndexGIndex)
 }
 
-// UnmarshalSSZ ssz unmarshals the SyncCommittee object
-func (s *SyncCommittee) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 24624 {
-		return ssz.ErrSize
-	}
+// ProveFromBLSPubKey returns a merkle proof of the 'FromBLSPubKey' field of the BLSToExecutionChange object
+func (b *BLSToExecutionChange) ProveFromBLSPubKey() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BLSToExecutionChangeFromBLSPubKeyGIndex)
+}
 
-	// Field (0) 'PubKeys'
-	s.PubKeys = make([][]byte, 512)
-	for ii := 0; ii < 512; ii++ {
-		if cap(s.PubKeys[ii]) == 0 {
-			s.PubKeys[ii] = make([]byte, 0, len(buf[0:24576][ii*48:(ii+1)*48]))
-		}
-		s.PubKeys[ii] = append(s.PubKeys[ii], buf[0:24576][ii*48:(ii+1)*48]...)
-	}
+// VerifyBLSToExecutionChangeFromBLSPubKey verifies a merkle proof of the 'FromBLSPubKey' field of a BLSToExecutionChange object
+func VerifyBLSToExecutionChangeFromBLSPubKey(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BLSToExecutionChangeFromBLSPubKeyGIndex)
+}
 
-	// Field (1) 'AggregatePubKey'
-	copy(s.AggregatePubKey[:], buf[24576:24624])
+// ProveToExecutionAddress returns a merkle proof of the 'ToExecutionAddress' field of the BLSToExecutionChange object
+func (b *BLSToExecutionChange) ProveToExecutionAddress() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BLSToExecutionChangeToExecutionAddressGIndex)
+}
 
-	return err
+// VerifyBLSToExecutionChangeToExecutionAddress verifies a merkle proof of the 'ToExecutionAddress' field of a BLSToExecutionChange object
+func VerifyBLSToExecutionChangeToExecutionAddress(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BLSToExecutionChangeToExecutionAddressGIndex)
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the SyncCommittee object
-func (s *SyncCommittee) SizeSSZ() (size int) {
-	size = 24624
-	return
+// SchemaSSZ returns the schema of the tree of the BLSToExecutionChange object
+func (b *BLSToExecutionChange) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "ValidatorIndex", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "FromBLSPubKey", Schema: ssz.SchemaBytes(48)},
+		&ssz.SchemaField{Name: "ToExecutionAddress", Schema: ssz.SchemaBytes(20)},
+	)
 }
 
-// HashTreeRoot ssz hashes the SyncCommittee object
-func (s *SyncCommittee) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(s)
+// MarshalSSZ ssz marshals the HistoricalSummary object
+func (h *HistoricalSummary) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(h)
 }
 
-// HashTreeRootWith ssz hashes the SyncCommittee object with a hasher
-func (s *SyncCommittee) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
+// MarshalSSZTo ssz marshals the HistoricalSummary object to a target array
+func (h *HistoricalSummary) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
 
-	// Field (0) 'PubKeys'
-	{
-		if size := len(s.PubKeys); size != 512 {
-			err = ssz.ErrVectorLengthFn("SyncCommittee.PubKeys", size, 512)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range s.PubKeys {
-			if len(i) != 48 {
-				err = ssz.ErrBytesLength
-				return
-			}
-			hh.PutBytes(i)
-		}
-		hh.Merkleize(subIndx)
-	}
+	// Field (0) 'BlockSummaryRoot'
+	dst = append(dst, h.BlockSummaryRoot[:]...)
 
-	// Field (1) 'AggregatePubKey'
-	hh.PutBytes(s.AggregatePubKey[:])
+	// Field (1) 'StateSummaryRoot'
+	dst = append(dst, h.StateSummaryRoot[:]...)
 
-	hh.Merkleize(indx)
 	return
 }
 
-// GetTree ssz hashes the SyncCommittee object
-func (s *SyncCommittee) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(s)
-}
-
-// MarshalSSZ ssz marshals the SyncAggregate object
-func (s *SyncAggregate) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(s)
+// MarshalSSZToWriter ssz marshals the HistoricalSummary object to a writer
+func (h *HistoricalSummary) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(h, writer)
 }
 
-// MarshalSSZTo ssz marshals the SyncAggregate object to a target array
-func (s *SyncAggregate) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZToStream ssz marshals the HistoricalSummary object to a target array that is flushed to a stream writer
+func (h *HistoricalSummary) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
 	dst = buf
 
-	// Field (0) 'SyncCommiteeBits'
-	if size := len(s.SyncCommiteeBits); size != 64 {
-		err = ssz.ErrBytesLengthFn("SyncAggregate.SyncCommiteeBits", size, 64)
-		return
-	}
-	dst = append(dst, s.SyncCommiteeBits...)
+	// Field (0) 'BlockSummaryRoot'
+	dst = append(dst, h.BlockSummaryRoot[:]...)
 
-	// Field (1) 'SyncCommiteeSignature'
-	dst = append(dst, s.SyncCommiteeSignature[:]...)
+	// Field (1) 'StateSummaryRoot'
+	dst = append(dst, h.StateSummaryRoot[:]...)
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the SyncAggregate object
-func (s *SyncAggregate) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the HistoricalSummary object
+func (h *HistoricalSummary) UnmarshalSSZ(buf []byte) error {
+	return h.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the HistoricalSummary object with the decoding options
+func (h *HistoricalSummary) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
-	if size != 160 {
+	if size != 64 {
 		return ssz.ErrSize
 	}
 
-	// Field (0) 'SyncCommiteeBits'
-	if cap(s.SyncCommiteeBits) == 0 {
-		s.SyncCommiteeBits = make([]byte, 0, len(buf[0:64]))
-	}
-	s.SyncCommiteeBits = append(s.SyncCommiteeBits, buf[0:64]...)
+	// Field (0) 'BlockSummaryRoot'
+	copy(h.BlockSummaryRoot[:], buf[0:32])
 
-	// Field (1) 'SyncCommiteeSignature'
-	copy(s.SyncCommiteeSignature[:], buf[64:160])
+	// Field (1) 'StateSummaryRoot'
+	copy(h.StateSummaryRoot[:], buf[32:64])
 
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the SyncAggregate object
-func (s *SyncAggregate) SizeSSZ() (size int) {
-	size = 160
+// SizeSSZ returns the ssz encoded size in bytes for the HistoricalSummary object
+func (h *HistoricalSummary) SizeSSZ() (size int) {
+	size = 64
 	return
 }
 
-// HashTreeRoot ssz hashes the SyncAggregate object
-func (s *SyncAggregate) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(s)
+// MinSSZSize returns the minimum ssz encoded size in bytes for the HistoricalSummary object
+func (h *HistoricalSummary) MinSSZSize() uint64 {
+	return 64
 }
 
-// HashTreeRootWith ssz hashes the SyncAggregate object with a hasher
-func (s *SyncAggregate) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the HistoricalSummary object
+func (h *HistoricalSummary) MaxSSZSize() uint64 {
+	return 64
+}
+
+// HashTreeRoot ssz hashes the HistoricalSummary object
+func (h *HistoricalSummary) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(h)
+}
+
+// HashTreeRootWith ssz hashes the HistoricalSummary object with a hasher
+func (h *HistoricalSummary) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
 
-	// Field (0) 'SyncCommiteeBits'
-	if size := len(s.SyncCommiteeBits); size != 64 {
-		err = ssz.ErrBytesLengthFn("SyncAggregate.SyncCommiteeBits", size, 64)
-		return
-	}
-	hh.PutBytes(s.SyncCommiteeBits)
+	// Field (0) 'BlockSummaryRoot'
+	hh.PutBytes(h.BlockSummaryRoot[:])
 
-	// Field (1) 'SyncCommiteeSignature'
-	hh.PutBytes(s.SyncCommiteeSignature[:])
+	// Field (1) 'StateSummaryRoot'
+	hh.PutBytes(h.StateSummaryRoot[:])
 
 	hh.Merkleize(indx)
 	return
 }
 
-// GetTree ssz hashes the SyncAggregate object
-func (s *SyncAggregate) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(s)
-}
-
-// MarshalSSZ ssz marshals the ExecutionPayload object
-func (e *ExecutionPayload) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(e)
+// HashTreeRootSSZ ssz hashes the encoded HistoricalSummary object without decoding it
+func (h *HistoricalSummary) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(h, buf)
 }
 
-// MarshalSSZTo ssz marshals the ExecutionPayload object to a target array
-func (e *ExecutionPayload) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := int(508)
-
-	// Field (0) 'ParentHash'
-	dst = append(dst, e.ParentHash[:]...)
+// HashTreeRootSSZWith ssz hashes the encoded HistoricalSummary object with a hasher
+func (h *HistoricalSummary) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 64 {
+		return ssz.ErrSize
+	}
 
-	// Field (1) 'FeeRecipient'
-	dst = append(dst, e.FeeRecipient[:]...)
+	indx := hh.Index()
 
-	// Field (2) 'StateRoot'
-	dst = append(dst, e.StateRoot[:]...)
+	// Field (0) 'BlockSummaryRoot'
+	hh.PutBytes(buf[0:32])
 
-	// Field (3) 'ReceiptsRoot'
-	dst = append(dst, e.ReceiptsRoot[:]...)
+	// Field (1) 'StateSummaryRoot'
+	hh.PutBytes(buf[32:64])
 
-	// Field (4) 'LogsBloom'
-	dst = append(dst, e.LogsBloom[:]...)
+	hh.Merkleize(indx)
+	return
+}
 
-	// Field (5) 'PrevRandao'
-	dst = append(dst, e.PrevRandao[:]...)
+// GetTree ssz hashes the HistoricalSummary object
+func (h *HistoricalSummary) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(h)
+}
 
-	// Field (6) 'BlockNumber'
-	dst = ssz.MarshalUint64(dst, e.BlockNumber)
+const (
+	// HistoricalSummaryBlockSummaryRootGIndex is the generalized index of the 'BlockSummaryRoot' field
+	HistoricalSummaryBlockSummaryRootGIndex ssz.GIndex = 2
+	// HistoricalSummaryStateSummaryRootGIndex is the generalized index of the 'StateSummaryRoot' field
+	HistoricalSummaryStateSummaryRootGIndex ssz.GIndex = 3
+)
 
-	// Field (7) 'GasLimit'
-	dst = ssz.MarshalUint64(dst, e.GasLimit)
+// ProveBlockSummaryRoot returns a merkle proof of the 'BlockSummaryRoot' field of the HistoricalSummary object
+func (h *HistoricalSummary) ProveBlockSummaryRoot() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(h, HistoricalSummaryBlockSummaryRootGIndex)
+}
 
-	// Field (8) 'GasUsed'
-	dst = ssz.MarshalUint64(dst, e.GasUsed)
+// VerifyHistoricalSummaryBlockSummaryRoot verifies a merkle proof of the 'BlockSummaryRoot' field of a HistoricalSummary object
+func VerifyHistoricalSummaryBlockSummaryRoot(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, HistoricalSummaryBlockSummaryRootGIndex)
+}
 
-	// Field (9) 'Timestamp'
-	dst = ssz.MarshalUint64(dst, e.Timestamp)
+// ProveStateSummaryRoot returns a merkle proof of the 'StateSummaryRoot' field of the HistoricalSummary object
+func (h *HistoricalSummary) ProveStateSummaryRoot() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(h, HistoricalSummaryStateSummaryRootGIndex)
+}
 
-	// Offset (10) 'ExtraData'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(e.ExtraData)
+// VerifyHistoricalSummaryStateSummaryRoot verifies a merkle proof of the 'StateSummaryRoot' field of a HistoricalSummary object
+func VerifyHistoricalSummaryStateSummaryRoot(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, HistoricalSummaryStateSummaryRootGIndex)
+}
 
-	// Field (11) 'BaseFeePerGas'
-	dst = append(dst, e.BaseFeePerGas[:]...)
+// SchemaSSZ returns the schema of the tree of the HistoricalSummary object
+func (h *HistoricalSummary) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "BlockSummaryRoot", Schema: ssz.SchemaBytes(32)},
+		&ssz.SchemaField{Name: "StateSummaryRoot", Schema: ssz.SchemaBytes(32)},
+	)
+}
 
-	// Field (12) 'BlockHash'
-	dst = append(dst, e.BlockHash[:]...)
+// MarshalSSZ ssz marshals the SignedBLSToExecutionChange object
+func (s *SignedBLSToExecutionChange) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
 
-	// Offset (13) 'Transactions'
-	dst = ssz.WriteOffset(dst, offset)
+// MarshalSSZTo ssz marshals the SignedBLSToExecutionChange object to a target array
+func (s *SignedBLSToExecutionChange) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
 
-	// Field (10) 'ExtraData'
-	if size := len(e.ExtraData); size > 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayload.ExtraData", size, 32)
-		return
+	// Field (0) 'Message'
+	if s.Message == nil {
+		s.Message = new(BLSToExecutionChange)
 	}
-	dst = append(dst, e.ExtraData...)
-
-	// Field (13) 'Transactions'
-	if size := len(e.Transactions); size > 1048576 {
-		err = ssz.ErrListTooBigFn("ExecutionPayload.Transactions", size, 1048576)
+	if dst, err = s.Message.MarshalSSZTo(dst); err != nil {
 		return
 	}
-	{
-		offset = 4 * len(e.Transactions)
-		for ii := 0; ii < len(e.Transactions); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += len(e.Transactions[ii])
-		}
+
+	// Field (1) 'Signature'
+	dst = append(dst, s.Signature[:]...)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the SignedBLSToExecutionChange object to a writer
+func (s *SignedBLSToExecutionChange) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(s, writer)
+}
+
+// MarshalSSZToStream ssz marshals the SignedBLSToExecutionChange object to a target array that is flushed to a stream writer
+func (s *SignedBLSToExecutionChange) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Message'
+	if s.Message == nil {
+		s.Message = new(BLSToExecutionChange)
 	}
-	for ii := 0; ii < len(e.Transactions); ii++ {
-		if size := len(e.Transactions[ii]); size > 1073741824 {
-			err = ssz.ErrBytesLengthFn("ExecutionPayload.Transactions[ii]", size, 1073741824)
-			return
-		}
-		dst = append(dst, e.Transactions[ii]...)
+	if dst, err = s.Message.MarshalSSZToStream(dst, sw); err != nil {
+		return
 	}
 
+	// Field (1) 'Signature'
+	dst = append(dst, s.Signature[:]...)
+
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the ExecutionPayload object
-func (e *ExecutionPayload) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the SignedBLSToExecutionChange object
+func (s *SignedBLSToExecutionChange) UnmarshalSSZ(buf []byte) error {
+	return s.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the SignedBLSToExecutionChange object with the decoding options
+func (s *SignedBLSToExecutionChange) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 508 {
+	if size != 172 {
 		return ssz.ErrSize
 	}
 
-	tail := buf
-	var o10, o13 uint64
+	// Field (0) 'Message'
+	if s.Message == nil {
+		s.Message = new(BLSToExecutionChange)
+	}
+	if err = s.Message.UnmarshalSSZWithOptions(buf[0:76], opts); err != nil {
+		return err
+	}
 
-	// Field (0) 'ParentHash'
-	copy(e.ParentHash[:], buf[0:32])
+	// Field (1) 'Signature'
+	copy(s.Signature[:], buf[76:172])
 
-	// Field (1) 'FeeRecipient'
-	copy(e.FeeRecipient[:], buf[32:52])
+	return err
+}
 
-	// Field (2) 'StateRoot'
-	copy(e.StateRoot[:], buf[52:84])
+// SizeSSZ returns the ssz encoded size in bytes for the SignedBLSToExecutionChange object
+func (s *SignedBLSToExecutionChange) SizeSSZ() (size int) {
+	size = 172
+	return
+}
 
-	// Field (3) 'ReceiptsRoot'
-	copy(e.ReceiptsRoot[:], buf[84:116])
+// MinSSZSize returns the minimum ssz encoded size in bytes for the SignedBLSToExecutionChange object
+func (s *SignedBLSToExecutionChange) MinSSZSize() uint64 {
+	return 172
+}
 
-	// Field (4) 'LogsBloom'
-	copy(e.LogsBloom[:], buf[116:372])
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the SignedBLSToExecutionChange object
+func (s *SignedBLSToExecutionChange) MaxSSZSize() uint64 {
+	return 172
+}
 
-	// Field (5) 'PrevRandao'
-	copy(e.PrevRandao[:], buf[372:404])
+// HashTreeRoot ssz hashes the SignedBLSToExecutionChange object
+func (s *SignedBLSToExecutionChange) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
 
-	// Field (6) 'BlockNumber'
-	e.BlockNumber = ssz.UnmarshallUint64(buf[404:412])
+// HashTreeRootWith ssz hashes the SignedBLSToExecutionChange object with a hasher
+func (s *SignedBLSToExecutionChange) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
 
-	// Field (7) 'GasLimit'
-	e.GasLimit = ssz.UnmarshallUint64(buf[412:420])
+	// Field (0) 'Message'
+	if s.Message == nil {
+		s.Message = new(BLSToExecutionChange)
+	}
+	if err = s.Message.HashTreeRootWith(hh); err != nil {
+		return
+	}
 
-	// Field (8) 'GasUsed'
-	e.GasUsed = ssz.UnmarshallUint64(buf[420:428])
+	// Field (1) 'Signature'
+	hh.PutBytes(s.Signature[:])
 
-	// Field (9) 'Timestamp'
-	e.Timestamp = ssz.UnmarshallUint64(buf[428:436])
+	hh.Merkleize(indx)
+	return
+}
 
-	// Offset (10) 'ExtraData'
-	if o10 = ssz.ReadOffset(buf[436:440]); o10 > size {
-		return ssz.ErrOffset
-	}
+// HashTreeRootSSZ ssz hashes the encoded SignedBLSToExecutionChange object without decoding it
+func (s *SignedBLSToExecutionChange) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(s, buf)
+}
 
-	if o10 < 508 {
-		return ssz.ErrInvalidVariableOffset
+// HashTreeRootSSZWith ssz hashes the encoded SignedBLSToExecutionChange object with a hasher
+func (s *SignedBLSToExecutionChange) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 172 {
+		return ssz.ErrSize
 	}
 
-	// Field (11) 'BaseFeePerGas'
-	copy(e.BaseFeePerGas[:], buf[440:472])
-
-	// Field (12) 'BlockHash'
-	copy(e.BlockHash[:], buf[472:504])
+	indx := hh.Index()
 
-	// Offset (13) 'Transactions'
-	if o13 = ssz.ReadOffset(buf[504:508]); o13 > size || o10 > o13 {
-		return ssz.ErrOffset
+	// Field (0) 'Message'
+	if err = (*BLSToExecutionChange)(nil).HashTreeRootSSZWith(hh, buf[0:76]); err != nil {
+		return err
 	}
 
-	// Field (10) 'ExtraData'
-	{
-		buf = tail[o10:o13]
-		if len(buf) > 32 {
-			return ssz.ErrBytesLength
-		}
-		if cap(e.ExtraData) == 0 {
-			e.ExtraData = make([]byte, 0, len(buf))
-		}
-		e.ExtraData = append(e.ExtraData, buf...)
-	}
+	// Field (1) 'Signature'
+	hh.PutBytes(buf[76:172])
 
-	// Field (13) 'Transactions'
-	{
-		buf = tail[o13:]
-		num, err := ssz.DecodeDynamicLength(buf, 1048576)
-		if err != nil {
-			return err
-		}
-		e.Transactions = make([][]byte, num)
-		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
-			if len(buf) > 1073741824 {
-				return ssz.ErrBytesLength
-			}
-			if cap(e.Transactions[indx]) == 0 {
-				e.Transactions[indx] = make([]byte, 0, len(buf))
-			}
-			e.Transactions[indx] = append(e.Transactions[indx], buf...)
-			return nil
-		})
-		if err != nil {
-			return err
-		}
-	}
-	return err
+	hh.Merkleize(indx)
+	return
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the ExecutionPayload object
-func (e *ExecutionPayload) SizeSSZ() (size int) {
-	size = 508
+// GetTree ssz hashes the SignedBLSToExecutionChange object
+func (s *SignedBLSToExecutionChange) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
 
-	// Field (10) 'ExtraData'
-	size += len(e.ExtraData)
+const (
+	// SignedBLSToExecutionChangeMessageGIndex is the generalized index of the 'Message' field
+	SignedBLSToExecutionChangeMessageGIndex ssz.GIndex = 2
+	// SignedBLSToExecutionChangeSignatureGIndex is the generalized index of the 'Signature' field
+	SignedBLSToExecutionChangeSignatureGIndex ssz.GIndex = 3
+)
 
-	// Field (13) 'Transactions'
-	for ii := 0; ii < len(e.Transactions); ii++ {
-		size += 4
-		size += len(e.Transactions[ii])
-	}
+// ProveMessage returns a merkle proof of the 'Message' field of the SignedBLSToExecutionChange object
+func (s *SignedBLSToExecutionChange) ProveMessage() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(s, SignedBLSToExecutionChangeMessageGIndex)
+}
 
-	return
+// VerifySignedBLSToExecutionChangeMessage verifies a merkle proof of the 'Message' field of a SignedBLSToExecutionChange object
+func VerifySignedBLSToExecutionChangeMessage(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, SignedBLSToExecutionChangeMessageGIndex)
 }
 
-// HashTreeRoot ssz hashes the ExecutionPayload object
-func (e *ExecutionPayload) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(e)
+// ProveSignature returns a merkle proof of the 'Signature' field of the SignedBLSToExecutionChange object
+func (s *SignedBLSToExecutionChange) ProveSignature() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(s, SignedBLSToExecutionChangeSignatureGIndex)
 }
 
-// HashTreeRootWith ssz hashes the ExecutionPayload object with a hasher
-func (e *ExecutionPayload) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
+// VerifySignedBLSToExecutionChangeSignature verifies a merkle proof of the 'Signature' field of a SignedBLSToExecutionChange object
+func VerifySignedBLSToExecutionChangeSignature(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, SignedBLSToExecutionChangeSignatureGIndex)
+}
 
-	// Field (0) 'ParentHash'
-	hh.PutBytes(e.ParentHash[:])
+// SchemaSSZ returns the schema of the tree of the SignedBLSToExecutionChange object
+func (s *SignedBLSToExecutionChange) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Message", Schema: (*BLSToExecutionChange)(nil).SchemaSSZ()},
+		&ssz.SchemaField{Name: "Signature", Schema: ssz.SchemaBytes(96)},
+	)
+}
 
-	// Field (1) 'FeeRecipient'
-	hh.PutBytes(e.FeeRecipient[:])
+// MarshalSSZ ssz marshals the Withdrawal object
+func (w *Withdrawal) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(w)
+}
 
-	// Field (2) 'StateRoot'
-	hh.PutBytes(e.StateRoot[:])
+// MarshalSSZTo ssz marshals the Withdrawal object to a target array
+func (w *Withdrawal) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
 
-	// Field (3) 'ReceiptsRoot'
-	hh.PutBytes(e.ReceiptsRoot[:])
+	// Field (0) 'Index'
+	dst = ssz.MarshalUint64(dst, w.Index)
 
-	// Field (4) 'LogsBloom'
-	hh.PutBytes(e.LogsBloom[:])
+	// Field (1) 'ValidatorIndex'
+	dst = ssz.MarshalUint64(dst, w.ValidatorIndex)
 
-	// Field (5) 'PrevRandao'
-	hh.PutBytes(e.PrevRandao[:])
+	// Field (2) 'Address'
+	dst = append(dst, w.Address[:]...)
 
-	// Field (6) 'BlockNumber'
-	hh.PutUint64(e.BlockNumber)
+	// Field (3) 'Amount'
+	dst = ssz.MarshalUint64(dst, w.Amount)
 
-	// Field (7) 'GasLimit'
-	hh.PutUint64(e.GasLimit)
+	return
+}
 
-	// Field (8) 'GasUsed'
-	hh.PutUint64(e.GasUsed)
+// MarshalSSZToWriter ssz marshals the Withdrawal object to a writer
+func (w *Withdrawal) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(w, writer)
+}
 
-	// Field (9) 'Timestamp'
-	hh.PutUint64(e.Timestamp)
+// MarshalSSZToStream ssz marshals the Withdrawal object to a target array that is flushed to a stream writer
+func (w *Withdrawal) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
 
-	// Field (10) 'ExtraData'
-	{
-		elemIndx := hh.Index()
-		byteLen := uint64(len(e.ExtraData))
-		if byteLen > 32 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		hh.Append(e.ExtraData)
-		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
-	}
+	// Field (0) 'Index'
+	dst = ssz.MarshalUint64(dst, w.Index)
 
-	// Field (11) 'BaseFeePerGas'
-	hh.PutBytes(e.BaseFeePerGas[:])
+	// Field (1) 'ValidatorIndex'
+	dst = ssz.MarshalUint64(dst, w.ValidatorIndex)
 
-	// Field (12) 'BlockHash'
-	hh.PutBytes(e.BlockHash[:])
+	// Field (2) 'Address'
+	dst = append(dst, w.Address[:]...)
 
-	// Field (13) 'Transactions'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(e.Transactions))
-		if num > 1048576 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range e.Transactions {
-			{
-				elemIndx := hh.Index()
-				byteLen := uint64(len(elem))
-				if byteLen > 1073741824 {
-					err = ssz.ErrIncorrectListSize
-					return
-				}
-				hh.AppendBytes32(elem)
-				hh.MerkleizeWithMixin(elemIndx, byteLen, (1073741824+31)/32)
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, 1048576)
-	}
+	// Field (3) 'Amount'
+	dst = ssz.MarshalUint64(dst, w.Amount)
 
-	hh.Merkleize(indx)
 	return
 }
 
-// GetTree ssz hashes the ExecutionPayload object
-func (e *ExecutionPayload) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(e)
-}
-
-// MarshalSSZ ssz marshals the ExecutionPayloadHeader object
-func (e *ExecutionPayloadHeader) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(e)
+// UnmarshalSSZ ssz unmarshals the Withdrawal object
+func (w *Withdrawal) UnmarshalSSZ(buf []byte) error {
+	return w.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
 }
 
-// MarshalSSZTo ssz marshals the ExecutionPayloadHeader object to a target array
-func (e *ExecutionPayloadHeader) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := int(536)
-
-	// Field (0) 'ParentHash'
-	if size := len(e.ParentHash); size != 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.ParentHash", size, 32)
-		return
+// UnmarshalSSZWithOptions ssz unmarshals the Withdrawal object with the decoding options
+func (w *Withdrawal) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 44 {
+		return ssz.ErrSize
 	}
-	dst = append(dst, e.ParentHash...)
 
-	// Field (1) 'FeeRecipient'
-	if size := len(e.FeeRecipient); size != 20 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.FeeRecipient", size, 20)
-		return
-	}
-	dst = append(dst, e.FeeRecipient...)
+	// Field (0) 'Index'
+	w.Index = ssz.UnmarshallUint64(buf[0:8])
 
-	// Field (2) 'StateRoot'
-	if size := len(e.StateRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.StateRoot", size, 32)
-		return
-	}
-	dst = append(dst, e.StateRoot...)
+	// Field (1) 'ValidatorIndex'
+	w.ValidatorIndex = ssz.UnmarshallUint64(buf[8:16])
 
-	// Field (3) 'ReceiptsRoot'
-	if size := len(e.ReceiptsRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.ReceiptsRoot", size, 32)
-		return
-	}
-	dst = append(dst, e.ReceiptsRoot...)
+	// Field (2) 'Address'
+	copy(w.Address[:], buf[16:36])
 
-	// Field (4) 'LogsBloom'
-	if size := len(e.LogsBloom); size != 256 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.LogsBloom", size, 256)
-		return
-	}
-	dst = append(dst, e.LogsBloom...)
+	// Field (3) 'Amount'
+	w.Amount = ssz.UnmarshallUint64(buf[36:44])
 
-	// Field (5) 'PrevRandao'
-	if size := len(e.PrevRandao); size != 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.PrevRandao", size, 32)
-		return
-	}
-	dst = append(dst, e.PrevRandao...)
+	return err
+}
 
-	// Field (6) 'BlockNumber'
-	dst = ssz.MarshalUint64(dst, e.BlockNumber)
+// SizeSSZ returns the ssz encoded size in bytes for the Withdrawal object
+func (w *Withdrawal) SizeSSZ() (size int) {
+	size = 44
+	return
+}
 
-	// Field (7) 'GasLimit'
-	dst = ssz.MarshalUint64(dst, e.GasLimit)
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Withdrawal object
+func (w *Withdrawal) MinSSZSize() uint64 {
+	return 44
+}
 
-	// Field (8) 'GasUsed'
-	dst = ssz.MarshalUint64(dst, e.GasUsed)
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Withdrawal object
+func (w *Withdrawal) MaxSSZSize() uint64 {
+	return 44
+}
 
-	// Field (9) 'Timestamp'
-	dst = ssz.MarshalUint64(dst, e.Timestamp)
+// HashTreeRoot ssz hashes the Withdrawal object
+func (w *Withdrawal) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(w)
+}
 
-	// Offset (10) 'ExtraData'
-	dst = ssz.WriteOffset(dst, offset)
+// HashTreeRootWith ssz hashes the Withdrawal object with a hasher
+func (w *Withdrawal) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
 
-	// Field (11) 'BaseFeePerGas'
-	if size := len(e.BaseFeePerGas); size != 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.BaseFeePerGas", size, 32)
-		return
-	}
-	dst = append(dst, e.BaseFeePerGas...)
+	// Field (0) 'Index'
+	hh.PutUint64(w.Index)
 
-	// Field (12) 'BlockHash'
-	if size := len(e.BlockHash); size != 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.BlockHash", size, 32)
-		return
-	}
-	dst = append(dst, e.BlockHash...)
+	// Field (1) 'ValidatorIndex'
+	hh.PutUint64(w.ValidatorIndex)
 
-	// Field (13) 'TransactionsRoot'
-	if size := len(e.TransactionsRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.TransactionsRoot", size, 32)
-		return
-	}
-	dst = append(dst, e.TransactionsRoot...)
+	// Field (2) 'Address'
+	hh.PutBytes(w.Address[:])
 
-	// Field (10) 'ExtraData'
-	if size := len(e.ExtraData); size > 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.ExtraData", size, 32)
-		return
-	}
-	dst = append(dst, e.ExtraData...)
+	// Field (3) 'Amount'
+	hh.PutUint64(w.Amount)
 
+	hh.Merkleize(indx)
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the ExecutionPayloadHeader object
-func (e *ExecutionPayloadHeader) UnmarshalSSZ(buf []byte) error {
-	var err error
+// HashTreeRootSSZ ssz hashes the encoded Withdrawal object without decoding it
+func (w *Withdrawal) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(w, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded Withdrawal object with a hasher
+func (w *Withdrawal) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
 	size := uint64(len(buf))
-	if size < 536 {
+	if size != 44 {
 		return ssz.ErrSize
 	}
 
-	tail := buf
-	var o10 uint64
+	indx := hh.Index()
 
-	// Field (0) 'ParentHash'
-	if cap(e.ParentHash) == 0 {
-		e.ParentHash = make([]byte, 0, len(buf[0:32]))
-	}
-	e.ParentHash = append(e.ParentHash, buf[0:32]...)
+	// Field (0) 'Index'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
 
-	// Field (1) 'FeeRecipient'
-	if cap(e.FeeRecipient) == 0 {
-		e.FeeRecipient = make([]byte, 0, len(buf[32:52]))
-	}
-	e.FeeRecipient = append(e.FeeRecipient, buf[32:52]...)
+	// Field (1) 'ValidatorIndex'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[8:16]))
+
+	// Field (2) 'Address'
+	hh.PutBytes(buf[16:36])
+
+	// Field (3) 'Amount'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[36:44]))
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Withdrawal object
+func (w *Withdrawal) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(w)
+}
+
+const (
+	// WithdrawalIndexGIndex is the generalized index of the 'Index' field
+	WithdrawalIndexGIndex ssz.GIndex = 4
+	// WithdrawalValidatorIndexGIndex is the generalized index of the 'ValidatorIndex' field
+	WithdrawalValidatorIndexGIndex ssz.GIndex = 5
+	// WithdrawalAddressGIndex is the generalized index of the 'Address' field
+	WithdrawalAddressGIndex ssz.GIndex = 6
+	// WithdrawalAmountGIndex is the generalized index of the 'Amount' field
+	WithdrawalAmountGIndex ssz.GIndex = 7
+)
 
-	// Field (2) 'StateRoot'
-	if cap(e.StateRoot) == 0 {
-		e.StateRoot = make([]byte, 0, len(buf[52:84]))
-	}
-	e.StateRoot = append(e.StateRoot, buf[52:84]...)
+// ProveIndex returns a merkle proof of the 'Index' field of the Withdrawal object
+func (w *Withdrawal) ProveIndex() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(w, WithdrawalIndexGIndex)
+}
 
-	// Field (3) 'ReceiptsRoot'
-	if cap(e.ReceiptsRoot) == 0 {
-		e.ReceiptsRoot = make([]byte, 0, len(buf[84:116]))
-	}
-	e.ReceiptsRoot = append(e.ReceiptsRoot, buf[84:116]...)
+// VerifyWithdrawalIndex verifies a merkle proof of the 'Index' field of a Withdrawal object
+func VerifyWithdrawalIndex(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, WithdrawalIndexGIndex)
+}
 
-	// Field (4) 'LogsBloom'
-	if cap(e.LogsBloom) == 0 {
-		e.LogsBloom = make([]byte, 0, len(buf[116:372]))
-	}
-	e.LogsBloom = append(e.LogsBloom, buf[116:372]...)
+// ProveValidatorIndex returns a merkle proof of the 'ValidatorIndex' field of the Withdrawal object
+func (w *Withdrawal) ProveValidatorIndex() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(w, WithdrawalValidatorIndexGIndex)
+}
 
-	// Field (5) 'PrevRandao'
-	if cap(e.PrevRandao) == 0 {
-		e.PrevRandao = make([]byte, 0, len(buf[372:404]))
-	}
-	e.PrevRandao = append(e.PrevRandao, buf[372:404]...)
+// VerifyWithdrawalValidatorIndex verifies a merkle proof of the 'ValidatorIndex' field of a Withdrawal object
+func VerifyWithdrawalValidatorIndex(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, WithdrawalValidatorIndexGIndex)
+}
 
-	// Field (6) 'BlockNumber'
-	e.BlockNumber = ssz.UnmarshallUint64(buf[404:412])
+// ProveAddress returns a merkle proof of the 'Address' field of the Withdrawal object
+func (w *Withdrawal) ProveAddress() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(w, WithdrawalAddressGIndex)
+}
 
-	// Field (7) 'GasLimit'
-	e.GasLimit = ssz.UnmarshallUint64(buf[412:420])
+// VerifyWithdrawalAddress verifies a merkle proof of the 'Address' field of a Withdrawal object
+func VerifyWithdrawalAddress(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, WithdrawalAddressGIndex)
+}
 
-	// Field (8) 'GasUsed'
-	e.GasUsed = ssz.UnmarshallUint64(buf[420:428])
+// ProveAmount returns a merkle proof of the 'Amount' field of the Withdrawal object
+func (w *Withdrawal) ProveAmount() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(w, WithdrawalAmountGIndex)
+}
 
-	// Field (9) 'Timestamp'
-	e.Timestamp = ssz.UnmarshallUint64(buf[428:436])
+// VerifyWithdrawalAmount verifies a merkle proof of the 'Amount' field of a Withdrawal object
+func VerifyWithdrawalAmount(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, WithdrawalAmountGIndex)
+}
 
-	// Offset (10) 'ExtraData'
-	if o10 = ssz.ReadOffset(buf[436:440]); o10 > size {
-		return ssz.ErrOffset
-	}
+// SchemaSSZ returns the schema of the tree of the Withdrawal object
+func (w *Withdrawal) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Index", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "ValidatorIndex", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "Address", Schema: ssz.SchemaBytes(20)},
+		&ssz.SchemaField{Name: "Amount", Schema: ssz.SchemaUint(8)},
+	)
+}
 
-	if o10 < 536 {
-		return ssz.ErrInvalidVariableOffset
-	}
+// MarshalSSZ ssz marshals the SingleFieldTestStruct object
+func (s *SingleFieldTestStruct) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
 
-	// Field (11) 'BaseFeePerGas'
-	if cap(e.BaseFeePerGas) == 0 {
-		e.BaseFeePerGas = make([]byte, 0, len(buf[440:472]))
-	}
-	e.BaseFeePerGas = append(e.BaseFeePerGas, buf[440:472]...)
+// MarshalSSZTo ssz marshals the SingleFieldTestStruct object to a target array
+func (s *SingleFieldTestStruct) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
 
-	// Field (12) 'BlockHash'
-	if cap(e.BlockHash) == 0 {
-		e.BlockHash = make([]byte, 0, len(buf[472:504]))
-	}
-	e.BlockHash = append(e.BlockHash, buf[472:504]...)
+	// Field (0) 'A'
+	dst = ssz.MarshalUint8(dst, s.A)
 
-	// Field (13) 'TransactionsRoot'
-	if cap(e.TransactionsRoot) == 0 {
-		e.TransactionsRoot = make([]byte, 0, len(buf[504:536]))
-	}
-	e.TransactionsRoot = append(e.TransactionsRoot, buf[504:536]...)
+	return
+}
 
-	// Field (10) 'ExtraData'
-	{
-		buf = tail[o10:]
-		if len(buf) > 32 {
-			return ssz.ErrBytesLength
-		}
-		if cap(e.ExtraData) == 0 {
-			e.ExtraData = make([]byte, 0, len(buf))
-		}
-		e.ExtraData = append(e.ExtraData, buf...)
-	}
-	return err
+// MarshalSSZToWriter ssz marshals the SingleFieldTestStruct object to a writer
+func (s *SingleFieldTestStruct) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(s, writer)
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the ExecutionPayloadHeader object
-func (e *ExecutionPayloadHeader) SizeSSZ() (size int) {
-	size = 536
+// MarshalSSZToStream ssz marshals the SingleFieldTestStruct object to a target array that is flushed to a stream writer
+func (s *SingleFieldTestStruct) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
 
-	// Field (10) 'ExtraData'
-	size += len(e.ExtraData)
+	// Field (0) 'A'
+	dst = ssz.MarshalUint8(dst, s.A)
 
 	return
 }
 
-// HashTreeRoot ssz hashes the ExecutionPayloadHeader object
-func (e *ExecutionPayloadHeader) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(e)
+// UnmarshalSSZ ssz unmarshals the SingleFieldTestStruct object
+func (s *SingleFieldTestStruct) UnmarshalSSZ(buf []byte) error {
+	return s.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
 }
 
-// HashTreeRootWith ssz hashes the ExecutionPayloadHeader object with a hasher
-func (e *ExecutionPayloadHeader) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
-
-	// Field (0) 'ParentHash'
-	if size := len(e.ParentHash); size != 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.ParentHash", size, 32)
-		return
+// UnmarshalSSZWithOptions ssz unmarshals the SingleFieldTestStruct object with the decoding options
+func (s *SingleFieldTestStruct) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 1 {
+		return ssz.ErrSize
 	}
-	hh.PutBytes(e.ParentHash)
 
-	// Field (1) 'FeeRecipient'
-	if size := len(e.FeeRecipient); size != 20 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.FeeRecipient", size, 20)
-		return
-	}
-	hh.PutBytes(e.FeeRecipient)
+	// Field (0) 'A'
+	s.A = ssz.UnmarshallUint8(buf[0:1])
 
-	// Field (2) 'StateRoot'
-	if size := len(e.StateRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.StateRoot", size, 32)
-		return
-	}
-	hh.PutBytes(e.StateRoot)
+	return err
+}
 
-	// Field (3) 'ReceiptsRoot'
-	if size := len(e.ReceiptsRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.ReceiptsRoot", size, 32)
-		return
-	}
-	hh.PutBytes(e.ReceiptsRoot)
+// SizeSSZ returns the ssz encoded size in bytes for the SingleFieldTestStruct object
+func (s *SingleFieldTestStruct) SizeSSZ() (size int) {
+	size = 1
+	return
+}
 
-	// Field (4) 'LogsBloom'
-	if size := len(e.LogsBloom); size != 256 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.LogsBloom", size, 256)
-		return
-	}
-	hh.PutBytes(e.LogsBloom)
+// MinSSZSize returns the minimum ssz encoded size in bytes for the SingleFieldTestStruct object
+func (s *SingleFieldTestStruct) MinSSZSize() uint64 {
+	return 1
+}
 
-	// Field (5) 'PrevRandao'
-	if size := len(e.PrevRandao); size != 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.PrevRandao", size, 32)
-		return
-	}
-	hh.PutBytes(e.PrevRandao)
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the SingleFieldTestStruct object
+func (s *SingleFieldTestStruct) MaxSSZSize() uint64 {
+	return 1
+}
 
-	// Field (6) 'BlockNumber'
-	hh.PutUint64(e.BlockNumber)
+// HashTreeRoot ssz hashes the SingleFieldTestStruct object
+func (s *SingleFieldTestStruct) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
 
-	// Field (7) 'GasLimit'
-	hh.PutUint64(e.GasLimit)
+// HashTreeRootWith ssz hashes the SingleFieldTestStruct object with a hasher
+func (s *SingleFieldTestStruct) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
 
-	// Field (8) 'GasUsed'
-	hh.PutUint64(e.GasUsed)
+	// Field (0) 'A'
+	hh.PutUint8(s.A)
 
-	// Field (9) 'Timestamp'
-	hh.PutUint64(e.Timestamp)
+	hh.Merkleize(indx)
+	return
+}
 
-	// Field (10) 'ExtraData'
-	{
-		elemIndx := hh.Index()
-		byteLen := uint64(len(e.ExtraData))
-		if byteLen > 32 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		hh.Append(e.ExtraData)
-		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
-	}
+// HashTreeRootSSZ ssz hashes the encoded SingleFieldTestStruct object without decoding it
+func (s *SingleFieldTestStruct) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(s, buf)
+}
 
-	// Field (11) 'BaseFeePerGas'
-	if size := len(e.BaseFeePerGas); size != 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.BaseFeePerGas", size, 32)
-		return
+// HashTreeRootSSZWith ssz hashes the encoded SingleFieldTestStruct object with a hasher
+func (s *SingleFieldTestStruct) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 1 {
+		return ssz.ErrSize
 	}
-	hh.PutBytes(e.BaseFeePerGas)
 
-	// Field (12) 'BlockHash'
-	if size := len(e.BlockHash); size != 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.BlockHash", size, 32)
-		return
-	}
-	hh.PutBytes(e.BlockHash)
+	indx := hh.Index()
 
-	// Field (13) 'TransactionsRoot'
-	if size := len(e.TransactionsRoot); size != 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeader.TransactionsRoot", size, 32)
-		return
-	}
-	hh.PutBytes(e.TransactionsRoot)
+	// Field (0) 'A'
+	hh.PutUint8(ssz.UnmarshallUint8(buf[0:1]))
 
 	hh.Merkleize(indx)
 	return
 }
 
-// GetTree ssz hashes the ExecutionPayloadHeader object
-func (e *ExecutionPayloadHeader) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(e)
+// GetTree ssz hashes the SingleFieldTestStruct object
+func (s *SingleFieldTestStruct) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
 }
 
-// MarshalSSZ ssz marshals the ExecutionPayloadCapella object
-func (e *ExecutionPayloadCapella) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(e)
+const (
+	// SingleFieldTestStructAGIndex is the generalized index of the 'A' field
+	SingleFieldTestStructAGIndex ssz.GIndex = 1
+)
+
+// ProveA returns a merkle proof of the 'A' field of the SingleFieldTestStruct object
+func (s *SingleFieldTestStruct) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(s, SingleFieldTestStructAGIndex)
+}
+
+// VerifySingleFieldTestStructA verifies a merkle proof of the 'A' field of a SingleFieldTestStruct object
+func VerifySingleFieldTestStructA(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, SingleFieldTestStructAGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the SingleFieldTestStruct object
+func (s *SingleFieldTestStruct) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: ssz.SchemaUint(1)},
+	)
 }
 
-// MarshalSSZTo ssz marshals the ExecutionPayloadCapella object to a target array
-func (e *ExecutionPayloadCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZ ssz marshals the SmallTestStruct object
+func (s *SmallTestStruct) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SmallTestStruct object to a target array
+func (s *SmallTestStruct) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(512)
 
-	// Field (0) 'ParentHash'
-	dst = append(dst, e.ParentHash[:]...)
+	// Field (0) 'A'
+	dst = ssz.MarshalUint16(dst, s.A)
 
-	// Field (1) 'FeeRecipient'
-	dst = append(dst, e.FeeRecipient[:]...)
+	// Field (1) 'B'
+	dst = ssz.MarshalUint16(dst, s.B)
 
-	// Field (2) 'StateRoot'
-	dst = append(dst, e.StateRoot[:]...)
+	return
+}
 
-	// Field (3) 'ReceiptsRoot'
-	dst = append(dst, e.ReceiptsRoot[:]...)
+// MarshalSSZToWriter ssz marshals the SmallTestStruct object to a writer
+func (s *SmallTestStruct) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(s, writer)
+}
 
-	// Field (4) 'LogsBloom'
-	dst = append(dst, e.LogsBloom[:]...)
+// MarshalSSZToStream ssz marshals the SmallTestStruct object to a target array that is flushed to a stream writer
+func (s *SmallTestStruct) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
 
-	// Field (5) 'PrevRandao'
-	dst = append(dst, e.PrevRandao[:]...)
+	// Field (0) 'A'
+	dst = ssz.MarshalUint16(dst, s.A)
 
-	// Field (6) 'BlockNumber'
-	dst = ssz.MarshalUint64(dst, e.BlockNumber)
+	// Field (1) 'B'
+	dst = ssz.MarshalUint16(dst, s.B)
 
-	// Field (7) 'GasLimit'
-	dst = ssz.MarshalUint64(dst, e.GasLimit)
+	return
+}
 
-	// Field (8) 'GasUsed'
-	dst = ssz.MarshalUint64(dst, e.GasUsed)
+// UnmarshalSSZ ssz unmarshals the SmallTestStruct object
+func (s *SmallTestStruct) UnmarshalSSZ(buf []byte) error {
+	return s.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
 
-	// Field (9) 'Timestamp'
-	dst = ssz.MarshalUint64(dst, e.Timestamp)
+// UnmarshalSSZWithOptions ssz unmarshals the SmallTestStruct object with the decoding options
+func (s *SmallTestStruct) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 4 {
+		return ssz.ErrSize
+	}
 
-	// Offset (10) 'ExtraData'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(e.ExtraData)
+	// Field (0) 'A'
+	s.A = ssz.UnmarshallUint16(buf[0:2])
 
-	// Field (11) 'BaseFeePerGas'
-	dst = append(dst, e.BaseFeePerGas[:]...)
+	// Field (1) 'B'
+	s.B = ssz.UnmarshallUint16(buf[2:4])
 
-	// Field (12) 'BlockHash'
-	dst = append(dst, e.BlockHash[:]...)
+	return err
+}
 
-	// Offset (13) 'Transactions'
-	dst = ssz.WriteOffset(dst, offset)
-	for ii := 0; ii < len(e.Transactions); ii++ {
-		offset += 4
-		offset += len(e.Transactions[ii])
-	}
+// SizeSSZ returns the ssz encoded size in bytes for the SmallTestStruct object
+func (s *SmallTestStruct) SizeSSZ() (size int) {
+	size = 4
+	return
+}
 
-	// Offset (14) 'Withdrawals'
-	dst = ssz.WriteOffset(dst, offset)
+// MinSSZSize returns the minimum ssz encoded size in bytes for the SmallTestStruct object
+func (s *SmallTestStruct) MinSSZSize() uint64 {
+	return 4
+}
 
-	// Field (10) 'ExtraData'
-	if size := len(e.ExtraData); size > 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadCapella.ExtraData", size, 32)
-		return
-	}
-	dst = append(dst, e.ExtraData...)
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the SmallTestStruct object
+func (s *SmallTestStruct) MaxSSZSize() uint64 {
+	return 4
+}
 
-	// Field (13) 'Transactions'
-	if size := len(e.Transactions); size > 1048576 {
-		err = ssz.ErrListTooBigFn("ExecutionPayloadCapella.Transactions", size, 1048576)
-		return
-	}
-	{
-		offset = 4 * len(e.Transactions)
-		for ii := 0; ii < len(e.Transactions); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += len(e.Transactions[ii])
-		}
-	}
-	for ii := 0; ii < len(e.Transactions); ii++ {
-		if size := len(e.Transactions[ii]); size > 1073741824 {
-			err = ssz.ErrBytesLengthFn("ExecutionPayloadCapella.Transactions[ii]", size, 1073741824)
-			return
-		}
-		dst = append(dst, e.Transactions[ii]...)
-	}
+// HashTreeRoot ssz hashes the SmallTestStruct object
+func (s *SmallTestStruct) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
 
-	// Field (14) 'Withdrawals'
-	if size := len(e.Withdrawals); size > 16 {
-		err = ssz.ErrListTooBigFn("ExecutionPayloadCapella.Withdrawals", size, 16)
-		return
-	}
-	for ii := 0; ii < len(e.Withdrawals); ii++ {
-		if dst, err = e.Withdrawals[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
-	}
+// HashTreeRootWith ssz hashes the SmallTestStruct object with a hasher
+func (s *SmallTestStruct) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint16(s.A)
 
+	// Field (1) 'B'
+	hh.PutUint16(s.B)
+
+	hh.Merkleize(indx)
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the ExecutionPayloadCapella object
-func (e *ExecutionPayloadCapella) UnmarshalSSZ(buf []byte) error {
-	var err error
+// HashTreeRootSSZ ssz hashes the encoded SmallTestStruct object without decoding it
+func (s *SmallTestStruct) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(s, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded SmallTestStruct object with a hasher
+func (s *SmallTestStruct) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
 	size := uint64(len(buf))
-	if size < 512 {
+	if size != 4 {
 		return ssz.ErrSize
 	}
 
-	tail := buf
-	var o10, o13, o14 uint64
-
-	// Field (0) 'ParentHash'
-	copy(e.ParentHash[:], buf[0:32])
-
-	// Field (1) 'FeeRecipient'
-	copy(e.FeeRecipient[:], buf[32:52])
+	indx := hh.Index()
 
-	// Field (2) 'StateRoot'
-	copy(e.StateRoot[:], buf[52:84])
+	// Field (0) 'A'
+	hh.PutUint16(ssz.UnmarshallUint16(buf[0:2]))
 
-	// Field (3) 'ReceiptsRoot'
-	copy(e.ReceiptsRoot[:], buf[84:116])
+	// Field (1) 'B'
+	hh.PutUint16(ssz.UnmarshallUint16(buf[2:4]))
 
-	// Field (4) 'LogsBloom'
-	copy(e.LogsBloom[:], buf[116:372])
+	hh.Merkleize(indx)
+	return
+}
 
-	// Field (5) 'PrevRandao'
-	copy(e.PrevRandao[:], buf[372:404])
+// GetTree ssz hashes the SmallTestStruct object
+func (s *SmallTestStruct) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
 
-	// Field (6) 'BlockNumber'
-	e.BlockNumber = ssz.UnmarshallUint64(buf[404:412])
+const (
+	// SmallTestStructAGIndex is the generalized index of the 'A' field
+	SmallTestStructAGIndex ssz.GIndex = 2
+	// SmallTestStructBGIndex is the generalized index of the 'B' field
+	SmallTestStructBGIndex ssz.GIndex = 3
+)
 
-	// Field (7) 'GasLimit'
-	e.GasLimit = ssz.UnmarshallUint64(buf[412:420])
+// ProveA returns a merkle proof of the 'A' field of the SmallTestStruct object
+func (s *SmallTestStruct) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(s, SmallTestStructAGIndex)
+}
 
-	// Field (8) 'GasUsed'
-	e.GasUsed = ssz.UnmarshallUint64(buf[420:428])
+// VerifySmallTestStructA verifies a merkle proof of the 'A' field of a SmallTestStruct object
+func VerifySmallTestStructA(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, SmallTestStructAGIndex)
+}
 
-	// Field (9) 'Timestamp'
-	e.Timestamp = ssz.UnmarshallUint64(buf[428:436])
+// ProveB returns a merkle proof of the 'B' field of the SmallTestStruct object
+func (s *SmallTestStruct) ProveB() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(s, SmallTestStructBGIndex)
+}
 
-	// Offset (10) 'ExtraData'
-	if o10 = ssz.ReadOffset(buf[436:440]); o10 > size {
-		return ssz.ErrOffset
-	}
+// VerifySmallTestStructB verifies a merkle proof of the 'B' field of a SmallTestStruct object
+func VerifySmallTestStructB(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, SmallTestStructBGIndex)
+}
 
-	if o10 < 512 {
-		return ssz.ErrInvalidVariableOffset
-	}
+// SchemaSSZ returns the schema of the tree of the SmallTestStruct object
+func (s *SmallTestStruct) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: ssz.SchemaUint(2)},
+		&ssz.SchemaField{Name: "B", Schema: ssz.SchemaUint(2)},
+	)
+}
 
-	// Field (11) 'BaseFeePerGas'
-	copy(e.BaseFeePerGas[:], buf[440:472])
+// MarshalSSZ ssz marshals the FixedTestStruct object
+func (f *FixedTestStruct) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(f)
+}
 
-	// Field (12) 'BlockHash'
-	copy(e.BlockHash[:], buf[472:504])
+// MarshalSSZTo ssz marshals the FixedTestStruct object to a target array
+func (f *FixedTestStruct) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
 
-	// Offset (13) 'Transactions'
-	if o13 = ssz.ReadOffset(buf[504:508]); o13 > size || o10 > o13 {
-		return ssz.ErrOffset
-	}
+	// Field (0) 'A'
+	dst = ssz.MarshalUint8(dst, f.A)
 
-	// Offset (14) 'Withdrawals'
-	if o14 = ssz.ReadOffset(buf[508:512]); o14 > size || o13 > o14 {
-		return ssz.ErrOffset
-	}
+	// Field (1) 'B'
+	dst = ssz.MarshalUint64(dst, f.B)
 
-	// Field (10) 'ExtraData'
-	{
-		buf = tail[o10:o13]
-		if len(buf) > 32 {
-			return ssz.ErrBytesLength
-		}
-		if cap(e.ExtraData) == 0 {
-			e.ExtraData = make([]byte, 0, len(buf))
-		}
-		e.ExtraData = append(e.ExtraData, buf...)
-	}
+	// Field (2) 'C'
+	dst = ssz.MarshalUint32(dst, f.C)
 
-	// Field (13) 'Transactions'
-	{
-		buf = tail[o13:o14]
-		num, err := ssz.DecodeDynamicLength(buf, 1048576)
-		if err != nil {
-			return err
-		}
-		e.Transactions = make([][]byte, num)
-		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
-			if len(buf) > 1073741824 {
-				return ssz.ErrBytesLength
-			}
-			if cap(e.Transactions[indx]) == 0 {
-				e.Transactions[indx] = make([]byte, 0, len(buf))
-			}
-			e.Transactions[indx] = append(e.Transactions[indx], buf...)
-			return nil
-		})
-		if err != nil {
-			return err
-		}
-	}
+	return
+}
 
-	// Field (14) 'Withdrawals'
-	{
-		buf = tail[o14:]
-		num, err := ssz.DivideInt2(len(buf), 44, 16)
-		if err != nil {
-			return err
-		}
-		e.Withdrawals = make([]*Withdrawal, num)
-		for ii := 0; ii < num; ii++ {
-			if e.Withdrawals[ii] == nil {
-				e.Withdrawals[ii] = new(Withdrawal)
-			}
-			if err = e.Withdrawals[ii].UnmarshalSSZ(buf[ii*44 : (ii+1)*44]); err != nil {
-				return err
-			}
-		}
-	}
-	return err
+// MarshalSSZToWriter ssz marshals the FixedTestStruct object to a writer
+func (f *FixedTestStruct) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(f, writer)
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the ExecutionPayloadCapella object
-func (e *ExecutionPayloadCapella) SizeSSZ() (size int) {
-	size = 512
+// MarshalSSZToStream ssz marshals the FixedTestStruct object to a target array that is flushed to a stream writer
+func (f *FixedTestStruct) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
 
-	// Field (10) 'ExtraData'
-	size += len(e.ExtraData)
+	// Field (0) 'A'
+	dst = ssz.MarshalUint8(dst, f.A)
 
-	// Field (13) 'Transactions'
-	for ii := 0; ii < len(e.Transactions); ii++ {
-		size += 4
-		size += len(e.Transactions[ii])
-	}
+	// Field (1) 'B'
+	dst = ssz.MarshalUint64(dst, f.B)
 
-	// Field (14) 'Withdrawals'
-	size += len(e.Withdrawals) * 44
+	// Field (2) 'C'
+	dst = ssz.MarshalUint32(dst, f.C)
 
 	return
 }
 
-// HashTreeRoot ssz hashes the ExecutionPayloadCapella object
-func (e *ExecutionPayloadCapella) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(e)
+// UnmarshalSSZ ssz unmarshals the FixedTestStruct object
+func (f *FixedTestStruct) UnmarshalSSZ(buf []byte) error {
+	return f.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
 }
 
-// HashTreeRootWith ssz hashes the ExecutionPayloadCapella object with a hasher
-func (e *ExecutionPayloadCapella) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
+// UnmarshalSSZWithOptions ssz unmarshals the FixedTestStruct object with the decoding options
+func (f *FixedTestStruct) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 13 {
+		return ssz.ErrSize
+	}
 
-	// Field (0) 'ParentHash'
-	hh.PutBytes(e.ParentHash[:])
+	// Field (0) 'A'
+	f.A = ssz.UnmarshallUint8(buf[0:1])
 
-	// Field (1) 'FeeRecipient'
-	hh.PutBytes(e.FeeRecipient[:])
+	// Field (1) 'B'
+	f.B = ssz.UnmarshallUint64(buf[1:9])
 
-	// Field (2) 'StateRoot'
-	hh.PutBytes(e.StateRoot[:])
+	// Field (2) 'C'
+	f.C = ssz.UnmarshallUint32(buf[9:13])
 
-	// Field (3) 'ReceiptsRoot'
-	hh.PutBytes(e.ReceiptsRoot[:])
+	return err
+}
 
-	// Field (4) 'LogsBloom'
-	hh.PutBytes(e.LogsBloom[:])
+// SizeSSZ returns the ssz encoded size in bytes for the FixedTestStruct object
+func (f *FixedTestStruct) SizeSSZ() (size int) {
+	size = 13
+	return
+}
 
-	// Field (5) 'PrevRandao'
-	hh.PutBytes(e.PrevRandao[:])
+// MinSSZSize returns the minimum ssz encoded size in bytes for the FixedTestStruct object
+func (f *FixedTestStruct) MinSSZSize() uint64 {
+	return 13
+}
 
-	// Field (6) 'BlockNumber'
-	hh.PutUint64(e.BlockNumber)
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the FixedTestStruct object
+func (f *FixedTestStruct) MaxSSZSize() uint64 {
+	return 13
+}
 
-	// Field (7) 'GasLimit'
-	hh.PutUint64(e.GasLimit)
+// HashTreeRoot ssz hashes the FixedTestStruct object
+func (f *FixedTestStruct) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(f)
+}
 
-	// Field (8) 'GasUsed'
-	hh.PutUint64(e.GasUsed)
+// HashTreeRootWith ssz hashes the FixedTestStruct object with a hasher
+func (f *FixedTestStruct) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
 
-	// Field (9) 'Timestamp'
-	hh.PutUint64(e.Timestamp)
+	// Field (0) 'A'
+	hh.PutUint8(f.A)
 
-	// Field (10) 'ExtraData'
-	{
-		elemIndx := hh.Index()
-		byteLen := uint64(len(e.ExtraData))
-		if byteLen > 32 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		hh.Append(e.ExtraData)
-		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
-	}
+	// Field (1) 'B'
+	hh.PutUint64(f.B)
 
-	// Field (11) 'BaseFeePerGas'
-	hh.PutBytes(e.BaseFeePerGas[:])
+	// Field (2) 'C'
+	hh.PutUint32(f.C)
 
-	// Field (12) 'BlockHash'
-	hh.PutBytes(e.BlockHash[:])
+	hh.Merkleize(indx)
+	return
+}
 
-	// Field (13) 'Transactions'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(e.Transactions))
-		if num > 1048576 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range e.Transactions {
-			{
-				elemIndx := hh.Index()
-				byteLen := uint64(len(elem))
-				if byteLen > 1073741824 {
-					err = ssz.ErrIncorrectListSize
-					return
-				}
-				hh.AppendBytes32(elem)
-				hh.MerkleizeWithMixin(elemIndx, byteLen, (1073741824+31)/32)
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, 1048576)
-	}
+// HashTreeRootSSZ ssz hashes the encoded FixedTestStruct object without decoding it
+func (f *FixedTestStruct) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(f, buf)
+}
 
-	// Field (14) 'Withdrawals'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(e.Withdrawals))
-		if num > 16 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range e.Withdrawals {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, 16)
+// HashTreeRootSSZWith ssz hashes the encoded FixedTestStruct object with a hasher
+func (f *FixedTestStruct) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 13 {
+		return ssz.ErrSize
 	}
 
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint8(ssz.UnmarshallUint8(buf[0:1]))
+
+	// Field (1) 'B'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[1:9]))
+
+	// Field (2) 'C'
+	hh.PutUint32(ssz.UnmarshallUint32(buf[9:13]))
+
 	hh.Merkleize(indx)
 	return
 }
 
-// GetTree ssz hashes the ExecutionPayloadCapella object
-func (e *ExecutionPayloadCapella) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(e)
+// GetTree ssz hashes the FixedTestStruct object
+func (f *FixedTestStruct) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(f)
 }
 
-// MarshalSSZ ssz marshals the ExecutionPayloadHeaderCapella object
-func (e *ExecutionPayloadHeaderCapella) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(e)
+const (
+	// FixedTestStructAGIndex is the generalized index of the 'A' field
+	FixedTestStructAGIndex ssz.GIndex = 4
+	// FixedTestStructBGIndex is the generalized index of the 'B' field
+	FixedTestStructBGIndex ssz.GIndex = 5
+	// FixedTestStructCGIndex is the generalized index of the 'C' field
+	FixedTestStructCGIndex ssz.GIndex = 6
+)
+
+// ProveA returns a merkle proof of the 'A' field of the FixedTestStruct object
+func (f *FixedTestStruct) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(f, FixedTestStructAGIndex)
 }
 
-// MarshalSSZTo ssz marshals the ExecutionPayloadHeaderCapella object to a target array
-func (e *ExecutionPayloadHeaderCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := int(568)
+// VerifyFixedTestStructA verifies a merkle proof of the 'A' field of a FixedTestStruct object
+func VerifyFixedTestStructA(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, FixedTestStructAGIndex)
+}
+
+// ProveB returns a merkle proof of the 'B' field of the FixedTestStruct object
+func (f *FixedTestStruct) ProveB() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(f, FixedTestStructBGIndex)
+}
 
-	// Field (0) 'ParentHash'
-	dst = append(dst, e.ParentHash[:]...)
+// VerifyFixedTestStructB verifies a merkle proof of the 'B' field of a FixedTestStruct object
+func VerifyFixedTestStructB(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, FixedTestStructBGIndex)
+}
+
+// ProveC returns a merkle proof of the 'C' field of the FixedTestStruct object
+func (f *FixedTestStruct) ProveC() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(f, FixedTestStructCGIndex)
+}
 
-	// Field (1) 'FeeRecipient'
-	dst = append(dst, e.FeeRecipient[:]...)
+// VerifyFixedTestStructC verifies a merkle proof of the 'C' field of a FixedTestStruct object
+func VerifyFixedTestStructC(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, FixedTestStructCGIndex)
+}
 
-	// Field (2) 'StateRoot'
-	dst = append(dst, e.StateRoot[:]...)
+// SchemaSSZ returns the schema of the tree of the FixedTestStruct object
+func (f *FixedTestStruct) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: ssz.SchemaUint(1)},
+		&ssz.SchemaField{Name: "B", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "C", Schema: ssz.SchemaUint(4)},
+	)
+}
 
-	// Field (3) 'ReceiptsRoot'
-	dst = append(dst, e.ReceiptsRoot[:]...)
+// MarshalSSZ ssz marshals the VarTestStruct object
+func (v *VarTestStruct) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
 
-	// Field (4) 'LogsBloom'
-	dst = append(dst, e.LogsBloom[:]...)
+// MarshalSSZTo ssz marshals the VarTestStruct object to a target array
+func (v *VarTestStruct) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(7)
 
-	// Field (5) 'PrevRandao'
-	dst = append(dst, e.PrevRandao[:]...)
+	// Field (0) 'A'
+	dst = ssz.MarshalUint16(dst, v.A)
 
-	// Field (6) 'BlockNumber'
-	dst = ssz.MarshalUint64(dst, e.BlockNumber)
+	// Offset (1) 'B'
+	dst = ssz.WriteOffset(dst, offset)
 
-	// Field (7) 'GasLimit'
-	dst = ssz.MarshalUint64(dst, e.GasLimit)
+	// Field (2) 'C'
+	dst = ssz.MarshalUint8(dst, v.C)
 
-	// Field (8) 'GasUsed'
-	dst = ssz.MarshalUint64(dst, e.GasUsed)
+	// Field (1) 'B'
+	if size := len(v.B); size > 1024 {
+		err = ssz.ErrListTooBigFn("VarTestStruct.B", size, 1024)
+		return
+	}
+	for ii := 0; ii < len(v.B); ii++ {
+		dst = ssz.MarshalUint16(dst, v.B[ii])
 
-	// Field (9) 'Timestamp'
-	dst = ssz.MarshalUint64(dst, e.Timestamp)
+	}
 
-	// Offset (10) 'ExtraData'
-	dst = ssz.WriteOffset(dst, offset)
+	return
+}
 
-	// Field (11) 'BaseFeePerGas'
-	dst = append(dst, e.BaseFeePerGas[:]...)
+// MarshalSSZToWriter ssz marshals the VarTestStruct object to a writer
+func (v *VarTestStruct) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(v, writer)
+}
+
+// MarshalSSZToStream ssz marshals the VarTestStruct object to a target array that is flushed to a stream writer
+func (v *VarTestStruct) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(7)
 
-	// Field (12) 'BlockHash'
-	dst = append(dst, e.BlockHash[:]...)
+	// Field (0) 'A'
+	dst = ssz.MarshalUint16(dst, v.A)
 
-	// Field (13) 'TransactionsRoot'
-	dst = append(dst, e.TransactionsRoot[:]...)
+	// Offset (1) 'B'
+	dst = ssz.WriteOffset(dst, offset)
 
-	// Field (14) 'WithdrawalRoot'
-	dst = append(dst, e.WithdrawalRoot[:]...)
+	// Field (2) 'C'
+	dst = ssz.MarshalUint8(dst, v.C)
 
-	// Field (10) 'ExtraData'
-	if size := len(e.ExtraData); size > 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeaderCapella.ExtraData", size, 32)
+	// Field (1) 'B'
+	if size := len(v.B); size > 1024 {
+		err = ssz.ErrListTooBigFn("VarTestStruct.B", size, 1024)
 		return
 	}
-	dst = append(dst, e.ExtraData...)
+	for ii := 0; ii < len(v.B); ii++ {
+		dst = ssz.MarshalUint16(dst, v.B[ii])
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
+	}
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the ExecutionPayloadHeaderCapella object
-func (e *ExecutionPayloadHeaderCapella) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the VarTestStruct object
+func (v *VarTestStruct) UnmarshalSSZ(buf []byte) error {
+	return v.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the VarTestStruct object with the decoding options
+func (v *VarTestStruct) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 568 {
+	if size < 7 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o10 uint64
-
-	// Field (0) 'ParentHash'
-	copy(e.ParentHash[:], buf[0:32])
-
-	// Field (1) 'FeeRecipient'
-	copy(e.FeeRecipient[:], buf[32:52])
-
-	// Field (2) 'StateRoot'
-	copy(e.StateRoot[:], buf[52:84])
-
-	// Field (3) 'ReceiptsRoot'
-	copy(e.ReceiptsRoot[:], buf[84:116])
-
-	// Field (4) 'LogsBloom'
-	copy(e.LogsBloom[:], buf[116:372])
-
-	// Field (5) 'PrevRandao'
-	copy(e.PrevRandao[:], buf[372:404])
-
-	// Field (6) 'BlockNumber'
-	e.BlockNumber = ssz.UnmarshallUint64(buf[404:412])
-
-	// Field (7) 'GasLimit'
-	e.GasLimit = ssz.UnmarshallUint64(buf[412:420])
-
-	// Field (8) 'GasUsed'
-	e.GasUsed = ssz.UnmarshallUint64(buf[420:428])
+	var o1 uint64
 
-	// Field (9) 'Timestamp'
-	e.Timestamp = ssz.UnmarshallUint64(buf[428:436])
+	// Field (0) 'A'
+	v.A = ssz.UnmarshallUint16(buf[0:2])
 
-	// Offset (10) 'ExtraData'
-	if o10 = ssz.ReadOffset(buf[436:440]); o10 > size {
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[2:6]); o1 > size {
 		return ssz.ErrOffset
 	}
 
-	if o10 < 568 {
-		return ssz.ErrInvalidVariableOffset
+	if err = opts.ValidateFirstOffset(o1, 7); err != nil {
+		return err
 	}
 
-	// Field (11) 'BaseFeePerGas'
-	copy(e.BaseFeePerGas[:], buf[440:472])
+	// Field (2) 'C'
+	v.C = ssz.UnmarshallUint8(buf[6:7])
 
-	// Field (12) 'BlockHash'
-	copy(e.BlockHash[:], buf[472:504])
-
-	// Field (13) 'TransactionsRoot'
-	copy(e.TransactionsRoot[:], buf[504:536])
-
-	// Field (14) 'WithdrawalRoot'
-	copy(e.WithdrawalRoot[:], buf[536:568])
-
-	// Field (10) 'ExtraData'
+	// Field (1) 'B'
 	{
-		buf = tail[o10:]
-		if len(buf) > 32 {
-			return ssz.ErrBytesLength
+		buf = tail[o1:]
+		num, err := ssz.DivideInt2(len(buf), 2, 1024)
+		if err != nil {
+			return err
 		}
-		if cap(e.ExtraData) == 0 {
-			e.ExtraData = make([]byte, 0, len(buf))
+		v.B = ssz.ExtendUint16(v.B, num)
+		for ii := 0; ii < num; ii++ {
+			v.B[ii] = ssz.UnmarshallUint16(buf[ii*2 : (ii+1)*2])
 		}
-		e.ExtraData = append(e.ExtraData, buf...)
 	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the ExecutionPayloadHeaderCapella object
-func (e *ExecutionPayloadHeaderCapella) SizeSSZ() (size int) {
-	size = 568
+// SizeSSZ returns the ssz encoded size in bytes for the VarTestStruct object
+func (v *VarTestStruct) SizeSSZ() (size int) {
+	size = 7
 
-	// Field (10) 'ExtraData'
-	size += len(e.ExtraData)
+	// Field (1) 'B'
+	size += len(v.B) * 2
 
 	return
 }
 
-// HashTreeRoot ssz hashes the ExecutionPayloadHeaderCapella object
-func (e *ExecutionPayloadHeaderCapella) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(e)
+// MinSSZSize returns the minimum ssz encoded size in bytes for the VarTestStruct object
+func (v *VarTestStruct) MinSSZSize() uint64 {
+	return 7
 }
 
-// HashTreeRootWith ssz hashes the ExecutionPayloadHeaderCapella object with a hasher
-func (e *ExecutionPayloadHeaderCapella) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
-
-	// Field (0) 'ParentHash'
-	hh.PutBytes(e.ParentHash[:])
-
-	// Field (1) 'FeeRecipient'
-	hh.PutBytes(e.FeeRecipient[:])
-
-	// Field (2) 'StateRoot'
-	hh.PutBytes(e.StateRoot[:])
-
-	// Field (3) 'ReceiptsRoot'
-	hh.PutBytes(e.ReceiptsRoot[:])
-
-	// Field (4) 'LogsBloom'
-	hh.PutBytes(e.LogsBloom[:])
-
-	// Field (5) 'PrevRandao'
-	hh.PutBytes(e.PrevRandao[:])
-
-	// Field (6) 'BlockNumber'
-	hh.PutUint64(e.BlockNumber)
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the VarTestStruct object
+func (v *VarTestStruct) MaxSSZSize() uint64 {
+	return 2055
+}
 
-	// Field (7) 'GasLimit'
-	hh.PutUint64(e.GasLimit)
+// HashTreeRoot ssz hashes the VarTestStruct object
+func (v *VarTestStruct) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
 
-	// Field (8) 'GasUsed'
-	hh.PutUint64(e.GasUsed)
+// HashTreeRootWith ssz hashes the VarTestStruct object with a hasher
+func (v *VarTestStruct) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
 
-	// Field (9) 'Timestamp'
-	hh.PutUint64(e.Timestamp)
+	// Field (0) 'A'
+	hh.PutUint16(v.A)
 
-	// Field (10) 'ExtraData'
+	// Field (1) 'B'
 	{
-		elemIndx := hh.Index()
-		byteLen := uint64(len(e.ExtraData))
-		if byteLen > 32 {
-			err = ssz.ErrIncorrectListSize
+		if size := len(v.B); size > 1024 {
+			err = ssz.ErrListTooBigFn("VarTestStruct.B", size, 1024)
 			return
 		}
-		hh.Append(e.ExtraData)
-		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+		subIndx := hh.Index()
+		for _, i := range v.B {
+			hh.Append(ssz.MarshalUint16(nil, i))
+		}
+		hh.FillUpTo32()
+		numItems := uint64(len(v.B))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1024, numItems, 2))
 	}
 
-	// Field (11) 'BaseFeePerGas'
-	hh.PutBytes(e.BaseFeePerGas[:])
-
-	// Field (12) 'BlockHash'
-	hh.PutBytes(e.BlockHash[:])
-
-	// Field (13) 'TransactionsRoot'
-	hh.PutBytes(e.TransactionsRoot[:])
-
-	// Field (14) 'WithdrawalRoot'
-	hh.PutBytes(e.WithdrawalRoot[:])
+	// Field (2) 'C'
+	hh.PutUint8(v.C)
 
 	hh.Merkleize(indx)
 	return
 }
 
-// GetTree ssz hashes the ExecutionPayloadHeaderCapella object
-func (e *ExecutionPayloadHeaderCapella) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(e)
+// HashTreeRootSSZ ssz hashes the encoded VarTestStruct object without decoding it
+func (v *VarTestStruct) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(v, buf)
 }
 
-// MarshalSSZ ssz marshals the BLSToExecutionChange object
-func (b *BLSToExecutionChange) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(b)
-}
+// HashTreeRootSSZWith ssz hashes the encoded VarTestStruct object with a hasher
+func (v *VarTestStruct) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 7 {
+		return ssz.ErrSize
+	}
 
-// MarshalSSZTo ssz marshals the BLSToExecutionChange object to a target array
-func (b *BLSToExecutionChange) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+	tail := buf
+	var o1 uint64
 
-	// Field (0) 'ValidatorIndex'
-	dst = ssz.MarshalUint64(dst, b.ValidatorIndex)
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[2:6]); o1 > size {
+		return ssz.ErrOffset
+	}
 
-	// Field (1) 'FromBLSPubKey'
-	dst = append(dst, b.FromBLSPubKey[:]...)
+	if err = ssz.ValidateFirstOffset(o1, 7); err != nil {
+		return err
+	}
 
-	// Field (2) 'ToExecutionAddress'
-	dst = append(dst, b.ToExecutionAddress[:]...)
+	indx := hh.Index()
 
-	return
-}
+	// Field (0) 'A'
+	hh.PutUint16(ssz.UnmarshallUint16(buf[0:2]))
 
-// UnmarshalSSZ ssz unmarshals the BLSToExecutionChange object
-func (b *BLSToExecutionChange) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 76 {
-		return ssz.ErrSize
+	// Field (1) 'B'
+	{
+		buf := tail[o1:]
+		{
+			subIndx := hh.Index()
+			num, err := ssz.DivideInt2(len(buf), 2, 1024)
+			if err != nil {
+				return err
+			}
+			hh.Append(buf)
+			hh.FillUpTo32()
+			hh.MerkleizeWithMixin(subIndx, uint64(num), ssz.CalculateLimit(1024, uint64(num), 2))
+		}
 	}
 
-	// Field (0) 'ValidatorIndex'
-	b.ValidatorIndex = ssz.UnmarshallUint64(buf[0:8])
-
-	// Field (1) 'FromBLSPubKey'
-	copy(b.FromBLSPubKey[:], buf[8:56])
-
-	// Field (2) 'ToExecutionAddress'
-	copy(b.ToExecutionAddress[:], buf[56:76])
+	// Field (2) 'C'
+	hh.PutUint8(ssz.UnmarshallUint8(buf[6:7]))
 
-	return err
+	hh.Merkleize(indx)
+	return
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BLSToExecutionChange object
-func (b *BLSToExecutionChange) SizeSSZ() (size int) {
-	size = 76
-	return
+// GetTree ssz hashes the VarTestStruct object
+func (v *VarTestStruct) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(v)
 }
 
-// HashTreeRoot ssz hashes the BLSToExecutionChange object
-func (b *BLSToExecutionChange) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(b)
+const (
+	// VarTestStructAGIndex is the generalized index of the 'A' field
+	VarTestStructAGIndex ssz.GIndex = 4
+	// VarTestStructBGIndex is the generalized index of the 'B' field
+	VarTestStructBGIndex ssz.GIndex = 5
+	// VarTestStructCGIndex is the generalized index of the 'C' field
+	VarTestStructCGIndex ssz.GIndex = 6
+)
+
+// ProveA returns a merkle proof of the 'A' field of the VarTestStruct object
+func (v *VarTestStruct) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(v, VarTestStructAGIndex)
 }
 
-// HashTreeRootWith ssz hashes the BLSToExecutionChange object with a hasher
-func (b *BLSToExecutionChange) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
+// VerifyVarTestStructA verifies a merkle proof of the 'A' field of a VarTestStruct object
+func VerifyVarTestStructA(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, VarTestStructAGIndex)
+}
 
-	// Field (0) 'ValidatorIndex'
-	hh.PutUint64(b.ValidatorIndex)
+// ProveB returns a merkle proof of the 'B' field of the VarTestStruct object
+func (v *VarTestStruct) ProveB() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(v, VarTestStructBGIndex)
+}
 
-	// Field (1) 'FromBLSPubKey'
-	hh.PutBytes(b.FromBLSPubKey[:])
+// VerifyVarTestStructB verifies a merkle proof of the 'B' field of a VarTestStruct object
+func VerifyVarTestStructB(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, VarTestStructBGIndex)
+}
 
-	// Field (2) 'ToExecutionAddress'
-	hh.PutBytes(b.ToExecutionAddress[:])
+// ProveC returns a merkle proof of the 'C' field of the VarTestStruct object
+func (v *VarTestStruct) ProveC() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(v, VarTestStructCGIndex)
+}
 
-	hh.Merkleize(indx)
-	return
+// VerifyVarTestStructC verifies a merkle proof of the 'C' field of a VarTestStruct object
+func VerifyVarTestStructC(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, VarTestStructCGIndex)
 }
 
-// GetTree ssz hashes the BLSToExecutionChange object
-func (b *BLSToExecutionChange) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(b)
+// SchemaSSZ returns the schema of the tree of the VarTestStruct object
+func (v *VarTestStruct) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: ssz.SchemaUint(2)},
+		&ssz.SchemaField{Name: "B", Schema: ssz.SchemaList(ssz.SchemaUint(2), 1024)},
+		&ssz.SchemaField{Name: "C", Schema: ssz.SchemaUint(1)},
+	)
 }
 
-// MarshalSSZ ssz marshals the HistoricalSummary object
-func (h *HistoricalSummary) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(h)
+// MarshalSSZ ssz marshals the BitsStruct object
+func (b *BitsStruct) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
 }
 
-// MarshalSSZTo ssz marshals the HistoricalSummary object to a target array
-func (h *HistoricalSummary) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the BitsStruct object to a target array
+func (b *BitsStruct) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
+	offset := int(11)
 
-	// Field (0) 'BlockSummaryRoot'
-	dst = append(dst, h.BlockSummaryRoot[:]...)
-
-	// Field (1) 'StateSummaryRoot'
-	dst = append(dst, h.StateSummaryRoot[:]...)
+	// Offset (0) 'A'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.A)
 
-	return
-}
+	// Field (1) 'B'
+	if size := len(b.B); size != 1 {
+		err = ssz.ErrBytesLengthFn("BitsStruct.B", size, 1)
+		return
+	}
+	dst = append(dst, b.B...)
 
-// UnmarshalSSZ ssz unmarshals the HistoricalSummary object
-func (h *HistoricalSummary) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 64 {
-		return ssz.ErrSize
+	// Field (2) 'C'
+	if size := len(b.C); size != 1 {
+		err = ssz.ErrBytesLengthFn("BitsStruct.C", size, 1)
+		return
 	}
+	dst = append(dst, b.C...)
 
-	// Field (0) 'BlockSummaryRoot'
-	copy(h.BlockSummaryRoot[:], buf[0:32])
+	// Offset (3) 'D'
+	dst = ssz.WriteOffset(dst, offset)
 
-	// Field (1) 'StateSummaryRoot'
-	copy(h.StateSummaryRoot[:], buf[32:64])
+	// Field (4) 'E'
+	if size := len(b.E); size != 1 {
+		err = ssz.ErrBytesLengthFn("BitsStruct.E", size, 1)
+		return
+	}
+	dst = append(dst, b.E...)
 
-	return err
-}
+	// Field (0) 'A'
+	if size := len(b.A); size > 5 {
+		err = ssz.ErrBytesLengthFn("BitsStruct.A", size, 5)
+		return
+	}
+	dst = append(dst, b.A...)
+
+	// Field (3) 'D'
+	if size := len(b.D); size > 6 {
+		err = ssz.ErrBytesLengthFn("BitsStruct.D", size, 6)
+		return
+	}
+	dst = append(dst, b.D...)
 
-// SizeSSZ returns the ssz encoded size in bytes for the HistoricalSummary object
-func (h *HistoricalSummary) SizeSSZ() (size int) {
-	size = 64
 	return
 }
 
-// HashTreeRoot ssz hashes the HistoricalSummary object
-func (h *HistoricalSummary) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(h)
+// MarshalSSZToWriter ssz marshals the BitsStruct object to a writer
+func (b *BitsStruct) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(b, writer)
 }
 
-// HashTreeRootWith ssz hashes the HistoricalSummary object with a hasher
-func (h *HistoricalSummary) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
+// MarshalSSZToStream ssz marshals the BitsStruct object to a target array that is flushed to a stream writer
+func (b *BitsStruct) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(11)
 
-	// Field (0) 'BlockSummaryRoot'
-	hh.PutBytes(h.BlockSummaryRoot[:])
+	// Offset (0) 'A'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.A)
 
-	// Field (1) 'StateSummaryRoot'
-	hh.PutBytes(h.StateSummaryRoot[:])
+	// Field (1) 'B'
+	if size := len(b.B); size != 1 {
+		err = ssz.ErrBytesLengthFn("BitsStruct.B", size, 1)
+		return
+	}
+	dst = append(dst, b.B...)
+
+	// Field (2) 'C'
+	if size := len(b.C); size != 1 {
+		err = ssz.ErrBytesLengthFn("BitsStruct.C", size, 1)
+		return
+	}
+	dst = append(dst, b.C...)
+
+	// Offset (3) 'D'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (4) 'E'
+	if size := len(b.E); size != 1 {
+		err = ssz.ErrBytesLengthFn("BitsStruct.E", size, 1)
+		return
+	}
+	dst = append(dst, b.E...)
+
+	// Field (0) 'A'
+	if size := len(b.A); size > 5 {
+		err = ssz.ErrBytesLengthFn("BitsStruct.A", size, 5)
+		return
+	}
+	dst = append(dst, b.A...)
+
+	// Field (3) 'D'
+	if size := len(b.D); size > 6 {
+		err = ssz.ErrBytesLengthFn("BitsStruct.D", size, 6)
+		return
+	}
+	dst = append(dst, b.D...)
 
-	hh.Merkleize(indx)
 	return
 }
 
-// GetTree ssz hashes the HistoricalSummary object
-func (h *HistoricalSummary) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(h)
+// UnmarshalSSZ ssz unmarshals the BitsStruct object
+func (b *BitsStruct) UnmarshalSSZ(buf []byte) error {
+	return b.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
 }
 
-// MarshalSSZ ssz marshals the SignedBLSToExecutionChange object
-func (s *SignedBLSToExecutionChange) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(s)
-}
+// UnmarshalSSZWithOptions ssz unmarshals the BitsStruct object with the decoding options
+func (b *BitsStruct) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 11 {
+		return ssz.ErrSize
+	}
 
-// MarshalSSZTo ssz marshals the SignedBLSToExecutionChange object to a target array
-func (s *SignedBLSToExecutionChange) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+	tail := buf
+	var o0, o3 uint64
 
-	// Field (0) 'Message'
-	if s.Message == nil {
-		s.Message = new(BLSToExecutionChange)
-	}
-	if dst, err = s.Message.MarshalSSZTo(dst); err != nil {
-		return
+	// Offset (0) 'A'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
 	}
 
-	// Field (1) 'Signature'
-	dst = append(dst, s.Signature[:]...)
+	if err = opts.ValidateFirstOffset(o0, 11); err != nil {
+		return err
+	}
 
-	return
-}
+	// Field (1) 'B'
+	if err = opts.ValidateBitvector(buf[4:5], 2); err != nil {
+		return err
+	}
+	if cap(b.B) == 0 {
+		b.B = make([]byte, 0, len(buf[4:5]))
+	}
+	b.B = append(b.B, buf[4:5]...)
 
-// UnmarshalSSZ ssz unmarshals the SignedBLSToExecutionChange object
-func (s *SignedBLSToExecutionChange) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 172 {
-		return ssz.ErrSize
+	// Field (2) 'C'
+	if err = opts.ValidateBitvector(buf[5:6], 1); err != nil {
+		return err
+	}
+	if cap(b.C) == 0 {
+		b.C = make([]byte, 0, len(buf[5:6]))
 	}
+	b.C = append(b.C, buf[5:6]...)
 
-	// Field (0) 'Message'
-	if s.Message == nil {
-		s.Message = new(BLSToExecutionChange)
+	// Offset (3) 'D'
+	if o3 = ssz.ReadOffset(buf[6:10]); o3 > size || o0 > o3 {
+		return ssz.ErrOffset
 	}
-	if err = s.Message.UnmarshalSSZ(buf[0:76]); err != nil {
+
+	// Field (4) 'E'
+	if err = opts.ValidateBitvector(buf[10:11], 8); err != nil {
 		return err
 	}
+	if cap(b.E) == 0 {
+		b.E = make([]byte, 0, len(buf[10:11]))
+	}
+	b.E = append(b.E, buf[10:11]...)
 
-	// Field (1) 'Signature'
-	copy(s.Signature[:], buf[76:172])
+	// Field (0) 'A'
+	{
+		buf = tail[o0:o3]
+		if err = ssz.ValidateBitlist(buf, 5); err != nil {
+			return err
+		}
+		if cap(b.A) == 0 {
+			b.A = make([]byte, 0, len(buf))
+		}
+		b.A = append(b.A, buf...)
+	}
 
+	// Field (3) 'D'
+	{
+		buf = tail[o3:]
+		if err = ssz.ValidateBitlist(buf, 6); err != nil {
+			return err
+		}
+		if cap(b.D) == 0 {
+			b.D = make([]byte, 0, len(buf))
+		}
+		b.D = append(b.D, buf...)
+	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the SignedBLSToExecutionChange object
-func (s *SignedBLSToExecutionChange) SizeSSZ() (size int) {
-	size = 172
+// SizeSSZ returns the ssz encoded size in bytes for the BitsStruct object
+func (b *BitsStruct) SizeSSZ() (size int) {
+	size = 11
+
+	// Field (0) 'A'
+	size += len(b.A)
+
+	// Field (3) 'D'
+	size += len(b.D)
+
 	return
 }
 
-// HashTreeRoot ssz hashes the SignedBLSToExecutionChange object
-func (s *SignedBLSToExecutionChange) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(s)
+// MinSSZSize returns the minimum ssz encoded size in bytes for the BitsStruct object
+func (b *BitsStruct) MinSSZSize() uint64 {
+	return 13
 }
 
-// HashTreeRootWith ssz hashes the SignedBLSToExecutionChange object with a hasher
-func (s *SignedBLSToExecutionChange) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the BitsStruct object
+func (b *BitsStruct) MaxSSZSize() uint64 {
+	return 13
+}
+
+// HashTreeRoot ssz hashes the BitsStruct object
+func (b *BitsStruct) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BitsStruct object with a hasher
+func (b *BitsStruct) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
 
-	// Field (0) 'Message'
-	if s.Message == nil {
-		s.Message = new(BLSToExecutionChange)
+	// Field (0) 'A'
+	if len(b.A) == 0 {
+		err = ssz.ErrEmptyBitlist
+		return
 	}
-	if err = s.Message.HashTreeRootWith(hh); err != nil {
+	hh.PutBitlist(b.A, 5)
+
+	// Field (1) 'B'
+	if size := len(b.B); size != 1 {
+		err = ssz.ErrBytesLengthFn("BitsStruct.B", size, 1)
 		return
 	}
+	hh.PutBytes(b.B)
 
-	// Field (1) 'Signature'
-	hh.PutBytes(s.Signature[:])
+	// Field (2) 'C'
+	if size := len(b.C); size != 1 {
+		err = ssz.ErrBytesLengthFn("BitsStruct.C", size, 1)
+		return
+	}
+	hh.PutBytes(b.C)
+
+	// Field (3) 'D'
+	if len(b.D) == 0 {
+		err = ssz.ErrEmptyBitlist
+		return
+	}
+	hh.PutBitlist(b.D, 6)
+
+	// Field (4) 'E'
+	if size := len(b.E); size != 1 {
+		err = ssz.ErrBytesLengthFn("BitsStruct.E", size, 1)
+		return
+	}
+	hh.PutBytes(b.E)
 
 	hh.Merkleize(indx)
 	return
 }
 
-// GetTree ssz hashes the SignedBLSToExecutionChange object
-func (s *SignedBLSToExecutionChange) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(s)
-}
-
-// MarshalSSZ ssz marshals the Withdrawal object
-func (w *Withdrawal) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(w)
+// HashTreeRootSSZ ssz hashes the encoded BitsStruct object without decoding it
+func (b *BitsStruct) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(b, buf)
 }
 
-// MarshalSSZTo ssz marshals the Withdrawal object to a target array
-func (w *Withdrawal) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
+// HashTreeRootSSZWith ssz hashes the encoded BitsStruct object with a hasher
+func (b *BitsStruct) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 11 {
+		return ssz.ErrSize
+	}
 
-	// Field (0) 'Index'
-	dst = ssz.MarshalUint64(dst, w.Index)
+	tail := buf
+	var o0, o3 uint64
 
-	// Field (1) 'ValidatorIndex'
-	dst = ssz.MarshalUint64(dst, w.ValidatorIndex)
+	// Offset (0) 'A'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
 
-	// Field (2) 'Address'
-	dst = append(dst, w.Address[:]...)
+	if err = ssz.ValidateFirstOffset(o0, 11); err != nil {
+		return err
+	}
 
-	// Field (3) 'Amount'
-	dst = ssz.MarshalUint64(dst, w.Amount)
+	// Offset (3) 'D'
+	if o3 = ssz.ReadOffset(buf[6:10]); o3 > size || o0 > o3 {
+		return ssz.ErrOffset
+	}
 
-	return
-}
+	indx := hh.Index()
 
-// UnmarshalSSZ ssz unmarshals the Withdrawal object
-func (w *Withdrawal) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size != 44 {
-		return ssz.ErrSize
+	// Field (0) 'A'
+	{
+		buf := tail[o0:o3]
+		if err = ssz.ValidateBitlist(buf, 5); err != nil {
+			return err
+		}
+		hh.PutBitlist(buf, 5)
 	}
 
-	// Field (0) 'Index'
-	w.Index = ssz.UnmarshallUint64(buf[0:8])
-
-	// Field (1) 'ValidatorIndex'
-	w.ValidatorIndex = ssz.UnmarshallUint64(buf[8:16])
+	// Field (1) 'B'
+	if err = ssz.ValidateBitvector(buf[4:5], 2); err != nil {
+		return err
+	}
+	hh.PutBytes(buf[4:5])
 
-	// Field (2) 'Address'
-	copy(w.Address[:], buf[16:36])
+	// Field (2) 'C'
+	if err = ssz.ValidateBitvector(buf[5:6], 1); err != nil {
+		return err
+	}
+	hh.PutBytes(buf[5:6])
 
-	// Field (3) 'Amount'
-	w.Amount = ssz.UnmarshallUint64(buf[36:44])
+	// Field (3) 'D'
+	{
+		buf := tail[o3:]
+		if err = ssz.ValidateBitlist(buf, 6); err != nil {
+			return err
+		}
+		hh.PutBitlist(buf, 6)
+	}
 
-	return err
-}
+	// Field (4) 'E'
+	if err = ssz.ValidateBitvector(buf[10:11], 8); err != nil {
+		return err
+	}
+	hh.PutBytes(buf[10:11])
 
-// SizeSSZ returns the ssz encoded size in bytes for the Withdrawal object
-func (w *Withdrawal) SizeSSZ() (size int) {
-	size = 44
+	hh.Merkleize(indx)
 	return
 }
 
-// HashTreeRoot ssz hashes the Withdrawal object
-func (w *Withdrawal) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(w)
+// GetTree ssz hashes the BitsStruct object
+func (b *BitsStruct) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
 }
 
-// HashTreeRootWith ssz hashes the Withdrawal object with a hasher
-func (w *Withdrawal) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
-
-	// Field (0) 'Index'
-	hh.PutUint64(w.Index)
-
-	// Field (1) 'ValidatorIndex'
-	hh.PutUint64(w.ValidatorIndex)
-
-	// Field (2) 'Address'
-	hh.PutBytes(w.Address[:])
+const (
+	// BitsStructAGIndex is the generalized index of the 'A' field
+	BitsStructAGIndex ssz.GIndex = 8
+	// BitsStructBGIndex is the generalized index of the 'B' field
+	BitsStructBGIndex ssz.GIndex = 9
+	// BitsStructCGIndex is the generalized index of the 'C' field
+	BitsStructCGIndex ssz.GIndex = 10
+	// BitsStructDGIndex is the generalized index of the 'D' field
+	BitsStructDGIndex ssz.GIndex = 11
+	// BitsStructEGIndex is the generalized index of the 'E' field
+	BitsStructEGIndex ssz.GIndex = 12
+)
 
-	// Field (3) 'Amount'
-	hh.PutUint64(w.Amount)
+// ProveA returns a merkle proof of the 'A' field of the BitsStruct object
+func (b *BitsStruct) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BitsStructAGIndex)
+}
 
-	hh.Merkleize(indx)
-	return
+// VerifyBitsStructA verifies a merkle proof of the 'A' field of a BitsStruct object
+func VerifyBitsStructA(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BitsStructAGIndex)
 }
 
-// GetTree ssz hashes the Withdrawal object
-func (w *Withdrawal) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(w)
+// ProveB returns a merkle proof of the 'B' field of the BitsStruct object
+func (b *BitsStruct) ProveB() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BitsStructBGIndex)
 }
 
-// MarshalSSZ ssz marshals the BeaconStateCapella object
-func (b *BeaconStateCapella) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(b)
+// VerifyBitsStructB verifies a merkle proof of the 'B' field of a BitsStruct object
+func VerifyBitsStructB(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BitsStructBGIndex)
 }
 
-// MarshalSSZTo ssz marshals the BeaconStateCapella object to a target array
-func (b *BeaconStateCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := int(2736653)
+// ProveC returns a merkle proof of the 'C' field of the BitsStruct object
+func (b *BitsStruct) ProveC() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BitsStructCGIndex)
+}
 
-	// Field (0) 'GenesisTime'
-	dst = ssz.MarshalUint64(dst, b.GenesisTime)
+// VerifyBitsStructC verifies a merkle proof of the 'C' field of a BitsStruct object
+func VerifyBitsStructC(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BitsStructCGIndex)
+}
 
-	// Field (1) 'GenesisValidatorsRoot'
-	dst = append(dst, b.GenesisValidatorsRoot[:]...)
+// ProveD returns a merkle proof of the 'D' field of the BitsStruct object
+func (b *BitsStruct) ProveD() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BitsStructDGIndex)
+}
 
-	// Field (2) 'Slot'
-	dst = ssz.MarshalUint64(dst, b.Slot)
+// VerifyBitsStructD verifies a merkle proof of the 'D' field of a BitsStruct object
+func VerifyBitsStructD(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BitsStructDGIndex)
+}
 
-	// Field (3) 'Fork'
-	if b.Fork == nil {
-		b.Fork = new(Fork)
-	}
-	if dst, err = b.Fork.MarshalSSZTo(dst); err != nil {
-		return
-	}
+// ProveE returns a merkle proof of the 'E' field of the BitsStruct object
+func (b *BitsStruct) ProveE() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BitsStructEGIndex)
+}
 
-	// Field (4) 'LatestBlockHeader'
-	if b.LatestBlockHeader == nil {
-		b.LatestBlockHeader = new(BeaconBlockHeader)
-	}
-	if dst, err = b.LatestBlockHeader.MarshalSSZTo(dst); err != nil {
-		return
-	}
+// VerifyBitsStructE verifies a merkle proof of the 'E' field of a BitsStruct object
+func VerifyBitsStructE(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BitsStructEGIndex)
+}
 
-	// Field (5) 'BlockRoots'
-	for ii := 0; ii < 8192; ii++ {
-		dst = append(dst, b.BlockRoots[ii][:]...)
-	}
+// SchemaSSZ returns the schema of the tree of the BitsStruct object
+func (b *BitsStruct) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: ssz.SchemaBitlist(5)},
+		&ssz.SchemaField{Name: "B", Schema: ssz.SchemaBitvector(2)},
+		&ssz.SchemaField{Name: "C", Schema: ssz.SchemaBitvector(1)},
+		&ssz.SchemaField{Name: "D", Schema: ssz.SchemaBitlist(6)},
+		&ssz.SchemaField{Name: "E", Schema: ssz.SchemaBitvector(8)},
+	)
+}
 
-	// Field (6) 'StateRoots'
-	for ii := 0; ii < 8192; ii++ {
-		dst = append(dst, b.StateRoots[ii][:]...)
-	}
+// MarshalSSZ ssz marshals the ComplexTestStruct object
+func (c *ComplexTestStruct) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
 
-	// Offset (7) 'HistoricalRoots'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.HistoricalRoots) * 32
+// MarshalSSZTo ssz marshals the ComplexTestStruct object to a target array
+func (c *ComplexTestStruct) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(71)
 
-	// Field (8) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
-		return
-	}
+	// Field (0) 'A'
+	dst = ssz.MarshalUint16(dst, c.A)
 
-	// Offset (9) 'Eth1DataVotes'
+	// Offset (1) 'B'
 	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Eth1DataVotes) * 72
+	offset += len(c.B) * 2
 
-	// Field (10) 'Eth1DepositIndex'
-	dst = ssz.MarshalUint64(dst, b.Eth1DepositIndex)
+	// Field (2) 'C'
+	dst = ssz.MarshalUint8(dst, c.C)
 
-	// Offset (11) 'Validators'
+	// Offset (3) 'D'
 	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Validators) * 121
+	offset += len(c.D)
 
-	// Offset (12) 'Balances'
+	// Offset (4) 'E'
 	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Balances) * 8
-
-	// Field (13) 'RandaoMixes'
-	for ii := 0; ii < 65536; ii++ {
-		dst = append(dst, b.RandaoMixes[ii][:]...)
+	if c.E == nil {
+		c.E = new(VarTestStruct)
 	}
+	offset += c.E.SizeSSZ()
 
-	// Field (14) 'Slashings'
-	if size := len(b.Slashings); size != 8192 {
-		err = ssz.ErrVectorLengthFn("BeaconStateCapella.Slashings", size, 8192)
+	// Field (5) 'F'
+	if size := len(c.F); size != 4 {
+		err = ssz.ErrVectorLengthFn("ComplexTestStruct.F", size, 4)
 		return
 	}
-	for ii := 0; ii < 8192; ii++ {
-		dst = ssz.MarshalUint64(dst, b.Slashings[ii])
-	}
+	for ii := 0; ii < 4; ii++ {
+		if dst, err = c.F[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
 
-	// Offset (15) 'PreviousEpochParticipation'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.PreviousEpochParticipation)
+	}
 
-	// Offset (16) 'CurrentEpochParticipation'
+	// Offset (6) 'G'
 	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.CurrentEpochParticipation)
 
-	// Field (17) 'JustificationBits'
-	dst = append(dst, b.JustificationBits[:]...)
-
-	// Field (18) 'PreviousJustifiedCheckpoint'
-	if b.PreviousJustifiedCheckpoint == nil {
-		b.PreviousJustifiedCheckpoint = new(Checkpoint)
-	}
-	if dst, err = b.PreviousJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
+	// Field (1) 'B'
+	if size := len(c.B); size > 128 {
+		err = ssz.ErrListTooBigFn("ComplexTestStruct.B", size, 128)
 		return
 	}
+	for ii := 0; ii < len(c.B); ii++ {
+		dst = ssz.MarshalUint16(dst, c.B[ii])
 
-	// Field (19) 'CurrentJustifiedCheckpoint'
-	if b.CurrentJustifiedCheckpoint == nil {
-		b.CurrentJustifiedCheckpoint = new(Checkpoint)
 	}
-	if dst, err = b.CurrentJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
+
+	// Field (3) 'D'
+	if size := len(c.D); size > 256 {
+		err = ssz.ErrBytesLengthFn("ComplexTestStruct.D", size, 256)
 		return
 	}
+	dst = append(dst, c.D...)
 
-	// Field (20) 'FinalizedCheckpoint'
-	if b.FinalizedCheckpoint == nil {
-		b.FinalizedCheckpoint = new(Checkpoint)
-	}
-	if dst, err = b.FinalizedCheckpoint.MarshalSSZTo(dst); err != nil {
+	// Field (4) 'E'
+	if dst, err = c.E.MarshalSSZTo(dst); err != nil {
 		return
 	}
 
-	// Offset (21) 'InactivityScores'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.InactivityScores) * 8
-
-	// Field (22) 'CurrentSyncCommittee'
-	if b.CurrentSyncCommittee == nil {
-		b.CurrentSyncCommittee = new(SyncCommittee)
-	}
-	if dst, err = b.CurrentSyncCommittee.MarshalSSZTo(dst); err != nil {
+	// Field (6) 'G'
+	if size := len(c.G); size != 2 {
+		err = ssz.ErrVectorLengthFn("ComplexTestStruct.G", size, 2)
 		return
 	}
+	{
+		offset = 4 * len(c.G)
+		for ii := 0; ii < len(c.G); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += c.G[ii].SizeSSZ()
 
-	// Field (23) 'NextSyncCommittee'
-	if b.NextSyncCommittee == nil {
-		b.NextSyncCommittee = new(SyncCommittee)
+		}
 	}
-	if dst, err = b.NextSyncCommittee.MarshalSSZTo(dst); err != nil {
-		return
+	for ii := 0; ii < len(c.G); ii++ {
+		if dst, err = c.G[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+
 	}
 
-	// Offset (24) 'LatestExecutionPayloadHeader'
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the ComplexTestStruct object to a writer
+func (c *ComplexTestStruct) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(c, writer)
+}
+
+// MarshalSSZToStream ssz marshals the ComplexTestStruct object to a target array that is flushed to a stream writer
+func (c *ComplexTestStruct) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(71)
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint16(dst, c.A)
+
+	// Offset (1) 'B'
 	dst = ssz.WriteOffset(dst, offset)
-	if b.LatestExecutionPayloadHeader == nil {
-		b.LatestExecutionPayloadHeader = new(ExecutionPayloadHeaderCapella)
-	}
-	offset += b.LatestExecutionPayloadHeader.SizeSSZ()
+	offset += len(c.B) * 2
 
-	// Field (25) 'NextWithdrawalIndex'
-	dst = ssz.MarshalUint64(dst, b.NextWithdrawalIndex)
+	// Field (2) 'C'
+	dst = ssz.MarshalUint8(dst, c.C)
 
-	// Field (26) 'NextWithdrawalValidatorIndex'
-	dst = ssz.MarshalUint64(dst, b.NextWithdrawalValidatorIndex)
+	// Offset (3) 'D'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(c.D)
 
-	// Offset (27) 'HistoricalSummaries'
+	// Offset (4) 'E'
 	dst = ssz.WriteOffset(dst, offset)
+	if c.E == nil {
+		c.E = new(VarTestStruct)
+	}
+	offset += c.E.SizeSSZ()
 
-	// Field (7) 'HistoricalRoots'
-	if size := len(b.HistoricalRoots); size > 16777216 {
-		err = ssz.ErrListTooBigFn("BeaconStateCapella.HistoricalRoots", size, 16777216)
+	// Field (5) 'F'
+	if size := len(c.F); size != 4 {
+		err = ssz.ErrVectorLengthFn("ComplexTestStruct.F", size, 4)
 		return
 	}
-	for ii := 0; ii < len(b.HistoricalRoots); ii++ {
-		if size := len(b.HistoricalRoots[ii]); size != 32 {
-			err = ssz.ErrBytesLengthFn("BeaconStateCapella.HistoricalRoots[ii]", size, 32)
+	for ii := 0; ii < 4; ii++ {
+		if dst, err = c.F[ii].MarshalSSZToStream(dst, sw); err != nil {
 			return
 		}
-		dst = append(dst, b.HistoricalRoots[ii]...)
-	}
-
-	// Field (9) 'Eth1DataVotes'
-	if size := len(b.Eth1DataVotes); size > 2048 {
-		err = ssz.ErrListTooBigFn("BeaconStateCapella.Eth1DataVotes", size, 2048)
-		return
-	}
-	for ii := 0; ii < len(b.Eth1DataVotes); ii++ {
-		if dst, err = b.Eth1DataVotes[ii].MarshalSSZTo(dst); err != nil {
+		if dst, err = sw.Flush(dst); err != nil {
 			return
 		}
 	}
 
-	// Field (11) 'Validators'
-	if size := len(b.Validators); size > 1099511627776 {
-		err = ssz.ErrListTooBigFn("BeaconStateCapella.Validators", size, 1099511627776)
+	// Offset (6) 'G'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'B'
+	if size := len(c.B); size > 128 {
+		err = ssz.ErrListTooBigFn("ComplexTestStruct.B", size, 128)
 		return
 	}
-	for ii := 0; ii < len(b.Validators); ii++ {
-		if dst, err = b.Validators[ii].MarshalSSZTo(dst); err != nil {
+	for ii := 0; ii < len(c.B); ii++ {
+		dst = ssz.MarshalUint16(dst, c.B[ii])
+		if dst, err = sw.Flush(dst); err != nil {
 			return
 		}
 	}
 
-	// Field (12) 'Balances'
-	if size := len(b.Balances); size > 1099511627776 {
-		err = ssz.ErrListTooBigFn("BeaconStateCapella.Balances", size, 1099511627776)
-		return
-	}
-	for ii := 0; ii < len(b.Balances); ii++ {
-		dst = ssz.MarshalUint64(dst, b.Balances[ii])
-	}
-
-	// Field (15) 'PreviousEpochParticipation'
-	if size := len(b.PreviousEpochParticipation); size > 1099511627776 {
-		err = ssz.ErrBytesLengthFn("BeaconStateCapella.PreviousEpochParticipation", size, 1099511627776)
+	// Field (3) 'D'
+	if size := len(c.D); size > 256 {
+		err = ssz.ErrBytesLengthFn("ComplexTestStruct.D", size, 256)
 		return
 	}
-	dst = append(dst, b.PreviousEpochParticipation...)
-
-	// Field (16) 'CurrentEpochParticipation'
-	if size := len(b.CurrentEpochParticipation); size > 1099511627776 {
-		err = ssz.ErrBytesLengthFn("BeaconStateCapella.CurrentEpochParticipation", size, 1099511627776)
+	if dst, err = sw.Append(dst, c.D); err != nil {
 		return
 	}
-	dst = append(dst, b.CurrentEpochParticipation...)
 
-	// Field (21) 'InactivityScores'
-	if size := len(b.InactivityScores); size > 1099511627776 {
-		err = ssz.ErrListTooBigFn("BeaconStateCapella.InactivityScores", size, 1099511627776)
+	// Field (4) 'E'
+	if dst, err = c.E.MarshalSSZToStream(dst, sw); err != nil {
 		return
 	}
-	for ii := 0; ii < len(b.InactivityScores); ii++ {
-		dst = ssz.MarshalUint64(dst, b.InactivityScores[ii])
-	}
 
-	// Field (24) 'LatestExecutionPayloadHeader'
-	if dst, err = b.LatestExecutionPayloadHeader.MarshalSSZTo(dst); err != nil {
+	// Field (6) 'G'
+	if size := len(c.G); size != 2 {
+		err = ssz.ErrVectorLengthFn("ComplexTestStruct.G", size, 2)
 		return
 	}
-
-	// Field (27) 'HistoricalSummaries'
-	if size := len(b.HistoricalSummaries); size > 16777216 {
-		err = ssz.ErrListTooBigFn("BeaconStateCapella.HistoricalSummaries", size, 16777216)
-		return
+	{
+		offset = 4 * len(c.G)
+		for ii := 0; ii < len(c.G); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += c.G[ii].SizeSSZ()
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
 	}
-	for ii := 0; ii < len(b.HistoricalSummaries); ii++ {
-		if dst, err = b.HistoricalSummaries[ii].MarshalSSZTo(dst); err != nil {
+	for ii := 0; ii < len(c.G); ii++ {
+		if dst, err = c.G[ii].MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+		if dst, err = sw.Flush(dst); err != nil {
 			return
 		}
 	}
@@ -8435,1996 +24446,2129 @@ func (b *BeaconStateCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the BeaconStateCapella object
-func (b *BeaconStateCapella) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the ComplexTestStruct object
+func (c *ComplexTestStruct) UnmarshalSSZ(buf []byte) error {
+	return c.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the ComplexTestStruct object with the decoding options
+func (c *ComplexTestStruct) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 2736653 {
+	if size < 71 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o7, o9, o11, o12, o15, o16, o21, o24, o27 uint64
-
-	// Field (0) 'GenesisTime'
-	b.GenesisTime = ssz.UnmarshallUint64(buf[0:8])
-
-	// Field (1) 'GenesisValidatorsRoot'
-	copy(b.GenesisValidatorsRoot[:], buf[8:40])
-
-	// Field (2) 'Slot'
-	b.Slot = ssz.UnmarshallUint64(buf[40:48])
-
-	// Field (3) 'Fork'
-	if b.Fork == nil {
-		b.Fork = new(Fork)
-	}
-	if err = b.Fork.UnmarshalSSZ(buf[48:64]); err != nil {
-		return err
-	}
-
-	// Field (4) 'LatestBlockHeader'
-	if b.LatestBlockHeader == nil {
-		b.LatestBlockHeader = new(BeaconBlockHeader)
-	}
-	if err = b.LatestBlockHeader.UnmarshalSSZ(buf[64:176]); err != nil {
-		return err
-	}
-
-	// Field (5) 'BlockRoots'
+	var o1, o3, o4, o6 uint64
 
-	for ii := 0; ii < 8192; ii++ {
-		copy(b.BlockRoots[ii][:], buf[176:262320][ii*32:(ii+1)*32])
-	}
-
-	// Field (6) 'StateRoots'
-
-	for ii := 0; ii < 8192; ii++ {
-		copy(b.StateRoots[ii][:], buf[262320:524464][ii*32:(ii+1)*32])
-	}
+	// Field (0) 'A'
+	c.A = ssz.UnmarshallUint16(buf[0:2])
 
-	// Offset (7) 'HistoricalRoots'
-	if o7 = ssz.ReadOffset(buf[524464:524468]); o7 > size {
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[2:6]); o1 > size {
 		return ssz.ErrOffset
 	}
 
-	if o7 < 2736653 {
-		return ssz.ErrInvalidVariableOffset
-	}
-
-	// Field (8) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if err = b.Eth1Data.UnmarshalSSZ(buf[524468:524540]); err != nil {
+	if err = opts.ValidateFirstOffset(o1, 71); err != nil {
 		return err
 	}
 
-	// Offset (9) 'Eth1DataVotes'
-	if o9 = ssz.ReadOffset(buf[524540:524544]); o9 > size || o7 > o9 {
+	// Field (2) 'C'
+	c.C = ssz.UnmarshallUint8(buf[6:7])
+
+	// Offset (3) 'D'
+	if o3 = ssz.ReadOffset(buf[7:11]); o3 > size || o1 > o3 {
 		return ssz.ErrOffset
 	}
 
-	// Field (10) 'Eth1DepositIndex'
-	b.Eth1DepositIndex = ssz.UnmarshallUint64(buf[524544:524552])
-
-	// Offset (11) 'Validators'
-	if o11 = ssz.ReadOffset(buf[524552:524556]); o11 > size || o9 > o11 {
+	// Offset (4) 'E'
+	if o4 = ssz.ReadOffset(buf[11:15]); o4 > size || o3 > o4 {
 		return ssz.ErrOffset
 	}
 
-	// Offset (12) 'Balances'
-	if o12 = ssz.ReadOffset(buf[524556:524560]); o12 > size || o11 > o12 {
-		return ssz.ErrOffset
+	// Field (5) 'F'
+	c.F = make([]*FixedTestStruct, 4)
+	for ii := 0; ii < 4; ii++ {
+		if c.F[ii] == nil {
+			c.F[ii] = new(FixedTestStruct)
+		}
+		if err = c.F[ii].UnmarshalSSZWithOptions(buf[15:67][ii*13:(ii+1)*13], opts); err != nil {
+			return err
+		}
 	}
 
-	// Field (13) 'RandaoMixes'
+	// Offset (6) 'G'
+	if o6 = ssz.ReadOffset(buf[67:71]); o6 > size || o4 > o6 {
+		return ssz.ErrOffset
+	}
 
-	for ii := 0; ii < 65536; ii++ {
-		copy(b.RandaoMixes[ii][:], buf[524560:2621712][ii*32:(ii+1)*32])
+	// Field (1) 'B'
+	{
+		buf = tail[o1:o3]
+		num, err := ssz.DivideInt2(len(buf), 2, 128)
+		if err != nil {
+			return err
+		}
+		c.B = ssz.ExtendUint16(c.B, num)
+		for ii := 0; ii < num; ii++ {
+			c.B[ii] = ssz.UnmarshallUint16(buf[ii*2 : (ii+1)*2])
+		}
 	}
 
-	// Field (14) 'Slashings'
-	b.Slashings = ssz.ExtendUint64(b.Slashings, 8192)
-	for ii := 0; ii < 8192; ii++ {
-		b.Slashings[ii] = ssz.UnmarshallUint64(buf[2621712:2687248][ii*8 : (ii+1)*8])
+	// Field (3) 'D'
+	{
+		buf = tail[o3:o4]
+		if len(buf) > 256 {
+			return ssz.ErrBytesLength
+		}
+		if cap(c.D) == 0 {
+			c.D = make([]byte, 0, len(buf))
+		}
+		c.D = append(c.D, buf...)
 	}
 
-	// Offset (15) 'PreviousEpochParticipation'
-	if o15 = ssz.ReadOffset(buf[2687248:2687252]); o15 > size || o12 > o15 {
-		return ssz.ErrOffset
+	// Field (4) 'E'
+	{
+		buf = tail[o4:o6]
+		if c.E == nil {
+			c.E = new(VarTestStruct)
+		}
+		if err = c.E.UnmarshalSSZWithOptions(buf, opts); err != nil {
+			return err
+		}
 	}
 
-	// Offset (16) 'CurrentEpochParticipation'
-	if o16 = ssz.ReadOffset(buf[2687252:2687256]); o16 > size || o15 > o16 {
-		return ssz.ErrOffset
+	// Field (6) 'G'
+	{
+		buf = tail[o6:]
+		num, err := opts.DecodeDynamicLength(buf, 2)
+		if err != nil {
+			return err
+		}
+		if num != 2 {
+			return ssz.ErrVectorLength
+		}
+		c.G = make([]*VarTestStruct, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if c.G[indx] == nil {
+				c.G[indx] = new(VarTestStruct)
+			}
+			if err = c.G[indx].UnmarshalSSZWithOptions(buf, opts); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
 	}
+	return err
+}
 
-	// Field (17) 'JustificationBits'
-	copy(b.JustificationBits[:], buf[2687256:2687257])
+// SizeSSZ returns the ssz encoded size in bytes for the ComplexTestStruct object
+func (c *ComplexTestStruct) SizeSSZ() (size int) {
+	size = 71
 
-	// Field (18) 'PreviousJustifiedCheckpoint'
-	if b.PreviousJustifiedCheckpoint == nil {
-		b.PreviousJustifiedCheckpoint = new(Checkpoint)
-	}
-	if err = b.PreviousJustifiedCheckpoint.UnmarshalSSZ(buf[2687257:2687297]); err != nil {
-		return err
-	}
+	// Field (1) 'B'
+	size += len(c.B) * 2
 
-	// Field (19) 'CurrentJustifiedCheckpoint'
-	if b.CurrentJustifiedCheckpoint == nil {
-		b.CurrentJustifiedCheckpoint = new(Checkpoint)
-	}
-	if err = b.CurrentJustifiedCheckpoint.UnmarshalSSZ(buf[2687297:2687337]); err != nil {
-		return err
-	}
+	// Field (3) 'D'
+	size += len(c.D)
 
-	// Field (20) 'FinalizedCheckpoint'
-	if b.FinalizedCheckpoint == nil {
-		b.FinalizedCheckpoint = new(Checkpoint)
-	}
-	if err = b.FinalizedCheckpoint.UnmarshalSSZ(buf[2687337:2687377]); err != nil {
-		return err
+	// Field (4) 'E'
+	if c.E == nil {
+		c.E = new(VarTestStruct)
 	}
+	size += c.E.SizeSSZ()
 
-	// Offset (21) 'InactivityScores'
-	if o21 = ssz.ReadOffset(buf[2687377:2687381]); o21 > size || o16 > o21 {
-		return ssz.ErrOffset
+	// Field (6) 'G'
+	for ii := 0; ii < len(c.G); ii++ {
+		size += 4
+		size += c.G[ii].SizeSSZ()
 	}
 
-	// Field (22) 'CurrentSyncCommittee'
-	if b.CurrentSyncCommittee == nil {
-		b.CurrentSyncCommittee = new(SyncCommittee)
-	}
-	if err = b.CurrentSyncCommittee.UnmarshalSSZ(buf[2687381:2712005]); err != nil {
-		return err
-	}
+	return
+}
 
-	// Field (23) 'NextSyncCommittee'
-	if b.NextSyncCommittee == nil {
-		b.NextSyncCommittee = new(SyncCommittee)
-	}
-	if err = b.NextSyncCommittee.UnmarshalSSZ(buf[2712005:2736629]); err != nil {
-		return err
-	}
+// MinSSZSize returns the minimum ssz encoded size in bytes for the ComplexTestStruct object
+func (c *ComplexTestStruct) MinSSZSize() uint64 {
+	return 100
+}
 
-	// Offset (24) 'LatestExecutionPayloadHeader'
-	if o24 = ssz.ReadOffset(buf[2736629:2736633]); o24 > size || o21 > o24 {
-		return ssz.ErrOffset
-	}
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the ComplexTestStruct object
+func (c *ComplexTestStruct) MaxSSZSize() uint64 {
+	return 6756
+}
 
-	// Field (25) 'NextWithdrawalIndex'
-	b.NextWithdrawalIndex = ssz.UnmarshallUint64(buf[2736633:2736641])
+// HashTreeRoot ssz hashes the ComplexTestStruct object
+func (c *ComplexTestStruct) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
 
-	// Field (26) 'NextWithdrawalValidatorIndex'
-	b.NextWithdrawalValidatorIndex = ssz.UnmarshallUint64(buf[2736641:2736649])
+// HashTreeRootWith ssz hashes the ComplexTestStruct object with a hasher
+func (c *ComplexTestStruct) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
 
-	// Offset (27) 'HistoricalSummaries'
-	if o27 = ssz.ReadOffset(buf[2736649:2736653]); o27 > size || o24 > o27 {
-		return ssz.ErrOffset
-	}
+	// Field (0) 'A'
+	hh.PutUint16(c.A)
 
-	// Field (7) 'HistoricalRoots'
+	// Field (1) 'B'
 	{
-		buf = tail[o7:o9]
-		num, err := ssz.DivideInt2(len(buf), 32, 16777216)
-		if err != nil {
-			return err
+		if size := len(c.B); size > 128 {
+			err = ssz.ErrListTooBigFn("ComplexTestStruct.B", size, 128)
+			return
 		}
-		b.HistoricalRoots = make([][]byte, num)
-		for ii := 0; ii < num; ii++ {
-			if cap(b.HistoricalRoots[ii]) == 0 {
-				b.HistoricalRoots[ii] = make([]byte, 0, len(buf[ii*32:(ii+1)*32]))
-			}
-			b.HistoricalRoots[ii] = append(b.HistoricalRoots[ii], buf[ii*32:(ii+1)*32]...)
+		subIndx := hh.Index()
+		for _, i := range c.B {
+			hh.Append(ssz.MarshalUint16(nil, i))
 		}
+		hh.FillUpTo32()
+		numItems := uint64(len(c.B))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(128, numItems, 2))
 	}
 
-	// Field (9) 'Eth1DataVotes'
+	// Field (2) 'C'
+	hh.PutUint8(c.C)
+
+	// Field (3) 'D'
 	{
-		buf = tail[o9:o11]
-		num, err := ssz.DivideInt2(len(buf), 72, 2048)
-		if err != nil {
-			return err
-		}
-		b.Eth1DataVotes = make([]*Eth1Data, num)
-		for ii := 0; ii < num; ii++ {
-			if b.Eth1DataVotes[ii] == nil {
-				b.Eth1DataVotes[ii] = new(Eth1Data)
-			}
-			if err = b.Eth1DataVotes[ii].UnmarshalSSZ(buf[ii*72 : (ii+1)*72]); err != nil {
-				return err
-			}
+		elemIndx := hh.Index()
+		byteLen := uint64(len(c.D))
+		if byteLen > 256 {
+			err = ssz.ErrIncorrectListSize
+			return
 		}
+		hh.Append(c.D)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (256+31)/32)
+	}
+
+	// Field (4) 'E'
+	if err = c.E.HashTreeRootWith(hh); err != nil {
+		return
 	}
 
-	// Field (11) 'Validators'
+	// Field (5) 'F'
 	{
-		buf = tail[o11:o12]
-		num, err := ssz.DivideInt2(len(buf), 121, 1099511627776)
-		if err != nil {
-			return err
+		if size := len(c.F); size != 4 {
+			err = ssz.ErrVectorLengthFn("ComplexTestStruct.F", size, 4)
+			return
 		}
-		b.Validators = make([]*Validator, num)
-		for ii := 0; ii < num; ii++ {
-			if b.Validators[ii] == nil {
-				b.Validators[ii] = new(Validator)
-			}
-			if err = b.Validators[ii].UnmarshalSSZ(buf[ii*121 : (ii+1)*121]); err != nil {
-				return err
+		subIndx := hh.Index()
+		for _, elem := range c.F {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
 			}
 		}
+		hh.Merkleize(subIndx)
 	}
 
-	// Field (12) 'Balances'
+	// Field (6) 'G'
 	{
-		buf = tail[o12:o15]
-		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
-		if err != nil {
-			return err
+		if size := len(c.G); size != 2 {
+			err = ssz.ErrVectorLengthFn("ComplexTestStruct.G", size, 2)
+			return
 		}
-		b.Balances = ssz.ExtendUint64(b.Balances, num)
-		for ii := 0; ii < num; ii++ {
-			b.Balances[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		subIndx := hh.Index()
+		for _, elem := range c.G {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
 		}
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded ComplexTestStruct object without decoding it
+func (c *ComplexTestStruct) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(c, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded ComplexTestStruct object with a hasher
+func (c *ComplexTestStruct) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 71 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1, o3, o4, o6 uint64
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[2:6]); o1 > size {
+		return ssz.ErrOffset
 	}
 
-	// Field (15) 'PreviousEpochParticipation'
+	if err = ssz.ValidateFirstOffset(o1, 71); err != nil {
+		return err
+	}
+
+	// Offset (3) 'D'
+	if o3 = ssz.ReadOffset(buf[7:11]); o3 > size || o1 > o3 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (4) 'E'
+	if o4 = ssz.ReadOffset(buf[11:15]); o4 > size || o3 > o4 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (6) 'G'
+	if o6 = ssz.ReadOffset(buf[67:71]); o6 > size || o4 > o6 {
+		return ssz.ErrOffset
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint16(ssz.UnmarshallUint16(buf[0:2]))
+
+	// Field (1) 'B'
 	{
-		buf = tail[o15:o16]
-		if len(buf) > 1099511627776 {
-			return ssz.ErrBytesLength
-		}
-		if cap(b.PreviousEpochParticipation) == 0 {
-			b.PreviousEpochParticipation = make([]byte, 0, len(buf))
+		buf := tail[o1:o3]
+		{
+			subIndx := hh.Index()
+			num, err := ssz.DivideInt2(len(buf), 2, 128)
+			if err != nil {
+				return err
+			}
+			hh.Append(buf)
+			hh.FillUpTo32()
+			hh.MerkleizeWithMixin(subIndx, uint64(num), ssz.CalculateLimit(128, uint64(num), 2))
 		}
-		b.PreviousEpochParticipation = append(b.PreviousEpochParticipation, buf...)
 	}
 
-	// Field (16) 'CurrentEpochParticipation'
+	// Field (2) 'C'
+	hh.PutUint8(ssz.UnmarshallUint8(buf[6:7]))
+
+	// Field (3) 'D'
 	{
-		buf = tail[o16:o21]
-		if len(buf) > 1099511627776 {
+		buf := tail[o3:o4]
+		if len(buf) > 256 {
 			return ssz.ErrBytesLength
 		}
-		if cap(b.CurrentEpochParticipation) == 0 {
-			b.CurrentEpochParticipation = make([]byte, 0, len(buf))
+		{
+			elemIndx := hh.Index()
+			hh.Append(buf)
+			hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (256+31)/32)
 		}
-		b.CurrentEpochParticipation = append(b.CurrentEpochParticipation, buf...)
 	}
 
-	// Field (21) 'InactivityScores'
+	// Field (4) 'E'
 	{
-		buf = tail[o21:o24]
-		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
-		if err != nil {
+		buf := tail[o4:o6]
+		if err = (*VarTestStruct)(nil).HashTreeRootSSZWith(hh, buf); err != nil {
 			return err
 		}
-		b.InactivityScores = ssz.ExtendUint64(b.InactivityScores, num)
-		for ii := 0; ii < num; ii++ {
-			b.InactivityScores[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
-		}
 	}
 
-	// Field (24) 'LatestExecutionPayloadHeader'
+	// Field (5) 'F'
 	{
-		buf = tail[o24:o27]
-		if b.LatestExecutionPayloadHeader == nil {
-			b.LatestExecutionPayloadHeader = new(ExecutionPayloadHeaderCapella)
-		}
-		if err = b.LatestExecutionPayloadHeader.UnmarshalSSZ(buf); err != nil {
-			return err
+		subIndx := hh.Index()
+		for ii := 0; ii < 4; ii++ {
+			if err = (*FixedTestStruct)(nil).HashTreeRootSSZWith(hh, buf[15:67][ii*13:(ii+1)*13]); err != nil {
+				return err
+			}
 		}
+		hh.Merkleize(subIndx)
 	}
 
-	// Field (27) 'HistoricalSummaries'
+	// Field (6) 'G'
 	{
-		buf = tail[o27:]
-		num, err := ssz.DivideInt2(len(buf), 64, 16777216)
-		if err != nil {
-			return err
-		}
-		b.HistoricalSummaries = make([]*HistoricalSummary, num)
-		for ii := 0; ii < num; ii++ {
-			if b.HistoricalSummaries[ii] == nil {
-				b.HistoricalSummaries[ii] = new(HistoricalSummary)
+		buf := tail[o6:]
+		{
+			subIndx := hh.Index()
+			num, err := ssz.DecodeDynamicLength(buf, 2)
+			if err != nil {
+				return err
 			}
-			if err = b.HistoricalSummaries[ii].UnmarshalSSZ(buf[ii*64 : (ii+1)*64]); err != nil {
+			if num != 2 {
+				return ssz.ErrVectorLength
+			}
+			err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+				if err = (*VarTestStruct)(nil).HashTreeRootSSZWith(hh, buf); err != nil {
+					return err
+				}
+				return nil
+			})
+			if err != nil {
 				return err
 			}
+			hh.Merkleize(subIndx)
 		}
 	}
-	return err
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ComplexTestStruct object
+func (c *ComplexTestStruct) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(c)
+}
+
+const (
+	// ComplexTestStructAGIndex is the generalized index of the 'A' field
+	ComplexTestStructAGIndex ssz.GIndex = 8
+	// ComplexTestStructBGIndex is the generalized index of the 'B' field
+	ComplexTestStructBGIndex ssz.GIndex = 9
+	// ComplexTestStructCGIndex is the generalized index of the 'C' field
+	ComplexTestStructCGIndex ssz.GIndex = 10
+	// ComplexTestStructDGIndex is the generalized index of the 'D' field
+	ComplexTestStructDGIndex ssz.GIndex = 11
+	// ComplexTestStructEGIndex is the generalized index of the 'E' field
+	ComplexTestStructEGIndex ssz.GIndex = 12
+	// ComplexTestStructFGIndex is the generalized index of the 'F' field
+	ComplexTestStructFGIndex ssz.GIndex = 13
+	// ComplexTestStructGGIndex is the generalized index of the 'G' field
+	ComplexTestStructGGIndex ssz.GIndex = 14
+)
+
+// ProveA returns a merkle proof of the 'A' field of the ComplexTestStruct object
+func (c *ComplexTestStruct) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, ComplexTestStructAGIndex)
+}
+
+// VerifyComplexTestStructA verifies a merkle proof of the 'A' field of a ComplexTestStruct object
+func VerifyComplexTestStructA(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ComplexTestStructAGIndex)
+}
+
+// ProveB returns a merkle proof of the 'B' field of the ComplexTestStruct object
+func (c *ComplexTestStruct) ProveB() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, ComplexTestStructBGIndex)
+}
+
+// VerifyComplexTestStructB verifies a merkle proof of the 'B' field of a ComplexTestStruct object
+func VerifyComplexTestStructB(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ComplexTestStructBGIndex)
+}
+
+// ProveC returns a merkle proof of the 'C' field of the ComplexTestStruct object
+func (c *ComplexTestStruct) ProveC() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, ComplexTestStructCGIndex)
+}
+
+// VerifyComplexTestStructC verifies a merkle proof of the 'C' field of a ComplexTestStruct object
+func VerifyComplexTestStructC(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ComplexTestStructCGIndex)
+}
+
+// ProveD returns a merkle proof of the 'D' field of the ComplexTestStruct object
+func (c *ComplexTestStruct) ProveD() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, ComplexTestStructDGIndex)
+}
+
+// VerifyComplexTestStructD verifies a merkle proof of the 'D' field of a ComplexTestStruct object
+func VerifyComplexTestStructD(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ComplexTestStructDGIndex)
+}
+
+// ProveE returns a merkle proof of the 'E' field of the ComplexTestStruct object
+func (c *ComplexTestStruct) ProveE() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, ComplexTestStructEGIndex)
+}
+
+// VerifyComplexTestStructE verifies a merkle proof of the 'E' field of a ComplexTestStruct object
+func VerifyComplexTestStructE(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ComplexTestStructEGIndex)
+}
+
+// ProveF returns a merkle proof of the 'F' field of the ComplexTestStruct object
+func (c *ComplexTestStruct) ProveF() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, ComplexTestStructFGIndex)
+}
+
+// VerifyComplexTestStructF verifies a merkle proof of the 'F' field of a ComplexTestStruct object
+func VerifyComplexTestStructF(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ComplexTestStructFGIndex)
+}
+
+// ProveG returns a merkle proof of the 'G' field of the ComplexTestStruct object
+func (c *ComplexTestStruct) ProveG() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, ComplexTestStructGGIndex)
+}
+
+// VerifyComplexTestStructG verifies a merkle proof of the 'G' field of a ComplexTestStruct object
+func VerifyComplexTestStructG(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ComplexTestStructGGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the ComplexTestStruct object
+func (c *ComplexTestStruct) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: ssz.SchemaUint(2)},
+		&ssz.SchemaField{Name: "B", Schema: ssz.SchemaList(ssz.SchemaUint(2), 128)},
+		&ssz.SchemaField{Name: "C", Schema: ssz.SchemaUint(1)},
+		&ssz.SchemaField{Name: "D", Schema: ssz.SchemaList(ssz.SchemaUint(1), 256)},
+		&ssz.SchemaField{Name: "E", Schema: (*VarTestStruct)(nil).SchemaSSZ()},
+		&ssz.SchemaField{Name: "F", Schema: ssz.SchemaVector((*FixedTestStruct)(nil).SchemaSSZ(), 4)},
+		&ssz.SchemaField{Name: "G", Schema: ssz.SchemaVector((*VarTestStruct)(nil).SchemaSSZ(), 2)},
+	)
+}
+
+// MarshalSSZ ssz marshals the BoolTestStruct object
+func (b *BoolTestStruct) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BoolTestStruct object to a target array
+func (b *BoolTestStruct) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'A'
+	dst = ssz.MarshalBool(dst, b.A)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the BoolTestStruct object to a writer
+func (b *BoolTestStruct) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(b, writer)
+}
+
+// MarshalSSZToStream ssz marshals the BoolTestStruct object to a target array that is flushed to a stream writer
+func (b *BoolTestStruct) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'A'
+	dst = ssz.MarshalBool(dst, b.A)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BoolTestStruct object
+func (b *BoolTestStruct) UnmarshalSSZ(buf []byte) error {
+	return b.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the BoolTestStruct object with the decoding options
+func (b *BoolTestStruct) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 1 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'A'
+	if err = opts.ValidateBool(buf[0:1]); err != nil {
+		return err
+	}
+	b.A = ssz.UnmarshalBool(buf[0:1])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BoolTestStruct object
+func (b *BoolTestStruct) SizeSSZ() (size int) {
+	size = 1
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the BoolTestStruct object
+func (b *BoolTestStruct) MinSSZSize() uint64 {
+	return 1
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the BoolTestStruct object
+func (b *BoolTestStruct) MaxSSZSize() uint64 {
+	return 1
+}
+
+// HashTreeRoot ssz hashes the BoolTestStruct object
+func (b *BoolTestStruct) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BoolTestStruct object with a hasher
+func (b *BoolTestStruct) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutBool(b.A)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded BoolTestStruct object without decoding it
+func (b *BoolTestStruct) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(b, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded BoolTestStruct object with a hasher
+func (b *BoolTestStruct) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 1 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	if err = ssz.ValidateBool(buf[0:1]); err != nil {
+		return err
+	}
+	hh.PutBool(ssz.UnmarshalBool(buf[0:1]))
+
+	hh.Merkleize(indx)
+	return
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconStateCapella object
-func (b *BeaconStateCapella) SizeSSZ() (size int) {
-	size = 2736653
+// GetTree ssz hashes the BoolTestStruct object
+func (b *BoolTestStruct) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
 
-	// Field (7) 'HistoricalRoots'
-	size += len(b.HistoricalRoots) * 32
+const (
+	// BoolTestStructAGIndex is the generalized index of the 'A' field
+	BoolTestStructAGIndex ssz.GIndex = 1
+)
 
-	// Field (9) 'Eth1DataVotes'
-	size += len(b.Eth1DataVotes) * 72
+// ProveA returns a merkle proof of the 'A' field of the BoolTestStruct object
+func (b *BoolTestStruct) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BoolTestStructAGIndex)
+}
 
-	// Field (11) 'Validators'
-	size += len(b.Validators) * 121
+// VerifyBoolTestStructA verifies a merkle proof of the 'A' field of a BoolTestStruct object
+func VerifyBoolTestStructA(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BoolTestStructAGIndex)
+}
 
-	// Field (12) 'Balances'
-	size += len(b.Balances) * 8
+// SchemaSSZ returns the schema of the tree of the BoolTestStruct object
+func (b *BoolTestStruct) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: ssz.SchemaBool()},
+	)
+}
 
-	// Field (15) 'PreviousEpochParticipation'
-	size += len(b.PreviousEpochParticipation)
+// MarshalSSZ ssz marshals the Bitlist1TestStruct object
+func (b *Bitlist1TestStruct) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
 
-	// Field (16) 'CurrentEpochParticipation'
-	size += len(b.CurrentEpochParticipation)
+// MarshalSSZTo ssz marshals the Bitlist1TestStruct object to a target array
+func (b *Bitlist1TestStruct) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
 
-	// Field (21) 'InactivityScores'
-	size += len(b.InactivityScores) * 8
+	// Offset (0) 'A'
+	dst = ssz.WriteOffset(dst, offset)
 
-	// Field (24) 'LatestExecutionPayloadHeader'
-	if b.LatestExecutionPayloadHeader == nil {
-		b.LatestExecutionPayloadHeader = new(ExecutionPayloadHeaderCapella)
+	// Field (0) 'A'
+	if size := len(b.A); size > 1 {
+		err = ssz.ErrBytesLengthFn("Bitlist1TestStruct.A", size, 1)
+		return
 	}
-	size += b.LatestExecutionPayloadHeader.SizeSSZ()
-
-	// Field (27) 'HistoricalSummaries'
-	size += len(b.HistoricalSummaries) * 64
+	dst = append(dst, b.A...)
 
 	return
 }
 
-// HashTreeRoot ssz hashes the BeaconStateCapella object
-func (b *BeaconStateCapella) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(b)
+// MarshalSSZToWriter ssz marshals the Bitlist1TestStruct object to a writer
+func (b *Bitlist1TestStruct) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(b, writer)
 }
 
-// HashTreeRootWith ssz hashes the BeaconStateCapella object with a hasher
-func (b *BeaconStateCapella) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
-
-	// Field (0) 'GenesisTime'
-	hh.PutUint64(b.GenesisTime)
-
-	// Field (1) 'GenesisValidatorsRoot'
-	hh.PutBytes(b.GenesisValidatorsRoot[:])
+// MarshalSSZToStream ssz marshals the Bitlist1TestStruct object to a target array that is flushed to a stream writer
+func (b *Bitlist1TestStruct) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
 
-	// Field (2) 'Slot'
-	hh.PutUint64(b.Slot)
+	// Offset (0) 'A'
+	dst = ssz.WriteOffset(dst, offset)
 
-	// Field (3) 'Fork'
-	if b.Fork == nil {
-		b.Fork = new(Fork)
-	}
-	if err = b.Fork.HashTreeRootWith(hh); err != nil {
+	// Field (0) 'A'
+	if size := len(b.A); size > 1 {
+		err = ssz.ErrBytesLengthFn("Bitlist1TestStruct.A", size, 1)
 		return
 	}
+	dst = append(dst, b.A...)
 
-	// Field (4) 'LatestBlockHeader'
-	if b.LatestBlockHeader == nil {
-		b.LatestBlockHeader = new(BeaconBlockHeader)
-	}
-	if err = b.LatestBlockHeader.HashTreeRootWith(hh); err != nil {
-		return
-	}
+	return
+}
 
-	// Field (5) 'BlockRoots'
-	{
-		subIndx := hh.Index()
-		for _, i := range b.BlockRoots {
-			hh.Append(i[:])
-		}
-		hh.Merkleize(subIndx)
-	}
+// UnmarshalSSZ ssz unmarshals the Bitlist1TestStruct object
+func (b *Bitlist1TestStruct) UnmarshalSSZ(buf []byte) error {
+	return b.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
 
-	// Field (6) 'StateRoots'
-	{
-		subIndx := hh.Index()
-		for _, i := range b.StateRoots {
-			hh.Append(i[:])
-		}
-		hh.Merkleize(subIndx)
+// UnmarshalSSZWithOptions ssz unmarshals the Bitlist1TestStruct object with the decoding options
+func (b *Bitlist1TestStruct) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
 	}
 
-	// Field (7) 'HistoricalRoots'
-	{
-		if size := len(b.HistoricalRoots); size > 16777216 {
-			err = ssz.ErrListTooBigFn("BeaconStateCapella.HistoricalRoots", size, 16777216)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.HistoricalRoots {
-			if len(i) != 32 {
-				err = ssz.ErrBytesLength
-				return
-			}
-			hh.Append(i)
-		}
-		numItems := uint64(len(b.HistoricalRoots))
-		hh.MerkleizeWithMixin(subIndx, numItems, 16777216)
-	}
+	tail := buf
+	var o0 uint64
 
-	// Field (8) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
-		return
+	// Offset (0) 'A'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
 	}
 
-	// Field (9) 'Eth1DataVotes'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.Eth1DataVotes))
-		if num > 2048 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.Eth1DataVotes {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, 2048)
+	if err = opts.ValidateFirstOffset(o0, 4); err != nil {
+		return err
 	}
 
-	// Field (10) 'Eth1DepositIndex'
-	hh.PutUint64(b.Eth1DepositIndex)
-
-	// Field (11) 'Validators'
+	// Field (0) 'A'
 	{
-		subIndx := hh.Index()
-		num := uint64(len(b.Validators))
-		if num > 1099511627776 {
-			err = ssz.ErrIncorrectListSize
-			return
+		buf = tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 1); err != nil {
+			return err
 		}
-		for _, elem := range b.Validators {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+		if cap(b.A) == 0 {
+			b.A = make([]byte, 0, len(buf))
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
+		b.A = append(b.A, buf...)
 	}
+	return err
+}
 
-	// Field (12) 'Balances'
-	{
-		if size := len(b.Balances); size > 1099511627776 {
-			err = ssz.ErrListTooBigFn("BeaconStateCapella.Balances", size, 1099511627776)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.Balances {
-			hh.AppendUint64(i)
-		}
-		hh.FillUpTo32()
-		numItems := uint64(len(b.Balances))
-		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
-	}
+// SizeSSZ returns the ssz encoded size in bytes for the Bitlist1TestStruct object
+func (b *Bitlist1TestStruct) SizeSSZ() (size int) {
+	size = 4
 
-	// Field (13) 'RandaoMixes'
-	{
-		subIndx := hh.Index()
-		for _, i := range b.RandaoMixes {
-			hh.Append(i[:])
-		}
-		hh.Merkleize(subIndx)
-	}
+	// Field (0) 'A'
+	size += len(b.A)
 
-	// Field (14) 'Slashings'
-	{
-		if size := len(b.Slashings); size != 8192 {
-			err = ssz.ErrVectorLengthFn("BeaconStateCapella.Slashings", size, 8192)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.Slashings {
-			hh.AppendUint64(i)
-		}
-		hh.Merkleize(subIndx)
-	}
+	return
+}
 
-	// Field (15) 'PreviousEpochParticipation'
-	{
-		elemIndx := hh.Index()
-		byteLen := uint64(len(b.PreviousEpochParticipation))
-		if byteLen > 1099511627776 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		hh.Append(b.PreviousEpochParticipation)
-		hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
-	}
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Bitlist1TestStruct object
+func (b *Bitlist1TestStruct) MinSSZSize() uint64 {
+	return 5
+}
 
-	// Field (16) 'CurrentEpochParticipation'
-	{
-		elemIndx := hh.Index()
-		byteLen := uint64(len(b.CurrentEpochParticipation))
-		if byteLen > 1099511627776 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		hh.Append(b.CurrentEpochParticipation)
-		hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
-	}
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Bitlist1TestStruct object
+func (b *Bitlist1TestStruct) MaxSSZSize() uint64 {
+	return 5
+}
 
-	// Field (17) 'JustificationBits'
-	hh.PutBytes(b.JustificationBits[:])
+// HashTreeRoot ssz hashes the Bitlist1TestStruct object
+func (b *Bitlist1TestStruct) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
 
-	// Field (18) 'PreviousJustifiedCheckpoint'
-	if b.PreviousJustifiedCheckpoint == nil {
-		b.PreviousJustifiedCheckpoint = new(Checkpoint)
-	}
-	if err = b.PreviousJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
-		return
-	}
+// HashTreeRootWith ssz hashes the Bitlist1TestStruct object with a hasher
+func (b *Bitlist1TestStruct) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
 
-	// Field (19) 'CurrentJustifiedCheckpoint'
-	if b.CurrentJustifiedCheckpoint == nil {
-		b.CurrentJustifiedCheckpoint = new(Checkpoint)
-	}
-	if err = b.CurrentJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+	// Field (0) 'A'
+	if len(b.A) == 0 {
+		err = ssz.ErrEmptyBitlist
 		return
 	}
+	hh.PutBitlist(b.A, 1)
 
-	// Field (20) 'FinalizedCheckpoint'
-	if b.FinalizedCheckpoint == nil {
-		b.FinalizedCheckpoint = new(Checkpoint)
-	}
-	if err = b.FinalizedCheckpoint.HashTreeRootWith(hh); err != nil {
-		return
-	}
+	hh.Merkleize(indx)
+	return
+}
 
-	// Field (21) 'InactivityScores'
-	{
-		if size := len(b.InactivityScores); size > 1099511627776 {
-			err = ssz.ErrListTooBigFn("BeaconStateCapella.InactivityScores", size, 1099511627776)
-			return
-		}
-		subIndx := hh.Index()
-		for _, i := range b.InactivityScores {
-			hh.AppendUint64(i)
-		}
-		hh.FillUpTo32()
-		numItems := uint64(len(b.InactivityScores))
-		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
-	}
+// HashTreeRootSSZ ssz hashes the encoded Bitlist1TestStruct object without decoding it
+func (b *Bitlist1TestStruct) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(b, buf)
+}
 
-	// Field (22) 'CurrentSyncCommittee'
-	if b.CurrentSyncCommittee == nil {
-		b.CurrentSyncCommittee = new(SyncCommittee)
-	}
-	if err = b.CurrentSyncCommittee.HashTreeRootWith(hh); err != nil {
-		return
+// HashTreeRootSSZWith ssz hashes the encoded Bitlist1TestStruct object with a hasher
+func (b *Bitlist1TestStruct) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
 	}
 
-	// Field (23) 'NextSyncCommittee'
-	if b.NextSyncCommittee == nil {
-		b.NextSyncCommittee = new(SyncCommittee)
-	}
-	if err = b.NextSyncCommittee.HashTreeRootWith(hh); err != nil {
-		return
-	}
+	tail := buf
+	var o0 uint64
 
-	// Field (24) 'LatestExecutionPayloadHeader'
-	if err = b.LatestExecutionPayloadHeader.HashTreeRootWith(hh); err != nil {
-		return
+	// Offset (0) 'A'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
 	}
 
-	// Field (25) 'NextWithdrawalIndex'
-	hh.PutUint64(b.NextWithdrawalIndex)
+	if err = ssz.ValidateFirstOffset(o0, 4); err != nil {
+		return err
+	}
 
-	// Field (26) 'NextWithdrawalValidatorIndex'
-	hh.PutUint64(b.NextWithdrawalValidatorIndex)
+	indx := hh.Index()
 
-	// Field (27) 'HistoricalSummaries'
+	// Field (0) 'A'
 	{
-		subIndx := hh.Index()
-		num := uint64(len(b.HistoricalSummaries))
-		if num > 16777216 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.HistoricalSummaries {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+		buf := tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 1); err != nil {
+			return err
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 16777216)
+		hh.PutBitlist(buf, 1)
 	}
 
 	hh.Merkleize(indx)
 	return
 }
 
-// GetTree ssz hashes the BeaconStateCapella object
-func (b *BeaconStateCapella) GetTree() (*ssz.Node, error) {
+// GetTree ssz hashes the Bitlist1TestStruct object
+func (b *Bitlist1TestStruct) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(b)
 }
 
-// MarshalSSZ ssz marshals the SignedBeaconBlockCapella object
-func (s *SignedBeaconBlockCapella) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(s)
+const (
+	// Bitlist1TestStructAGIndex is the generalized index of the 'A' field
+	Bitlist1TestStructAGIndex ssz.GIndex = 1
+)
+
+// ProveA returns a merkle proof of the 'A' field of the Bitlist1TestStruct object
+func (b *Bitlist1TestStruct) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, Bitlist1TestStructAGIndex)
+}
+
+// VerifyBitlist1TestStructA verifies a merkle proof of the 'A' field of a Bitlist1TestStruct object
+func VerifyBitlist1TestStructA(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Bitlist1TestStructAGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the Bitlist1TestStruct object
+func (b *Bitlist1TestStruct) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: ssz.SchemaBitlist(1)},
+	)
+}
+
+// MarshalSSZ ssz marshals the Bitlist2TestStruct object
+func (b *Bitlist2TestStruct) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
 }
 
-// MarshalSSZTo ssz marshals the SignedBeaconBlockCapella object to a target array
-func (s *SignedBeaconBlockCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the Bitlist2TestStruct object to a target array
+func (b *Bitlist2TestStruct) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(100)
+	offset := int(4)
 
-	// Offset (0) 'Block'
+	// Offset (0) 'A'
 	dst = ssz.WriteOffset(dst, offset)
 
-	// Field (1) 'Signature'
-	if size := len(s.Signature); size != 96 {
-		err = ssz.ErrBytesLengthFn("SignedBeaconBlockCapella.Signature", size, 96)
+	// Field (0) 'A'
+	if size := len(b.A); size > 2 {
+		err = ssz.ErrBytesLengthFn("Bitlist2TestStruct.A", size, 2)
 		return
 	}
-	dst = append(dst, s.Signature...)
+	dst = append(dst, b.A...)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the Bitlist2TestStruct object to a writer
+func (b *Bitlist2TestStruct) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(b, writer)
+}
+
+// MarshalSSZToStream ssz marshals the Bitlist2TestStruct object to a target array that is flushed to a stream writer
+func (b *Bitlist2TestStruct) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
 
-	// Field (0) 'Block'
-	if dst, err = s.Block.MarshalSSZTo(dst); err != nil {
+	// Offset (0) 'A'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'A'
+	if size := len(b.A); size > 2 {
+		err = ssz.ErrBytesLengthFn("Bitlist2TestStruct.A", size, 2)
 		return
 	}
+	dst = append(dst, b.A...)
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the SignedBeaconBlockCapella object
-func (s *SignedBeaconBlockCapella) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the Bitlist2TestStruct object
+func (b *Bitlist2TestStruct) UnmarshalSSZ(buf []byte) error {
+	return b.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Bitlist2TestStruct object with the decoding options
+func (b *Bitlist2TestStruct) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 100 {
+	if size < 4 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
 	var o0 uint64
 
-	// Offset (0) 'Block'
+	// Offset (0) 'A'
 	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 100 {
-		return ssz.ErrInvalidVariableOffset
-	}
-
-	// Field (1) 'Signature'
-	if cap(s.Signature) == 0 {
-		s.Signature = make([]byte, 0, len(buf[4:100]))
+	if err = opts.ValidateFirstOffset(o0, 4); err != nil {
+		return err
 	}
-	s.Signature = append(s.Signature, buf[4:100]...)
 
-	// Field (0) 'Block'
+	// Field (0) 'A'
 	{
 		buf = tail[o0:]
-		if s.Block == nil {
-			s.Block = new(BeaconBlockCapella)
-		}
-		if err = s.Block.UnmarshalSSZ(buf); err != nil {
+		if err = ssz.ValidateBitlist(buf, 2); err != nil {
 			return err
 		}
+		if cap(b.A) == 0 {
+			b.A = make([]byte, 0, len(buf))
+		}
+		b.A = append(b.A, buf...)
 	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the SignedBeaconBlockCapella object
-func (s *SignedBeaconBlockCapella) SizeSSZ() (size int) {
-	size = 100
+// SizeSSZ returns the ssz encoded size in bytes for the Bitlist2TestStruct object
+func (b *Bitlist2TestStruct) SizeSSZ() (size int) {
+	size = 4
 
-	// Field (0) 'Block'
-	if s.Block == nil {
-		s.Block = new(BeaconBlockCapella)
-	}
-	size += s.Block.SizeSSZ()
+	// Field (0) 'A'
+	size += len(b.A)
 
 	return
 }
 
-// HashTreeRoot ssz hashes the SignedBeaconBlockCapella object
-func (s *SignedBeaconBlockCapella) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(s)
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Bitlist2TestStruct object
+func (b *Bitlist2TestStruct) MinSSZSize() uint64 {
+	return 5
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Bitlist2TestStruct object
+func (b *Bitlist2TestStruct) MaxSSZSize() uint64 {
+	return 5
+}
+
+// HashTreeRoot ssz hashes the Bitlist2TestStruct object
+func (b *Bitlist2TestStruct) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
 }
 
-// HashTreeRootWith ssz hashes the SignedBeaconBlockCapella object with a hasher
-func (s *SignedBeaconBlockCapella) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+// HashTreeRootWith ssz hashes the Bitlist2TestStruct object with a hasher
+func (b *Bitlist2TestStruct) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	indx := hh.Index()
 
-	// Field (0) 'Block'
-	if err = s.Block.HashTreeRootWith(hh); err != nil {
+	// Field (0) 'A'
+	if len(b.A) == 0 {
+		err = ssz.ErrEmptyBitlist
 		return
 	}
+	hh.PutBitlist(b.A, 2)
 
-	// Field (1) 'Signature'
-	if size := len(s.Signature); size != 96 {
-		err = ssz.ErrBytesLengthFn("SignedBeaconBlockCapella.Signature", size, 96)
-		return
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded Bitlist2TestStruct object without decoding it
+func (b *Bitlist2TestStruct) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(b, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded Bitlist2TestStruct object with a hasher
+func (b *Bitlist2TestStruct) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'A'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = ssz.ValidateFirstOffset(o0, 4); err != nil {
+		return err
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	{
+		buf := tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 2); err != nil {
+			return err
+		}
+		hh.PutBitlist(buf, 2)
 	}
-	hh.PutBytes(s.Signature)
 
 	hh.Merkleize(indx)
 	return
 }
 
-// GetTree ssz hashes the SignedBeaconBlockCapella object
-func (s *SignedBeaconBlockCapella) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(s)
+// GetTree ssz hashes the Bitlist2TestStruct object
+func (b *Bitlist2TestStruct) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+const (
+	// Bitlist2TestStructAGIndex is the generalized index of the 'A' field
+	Bitlist2TestStructAGIndex ssz.GIndex = 1
+)
+
+// ProveA returns a merkle proof of the 'A' field of the Bitlist2TestStruct object
+func (b *Bitlist2TestStruct) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, Bitlist2TestStructAGIndex)
+}
+
+// VerifyBitlist2TestStructA verifies a merkle proof of the 'A' field of a Bitlist2TestStruct object
+func VerifyBitlist2TestStructA(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Bitlist2TestStructAGIndex)
 }
 
-// MarshalSSZ ssz marshals the BeaconBlockCapella object
-func (b *BeaconBlockCapella) MarshalSSZ() ([]byte, error) {
+// SchemaSSZ returns the schema of the tree of the Bitlist2TestStruct object
+func (b *Bitlist2TestStruct) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: ssz.SchemaBitlist(2)},
+	)
+}
+
+// MarshalSSZ ssz marshals the Bitlist3TestStruct object
+func (b *Bitlist3TestStruct) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(b)
 }
 
-// MarshalSSZTo ssz marshals the BeaconBlockCapella object to a target array
-func (b *BeaconBlockCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the Bitlist3TestStruct object to a target array
+func (b *Bitlist3TestStruct) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(84)
+	offset := int(4)
 
-	// Field (0) 'Slot'
-	dst = ssz.MarshalUint64(dst, b.Slot)
+	// Offset (0) 'A'
+	dst = ssz.WriteOffset(dst, offset)
 
-	// Field (1) 'ProposerIndex'
-	dst = ssz.MarshalUint64(dst, b.ProposerIndex)
+	// Field (0) 'A'
+	if size := len(b.A); size > 3 {
+		err = ssz.ErrBytesLengthFn("Bitlist3TestStruct.A", size, 3)
+		return
+	}
+	dst = append(dst, b.A...)
 
-	// Field (2) 'ParentRoot'
-	dst = append(dst, b.ParentRoot[:]...)
+	return
+}
 
-	// Field (3) 'StateRoot'
-	dst = append(dst, b.StateRoot[:]...)
+// MarshalSSZToWriter ssz marshals the Bitlist3TestStruct object to a writer
+func (b *Bitlist3TestStruct) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(b, writer)
+}
+
+// MarshalSSZToStream ssz marshals the Bitlist3TestStruct object to a target array that is flushed to a stream writer
+func (b *Bitlist3TestStruct) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
 
-	// Offset (4) 'Body'
+	// Offset (0) 'A'
 	dst = ssz.WriteOffset(dst, offset)
 
-	// Field (4) 'Body'
-	if dst, err = b.Body.MarshalSSZTo(dst); err != nil {
+	// Field (0) 'A'
+	if size := len(b.A); size > 3 {
+		err = ssz.ErrBytesLengthFn("Bitlist3TestStruct.A", size, 3)
 		return
 	}
+	dst = append(dst, b.A...)
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the BeaconBlockCapella object
-func (b *BeaconBlockCapella) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the Bitlist3TestStruct object
+func (b *Bitlist3TestStruct) UnmarshalSSZ(buf []byte) error {
+	return b.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Bitlist3TestStruct object with the decoding options
+func (b *Bitlist3TestStruct) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 84 {
+	if size < 4 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o4 uint64
-
-	// Field (0) 'Slot'
-	b.Slot = ssz.UnmarshallUint64(buf[0:8])
-
-	// Field (1) 'ProposerIndex'
-	b.ProposerIndex = ssz.UnmarshallUint64(buf[8:16])
-
-	// Field (2) 'ParentRoot'
-	copy(b.ParentRoot[:], buf[16:48])
-
-	// Field (3) 'StateRoot'
-	copy(b.StateRoot[:], buf[48:80])
+	var o0 uint64
 
-	// Offset (4) 'Body'
-	if o4 = ssz.ReadOffset(buf[80:84]); o4 > size {
+	// Offset (0) 'A'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
 		return ssz.ErrOffset
 	}
 
-	if o4 < 84 {
-		return ssz.ErrInvalidVariableOffset
+	if err = opts.ValidateFirstOffset(o0, 4); err != nil {
+		return err
 	}
 
-	// Field (4) 'Body'
+	// Field (0) 'A'
 	{
-		buf = tail[o4:]
-		if b.Body == nil {
-			b.Body = new(BeaconBlockBodyCapella)
-		}
-		if err = b.Body.UnmarshalSSZ(buf); err != nil {
+		buf = tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 3); err != nil {
 			return err
 		}
+		if cap(b.A) == 0 {
+			b.A = make([]byte, 0, len(buf))
+		}
+		b.A = append(b.A, buf...)
 	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockCapella object
-func (b *BeaconBlockCapella) SizeSSZ() (size int) {
-	size = 84
+// SizeSSZ returns the ssz encoded size in bytes for the Bitlist3TestStruct object
+func (b *Bitlist3TestStruct) SizeSSZ() (size int) {
+	size = 4
 
-	// Field (4) 'Body'
-	if b.Body == nil {
-		b.Body = new(BeaconBlockBodyCapella)
-	}
-	size += b.Body.SizeSSZ()
+	// Field (0) 'A'
+	size += len(b.A)
 
 	return
 }
 
-// HashTreeRoot ssz hashes the BeaconBlockCapella object
-func (b *BeaconBlockCapella) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(b)
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Bitlist3TestStruct object
+func (b *Bitlist3TestStruct) MinSSZSize() uint64 {
+	return 5
 }
 
-// HashTreeRootWith ssz hashes the BeaconBlockCapella object with a hasher
-func (b *BeaconBlockCapella) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
-
-	// Field (0) 'Slot'
-	hh.PutUint64(b.Slot)
-
-	// Field (1) 'ProposerIndex'
-	hh.PutUint64(b.ProposerIndex)
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Bitlist3TestStruct object
+func (b *Bitlist3TestStruct) MaxSSZSize() uint64 {
+	return 5
+}
 
-	// Field (2) 'ParentRoot'
-	hh.PutBytes(b.ParentRoot[:])
+// HashTreeRoot ssz hashes the Bitlist3TestStruct object
+func (b *Bitlist3TestStruct) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
 
-	// Field (3) 'StateRoot'
-	hh.PutBytes(b.StateRoot[:])
+// HashTreeRootWith ssz hashes the Bitlist3TestStruct object with a hasher
+func (b *Bitlist3TestStruct) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
 
-	// Field (4) 'Body'
-	if err = b.Body.HashTreeRootWith(hh); err != nil {
+	// Field (0) 'A'
+	if len(b.A) == 0 {
+		err = ssz.ErrEmptyBitlist
 		return
 	}
+	hh.PutBitlist(b.A, 3)
 
 	hh.Merkleize(indx)
 	return
 }
 
-// GetTree ssz hashes the BeaconBlockCapella object
-func (b *BeaconBlockCapella) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(b)
+// HashTreeRootSSZ ssz hashes the encoded Bitlist3TestStruct object without decoding it
+func (b *Bitlist3TestStruct) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(b, buf)
 }
 
-// MarshalSSZ ssz marshals the BeaconBlockBodyCapella object
-func (b *BeaconBlockBodyCapella) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(b)
-}
+// HashTreeRootSSZWith ssz hashes the encoded Bitlist3TestStruct object with a hasher
+func (b *Bitlist3TestStruct) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
+	}
 
-// MarshalSSZTo ssz marshals the BeaconBlockBodyCapella object to a target array
-func (b *BeaconBlockBodyCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := int(388)
+	tail := buf
+	var o0 uint64
 
-	// Field (0) 'RandaoReveal'
-	if size := len(b.RandaoReveal); size != 96 {
-		err = ssz.ErrBytesLengthFn("BeaconBlockBodyCapella.RandaoReveal", size, 96)
-		return
+	// Offset (0) 'A'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
 	}
-	dst = append(dst, b.RandaoReveal...)
 
-	// Field (1) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
+	if err = ssz.ValidateFirstOffset(o0, 4); err != nil {
+		return err
 	}
-	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
-		return
+
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	{
+		buf := tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 3); err != nil {
+			return err
+		}
+		hh.PutBitlist(buf, 3)
 	}
 
-	// Field (2) 'Graffiti'
-	dst = append(dst, b.Graffiti[:]...)
+	hh.Merkleize(indx)
+	return
+}
 
-	// Offset (3) 'ProposerSlashings'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.ProposerSlashings) * 416
+// GetTree ssz hashes the Bitlist3TestStruct object
+func (b *Bitlist3TestStruct) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
 
-	// Offset (4) 'AttesterSlashings'
-	dst = ssz.WriteOffset(dst, offset)
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		offset += 4
-		offset += b.AttesterSlashings[ii].SizeSSZ()
-	}
+const (
+	// Bitlist3TestStructAGIndex is the generalized index of the 'A' field
+	Bitlist3TestStructAGIndex ssz.GIndex = 1
+)
 
-	// Offset (5) 'Attestations'
-	dst = ssz.WriteOffset(dst, offset)
-	for ii := 0; ii < len(b.Attestations); ii++ {
-		offset += 4
-		offset += b.Attestations[ii].SizeSSZ()
-	}
+// ProveA returns a merkle proof of the 'A' field of the Bitlist3TestStruct object
+func (b *Bitlist3TestStruct) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, Bitlist3TestStructAGIndex)
+}
 
-	// Offset (6) 'Deposits'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.Deposits) * 1240
+// VerifyBitlist3TestStructA verifies a merkle proof of the 'A' field of a Bitlist3TestStruct object
+func VerifyBitlist3TestStructA(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Bitlist3TestStructAGIndex)
+}
 
-	// Offset (7) 'VoluntaryExits'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(b.VoluntaryExits) * 112
+// SchemaSSZ returns the schema of the tree of the Bitlist3TestStruct object
+func (b *Bitlist3TestStruct) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: ssz.SchemaBitlist(3)},
+	)
+}
 
-	// Field (8) 'SyncAggregate'
-	if b.SyncAggregate == nil {
-		b.SyncAggregate = new(SyncAggregate)
-	}
-	if dst, err = b.SyncAggregate.MarshalSSZTo(dst); err != nil {
-		return
-	}
+// MarshalSSZ ssz marshals the Bitlist4TestStruct object
+func (b *Bitlist4TestStruct) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
 
-	// Offset (9) 'ExecutionPayload'
-	dst = ssz.WriteOffset(dst, offset)
-	if b.ExecutionPayload == nil {
-		b.ExecutionPayload = new(ExecutionPayloadCapella)
-	}
-	offset += b.ExecutionPayload.SizeSSZ()
+// MarshalSSZTo ssz marshals the Bitlist4TestStruct object to a target array
+func (b *Bitlist4TestStruct) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
 
-	// Offset (10) 'BlsToExecutionChanges'
+	// Offset (0) 'A'
 	dst = ssz.WriteOffset(dst, offset)
 
-	// Field (3) 'ProposerSlashings'
-	if size := len(b.ProposerSlashings); size > 16 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyCapella.ProposerSlashings", size, 16)
-		return
-	}
-	for ii := 0; ii < len(b.ProposerSlashings); ii++ {
-		if dst, err = b.ProposerSlashings[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
-	}
-
-	// Field (4) 'AttesterSlashings'
-	if size := len(b.AttesterSlashings); size > 2 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyCapella.AttesterSlashings", size, 2)
+	// Field (0) 'A'
+	if size := len(b.A); size > 4 {
+		err = ssz.ErrBytesLengthFn("Bitlist4TestStruct.A", size, 4)
 		return
 	}
-	{
-		offset = 4 * len(b.AttesterSlashings)
-		for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += b.AttesterSlashings[ii].SizeSSZ()
-		}
-	}
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		if dst, err = b.AttesterSlashings[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
-	}
+	dst = append(dst, b.A...)
 
-	// Field (5) 'Attestations'
-	if size := len(b.Attestations); size > 128 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyCapella.Attestations", size, 128)
-		return
-	}
-	{
-		offset = 4 * len(b.Attestations)
-		for ii := 0; ii < len(b.Attestations); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += b.Attestations[ii].SizeSSZ()
-		}
-	}
-	for ii := 0; ii < len(b.Attestations); ii++ {
-		if dst, err = b.Attestations[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
-	}
+	return
+}
 
-	// Field (6) 'Deposits'
-	if size := len(b.Deposits); size > 16 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyCapella.Deposits", size, 16)
-		return
-	}
-	for ii := 0; ii < len(b.Deposits); ii++ {
-		if dst, err = b.Deposits[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
-	}
+// MarshalSSZToWriter ssz marshals the Bitlist4TestStruct object to a writer
+func (b *Bitlist4TestStruct) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(b, writer)
+}
 
-	// Field (7) 'VoluntaryExits'
-	if size := len(b.VoluntaryExits); size > 16 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyCapella.VoluntaryExits", size, 16)
-		return
-	}
-	for ii := 0; ii < len(b.VoluntaryExits); ii++ {
-		if dst, err = b.VoluntaryExits[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
-	}
+// MarshalSSZToStream ssz marshals the Bitlist4TestStruct object to a target array that is flushed to a stream writer
+func (b *Bitlist4TestStruct) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
 
-	// Field (9) 'ExecutionPayload'
-	if dst, err = b.ExecutionPayload.MarshalSSZTo(dst); err != nil {
-		return
-	}
+	// Offset (0) 'A'
+	dst = ssz.WriteOffset(dst, offset)
 
-	// Field (10) 'BlsToExecutionChanges'
-	if size := len(b.BlsToExecutionChanges); size > 16 {
-		err = ssz.ErrListTooBigFn("BeaconBlockBodyCapella.BlsToExecutionChanges", size, 16)
+	// Field (0) 'A'
+	if size := len(b.A); size > 4 {
+		err = ssz.ErrBytesLengthFn("Bitlist4TestStruct.A", size, 4)
 		return
 	}
-	for ii := 0; ii < len(b.BlsToExecutionChanges); ii++ {
-		if dst, err = b.BlsToExecutionChanges[ii].MarshalSSZTo(dst); err != nil {
-			return
-		}
-	}
+	dst = append(dst, b.A...)
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the BeaconBlockBodyCapella object
-func (b *BeaconBlockBodyCapella) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the Bitlist4TestStruct object
+func (b *Bitlist4TestStruct) UnmarshalSSZ(buf []byte) error {
+	return b.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Bitlist4TestStruct object with the decoding options
+func (b *Bitlist4TestStruct) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 388 {
+	if size < 4 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o3, o4, o5, o6, o7, o9, o10 uint64
-
-	// Field (0) 'RandaoReveal'
-	if cap(b.RandaoReveal) == 0 {
-		b.RandaoReveal = make([]byte, 0, len(buf[0:96]))
-	}
-	b.RandaoReveal = append(b.RandaoReveal, buf[0:96]...)
-
-	// Field (1) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if err = b.Eth1Data.UnmarshalSSZ(buf[96:168]); err != nil {
-		return err
-	}
-
-	// Field (2) 'Graffiti'
-	copy(b.Graffiti[:], buf[168:200])
-
-	// Offset (3) 'ProposerSlashings'
-	if o3 = ssz.ReadOffset(buf[200:204]); o3 > size {
-		return ssz.ErrOffset
-	}
-
-	if o3 < 388 {
-		return ssz.ErrInvalidVariableOffset
-	}
-
-	// Offset (4) 'AttesterSlashings'
-	if o4 = ssz.ReadOffset(buf[204:208]); o4 > size || o3 > o4 {
-		return ssz.ErrOffset
-	}
-
-	// Offset (5) 'Attestations'
-	if o5 = ssz.ReadOffset(buf[208:212]); o5 > size || o4 > o5 {
-		return ssz.ErrOffset
-	}
-
-	// Offset (6) 'Deposits'
-	if o6 = ssz.ReadOffset(buf[212:216]); o6 > size || o5 > o6 {
-		return ssz.ErrOffset
-	}
-
-	// Offset (7) 'VoluntaryExits'
-	if o7 = ssz.ReadOffset(buf[216:220]); o7 > size || o6 > o7 {
-		return ssz.ErrOffset
-	}
-
-	// Field (8) 'SyncAggregate'
-	if b.SyncAggregate == nil {
-		b.SyncAggregate = new(SyncAggregate)
-	}
-	if err = b.SyncAggregate.UnmarshalSSZ(buf[220:380]); err != nil {
-		return err
-	}
-
-	// Offset (9) 'ExecutionPayload'
-	if o9 = ssz.ReadOffset(buf[380:384]); o9 > size || o7 > o9 {
-		return ssz.ErrOffset
-	}
-
-	// Offset (10) 'BlsToExecutionChanges'
-	if o10 = ssz.ReadOffset(buf[384:388]); o10 > size || o9 > o10 {
-		return ssz.ErrOffset
-	}
-
-	// Field (3) 'ProposerSlashings'
-	{
-		buf = tail[o3:o4]
-		num, err := ssz.DivideInt2(len(buf), 416, 16)
-		if err != nil {
-			return err
-		}
-		b.ProposerSlashings = make([]*ProposerSlashing, num)
-		for ii := 0; ii < num; ii++ {
-			if b.ProposerSlashings[ii] == nil {
-				b.ProposerSlashings[ii] = new(ProposerSlashing)
-			}
-			if err = b.ProposerSlashings[ii].UnmarshalSSZ(buf[ii*416 : (ii+1)*416]); err != nil {
-				return err
-			}
-		}
-	}
-
-	// Field (4) 'AttesterSlashings'
-	{
-		buf = tail[o4:o5]
-		num, err := ssz.DecodeDynamicLength(buf, 2)
-		if err != nil {
-			return err
-		}
-		b.AttesterSlashings = make([]*AttesterSlashing, num)
-		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
-			if b.AttesterSlashings[indx] == nil {
-				b.AttesterSlashings[indx] = new(AttesterSlashing)
-			}
-			if err = b.AttesterSlashings[indx].UnmarshalSSZ(buf); err != nil {
-				return err
-			}
-			return nil
-		})
-		if err != nil {
-			return err
-		}
-	}
-
-	// Field (5) 'Attestations'
-	{
-		buf = tail[o5:o6]
-		num, err := ssz.DecodeDynamicLength(buf, 128)
-		if err != nil {
-			return err
-		}
-		b.Attestations = make([]*Attestation, num)
-		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
-			if b.Attestations[indx] == nil {
-				b.Attestations[indx] = new(Attestation)
-			}
-			if err = b.Attestations[indx].UnmarshalSSZ(buf); err != nil {
-				return err
-			}
-			return nil
-		})
-		if err != nil {
-			return err
-		}
-	}
-
-	// Field (6) 'Deposits'
-	{
-		buf = tail[o6:o7]
-		num, err := ssz.DivideInt2(len(buf), 1240, 16)
-		if err != nil {
-			return err
-		}
-		b.Deposits = make([]*Deposit, num)
-		for ii := 0; ii < num; ii++ {
-			if b.Deposits[ii] == nil {
-				b.Deposits[ii] = new(Deposit)
-			}
-			if err = b.Deposits[ii].UnmarshalSSZ(buf[ii*1240 : (ii+1)*1240]); err != nil {
-				return err
-			}
-		}
-	}
+	var o0 uint64
 
-	// Field (7) 'VoluntaryExits'
-	{
-		buf = tail[o7:o9]
-		num, err := ssz.DivideInt2(len(buf), 112, 16)
-		if err != nil {
-			return err
-		}
-		b.VoluntaryExits = make([]*SignedVoluntaryExit, num)
-		for ii := 0; ii < num; ii++ {
-			if b.VoluntaryExits[ii] == nil {
-				b.VoluntaryExits[ii] = new(SignedVoluntaryExit)
-			}
-			if err = b.VoluntaryExits[ii].UnmarshalSSZ(buf[ii*112 : (ii+1)*112]); err != nil {
-				return err
-			}
-		}
+	// Offset (0) 'A'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
 	}
 
-	// Field (9) 'ExecutionPayload'
-	{
-		buf = tail[o9:o10]
-		if b.ExecutionPayload == nil {
-			b.ExecutionPayload = new(ExecutionPayloadCapella)
-		}
-		if err = b.ExecutionPayload.UnmarshalSSZ(buf); err != nil {
-			return err
-		}
+	if err = opts.ValidateFirstOffset(o0, 4); err != nil {
+		return err
 	}
 
-	// Field (10) 'BlsToExecutionChanges'
+	// Field (0) 'A'
 	{
-		buf = tail[o10:]
-		num, err := ssz.DivideInt2(len(buf), 172, 16)
-		if err != nil {
+		buf = tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 4); err != nil {
 			return err
 		}
-		b.BlsToExecutionChanges = make([]*SignedBLSToExecutionChange, num)
-		for ii := 0; ii < num; ii++ {
-			if b.BlsToExecutionChanges[ii] == nil {
-				b.BlsToExecutionChanges[ii] = new(SignedBLSToExecutionChange)
-			}
-			if err = b.BlsToExecutionChanges[ii].UnmarshalSSZ(buf[ii*172 : (ii+1)*172]); err != nil {
-				return err
-			}
+		if cap(b.A) == 0 {
+			b.A = make([]byte, 0, len(buf))
 		}
+		b.A = append(b.A, buf...)
 	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the BeaconBlockBodyCapella object
-func (b *BeaconBlockBodyCapella) SizeSSZ() (size int) {
-	size = 388
+// SizeSSZ returns the ssz encoded size in bytes for the Bitlist4TestStruct object
+func (b *Bitlist4TestStruct) SizeSSZ() (size int) {
+	size = 4
 
-	// Field (3) 'ProposerSlashings'
-	size += len(b.ProposerSlashings) * 416
+	// Field (0) 'A'
+	size += len(b.A)
 
-	// Field (4) 'AttesterSlashings'
-	for ii := 0; ii < len(b.AttesterSlashings); ii++ {
-		size += 4
-		size += b.AttesterSlashings[ii].SizeSSZ()
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Bitlist4TestStruct object
+func (b *Bitlist4TestStruct) MinSSZSize() uint64 {
+	return 5
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Bitlist4TestStruct object
+func (b *Bitlist4TestStruct) MaxSSZSize() uint64 {
+	return 5
+}
+
+// HashTreeRoot ssz hashes the Bitlist4TestStruct object
+func (b *Bitlist4TestStruct) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the Bitlist4TestStruct object with a hasher
+func (b *Bitlist4TestStruct) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	if len(b.A) == 0 {
+		err = ssz.ErrEmptyBitlist
+		return
 	}
+	hh.PutBitlist(b.A, 4)
 
-	// Field (5) 'Attestations'
-	for ii := 0; ii < len(b.Attestations); ii++ {
-		size += 4
-		size += b.Attestations[ii].SizeSSZ()
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded Bitlist4TestStruct object without decoding it
+func (b *Bitlist4TestStruct) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(b, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded Bitlist4TestStruct object with a hasher
+func (b *Bitlist4TestStruct) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
 	}
 
-	// Field (6) 'Deposits'
-	size += len(b.Deposits) * 1240
+	tail := buf
+	var o0 uint64
 
-	// Field (7) 'VoluntaryExits'
-	size += len(b.VoluntaryExits) * 112
+	// Offset (0) 'A'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
 
-	// Field (9) 'ExecutionPayload'
-	if b.ExecutionPayload == nil {
-		b.ExecutionPayload = new(ExecutionPayloadCapella)
+	if err = ssz.ValidateFirstOffset(o0, 4); err != nil {
+		return err
 	}
-	size += b.ExecutionPayload.SizeSSZ()
 
-	// Field (10) 'BlsToExecutionChanges'
-	size += len(b.BlsToExecutionChanges) * 172
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	{
+		buf := tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 4); err != nil {
+			return err
+		}
+		hh.PutBitlist(buf, 4)
+	}
 
+	hh.Merkleize(indx)
 	return
 }
 
-// HashTreeRoot ssz hashes the BeaconBlockBodyCapella object
-func (b *BeaconBlockBodyCapella) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(b)
+// GetTree ssz hashes the Bitlist4TestStruct object
+func (b *Bitlist4TestStruct) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
 }
 
-// HashTreeRootWith ssz hashes the BeaconBlockBodyCapella object with a hasher
-func (b *BeaconBlockBodyCapella) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
+const (
+	// Bitlist4TestStructAGIndex is the generalized index of the 'A' field
+	Bitlist4TestStructAGIndex ssz.GIndex = 1
+)
+
+// ProveA returns a merkle proof of the 'A' field of the Bitlist4TestStruct object
+func (b *Bitlist4TestStruct) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, Bitlist4TestStructAGIndex)
+}
+
+// VerifyBitlist4TestStructA verifies a merkle proof of the 'A' field of a Bitlist4TestStruct object
+func VerifyBitlist4TestStructA(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Bitlist4TestStructAGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the Bitlist4TestStruct object
+func (b *Bitlist4TestStruct) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: ssz.SchemaBitlist(4)},
+	)
+}
+
+// MarshalSSZ ssz marshals the Bitlist5TestStruct object
+func (b *Bitlist5TestStruct) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the Bitlist5TestStruct object to a target array
+func (b *Bitlist5TestStruct) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
+
+	// Offset (0) 'A'
+	dst = ssz.WriteOffset(dst, offset)
 
-	// Field (0) 'RandaoReveal'
-	if size := len(b.RandaoReveal); size != 96 {
-		err = ssz.ErrBytesLengthFn("BeaconBlockBodyCapella.RandaoReveal", size, 96)
+	// Field (0) 'A'
+	if size := len(b.A); size > 5 {
+		err = ssz.ErrBytesLengthFn("Bitlist5TestStruct.A", size, 5)
 		return
 	}
-	hh.PutBytes(b.RandaoReveal)
+	dst = append(dst, b.A...)
 
-	// Field (1) 'Eth1Data'
-	if b.Eth1Data == nil {
-		b.Eth1Data = new(Eth1Data)
-	}
-	if err = b.Eth1Data.HashTreeRootWith(hh); err != nil {
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the Bitlist5TestStruct object to a writer
+func (b *Bitlist5TestStruct) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(b, writer)
+}
+
+// MarshalSSZToStream ssz marshals the Bitlist5TestStruct object to a target array that is flushed to a stream writer
+func (b *Bitlist5TestStruct) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
+
+	// Offset (0) 'A'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'A'
+	if size := len(b.A); size > 5 {
+		err = ssz.ErrBytesLengthFn("Bitlist5TestStruct.A", size, 5)
 		return
 	}
+	dst = append(dst, b.A...)
 
-	// Field (2) 'Graffiti'
-	hh.PutBytes(b.Graffiti[:])
+	return
+}
 
-	// Field (3) 'ProposerSlashings'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.ProposerSlashings))
-		if num > 16 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.ProposerSlashings {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, 16)
+// UnmarshalSSZ ssz unmarshals the Bitlist5TestStruct object
+func (b *Bitlist5TestStruct) UnmarshalSSZ(buf []byte) error {
+	return b.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Bitlist5TestStruct object with the decoding options
+func (b *Bitlist5TestStruct) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
 	}
 
-	// Field (4) 'AttesterSlashings'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.AttesterSlashings))
-		if num > 2 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.AttesterSlashings {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, 2)
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'A'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
 	}
 
-	// Field (5) 'Attestations'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.Attestations))
-		if num > 128 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.Attestations {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, 128)
+	if err = opts.ValidateFirstOffset(o0, 4); err != nil {
+		return err
 	}
 
-	// Field (6) 'Deposits'
+	// Field (0) 'A'
 	{
-		subIndx := hh.Index()
-		num := uint64(len(b.Deposits))
-		if num > 16 {
-			err = ssz.ErrIncorrectListSize
-			return
+		buf = tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 5); err != nil {
+			return err
 		}
-		for _, elem := range b.Deposits {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+		if cap(b.A) == 0 {
+			b.A = make([]byte, 0, len(buf))
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 16)
+		b.A = append(b.A, buf...)
 	}
+	return err
+}
 
-	// Field (7) 'VoluntaryExits'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(b.VoluntaryExits))
-		if num > 16 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.VoluntaryExits {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, 16)
+// SizeSSZ returns the ssz encoded size in bytes for the Bitlist5TestStruct object
+func (b *Bitlist5TestStruct) SizeSSZ() (size int) {
+	size = 4
+
+	// Field (0) 'A'
+	size += len(b.A)
+
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Bitlist5TestStruct object
+func (b *Bitlist5TestStruct) MinSSZSize() uint64 {
+	return 5
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Bitlist5TestStruct object
+func (b *Bitlist5TestStruct) MaxSSZSize() uint64 {
+	return 5
+}
+
+// HashTreeRoot ssz hashes the Bitlist5TestStruct object
+func (b *Bitlist5TestStruct) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the Bitlist5TestStruct object with a hasher
+func (b *Bitlist5TestStruct) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	if len(b.A) == 0 {
+		err = ssz.ErrEmptyBitlist
+		return
 	}
+	hh.PutBitlist(b.A, 5)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded Bitlist5TestStruct object without decoding it
+func (b *Bitlist5TestStruct) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(b, buf)
+}
 
-	// Field (8) 'SyncAggregate'
-	if b.SyncAggregate == nil {
-		b.SyncAggregate = new(SyncAggregate)
+// HashTreeRootSSZWith ssz hashes the encoded Bitlist5TestStruct object with a hasher
+func (b *Bitlist5TestStruct) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
 	}
-	if err = b.SyncAggregate.HashTreeRootWith(hh); err != nil {
-		return
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'A'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
 	}
 
-	// Field (9) 'ExecutionPayload'
-	if err = b.ExecutionPayload.HashTreeRootWith(hh); err != nil {
-		return
+	if err = ssz.ValidateFirstOffset(o0, 4); err != nil {
+		return err
 	}
 
-	// Field (10) 'BlsToExecutionChanges'
+	indx := hh.Index()
+
+	// Field (0) 'A'
 	{
-		subIndx := hh.Index()
-		num := uint64(len(b.BlsToExecutionChanges))
-		if num > 16 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range b.BlsToExecutionChanges {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+		buf := tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 5); err != nil {
+			return err
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 16)
+		hh.PutBitlist(buf, 5)
 	}
 
 	hh.Merkleize(indx)
 	return
 }
 
-// GetTree ssz hashes the BeaconBlockBodyCapella object
-func (b *BeaconBlockBodyCapella) GetTree() (*ssz.Node, error) {
+// GetTree ssz hashes the Bitlist5TestStruct object
+func (b *Bitlist5TestStruct) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(b)
 }
 
-// MarshalSSZ ssz marshals the ExecutionPayloadDeneb object
-func (e *ExecutionPayloadDeneb) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(e)
+const (
+	// Bitlist5TestStructAGIndex is the generalized index of the 'A' field
+	Bitlist5TestStructAGIndex ssz.GIndex = 1
+)
+
+// ProveA returns a merkle proof of the 'A' field of the Bitlist5TestStruct object
+func (b *Bitlist5TestStruct) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, Bitlist5TestStructAGIndex)
+}
+
+// VerifyBitlist5TestStructA verifies a merkle proof of the 'A' field of a Bitlist5TestStruct object
+func VerifyBitlist5TestStructA(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Bitlist5TestStructAGIndex)
 }
 
-// MarshalSSZTo ssz marshals the ExecutionPayloadDeneb object to a target array
-func (e *ExecutionPayloadDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// SchemaSSZ returns the schema of the tree of the Bitlist5TestStruct object
+func (b *Bitlist5TestStruct) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: ssz.SchemaBitlist(5)},
+	)
+}
+
+// MarshalSSZ ssz marshals the Bitlist8TestStruct object
+func (b *Bitlist8TestStruct) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the Bitlist8TestStruct object to a target array
+func (b *Bitlist8TestStruct) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(528)
+	offset := int(4)
+
+	// Offset (0) 'A'
+	dst = ssz.WriteOffset(dst, offset)
 
-	// Field (0) 'ParentHash'
-	dst = append(dst, e.ParentHash[:]...)
+	// Field (0) 'A'
+	if size := len(b.A); size > 8 {
+		err = ssz.ErrBytesLengthFn("Bitlist8TestStruct.A", size, 8)
+		return
+	}
+	dst = append(dst, b.A...)
 
-	// Field (1) 'FeeRecipient'
-	dst = append(dst, e.FeeRecipient[:]...)
+	return
+}
 
-	// Field (2) 'StateRoot'
-	dst = append(dst, e.StateRoot[:]...)
+// MarshalSSZToWriter ssz marshals the Bitlist8TestStruct object to a writer
+func (b *Bitlist8TestStruct) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(b, writer)
+}
 
-	// Field (3) 'ReceiptsRoot'
-	dst = append(dst, e.ReceiptsRoot[:]...)
+// MarshalSSZToStream ssz marshals the Bitlist8TestStruct object to a target array that is flushed to a stream writer
+func (b *Bitlist8TestStruct) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
 
-	// Field (4) 'LogsBloom'
-	dst = append(dst, e.LogsBloom[:]...)
+	// Offset (0) 'A'
+	dst = ssz.WriteOffset(dst, offset)
 
-	// Field (5) 'PrevRandao'
-	dst = append(dst, e.PrevRandao[:]...)
+	// Field (0) 'A'
+	if size := len(b.A); size > 8 {
+		err = ssz.ErrBytesLengthFn("Bitlist8TestStruct.A", size, 8)
+		return
+	}
+	dst = append(dst, b.A...)
 
-	// Field (6) 'BlockNumber'
-	dst = ssz.MarshalUint64(dst, e.BlockNumber)
+	return
+}
 
-	// Field (7) 'GasLimit'
-	dst = ssz.MarshalUint64(dst, e.GasLimit)
+// UnmarshalSSZ ssz unmarshals the Bitlist8TestStruct object
+func (b *Bitlist8TestStruct) UnmarshalSSZ(buf []byte) error {
+	return b.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
 
-	// Field (8) 'GasUsed'
-	dst = ssz.MarshalUint64(dst, e.GasUsed)
+// UnmarshalSSZWithOptions ssz unmarshals the Bitlist8TestStruct object with the decoding options
+func (b *Bitlist8TestStruct) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
+	}
 
-	// Field (9) 'Timestamp'
-	dst = ssz.MarshalUint64(dst, e.Timestamp)
+	tail := buf
+	var o0 uint64
 
-	// Offset (10) 'ExtraData'
-	dst = ssz.WriteOffset(dst, offset)
-	offset += len(e.ExtraData)
+	// Offset (0) 'A'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = opts.ValidateFirstOffset(o0, 4); err != nil {
+		return err
+	}
+
+	// Field (0) 'A'
+	{
+		buf = tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 8); err != nil {
+			return err
+		}
+		if cap(b.A) == 0 {
+			b.A = make([]byte, 0, len(buf))
+		}
+		b.A = append(b.A, buf...)
+	}
+	return err
+}
 
-	// Field (11) 'BaseFeePerGas'
-	dst = append(dst, e.BaseFeePerGas[:]...)
+// SizeSSZ returns the ssz encoded size in bytes for the Bitlist8TestStruct object
+func (b *Bitlist8TestStruct) SizeSSZ() (size int) {
+	size = 4
 
-	// Field (12) 'BlockHash'
-	dst = append(dst, e.BlockHash[:]...)
+	// Field (0) 'A'
+	size += len(b.A)
 
-	// Offset (13) 'Transactions'
-	dst = ssz.WriteOffset(dst, offset)
-	for ii := 0; ii < len(e.Transactions); ii++ {
-		offset += 4
-		offset += len(e.Transactions[ii])
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Bitlist8TestStruct object
+func (b *Bitlist8TestStruct) MinSSZSize() uint64 {
+	return 5
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Bitlist8TestStruct object
+func (b *Bitlist8TestStruct) MaxSSZSize() uint64 {
+	return 6
+}
+
+// HashTreeRoot ssz hashes the Bitlist8TestStruct object
+func (b *Bitlist8TestStruct) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the Bitlist8TestStruct object with a hasher
+func (b *Bitlist8TestStruct) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	if len(b.A) == 0 {
+		err = ssz.ErrEmptyBitlist
+		return
 	}
+	hh.PutBitlist(b.A, 8)
+
+	hh.Merkleize(indx)
+	return
+}
 
-	// Offset (14) 'Withdrawals'
-	dst = ssz.WriteOffset(dst, offset)
+// HashTreeRootSSZ ssz hashes the encoded Bitlist8TestStruct object without decoding it
+func (b *Bitlist8TestStruct) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(b, buf)
+}
 
-	// Field (15) 'BlobGasUsed'
-	dst = ssz.MarshalUint64(dst, e.BlobGasUsed)
+// HashTreeRootSSZWith ssz hashes the encoded Bitlist8TestStruct object with a hasher
+func (b *Bitlist8TestStruct) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
+	}
 
-	// Field (16) 'ExcessBlobGas'
-	dst = ssz.MarshalUint64(dst, e.ExcessBlobGas)
+	tail := buf
+	var o0 uint64
 
-	// Field (10) 'ExtraData'
-	if size := len(e.ExtraData); size > 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadDeneb.ExtraData", size, 32)
-		return
+	// Offset (0) 'A'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
 	}
-	dst = append(dst, e.ExtraData...)
 
-	// Field (13) 'Transactions'
-	if size := len(e.Transactions); size > 1048576 {
-		err = ssz.ErrListTooBigFn("ExecutionPayloadDeneb.Transactions", size, 1048576)
-		return
-	}
-	{
-		offset = 4 * len(e.Transactions)
-		for ii := 0; ii < len(e.Transactions); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
-			offset += len(e.Transactions[ii])
-		}
-	}
-	for ii := 0; ii < len(e.Transactions); ii++ {
-		if size := len(e.Transactions[ii]); size > 1073741824 {
-			err = ssz.ErrBytesLengthFn("ExecutionPayloadDeneb.Transactions[ii]", size, 1073741824)
-			return
-		}
-		dst = append(dst, e.Transactions[ii]...)
+	if err = ssz.ValidateFirstOffset(o0, 4); err != nil {
+		return err
 	}
 
-	// Field (14) 'Withdrawals'
-	if size := len(e.Withdrawals); size > 16 {
-		err = ssz.ErrListTooBigFn("ExecutionPayloadDeneb.Withdrawals", size, 16)
-		return
-	}
-	for ii := 0; ii < len(e.Withdrawals); ii++ {
-		if dst, err = e.Withdrawals[ii].MarshalSSZTo(dst); err != nil {
-			return
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	{
+		buf := tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 8); err != nil {
+			return err
 		}
+		hh.PutBitlist(buf, 8)
 	}
 
+	hh.Merkleize(indx)
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the ExecutionPayloadDeneb object
-func (e *ExecutionPayloadDeneb) UnmarshalSSZ(buf []byte) error {
-	var err error
-	size := uint64(len(buf))
-	if size < 528 {
-		return ssz.ErrSize
-	}
+// GetTree ssz hashes the Bitlist8TestStruct object
+func (b *Bitlist8TestStruct) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
 
-	tail := buf
-	var o10, o13, o14 uint64
+const (
+	// Bitlist8TestStructAGIndex is the generalized index of the 'A' field
+	Bitlist8TestStructAGIndex ssz.GIndex = 1
+)
 
-	// Field (0) 'ParentHash'
-	copy(e.ParentHash[:], buf[0:32])
+// ProveA returns a merkle proof of the 'A' field of the Bitlist8TestStruct object
+func (b *Bitlist8TestStruct) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, Bitlist8TestStructAGIndex)
+}
 
-	// Field (1) 'FeeRecipient'
-	copy(e.FeeRecipient[:], buf[32:52])
+// VerifyBitlist8TestStructA verifies a merkle proof of the 'A' field of a Bitlist8TestStruct object
+func VerifyBitlist8TestStructA(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Bitlist8TestStructAGIndex)
+}
 
-	// Field (2) 'StateRoot'
-	copy(e.StateRoot[:], buf[52:84])
+// SchemaSSZ returns the schema of the tree of the Bitlist8TestStruct object
+func (b *Bitlist8TestStruct) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: ssz.SchemaBitlist(8)},
+	)
+}
 
-	// Field (3) 'ReceiptsRoot'
-	copy(e.ReceiptsRoot[:], buf[84:116])
+// MarshalSSZ ssz marshals the Bitlist32TestStruct object
+func (b *Bitlist32TestStruct) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
 
-	// Field (4) 'LogsBloom'
-	copy(e.LogsBloom[:], buf[116:372])
+// MarshalSSZTo ssz marshals the Bitlist32TestStruct object to a target array
+func (b *Bitlist32TestStruct) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
 
-	// Field (5) 'PrevRandao'
-	copy(e.PrevRandao[:], buf[372:404])
+	// Offset (0) 'A'
+	dst = ssz.WriteOffset(dst, offset)
 
-	// Field (6) 'BlockNumber'
-	e.BlockNumber = ssz.UnmarshallUint64(buf[404:412])
+	// Field (0) 'A'
+	if size := len(b.A); size > 32 {
+		err = ssz.ErrBytesLengthFn("Bitlist32TestStruct.A", size, 32)
+		return
+	}
+	dst = append(dst, b.A...)
 
-	// Field (7) 'GasLimit'
-	e.GasLimit = ssz.UnmarshallUint64(buf[412:420])
+	return
+}
 
-	// Field (8) 'GasUsed'
-	e.GasUsed = ssz.UnmarshallUint64(buf[420:428])
+// MarshalSSZToWriter ssz marshals the Bitlist32TestStruct object to a writer
+func (b *Bitlist32TestStruct) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(b, writer)
+}
 
-	// Field (9) 'Timestamp'
-	e.Timestamp = ssz.UnmarshallUint64(buf[428:436])
+// MarshalSSZToStream ssz marshals the Bitlist32TestStruct object to a target array that is flushed to a stream writer
+func (b *Bitlist32TestStruct) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
 
-	// Offset (10) 'ExtraData'
-	if o10 = ssz.ReadOffset(buf[436:440]); o10 > size {
-		return ssz.ErrOffset
-	}
+	// Offset (0) 'A'
+	dst = ssz.WriteOffset(dst, offset)
 
-	if o10 < 528 {
-		return ssz.ErrInvalidVariableOffset
+	// Field (0) 'A'
+	if size := len(b.A); size > 32 {
+		err = ssz.ErrBytesLengthFn("Bitlist32TestStruct.A", size, 32)
+		return
 	}
+	dst = append(dst, b.A...)
 
-	// Field (11) 'BaseFeePerGas'
-	copy(e.BaseFeePerGas[:], buf[440:472])
-
-	// Field (12) 'BlockHash'
-	copy(e.BlockHash[:], buf[472:504])
+	return
+}
 
-	// Offset (13) 'Transactions'
-	if o13 = ssz.ReadOffset(buf[504:508]); o13 > size || o10 > o13 {
-		return ssz.ErrOffset
-	}
+// UnmarshalSSZ ssz unmarshals the Bitlist32TestStruct object
+func (b *Bitlist32TestStruct) UnmarshalSSZ(buf []byte) error {
+	return b.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
 
-	// Offset (14) 'Withdrawals'
-	if o14 = ssz.ReadOffset(buf[508:512]); o14 > size || o13 > o14 {
-		return ssz.ErrOffset
+// UnmarshalSSZWithOptions ssz unmarshals the Bitlist32TestStruct object with the decoding options
+func (b *Bitlist32TestStruct) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
 	}
 
-	// Field (15) 'BlobGasUsed'
-	e.BlobGasUsed = ssz.UnmarshallUint64(buf[512:520])
-
-	// Field (16) 'ExcessBlobGas'
-	e.ExcessBlobGas = ssz.UnmarshallUint64(buf[520:528])
+	tail := buf
+	var o0 uint64
 
-	// Field (10) 'ExtraData'
-	{
-		buf = tail[o10:o13]
-		if len(buf) > 32 {
-			return ssz.ErrBytesLength
-		}
-		if cap(e.ExtraData) == 0 {
-			e.ExtraData = make([]byte, 0, len(buf))
-		}
-		e.ExtraData = append(e.ExtraData, buf...)
+	// Offset (0) 'A'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
 	}
 
-	// Field (13) 'Transactions'
-	{
-		buf = tail[o13:o14]
-		num, err := ssz.DecodeDynamicLength(buf, 1048576)
-		if err != nil {
-			return err
-		}
-		e.Transactions = make([][]byte, num)
-		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
-			if len(buf) > 1073741824 {
-				return ssz.ErrBytesLength
-			}
-			if cap(e.Transactions[indx]) == 0 {
-				e.Transactions[indx] = make([]byte, 0, len(buf))
-			}
-			e.Transactions[indx] = append(e.Transactions[indx], buf...)
-			return nil
-		})
-		if err != nil {
-			return err
-		}
+	if err = opts.ValidateFirstOffset(o0, 4); err != nil {
+		return err
 	}
 
-	// Field (14) 'Withdrawals'
+	// Field (0) 'A'
 	{
-		buf = tail[o14:]
-		num, err := ssz.DivideInt2(len(buf), 44, 16)
-		if err != nil {
+		buf = tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 32); err != nil {
 			return err
 		}
-		e.Withdrawals = make([]*Withdrawal, num)
-		for ii := 0; ii < num; ii++ {
-			if e.Withdrawals[ii] == nil {
-				e.Withdrawals[ii] = new(Withdrawal)
-			}
-			if err = e.Withdrawals[ii].UnmarshalSSZ(buf[ii*44 : (ii+1)*44]); err != nil {
-				return err
-			}
+		if cap(b.A) == 0 {
+			b.A = make([]byte, 0, len(buf))
 		}
+		b.A = append(b.A, buf...)
 	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the ExecutionPayloadDeneb object
-func (e *ExecutionPayloadDeneb) SizeSSZ() (size int) {
-	size = 528
-
-	// Field (10) 'ExtraData'
-	size += len(e.ExtraData)
-
-	// Field (13) 'Transactions'
-	for ii := 0; ii < len(e.Transactions); ii++ {
-		size += 4
-		size += len(e.Transactions[ii])
-	}
+// SizeSSZ returns the ssz encoded size in bytes for the Bitlist32TestStruct object
+func (b *Bitlist32TestStruct) SizeSSZ() (size int) {
+	size = 4
 
-	// Field (14) 'Withdrawals'
-	size += len(e.Withdrawals) * 44
+	// Field (0) 'A'
+	size += len(b.A)
 
 	return
 }
 
-// HashTreeRoot ssz hashes the ExecutionPayloadDeneb object
-func (e *ExecutionPayloadDeneb) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(e)
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Bitlist32TestStruct object
+func (b *Bitlist32TestStruct) MinSSZSize() uint64 {
+	return 5
 }
 
-// HashTreeRootWith ssz hashes the ExecutionPayloadDeneb object with a hasher
-func (e *ExecutionPayloadDeneb) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
-
-	// Field (0) 'ParentHash'
-	hh.PutBytes(e.ParentHash[:])
-
-	// Field (1) 'FeeRecipient'
-	hh.PutBytes(e.FeeRecipient[:])
-
-	// Field (2) 'StateRoot'
-	hh.PutBytes(e.StateRoot[:])
-
-	// Field (3) 'ReceiptsRoot'
-	hh.PutBytes(e.ReceiptsRoot[:])
-
-	// Field (4) 'LogsBloom'
-	hh.PutBytes(e.LogsBloom[:])
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Bitlist32TestStruct object
+func (b *Bitlist32TestStruct) MaxSSZSize() uint64 {
+	return 9
+}
 
-	// Field (5) 'PrevRandao'
-	hh.PutBytes(e.PrevRandao[:])
+// HashTreeRoot ssz hashes the Bitlist32TestStruct object
+func (b *Bitlist32TestStruct) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
 
-	// Field (6) 'BlockNumber'
-	hh.PutUint64(e.BlockNumber)
+// HashTreeRootWith ssz hashes the Bitlist32TestStruct object with a hasher
+func (b *Bitlist32TestStruct) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
 
-	// Field (7) 'GasLimit'
-	hh.PutUint64(e.GasLimit)
+	// Field (0) 'A'
+	if len(b.A) == 0 {
+		err = ssz.ErrEmptyBitlist
+		return
+	}
+	hh.PutBitlist(b.A, 32)
 
-	// Field (8) 'GasUsed'
-	hh.PutUint64(e.GasUsed)
+	hh.Merkleize(indx)
+	return
+}
 
-	// Field (9) 'Timestamp'
-	hh.PutUint64(e.Timestamp)
+// HashTreeRootSSZ ssz hashes the encoded Bitlist32TestStruct object without decoding it
+func (b *Bitlist32TestStruct) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(b, buf)
+}
 
-	// Field (10) 'ExtraData'
-	{
-		elemIndx := hh.Index()
-		byteLen := uint64(len(e.ExtraData))
-		if byteLen > 32 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		hh.Append(e.ExtraData)
-		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+// HashTreeRootSSZWith ssz hashes the encoded Bitlist32TestStruct object with a hasher
+func (b *Bitlist32TestStruct) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
 	}
 
-	// Field (11) 'BaseFeePerGas'
-	hh.PutBytes(e.BaseFeePerGas[:])
+	tail := buf
+	var o0 uint64
 
-	// Field (12) 'BlockHash'
-	hh.PutBytes(e.BlockHash[:])
+	// Offset (0) 'A'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
 
-	// Field (13) 'Transactions'
-	{
-		subIndx := hh.Index()
-		num := uint64(len(e.Transactions))
-		if num > 1048576 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range e.Transactions {
-			{
-				elemIndx := hh.Index()
-				byteLen := uint64(len(elem))
-				if byteLen > 1073741824 {
-					err = ssz.ErrIncorrectListSize
-					return
-				}
-				hh.AppendBytes32(elem)
-				hh.MerkleizeWithMixin(elemIndx, byteLen, (1073741824+31)/32)
-			}
-		}
-		hh.MerkleizeWithMixin(subIndx, num, 1048576)
+	if err = ssz.ValidateFirstOffset(o0, 4); err != nil {
+		return err
 	}
 
-	// Field (14) 'Withdrawals'
+	indx := hh.Index()
+
+	// Field (0) 'A'
 	{
-		subIndx := hh.Index()
-		num := uint64(len(e.Withdrawals))
-		if num > 16 {
-			err = ssz.ErrIncorrectListSize
-			return
-		}
-		for _, elem := range e.Withdrawals {
-			if err = elem.HashTreeRootWith(hh); err != nil {
-				return
-			}
+		buf := tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 32); err != nil {
+			return err
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 16)
+		hh.PutBitlist(buf, 32)
 	}
 
-	// Field (15) 'BlobGasUsed'
-	hh.PutUint64(e.BlobGasUsed)
-
-	// Field (16) 'ExcessBlobGas'
-	hh.PutUint64(e.ExcessBlobGas)
-
 	hh.Merkleize(indx)
 	return
 }
 
-// GetTree ssz hashes the ExecutionPayloadDeneb object
-func (e *ExecutionPayloadDeneb) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(e)
-}
-
-// MarshalSSZ ssz marshals the ExecutionPayloadHeaderDeneb object
-func (e *ExecutionPayloadHeaderDeneb) MarshalSSZ() ([]byte, error) {
-	return ssz.MarshalSSZ(e)
+// GetTree ssz hashes the Bitlist32TestStruct object
+func (b *Bitlist32TestStruct) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
 }
 
-// MarshalSSZTo ssz marshals the ExecutionPayloadHeaderDeneb object to a target array
-func (e *ExecutionPayloadHeaderDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error) {
-	dst = buf
-	offset := int(584)
-
-	// Field (0) 'ParentHash'
-	dst = append(dst, e.ParentHash[:]...)
-
-	// Field (1) 'FeeRecipient'
-	dst = append(dst, e.FeeRecipient[:]...)
-
-	// Field (2) 'StateRoot'
-	dst = append(dst, e.StateRoot[:]...)
-
-	// Field (3) 'ReceiptsRoot'
-	dst = append(dst, e.ReceiptsRoot[:]...)
-
-	// Field (4) 'LogsBloom'
-	dst = append(dst, e.LogsBloom[:]...)
+const (
+	// Bitlist32TestStructAGIndex is the generalized index of the 'A' field
+	Bitlist32TestStructAGIndex ssz.GIndex = 1
+)
 
-	// Field (5) 'PrevRandao'
-	dst = append(dst, e.PrevRandao[:]...)
+// ProveA returns a merkle proof of the 'A' field of the Bitlist32TestStruct object
+func (b *Bitlist32TestStruct) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, Bitlist32TestStructAGIndex)
+}
 
-	// Field (6) 'BlockNumber'
-	dst = ssz.MarshalUint64(dst, e.BlockNumber)
+// VerifyBitlist32TestStructA verifies a merkle proof of the 'A' field of a Bitlist32TestStruct object
+func VerifyBitlist32TestStructA(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Bitlist32TestStructAGIndex)
+}
 
-	// Field (7) 'GasLimit'
-	dst = ssz.MarshalUint64(dst, e.GasLimit)
+// SchemaSSZ returns the schema of the tree of the Bitlist32TestStruct object
+func (b *Bitlist32TestStruct) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: ssz.SchemaBitlist(32)},
+	)
+}
 
-	// Field (8) 'GasUsed'
-	dst = ssz.MarshalUint64(dst, e.GasUsed)
+// MarshalSSZ ssz marshals the Bitlist512TestStruct object
+func (b *Bitlist512TestStruct) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
 
-	// Field (9) 'Timestamp'
-	dst = ssz.MarshalUint64(dst, e.Timestamp)
+// MarshalSSZTo ssz marshals the Bitlist512TestStruct object to a target array
+func (b *Bitlist512TestStruct) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
 
-	// Offset (10) 'ExtraData'
+	// Offset (0) 'A'
 	dst = ssz.WriteOffset(dst, offset)
 
-	// Field (11) 'BaseFeePerGas'
-	dst = append(dst, e.BaseFeePerGas[:]...)
-
-	// Field (12) 'BlockHash'
-	dst = append(dst, e.BlockHash[:]...)
+	// Field (0) 'A'
+	if size := len(b.A); size > 512 {
+		err = ssz.ErrBytesLengthFn("Bitlist512TestStruct.A", size, 512)
+		return
+	}
+	dst = append(dst, b.A...)
 
-	// Field (13) 'TransactionsRoot'
-	dst = append(dst, e.TransactionsRoot[:]...)
+	return
+}
 
-	// Field (14) 'WithdrawalRoot'
-	dst = append(dst, e.WithdrawalRoot[:]...)
+// MarshalSSZToWriter ssz marshals the Bitlist512TestStruct object to a writer
+func (b *Bitlist512TestStruct) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(b, writer)
+}
 
-	// Field (15) 'BlobGasUsed'
-	dst = ssz.MarshalUint64(dst, e.BlobGasUsed)
+// MarshalSSZToStream ssz marshals the Bitlist512TestStruct object to a target array that is flushed to a stream writer
+func (b *Bitlist512TestStruct) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
 
-	// Field (16) 'ExcessBlobGas'
-	dst = ssz.MarshalUint64(dst, e.ExcessBlobGas)
+	// Offset (0) 'A'
+	dst = ssz.WriteOffset(dst, offset)
 
-	// Field (10) 'ExtraData'
-	if size := len(e.ExtraData); size > 32 {
-		err = ssz.ErrBytesLengthFn("ExecutionPayloadHeaderDeneb.ExtraData", size, 32)
+	// Field (0) 'A'
+	if size := len(b.A); size > 512 {
+		err = ssz.ErrBytesLengthFn("Bitlist512TestStruct.A", size, 512)
 		return
 	}
-	dst = append(dst, e.ExtraData...)
+	dst = append(dst, b.A...)
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the ExecutionPayloadHeaderDeneb object
-func (e *ExecutionPayloadHeaderDeneb) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the Bitlist512TestStruct object
+func (b *Bitlist512TestStruct) UnmarshalSSZ(buf []byte) error {
+	return b.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Bitlist512TestStruct object with the decoding options
+func (b *Bitlist512TestStruct) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 584 {
+	if size < 4 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o10 uint64
-
-	// Field (0) 'ParentHash'
-	copy(e.ParentHash[:], buf[0:32])
-
-	// Field (1) 'FeeRecipient'
-	copy(e.FeeRecipient[:], buf[32:52])
-
-	// Field (2) 'StateRoot'
-	copy(e.StateRoot[:], buf[52:84])
-
-	// Field (3) 'ReceiptsRoot'
-	copy(e.ReceiptsRoot[:], buf[84:116])
-
-	// Field (4) 'LogsBloom'
-	copy(e.LogsBloom[:], buf[116:372])
-
-	// Field (5) 'PrevRandao'
-	copy(e.PrevRandao[:], buf[372:404])
-
-	// Field (6) 'BlockNumber'
-	e.BlockNumber = ssz.UnmarshallUint64(buf[404:412])
-
-	// Field (7) 'GasLimit'
-	e.GasLimit = ssz.UnmarshallUint64(buf[412:420])
-
-	// Field (8) 'GasUsed'
-	e.GasUsed = ssz.UnmarshallUint64(buf[420:428])
-
-	// Field (9) 'Timestamp'
-	e.Timestamp = ssz.UnmarshallUint64(buf[428:436])
+	var o0 uint64
 
-	// Offset (10) 'ExtraData'
-	if o10 = ssz.ReadOffset(buf[436:440]); o10 > size {
+	// Offset (0) 'A'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
 		return ssz.ErrOffset
 	}
 
-	if o10 < 584 {
-		return ssz.ErrInvalidVariableOffset
+	if err = opts.ValidateFirstOffset(o0, 4); err != nil {
+		return err
 	}
 
-	// Field (11) 'BaseFeePerGas'
-	copy(e.BaseFeePerGas[:], buf[440:472])
-
-	// Field (12) 'BlockHash'
-	copy(e.BlockHash[:], buf[472:504])
-
-	// Field (13) 'TransactionsRoot'
-	copy(e.TransactionsRoot[:], buf[504:536])
-
-	// Field (14) 'WithdrawalRoot'
-	copy(e.WithdrawalRoot[:], buf[536:568])
-
-	// Field (15) 'BlobGasUsed'
-	e.BlobGasUsed = ssz.UnmarshallUint64(buf[568:576])
-
-	// Field (16) 'ExcessBlobGas'
-	e.ExcessBlobGas = ssz.UnmarshallUint64(buf[576:584])
-
-	// Field (10) 'ExtraData'
+	// Field (0) 'A'
 	{
-		buf = tail[o10:]
-		if len(buf) > 32 {
-			return ssz.ErrBytesLength
+		buf = tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 512); err != nil {
+			return err
 		}
-		if cap(e.ExtraData) == 0 {
-			e.ExtraData = make([]byte, 0, len(buf))
+		if cap(b.A) == 0 {
+			b.A = make([]byte, 0, len(buf))
 		}
-		e.ExtraData = append(e.ExtraData, buf...)
+		b.A = append(b.A, buf...)
 	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the ExecutionPayloadHeaderDeneb object
-func (e *ExecutionPayloadHeaderDeneb) SizeSSZ() (size int) {
-	size = 584
+// SizeSSZ returns the ssz encoded size in bytes for the Bitlist512TestStruct object
+func (b *Bitlist512TestStruct) SizeSSZ() (size int) {
+	size = 4
 
-	// Field (10) 'ExtraData'
-	size += len(e.ExtraData)
+	// Field (0) 'A'
+	size += len(b.A)
 
 	return
 }
 
-// HashTreeRoot ssz hashes the ExecutionPayloadHeaderDeneb object
-func (e *ExecutionPayloadHeaderDeneb) HashTreeRoot() ([32]byte, error) {
-	return ssz.HashWithDefaultHasher(e)
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Bitlist512TestStruct object
+func (b *Bitlist512TestStruct) MinSSZSize() uint64 {
+	return 5
 }
 
-// HashTreeRootWith ssz hashes the ExecutionPayloadHeaderDeneb object with a hasher
-func (e *ExecutionPayloadHeaderDeneb) HashTreeRootWith(hh ssz.HashWalker) (err error) {
-	indx := hh.Index()
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Bitlist512TestStruct object
+func (b *Bitlist512TestStruct) MaxSSZSize() uint64 {
+	return 69
+}
 
-	// Field (0) 'ParentHash'
-	hh.PutBytes(e.ParentHash[:])
+// HashTreeRoot ssz hashes the Bitlist512TestStruct object
+func (b *Bitlist512TestStruct) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
 
-	// Field (1) 'FeeRecipient'
-	hh.PutBytes(e.FeeRecipient[:])
+// HashTreeRootWith ssz hashes the Bitlist512TestStruct object with a hasher
+func (b *Bitlist512TestStruct) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
 
-	// Field (2) 'StateRoot'
-	hh.PutBytes(e.StateRoot[:])
+	// Field (0) 'A'
+	if len(b.A) == 0 {
+		err = ssz.ErrEmptyBitlist
+		return
+	}
+	hh.PutBitlist(b.A, 512)
 
-	// Field (3) 'ReceiptsRoot'
-	hh.PutBytes(e.ReceiptsRoot[:])
+	hh.Merkleize(indx)
+	return
+}
 
-	// Field (4) 'LogsBloom'
-	hh.PutBytes(e.LogsBloom[:])
+// HashTreeRootSSZ ssz hashes the encoded Bitlist512TestStruct object without decoding it
+func (b *Bitlist512TestStruct) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(b, buf)
+}
 
-	// Field (5) 'PrevRandao'
-	hh.PutBytes(e.PrevRandao[:])
+// HashTreeRootSSZWith ssz hashes the encoded Bitlist512TestStruct object with a hasher
+func (b *Bitlist512TestStruct) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
+	}
 
-	// Field (6) 'BlockNumber'
-	hh.PutUint64(e.BlockNumber)
+	tail := buf
+	var o0 uint64
 
-	// Field (7) 'GasLimit'
-	hh.PutUint64(e.GasLimit)
+	// Offset (0) 'A'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
 
-	// Field (8) 'GasUsed'
-	hh.PutUint64(e.GasUsed)
+	if err = ssz.ValidateFirstOffset(o0, 4); err != nil {
+		return err
+	}
 
-	// Field (9) 'Timestamp'
-	hh.PutUint64(e.Timestamp)
+	indx := hh.Index()
 
-	// Field (10) 'ExtraData'
+	// Field (0) 'A'
 	{
-		elemIndx := hh.Index()
-		byteLen := uint64(len(e.ExtraData))
-		if byteLen > 32 {
-			err = ssz.ErrIncorrectListSize
-			return
+		buf := tail[o0:]
+		if err = ssz.ValidateBitlist(buf, 512); err != nil {
+			return err
 		}
-		hh.Append(e.ExtraData)
-		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+		hh.PutBitlist(buf, 512)
 	}
 
-	// Field (11) 'BaseFeePerGas'
-	hh.PutBytes(e.BaseFeePerGas[:])
-
-	// Field (12) 'BlockHash'
-	hh.PutBytes(e.BlockHash[:])
-
-	// Field (13) 'TransactionsRoot'
-	hh.PutBytes(e.TransactionsRoot[:])
+	hh.Merkleize(indx)
+	return
+}
 
-	// Field (14) 'WithdrawalRoot'
-	hh.PutBytes(e.WithdrawalRoot[:])
+// GetTree ssz hashes the Bitlist512TestStruct object
+func (b *Bitlist512TestStruct) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
 
-	// Field (15) 'BlobGasUsed'
-	hh.PutUint64(e.BlobGasUsed)
+const (
+	// Bitlist512TestStructAGIndex is the generalized index of the 'A' field
+	Bitlist512TestStructAGIndex ssz.GIndex = 1
+)
 
-	// Field (16) 'ExcessBlobGas'
-	hh.PutUint64(e.ExcessBlobGas)
+// ProveA returns a merkle proof of the 'A' field of the Bitlist512TestStruct object
+func (b *Bitlist512TestStruct) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, Bitlist512TestStructAGIndex)
+}
 
-	hh.Merkleize(indx)
-	return
+// VerifyBitlist512TestStructA verifies a merkle proof of the 'A' field of a Bitlist512TestStruct object
+func VerifyBitlist512TestStructA(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Bitlist512TestStructAGIndex)
 }
 
-// GetTree ssz hashes the ExecutionPayloadHeaderDeneb object
-func (e *ExecutionPayloadHeaderDeneb) GetTree() (*ssz.Node, error) {
-	return ssz.ProofTree(e)
+// SchemaSSZ returns the schema of the tree of the Bitlist512TestStruct object
+func (b *Bitlist512TestStruct) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: ssz.SchemaBitlist(512)},
+	)
 }
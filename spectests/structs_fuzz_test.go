@@ -7,7 +7,9 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
+	ssz "github.com/ferranbt/fastssz"
 	"github.com/ferranbt/fastssz/fuzz"
 )
 
@@ -45,21 +47,38 @@ func checkIsFuzzEnabled(t *testing.T) {
 	}
 }
 
+// fuzzRand returns a random source with the seed of the FUZZ_SEED env variable
+// or a new one. The seed is logged to reproduce the failures.
+func fuzzRand(t *testing.T) *rand.Rand {
+	seed := time.Now().UnixNano()
+	if seedStr := os.Getenv("FUZZ_SEED"); seedStr != "" {
+		var err error
+		if seed, err = strconv.ParseInt(seedStr, 10, 64); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Logf("Fuzz seed %d", seed)
+	return rand.New(rand.NewSource(seed))
+}
+
 func TestFuzzMarshalWithWrongSizes(t *testing.T) {
 	checkIsFuzzEnabled(t)
 
 	for name, codec := range codecs {
 		count := fuzzTestCount(t, name)
-		for i := 0; i < count; i++ {
-			obj := codec("")
-
-			f := fuzz.New()
-			f.SetFailureRatio(.1)
-
-			failed := f.Fuzz(obj)
-			if failed {
-				if _, err := obj.MarshalSSZTo(nil); err == nil {
-					t.Fatalf("%s it should have failed", name)
+		for _, fork := range codecForks(codec()) {
+			for i := 0; i < count; i++ {
+				obj := codec()
+
+				f := fuzz.New()
+				f.SetFork(fork)
+				f.SetFailureRatio(.1)
+
+				failed := f.Fuzz(obj)
+				if failed {
+					if _, err := atFork(obj, fork).MarshalSSZTo(nil); err == nil {
+						t.Fatalf("%s it should have failed", name)
+					}
 				}
 			}
 		}
@@ -70,7 +89,7 @@ func TestErrorResponse(t *testing.T) {
 	codec := codecs["ErrorResponse"]
 
 	for i := 0; i < 1000; i++ {
-		obj := codec("")
+		obj := codec()
 		f := fuzz.New()
 		f.SetFailureRatio(.1)
 		failed := f.Fuzz(obj)
@@ -84,7 +103,7 @@ func TestErrorResponse(t *testing.T) {
 			}
 		}
 
-		obj2 := codec("")
+		obj2 := codec()
 		if err := obj2.UnmarshalSSZ(dst); err != nil {
 			t.Fatal(err)
 		}
@@ -99,22 +118,25 @@ func TestFuzzEncoding(t *testing.T) {
 
 	for name, codec := range codecs {
 		count := fuzzTestCount(t, name)
-		for i := 0; i < count; i++ {
-			obj := codec("")
-			f := fuzz.New()
-			f.Fuzz(obj)
-
-			dst, err := obj.MarshalSSZTo(nil)
-			if err != nil {
-				t.Fatal(err)
-			}
+		for _, fork := range codecForks(codec()) {
+			for i := 0; i < count; i++ {
+				obj := codec()
+				f := fuzz.New()
+				f.SetFork(fork)
+				f.Fuzz(obj)
+
+				dst, err := atFork(obj, fork).MarshalSSZTo(nil)
+				if err != nil {
+					t.Fatal(err)
+				}
 
-			obj2 := codec("")
-			if err := obj2.UnmarshalSSZ(dst); err != nil {
-				t.Fatal(err)
-			}
-			if !deepEqual(obj, obj2) {
-				t.Fatal("bad")
+				obj2 := codec()
+				if err := atFork(obj2, fork).UnmarshalSSZ(dst); err != nil {
+					t.Fatal(err)
+				}
+				if !deepEqual(obj, obj2) {
+					t.Fatal("bad")
+				}
 			}
 		}
 	}
@@ -127,33 +149,36 @@ func TestFuzzUnmarshalAppend(t *testing.T) {
 	for name, codec := range codecs {
 		t.Logf("Process %s", name)
 
-		for j := 0; j < 5; j++ {
-			obj := codec("")
-			f := fuzz.New()
-			f.Fuzz(obj)
+		for _, fork := range codecForks(codec()) {
+			for j := 0; j < 5; j++ {
+				obj := codec()
+				f := fuzz.New()
+				f.SetFork(fork)
+				f.Fuzz(obj)
 
-			dst, err := obj.MarshalSSZTo(nil)
-			if err != nil {
-				t.Fatal(err)
-			}
+				dst, err := atFork(obj, fork).MarshalSSZTo(nil)
+				if err != nil {
+					t.Fatal(err)
+				}
 
-			for i := 0; i < 100; i++ {
-				buf := []byte{}
+				for i := 0; i < 100; i++ {
+					buf := []byte{}
 
-				pos := randomInt(0, len(dst))
-				size := randomInt(1, 20)
+					pos := randomInt(0, len(dst))
+					size := randomInt(1, 20)
 
-				aux := make([]byte, size)
-				rand.Read(aux)
+					aux := make([]byte, size)
+					rand.Read(aux)
 
-				buf = append(buf, dst[:pos]...)
-				buf = append(buf, aux...)
-				buf = append(buf, dst[pos:]...)
+					buf = append(buf, dst[:pos]...)
+					buf = append(buf, aux...)
+					buf = append(buf, dst[pos:]...)
 
-				obj2 := codec("")
-				if err := obj2.UnmarshalSSZ(buf); err == nil {
-					if deepEqual(obj, obj2) {
-						t.Fatal("bad")
+					obj2 := codec()
+					if err := atFork(obj2, fork).UnmarshalSSZ(buf); err == nil {
+						if deepEqual(obj, obj2) {
+							t.Fatal("bad")
+						}
 					}
 				}
 			}
@@ -166,30 +191,33 @@ func TestFuzzUnmarshalShuffle(t *testing.T) {
 
 	// Unmarshal a correct dst with shuffled data
 	for _, codec := range codecs {
-		obj := codec("")
-		f := fuzz.New()
-		f.Fuzz(obj)
+		for _, fork := range codecForks(codec()) {
+			obj := codec()
+			f := fuzz.New()
+			f.SetFork(fork)
+			f.Fuzz(obj)
 
-		dst, err := obj.MarshalSSZTo(nil)
-		if err != nil {
-			t.Fatal(err)
-		}
+			dst, err := atFork(obj, fork).MarshalSSZTo(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
 
-		for i := 0; i < 100; i++ {
-			buf := make([]byte, len(dst))
-			copy(buf, dst)
+			for i := 0; i < 100; i++ {
+				buf := make([]byte, len(dst))
+				copy(buf, dst)
 
-			pos := randomInt(1, len(dst))
-			n := randomInt(2, 4)
-			rand.Read(buf[pos:min(pos+n, len(dst))])
+				pos := randomInt(1, len(dst))
+				n := randomInt(2, 4)
+				rand.Read(buf[pos:min(pos+n, len(dst))])
 
-			if bytes.Equal(buf, dst) {
-				continue
-			}
-			obj2 := codec("")
-			if err := obj2.UnmarshalSSZ(buf); err == nil {
-				if deepEqual(obj, obj2) {
-					t.Fatal("bad")
+				if bytes.Equal(buf, dst) {
+					continue
+				}
+				obj2 := codec()
+				if err := atFork(obj2, fork).UnmarshalSSZ(buf); err == nil {
+					if deepEqual(obj, obj2) {
+						t.Fatal("bad")
+					}
 				}
 			}
 		}
@@ -202,3 +230,110 @@ func min(i, j int) int {
 	}
 	return j
 }
+
+// canonicalCodecs are the objects used to check that the decoded inputs are canonical
+var canonicalCodecs = []string{
+	"AttestationData", "Checkpoint", "AggregateAndProof", "Attestation", "AttesterSlashing",
+	"BeaconBlock", "BeaconBlockBody", "BeaconBlockHeader", "Deposit", "DepositData", "Eth1Data",
+	"Fork", "IndexedAttestation", "PendingAttestation", "ProposerSlashing", "SignedBeaconBlockHeader",
+	"SignedVoluntaryExit", "Validator", "VoluntaryExit", "ErrorResponse", "SyncAggregate",
+	"ExecutionPayloadHeader", "Withdrawal",
+}
+
+func TestFuzzUnmarshalCanonical(t *testing.T) {
+	checkIsFuzzEnabled(t)
+	r := fuzzRand(t)
+
+	// Unmarshal a correct dst with random bytes. With the strict decoding
+	// any input that is decoded is the canonical encoding of the object
+	for _, name := range canonicalCodecs {
+		codec := codecs[name]
+
+		count := fuzzTestCount(t, name)
+		for j := 0; j < count; j++ {
+			obj := codec()
+			f := fuzz.NewWithSeed(r.Int63())
+			f.SetFork(ssz.ForkPhase0)
+			f.Fuzz(obj)
+
+			dst, err := atFork(obj, ssz.ForkPhase0).MarshalSSZTo(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for i := 0; i < 100; i++ {
+				buf := make([]byte, len(dst))
+				copy(buf, dst)
+
+				pos := r.Intn(len(dst))
+				r.Read(buf[pos:min(pos+1+r.Intn(3), len(dst))])
+
+				obj2 := atFork(codec(), ssz.ForkPhase0)
+				if err := obj2.UnmarshalSSZ(buf); err != nil {
+					continue
+				}
+				res, err := obj2.MarshalSSZTo(nil)
+				if err != nil {
+					t.Fatalf("%s: %v", name, err)
+				}
+				if !bytes.Equal(res, buf) {
+					t.Fatalf("%s: the input is not the canonical encoding", name)
+				}
+			}
+		}
+	}
+}
+
+func TestFuzzHashTreeRootSSZ(t *testing.T) {
+	checkIsFuzzEnabled(t)
+	r := fuzzRand(t)
+
+	// Hash a correct dst with random bytes without decoding it. The root is
+	// the same as the one of the decoded object and the invalid inputs fail
+	for _, name := range canonicalCodecs {
+		codec := codecs[name]
+
+		count := fuzzTestCount(t, name)
+		for j := 0; j < count; j++ {
+			obj := codec()
+			f := fuzz.NewWithSeed(r.Int63())
+			f.SetFork(ssz.ForkPhase0)
+			f.Fuzz(obj)
+
+			dst, err := atFork(obj, ssz.ForkPhase0).MarshalSSZTo(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for i := 0; i < 100; i++ {
+				buf := make([]byte, len(dst))
+				copy(buf, dst)
+
+				if i != 0 {
+					pos := r.Intn(len(dst))
+					r.Read(buf[pos:min(pos+1+r.Intn(3), len(dst))])
+				}
+
+				root, err := atFork(codec(), ssz.ForkPhase0).HashTreeRootSSZ(buf)
+
+				obj2 := atFork(codec(), ssz.ForkPhase0)
+				if err2 := obj2.UnmarshalSSZ(buf); err2 != nil {
+					if err == nil {
+						t.Fatalf("%s: the invalid input is hashed", name)
+					}
+					continue
+				}
+				if err != nil {
+					t.Fatalf("%s: %v", name, err)
+				}
+				expected, err := obj2.HashTreeRoot()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if root != expected {
+					t.Fatalf("%s: bad root", name)
+				}
+			}
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"path/filepath"
 	"strings"
@@ -20,113 +21,119 @@ type codec interface {
 	ssz.Marshaler
 	ssz.Unmarshaler
 	ssz.HashRoot
+	HashTreeRootSSZ(buf []byte) ([32]byte, error)
+	MarshalSSZToWriter(writer io.Writer) (int, error)
 }
 
-type fork string
+// forkCodec is the interface of the objects whose encoding depends on the fork
+type forkCodec interface {
+	ssz.ForkMarshaler
+	ssz.ForkUnmarshaler
+	ssz.ForkHashRoot
+	HashTreeRootSSZFork(buf []byte, fork ssz.Fork) ([32]byte, error)
+	MarshalSSZToWriterFork(writer io.Writer, fork ssz.Fork) (int, error)
+	GetTreeFork(fork ssz.Fork) (*ssz.Node, error)
+}
 
-const (
-	phase0    fork = "phase0"
-	altair    fork = "altair"
-	bellatrix fork = "bellatrix"
-	capella   fork = "capella"
-	deneb     fork = "deneb"
-)
+// withFork is the codec of a fork aware object that uses the functions of a given fork
+type withFork struct {
+	codec
+	obj  forkCodec
+	fork ssz.Fork
+}
 
-type testCallback func(fork fork) codec
-
-var codecs = map[string]testCallback{
-	"AttestationData":   func(fork fork) codec { return new(AttestationData) },
-	"Checkpoint":        func(fork fork) codec { return new(Checkpoint) },
-	"AggregateAndProof": func(fork fork) codec { return new(AggregateAndProof) },
-	"Attestation":       func(fork fork) codec { return new(Attestation) },
-	"AttesterSlashing":  func(fork fork) codec { return new(AttesterSlashing) },
-	"BeaconState": func(fork fork) codec {
-		if fork == phase0 {
-			return new(BeaconState)
-		} else if fork == altair {
-			return new(BeaconStateAltair)
-		} else if fork == bellatrix {
-			return new(BeaconStateBellatrix)
-		} else if fork == capella {
-			return new(BeaconStateCapella)
-		}
-		return nil
-	},
-	"BeaconBlock": func(fork fork) codec {
-		if fork == phase0 {
-			return new(BeaconBlock)
-		} else if fork == capella {
-			return new(BeaconBlockCapella)
-		}
-		return nil
-	},
-	"BeaconBlockBody": func(fork fork) codec {
-		if fork == phase0 {
-			return new(BeaconBlockBodyPhase0)
-		} else if fork == altair {
-			return new(BeaconBlockBodyAltair)
-		} else if fork == bellatrix {
-			return new(BeaconBlockBodyBellatrix)
-		} else if fork == capella {
-			return new(BeaconBlockBodyCapella)
-		}
-		return nil
-	},
-	"BeaconBlockHeader":  func(fork fork) codec { return new(BeaconBlockHeader) },
-	"Deposit":            func(fork fork) codec { return new(Deposit) },
-	"DepositData":        func(fork fork) codec { return new(DepositData) },
-	"DepositMessage":     func(fork fork) codec { return new(DepositMessage) },
-	"Eth1Block":          func(fork fork) codec { return new(Eth1Block) },
-	"Eth1Data":           func(fork fork) codec { return new(Eth1Data) },
-	"Fork":               func(fork fork) codec { return new(Fork) },
-	"HistoricalBatch":    func(fork fork) codec { return new(HistoricalBatch) },
-	"IndexedAttestation": func(fork fork) codec { return new(IndexedAttestation) },
-	"PendingAttestation": func(fork fork) codec { return new(PendingAttestation) },
-	"ProposerSlashing":   func(fork fork) codec { return new(ProposerSlashing) },
-	"SignedBeaconBlock": func(fork fork) codec {
-		if fork == phase0 {
-			return new(SignedBeaconBlock)
-		} else if fork == capella {
-			return new(SignedBeaconBlockCapella)
-		}
-		return nil
-	},
-	"SignedBeaconBlockHeader": func(fork fork) codec { return new(SignedBeaconBlockHeader) },
-	"SignedVoluntaryExit":     func(fork fork) codec { return new(SignedVoluntaryExit) },
-	"SigningRoot":             func(fork fork) codec { return new(SigningRoot) },
-	"Validator":               func(fork fork) codec { return new(Validator) },
-	"VoluntaryExit":           func(fork fork) codec { return new(VoluntaryExit) },
-	"ErrorResponse":           func(fork fork) codec { return new(ErrorResponse) },
-	"SyncCommittee": func(fork fork) codec {
-		return new(SyncCommittee)
-	},
-	"SyncAggregate": func(fork fork) codec {
-		return new(SyncAggregate)
-	},
-	"ExecutionPayload": func(fork fork) codec {
-		if fork == deneb {
-			return new(ExecutionPayloadDeneb)
-		} else if fork == capella {
-			return new(ExecutionPayloadCapella)
-		}
-		return new(ExecutionPayload)
-	},
-	"ExecutionPayloadHeader": func(fork fork) codec {
-		if fork == deneb {
-			return new(ExecutionPayloadHeaderDeneb)
-		} else if fork == capella {
-			return new(ExecutionPayloadHeaderCapella)
-		}
-		return new(ExecutionPayloadHeader)
-	},
-	"BLSToExecutionChange":       func(f fork) codec { return new(BLSToExecutionChange) },
-	"HistoricalSummary":          func(f fork) codec { return new(HistoricalSummary) },
-	"SignedBLSToExecutionChange": func(f fork) codec { return new(SignedBLSToExecutionChange) },
-	"Withdrawal":                 func(f fork) codec { return new(Withdrawal) },
+func (w *withFork) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZFork(w.obj, w.fork)
+}
+
+func (w *withFork) MarshalSSZTo(buf []byte) ([]byte, error) {
+	return w.obj.MarshalSSZToFork(buf, w.fork)
+}
+
+func (w *withFork) SizeSSZ() int {
+	return w.obj.SizeSSZFork(w.fork)
+}
+
+func (w *withFork) UnmarshalSSZ(buf []byte) error {
+	return w.obj.UnmarshalSSZFork(buf, w.fork)
+}
+
+func (w *withFork) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasherFork(w.obj, w.fork)
+}
+
+func (w *withFork) HashTreeRootWith(hh ssz.HashWalker) error {
+	return w.obj.HashTreeRootWithFork(hh, w.fork)
+}
+
+func (w *withFork) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return w.obj.HashTreeRootSSZFork(buf, w.fork)
 }
 
-func testSpecFork(t *testing.T, fork fork) {
-	files := readDir(t, filepath.Join(testsPath, "/mainnet/"+string(fork)+"/ssz_static"))
+func (w *withFork) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return w.obj.MarshalSSZToWriterFork(writer, w.fork)
+}
+
+func (w *withFork) GetTree() (*ssz.Node, error) {
+	return w.obj.GetTreeFork(w.fork)
+}
+
+// atFork returns the codec of the object for the given fork
+func atFork(obj codec, fork ssz.Fork) codec {
+	if f, ok := obj.(forkCodec); ok {
+		return &withFork{codec: obj, obj: f, fork: fork}
+	}
+	return obj
+}
+
+// codecForks returns the forks in which an object is tested. The objects
+// whose encoding does not depend on the fork are only tested once.
+func codecForks(obj codec) []ssz.Fork {
+	if _, ok := obj.(forkCodec); !ok {
+		return []ssz.Fork{ssz.ForkPhase0}
+	}
+	return []ssz.Fork{ssz.ForkPhase0, ssz.ForkAltair, ssz.ForkBellatrix, ssz.ForkCapella, ssz.ForkDeneb}
+}
+
+var codecs = map[string]func() codec{
+	"AttestationData":            func() codec { return new(AttestationData) },
+	"Checkpoint":                 func() codec { return new(Checkpoint) },
+	"AggregateAndProof":          func() codec { return new(AggregateAndProof) },
+	"Attestation":                func() codec { return new(Attestation) },
+	"AttesterSlashing":           func() codec { return new(AttesterSlashing) },
+	"BeaconState":                func() codec { return new(BeaconState) },
+	"BeaconBlock":                func() codec { return new(BeaconBlock) },
+	"BeaconBlockBody":            func() codec { return new(BeaconBlockBody) },
+	"BeaconBlockHeader":          func() codec { return new(BeaconBlockHeader) },
+	"Deposit":                    func() codec { return new(Deposit) },
+	"DepositData":                func() codec { return new(DepositData) },
+	"DepositMessage":             func() codec { return new(DepositMessage) },
+	"Eth1Block":                  func() codec { return new(Eth1Block) },
+	"Eth1Data":                   func() codec { return new(Eth1Data) },
+	"Fork":                       func() codec { return new(Fork) },
+	"HistoricalBatch":            func() codec { return new(HistoricalBatch) },
+	"IndexedAttestation":         func() codec { return new(IndexedAttestation) },
+	"PendingAttestation":         func() codec { return new(PendingAttestation) },
+	"ProposerSlashing":           func() codec { return new(ProposerSlashing) },
+	"SignedBeaconBlock":          func() codec { return new(SignedBeaconBlock) },
+	"SignedBeaconBlockHeader":    func() codec { return new(SignedBeaconBlockHeader) },
+	"SignedVoluntaryExit":        func() codec { return new(SignedVoluntaryExit) },
+	"SigningRoot":                func() codec { return new(SigningRoot) },
+	"Validator":                  func() codec { return new(Validator) },
+	"VoluntaryExit":              func() codec { return new(VoluntaryExit) },
+	"ErrorResponse":              func() codec { return new(ErrorResponse) },
+	"SyncCommittee":              func() codec { return new(SyncCommittee) },
+	"SyncAggregate":              func() codec { return new(SyncAggregate) },
+	"ExecutionPayload":           func() codec { return new(ExecutionPayload) },
+	"ExecutionPayloadHeader":     func() codec { return new(ExecutionPayloadHeader) },
+	"BLSToExecutionChange":       func() codec { return new(BLSToExecutionChange) },
+	"HistoricalSummary":          func() codec { return new(HistoricalSummary) },
+	"SignedBLSToExecutionChange": func() codec { return new(SignedBLSToExecutionChange) },
+	"Withdrawal":                 func() codec { return new(Withdrawal) },
+}
+
+func testSpecFork(t *testing.T, fork ssz.Fork) {
+	files := readDir(t, filepath.Join(testsPath, "/mainnet/"+fork.String()+"/ssz_static"))
 	for _, f := range files {
 		spl := strings.Split(f, "/")
 		name := spl[len(spl)-1]
@@ -146,39 +153,38 @@ func testSpecFork(t *testing.T, fork fork) {
 }
 
 func TestSpec_Phase0(t *testing.T) {
-	testSpecFork(t, phase0)
+	testSpecFork(t, ssz.ForkPhase0)
 }
 
 func TestSpec_Altair(t *testing.T) {
-	testSpecFork(t, altair)
+	testSpecFork(t, ssz.ForkAltair)
 }
 
 func TestSpec_Bellatrix(t *testing.T) {
-	testSpecFork(t, bellatrix)
+	testSpecFork(t, ssz.ForkBellatrix)
 }
 
 func TestSpec_Capella(t *testing.T) {
-	testSpecFork(t, capella)
+	testSpecFork(t, ssz.ForkCapella)
 }
 
 func TestSpec_Deneb(t *testing.T) {
-	testSpecFork(t, deneb)
+	testSpecFork(t, ssz.ForkDeneb)
 }
 
-func checkSSZEncoding(t *testing.T, fork fork, fileName, structName string, base testCallback) {
-	obj := base(fork)
-	if obj == nil {
-		// skip
-		return
-	}
-	output := readValidGenericSSZ(t, fileName, &obj)
+func checkSSZEncoding(t *testing.T, fork ssz.Fork, fileName, structName string, base func() codec) {
+	obj := base()
+	output := readValidGenericSSZ(t, fileName, obj)
 
 	fatal := func(errHeader string, err error) {
 		t.Fatalf("%s spec file=%s, struct=%s, err=%v", errHeader, fileName, structName, err)
 	}
 
+	// the fork aware objects use the functions of the fork of the test
+	c := atFork(obj, fork)
+
 	// Marshal
-	res, err := obj.MarshalSSZTo(nil)
+	res, err := c.MarshalSSZTo(nil)
 	if err != nil {
 		fatal("marshalSSZ", err)
 	}
@@ -186,9 +192,18 @@ func checkSSZEncoding(t *testing.T, fork fork, fileName, structName string, base
 		fatal("marshalSSZ_equal", fmt.Errorf("bad marshal"))
 	}
 
+	// Marshal to a writer
+	var w bytes.Buffer
+	if _, err := c.MarshalSSZToWriter(&w); err != nil {
+		fatal("MarshalSSZToWriter", err)
+	}
+	if !bytes.Equal(w.Bytes(), output.ssz) {
+		fatal("MarshalSSZToWriter_equal", fmt.Errorf("bad marshal"))
+	}
+
 	// Unmarshal
-	obj2 := base(fork)
-	if err := obj2.UnmarshalSSZ(output.ssz); err != nil {
+	obj2 := base()
+	if err := atFork(obj2, fork).UnmarshalSSZ(output.ssz); err != nil {
 		fatal("UnmarshalSSZ", err)
 	}
 	if !deepEqual(obj, obj2) {
@@ -196,7 +211,7 @@ func checkSSZEncoding(t *testing.T, fork fork, fileName, structName string, base
 	}
 
 	// Root
-	root, err := obj.HashTreeRoot()
+	root, err := c.HashTreeRoot()
 	if err != nil {
 		fatal("HashTreeRoot", err)
 	}
@@ -204,9 +219,18 @@ func checkSSZEncoding(t *testing.T, fork fork, fileName, structName string, base
 		fatal("HashTreeRoot_equal", fmt.Errorf("bad root"))
 	}
 
+	// Root from the encoding
+	sszRoot, err := c.HashTreeRootSSZ(output.ssz)
+	if err != nil {
+		fatal("HashTreeRootSSZ", err)
+	}
+	if !bytes.Equal(sszRoot[:], output.root) {
+		fatal("HashTreeRootSSZ_equal", fmt.Errorf("bad root"))
+	}
+
 	// Root with gohashtree
 	hh := ssz.NewHasherWithHashFn(gohashtree.HashByteSlice)
-	if err := obj.HashTreeRootWith(hh); err != nil {
+	if err := c.HashTreeRootWith(hh); err != nil {
 		fatal("GohashtreeRoot", err)
 	}
 	gohashTreeRoot, err := hh.HashRoot()
@@ -218,7 +242,7 @@ func checkSSZEncoding(t *testing.T, fork fork, fileName, structName string, base
 	}
 
 	// Proof
-	node, err := obj.GetTree()
+	node, err := c.GetTree()
 	if err != nil {
 		fatal("Tree", err)
 	}
@@ -0,0 +1,134 @@
+package generator
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+)
+
+// sizeBounds creates two functions that return the static bounds of the SSZ size of the struct.
+// 1. MinSSZSize: Size of the smallest valid encoding (i.e. empty lists and bytes).
+// 2. MaxSSZSize: Size of the largest valid encoding (i.e. lists filled up to 'ssz-max').
+// Both bounds are computed from the IR at generation time. If the maximum size does not fit
+// in an uint64 (i.e. nested lists with huge limits) it saturates to ssz.MaxSizeUnbounded.
+func (e *env) sizeBounds(name string, v *Value) string {
+	tmpl := `// MinSSZSize returns the minimum ssz encoded size in bytes for the {{.name}} object
+	func (:: *{{.name}}) MinSSZSize() uint64 {
+		return {{.min}}
+	}
+
+	// MaxSSZSize returns the maximum ssz encoded size in bytes for the {{.name}} object
+	func (:: *{{.name}}) MaxSSZSize() uint64 {
+		return {{.max}}
+	}`
+
+	str := execTmpl(tmpl, map[string]interface{}{
+		"name": name,
+		"min":  boundStr(v.minSize()),
+		"max":  boundStr(v.maxSize()),
+	})
+	return appendObjSignature(str, v)
+}
+
+func boundStr(size uint64) string {
+	if size == math.MaxUint64 {
+		return "$ssz.MaxSizeUnbounded"
+	}
+	return fmt.Sprintf("%d", size)
+}
+
+// addSat adds two sizes saturating at math.MaxUint64
+func addSat(a, b uint64) uint64 {
+	res, carry := bits.Add64(a, b, 0)
+	if carry != 0 {
+		return math.MaxUint64
+	}
+	return res
+}
+
+// mulSat multiplies two sizes saturating at math.MaxUint64
+func mulSat(a, b uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	if hi != 0 {
+		return math.MaxUint64
+	}
+	return lo
+}
+
+// minSize returns the size of the smallest valid encoding of the value
+func (v *Value) minSize() uint64 {
+	if v.isFixed() {
+		return v.fixedSize()
+	}
+
+	switch v.t {
+	case TypeContainer:
+		var size uint64
+		for _, f := range v.o {
+			if f.isFixed() {
+				size = addSat(size, f.fixedSize())
+			} else {
+				size = addSat(size, addSat(bytesPerLengthOffset, f.minSize()))
+			}
+		}
+		return size
+
+	case TypeBitList:
+		// a bitlist always includes the length bit
+		return 1
+
+	case TypeVector:
+		// vector of dynamic elements, one offset per element
+		return mulSat(v.s, addSat(bytesPerLengthOffset, v.e.minSize()))
+
+	case TypeBytes, TypeList, TypeReference:
+		// the size of a dynamic reference is not known at generation time
+		return 0
+
+	default:
+		panic(fmt.Errorf("min size not implemented for type %s", v.t.String()))
+	}
+}
+
+// maxSize returns the size of the largest valid encoding of the value
+func (v *Value) maxSize() uint64 {
+	if v.isFixed() {
+		return v.fixedSize()
+	}
+
+	switch v.t {
+	case TypeContainer:
+		var size uint64
+		for _, f := range v.o {
+			if f.isFixed() {
+				size = addSat(size, f.fixedSize())
+			} else {
+				size = addSat(size, addSat(bytesPerLengthOffset, f.maxSize()))
+			}
+		}
+		return size
+
+	case TypeBitList:
+		// the bits plus the length bit
+		return v.m/8 + 1
+
+	case TypeBytes:
+		return v.m
+
+	case TypeList:
+		if v.e.isFixed() {
+			return mulSat(v.m, v.e.fixedSize())
+		}
+		return mulSat(v.m, addSat(bytesPerLengthOffset, v.e.maxSize()))
+
+	case TypeVector:
+		return mulSat(v.s, addSat(bytesPerLengthOffset, v.e.maxSize()))
+
+	case TypeReference:
+		// the size of a dynamic reference is not known at generation time
+		return math.MaxUint64
+
+	default:
+		panic(fmt.Errorf("max size not implemented for type %s", v.t.String()))
+	}
+}
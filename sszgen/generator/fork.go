@@ -0,0 +1,383 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// forkNames is the ordered list of forks that can be used in the fork tags. It matches
+// the ssz.Fork constants of the library.
+var forkNames = []string{"phase0", "altair", "bellatrix", "capella", "deneb"}
+
+// defaultFork is the fork used by the standard SSZ functions of the fork aware objects.
+var defaultFork = len(forkNames) - 1
+
+// Fork aware objects are structs with fields that are only part of the SSZ encoding in some
+// forks. The fields are annotated with two tags:
+// 1. 'ssz-fork': The fork in which the field is introduced.
+// 2. 'ssz-until': The fork in which the field is removed.
+// Given that the offsets and the positions of the fields depend on the fork, the generator
+// creates one body for each set of fields (i.e. the forks that introduce or remove fields)
+// and chooses the body with a switch over the fork.
+
+func forkConst(fork int) string {
+	name := forkNames[fork]
+	return "$ssz.Fork" + strings.ToUpper(name[:1]) + name[1:]
+}
+
+func forkIndex(name string) (int, bool) {
+	for indx, fork := range forkNames {
+		if fork == name {
+			return indx, true
+		}
+	}
+	return 0, false
+}
+
+// parseForkTags decodes the 'ssz-fork' and 'ssz-until' tags of a field
+func parseForkTags(name, tags string, v *Value) error {
+	if fork, ok := getTags(tags, "ssz-fork"); ok {
+		indx, ok := forkIndex(fork)
+		if !ok {
+			return fmt.Errorf("unknown fork '%s' in ssz-fork tag for %s", fork, name)
+		}
+		v.forkFrom = indx
+	}
+	if fork, ok := getTags(tags, "ssz-until"); ok {
+		indx, ok := forkIndex(fork)
+		if !ok {
+			return fmt.Errorf("unknown fork '%s' in ssz-until tag for %s", fork, name)
+		}
+		if indx <= v.forkFrom {
+			return fmt.Errorf("ssz-until fork '%s' for %s must be later than the ssz-fork fork", fork, name)
+		}
+		v.forkUntil = indx
+	}
+	return nil
+}
+
+// isActive returns true if the field is part of the encoding in the given fork
+func (v *Value) isActive(fork int) bool {
+	if fork < v.forkFrom {
+		return false
+	}
+	if v.forkUntil != 0 && fork >= v.forkUntil {
+		return false
+	}
+	return true
+}
+
+// forks returns the sorted list of forks in which the encoding of the value changes
+func (v *Value) forks() []int {
+	found := map[int]struct{}{}
+
+	var collect func(v *Value)
+	collect = func(v *Value) {
+		if v.forkFrom != 0 {
+			found[v.forkFrom] = struct{}{}
+		}
+		if v.forkUntil != 0 {
+			found[v.forkUntil] = struct{}{}
+		}
+		for _, f := range v.o {
+			collect(f)
+		}
+		if v.e != nil {
+			collect(v.e)
+		}
+	}
+	for _, f := range v.o {
+		collect(f)
+	}
+	if v.e != nil {
+		collect(v.e)
+	}
+
+	forks := []int{}
+	for fork := range found {
+		forks = append(forks, fork)
+	}
+	sort.Ints(forks)
+	return forks
+}
+
+// atFork returns a copy of the value with only the fields active in the given fork
+func (v *Value) atFork(fork int) *Value {
+	vv := v.copy()
+	vv.filterFork(fork)
+	return vv
+}
+
+func (v *Value) filterFork(fork int) {
+	if v.t == TypeContainer {
+		v.forkAware = len(v.forks()) != 0
+
+		o := []*Value{}
+		for _, f := range v.o {
+			if f.isActive(fork) {
+				f.filterFork(fork)
+				o = append(o, f)
+			}
+		}
+		v.o = o
+	}
+	if v.e != nil {
+		v.e.filterFork(fork)
+	}
+}
+
+// forkSwitch creates a switch over the fork with one case for each set of
+// fields of the object. Consecutive forks with the same body share the case
+// of the earlier fork.
+func forkSwitch(v *Value, forks []int, body func(v *Value) string) string {
+	bodies := []string{body(v.atFork(0))}
+	for _, fork := range forks {
+		bodies = append(bodies, body(v.atFork(fork)))
+	}
+
+	cases := []string{}
+	for i := len(forks) - 1; i >= 0; i-- {
+		if bodies[i+1] == bodies[i] {
+			continue
+		}
+		cases = append(cases, fmt.Sprintf("case fork >= %s:\n%s", forkConst(forks[i]), bodies[i+1]))
+	}
+	cases = append(cases, fmt.Sprintf("default:\n%s", bodies[0]))
+
+	return fmt.Sprintf("switch {\n%s\n}", strings.Join(cases, "\n"))
+}
+
+func (e *env) marshalFork(name string, v *Value, forks []int) string {
+	tmpl := `// MarshalSSZ ssz marshals the {{.name}} object
+	func (:: *{{.name}}) MarshalSSZ() ([]byte, error) {
+		return $ssz.MarshalSSZ(::)
+	}
+
+	// MarshalSSZTo ssz marshals the {{.name}} object to a target array
+	func (:: *{{.name}}) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+		return ::.MarshalSSZToFork(buf, {{.default}})
+	}
+
+	// MarshalSSZFork ssz marshals the {{.name}} object for a given fork
+	func (:: *{{.name}}) MarshalSSZFork(fork $ssz.Fork) ([]byte, error) {
+		return $ssz.MarshalSSZFork(::, fork)
+	}
+
+	// MarshalSSZToFork ssz marshals the {{.name}} object to a target array for a given fork
+	func (:: *{{.name}}) MarshalSSZToFork(buf []byte, fork $ssz.Fork) (dst []byte, err error) {
+		dst = buf
+		{{.marshal}}
+		return
+	}
+
+	// MarshalSSZToWriter ssz marshals the {{.name}} object to a writer
+	func (:: *{{.name}}) MarshalSSZToWriter(writer io.Writer) (int, error) {
+		return $ssz.MarshalSSZToWriter(::, writer)
+	}
+
+	// MarshalSSZToStream ssz marshals the {{.name}} object to a target array that is flushed to a stream writer
+	func (:: *{{.name}}) MarshalSSZToStream(buf []byte, sw *$ssz.StreamWriter) (dst []byte, err error) {
+		return ::.MarshalSSZToStreamFork(buf, sw, {{.default}})
+	}
+
+	// MarshalSSZToWriterFork ssz marshals the {{.name}} object to a writer for a given fork
+	func (:: *{{.name}}) MarshalSSZToWriterFork(writer io.Writer, fork $ssz.Fork) (int, error) {
+		return $ssz.MarshalSSZToWriterFork(::, writer, fork)
+	}
+
+	// MarshalSSZToStreamFork ssz marshals the {{.name}} object to a target array that is flushed to a stream writer for a given fork
+	func (:: *{{.name}}) MarshalSSZToStreamFork(buf []byte, sw *$ssz.StreamWriter, fork $ssz.Fork) (dst []byte, err error) {
+		dst = buf
+		{{.stream}}
+		return
+	}`
+
+	marshal := func(stream bool) func(v *Value) string {
+		return func(v *Value) string {
+			str := v.marshalContainer(true, stream)
+			if !v.isFixed() {
+				str = fmt.Sprintf("offset := int(%d)\n", v.fixedSize()) + str
+			}
+			return str
+		}
+	}
+	data := map[string]interface{}{
+		"name":    name,
+		"default": forkConst(defaultFork),
+		"marshal": forkSwitch(v, forks, marshal(false)),
+		"stream":  forkSwitch(v, forks, marshal(true)),
+	}
+	str := execTmpl(tmpl, data)
+	return appendObjSignature(str, v)
+}
+
+func (e *env) unmarshalFork(name string, v *Value, forks []int) string {
+	tmpl := `// UnmarshalSSZ ssz unmarshals the {{.name}} object
+	func (:: *{{.name}}) UnmarshalSSZ(buf []byte) error {
+		return ::.UnmarshalSSZForkWithOptions(buf, {{.default}}, $ssz.UnmarshalOptions{})
+	}
+
+	// UnmarshalSSZWithOptions ssz unmarshals the {{.name}} object with the decoding options
+	func (:: *{{.name}}) UnmarshalSSZWithOptions(buf []byte, opts $ssz.UnmarshalOptions) error {
+		return ::.UnmarshalSSZForkWithOptions(buf, {{.default}}, opts)
+	}
+
+	// UnmarshalSSZFork ssz unmarshals the {{.name}} object for a given fork
+	func (:: *{{.name}}) UnmarshalSSZFork(buf []byte, fork $ssz.Fork) error {
+		return ::.UnmarshalSSZForkWithOptions(buf, fork, $ssz.UnmarshalOptions{})
+	}
+
+	// UnmarshalSSZForkWithOptions ssz unmarshals the {{.name}} object for a given fork with the decoding options
+	func (:: *{{.name}}) UnmarshalSSZForkWithOptions(buf []byte, fork $ssz.Fork, opts $ssz.UnmarshalOptions) error {
+		var err error
+		{{.unmarshal}}
+		return err
+	}`
+
+	str := execTmpl(tmpl, map[string]interface{}{
+		"name":    name,
+		"default": forkConst(defaultFork),
+		"unmarshal": forkSwitch(v, forks, func(v *Value) string {
+			return v.umarshalContainer(true, "buf")
+		}),
+	})
+	return appendObjSignature(str, v)
+}
+
+func (e *env) sizeFork(name string, v *Value, forks []int) string {
+	tmpl := `// SizeSSZ returns the ssz encoded size in bytes for the {{.name}} object
+	func (:: *{{.name}}) SizeSSZ() (size int) {
+		return ::.SizeSSZFork({{.default}})
+	}
+
+	// SizeSSZFork returns the ssz encoded size in bytes for the {{.name}} object for a given fork
+	func (:: *{{.name}}) SizeSSZFork(fork $ssz.Fork) (size int) {
+		{{.size}}
+		return
+	}`
+
+	str := execTmpl(tmpl, map[string]interface{}{
+		"name":    name,
+		"default": forkConst(defaultFork),
+		"size": forkSwitch(v, forks, func(v *Value) string {
+			return fmt.Sprintf("size = %d\n%s", v.fixedSize(), v.sizeContainer("size", true))
+		}),
+	})
+	return appendObjSignature(str, v)
+}
+
+func (e *env) sizeBoundsFork(name string, v *Value, forks []int) string {
+	tmpl := `// MinSSZSize returns the minimum ssz encoded size in bytes for the {{.name}} object
+	func (:: *{{.name}}) MinSSZSize() uint64 {
+		return ::.MinSSZSizeFork({{.default}})
+	}
+
+	// MinSSZSizeFork returns the minimum ssz encoded size in bytes for the {{.name}} object for a given fork
+	func (:: *{{.name}}) MinSSZSizeFork(fork $ssz.Fork) uint64 {
+		{{.min}}
+	}
+
+	// MaxSSZSize returns the maximum ssz encoded size in bytes for the {{.name}} object
+	func (:: *{{.name}}) MaxSSZSize() uint64 {
+		return ::.MaxSSZSizeFork({{.default}})
+	}
+
+	// MaxSSZSizeFork returns the maximum ssz encoded size in bytes for the {{.name}} object for a given fork
+	func (:: *{{.name}}) MaxSSZSizeFork(fork $ssz.Fork) uint64 {
+		{{.max}}
+	}`
+
+	str := execTmpl(tmpl, map[string]interface{}{
+		"name":    name,
+		"default": forkConst(defaultFork),
+		"min": forkSwitch(v, forks, func(v *Value) string {
+			return "return " + boundStr(v.minSize())
+		}),
+		"max": forkSwitch(v, forks, func(v *Value) string {
+			return "return " + boundStr(v.maxSize())
+		}),
+	})
+	return appendObjSignature(str, v)
+}
+
+func (e *env) hashTreeRootFork(name string, v *Value, forks []int) string {
+	tmpl := `// HashTreeRoot ssz hashes the {{.name}} object
+	func (:: *{{.name}}) HashTreeRoot() ([32]byte, error) {
+		return $ssz.HashWithDefaultHasher(::)
+	}
+
+	// HashTreeRootWith ssz hashes the {{.name}} object with a hasher
+	func (:: *{{.name}}) HashTreeRootWith(hh $ssz.HashWalker) (err error) {
+		return ::.HashTreeRootWithFork(hh, {{.default}})
+	}
+
+	// HashTreeRootFork ssz hashes the {{.name}} object for a given fork
+	func (:: *{{.name}}) HashTreeRootFork(fork $ssz.Fork) ([32]byte, error) {
+		return $ssz.HashWithDefaultHasherFork(::, fork)
+	}
+
+	// HashTreeRootWithFork ssz hashes the {{.name}} object with a hasher for a given fork
+	func (:: *{{.name}}) HashTreeRootWithFork(hh $ssz.HashWalker, fork $ssz.Fork) (err error) {
+		{{.hashTreeRoot}}
+		return
+	}`
+
+	str := execTmpl(tmpl, map[string]interface{}{
+		"name":    name,
+		"default": forkConst(defaultFork),
+		"hashTreeRoot": forkSwitch(v, forks, func(v *Value) string {
+			return v.hashTreeRootContainer(true)
+		}),
+	})
+	return appendObjSignature(str, v)
+}
+
+func (e *env) hashTreeRootSSZFork(name string, v *Value, forks []int) string {
+	tmpl := `// HashTreeRootSSZ ssz hashes the encoded {{.name}} object without decoding it
+	func (:: *{{.name}}) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+		return $ssz.HashSSZWithDefaultHasher(::, buf)
+	}
+
+	// HashTreeRootSSZWith ssz hashes the encoded {{.name}} object with a hasher
+	func (:: *{{.name}}) HashTreeRootSSZWith(hh $ssz.HashWalker, buf []byte) (err error) {
+		return ::.HashTreeRootSSZWithFork(hh, buf, {{.default}})
+	}
+
+	// HashTreeRootSSZFork ssz hashes the encoded {{.name}} object for a given fork without decoding it
+	func (:: *{{.name}}) HashTreeRootSSZFork(buf []byte, fork $ssz.Fork) ([32]byte, error) {
+		return $ssz.HashSSZWithDefaultHasherFork(::, buf, fork)
+	}
+
+	// HashTreeRootSSZWithFork ssz hashes the encoded {{.name}} object with a hasher for a given fork
+	func (:: *{{.name}}) HashTreeRootSSZWithFork(hh $ssz.HashWalker, buf []byte, fork $ssz.Fork) (err error) {
+		{{.hashTreeRoot}}
+		return
+	}`
+
+	str := execTmpl(tmpl, map[string]interface{}{
+		"name":    name,
+		"default": forkConst(defaultFork),
+		"hashTreeRoot": forkSwitch(v, forks, func(v *Value) string {
+			return v.hashTreeRootSSZContainer(true, "buf")
+		}),
+	})
+	return appendObjSignature(str, v)
+}
+
+func (e *env) getTreeFork(name string, v *Value) string {
+	tmpl := `// GetTree ssz hashes the {{.name}} object
+	func (:: *{{.name}}) GetTree() (*$ssz.Node, error) {
+		return $ssz.ProofTree(::)
+	}
+
+	// GetTreeFork ssz hashes the {{.name}} object for a given fork
+	func (:: *{{.name}}) GetTreeFork(fork $ssz.Fork) (*$ssz.Node, error) {
+		return $ssz.ProofTreeFork(::, fork)
+	}`
+
+	str := execTmpl(tmpl, map[string]interface{}{
+		"name": name,
+	})
+	return appendObjSignature(str, v)
+}
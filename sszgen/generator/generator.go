@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"go/ast"
+	"go/build"
 	"go/format"
 	"go/parser"
 	"go/token"
@@ -24,13 +25,23 @@ import (
 
 const bytesPerLengthOffset = 4
 
+// sszRef is the package qualifier of the ssz library in the templates (i.e. '$ssz.HashWalker').
+// It is replaced with the qualifier of the import or with nothing when the target package is
+// the ssz library, since the library cannot import itself.
+const sszRef = "$ssz."
+
 // The SSZ code generation works in three steps:
 // 1. Parse the Go input with the go/parser library to generate an AST representation.
 // 2. Convert the AST into an Internal Representation (IR) to describe the structs and fields
 // using the Value object.
 // 3. Use the IR to print the encoding functions
 
-func Encode(source string, targets []string, output string, includePaths []string, excludeTypeNames map[string]bool, suffix string) error {
+func Encode(source string, targets []string, output string, includePaths []string, excludeTypeNames map[string]bool, suffix string, upgradesList []string) error {
+	upgrades, err := parseUpgrades(upgradesList)
+	if err != nil {
+		return err
+	}
+
 	files, err := parseInput(source) // 1.
 	if err != nil {
 		return err
@@ -48,29 +59,84 @@ func Encode(source string, targets []string, output string, includePaths []strin
 		}
 	}
 
+	e := newEnv(files, include)
+	e.source = source
+	e.targets = targets
+	e.excludeTypeNames = excludeTypeNames
+	e.suffix = suffix
+	e.upgrades = upgrades
+
+	out, err := e.generate(output)
+	if err != nil {
+		return err
+	}
+	for name, output := range out {
+		if err := ioutil.WriteFile(name, output, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Generate returns the encodings of the structs in the given Go sources (file name to content)
+// without reading or writing files. The output files are named after the sources with the suffix.
+// The include sources are the packages of the structs referenced from other packages.
+func Generate(sources map[string][]byte, include map[string][]byte, suffix string) (map[string][]byte, error) {
+	parse := func(sources map[string][]byte) (map[string]*ast.File, error) {
+		files := map[string]*ast.File{}
+		for name, src := range sources {
+			file, err := parser.ParseFile(token.NewFileSet(), name, src, parser.ParseComments)
+			if err != nil {
+				return nil, err
+			}
+			files[name] = file
+		}
+		return files, nil
+	}
+	files, err := parse(sources)
+	if err != nil {
+		return nil, err
+	}
+	includeFiles, err := parse(include)
+	if err != nil {
+		return nil, err
+	}
+
+	e := newEnv(files, includeFiles)
+	e.suffix = suffix
+	return e.generate("")
+}
+
+func newEnv(files map[string]*ast.File, include map[string]*ast.File) *env {
 	// read package
 	var packName string
 	for _, file := range files {
 		packName = file.Name.Name
 	}
 
-	e := &env{
-		include:          include,
-		source:           source,
-		files:            files,
-		objs:             map[string]*Value{},
-		packName:         packName,
-		targets:          targets,
-		excludeTypeNames: excludeTypeNames,
-		suffix:           suffix,
+	return &env{
+		include:  include,
+		files:    files,
+		objs:     map[string]*Value{},
+		packName: packName,
 	}
+}
 
+// generate returns the formatted encodings of the files either in a
+// single output file or in a file for each source file
+func (e *env) generate(output string) (map[string][]byte, error) {
 	if err := e.generateIR(); err != nil { // 2.
-		return err
+		return nil, err
+	}
+	for _, u := range e.upgrades {
+		if !e.isGenerated(u.to) {
+			return nil, fmt.Errorf("upgrade target '%s' not found in the source", u.to)
+		}
 	}
 
 	// 3.
 	var out map[string]string
+	var err error
 	if output == "" {
 		out, err = e.generateEncodings()
 	} else {
@@ -85,18 +151,15 @@ func Encode(source string, targets []string, output string, includePaths []strin
 		panic("No files to generate")
 	}
 
+	res := map[string][]byte{}
 	for name, str := range out {
-		output := []byte(str)
-
-		output, err = format.Source(output)
+		output, err := format.Source([]byte(str))
 		if err != nil {
-			return err
-		}
-		if err := ioutil.WriteFile(name, output, 0644); err != nil {
-			return err
+			return nil, err
 		}
+		res[name] = output
 	}
-	return nil
+	return res, nil
 }
 
 func isDir(path string) (bool, error) {
@@ -182,6 +245,21 @@ type Value struct {
 	noPtr bool
 	// isFixed allows us to explicitly mark fixed at parse time
 	fixed bool
+	// castRef is the package of the named type set with the 'cast-type' tag
+	castRef string
+	// castObj is the named type set with the 'cast-type' tag. The value
+	// is decoded into this type and converted back to bytes to encode it
+	castObj string
+	// forkFrom is the fork in which the field is introduced ('ssz-fork' tag)
+	forkFrom int
+	// forkUntil is the fork in which the field is removed ('ssz-until' tag).
+	// Zero means that the field is never removed
+	forkUntil int
+	// forkAware determines if the encoding of the value depends on the fork
+	forkAware bool
+	// bits is the size in bits of a bitvector (i.e. 4 for a Bitvector4) to
+	// validate its padding bits. Zero if the size is unknown
+	bits uint64
 }
 
 func (v *Value) isListElem() bool {
@@ -198,6 +276,10 @@ func appendWithoutRepeated(s []string, i []string) []string {
 }
 
 func detectImports(v *Value) string {
+	if v.castRef != "" {
+		return v.castRef
+	}
+
 	// for sure v is a container
 	var ref string
 	switch v.t {
@@ -229,6 +311,23 @@ func (v *Value) objRef() string {
 	return v.ref + "." + v.obj
 }
 
+// castObjRef returns the reference of the 'cast-type' object including its package
+func (v *Value) castObjRef() string {
+	if v.castObj == "" {
+		return ""
+	}
+	valuesImported = append(valuesImported, v)
+	return v.castRef + "." + v.castObj
+}
+
+// castBytes converts the expression of a value with a 'cast-type' back to bytes
+func (v *Value) castBytes(name string) string {
+	if v.castObj == "" {
+		return name
+	}
+	return "[]byte(" + name + ")"
+}
+
 func (v *Value) copy() *Value {
 	vv := new(Value)
 	*vv = *v
@@ -319,6 +418,18 @@ type env struct {
 	results []*astResult
 	// suffix is the suffix to append to codec files.
 	suffix string
+	// upgrades is the list of conversion functions between objects
+	upgrades []*upgrade
+}
+
+// isGenerated returns true if the object is part of the generated output
+func (e *env) isGenerated(name string) bool {
+	for _, order := range e.order {
+		if contains(name, order) {
+			return true
+		}
+	}
+	return false
 }
 
 func (e *env) generateOutputEncodings(output string) (map[string]string, error) {
@@ -401,7 +512,9 @@ func (e *env) print(order []string) (string, bool, error) {
 	package {{.package}}
 
 	import (
-		ssz "github.com/ferranbt/fastssz" {{ if .imports }}{{ range $value := .imports }}
+		"io"
+
+		{{ if .qualifier }}ssz "{{.sszPackagePath}}"{{ end }} {{ if .imports }}{{ range $value := .imports }}
 			{{ $value }} {{ end }}
 		{{ end }}
 	)
@@ -410,19 +523,33 @@ func (e *env) print(order []string) (string, bool, error) {
 		{{ .Marshal }}
 		{{ .Unmarshal }}
 		{{ .Size }}
+		{{ .SizeBounds }}
 		{{ .HashTreeRoot }}
+		{{ .HashTreeRootSSZ }}
 		{{ .GetTree }}
+		{{ .GIndex }}
+		{{ .Schema }}
+	{{ end }}
+
+	{{ range .upgrades }}
+		{{ . }}
 	{{ end }}
 	`
 
+	qualifier := "ssz."
+	if e.isSSZSource() {
+		qualifier = ""
+	}
 	data := map[string]interface{}{
-		"package": e.packName,
-		"hash":    hash,
-		"version": version.Version,
+		"package":        e.packName,
+		"hash":           hash,
+		"version":        version.Version,
+		"qualifier":      qualifier,
+		"sszPackagePath": sszPackagePath,
 	}
 
 	type Obj struct {
-		Size, Marshal, Unmarshal, HashTreeRoot, GetTree string
+		Size, SizeBounds, Marshal, Unmarshal, HashTreeRoot, HashTreeRootSSZ, GetTree, GIndex, Schema string
 	}
 
 	objs := []*Obj{}
@@ -445,12 +572,32 @@ func (e *env) print(order []string) (string, bool, error) {
 			continue
 		}
 
+		if forks := obj.forks(); len(forks) != 0 {
+			// the encoding of the object depends on the fork
+			objs = append(objs, &Obj{
+				HashTreeRoot:    e.hashTreeRootFork(name, obj, forks),
+				HashTreeRootSSZ: e.hashTreeRootSSZFork(name, obj, forks),
+				GetTree:         e.getTreeFork(name, obj),
+				GIndex:          e.gindexFork(name, obj, forks),
+				Marshal:         e.marshalFork(name, obj, forks),
+				Unmarshal:       e.unmarshalFork(name, obj, forks),
+				Size:            e.sizeFork(name, obj, forks),
+				SizeBounds:      e.sizeBoundsFork(name, obj, forks),
+				Schema:          e.schemaFork(name, obj, forks),
+			})
+			continue
+		}
+
 		objs = append(objs, &Obj{
-			HashTreeRoot: e.hashTreeRoot(name, obj),
-			GetTree:      e.getTree(name, obj),
-			Marshal:      e.marshal(name, obj),
-			Unmarshal:    e.unmarshal(name, obj),
-			Size:         e.size(name, obj),
+			HashTreeRoot:    e.hashTreeRoot(name, obj),
+			HashTreeRootSSZ: e.hashTreeRootSSZ(name, obj),
+			GetTree:         e.getTree(name, obj),
+			GIndex:          e.gindex(name, obj),
+			Marshal:         e.marshal(name, obj),
+			Unmarshal:       e.unmarshal(name, obj),
+			Size:            e.size(name, obj),
+			SizeBounds:      e.sizeBounds(name, obj),
+			Schema:          e.schema(name, obj),
 		})
 	}
 	if len(objs) == 0 {
@@ -459,6 +606,20 @@ func (e *env) print(order []string) (string, bool, error) {
 	}
 	data["objs"] = objs
 
+	// The upgrade functions are printed in the file of the target object
+	upgrades := []string{}
+	for _, u := range e.upgrades {
+		if !contains(u.to, order) {
+			continue
+		}
+		str, err := e.upgrade(u)
+		if err != nil {
+			return "", false, err
+		}
+		upgrades = append(upgrades, str)
+	}
+	data["upgrades"] = upgrades
+
 	imports := []string{}
 	for _, v := range valuesImported {
 		imports = appendWithoutRepeated(imports, []string{detectImports(v)})
@@ -473,7 +634,7 @@ func (e *env) print(order []string) (string, bool, error) {
 		data["imports"] = importsStr
 	}
 
-	return execTmpl(tmpl, data), true, nil
+	return strings.ReplaceAll(execTmpl(tmpl, data), sszRef, qualifier), true, nil
 }
 
 func isBasicType(v *Value) bool {
@@ -539,6 +700,7 @@ type astResult struct {
 	objs     []*astStruct
 	funcs    []string
 	alias    []*aliasRef
+	limits   []*aliasRef // types that implement ssz.Limit
 	packName string
 }
 
@@ -604,6 +766,10 @@ func decodeASTStruct(file *ast.File) *astResult {
 			if funcDecl.Recv == nil {
 				continue
 			}
+			if limit, ok := decodeLimitFunc(funcDecl); ok {
+				res.limits = append(res.limits, limit)
+				continue
+			}
 			if expr, ok := funcDecl.Recv.List[0].Type.(*ast.StarExpr); ok {
 				// only allow pointer functions
 				if i, ok := expr.X.(*ast.Ident); ok {
@@ -621,6 +787,16 @@ func decodeASTStruct(file *ast.File) *astResult {
 			res.funcs = append(res.funcs, name)
 		}
 	}
+	if len(res.limits) != 0 {
+		// the limit types are not encoded
+		objs := []*astStruct{}
+		for _, obj := range res.objs {
+			if !res.isLimit(obj.name) {
+				objs = append(objs, obj)
+			}
+		}
+		res.objs = objs
+	}
 	return res
 }
 
@@ -692,6 +868,41 @@ func (a *astImport) match(name string) bool {
 	return filepath.Base(a.path) == name
 }
 
+// castTypePackage returns the name of the package of a 'cast-type' from its
+// import path, resolved from the directory of the source (i.e. the package of
+// gopkg.in/yaml.v2 is yaml).
+func (e *env) castTypePackage(path string) (string, error) {
+	dir := e.source
+	if ok, err := isDir(dir); err != nil || !ok {
+		dir = filepath.Dir(dir)
+	}
+	pkg, err := build.Default.Import(path, dir, 0)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve the package '%s', import it in the source with an alias: %v", path, err)
+	}
+	return pkg.Name, nil
+}
+
+// isSSZSource returns true if the source is the package of the ssz library
+func (e *env) isSSZSource() bool {
+	if e.source == "" || e.packName != "ssz" {
+		return false
+	}
+	dir := e.source
+	if ok, err := isDir(dir); err != nil || !ok {
+		dir = filepath.Dir(dir)
+	}
+	pkg, err := build.Default.Import(sszPackagePath, dir, build.FindOnly)
+	if err != nil {
+		return false
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return false
+	}
+	return pkg.Dir == abs
+}
+
 func trimQuotes(a string) string {
 	return strings.Trim(a, "\"")
 }
@@ -983,6 +1194,9 @@ func (e *env) parseASTStructType(name string) (*Value, error) {
 			continue
 		}
 		elem.name = fieldName
+		if err := parseForkTags(fieldName, tags, elem); err != nil {
+			return nil, err
+		}
 		v.o = append(v.o, elem)
 	}
 
@@ -997,6 +1211,14 @@ func (e *env) parseASTFieldType(name, tags string, expr ast.Expr) (*Value, error
 	}
 
 	switch obj := expr.(type) {
+	case *ast.IndexExpr:
+		// ssz.BitlistOf[L] or ssz.BitvectorOf[N]
+		return e.parseASTGenericType(name, obj, obj.X, []ast.Expr{obj.Index})
+
+	case *ast.IndexListExpr:
+		// ssz.List[T, L] or ssz.Vector[T, N]
+		return e.parseASTGenericType(name, obj, obj.X, obj.Indices)
+
 	case *ast.StarExpr:
 		// *Struct
 		switch elem := obj.X.(type) {
@@ -1079,6 +1301,9 @@ func (e *env) parseASTFieldType(name, tags string, expr ast.Expr) (*Value, error
 		if err != nil {
 			if err == errDimNotFound && outer.isFixed() {
 				// if the item is fixed it does not need dimensions
+				if err := e.parseCastType(name, tags, outer); err != nil {
+					return nil, err
+				}
 				return outer, nil
 			}
 			return nil, fmt.Errorf("%v, tag=%s", err, tags)
@@ -1118,6 +1343,9 @@ func (e *env) parseASTFieldType(name, tags string, expr ast.Expr) (*Value, error
 			outerRef = outerRef.e
 		}
 
+		if err := e.parseCastType(name, tags, outer); err != nil {
+			return nil, err
+		}
 		return outer, nil
 
 	case *ast.Ident:
@@ -1171,7 +1399,7 @@ func (e *env) parseASTFieldType(name, tags string, expr ast.Expr) (*Value, error
 			if !tailDim.IsVector() {
 				return nil, fmt.Errorf("bitvector tag parse failed (no ssz-size for last dim) %s, err=%s", name, err)
 			}
-			return &Value{t: TypeBytes, fixed: true, s: uint64(tailDim.VectorLen())}, nil
+			return &Value{t: TypeBytes, fixed: true, s: uint64(tailDim.VectorLen()), bits: bitvectorBits(sel)}, nil
 		}
 		// external reference
 		vv, err := e.encodeItem(sel, tags)
@@ -1187,6 +1415,70 @@ func (e *env) parseASTFieldType(name, tags string, expr ast.Expr) (*Value, error
 	}
 }
 
+// parseCastType decodes the 'cast-type' tag of the format 'path/to/pkg.Type' and
+// registers the import of the package so that it is included by 'buildImports'.
+func (e *env) parseCastType(name, tags string, v *Value) error {
+	castType, ok := getTags(tags, "cast-type")
+	if !ok {
+		return nil
+	}
+	if v.t != TypeBytes && v.t != TypeBitList {
+		return fmt.Errorf("cast-type for %s is only supported for bytes and bitlists but %s found", name, v.t.String())
+	}
+	if v.c {
+		return fmt.Errorf("cast-type for %s is not supported on fixed arrays", name)
+	}
+
+	indx := strings.LastIndex(castType, ".")
+	if indx <= 0 || indx == len(castType)-1 || strings.HasSuffix(castType[:indx], "/") {
+		return fmt.Errorf("cast-type '%s' for %s is not of the format 'path.Type'", castType, name)
+	}
+	path, obj := castType[:indx], castType[indx+1:]
+
+	var imp *astImport
+	for _, i := range e.imports {
+		if i.path == path {
+			imp = i
+		}
+	}
+	if imp == nil {
+		imp = &astImport{path: path}
+		e.imports = append(e.imports, imp)
+	}
+	if imp.alias == "" {
+		pkgName, err := e.castTypePackage(path)
+		if err != nil {
+			return fmt.Errorf("cast-type for %s: %v", name, err)
+		}
+		if pkgName != filepath.Base(path) {
+			// the name of the package does not match the path, use an alias to reference it
+			imp.alias = pkgName
+		}
+	}
+
+	v.castRef = imp.alias
+	if v.castRef == "" {
+		v.castRef = filepath.Base(path)
+	}
+	v.castObj = obj
+	if v.fixed {
+		v.bits = bitvectorBits(obj)
+	}
+	return nil
+}
+
+// bitvectorBits returns the size in bits of a bitvector type from its name (i.e. Bitvector4)
+func bitvectorBits(name string) uint64 {
+	if !strings.HasPrefix(name, "Bitvector") {
+		return 0
+	}
+	bits, err := strconv.ParseUint(strings.TrimPrefix(name, "Bitvector"), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return bits
+}
+
 func isExportedField(str string) bool {
 	return str[0] <= 90
 }
@@ -0,0 +1,61 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCastTypePackage(t *testing.T) {
+	// the package names are resolved from the module of the source
+	cases := map[string]string{
+		"github.com/prysmaticlabs/go-bitfield": "bitfield",
+		"github.com/minio/sha256-simd":         "sha256",
+		"gopkg.in/yaml.v2":                     "yaml",
+		"github.com/ferranbt/fastssz":          "ssz",
+	}
+	e := &env{source: "."}
+	for path, name := range cases {
+		found, err := e.castTypePackage(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if found != name {
+			t.Fatalf("expected package '%s' for %s but found '%s'", name, path, found)
+		}
+	}
+
+	if _, err := e.castTypePackage("github.com/ferranbt/fastssz/notfound"); err == nil {
+		t.Fatal("expected an error for a package that does not exist")
+	}
+}
+
+func TestIsSSZSource(t *testing.T) {
+	// the objects of the ssz library do not import it
+	if !(&env{source: "../../deposit.go", packName: "ssz"}).isSSZSource() {
+		t.Fatal("expected the ssz library")
+	}
+	if (&env{source: "../testcases", packName: "testcases"}).isSSZSource() {
+		t.Fatal("expected another package")
+	}
+	if (&env{source: "../testcases", packName: "ssz"}).isSSZSource() {
+		t.Fatal("expected another package named ssz")
+	}
+}
+
+func TestGenerateQualifier(t *testing.T) {
+	// another package named ssz imports the ssz library
+	src := []byte("package ssz\n\ntype A struct {\n\tB uint64\n\tC []byte `ssz-max:\"32\"`\n}\n")
+	out, err := Generate(map[string][]byte{"a.go": src}, nil, "_encoding.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := string(out["a_encoding.go"])
+	for _, str := range []string{`ssz "github.com/ferranbt/fastssz"`, "ssz.MarshalUint64(", "ssz.ErrOffset"} {
+		if !strings.Contains(content, str) {
+			t.Fatalf("'%s' not found in the output", str)
+		}
+	}
+	if strings.Contains(content, sszRef) {
+		t.Fatal("the qualifier of the templates is not replaced")
+	}
+}
@@ -0,0 +1,230 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"strconv"
+	"strings"
+)
+
+const sszPackagePath = "github.com/ferranbt/fastssz"
+
+// limitSuffixes are the multipliers of the names of the predefined ssz limits (i.e. ssz.Limit1T)
+var limitSuffixes = map[string]uint64{
+	"":  1,
+	"K": 1 << 10,
+	"M": 1 << 20,
+	"G": 1 << 30,
+	"T": 1 << 40,
+}
+
+// parseSSZLimit returns the value of a predefined limit of the ssz package from its name
+func parseSSZLimit(name string) (uint64, bool) {
+	if !strings.HasPrefix(name, "Limit") {
+		return 0, false
+	}
+	name = strings.TrimPrefix(name, "Limit")
+
+	digits := strings.TrimRight(name, "KMGT")
+	mul, ok := limitSuffixes[name[len(digits):]]
+	if !ok {
+		return 0, false
+	}
+	num, err := strconv.ParseUint(digits, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return num * mul, true
+}
+
+// decodeLimitFunc decodes the 'Limit() uint64' functions that return a constant
+// value which are used to declare custom limits for the generic types
+func decodeLimitFunc(funcDecl *ast.FuncDecl) (*aliasRef, bool) {
+	if funcDecl.Name.Name != "Limit" || funcDecl.Body == nil || len(funcDecl.Body.List) != 1 {
+		return nil, false
+	}
+	if !isSpecificFunc(funcDecl, []string{}, []string{"uint64"}) {
+		return nil, false
+	}
+	recv := funcDecl.Recv.List[0].Type
+	if star, ok := recv.(*ast.StarExpr); ok {
+		recv = star.X
+	}
+	ident, ok := recv.(*ast.Ident)
+	if !ok {
+		return nil, false
+	}
+	ret, ok := funcDecl.Body.List[0].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return nil, false
+	}
+	value, ok := evalUint(ret.Results[0])
+	if !ok {
+		return nil, false
+	}
+	return &aliasRef{name: ident.Name, value: value}, true
+}
+
+// evalUint evaluates a constant integer expression made of literals and the
+// '+', '*' and '<<' operators (i.e. 1 << 40)
+func evalUint(expr ast.Expr) (uint64, bool) {
+	switch obj := expr.(type) {
+	case *ast.BasicLit:
+		if obj.Kind != token.INT {
+			return 0, false
+		}
+		num, err := strconv.ParseUint(obj.Value, 0, 64)
+		return num, err == nil
+
+	case *ast.ParenExpr:
+		return evalUint(obj.X)
+
+	case *ast.BinaryExpr:
+		x, ok := evalUint(obj.X)
+		if !ok {
+			return 0, false
+		}
+		y, ok := evalUint(obj.Y)
+		if !ok {
+			return 0, false
+		}
+		switch obj.Op {
+		case token.ADD:
+			return x + y, true
+		case token.MUL:
+			return x * y, true
+		case token.SHL:
+			if y >= 64 {
+				return 0, false
+			}
+			return x << y, true
+		}
+	}
+	return 0, false
+}
+
+func (a *astResult) isLimit(name string) bool {
+	for _, limit := range a.limits {
+		if limit.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// isSSZPackage returns true if the expression references the fastssz package
+func (e *env) isSSZPackage(expr ast.Expr) bool {
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	for _, i := range e.imports {
+		if i.path == sszPackagePath && i.match(ident.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveLimit returns the value of a limit type parameter. It is either
+// a predefined limit of the ssz package or a type of the same package
+func (e *env) resolveLimit(expr ast.Expr) (uint64, error) {
+	switch obj := expr.(type) {
+	case *ast.SelectorExpr:
+		if e.isSSZPackage(obj.X) {
+			if num, ok := parseSSZLimit(obj.Sel.Name); ok {
+				return num, nil
+			}
+		}
+	case *ast.Ident:
+		for _, res := range e.results {
+			for _, limit := range res.limits {
+				if limit.name == obj.Name {
+					return limit.value, nil
+				}
+			}
+		}
+	}
+	return 0, fmt.Errorf("limit '%s' not found", exprString(expr))
+}
+
+func exprString(expr ast.Expr) string {
+	var buf bytes.Buffer
+	if err := format.Node(&buf, token.NewFileSet(), expr); err != nil {
+		panic(fmt.Errorf("BUG: failed to format expression: %v", err))
+	}
+	return buf.String()
+}
+
+// parseASTGenericType parses the ssz.List, ssz.Vector, ssz.BitlistOf and ssz.BitvectorOf types. The limits are
+// set by the type parameters and the values are the same as the ones of the slices with tags.
+func (e *env) parseASTGenericType(name string, expr ast.Expr, typ ast.Expr, params []ast.Expr) (*Value, error) {
+	sel, ok := typ.(*ast.SelectorExpr)
+	if !ok || !e.isSSZPackage(sel.X) {
+		return nil, fmt.Errorf("generic type '%s' of field %s is not supported", exprString(expr), name)
+	}
+
+	var v *Value
+	switch sel.Sel.Name {
+	case "List", "Vector":
+		if len(params) != 2 {
+			return nil, fmt.Errorf("type '%s' of field %s expects two type parameters", exprString(expr), name)
+		}
+		limit, err := e.resolveLimit(params[1])
+		if err != nil {
+			return nil, fmt.Errorf("type '%s' of field %s: %v", exprString(expr), name, err)
+		}
+
+		isList := sel.Sel.Name == "List"
+		if ident, ok := params[0].(*ast.Ident); ok && (ident.Name == "byte" || ident.Name == "uint8") {
+			// byte list or vector
+			v = &Value{t: TypeBytes, s: limit}
+			if isList {
+				v.m = limit
+			} else {
+				v.fixed = true
+			}
+		} else {
+			elem, err := e.parseASTFieldType(name, "", params[0])
+			if err != nil {
+				return nil, err
+			}
+			v = &Value{t: TypeVector, e: elem, s: limit}
+			if isList {
+				v.t = TypeList
+				v.m = limit
+			}
+		}
+
+	case "BitlistOf":
+		if len(params) != 1 {
+			return nil, fmt.Errorf("type '%s' of field %s expects one type parameter", exprString(expr), name)
+		}
+		limit, err := e.resolveLimit(params[0])
+		if err != nil {
+			return nil, fmt.Errorf("type '%s' of field %s: %v", exprString(expr), name, err)
+		}
+		v = &Value{t: TypeBitList, m: limit, s: limit}
+
+	case "BitvectorOf":
+		if len(params) != 1 {
+			return nil, fmt.Errorf("type '%s' of field %s expects one type parameter", exprString(expr), name)
+		}
+		limit, err := e.resolveLimit(params[0])
+		if err != nil {
+			return nil, fmt.Errorf("type '%s' of field %s: %v", exprString(expr), name, err)
+		}
+		// the bitvector is a fixed byte array with padding bits
+		v = &Value{t: TypeBytes, fixed: true, s: (limit + 7) / 8, bits: limit}
+
+	default:
+		return nil, fmt.Errorf("generic type '%s' of field %s is not supported", exprString(expr), name)
+	}
+
+	// the Go type of the value is used when the value is the element of a list
+	v.obj = exprString(expr)
+	return v, nil
+}
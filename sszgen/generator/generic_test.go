@@ -0,0 +1,34 @@
+package generator
+
+import (
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+func TestParseSSZLimit(t *testing.T) {
+	// the names of the predefined limits match their values
+	limits := map[string]ssz.Limit{
+		"Limit1":     ssz.Limit1{},
+		"Limit48":    ssz.Limit48{},
+		"Limit2048":  ssz.Limit2048{},
+		"Limit65536": ssz.Limit65536{},
+		"Limit1M":    ssz.Limit1M{},
+		"Limit1G":    ssz.Limit1G{},
+		"Limit1T":    ssz.Limit1T{},
+	}
+	for name, limit := range limits {
+		num, ok := parseSSZLimit(name)
+		if !ok {
+			t.Fatalf("%s not parsed", name)
+		}
+		if num != limit.Limit() {
+			t.Fatalf("%s: expected %d but found %d", name, limit.Limit(), num)
+		}
+	}
+	for _, name := range []string{"Limit", "LimitX", "Limit1KM", "Other1"} {
+		if _, ok := parseSSZLimit(name); ok {
+			t.Fatalf("%s should not be parsed", name)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// gindex creates the generalized index constants for the fields of the struct and the
+// typed functions to prove and verify each one of them. The generalized index of a field
+// depends on the position of the field and the depth of the container, which is the same
+// depth used during HashTreeRootWith to merkleize the fields. The generalized index of a field
+// of a nested container is the concatenation of both indices with ssz.ConcatGIndices.
+func (e *env) gindex(name string, v *Value) string {
+	if v.t != TypeContainer || len(v.o) == 0 {
+		return ""
+	}
+
+	depth := getDepth(uint64(len(v.o)))
+
+	// the constants of a fork aware struct are the ones of the default fork
+	atFork := ""
+	if v.forkAware {
+		atFork = " at the " + forkNames[defaultFork] + " fork"
+	}
+
+	consts := []string{}
+	fields := []map[string]interface{}{}
+	for indx, f := range v.o {
+		constName := name + f.name + "GIndex"
+		consts = append(consts, fmt.Sprintf("// %s is the generalized index of the '%s' field%s\n%s $ssz.GIndex = %d", constName, f.name, atFork, constName, 1<<depth+indx))
+
+		fields = append(fields, map[string]interface{}{
+			"field": f.name,
+			"const": constName,
+		})
+	}
+
+	tmpl := `const (
+		{{.consts}}
+	)
+
+	{{range .fields}}
+	// Prove{{.field}} returns a merkle proof of the '{{.field}}' field of the {{$.name}} object
+	func (:: *{{$.name}}) Prove{{.field}}() (*$ssz.Proof, error) {
+		return $ssz.ProveGIndex(::, {{.const}})
+	}
+
+	// Verify{{$.name}}{{.field}} verifies a merkle proof of the '{{.field}}' field of a {{$.name}} object
+	func Verify{{$.name}}{{.field}}(root []byte, proof *$ssz.Proof) (bool, error) {
+		return $ssz.VerifyProofAtGIndex(root, proof, {{.const}})
+	}
+	{{end}}`
+
+	str := execTmpl(tmpl, map[string]interface{}{
+		"name":   name,
+		"consts": strings.Join(consts, "\n"),
+		"fields": fields,
+	})
+	return appendObjSignature(str, v)
+}
+
+// gindexFork creates the generalized index constants and the proof functions of the fields
+// of a fork aware struct at the default fork, plus the functions to get the generalized index
+// of each field, and to prove and verify it, for a given fork. The fields that are not part of
+// the given fork return ssz.ErrForkField.
+func (e *env) gindexFork(name string, v *Value, forks []int) string {
+	if v.t != TypeContainer || len(v.o) == 0 {
+		return ""
+	}
+	str := e.gindex(name, v.atFork(defaultFork))
+
+	fields := []map[string]interface{}{}
+	for _, f := range v.o {
+		fieldName := f.name
+		body := func(v *Value) string {
+			depth := getDepth(uint64(len(v.o)))
+			for indx, f := range v.o {
+				if f.name == fieldName {
+					return fmt.Sprintf("return %d, nil", 1<<depth+indx)
+				}
+			}
+			return "return 0, $ssz.ErrForkField"
+		}
+
+		// the switch is only required if the index changes between forks
+		gindex := body(v.atFork(0))
+		for _, fork := range forks {
+			if body(v.atFork(fork)) != gindex {
+				gindex = forkSwitch(v, forks, body)
+				break
+			}
+		}
+		fields = append(fields, map[string]interface{}{
+			"field":  fieldName,
+			"func":   name + fieldName + "GIndexFork",
+			"gindex": gindex,
+		})
+	}
+
+	tmpl := `{{range .fields}}
+	// {{.func}} returns the generalized index of the '{{.field}}' field for a given fork
+	func {{.func}}(fork $ssz.Fork) ($ssz.GIndex, error) {
+		{{.gindex}}
+	}
+
+	// Prove{{.field}}Fork returns a merkle proof of the '{{.field}}' field of the {{$.name}} object for a given fork
+	func (:: *{{$.name}}) Prove{{.field}}Fork(fork $ssz.Fork) (*$ssz.Proof, error) {
+		gindex, err := {{.func}}(fork)
+		if err != nil {
+			return nil, err
+		}
+		return $ssz.ProveGIndexFork(::, gindex, fork)
+	}
+
+	// Verify{{$.name}}{{.field}}Fork verifies a merkle proof of the '{{.field}}' field of a {{$.name}} object for a given fork
+	func Verify{{$.name}}{{.field}}Fork(root []byte, proof *$ssz.Proof, fork $ssz.Fork) (bool, error) {
+		gindex, err := {{.func}}(fork)
+		if err != nil {
+			return false, err
+		}
+		return $ssz.VerifyProofAtGIndex(root, proof, gindex)
+	}
+	{{end}}`
+
+	str += execTmpl(tmpl, map[string]interface{}{
+		"name":   name,
+		"fields": fields,
+	})
+	return appendObjSignature(str, v)
+}
+
+// getDepth returns the depth of a merkle tree with the given number of leaves
+func getDepth(num uint64) uint8 {
+	var depth uint8
+	for (uint64(1) << depth) < num {
+		depth++
+	}
+	return depth
+}
@@ -9,11 +9,11 @@ import (
 func (e *env) hashTreeRoot(name string, v *Value) string {
 	tmpl := `// HashTreeRoot ssz hashes the {{.name}} object
 	func (:: *{{.name}}) HashTreeRoot() ([32]byte, error) {
-		return ssz.HashWithDefaultHasher(::)
+		return $ssz.HashWithDefaultHasher(::)
 	}
 	
 	// HashTreeRootWith ssz hashes the {{.name}} object with a hasher	
-	func (:: *{{.name}}) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	func (:: *{{.name}}) HashTreeRootWith(hh $ssz.HashWalker) (err error) {
 		{{.hashTreeRoot}}
 		return
 	}`
@@ -34,7 +34,7 @@ func (v *Value) hashRoots(isList bool, elem Type) string {
 	inner := ""
 	if !v.e.c && elem == TypeBytes {
 		inner = `if len(i) != %d {
-			err = ssz.ErrBytesLength
+			err = $ssz.ErrBytesLength
 			return
 		}
 		`
@@ -58,6 +58,11 @@ func (v *Value) hashRoots(isList bool, elem Type) string {
 		// []uint64
 		appendFn = "Append" + uintVToName(v.e)
 		elemSize = uint64(v.e.fixedSize())
+		if v.e.s == 2 {
+			// the HashWalker does not have an AppendUint16 function
+			appendFn = "Append"
+			subName = "$ssz.MarshalUint16(nil, i)"
+		}
 	}
 
 	var merkleize string
@@ -73,7 +78,7 @@ func (v *Value) hashRoots(isList bool, elem Type) string {
 		}
 
 		tmpl := `numItems := uint64(len(::.{{.name}}))
-		hh.MerkleizeWithMixin(subIndx, numItems, {{if .isComplex}} {{.listSize}} {{ else }} ssz.CalculateLimit({{.listSize}}, numItems, {{.elemSize}}) {{ end }})`
+		hh.MerkleizeWithMixin(subIndx, numItems, {{if .isComplex}} {{.listSize}} {{ else }} $ssz.CalculateLimit({{.listSize}}, numItems, {{.elemSize}}) {{ end }})`
 
 		merkleize = execTmpl(tmpl, map[string]interface{}{
 			"name":      v.name,
@@ -129,6 +134,7 @@ func (v *Value) hashTreeRoot(name string, appendBytes bool) string {
 		if v.c {
 			name += "[:]"
 		}
+		name = v.castBytes(name)
 		if v.isFixed() {
 			tmpl := `{{.validate}}hh.PutBytes({{.name}})`
 			return execTmpl(tmpl, map[string]interface{}{
@@ -146,7 +152,7 @@ func (v *Value) hashTreeRoot(name string, appendBytes bool) string {
 	elemIndx := hh.Index()
 	byteLen := uint64(len({{.name}}))
 	if byteLen > {{.maxLen}} {
-		err = ssz.ErrIncorrectListSize
+		err = $ssz.ErrIncorrectListSize
 		return
     }
 	hh.{{.hashMethod}}({{.name}})
@@ -169,21 +175,40 @@ func (v *Value) hashTreeRoot(name string, appendBytes bool) string {
 
 	case TypeBitList:
 		tmpl := `if len({{.name}}) == 0 {
-			err = ssz.ErrEmptyBitlist
+			err = $ssz.ErrEmptyBitlist
 			return
 		}
-		hh.PutBitlist({{.name}}, {{.size}})
+		hh.PutBitlist({{.bytes}}, {{.size}})
 		`
 		return execTmpl(tmpl, map[string]interface{}{
-			"name": name,
-			"size": v.m,
+			"name":  name,
+			"bytes": v.castBytes(name),
+			"size":  v.m,
 		})
 
 	case TypeBool:
 		return fmt.Sprintf("hh.PutBool(%s)", name)
 
 	case TypeVector:
-		return v.hashRoots(false, v.e.t)
+		if v.e.t == TypeUint || v.e.t == TypeBytes {
+			return v.hashRoots(false, v.e.t)
+		}
+
+		// vector of objects
+		tmpl := `{
+			{{.validate}}subIndx := hh.Index()
+			for _, elem := range {{.name}} {
+				if err = elem.{{ if .fork }}HashTreeRootWithFork(hh, fork){{ else }}HashTreeRootWith(hh){{ end }}; err != nil {
+					return
+				}
+			}
+			hh.Merkleize(subIndx)
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"validate": v.validate(),
+			"name":     name,
+			"fork":     v.e.forkAware,
+		})
 
 	case TypeList:
 		if v.e.isFixed() {
@@ -196,7 +221,7 @@ func (v *Value) hashTreeRoot(name string, appendBytes bool) string {
 			subIndx := hh.Index()
 			num := uint64(len({{.name}}))
 			if num > {{.num}} {
-				err = ssz.ErrIncorrectListSize
+				err = $ssz.ErrIncorrectListSize
 				return
 			}
 			for _, elem := range {{.name}} {
@@ -210,10 +235,10 @@ func (v *Value) hashTreeRoot(name string, appendBytes bool) string {
 			// ByteLists should be represented as Value with TypeBytes and .m set instead of .s (isFixed == true)
 			htrCall = v.e.hashTreeRoot(eName, true)
 		} else {
-			htrCall = execTmpl(`if err = elem.HashTreeRootWith(hh); err != nil {
+			htrCall = execTmpl(`if err = elem.{{ if .fork }}HashTreeRootWithFork(hh, fork){{ else }}HashTreeRootWith(hh){{ end }}; err != nil {
 	return
 }`,
-				map[string]interface{}{"name": name})
+				map[string]interface{}{"name": name, "fork": v.e.forkAware})
 		}
 		return execTmpl(tmpl, map[string]interface{}{
 			"name":    name,
@@ -234,7 +259,7 @@ func (v *Value) hashTreeRootContainer(start bool) string {
 		tmpl := `{{ if .check }}if ::.{{.name}} == nil {
 			::.{{.name}} = new({{ref .obj}})
 		}
-		{{ end }}if err = ::.{{.name}}.HashTreeRootWith(hh); err != nil {
+		{{ end }}if err = ::.{{.name}}.{{ if .fork }}HashTreeRootWithFork(hh, fork){{ else }}HashTreeRootWith(hh){{ end }}; err != nil {
 			return
 		}`
 		// validate only for fixed structs
@@ -249,6 +274,7 @@ func (v *Value) hashTreeRootContainer(start bool) string {
 			"name":  v.name,
 			"obj":   v,
 			"check": check,
+			"fork":  v.forkAware,
 		})
 	}
 
@@ -274,3 +300,294 @@ func (v *Value) hashTreeRootContainer(start bool) string {
 		"fields": strings.Join(out, "\n"),
 	})
 }
+
+// hashTreeRootSSZ creates a function that SSZ hashes the encoding of the structs
+// without decoding them
+func (e *env) hashTreeRootSSZ(name string, v *Value) string {
+	tmpl := `// HashTreeRootSSZ ssz hashes the encoded {{.name}} object without decoding it
+	func (:: *{{.name}}) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+		return $ssz.HashSSZWithDefaultHasher(::, buf)
+	}
+
+	// HashTreeRootSSZWith ssz hashes the encoded {{.name}} object with a hasher
+	func (:: *{{.name}}) HashTreeRootSSZWith(hh $ssz.HashWalker, buf []byte) (err error) {
+		{{.hashTreeRoot}}
+		return
+	}`
+
+	data := map[string]interface{}{
+		"name":         name,
+		"hashTreeRoot": v.hashTreeRootSSZContainer(true, "buf"),
+	}
+	str := execTmpl(tmpl, data)
+	return appendObjSignature(str, v)
+}
+
+// hashTreeRootSSZ hashes the value from its encoding in the dst buffer. The encoding
+// is validated as in the unmarshal functions. appendBytes has the same meaning as in hashTreeRoot.
+func (v *Value) hashTreeRootSSZ(dst string, appendBytes bool) string {
+	switch v.t {
+	case TypeContainer, TypeReference:
+		return v.hashTreeRootSSZContainer(false, dst)
+
+	case TypeBytes:
+		if v.isFixed() {
+			validateBits := ""
+			if v.bits != 0 {
+				// bitvector with padding bits
+				validateBits = fmt.Sprintf("if err = $ssz.ValidateBitvector(%s, %d); err != nil {\nreturn err\n}\n", dst, v.bits)
+			}
+			return fmt.Sprintf("%shh.PutBytes(%s)", validateBits, dst)
+		}
+
+		hMethod := "Append"
+		if appendBytes {
+			hMethod = "AppendBytes32"
+		}
+		tmpl := `if len({{.dst}}) > {{.maxLen}} {
+			return $ssz.ErrBytesLength
+		}
+		{
+			elemIndx := hh.Index()
+			hh.{{.hashMethod}}({{.dst}})
+			hh.MerkleizeWithMixin(elemIndx, uint64(len({{.dst}})), ({{.maxLen}}+31)/32)
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"hashMethod": hMethod,
+			"dst":        dst,
+			"maxLen":     v.m,
+		})
+
+	case TypeUint:
+		return fmt.Sprintf("hh.PutUint%d($ssz.Unmarshall%s(%s))", v.fixedSize()*8, uintVToName(v), dst)
+
+	case TypeBitList:
+		tmpl := `if err = $ssz.ValidateBitlist({{.dst}}, {{.size}}); err != nil {
+			return err
+		}
+		hh.PutBitlist({{.dst}}, {{.size}})`
+		return execTmpl(tmpl, map[string]interface{}{
+			"dst":  dst,
+			"size": v.m,
+		})
+
+	case TypeBool:
+		return fmt.Sprintf("if err = $ssz.ValidateBool(%s); err != nil {\nreturn err\n}\nhh.PutBool($ssz.UnmarshalBool(%s))", dst, dst)
+
+	case TypeVector:
+		if v.e.t == TypeUint || v.e.t == TypeBytes {
+			return v.hashRootsSSZ(false, dst)
+		}
+
+		if v.e.isFixed() {
+			// vector of fixed size objects
+			tmpl := `{
+				subIndx := hh.Index()
+				for ii := 0; ii < {{.num}}; ii++ {
+					{{.htrCall}}
+				}
+				hh.Merkleize(subIndx)
+			}`
+			return execTmpl(tmpl, map[string]interface{}{
+				"num":     v.s,
+				"htrCall": v.e.hashTreeRootSSZ(fmt.Sprintf("%s[ii*%d:(ii+1)*%d]", dst, v.e.fixedSize(), v.e.fixedSize()), false),
+			})
+		}
+
+		// vector of dynamic objects, the first offset has to match the size of the vector
+		tmpl := `{
+			subIndx := hh.Index()
+			num, err := $ssz.DecodeDynamicLength({{.dst}}, {{.num}})
+			if err != nil {
+				return err
+			}
+			if num != {{.num}} {
+				return $ssz.ErrVectorLength
+			}
+			err = $ssz.UnmarshalDynamic({{.dst}}, num, func(indx int, buf []byte) (err error) {
+				{{.htrCall}}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			hh.Merkleize(subIndx)
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"dst":     dst,
+			"num":     v.s,
+			"htrCall": v.e.hashTreeRootSSZ("buf", true),
+		})
+
+	case TypeList:
+		if v.e.isFixed() {
+			if v.e.t == TypeUint || v.e.t == TypeBytes {
+				return v.hashRootsSSZ(true, dst)
+			}
+
+			// list of fixed size objects
+			tmpl := `{
+				subIndx := hh.Index()
+				num, err := $ssz.DivideInt2(len({{.dst}}), {{.size}}, {{.num}})
+				if err != nil {
+					return err
+				}
+				for ii := 0; ii < num; ii++ {
+					{{.htrCall}}
+				}
+				hh.MerkleizeWithMixin(subIndx, uint64(num), {{.num}})
+			}`
+			return execTmpl(tmpl, map[string]interface{}{
+				"dst":     dst,
+				"size":    v.e.fixedSize(),
+				"num":     v.m,
+				"htrCall": v.e.hashTreeRootSSZ(fmt.Sprintf("%s[ii*%d:(ii+1)*%d]", dst, v.e.fixedSize(), v.e.fixedSize()), false),
+			})
+		}
+
+		// list of dynamic objects. 'ssz.DecodeDynamicLength' ensures that
+		// the number of elements do not surpass the limit
+		tmpl := `{
+			subIndx := hh.Index()
+			num, err := $ssz.DecodeDynamicLength({{.dst}}, {{.num}})
+			if err != nil {
+				return err
+			}
+			err = $ssz.UnmarshalDynamic({{.dst}}, num, func(indx int, buf []byte) (err error) {
+				{{.htrCall}}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			hh.MerkleizeWithMixin(subIndx, uint64(num), {{.num}})
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"dst":     dst,
+			"num":     v.m,
+			"htrCall": v.e.hashTreeRootSSZ("buf", true),
+		})
+
+	case TypeTime:
+		return fmt.Sprintf("hh.PutUint64($ssz.UnmarshallUint64(%s))", dst)
+
+	default:
+		panic(fmt.Errorf("hash from bytes not implemented for type %s", v.t.String()))
+	}
+}
+
+// hashRootsSSZ hashes the encoding of a vector or a list of unsigned integers or bytes
+func (v *Value) hashRootsSSZ(isList bool, dst string) string {
+	if v.e.t != TypeUint && v.e.t != TypeBytes {
+		panic(fmt.Errorf("hash from bytes not implemented for a vector of %s", v.e.t.String()))
+	}
+
+	elemSize := v.e.fixedSize()
+	if v.e.t == TypeBytes {
+		elemSize = v.e.s
+	}
+
+	tmpl := `{
+		subIndx := hh.Index()
+		{{ if .isList }}num, err := $ssz.DivideInt2(len({{.dst}}), {{.elemSize}}, {{.listSize}})
+		if err != nil {
+			return err
+		}
+		{{ end }}{{ if .putBytes }}for ii := 0; ii < len({{.dst}}); ii += {{.elemSize}} {
+			hh.PutBytes({{.dst}}[ii:ii+{{.elemSize}}])
+		}{{ else }}hh.Append({{.dst}}){{ end }}
+		{{ if .isList }}{{ if .isUint }}hh.FillUpTo32()
+		{{ end }}hh.MerkleizeWithMixin(subIndx, uint64(num), {{ if .isUint }}$ssz.CalculateLimit({{.listSize}}, uint64(num), {{.elemSize}}){{ else }}{{.listSize}}{{ end }}){{ else }}hh.Merkleize(subIndx){{ end }}
+	}`
+	return execTmpl(tmpl, map[string]interface{}{
+		"dst":      dst,
+		"isList":   isList,
+		"isUint":   v.e.t == TypeUint,
+		"putBytes": v.e.t == TypeBytes && v.e.s != 32,
+		"elemSize": elemSize,
+		"listSize": v.s,
+	})
+}
+
+func (v *Value) hashTreeRootSSZContainer(start bool, dst string) string {
+	if !start {
+		if v.t == TypeReference {
+			// the object is not generated, decode it to hash it
+			tmpl := `{
+				obj := new({{ref .obj}})
+				if err = obj.UnmarshalSSZ({{.dst}}); err != nil {
+					return err
+				}
+				if err = obj.HashTreeRootWith(hh); err != nil {
+					return err
+				}
+			}`
+			return execTmpl(tmpl, map[string]interface{}{
+				"obj": v,
+				"dst": dst,
+			})
+		}
+		// the functions do not use the receiver, a typed nil avoids one allocation per element
+		tmpl := `if err = (*{{ref .obj}})(nil).{{ if .fork }}HashTreeRootSSZWithFork(hh, {{.dst}}, fork){{ else }}HashTreeRootSSZWith(hh, {{.dst}}){{ end }}; err != nil {
+			return err
+		}`
+		return execTmpl(tmpl, map[string]interface{}{
+			"obj":  v,
+			"dst":  dst,
+			"fork": v.forkAware,
+		})
+	}
+
+	// validate the size and the offsets before hashing the fields in order
+	offsets, header, reads := v.readOffsets("$ssz", func(indx int, i *Value, dst string) string {
+		return ""
+	})
+
+	outs := []string{}
+	var o0 uint64
+	c := 0
+	for indx, i := range v.o {
+		if i.isFixed() {
+			dst := fmt.Sprintf("buf[%d:%d]", o0, o0+i.fixedSize())
+			o0 += i.fixedSize()
+
+			outs = append(outs, fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, i.hashTreeRootSSZ(dst, false)))
+			continue
+		}
+		o0 += bytesPerLengthOffset
+
+		to := ""
+		if c != len(offsets)-1 {
+			to = offsets[c+1]
+		}
+		tmpl := `// Field ({{.indx}}) '{{.name}}'
+		{
+			buf := tail[{{.from}}:{{.to}}]
+			{{.hashTreeRoot}}
+		}
+		`
+		outs = append(outs, execTmpl(tmpl, map[string]interface{}{
+			"indx":         indx,
+			"name":         i.name,
+			"from":         offsets[c],
+			"to":           to,
+			"hashTreeRoot": i.hashTreeRootSSZ("buf", false),
+		}))
+		c++
+	}
+
+	tmpl := `{{.header}}
+	{{.reads}}
+
+	indx := hh.Index()
+
+	{{.fields}}
+
+	hh.Merkleize(indx)`
+
+	return execTmpl(tmpl, map[string]interface{}{
+		"header": header,
+		"reads":  strings.Join(reads, ""),
+		"fields": strings.Join(outs, "\n"),
+	})
+}
@@ -5,13 +5,16 @@ import (
 	"strings"
 )
 
-// marshal creates a function that encodes the structs in SSZ format. It creates two functions:
+// marshal creates a function that encodes the structs in SSZ format. It creates four functions:
 // 1. MarshalTo(dst []byte) marshals the content to the target array.
 // 2. Marshal() marshals the content to a newly created array.
+// 3. MarshalSSZToStream(dst []byte, sw *ssz.StreamWriter) marshals the content to the target array
+// and flushes it to the stream writer when it is full.
+// 4. MarshalSSZToWriter(writer io.Writer) marshals the content to a writer.
 func (e *env) marshal(name string, v *Value) string {
 	tmpl := `// MarshalSSZ ssz marshals the {{.name}} object
 	func (:: *{{.name}}) MarshalSSZ() ([]byte, error) {
-		return ssz.MarshalSSZ(::)
+		return $ssz.MarshalSSZ(::)
 	}
 
 	// MarshalSSZTo ssz marshals the {{.name}} object to a target array	
@@ -20,11 +23,25 @@ func (e *env) marshal(name string, v *Value) string {
 		{{.offset}}
 		{{.marshal}}
 		return
+	}
+
+	// MarshalSSZToWriter ssz marshals the {{.name}} object to a writer
+	func (:: *{{.name}}) MarshalSSZToWriter(writer io.Writer) (int, error) {
+		return $ssz.MarshalSSZToWriter(::, writer)
+	}
+
+	// MarshalSSZToStream ssz marshals the {{.name}} object to a target array that is flushed to a stream writer
+	func (:: *{{.name}}) MarshalSSZToStream(buf []byte, sw *$ssz.StreamWriter) (dst []byte, err error) {
+		dst = buf
+		{{.offset}}
+		{{.stream}}
+		return
 	}`
 
 	data := map[string]interface{}{
 		"name":    name,
-		"marshal": v.marshalContainer(true),
+		"marshal": v.marshalContainer(true, false),
+		"stream":  v.marshalContainer(true, true),
 		"offset":  "",
 	}
 	if !v.isFixed() {
@@ -35,21 +52,34 @@ func (e *env) marshal(name string, v *Value) string {
 	return appendObjSignature(str, v)
 }
 
-func (v *Value) marshal() string {
+// streamFlush flushes the target array to the stream writer when it is full
+const streamFlush = `if dst, err = sw.Flush(dst); err != nil {
+	return
+}`
+
+// marshal encodes the value. With stream, the value is encoded in
+// a MarshalSSZToStream function with the 'sw' stream writer.
+func (v *Value) marshal(stream bool) string {
 	switch v.t {
 	case TypeContainer, TypeReference:
-		return v.marshalContainer(false)
+		return v.marshalContainer(false, stream)
 
 	case TypeBytes:
-		name := v.name
+		name := "::." + v.name
 		if v.c {
 			name += "[:]"
 		}
-		tmpl := `{{.validate}}dst = append(dst, ::.{{.name}}...)`
+		tmpl := `{{.validate}}dst = append(dst, {{.name}}...)`
+		if stream && !v.isFixed() {
+			// the byte lists can be large, write them without buffering
+			tmpl = `{{.validate}}if dst, err = sw.Append(dst, {{.name}}); err != nil {
+				return
+			}`
+		}
 
 		return execTmpl(tmpl, map[string]interface{}{
 			"validate": v.validate(),
-			"name":     name,
+			"name":     v.castBytes(name),
 		})
 
 	case TypeUint:
@@ -60,32 +90,32 @@ func (v *Value) marshal() string {
 		} else {
 			name = "::." + v.name
 		}
-		return fmt.Sprintf("dst = ssz.Marshal%s(dst, %s)", uintVToName(v), name)
+		return fmt.Sprintf("dst = $ssz.Marshal%s(dst, %s)", uintVToName(v), name)
 
 	case TypeBitList:
-		return fmt.Sprintf("%sdst = append(dst, ::.%s...)", v.validate(), v.name)
+		return fmt.Sprintf("%sdst = append(dst, %s...)", v.validate(), v.castBytes("::."+v.name))
 
 	case TypeBool:
-		return fmt.Sprintf("dst = ssz.MarshalBool(dst, ::.%s)", v.name)
+		return fmt.Sprintf("dst = $ssz.MarshalBool(dst, ::.%s)", v.name)
 
 	case TypeVector:
 		if v.e.isFixed() {
-			return v.marshalVector()
+			return v.marshalVector(stream)
 		}
 		fallthrough
 
 	case TypeList:
-		return v.marshalList()
+		return v.marshalList(stream)
 
 	case TypeTime:
-		return fmt.Sprintf("dst = ssz.MarshalTime(dst, ::.%s)", v.name)
+		return fmt.Sprintf("dst = $ssz.MarshalTime(dst, ::.%s)", v.name)
 
 	default:
 		panic(fmt.Errorf("marshal not implemented for type %s", v.t.String()))
 	}
 }
 
-func (v *Value) marshalList() string {
+func (v *Value) marshalList(stream bool) string {
 	v.e.name = v.name + "[ii]"
 
 	// bound check
@@ -94,10 +124,12 @@ func (v *Value) marshalList() string {
 	if v.e.isFixed() {
 		tmpl := `for ii := 0; ii < len(::.{{.name}}); ii++ {
 			{{.dynamic}}
+			{{.flush}}
 		}`
 		str += execTmpl(tmpl, map[string]interface{}{
 			"name":    v.name,
-			"dynamic": v.e.marshal(),
+			"dynamic": v.e.marshal(stream),
+			"flush":   flushIf(stream),
 		})
 		return str
 	}
@@ -109,44 +141,64 @@ func (v *Value) marshalList() string {
 	tmpl := `{
 		offset = 4 * len(::.{{.name}})
 		for ii := 0; ii < len(::.{{.name}}); ii++ {
-			dst = ssz.WriteOffset(dst, offset)
+			dst = $ssz.WriteOffset(dst, offset)
 			{{.size}}
+			{{.flush}}
 		}
 	}
 	for ii := 0; ii < len(::.{{.name}}); ii++ {
 		{{.marshal}}
+		{{.flush}}
 	}`
 
 	str += execTmpl(tmpl, map[string]interface{}{
 		"name":    v.name,
 		"size":    v.e.size("offset"),
-		"marshal": v.e.marshal(),
+		"marshal": v.e.marshal(stream),
+		"flush":   flushIf(stream),
 	})
 	return str
 }
 
-func (v *Value) marshalVector() (str string) {
+func (v *Value) marshalVector(stream bool) (str string) {
 	v.e.name = fmt.Sprintf("%s[ii]", v.name)
 
 	tmpl := `{{.validate}}for ii := 0; ii < {{.size}}; ii++ {
 		{{.marshal}}
+		{{.flush}}
 	}`
 	return execTmpl(tmpl, map[string]interface{}{
 		"validate": v.validate(),
 		"name":     v.name,
 		"size":     v.s,
-		"marshal":  v.e.marshal(),
+		"marshal":  v.e.marshal(stream),
+		"flush":    flushIf(stream),
 	})
 }
 
-func (v *Value) marshalContainer(start bool) string {
+func flushIf(stream bool) string {
+	if stream {
+		return streamFlush
+	}
+	return ""
+}
+
+func (v *Value) marshalContainer(start bool, stream bool) string {
 	if !start {
 		tmpl := `{{ if .check }}if ::.{{.name}} == nil {
 			::.{{.name}} = new({{ref .obj}})
 		}
-		{{ end }}if dst, err = ::.{{.name}}.MarshalSSZTo(dst); err != nil {
+		{{ end }}if dst, err = ::.{{.name}}.{{ if .fork }}MarshalSSZToFork(dst, fork){{ else }}MarshalSSZTo(dst){{ end }}; err != nil {
 			return
 		}`
+		if stream && v.t == TypeContainer {
+			tmpl = `{{ if .check }}if ::.{{.name}} == nil {
+				::.{{.name}} = new({{ref .obj}})
+			}
+			{{ end }}if dst, err = ::.{{.name}}.{{ if .fork }}MarshalSSZToStreamFork(dst, sw, fork){{ else }}MarshalSSZToStream(dst, sw){{ end }}; err != nil {
+				return
+			}`
+		}
 		// validate only for fixed structs
 		check := v.isFixed()
 		if v.isListElem() {
@@ -159,6 +211,7 @@ func (v *Value) marshalContainer(start bool) string {
 			"name":  v.name,
 			"obj":   v,
 			"check": check,
+			"fork":  v.forkAware,
 		})
 	}
 
@@ -174,10 +227,10 @@ func (v *Value) marshalContainer(start bool) string {
 		var str string
 		if i.isFixed() {
 			// write the content
-			str = fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, i.marshal())
+			str = fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, i.marshal(stream))
 		} else {
 			// write the offset
-			str = fmt.Sprintf("// Offset (%d) '%s'\ndst = ssz.WriteOffset(dst, offset)\n", indx, i.name)
+			str = fmt.Sprintf("// Offset (%d) '%s'\ndst = $ssz.WriteOffset(dst, offset)\n", indx, i.name)
 			// Update the offset for the next variable field.
 			// We don't need to update the offset if the current
 			// field is the last variable field in the container.
@@ -191,7 +244,7 @@ func (v *Value) marshalContainer(start bool) string {
 	// write the dynamic parts
 	for indx, i := range v.o {
 		if !i.isFixed() {
-			out = append(out, fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, i.marshal()))
+			out = append(out, fmt.Sprintf("// Field (%d) '%s'\n%s\n", indx, i.name, i.marshal(stream)))
 		}
 	}
 	return strings.Join(out, "\n")
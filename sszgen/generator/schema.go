@@ -0,0 +1,101 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// schema creates a function that returns the schema of the tree of the struct (see ssz.Schema).
+// The schema is built from the IR at generation time, the nested objects return their own schema.
+func (e *env) schema(name string, v *Value) string {
+	tmpl := `// SchemaSSZ returns the schema of the tree of the {{.name}} object
+	func (:: *{{.name}}) SchemaSSZ() *$ssz.Schema {
+		return {{.schema}}
+	}`
+
+	str := execTmpl(tmpl, map[string]interface{}{
+		"name":   name,
+		"schema": v.schemaContainer(true),
+	})
+	return appendObjSignature(str, v)
+}
+
+func (e *env) schemaFork(name string, v *Value, forks []int) string {
+	tmpl := `// SchemaSSZ returns the schema of the tree of the {{.name}} object
+	func (:: *{{.name}}) SchemaSSZ() *$ssz.Schema {
+		return ::.SchemaSSZFork({{.default}})
+	}
+
+	// SchemaSSZFork returns the schema of the tree of the {{.name}} object for a given fork
+	func (:: *{{.name}}) SchemaSSZFork(fork $ssz.Fork) *$ssz.Schema {
+		{{.schema}}
+	}`
+
+	str := execTmpl(tmpl, map[string]interface{}{
+		"name":    name,
+		"default": forkConst(defaultFork),
+		"schema": forkSwitch(v, forks, func(v *Value) string {
+			return "return " + v.schemaContainer(true)
+		}),
+	})
+	return appendObjSignature(str, v)
+}
+
+func (v *Value) schemaContainer(start bool) string {
+	if !start {
+		if v.t == TypeReference {
+			// the object is not generated, it might not describe its schema
+			return execTmpl(`$ssz.SchemaOf(new({{ref .obj}}))`, map[string]interface{}{
+				"obj": v,
+			})
+		}
+		return execTmpl(`(*{{ref .obj}})(nil).{{ if .fork }}SchemaSSZFork(fork){{ else }}SchemaSSZ(){{ end }}`, map[string]interface{}{
+			"obj":  v,
+			"fork": v.forkAware,
+		})
+	}
+
+	fields := []string{}
+	for _, f := range v.o {
+		fields = append(fields, fmt.Sprintf("&$ssz.SchemaField{Name: \"%s\", Schema: %s},", f.name, f.schema()))
+	}
+	return fmt.Sprintf("$ssz.SchemaContainer(\n%s\n)", strings.Join(fields, "\n"))
+}
+
+// schema returns the expression that builds the schema of the value
+func (v *Value) schema() string {
+	switch v.t {
+	case TypeContainer, TypeReference:
+		return v.schemaContainer(false)
+
+	case TypeBytes:
+		if v.isFixed() {
+			if v.bits != 0 {
+				return fmt.Sprintf("$ssz.SchemaBitvector(%d)", v.bits)
+			}
+			return fmt.Sprintf("$ssz.SchemaBytes(%d)", v.s)
+		}
+		return fmt.Sprintf("$ssz.SchemaList($ssz.SchemaUint(1), %d)", v.m)
+
+	case TypeUint:
+		return fmt.Sprintf("$ssz.SchemaUint(%d)", v.fixedSize())
+
+	case TypeBool:
+		return "$ssz.SchemaBool()"
+
+	case TypeTime:
+		return "$ssz.SchemaUint(8)"
+
+	case TypeBitList:
+		return fmt.Sprintf("$ssz.SchemaBitlist(%d)", v.m)
+
+	case TypeVector:
+		return fmt.Sprintf("$ssz.SchemaVector(%s, %d)", v.e.schema(), v.s)
+
+	case TypeList:
+		return fmt.Sprintf("$ssz.SchemaList(%s, %d)", v.e.schema(), v.m)
+
+	default:
+		panic(fmt.Errorf("schema not implemented for type %s", v.t.String()))
+	}
+}
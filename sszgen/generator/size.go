@@ -64,7 +64,7 @@ func (v *Value) sizeContainer(name string, start bool) string {
 		tmpl := `{{if .check}} if ::.{{.name}} == nil {
 			::.{{.name}} = new({{ref .obj}})
 		}
-		{{end}} {{ .dst }} += ::.{{.name}}.SizeSSZ()`
+		{{end}} {{ .dst }} += ::.{{.name}}.{{ if .fork }}SizeSSZFork(fork){{ else }}SizeSSZ(){{ end }}`
 
 		check := true
 		if v.isListElem() {
@@ -78,6 +78,7 @@ func (v *Value) sizeContainer(name string, start bool) string {
 			"dst":   name,
 			"obj":   v,
 			"check": check,
+			"fork":  v.forkAware,
 		})
 	}
 	out := []string{}
@@ -3,8 +3,8 @@ package generator
 // getTree creates a function that SSZ hashes the structs,
 func (e *env) getTree(name string, v *Value) string {
 	tmpl := `// GetTree ssz hashes the {{.name}} object
-	func (:: *{{.name}}) GetTree() (*ssz.Node, error) {
-		return ssz.ProofTree(::)
+	func (:: *{{.name}}) GetTree() (*$ssz.Node, error) {
+		return $ssz.ProofTree(::)
 	}`
 
 	data := map[string]interface{}{
@@ -7,9 +7,15 @@ import (
 )
 
 // unmarshal creates a function that decodes the structs with the input byte in SSZ format.
+// The decoding options (see ssz.UnmarshalOptions) are passed to the nested objects.
 func (e *env) unmarshal(name string, v *Value) string {
 	tmpl := `// UnmarshalSSZ ssz unmarshals the {{.name}} object
 	func (:: *{{.name}}) UnmarshalSSZ(buf []byte) error {
+		return ::.UnmarshalSSZWithOptions(buf, $ssz.UnmarshalOptions{})
+	}
+
+	// UnmarshalSSZWithOptions ssz unmarshals the {{.name}} object with the decoding options
+	func (:: *{{.name}}) UnmarshalSSZWithOptions(buf []byte, opts $ssz.UnmarshalOptions) error {
 		var err error
 		{{.unmarshal}}
 		return err
@@ -30,60 +36,71 @@ func (v *Value) unmarshal(dst string) string {
 		return v.umarshalContainer(false, dst)
 
 	case TypeBytes:
+		validateBits := ""
+		if v.bits != 0 {
+			// bitvector with padding bits
+			validateBits = fmt.Sprintf("if err = opts.ValidateBitvector(%s, %d); err != nil {\nreturn err\n}\n", dst, v.bits)
+		}
 		if v.c {
-			return fmt.Sprintf("copy(::.%s[:], %s)", v.name, dst)
+			return fmt.Sprintf("%scopy(::.%s[:], %s)", validateBits, v.name, dst)
 		}
 		validate := ""
 		if !v.isFixed() {
 			// dynamic bytes, we need to validate the size of the buffer
-			validate = fmt.Sprintf("if len(%s) > %d { return ssz.ErrBytesLength }\n", dst, v.m)
+			validate = fmt.Sprintf("if len(%s) > %d { return $ssz.ErrBytesLength }\n", dst, v.m)
 		}
 
 		refName := ""
 		if v.ref != "" {
 			refName = v.ref + "." + v.obj
 		}
+		if v.castObj != "" {
+			refName = v.castObjRef()
+		}
 
 		// both fixed and dynamic are decoded equally
-		tmpl := `{{.validate}}if cap(::.{{.name}}) == 0 {
+		tmpl := `{{.validate}}{{.validateBits}}if cap(::.{{.name}}) == 0 {
 			{{if .refName}} ::.{{.name}} = {{ .refName }}(make([]byte, 0, len({{.dst}}))) {{ else }} ::.{{.name}} = make([]byte, 0, len({{.dst}})) {{ end }}
 		}
 		::.{{.name}} = append(::.{{.name}}, {{.dst}}...)`
 		return execTmpl(tmpl, map[string]interface{}{
-			"validate": validate,
-			"name":     v.name,
-			"dst":      dst,
-			"size":     v.m,
-			"refName":  refName,
+			"validate":     validate,
+			"validateBits": validateBits,
+			"name":         v.name,
+			"dst":          dst,
+			"size":         v.m,
+			"refName":      refName,
 		})
 
 	case TypeUint:
 		if v.ref != "" {
 			// alias, we need to cast the value
-			return fmt.Sprintf("::.%s = %s(ssz.Unmarshall%s(%s))", v.name, v.objRef(), uintVToName(v), dst)
+			return fmt.Sprintf("::.%s = %s($ssz.Unmarshall%s(%s))", v.name, v.objRef(), uintVToName(v), dst)
 		}
 		if v.obj != "" {
 			// alias to a type on the same package
-			return fmt.Sprintf("::.%s = %s(ssz.Unmarshall%s(%s))", v.name, v.obj, uintVToName(v), dst)
+			return fmt.Sprintf("::.%s = %s($ssz.Unmarshall%s(%s))", v.name, v.obj, uintVToName(v), dst)
 		}
-		return fmt.Sprintf("::.%s = ssz.Unmarshall%s(%s)", v.name, uintVToName(v), dst)
+		return fmt.Sprintf("::.%s = $ssz.Unmarshall%s(%s)", v.name, uintVToName(v), dst)
 
 	case TypeBitList:
-		tmpl := `if err = ssz.ValidateBitlist({{.dst}}, {{.size}}); err != nil {
+		tmpl := `if err = $ssz.ValidateBitlist({{.dst}}, {{.size}}); err != nil {
 			return err
 		}
 		if cap(::.{{.name}}) == 0 {
-			::.{{.name}} = make([]byte, 0, len({{.dst}}))
+			{{if .refName}} ::.{{.name}} = {{ .refName }}(make([]byte, 0, len({{.dst}}))) {{ else }} ::.{{.name}} = make([]byte, 0, len({{.dst}})) {{ end }}
 		}
 		::.{{.name}} = append(::.{{.name}}, {{.dst}}...)`
 		return execTmpl(tmpl, map[string]interface{}{
-			"name": v.name,
-			"dst":  dst,
-			"size": v.m,
+			"name":    v.name,
+			"dst":     dst,
+			"size":    v.m,
+			"refName": v.castObjRef(),
 		})
 
 	case TypeVector:
 		if v.e.isFixed() {
+			v.e.name = v.name + "[ii]"
 			dst = fmt.Sprintf("%s[ii*%d: (ii+1)*%d]", dst, v.e.fixedSize(), v.e.fixedSize())
 
 			tmpl := `{{.create}}
@@ -102,10 +119,10 @@ func (v *Value) unmarshal(dst string) string {
 		return v.unmarshalList()
 
 	case TypeBool:
-		return fmt.Sprintf("::.%s = ssz.UnmarshalBool(%s)", v.name, dst)
+		return fmt.Sprintf("if err = opts.ValidateBool(%s); err != nil {\nreturn err\n}\n::.%s = $ssz.UnmarshalBool(%s)", dst, v.name, dst)
 
 	case TypeTime:
-		return fmt.Sprintf("::.%s = ssz.UnmarshalTime(%s)", v.name, dst)
+		return fmt.Sprintf("::.%s = $ssz.UnmarshalTime(%s)", v.name, dst)
 
 	default:
 		panic(fmt.Errorf("unmarshal not implemented for type %d", v.t))
@@ -114,9 +131,10 @@ func (v *Value) unmarshal(dst string) string {
 
 func (v *Value) unmarshalList() string {
 	if v.e.isFixed() {
+		v.e.name = v.name + "[ii]"
 		dst := fmt.Sprintf("buf[ii*%d: (ii+1)*%d]", v.e.fixedSize(), v.e.fixedSize())
 
-		tmpl := `num, err := ssz.DivideInt2(len(buf), {{.size}}, {{.max}})
+		tmpl := `num, err := $ssz.DivideInt2(len(buf), {{.size}}, {{.max}})
 		if err != nil {
 			return err
 		}
@@ -132,19 +150,19 @@ func (v *Value) unmarshalList() string {
 		})
 	}
 
-	if v.t == TypeVector {
-		panic("it cannot happen")
-	}
-
-	// Decode list with a dynamic element. 'ssz.DecodeDynamicLength' ensures
-	// that the number of elements do not surpass the 'ssz-max' tag.
+	// Decode list with a dynamic element. 'DecodeDynamicLength' ensures
+	// that the number of elements do not surpass the 'ssz-max' tag. The
+	// vectors must have exactly 'ssz-size' elements.
 
-	tmpl := `num, err := ssz.DecodeDynamicLength(buf, {{.max}})
+	tmpl := `num, err := opts.DecodeDynamicLength(buf, {{.max}})
 	if err != nil {
 		return err
 	}
-	{{.create}}
-	err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+	{{ if .vector }}if num != {{.max}} {
+		return $ssz.ErrVectorLength
+	}
+	{{ end }}{{.create}}
+	err = $ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
 		{{.unmarshal}}
 		return nil
 	})
@@ -156,6 +174,7 @@ func (v *Value) unmarshalList() string {
 
 	data := map[string]interface{}{
 		"max":       v.s,
+		"vector":    v.t == TypeVector,
 		"create":    v.createSlice(true),
 		"unmarshal": v.e.unmarshal("buf"),
 	}
@@ -167,7 +186,7 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 		tmpl := `{{ if .check }}if ::.{{.name}} == nil {
 			::.{{.name}} = new({{ref .obj}})
 		}
-		{{ end }}if err = ::.{{.name}}.UnmarshalSSZ({{.dst}}); err != nil {
+		{{ end }}if err = {{ if .reference }}$ssz.UnmarshalSSZWithOptions({{ if .noPtr }}&{{ end }}::.{{.name}}, {{.dst}}, opts){{ else }}::.{{.name}}.{{ if .fork }}UnmarshalSSZForkWithOptions({{.dst}}, fork, opts){{ else }}UnmarshalSSZWithOptions({{.dst}}, opts){{ end }}{{ end }}; err != nil {
 			return err
 		}`
 		check := true
@@ -175,14 +194,61 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 			check = false
 		}
 		return execTmpl(tmpl, map[string]interface{}{
-			"name":  v.name,
-			"obj":   v,
-			"dst":   dst,
-			"check": check,
+			"name":      v.name,
+			"obj":       v,
+			"dst":       dst,
+			"check":     check,
+			"fork":      v.forkAware,
+			"reference": v.t == TypeReference,
+			"noPtr":     v.noPtr,
 		})
 	}
 
-	var offsets []string
+	offsets, header, outs := v.readOffsets("opts", func(indx int, i *Value, dst string) string {
+		return fmt.Sprintf("// Field (%d) '%s'\n%s\n\n", indx, i.name, i.unmarshal(dst))
+	})
+	str += header
+
+	// Marshal the dynamic parts
+
+	c := 0
+
+	for indx, i := range v.o {
+		if !i.isFixed() {
+			from := offsets[c]
+			var to string
+			if c == len(offsets)-1 {
+				to = ""
+			} else {
+				to = offsets[c+1]
+			}
+			tmpl := `// Field ({{.indx}}) '{{.name}}'
+			{
+				buf = tail[{{.from}}:{{.to}}]
+				{{.unmarshal}}
+			}`
+			res := execTmpl(tmpl, map[string]interface{}{
+				"indx":      indx,
+				"name":      i.name,
+				"from":      from,
+				"to":        to,
+				"unmarshal": i.unmarshal("buf"),
+			})
+			outs = append(outs, res)
+			c++
+		}
+	}
+
+	str += strings.Join(outs, "\n\n")
+	return
+}
+
+// readOffsets validates the size of the input buffer of a container and reads the offsets
+// of its dynamic fields. It returns the names of the offset variables, the code to declare
+// them and the code to read each field of the fixed part where the fixed fields are decoded
+// with the fixed function. The first offset is validated with the ValidateFirstOffset function
+// of the validator (i.e. the decoding options or the ssz package).
+func (v *Value) readOffsets(validator string, fixed func(indx int, i *Value, dst string) string) (offsets []string, header string, outs []string) {
 	offsetsMatch := map[string]string{}
 
 	for indx, i := range v.o {
@@ -210,7 +276,7 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 
 	tmpl := `size := uint64(len(buf))
 	if size {{.cmp}} {{.size}} {
-		return ssz.ErrSize
+		return $ssz.ErrSize
 	}
 	{{if .offsets}}
 		tail := buf
@@ -218,7 +284,7 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 	{{end}}
 	`
 
-	str += execTmpl(tmpl, map[string]interface{}{
+	header = execTmpl(tmpl, map[string]interface{}{
 		"cmp":     cmp,
 		"size":    v.fixedSize(),
 		"offsets": strings.Join(offsets, ", "),
@@ -233,7 +299,6 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 	// as the minimum boundary. subsequent offsets will replace this
 	// value with the name of the previous offset variable.
 	firstOffsetCheck := fmt.Sprintf("%d", v.fixedSize())
-	outs := []string{}
 	for indx, i := range v.o {
 
 		// How much it increases on every item
@@ -244,12 +309,12 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 			incr = bytesPerLengthOffset
 		}
 
-		dst = fmt.Sprintf("%s[%d:%d]", "buf", o0, o0+incr)
+		dst := fmt.Sprintf("%s[%d:%d]", "buf", o0, o0+incr)
 		o0 += incr
 
 		var res string
 		if i.isFixed() {
-			res = fmt.Sprintf("// Field (%d) '%s'\n%s\n\n", indx, i.name, i.unmarshal(dst))
+			res = fixed(indx, i, dst)
 
 		} else {
 			// read the offset
@@ -261,6 +326,7 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 				"offset":           offset,
 				"dst":              dst,
 				"firstOffsetCheck": firstOffsetCheck,
+				"validator":        validator,
 			}
 
 			// We need to do two validations for the offset:
@@ -274,12 +340,12 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 			}
 
 			tmpl := `// Offset ({{.indx}}) '{{.name}}'
-			if {{.offset}} = ssz.ReadOffset({{.dst}}); {{.offset}} > size {{.more}} {
-				return ssz.ErrOffset
+			if {{.offset}} = $ssz.ReadOffset({{.dst}}); {{.offset}} > size {{.more}} {
+				return $ssz.ErrOffset
 			}
 			{{ if .firstOffsetCheck }}
-			if {{.offset}} < {{.firstOffsetCheck}} {
-				return ssz.ErrInvalidVariableOffset
+			if err = {{.validator}}.ValidateFirstOffset({{.offset}}, {{.firstOffsetCheck}}); err != nil {
+				return err
 			}
 			{{ end }}
 			`
@@ -289,37 +355,6 @@ func (v *Value) umarshalContainer(start bool, dst string) (str string) {
 		outs = append(outs, res)
 	}
 
-	// Marshal the dynamic parts
-
-	c := 0
-
-	for indx, i := range v.o {
-		if !i.isFixed() {
-			from := offsets[c]
-			var to string
-			if c == len(offsets)-1 {
-				to = ""
-			} else {
-				to = offsets[c+1]
-			}
-			tmpl := `// Field ({{.indx}}) '{{.name}}'
-			{
-				buf = tail[{{.from}}:{{.to}}]
-				{{.unmarshal}}
-			}`
-			res := execTmpl(tmpl, map[string]interface{}{
-				"indx":      indx,
-				"name":      i.name,
-				"from":      from,
-				"to":        to,
-				"unmarshal": i.unmarshal("buf"),
-			})
-			outs = append(outs, res)
-			c++
-		}
-	}
-
-	str += strings.Join(outs, "\n\n")
 	return
 }
 
@@ -338,7 +373,7 @@ func (v *Value) createSlice(useNumVariable bool) string {
 	switch v.e.t {
 	case TypeUint:
 		// []int uses the Extend functions in the fastssz package
-		return fmt.Sprintf("::.%s = ssz.Extend%s(::.%s, %s)", v.name, uintVToName(v.e), v.name, size)
+		return fmt.Sprintf("::.%s = $ssz.Extend%s(::.%s, %s)", v.name, uintVToName(v.e), v.name, size)
 
 	case TypeContainer:
 		// []*(ref.)Struct{}
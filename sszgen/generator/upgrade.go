@@ -0,0 +1,271 @@
+package generator
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+// upgrade is a conversion between two containers (i.e. the same object in two
+// consecutive forks) requested with the 'upgrade' flag in the format 'From:To'.
+type upgrade struct {
+	from string
+	to   string
+}
+
+func parseUpgrades(list []string) ([]*upgrade, error) {
+	res := []*upgrade{}
+	for _, item := range list {
+		parts := strings.Split(strings.TrimSpace(item), ":")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("upgrade '%s' is not of the format 'From:To'", item)
+		}
+		res = append(res, &upgrade{from: parts[0], to: parts[1]})
+	}
+	return res, nil
+}
+
+// upgrade creates the UpgradeXToY function that converts an object X into a new object Y:
+// 1. Fields with the same name and SSZ type are copied. Nested containers, lists and bytes
+// are deep copied so that both objects do not share memory. The Go representation of the
+// field can be different (i.e. []byte and [32]byte).
+// 2. Fields that only exist in Y are left empty.
+// 3. Fields with the same name and a different SSZ type are not copied and are reported
+// during the generation.
+func (e *env) upgrade(u *upgrade) (string, error) {
+	from, ok := e.objs[u.from]
+	if !ok || from.t != TypeContainer {
+		return "", fmt.Errorf("upgrade source '%s' is not a struct", u.from)
+	}
+	to, ok := e.objs[u.to]
+	if !ok || to.t != TypeContainer {
+		return "", fmt.Errorf("upgrade target '%s' is not a struct", u.to)
+	}
+
+	fromFields := map[string]*Value{}
+	for _, f := range from.o {
+		fromFields[f.name] = f
+	}
+
+	fields := []string{}
+	for _, f := range to.o {
+		src, ok := fromFields[f.name]
+		if !ok {
+			// new field
+			continue
+		}
+		if src.sszType() != f.sszType() {
+			log.Printf("WARN: Field '%s' changed type from %s in %s to %s in %s and it is not copied", f.name, src.sszTypeName(), u.from, f.sszTypeName(), u.to)
+			continue
+		}
+		fields = append(fields, fmt.Sprintf("// Field '%s'\n%s", f.name, copyValue("dst."+f.name, "src."+f.name, f, src, 0)))
+	}
+
+	tmpl := `// Upgrade{{.from}}To{{.to}} creates a {{.to}} object from a {{.from}} object.
+	// The fields with the same name and ssz type are copied and the new fields are empty.
+	func Upgrade{{.from}}To{{.to}}(src *{{.from}}) *{{.to}} {
+		if src == nil {
+			return nil
+		}
+		dst := new({{.to}})
+		{{.fields}}
+		return dst
+	}`
+
+	return execTmpl(tmpl, map[string]interface{}{
+		"from":   u.from,
+		"to":     u.to,
+		"fields": strings.Join(fields, "\n\n"),
+	}), nil
+}
+
+// sszType returns a description of the ssz type of the value that does not depend
+// on the Go representation. Two values with the same description have the same encoding.
+func (v *Value) sszType() string {
+	switch v.t {
+	case TypeUint:
+		return fmt.Sprintf("uint%d", v.s*8)
+	case TypeBool, TypeTime:
+		return v.t.String()
+	case TypeBytes:
+		if v.isFixed() {
+			return fmt.Sprintf("bytes[%d]", v.s)
+		}
+		return fmt.Sprintf("bytes[max=%d]", v.m)
+	case TypeBitList:
+		return fmt.Sprintf("bitlist[max=%d]", v.m)
+	case TypeVector:
+		return fmt.Sprintf("vector[%d](%s)", v.s, v.e.sszType())
+	case TypeList:
+		return fmt.Sprintf("list[max=%d](%s)", v.m, v.e.sszType())
+	case TypeContainer:
+		fields := []string{}
+		for _, f := range v.o {
+			fields = append(fields, f.name+":"+f.sszType())
+		}
+		return "container(" + strings.Join(fields, ",") + ")"
+	case TypeReference:
+		return "reference(" + v.ref + "." + v.obj + ")"
+	default:
+		panic(fmt.Errorf("ssz type not implemented for type %s", v.t.String()))
+	}
+}
+
+// sszTypeName is a short version of sszType used to report errors
+func (v *Value) sszTypeName() string {
+	if v.t == TypeContainer {
+		return "container " + v.obj
+	}
+	return v.sszType()
+}
+
+// isValueType returns true if the Go representation of the value
+// does not share memory when it is assigned
+func (v *Value) isValueType() bool {
+	switch v.t {
+	case TypeUint, TypeBool, TypeTime:
+		return true
+	case TypeBytes, TypeBitList:
+		return v.c
+	case TypeVector:
+		return v.c && v.e.isValueType()
+	case TypeContainer:
+		if !v.noPtr {
+			return false
+		}
+		for _, f := range v.o {
+			if !f.isValueType() {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+// goType returns the Go type of the value
+func (v *Value) goType() string {
+	return v.goTypeWith((*Value).objRef, (*Value).castObjRef)
+}
+
+func (v *Value) goTypeWith(objRef, castObjRef func(v *Value) string) string {
+	if v.castObj != "" {
+		return castObjRef(v)
+	}
+	switch v.t {
+	case TypeContainer, TypeReference:
+		if v.noPtr {
+			return objRef(v)
+		}
+		return "*" + objRef(v)
+	}
+	if v.obj != "" {
+		// alias type
+		return objRef(v)
+	}
+	switch v.t {
+	case TypeUint:
+		return fmt.Sprintf("uint%d", v.s*8)
+	case TypeBool:
+		return "bool"
+	case TypeTime:
+		return "time.Time"
+	case TypeBytes, TypeBitList:
+		if v.c {
+			return fmt.Sprintf("[%d]byte", v.s)
+		}
+		return "[]byte"
+	case TypeVector:
+		if v.c {
+			return fmt.Sprintf("[%d]%s", v.s, v.e.goTypeWith(objRef, castObjRef))
+		}
+		return "[]" + v.e.goTypeWith(objRef, castObjRef)
+	case TypeList:
+		return "[]" + v.e.goTypeWith(objRef, castObjRef)
+	default:
+		panic(fmt.Errorf("go type not implemented for type %s", v.t.String()))
+	}
+}
+
+// sameGoType returns true if both values have the same Go representation
+func sameGoType(a, b *Value) bool {
+	name := func(v *Value) string {
+		if v.ref == "" {
+			return v.obj
+		}
+		return v.ref + "." + v.obj
+	}
+	castName := func(v *Value) string {
+		return v.castRef + "." + v.castObj
+	}
+	return a.goTypeWith(name, castName) == b.goTypeWith(name, castName)
+}
+
+// convert assigns the expression of the src value to dst with
+// a type conversion if the Go representations are different
+func convert(dst, expr string, d, s *Value) string {
+	if sameGoType(d, s) {
+		return fmt.Sprintf("%s = %s", dst, expr)
+	}
+	return fmt.Sprintf("%s = %s(%s)", dst, d.goType(), expr)
+}
+
+// copyValue deep copies the src value into the dst value. Both values have the same
+// ssz type but they might have a different Go representation.
+func copyValue(dst, src string, d, s *Value, depth int) string {
+	switch d.t {
+	case TypeBytes, TypeBitList:
+		if d.c && s.c {
+			return convert(dst, src, d, s)
+		}
+		if d.c {
+			return fmt.Sprintf("copy(%s[:], %s)", dst, src)
+		}
+		if s.c {
+			return convert(dst, fmt.Sprintf("append([]byte{}, %s[:]...)", src), d, &Value{t: TypeBytes})
+		}
+		return convert(dst, fmt.Sprintf("append(%s[:0:0], %s...)", src, src), d, s)
+
+	case TypeContainer:
+		body := []string{}
+		if !d.noPtr {
+			body = append(body, fmt.Sprintf("%s = new(%s)", dst, d.objRef()))
+		}
+		for indx, f := range d.o {
+			body = append(body, copyValue(dst+"."+f.name, src+"."+s.o[indx].name, f, s.o[indx], depth))
+		}
+		str := strings.Join(body, "\n")
+		if !s.noPtr {
+			str = fmt.Sprintf("if %s != nil {\n%s\n}", src, str)
+		}
+		return str
+
+	case TypeVector, TypeList:
+		if d.e.isValueType() && sameGoType(d, s) {
+			if d.c {
+				return fmt.Sprintf("%s = %s", dst, src)
+			}
+			return fmt.Sprintf("%s = append(%s[:0:0], %s...)", dst, src, src)
+		}
+
+		indx := strings.Repeat(string(rune('i'+depth)), 2)
+		elem := copyValue(dst+"["+indx+"]", src+"["+indx+"]", d.e, s.e, depth+1)
+
+		if d.c {
+			if s.c {
+				return fmt.Sprintf("for %s := range %s {\n%s\n}", indx, src, elem)
+			}
+			return fmt.Sprintf("for %s := 0; %s < len(%s) && %s < len(%s); %s++ {\n%s\n}", indx, indx, src, indx, dst, indx, elem)
+		}
+		str := fmt.Sprintf("%s = make(%s, len(%s))\nfor %s := range %s {\n%s\n}", dst, d.goType(), src, indx, src, elem)
+		if !s.c {
+			str = fmt.Sprintf("if %s != nil {\n%s\n}", src, str)
+		}
+		return str
+
+	default:
+		// basic types and references are assigned
+		return convert(dst, src, d, s)
+	}
+}
@@ -15,7 +15,7 @@ func (v *Value) validate() string {
 		}
 
 		tmpl := `if size := len(::.{{.name}}); size {{.cmp}} {{.size}} {
-			err = ssz.ErrBytesLengthFn("--.{{.name}}", size, {{.size}})
+			err = $ssz.ErrBytesLengthFn("--.{{.name}}", size, {{.size}})
 			return
 		}
 		`
@@ -32,7 +32,7 @@ func (v *Value) validate() string {
 		}
 		// We only have vectors for [][]byte roots
 		tmpl := `if size := len(::.{{.name}}); size != {{.size}} {
-			err = ssz.ErrVectorLengthFn("--.{{.name}}", size, {{.size}})
+			err = $ssz.ErrVectorLengthFn("--.{{.name}}", size, {{.size}})
 			return
 		}
 		`
@@ -43,7 +43,7 @@ func (v *Value) validate() string {
 
 	case TypeList:
 		tmpl := `if size := len(::.{{.name}}); size > {{.size}} {
-			err = ssz.ErrListTooBigFn("--.{{.name}}", size, {{.size}})
+			err = $ssz.ErrListTooBigFn("--.{{.name}}", size, {{.size}})
 			return
 		}
 		`
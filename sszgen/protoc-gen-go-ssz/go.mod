@@ -0,0 +1,10 @@
+module github.com/ferranbt/fastssz/sszgen/protoc-gen-go-ssz
+
+go 1.18
+
+require (
+	github.com/ferranbt/fastssz v0.1.3
+	google.golang.org/protobuf v1.33.0
+)
+
+replace github.com/ferranbt/fastssz => ../..
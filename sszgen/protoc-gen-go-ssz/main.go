@@ -0,0 +1,17 @@
+// protoc-gen-go-ssz is a protoc plugin that generates the ssz encodings of the Go
+// structs of protoc-gen-go. The sizes of the fields are set with the field options
+// of sszpb/ssz.proto:
+//
+//	protoc --go_out=. --go-ssz_out=. beacon.proto
+package main
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+func main() {
+	p := &plugin{}
+	protogen.Options{
+		ParamFunc: p.flags().Set,
+	}.Run(p.generate)
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ferranbt/fastssz/sszgen/generator"
+	"github.com/ferranbt/fastssz/sszgen/protoc-gen-go-ssz/sszpb"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+type plugin struct {
+	suffix string
+}
+
+// flags returns the parameters of the plugin, the 'paths' parameter
+// is handled by protogen as in protoc-gen-go
+func (p *plugin) flags() *flag.FlagSet {
+	flags := flag.NewFlagSet("protoc-gen-go-ssz", flag.ContinueOnError)
+	flags.StringVar(&p.suffix, "suffix", "encoding", "suffix of the generated files")
+	return flags
+}
+
+// generate writes the ssz encodings of the proto files to generate
+func (p *plugin) generate(gen *protogen.Plugin) error {
+	suffix := "_" + strings.TrimSuffix(strings.TrimPrefix(p.suffix, "_"), ".go") + ".go"
+
+	for _, file := range gen.Files {
+		if !file.Generate || len(file.Messages) == 0 {
+			continue
+		}
+
+		// the sources are the files of the same package and
+		// the include files are the ones of the other packages
+		sources, include := map[string][]byte{}, map[string][]byte{}
+		for _, f := range gen.Files {
+			if len(f.Messages) == 0 {
+				continue
+			}
+			src, err := goSource(gen, f)
+			if err != nil {
+				if f == file {
+					return err
+				}
+				// only the messages of the file to generate have to be supported
+				continue
+			}
+			if f.GoImportPath == file.GoImportPath {
+				sources[f.GeneratedFilenamePrefix+".go"] = src
+			} else {
+				include[f.Desc.Path()+".go"] = src
+			}
+		}
+
+		out, err := generateSafe(sources, include, suffix)
+		if err != nil {
+			return fmt.Errorf("%s: %v", file.Desc.Path(), err)
+		}
+		output := file.GeneratedFilenamePrefix + suffix
+		content, ok := out[output]
+		if !ok {
+			continue
+		}
+		if _, err := gen.NewGeneratedFile(output, file.GoImportPath).Write(content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// goSource returns the Go source of the structs of the messages of a proto file with the
+// ssz tags of the field options. The structs only have the exported fields of protoc-gen-go
+// and the ssz encodings are generated from them.
+func goSource(gen *protogen.Plugin, file *protogen.File) ([]byte, error) {
+	imports := map[protogen.GoImportPath]protogen.GoPackageName{}
+	var body bytes.Buffer
+
+	var writeMessages func(msgs []*protogen.Message) error
+	writeMessages = func(msgs []*protogen.Message) error {
+		for _, msg := range msgs {
+			if msg.Desc.IsMapEntry() {
+				continue
+			}
+			fmt.Fprintf(&body, "type %s struct {\n", msg.GoIdent.GoName)
+			for _, field := range msg.Fields {
+				typ, err := goType(gen, file, field, imports)
+				if err != nil {
+					return fmt.Errorf("field '%s' of message '%s': %v", field.Desc.Name(), msg.Desc.FullName(), err)
+				}
+				fmt.Fprintf(&body, "\t%s %s%s\n", field.GoName, typ, tags(field))
+			}
+			body.WriteString("}\n\n")
+
+			if err := writeMessages(msg.Messages); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := writeMessages(file.Messages); err != nil {
+		return nil, err
+	}
+
+	var src bytes.Buffer
+	fmt.Fprintf(&src, "package %s\n\n", file.GoPackageName)
+	if len(imports) != 0 {
+		paths := []string{}
+		for path := range imports {
+			paths = append(paths, string(path))
+		}
+		sort.Strings(paths)
+
+		src.WriteString("import (\n")
+		for _, path := range paths {
+			fmt.Fprintf(&src, "\t%s %q\n", imports[protogen.GoImportPath(path)], path)
+		}
+		src.WriteString(")\n\n")
+	}
+	src.Write(body.Bytes())
+	return src.Bytes(), nil
+}
+
+// goType returns the Go type of protoc-gen-go for a field
+func goType(gen *protogen.Plugin, file *protogen.File, field *protogen.Field, imports map[protogen.GoImportPath]protogen.GoPackageName) (string, error) {
+	if field.Desc.HasOptionalKeyword() {
+		return "", fmt.Errorf("optional fields are not supported")
+	}
+	if field.Oneof != nil {
+		return "", fmt.Errorf("oneof fields are not supported")
+	}
+	if field.Desc.IsMap() {
+		return "", fmt.Errorf("maps are not supported")
+	}
+
+	var typ string
+	switch field.Desc.Kind() {
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		typ = "uint64"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		typ = "uint32"
+	case protoreflect.BoolKind:
+		typ = "bool"
+	case protoreflect.BytesKind:
+		typ = "[]byte"
+	case protoreflect.MessageKind:
+		ident := field.Message.GoIdent
+		typ = "*" + ident.GoName
+		if ident.GoImportPath != file.GoImportPath {
+			name := gen.FilesByPath[field.Message.Desc.ParentFile().Path()].GoPackageName
+			for path, other := range imports {
+				if other == name && path != ident.GoImportPath {
+					return "", fmt.Errorf("two imported packages with the name '%s'", name)
+				}
+			}
+			imports[ident.GoImportPath] = name
+			typ = "*" + string(name) + "." + ident.GoName
+		}
+	default:
+		return "", fmt.Errorf("type %s is not supported", field.Desc.Kind())
+	}
+
+	if field.Desc.Cardinality() == protoreflect.Repeated {
+		typ = "[]" + typ
+	}
+	return typ, nil
+}
+
+// tags returns the struct tags of the ssz options of the field
+func tags(field *protogen.Field) string {
+	opts, ok := field.Desc.Options().(*descriptorpb.FieldOptions)
+	if !ok || opts == nil {
+		return ""
+	}
+	tags := []string{}
+	for _, opt := range []struct {
+		tag string
+		ext protoreflect.ExtensionType
+	}{
+		{"ssz-size", sszpb.E_SszSize},
+		{"ssz-max", sszpb.E_SszMax},
+		{"ssz", sszpb.E_Ssz},
+	} {
+		if value := proto.GetExtension(opts, opt.ext).(string); value != "" {
+			tags = append(tags, fmt.Sprintf("%s:%q", opt.tag, value))
+		}
+	}
+	if len(tags) == 0 {
+		return ""
+	}
+	return " `" + strings.Join(tags, " ") + "`"
+}
+
+// generateSafe runs the generator and returns its panics as errors
+func generateSafe(sources, include map[string][]byte, suffix string) (out map[string][]byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	return generator.Generate(sources, include, suffix)
+}
@@ -0,0 +1,229 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ferranbt/fastssz/sszgen/protoc-gen-go-ssz/sszpb"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+type testField struct {
+	name     string
+	typ      descriptorpb.FieldDescriptorProto_Type
+	repeated bool
+	typeName string
+	sszSize  string
+	sszMax   string
+}
+
+func (f *testField) descriptor(num int) *descriptorpb.FieldDescriptorProto {
+	label := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+	if f.repeated {
+		label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+	}
+	field := &descriptorpb.FieldDescriptorProto{
+		Name:   proto.String(f.name),
+		Number: proto.Int32(int32(num)),
+		Label:  label.Enum(),
+		Type:   f.typ.Enum(),
+	}
+	if f.typeName != "" {
+		field.TypeName = proto.String(f.typeName)
+	}
+	if f.sszSize != "" || f.sszMax != "" {
+		field.Options = &descriptorpb.FieldOptions{}
+		if f.sszSize != "" {
+			proto.SetExtension(field.Options, sszpb.E_SszSize, f.sszSize)
+		}
+		if f.sszMax != "" {
+			proto.SetExtension(field.Options, sszpb.E_SszMax, f.sszMax)
+		}
+	}
+	return field
+}
+
+func testMessage(name string, fields ...*testField) *descriptorpb.DescriptorProto {
+	msg := &descriptorpb.DescriptorProto{Name: proto.String(name)}
+	for i, f := range fields {
+		msg.Field = append(msg.Field, f.descriptor(i+1))
+	}
+	return msg
+}
+
+func testFile(name, pkg, goPackage string, deps []string, msgs ...*descriptorpb.DescriptorProto) *descriptorpb.FileDescriptorProto {
+	return &descriptorpb.FileDescriptorProto{
+		Name:        proto.String(name),
+		Package:     proto.String(pkg),
+		Dependency:  deps,
+		MessageType: msgs,
+		Options:     &descriptorpb.FileOptions{GoPackage: proto.String(goPackage)},
+		Syntax:      proto.String("proto3"),
+	}
+}
+
+func runPlugin(t *testing.T, parameter string, filesToGenerate []string, files ...*descriptorpb.FileDescriptorProto) (*pluginpb.CodeGeneratorResponse, error) {
+	t.Helper()
+
+	req := &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: filesToGenerate,
+		ProtoFile:      files,
+	}
+	if parameter != "" {
+		req.Parameter = proto.String(parameter)
+	}
+
+	p := &plugin{}
+	gen, err := protogen.Options{ParamFunc: p.flags().Set}.New(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.generate(gen); err != nil {
+		return nil, err
+	}
+	return gen.Response(), nil
+}
+
+var (
+	typeUint64  = descriptorpb.FieldDescriptorProto_TYPE_UINT64
+	typeBytes   = descriptorpb.FieldDescriptorProto_TYPE_BYTES
+	typeMessage = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+	typeString  = descriptorpb.FieldDescriptorProto_TYPE_STRING
+)
+
+func TestPlugin(t *testing.T) {
+	checkpoint := testMessage("Checkpoint",
+		&testField{name: "epoch", typ: typeUint64},
+		&testField{name: "root", typ: typeBytes, sszSize: "32"},
+	)
+	attestation := testMessage("AttestationData",
+		&testField{name: "slot", typ: typeUint64},
+		&testField{name: "beacon_block_root", typ: typeBytes, sszSize: "32"},
+		&testField{name: "source", typ: typeMessage, typeName: ".eth.Checkpoint"},
+		&testField{name: "target", typ: typeMessage, typeName: ".eth.Checkpoint"},
+		&testField{name: "indices", typ: typeUint64, repeated: true, sszMax: "2048"},
+	)
+	pending := testMessage("PendingAttestation",
+		&testField{name: "data", typ: typeMessage, typeName: ".eth.AttestationData"},
+		&testField{name: "proposer_index", typ: typeUint64},
+	)
+
+	resp, err := runPlugin(t, "paths=source_relative", []string{"eth/attestation.proto"},
+		testFile("eth/checkpoint.proto", "eth", "github.com/a/eth;eth", nil, checkpoint),
+		testFile("eth/attestation.proto", "eth", "github.com/a/eth;eth", []string{"eth/checkpoint.proto"}, attestation, pending),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.File) != 1 {
+		t.Fatalf("expected one file but found %d", len(resp.File))
+	}
+	file := resp.File[0]
+	if file.GetName() != "eth/attestation_encoding.go" {
+		t.Fatalf("bad file name %s", file.GetName())
+	}
+	content := file.GetContent()
+	for _, str := range []string{
+		"package eth",
+		"func (a *AttestationData) MarshalSSZTo(",
+		"func (a *AttestationData) HashTreeRootWith(",
+		"func (p *PendingAttestation) UnmarshalSSZ(",
+		"a.Source.MarshalSSZTo(",
+	} {
+		if !strings.Contains(content, str) {
+			t.Fatalf("'%s' not found in the output", str)
+		}
+	}
+	// the encodings of the other file are not included
+	if strings.Contains(content, "func (c *Checkpoint)") {
+		t.Fatal("Checkpoint should not be in the output")
+	}
+
+	// with the import paths and a custom suffix
+	resp, err = runPlugin(t, "suffix=ssz", []string{"eth/checkpoint.proto"},
+		testFile("eth/checkpoint.proto", "eth", "github.com/a/eth;eth", nil, checkpoint),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name := resp.File[0].GetName(); name != "github.com/a/eth/checkpoint_ssz.go" {
+		t.Fatalf("bad file name %s", name)
+	}
+}
+
+func TestPluginNested(t *testing.T) {
+	outer := testMessage("Outer",
+		&testField{name: "inner", typ: typeMessage, typeName: ".eth.Outer.Inner"},
+	)
+	outer.NestedType = append(outer.NestedType, testMessage("Inner",
+		&testField{name: "root", typ: typeBytes, sszSize: "32"},
+	))
+
+	resp, err := runPlugin(t, "", []string{"eth/outer.proto"},
+		testFile("eth/outer.proto", "eth", "github.com/a/eth", nil, outer),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the nested messages are named as in protoc-gen-go
+	content := resp.File[0].GetContent()
+	if !strings.Contains(content, "func (o *Outer_Inner) MarshalSSZTo(") {
+		t.Fatal("the nested message is not generated")
+	}
+}
+
+func TestPluginImport(t *testing.T) {
+	checkpoint := testMessage("Checkpoint",
+		&testField{name: "epoch", typ: typeUint64},
+		&testField{name: "root", typ: typeBytes, sszSize: "32"},
+	)
+	state := testMessage("State",
+		&testField{name: "finalized", typ: typeMessage, typeName: ".common.Checkpoint"},
+		&testField{name: "checkpoints", typ: typeMessage, typeName: ".common.Checkpoint", repeated: true, sszMax: "16"},
+	)
+
+	resp, err := runPlugin(t, "", []string{"state/state.proto"},
+		testFile("common/checkpoint.proto", "common", "github.com/a/common", nil, checkpoint),
+		testFile("state/state.proto", "state", "github.com/a/state", []string{"common/checkpoint.proto"}, state),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := resp.File[0].GetContent()
+	if !strings.Contains(content, "\"github.com/a/common\"") {
+		t.Fatal("the common package is not imported")
+	}
+	if !strings.Contains(content, "new(common.Checkpoint)") {
+		t.Fatal("the common checkpoint is not decoded")
+	}
+}
+
+func TestPluginErrors(t *testing.T) {
+	cases := []struct {
+		field *testField
+		err   string
+	}{
+		{&testField{name: "name", typ: typeString}, "type string is not supported"},
+		{&testField{name: "root", typ: typeMessage, typeName: ".eth.Unknown"}, "eth.Unknown"},
+	}
+	for _, c := range cases {
+		_, err := runPlugin(t, "", []string{"eth/a.proto"},
+			testFile("eth/a.proto", "eth", "github.com/a/eth", nil, testMessage("A", c.field)),
+		)
+		if err == nil {
+			t.Fatalf("%s: expected an error", c.field.name)
+		}
+		if !strings.Contains(err.Error(), c.err) {
+			t.Fatalf("expected error '%s' but found '%s'", c.err, err)
+		}
+	}
+
+	for _, param := range []string{"paths=unknown", "unknown=1"} {
+		if _, err := runPlugin(t, param, []string{"eth/a.proto"}); err == nil {
+			t.Fatalf("%s: expected an error", param)
+		}
+	}
+}
@@ -0,0 +1,3 @@
+// Package sszpb has the field options of ssz.proto to set the sizes of the fields of
+// the messages encoded by protoc-gen-go-ssz.
+package sszpb
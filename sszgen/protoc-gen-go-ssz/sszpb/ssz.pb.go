@@ -0,0 +1,121 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: sszpb/ssz.proto
+
+package sszpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var file_sszpb_ssz_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         7910,
+		Name:          "ssz.ssz_size",
+		Tag:           "bytes,7910,opt,name=ssz_size",
+		Filename:      "sszpb/ssz.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         7911,
+		Name:          "ssz.ssz_max",
+		Tag:           "bytes,7911,opt,name=ssz_max",
+		Filename:      "sszpb/ssz.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         7912,
+		Name:          "ssz.ssz",
+		Tag:           "bytes,7912,opt,name=ssz",
+		Filename:      "sszpb/ssz.proto",
+	},
+}
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// optional string ssz_size = 7910;
+	E_SszSize = &file_sszpb_ssz_proto_extTypes[0]
+	// optional string ssz_max = 7911;
+	E_SszMax = &file_sszpb_ssz_proto_extTypes[1]
+	// optional string ssz = 7912;
+	E_Ssz = &file_sszpb_ssz_proto_extTypes[2]
+)
+
+var File_sszpb_ssz_proto protoreflect.FileDescriptor
+
+var file_sszpb_ssz_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x73, 0x73, 0x7a, 0x70, 0x62, 0x2f, 0x73, 0x73, 0x7a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x03, 0x73, 0x73, 0x7a, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3a, 0x39, 0x0a, 0x08, 0x73, 0x73, 0x7a, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0xe6, 0x3d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x73, 0x7a, 0x53,
+	0x69, 0x7a, 0x65, 0x3a, 0x37, 0x0a, 0x07, 0x73, 0x73, 0x7a, 0x5f, 0x6d, 0x61, 0x78, 0x12, 0x1d,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xe7, 0x3d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x73, 0x7a, 0x4d, 0x61, 0x78, 0x3a, 0x30, 0x0a, 0x03,
+	0x73, 0x73, 0x7a, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0xe8, 0x3d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x73, 0x7a, 0x42, 0x3c,
+	0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x65, 0x72,
+	0x72, 0x61, 0x6e, 0x62, 0x74, 0x2f, 0x66, 0x61, 0x73, 0x74, 0x73, 0x73, 0x7a, 0x2f, 0x73, 0x73,
+	0x7a, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d,
+	0x67, 0x6f, 0x2d, 0x73, 0x73, 0x7a, 0x2f, 0x73, 0x73, 0x7a, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var file_sszpb_ssz_proto_goTypes = []interface{}{
+	(*descriptorpb.FieldOptions)(nil), // 0: google.protobuf.FieldOptions
+}
+var file_sszpb_ssz_proto_depIdxs = []int32{
+	0, // 0: ssz.ssz_size:extendee -> google.protobuf.FieldOptions
+	0, // 1: ssz.ssz_max:extendee -> google.protobuf.FieldOptions
+	0, // 2: ssz.ssz:extendee -> google.protobuf.FieldOptions
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	0, // [0:3] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_sszpb_ssz_proto_init() }
+func file_sszpb_ssz_proto_init() {
+	if File_sszpb_ssz_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_sszpb_ssz_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 3,
+			NumServices:   0,
+		},
+		GoTypes:           file_sszpb_ssz_proto_goTypes,
+		DependencyIndexes: file_sszpb_ssz_proto_depIdxs,
+		ExtensionInfos:    file_sszpb_ssz_proto_extTypes,
+	}.Build()
+	File_sszpb_ssz_proto = out.File
+	file_sszpb_ssz_proto_rawDesc = nil
+	file_sszpb_ssz_proto_goTypes = nil
+	file_sszpb_ssz_proto_depIdxs = nil
+}
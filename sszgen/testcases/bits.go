@@ -0,0 +1,12 @@
+package testcases
+
+import ssz "github.com/ferranbt/fastssz"
+
+//go:generate go run ../main.go --path bits.go
+
+// Bits is the same object as CastType with the bitfields of the ssz package
+type Bits struct {
+	A ssz.BitvectorOf[ssz.Limit4]
+	B ssz.Bitlist `ssz-max:"2048"`
+	C uint64
+}
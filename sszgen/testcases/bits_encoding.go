@@ -0,0 +1,278 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: a5116fa501cc630fbccab702d4373f9a9b6c60d3fa8ff13ba6fdf9bb0f76daa3
+// Version: 0.1.3
+package testcases
+
+import (
+	"io"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MarshalSSZ ssz marshals the Bits object
+func (b *Bits) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the Bits object to a target array
+func (b *Bits) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(13)
+
+	// Field (0) 'A'
+	if size := len(b.A); size != 1 {
+		err = ssz.ErrBytesLengthFn("Bits.A", size, 1)
+		return
+	}
+	dst = append(dst, b.A...)
+
+	// Offset (1) 'B'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (2) 'C'
+	dst = ssz.MarshalUint64(dst, b.C)
+
+	// Field (1) 'B'
+	if size := len(b.B); size > 2048 {
+		err = ssz.ErrBytesLengthFn("Bits.B", size, 2048)
+		return
+	}
+	dst = append(dst, b.B...)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the Bits object to a writer
+func (b *Bits) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(b, writer)
+}
+
+// MarshalSSZToStream ssz marshals the Bits object to a target array that is flushed to a stream writer
+func (b *Bits) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(13)
+
+	// Field (0) 'A'
+	if size := len(b.A); size != 1 {
+		err = ssz.ErrBytesLengthFn("Bits.A", size, 1)
+		return
+	}
+	dst = append(dst, b.A...)
+
+	// Offset (1) 'B'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (2) 'C'
+	dst = ssz.MarshalUint64(dst, b.C)
+
+	// Field (1) 'B'
+	if size := len(b.B); size > 2048 {
+		err = ssz.ErrBytesLengthFn("Bits.B", size, 2048)
+		return
+	}
+	dst = append(dst, b.B...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Bits object
+func (b *Bits) UnmarshalSSZ(buf []byte) error {
+	return b.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Bits object with the decoding options
+func (b *Bits) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 13 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'A'
+	if err = opts.ValidateBitvector(buf[0:1], 4); err != nil {
+		return err
+	}
+	if cap(b.A) == 0 {
+		b.A = make([]byte, 0, len(buf[0:1]))
+	}
+	b.A = append(b.A, buf[0:1]...)
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[1:5]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = opts.ValidateFirstOffset(o1, 13); err != nil {
+		return err
+	}
+
+	// Field (2) 'C'
+	b.C = ssz.UnmarshallUint64(buf[5:13])
+
+	// Field (1) 'B'
+	{
+		buf = tail[o1:]
+		if err = ssz.ValidateBitlist(buf, 2048); err != nil {
+			return err
+		}
+		if cap(b.B) == 0 {
+			b.B = make([]byte, 0, len(buf))
+		}
+		b.B = append(b.B, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Bits object
+func (b *Bits) SizeSSZ() (size int) {
+	size = 13
+
+	// Field (1) 'B'
+	size += len(b.B)
+
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Bits object
+func (b *Bits) MinSSZSize() uint64 {
+	return 14
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Bits object
+func (b *Bits) MaxSSZSize() uint64 {
+	return 270
+}
+
+// HashTreeRoot ssz hashes the Bits object
+func (b *Bits) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the Bits object with a hasher
+func (b *Bits) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	if size := len(b.A); size != 1 {
+		err = ssz.ErrBytesLengthFn("Bits.A", size, 1)
+		return
+	}
+	hh.PutBytes(b.A)
+
+	// Field (1) 'B'
+	if len(b.B) == 0 {
+		err = ssz.ErrEmptyBitlist
+		return
+	}
+	hh.PutBitlist(b.B, 2048)
+
+	// Field (2) 'C'
+	hh.PutUint64(b.C)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded Bits object without decoding it
+func (b *Bits) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(b, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded Bits object with a hasher
+func (b *Bits) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 13 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[1:5]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = ssz.ValidateFirstOffset(o1, 13); err != nil {
+		return err
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	if err = ssz.ValidateBitvector(buf[0:1], 4); err != nil {
+		return err
+	}
+	hh.PutBytes(buf[0:1])
+
+	// Field (1) 'B'
+	{
+		buf := tail[o1:]
+		if err = ssz.ValidateBitlist(buf, 2048); err != nil {
+			return err
+		}
+		hh.PutBitlist(buf, 2048)
+	}
+
+	// Field (2) 'C'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[5:13]))
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Bits object
+func (b *Bits) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+const (
+	// BitsAGIndex is the generalized index of the 'A' field
+	BitsAGIndex ssz.GIndex = 4
+	// BitsBGIndex is the generalized index of the 'B' field
+	BitsBGIndex ssz.GIndex = 5
+	// BitsCGIndex is the generalized index of the 'C' field
+	BitsCGIndex ssz.GIndex = 6
+)
+
+// ProveA returns a merkle proof of the 'A' field of the Bits object
+func (b *Bits) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BitsAGIndex)
+}
+
+// VerifyBitsA verifies a merkle proof of the 'A' field of a Bits object
+func VerifyBitsA(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BitsAGIndex)
+}
+
+// ProveB returns a merkle proof of the 'B' field of the Bits object
+func (b *Bits) ProveB() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BitsBGIndex)
+}
+
+// VerifyBitsB verifies a merkle proof of the 'B' field of a Bits object
+func VerifyBitsB(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BitsBGIndex)
+}
+
+// ProveC returns a merkle proof of the 'C' field of the Bits object
+func (b *Bits) ProveC() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BitsCGIndex)
+}
+
+// VerifyBitsC verifies a merkle proof of the 'C' field of a Bits object
+func VerifyBitsC(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BitsCGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the Bits object
+func (b *Bits) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: ssz.SchemaBitvector(4)},
+		&ssz.SchemaField{Name: "B", Schema: ssz.SchemaBitlist(2048)},
+		&ssz.SchemaField{Name: "C", Schema: ssz.SchemaUint(8)},
+	)
+}
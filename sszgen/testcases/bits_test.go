@@ -0,0 +1,66 @@
+package testcases
+
+import (
+	"bytes"
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+func TestBits(t *testing.T) {
+	obj := &Bits{A: ssz.NewBitvectorOf[ssz.Limit4](), B: ssz.NewBitlist(10), C: 1}
+	obj.A.SetBitAt(1, true)
+	obj.B.SetBitAt(3, true)
+
+	data, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// same encoding and root as the go-bitfield types
+	cast := &CastType{A: []byte(obj.A), B: []byte(obj.B), C: 1}
+	expected, err := cast.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, expected) {
+		t.Fatal("bad encoding")
+	}
+
+	obj2 := &Bits{}
+	if err := obj2.UnmarshalSSZ(data); err != nil {
+		t.Fatal(err)
+	}
+	if !obj2.A.BitAt(1) || obj2.B.Len() != 10 || !obj2.B.BitAt(3) {
+		t.Fatal("bad bits")
+	}
+
+	root, err := obj2.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedRoot, err := cast.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != expectedRoot {
+		t.Fatal("bad root")
+	}
+}
+
+func TestBits_BitvectorPadding(t *testing.T) {
+	obj := &Bits{A: ssz.NewBitvectorOf[ssz.Limit4](), B: ssz.NewBitlist(10), C: 1}
+	data, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the bitvector of 4 bits is decoded without the padding bits
+	data[0] = 0x10
+	if err := new(Bits).UnmarshalSSZ(data); err != ssz.ErrBitvectorPadding {
+		t.Fatalf("expected padding error but found %v", err)
+	}
+	if _, err := new(Bits).HashTreeRootSSZ(data); err != ssz.ErrBitvectorPadding {
+		t.Fatalf("expected padding error but found %v", err)
+	}
+}
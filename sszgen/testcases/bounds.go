@@ -0,0 +1,25 @@
+package testcases
+
+//go:generate go run ../main.go --path bounds.go
+
+type BoundsFixed struct {
+	A uint64
+	B [32]byte
+}
+
+type BoundsDynamic struct {
+	A *BoundsFixed
+	B []byte           `ssz-max:"64"`
+	C []*BoundsFixed   `ssz-max:"16"`
+	D [][]byte         `ssz-max:"4,8" ssz-size:"?,?"`
+	E []byte           `ssz:"bitlist" ssz-max:"2048"`
+	F []BoundsDynamic2 `ssz-max:"2"`
+}
+
+type BoundsDynamic2 struct {
+	A []byte `ssz-max:"10"`
+}
+
+type BoundsUnbounded struct {
+	A [][]byte `ssz-max:"1099511627776,1099511627776" ssz-size:"?,?"`
+}
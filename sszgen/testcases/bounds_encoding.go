@@ -0,0 +1,1388 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: 60ef13d6d2ea28e1d5ae5e0075c4f0108c89ef7ffa35de44a66e2fda1747c0f5
+// Version: 0.1.3
+package testcases
+
+import (
+	"io"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MarshalSSZ ssz marshals the BoundsFixed object
+func (b *BoundsFixed) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BoundsFixed object to a target array
+func (b *BoundsFixed) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint64(dst, b.A)
+
+	// Field (1) 'B'
+	dst = append(dst, b.B[:]...)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the BoundsFixed object to a writer
+func (b *BoundsFixed) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(b, writer)
+}
+
+// MarshalSSZToStream ssz marshals the BoundsFixed object to a target array that is flushed to a stream writer
+func (b *BoundsFixed) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint64(dst, b.A)
+
+	// Field (1) 'B'
+	dst = append(dst, b.B[:]...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BoundsFixed object
+func (b *BoundsFixed) UnmarshalSSZ(buf []byte) error {
+	return b.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the BoundsFixed object with the decoding options
+func (b *BoundsFixed) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 40 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'A'
+	b.A = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'B'
+	copy(b.B[:], buf[8:40])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BoundsFixed object
+func (b *BoundsFixed) SizeSSZ() (size int) {
+	size = 40
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the BoundsFixed object
+func (b *BoundsFixed) MinSSZSize() uint64 {
+	return 40
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the BoundsFixed object
+func (b *BoundsFixed) MaxSSZSize() uint64 {
+	return 40
+}
+
+// HashTreeRoot ssz hashes the BoundsFixed object
+func (b *BoundsFixed) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BoundsFixed object with a hasher
+func (b *BoundsFixed) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint64(b.A)
+
+	// Field (1) 'B'
+	hh.PutBytes(b.B[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded BoundsFixed object without decoding it
+func (b *BoundsFixed) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(b, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded BoundsFixed object with a hasher
+func (b *BoundsFixed) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 40 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'B'
+	hh.PutBytes(buf[8:40])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the BoundsFixed object
+func (b *BoundsFixed) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+const (
+	// BoundsFixedAGIndex is the generalized index of the 'A' field
+	BoundsFixedAGIndex ssz.GIndex = 2
+	// BoundsFixedBGIndex is the generalized index of the 'B' field
+	BoundsFixedBGIndex ssz.GIndex = 3
+)
+
+// ProveA returns a merkle proof of the 'A' field of the BoundsFixed object
+func (b *BoundsFixed) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BoundsFixedAGIndex)
+}
+
+// VerifyBoundsFixedA verifies a merkle proof of the 'A' field of a BoundsFixed object
+func VerifyBoundsFixedA(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BoundsFixedAGIndex)
+}
+
+// ProveB returns a merkle proof of the 'B' field of the BoundsFixed object
+func (b *BoundsFixed) ProveB() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BoundsFixedBGIndex)
+}
+
+// VerifyBoundsFixedB verifies a merkle proof of the 'B' field of a BoundsFixed object
+func VerifyBoundsFixedB(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BoundsFixedBGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the BoundsFixed object
+func (b *BoundsFixed) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "B", Schema: ssz.SchemaBytes(32)},
+	)
+}
+
+// MarshalSSZ ssz marshals the BoundsDynamic object
+func (b *BoundsDynamic) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BoundsDynamic object to a target array
+func (b *BoundsDynamic) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(60)
+
+	// Field (0) 'A'
+	if b.A == nil {
+		b.A = new(BoundsFixed)
+	}
+	if dst, err = b.A.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Offset (1) 'B'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.B)
+
+	// Offset (2) 'C'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.C) * 40
+
+	// Offset (3) 'D'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.D); ii++ {
+		offset += 4
+		offset += len(b.D[ii])
+	}
+
+	// Offset (4) 'E'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.E)
+
+	// Offset (5) 'F'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'B'
+	if size := len(b.B); size > 64 {
+		err = ssz.ErrBytesLengthFn("BoundsDynamic.B", size, 64)
+		return
+	}
+	dst = append(dst, b.B...)
+
+	// Field (2) 'C'
+	if size := len(b.C); size > 16 {
+		err = ssz.ErrListTooBigFn("BoundsDynamic.C", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.C); ii++ {
+		if dst, err = b.C[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+	}
+
+	// Field (3) 'D'
+	if size := len(b.D); size > 4 {
+		err = ssz.ErrListTooBigFn("BoundsDynamic.D", size, 4)
+		return
+	}
+	{
+		offset = 4 * len(b.D)
+		for ii := 0; ii < len(b.D); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += len(b.D[ii])
+
+		}
+	}
+	for ii := 0; ii < len(b.D); ii++ {
+		if size := len(b.D[ii]); size > 8 {
+			err = ssz.ErrBytesLengthFn("BoundsDynamic.D[ii]", size, 8)
+			return
+		}
+		dst = append(dst, b.D[ii]...)
+
+	}
+
+	// Field (4) 'E'
+	if size := len(b.E); size > 2048 {
+		err = ssz.ErrBytesLengthFn("BoundsDynamic.E", size, 2048)
+		return
+	}
+	dst = append(dst, b.E...)
+
+	// Field (5) 'F'
+	if size := len(b.F); size > 2 {
+		err = ssz.ErrListTooBigFn("BoundsDynamic.F", size, 2)
+		return
+	}
+	{
+		offset = 4 * len(b.F)
+		for ii := 0; ii < len(b.F); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.F[ii].SizeSSZ()
+
+		}
+	}
+	for ii := 0; ii < len(b.F); ii++ {
+		if dst, err = b.F[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the BoundsDynamic object to a writer
+func (b *BoundsDynamic) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(b, writer)
+}
+
+// MarshalSSZToStream ssz marshals the BoundsDynamic object to a target array that is flushed to a stream writer
+func (b *BoundsDynamic) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(60)
+
+	// Field (0) 'A'
+	if b.A == nil {
+		b.A = new(BoundsFixed)
+	}
+	if dst, err = b.A.MarshalSSZToStream(dst, sw); err != nil {
+		return
+	}
+
+	// Offset (1) 'B'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.B)
+
+	// Offset (2) 'C'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.C) * 40
+
+	// Offset (3) 'D'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.D); ii++ {
+		offset += 4
+		offset += len(b.D[ii])
+	}
+
+	// Offset (4) 'E'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.E)
+
+	// Offset (5) 'F'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'B'
+	if size := len(b.B); size > 64 {
+		err = ssz.ErrBytesLengthFn("BoundsDynamic.B", size, 64)
+		return
+	}
+	if dst, err = sw.Append(dst, b.B); err != nil {
+		return
+	}
+
+	// Field (2) 'C'
+	if size := len(b.C); size > 16 {
+		err = ssz.ErrListTooBigFn("BoundsDynamic.C", size, 16)
+		return
+	}
+	for ii := 0; ii < len(b.C); ii++ {
+		if dst, err = b.C[ii].MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (3) 'D'
+	if size := len(b.D); size > 4 {
+		err = ssz.ErrListTooBigFn("BoundsDynamic.D", size, 4)
+		return
+	}
+	{
+		offset = 4 * len(b.D)
+		for ii := 0; ii < len(b.D); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += len(b.D[ii])
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+	}
+	for ii := 0; ii < len(b.D); ii++ {
+		if size := len(b.D[ii]); size > 8 {
+			err = ssz.ErrBytesLengthFn("BoundsDynamic.D[ii]", size, 8)
+			return
+		}
+		if dst, err = sw.Append(dst, b.D[ii]); err != nil {
+			return
+		}
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (4) 'E'
+	if size := len(b.E); size > 2048 {
+		err = ssz.ErrBytesLengthFn("BoundsDynamic.E", size, 2048)
+		return
+	}
+	dst = append(dst, b.E...)
+
+	// Field (5) 'F'
+	if size := len(b.F); size > 2 {
+		err = ssz.ErrListTooBigFn("BoundsDynamic.F", size, 2)
+		return
+	}
+	{
+		offset = 4 * len(b.F)
+		for ii := 0; ii < len(b.F); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.F[ii].SizeSSZ()
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+	}
+	for ii := 0; ii < len(b.F); ii++ {
+		if dst, err = b.F[ii].MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BoundsDynamic object
+func (b *BoundsDynamic) UnmarshalSSZ(buf []byte) error {
+	return b.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the BoundsDynamic object with the decoding options
+func (b *BoundsDynamic) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 60 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1, o2, o3, o4, o5 uint64
+
+	// Field (0) 'A'
+	if b.A == nil {
+		b.A = new(BoundsFixed)
+	}
+	if err = b.A.UnmarshalSSZWithOptions(buf[0:40], opts); err != nil {
+		return err
+	}
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[40:44]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = opts.ValidateFirstOffset(o1, 60); err != nil {
+		return err
+	}
+
+	// Offset (2) 'C'
+	if o2 = ssz.ReadOffset(buf[44:48]); o2 > size || o1 > o2 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (3) 'D'
+	if o3 = ssz.ReadOffset(buf[48:52]); o3 > size || o2 > o3 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (4) 'E'
+	if o4 = ssz.ReadOffset(buf[52:56]); o4 > size || o3 > o4 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (5) 'F'
+	if o5 = ssz.ReadOffset(buf[56:60]); o5 > size || o4 > o5 {
+		return ssz.ErrOffset
+	}
+
+	// Field (1) 'B'
+	{
+		buf = tail[o1:o2]
+		if len(buf) > 64 {
+			return ssz.ErrBytesLength
+		}
+		if cap(b.B) == 0 {
+			b.B = make([]byte, 0, len(buf))
+		}
+		b.B = append(b.B, buf...)
+	}
+
+	// Field (2) 'C'
+	{
+		buf = tail[o2:o3]
+		num, err := ssz.DivideInt2(len(buf), 40, 16)
+		if err != nil {
+			return err
+		}
+		b.C = make([]*BoundsFixed, num)
+		for ii := 0; ii < num; ii++ {
+			if b.C[ii] == nil {
+				b.C[ii] = new(BoundsFixed)
+			}
+			if err = b.C[ii].UnmarshalSSZWithOptions(buf[ii*40:(ii+1)*40], opts); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (3) 'D'
+	{
+		buf = tail[o3:o4]
+		num, err := opts.DecodeDynamicLength(buf, 4)
+		if err != nil {
+			return err
+		}
+		b.D = make([][]byte, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if len(buf) > 8 {
+				return ssz.ErrBytesLength
+			}
+			if cap(b.D[indx]) == 0 {
+				b.D[indx] = make([]byte, 0, len(buf))
+			}
+			b.D[indx] = append(b.D[indx], buf...)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (4) 'E'
+	{
+		buf = tail[o4:o5]
+		if err = ssz.ValidateBitlist(buf, 2048); err != nil {
+			return err
+		}
+		if cap(b.E) == 0 {
+			b.E = make([]byte, 0, len(buf))
+		}
+		b.E = append(b.E, buf...)
+	}
+
+	// Field (5) 'F'
+	{
+		buf = tail[o5:]
+		num, err := opts.DecodeDynamicLength(buf, 2)
+		if err != nil {
+			return err
+		}
+		b.F = make([]BoundsDynamic2, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if err = b.F[indx].UnmarshalSSZWithOptions(buf, opts); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BoundsDynamic object
+func (b *BoundsDynamic) SizeSSZ() (size int) {
+	size = 60
+
+	// Field (1) 'B'
+	size += len(b.B)
+
+	// Field (2) 'C'
+	size += len(b.C) * 40
+
+	// Field (3) 'D'
+	for ii := 0; ii < len(b.D); ii++ {
+		size += 4
+		size += len(b.D[ii])
+	}
+
+	// Field (4) 'E'
+	size += len(b.E)
+
+	// Field (5) 'F'
+	for ii := 0; ii < len(b.F); ii++ {
+		size += 4
+		size += b.F[ii].SizeSSZ()
+	}
+
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the BoundsDynamic object
+func (b *BoundsDynamic) MinSSZSize() uint64 {
+	return 61
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the BoundsDynamic object
+func (b *BoundsDynamic) MaxSSZSize() uint64 {
+	return 1105
+}
+
+// HashTreeRoot ssz hashes the BoundsDynamic object
+func (b *BoundsDynamic) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BoundsDynamic object with a hasher
+func (b *BoundsDynamic) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	if b.A == nil {
+		b.A = new(BoundsFixed)
+	}
+	if err = b.A.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'B'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(b.B))
+		if byteLen > 64 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(b.B)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (64+31)/32)
+	}
+
+	// Field (2) 'C'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.C))
+		if num > 16 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.C {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 16)
+	}
+
+	// Field (3) 'D'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.D))
+		if num > 4 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.D {
+			{
+				elemIndx := hh.Index()
+				byteLen := uint64(len(elem))
+				if byteLen > 8 {
+					err = ssz.ErrIncorrectListSize
+					return
+				}
+				hh.AppendBytes32(elem)
+				hh.MerkleizeWithMixin(elemIndx, byteLen, (8+31)/32)
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 4)
+	}
+
+	// Field (4) 'E'
+	if len(b.E) == 0 {
+		err = ssz.ErrEmptyBitlist
+		return
+	}
+	hh.PutBitlist(b.E, 2048)
+
+	// Field (5) 'F'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.F))
+		if num > 2 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.F {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 2)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded BoundsDynamic object without decoding it
+func (b *BoundsDynamic) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(b, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded BoundsDynamic object with a hasher
+func (b *BoundsDynamic) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 60 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1, o2, o3, o4, o5 uint64
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[40:44]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = ssz.ValidateFirstOffset(o1, 60); err != nil {
+		return err
+	}
+
+	// Offset (2) 'C'
+	if o2 = ssz.ReadOffset(buf[44:48]); o2 > size || o1 > o2 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (3) 'D'
+	if o3 = ssz.ReadOffset(buf[48:52]); o3 > size || o2 > o3 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (4) 'E'
+	if o4 = ssz.ReadOffset(buf[52:56]); o4 > size || o3 > o4 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (5) 'F'
+	if o5 = ssz.ReadOffset(buf[56:60]); o5 > size || o4 > o5 {
+		return ssz.ErrOffset
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	if err = (*BoundsFixed)(nil).HashTreeRootSSZWith(hh, buf[0:40]); err != nil {
+		return err
+	}
+
+	// Field (1) 'B'
+	{
+		buf := tail[o1:o2]
+		if len(buf) > 64 {
+			return ssz.ErrBytesLength
+		}
+		{
+			elemIndx := hh.Index()
+			hh.Append(buf)
+			hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (64+31)/32)
+		}
+	}
+
+	// Field (2) 'C'
+	{
+		buf := tail[o2:o3]
+		{
+			subIndx := hh.Index()
+			num, err := ssz.DivideInt2(len(buf), 40, 16)
+			if err != nil {
+				return err
+			}
+			for ii := 0; ii < num; ii++ {
+				if err = (*BoundsFixed)(nil).HashTreeRootSSZWith(hh, buf[ii*40:(ii+1)*40]); err != nil {
+					return err
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, uint64(num), 16)
+		}
+	}
+
+	// Field (3) 'D'
+	{
+		buf := tail[o3:o4]
+		{
+			subIndx := hh.Index()
+			num, err := ssz.DecodeDynamicLength(buf, 4)
+			if err != nil {
+				return err
+			}
+			err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+				if len(buf) > 8 {
+					return ssz.ErrBytesLength
+				}
+				{
+					elemIndx := hh.Index()
+					hh.AppendBytes32(buf)
+					hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (8+31)/32)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			hh.MerkleizeWithMixin(subIndx, uint64(num), 4)
+		}
+	}
+
+	// Field (4) 'E'
+	{
+		buf := tail[o4:o5]
+		if err = ssz.ValidateBitlist(buf, 2048); err != nil {
+			return err
+		}
+		hh.PutBitlist(buf, 2048)
+	}
+
+	// Field (5) 'F'
+	{
+		buf := tail[o5:]
+		{
+			subIndx := hh.Index()
+			num, err := ssz.DecodeDynamicLength(buf, 2)
+			if err != nil {
+				return err
+			}
+			err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+				if err = (*BoundsDynamic2)(nil).HashTreeRootSSZWith(hh, buf); err != nil {
+					return err
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			hh.MerkleizeWithMixin(subIndx, uint64(num), 2)
+		}
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the BoundsDynamic object
+func (b *BoundsDynamic) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+const (
+	// BoundsDynamicAGIndex is the generalized index of the 'A' field
+	BoundsDynamicAGIndex ssz.GIndex = 8
+	// BoundsDynamicBGIndex is the generalized index of the 'B' field
+	BoundsDynamicBGIndex ssz.GIndex = 9
+	// BoundsDynamicCGIndex is the generalized index of the 'C' field
+	BoundsDynamicCGIndex ssz.GIndex = 10
+	// BoundsDynamicDGIndex is the generalized index of the 'D' field
+	BoundsDynamicDGIndex ssz.GIndex = 11
+	// BoundsDynamicEGIndex is the generalized index of the 'E' field
+	BoundsDynamicEGIndex ssz.GIndex = 12
+	// BoundsDynamicFGIndex is the generalized index of the 'F' field
+	BoundsDynamicFGIndex ssz.GIndex = 13
+)
+
+// ProveA returns a merkle proof of the 'A' field of the BoundsDynamic object
+func (b *BoundsDynamic) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BoundsDynamicAGIndex)
+}
+
+// VerifyBoundsDynamicA verifies a merkle proof of the 'A' field of a BoundsDynamic object
+func VerifyBoundsDynamicA(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BoundsDynamicAGIndex)
+}
+
+// ProveB returns a merkle proof of the 'B' field of the BoundsDynamic object
+func (b *BoundsDynamic) ProveB() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BoundsDynamicBGIndex)
+}
+
+// VerifyBoundsDynamicB verifies a merkle proof of the 'B' field of a BoundsDynamic object
+func VerifyBoundsDynamicB(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BoundsDynamicBGIndex)
+}
+
+// ProveC returns a merkle proof of the 'C' field of the BoundsDynamic object
+func (b *BoundsDynamic) ProveC() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BoundsDynamicCGIndex)
+}
+
+// VerifyBoundsDynamicC verifies a merkle proof of the 'C' field of a BoundsDynamic object
+func VerifyBoundsDynamicC(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BoundsDynamicCGIndex)
+}
+
+// ProveD returns a merkle proof of the 'D' field of the BoundsDynamic object
+func (b *BoundsDynamic) ProveD() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BoundsDynamicDGIndex)
+}
+
+// VerifyBoundsDynamicD verifies a merkle proof of the 'D' field of a BoundsDynamic object
+func VerifyBoundsDynamicD(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BoundsDynamicDGIndex)
+}
+
+// ProveE returns a merkle proof of the 'E' field of the BoundsDynamic object
+func (b *BoundsDynamic) ProveE() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BoundsDynamicEGIndex)
+}
+
+// VerifyBoundsDynamicE verifies a merkle proof of the 'E' field of a BoundsDynamic object
+func VerifyBoundsDynamicE(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BoundsDynamicEGIndex)
+}
+
+// ProveF returns a merkle proof of the 'F' field of the BoundsDynamic object
+func (b *BoundsDynamic) ProveF() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BoundsDynamicFGIndex)
+}
+
+// VerifyBoundsDynamicF verifies a merkle proof of the 'F' field of a BoundsDynamic object
+func VerifyBoundsDynamicF(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BoundsDynamicFGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the BoundsDynamic object
+func (b *BoundsDynamic) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: (*BoundsFixed)(nil).SchemaSSZ()},
+		&ssz.SchemaField{Name: "B", Schema: ssz.SchemaList(ssz.SchemaUint(1), 64)},
+		&ssz.SchemaField{Name: "C", Schema: ssz.SchemaList((*BoundsFixed)(nil).SchemaSSZ(), 16)},
+		&ssz.SchemaField{Name: "D", Schema: ssz.SchemaList(ssz.SchemaList(ssz.SchemaUint(1), 8), 4)},
+		&ssz.SchemaField{Name: "E", Schema: ssz.SchemaBitlist(2048)},
+		&ssz.SchemaField{Name: "F", Schema: ssz.SchemaList((*BoundsDynamic2)(nil).SchemaSSZ(), 2)},
+	)
+}
+
+// MarshalSSZ ssz marshals the BoundsDynamic2 object
+func (b *BoundsDynamic2) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BoundsDynamic2 object to a target array
+func (b *BoundsDynamic2) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
+
+	// Offset (0) 'A'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'A'
+	if size := len(b.A); size > 10 {
+		err = ssz.ErrBytesLengthFn("BoundsDynamic2.A", size, 10)
+		return
+	}
+	dst = append(dst, b.A...)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the BoundsDynamic2 object to a writer
+func (b *BoundsDynamic2) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(b, writer)
+}
+
+// MarshalSSZToStream ssz marshals the BoundsDynamic2 object to a target array that is flushed to a stream writer
+func (b *BoundsDynamic2) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
+
+	// Offset (0) 'A'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'A'
+	if size := len(b.A); size > 10 {
+		err = ssz.ErrBytesLengthFn("BoundsDynamic2.A", size, 10)
+		return
+	}
+	if dst, err = sw.Append(dst, b.A); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BoundsDynamic2 object
+func (b *BoundsDynamic2) UnmarshalSSZ(buf []byte) error {
+	return b.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the BoundsDynamic2 object with the decoding options
+func (b *BoundsDynamic2) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'A'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = opts.ValidateFirstOffset(o0, 4); err != nil {
+		return err
+	}
+
+	// Field (0) 'A'
+	{
+		buf = tail[o0:]
+		if len(buf) > 10 {
+			return ssz.ErrBytesLength
+		}
+		if cap(b.A) == 0 {
+			b.A = make([]byte, 0, len(buf))
+		}
+		b.A = append(b.A, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BoundsDynamic2 object
+func (b *BoundsDynamic2) SizeSSZ() (size int) {
+	size = 4
+
+	// Field (0) 'A'
+	size += len(b.A)
+
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the BoundsDynamic2 object
+func (b *BoundsDynamic2) MinSSZSize() uint64 {
+	return 4
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the BoundsDynamic2 object
+func (b *BoundsDynamic2) MaxSSZSize() uint64 {
+	return 14
+}
+
+// HashTreeRoot ssz hashes the BoundsDynamic2 object
+func (b *BoundsDynamic2) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BoundsDynamic2 object with a hasher
+func (b *BoundsDynamic2) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(b.A))
+		if byteLen > 10 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(b.A)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (10+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded BoundsDynamic2 object without decoding it
+func (b *BoundsDynamic2) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(b, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded BoundsDynamic2 object with a hasher
+func (b *BoundsDynamic2) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'A'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = ssz.ValidateFirstOffset(o0, 4); err != nil {
+		return err
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	{
+		buf := tail[o0:]
+		if len(buf) > 10 {
+			return ssz.ErrBytesLength
+		}
+		{
+			elemIndx := hh.Index()
+			hh.Append(buf)
+			hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (10+31)/32)
+		}
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the BoundsDynamic2 object
+func (b *BoundsDynamic2) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+const (
+	// BoundsDynamic2AGIndex is the generalized index of the 'A' field
+	BoundsDynamic2AGIndex ssz.GIndex = 1
+)
+
+// ProveA returns a merkle proof of the 'A' field of the BoundsDynamic2 object
+func (b *BoundsDynamic2) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BoundsDynamic2AGIndex)
+}
+
+// VerifyBoundsDynamic2A verifies a merkle proof of the 'A' field of a BoundsDynamic2 object
+func VerifyBoundsDynamic2A(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BoundsDynamic2AGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the BoundsDynamic2 object
+func (b *BoundsDynamic2) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: ssz.SchemaList(ssz.SchemaUint(1), 10)},
+	)
+}
+
+// MarshalSSZ ssz marshals the BoundsUnbounded object
+func (b *BoundsUnbounded) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BoundsUnbounded object to a target array
+func (b *BoundsUnbounded) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
+
+	// Offset (0) 'A'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'A'
+	if size := len(b.A); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("BoundsUnbounded.A", size, 1099511627776)
+		return
+	}
+	{
+		offset = 4 * len(b.A)
+		for ii := 0; ii < len(b.A); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += len(b.A[ii])
+
+		}
+	}
+	for ii := 0; ii < len(b.A); ii++ {
+		if size := len(b.A[ii]); size > 1099511627776 {
+			err = ssz.ErrBytesLengthFn("BoundsUnbounded.A[ii]", size, 1099511627776)
+			return
+		}
+		dst = append(dst, b.A[ii]...)
+
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the BoundsUnbounded object to a writer
+func (b *BoundsUnbounded) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(b, writer)
+}
+
+// MarshalSSZToStream ssz marshals the BoundsUnbounded object to a target array that is flushed to a stream writer
+func (b *BoundsUnbounded) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
+
+	// Offset (0) 'A'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'A'
+	if size := len(b.A); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("BoundsUnbounded.A", size, 1099511627776)
+		return
+	}
+	{
+		offset = 4 * len(b.A)
+		for ii := 0; ii < len(b.A); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += len(b.A[ii])
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+	}
+	for ii := 0; ii < len(b.A); ii++ {
+		if size := len(b.A[ii]); size > 1099511627776 {
+			err = ssz.ErrBytesLengthFn("BoundsUnbounded.A[ii]", size, 1099511627776)
+			return
+		}
+		if dst, err = sw.Append(dst, b.A[ii]); err != nil {
+			return
+		}
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BoundsUnbounded object
+func (b *BoundsUnbounded) UnmarshalSSZ(buf []byte) error {
+	return b.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the BoundsUnbounded object with the decoding options
+func (b *BoundsUnbounded) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'A'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = opts.ValidateFirstOffset(o0, 4); err != nil {
+		return err
+	}
+
+	// Field (0) 'A'
+	{
+		buf = tail[o0:]
+		num, err := opts.DecodeDynamicLength(buf, 1099511627776)
+		if err != nil {
+			return err
+		}
+		b.A = make([][]byte, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if len(buf) > 1099511627776 {
+				return ssz.ErrBytesLength
+			}
+			if cap(b.A[indx]) == 0 {
+				b.A[indx] = make([]byte, 0, len(buf))
+			}
+			b.A[indx] = append(b.A[indx], buf...)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BoundsUnbounded object
+func (b *BoundsUnbounded) SizeSSZ() (size int) {
+	size = 4
+
+	// Field (0) 'A'
+	for ii := 0; ii < len(b.A); ii++ {
+		size += 4
+		size += len(b.A[ii])
+	}
+
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the BoundsUnbounded object
+func (b *BoundsUnbounded) MinSSZSize() uint64 {
+	return 4
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the BoundsUnbounded object
+func (b *BoundsUnbounded) MaxSSZSize() uint64 {
+	return ssz.MaxSizeUnbounded
+}
+
+// HashTreeRoot ssz hashes the BoundsUnbounded object
+func (b *BoundsUnbounded) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BoundsUnbounded object with a hasher
+func (b *BoundsUnbounded) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.A))
+		if num > 1099511627776 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.A {
+			{
+				elemIndx := hh.Index()
+				byteLen := uint64(len(elem))
+				if byteLen > 1099511627776 {
+					err = ssz.ErrIncorrectListSize
+					return
+				}
+				hh.AppendBytes32(elem)
+				hh.MerkleizeWithMixin(elemIndx, byteLen, (1099511627776+31)/32)
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded BoundsUnbounded object without decoding it
+func (b *BoundsUnbounded) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(b, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded BoundsUnbounded object with a hasher
+func (b *BoundsUnbounded) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'A'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = ssz.ValidateFirstOffset(o0, 4); err != nil {
+		return err
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	{
+		buf := tail[o0:]
+		{
+			subIndx := hh.Index()
+			num, err := ssz.DecodeDynamicLength(buf, 1099511627776)
+			if err != nil {
+				return err
+			}
+			err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+				if len(buf) > 1099511627776 {
+					return ssz.ErrBytesLength
+				}
+				{
+					elemIndx := hh.Index()
+					hh.AppendBytes32(buf)
+					hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (1099511627776+31)/32)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			hh.MerkleizeWithMixin(subIndx, uint64(num), 1099511627776)
+		}
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the BoundsUnbounded object
+func (b *BoundsUnbounded) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(b)
+}
+
+const (
+	// BoundsUnboundedAGIndex is the generalized index of the 'A' field
+	BoundsUnboundedAGIndex ssz.GIndex = 1
+)
+
+// ProveA returns a merkle proof of the 'A' field of the BoundsUnbounded object
+func (b *BoundsUnbounded) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BoundsUnboundedAGIndex)
+}
+
+// VerifyBoundsUnboundedA verifies a merkle proof of the 'A' field of a BoundsUnbounded object
+func VerifyBoundsUnboundedA(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BoundsUnboundedAGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the BoundsUnbounded object
+func (b *BoundsUnbounded) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: ssz.SchemaList(ssz.SchemaList(ssz.SchemaUint(1), 1099511627776), 1099511627776)},
+	)
+}
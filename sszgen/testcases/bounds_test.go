@@ -0,0 +1,68 @@
+package testcases
+
+import (
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+func TestSizeBounds(t *testing.T) {
+	tests := []struct {
+		name     string
+		obj      ssz.SizeBounder
+		min, max uint64
+	}{
+		{
+			name: "Fixed",
+			obj:  &BoundsFixed{},
+			min:  40,
+			max:  40,
+		},
+		{
+			name: "Dynamic",
+			obj:  &BoundsDynamic{},
+			// 40 fixed bytes, 5 offsets and the bitlist length bit
+			min: 40 + 5*4 + 1,
+			// 64 bytes, 16 containers, 4 lists of 8 bytes, 2048 bits
+			// and 2 dynamic containers of 10 bytes
+			max: 40 + 5*4 + 64 + 16*40 + 4*(4+8) + 257 + 2*(4+4+10),
+		},
+		{
+			name: "Unbounded",
+			obj:  &BoundsUnbounded{},
+			min:  4,
+			max:  ssz.MaxSizeUnbounded,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if min := test.obj.MinSSZSize(); min != test.min {
+				t.Fatalf("bad min size, expected %d but found %d", test.min, min)
+			}
+			if max := test.obj.MaxSSZSize(); max != test.max {
+				t.Fatalf("bad max size, expected %d but found %d", test.max, max)
+			}
+		})
+	}
+}
+
+func TestSizeBoundsEncoding(t *testing.T) {
+	obj := &BoundsDynamic{
+		A: &BoundsFixed{},
+		E: []byte{0x1},
+	}
+	data, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ssz.CheckSizeBounds(data, obj); err != nil {
+		t.Fatal(err)
+	}
+	if uint64(len(data)) != obj.MinSSZSize() {
+		t.Fatalf("expected the empty object to have the min size %d but found %d", obj.MinSSZSize(), len(data))
+	}
+	if err := ssz.CheckSizeBounds(data[1:], obj); err == nil {
+		t.Fatal("expected error for a buffer smaller than the min size")
+	}
+}
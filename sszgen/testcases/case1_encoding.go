@@ -4,6 +4,8 @@
 package testcases
 
 import (
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -30,8 +32,38 @@ func (c *Case1A) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the Case1A object to a writer
+func (c *Case1A) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(c, writer)
+}
+
+// MarshalSSZToStream ssz marshals the Case1A object to a target array that is flushed to a stream writer
+func (c *Case1A) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
+
+	// Offset (0) 'Foo'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Foo'
+	if size := len(c.Foo); size > 2048 {
+		err = ssz.ErrBytesLengthFn("Case1A.Foo", size, 2048)
+		return
+	}
+	if dst, err = sw.Append(dst, c.Foo); err != nil {
+		return
+	}
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the Case1A object
 func (c *Case1A) UnmarshalSSZ(buf []byte) error {
+	return c.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Case1A object with the decoding options
+func (c *Case1A) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size < 4 {
@@ -46,8 +78,8 @@ func (c *Case1A) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 4 {
-		return ssz.ErrInvalidVariableOffset
+	if err = opts.ValidateFirstOffset(o0, 4); err != nil {
+		return err
 	}
 
 	// Field (0) 'Foo'
@@ -74,6 +106,16 @@ func (c *Case1A) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Case1A object
+func (c *Case1A) MinSSZSize() uint64 {
+	return 4
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Case1A object
+func (c *Case1A) MaxSSZSize() uint64 {
+	return 2052
+}
+
 // HashTreeRoot ssz hashes the Case1A object
 func (c *Case1A) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(c)
@@ -99,11 +141,76 @@ func (c *Case1A) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded Case1A object without decoding it
+func (c *Case1A) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(c, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded Case1A object with a hasher
+func (c *Case1A) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Foo'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = ssz.ValidateFirstOffset(o0, 4); err != nil {
+		return err
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Foo'
+	{
+		buf := tail[o0:]
+		if len(buf) > 2048 {
+			return ssz.ErrBytesLength
+		}
+		{
+			elemIndx := hh.Index()
+			hh.Append(buf)
+			hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (2048+31)/32)
+		}
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the Case1A object
 func (c *Case1A) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
 
+const (
+	// Case1AFooGIndex is the generalized index of the 'Foo' field
+	Case1AFooGIndex ssz.GIndex = 1
+)
+
+// ProveFoo returns a merkle proof of the 'Foo' field of the Case1A object
+func (c *Case1A) ProveFoo() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, Case1AFooGIndex)
+}
+
+// VerifyCase1AFoo verifies a merkle proof of the 'Foo' field of a Case1A object
+func VerifyCase1AFoo(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Case1AFooGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the Case1A object
+func (c *Case1A) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Foo", Schema: ssz.SchemaList(ssz.SchemaUint(1), 2048)},
+	)
+}
+
 // MarshalSSZ ssz marshals the Case1B object
 func (c *Case1B) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(c)
@@ -127,8 +234,38 @@ func (c *Case1B) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the Case1B object to a writer
+func (c *Case1B) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(c, writer)
+}
+
+// MarshalSSZToStream ssz marshals the Case1B object to a target array that is flushed to a stream writer
+func (c *Case1B) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
+
+	// Offset (0) 'Bar'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Bar'
+	if size := len(c.Bar); size > 32 {
+		err = ssz.ErrBytesLengthFn("Case1B.Bar", size, 32)
+		return
+	}
+	if dst, err = sw.Append(dst, c.Bar); err != nil {
+		return
+	}
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the Case1B object
 func (c *Case1B) UnmarshalSSZ(buf []byte) error {
+	return c.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Case1B object with the decoding options
+func (c *Case1B) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size < 4 {
@@ -143,8 +280,8 @@ func (c *Case1B) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 4 {
-		return ssz.ErrInvalidVariableOffset
+	if err = opts.ValidateFirstOffset(o0, 4); err != nil {
+		return err
 	}
 
 	// Field (0) 'Bar'
@@ -171,6 +308,16 @@ func (c *Case1B) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Case1B object
+func (c *Case1B) MinSSZSize() uint64 {
+	return 4
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Case1B object
+func (c *Case1B) MaxSSZSize() uint64 {
+	return 36
+}
+
 // HashTreeRoot ssz hashes the Case1B object
 func (c *Case1B) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(c)
@@ -196,7 +343,72 @@ func (c *Case1B) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded Case1B object without decoding it
+func (c *Case1B) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(c, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded Case1B object with a hasher
+func (c *Case1B) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Bar'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = ssz.ValidateFirstOffset(o0, 4); err != nil {
+		return err
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Bar'
+	{
+		buf := tail[o0:]
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		{
+			elemIndx := hh.Index()
+			hh.Append(buf)
+			hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (32+31)/32)
+		}
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the Case1B object
 func (c *Case1B) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
+
+const (
+	// Case1BBarGIndex is the generalized index of the 'Bar' field
+	Case1BBarGIndex ssz.GIndex = 1
+)
+
+// ProveBar returns a merkle proof of the 'Bar' field of the Case1B object
+func (c *Case1B) ProveBar() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, Case1BBarGIndex)
+}
+
+// VerifyCase1BBar verifies a merkle proof of the 'Bar' field of a Case1B object
+func VerifyCase1BBar(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Case1BBarGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the Case1B object
+func (c *Case1B) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Bar", Schema: ssz.SchemaList(ssz.SchemaUint(1), 32)},
+	)
+}
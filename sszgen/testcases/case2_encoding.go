@@ -4,6 +4,8 @@
 package testcases
 
 import (
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -22,8 +24,28 @@ func (c *Case2A) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the Case2A object to a writer
+func (c *Case2A) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(c, writer)
+}
+
+// MarshalSSZToStream ssz marshals the Case2A object to a target array that is flushed to a stream writer
+func (c *Case2A) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint64(dst, c.A)
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the Case2A object
 func (c *Case2A) UnmarshalSSZ(buf []byte) error {
+	return c.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Case2A object with the decoding options
+func (c *Case2A) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 8 {
@@ -42,6 +64,16 @@ func (c *Case2A) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Case2A object
+func (c *Case2A) MinSSZSize() uint64 {
+	return 8
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Case2A object
+func (c *Case2A) MaxSSZSize() uint64 {
+	return 8
+}
+
 // HashTreeRoot ssz hashes the Case2A object
 func (c *Case2A) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(c)
@@ -58,11 +90,54 @@ func (c *Case2A) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded Case2A object without decoding it
+func (c *Case2A) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(c, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded Case2A object with a hasher
+func (c *Case2A) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 8 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the Case2A object
 func (c *Case2A) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
 
+const (
+	// Case2AAGIndex is the generalized index of the 'A' field
+	Case2AAGIndex ssz.GIndex = 1
+)
+
+// ProveA returns a merkle proof of the 'A' field of the Case2A object
+func (c *Case2A) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, Case2AAGIndex)
+}
+
+// VerifyCase2AA verifies a merkle proof of the 'A' field of a Case2A object
+func VerifyCase2AA(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Case2AAGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the Case2A object
+func (c *Case2A) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: ssz.SchemaUint(8)},
+	)
+}
+
 // MarshalSSZ ssz marshals the Case2B object
 func (c *Case2B) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(c)
@@ -81,8 +156,31 @@ func (c *Case2B) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the Case2B object to a writer
+func (c *Case2B) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(c, writer)
+}
+
+// MarshalSSZToStream ssz marshals the Case2B object to a target array that is flushed to a stream writer
+func (c *Case2B) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint64(dst, c.A)
+
+	// Field (1) 'B'
+	dst = ssz.MarshalUint64(dst, c.B)
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the Case2B object
 func (c *Case2B) UnmarshalSSZ(buf []byte) error {
+	return c.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Case2B object with the decoding options
+func (c *Case2B) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 16 {
@@ -104,6 +202,16 @@ func (c *Case2B) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Case2B object
+func (c *Case2B) MinSSZSize() uint64 {
+	return 16
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Case2B object
+func (c *Case2B) MaxSSZSize() uint64 {
+	return 16
+}
+
 // HashTreeRoot ssz hashes the Case2B object
 func (c *Case2B) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(c)
@@ -123,7 +231,66 @@ func (c *Case2B) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded Case2B object without decoding it
+func (c *Case2B) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(c, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded Case2B object with a hasher
+func (c *Case2B) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 16 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'B'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[8:16]))
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the Case2B object
 func (c *Case2B) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
+
+const (
+	// Case2BAGIndex is the generalized index of the 'A' field
+	Case2BAGIndex ssz.GIndex = 2
+	// Case2BBGIndex is the generalized index of the 'B' field
+	Case2BBGIndex ssz.GIndex = 3
+)
+
+// ProveA returns a merkle proof of the 'A' field of the Case2B object
+func (c *Case2B) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, Case2BAGIndex)
+}
+
+// VerifyCase2BA verifies a merkle proof of the 'A' field of a Case2B object
+func VerifyCase2BA(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Case2BAGIndex)
+}
+
+// ProveB returns a merkle proof of the 'B' field of the Case2B object
+func (c *Case2B) ProveB() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, Case2BBGIndex)
+}
+
+// VerifyCase2BB verifies a merkle proof of the 'B' field of a Case2B object
+func VerifyCase2BB(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Case2BBGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the Case2B object
+func (c *Case2B) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "B", Schema: ssz.SchemaUint(8)},
+	)
+}
@@ -4,6 +4,8 @@
 package testcases
 
 import (
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 	"github.com/ferranbt/fastssz/sszgen/testcases/other"
 )
@@ -20,8 +22,25 @@ func (c *Case3B) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the Case3B object to a writer
+func (c *Case3B) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(c, writer)
+}
+
+// MarshalSSZToStream ssz marshals the Case3B object to a target array that is flushed to a stream writer
+func (c *Case3B) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the Case3B object
 func (c *Case3B) UnmarshalSSZ(buf []byte) error {
+	return c.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Case3B object with the decoding options
+func (c *Case3B) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 0 {
@@ -37,6 +56,16 @@ func (c *Case3B) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Case3B object
+func (c *Case3B) MinSSZSize() uint64 {
+	return 0
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Case3B object
+func (c *Case3B) MaxSSZSize() uint64 {
+	return 0
+}
+
 // HashTreeRoot ssz hashes the Case3B object
 func (c *Case3B) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(c)
@@ -50,11 +79,34 @@ func (c *Case3B) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded Case3B object without decoding it
+func (c *Case3B) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(c, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded Case3B object with a hasher
+func (c *Case3B) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 0 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the Case3B object
 func (c *Case3B) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
 
+// SchemaSSZ returns the schema of the tree of the Case3B object
+func (c *Case3B) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer()
+}
+
 // MarshalSSZ ssz marshals the Case3A object
 func (c *Case3A) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(c)
@@ -93,8 +145,51 @@ func (c *Case3A) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the Case3A object to a writer
+func (c *Case3A) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(c, writer)
+}
+
+// MarshalSSZToStream ssz marshals the Case3A object to a target array that is flushed to a stream writer
+func (c *Case3A) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'A'
+	if dst, err = c.A.MarshalSSZToStream(dst, sw); err != nil {
+		return
+	}
+
+	// Field (1) 'B'
+	if c.B == nil {
+		c.B = new(Case3B)
+	}
+	if dst, err = c.B.MarshalSSZToStream(dst, sw); err != nil {
+		return
+	}
+
+	// Field (2) 'C'
+	if dst, err = c.C.MarshalSSZToStream(dst, sw); err != nil {
+		return
+	}
+
+	// Field (3) 'D'
+	if c.D == nil {
+		c.D = new(other.Case3B)
+	}
+	if dst, err = c.D.MarshalSSZToStream(dst, sw); err != nil {
+		return
+	}
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the Case3A object
 func (c *Case3A) UnmarshalSSZ(buf []byte) error {
+	return c.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Case3A object with the decoding options
+func (c *Case3A) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 0 {
@@ -102,7 +197,7 @@ func (c *Case3A) UnmarshalSSZ(buf []byte) error {
 	}
 
 	// Field (0) 'A'
-	if err = c.A.UnmarshalSSZ(buf[0:0]); err != nil {
+	if err = c.A.UnmarshalSSZWithOptions(buf[0:0], opts); err != nil {
 		return err
 	}
 
@@ -110,12 +205,12 @@ func (c *Case3A) UnmarshalSSZ(buf []byte) error {
 	if c.B == nil {
 		c.B = new(Case3B)
 	}
-	if err = c.B.UnmarshalSSZ(buf[0:0]); err != nil {
+	if err = c.B.UnmarshalSSZWithOptions(buf[0:0], opts); err != nil {
 		return err
 	}
 
 	// Field (2) 'C'
-	if err = c.C.UnmarshalSSZ(buf[0:0]); err != nil {
+	if err = c.C.UnmarshalSSZWithOptions(buf[0:0], opts); err != nil {
 		return err
 	}
 
@@ -123,7 +218,7 @@ func (c *Case3A) UnmarshalSSZ(buf []byte) error {
 	if c.D == nil {
 		c.D = new(other.Case3B)
 	}
-	if err = c.D.UnmarshalSSZ(buf[0:0]); err != nil {
+	if err = c.D.UnmarshalSSZWithOptions(buf[0:0], opts); err != nil {
 		return err
 	}
 
@@ -136,6 +231,16 @@ func (c *Case3A) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Case3A object
+func (c *Case3A) MinSSZSize() uint64 {
+	return 0
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Case3A object
+func (c *Case3A) MaxSSZSize() uint64 {
+	return 0
+}
+
 // HashTreeRoot ssz hashes the Case3A object
 func (c *Case3A) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(c)
@@ -175,7 +280,106 @@ func (c *Case3A) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded Case3A object without decoding it
+func (c *Case3A) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(c, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded Case3A object with a hasher
+func (c *Case3A) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 0 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	if err = (*Case3B)(nil).HashTreeRootSSZWith(hh, buf[0:0]); err != nil {
+		return err
+	}
+
+	// Field (1) 'B'
+	if err = (*Case3B)(nil).HashTreeRootSSZWith(hh, buf[0:0]); err != nil {
+		return err
+	}
+
+	// Field (2) 'C'
+	if err = (*other.Case3B)(nil).HashTreeRootSSZWith(hh, buf[0:0]); err != nil {
+		return err
+	}
+
+	// Field (3) 'D'
+	if err = (*other.Case3B)(nil).HashTreeRootSSZWith(hh, buf[0:0]); err != nil {
+		return err
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the Case3A object
 func (c *Case3A) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
+
+const (
+	// Case3AAGIndex is the generalized index of the 'A' field
+	Case3AAGIndex ssz.GIndex = 4
+	// Case3ABGIndex is the generalized index of the 'B' field
+	Case3ABGIndex ssz.GIndex = 5
+	// Case3ACGIndex is the generalized index of the 'C' field
+	Case3ACGIndex ssz.GIndex = 6
+	// Case3ADGIndex is the generalized index of the 'D' field
+	Case3ADGIndex ssz.GIndex = 7
+)
+
+// ProveA returns a merkle proof of the 'A' field of the Case3A object
+func (c *Case3A) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, Case3AAGIndex)
+}
+
+// VerifyCase3AA verifies a merkle proof of the 'A' field of a Case3A object
+func VerifyCase3AA(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Case3AAGIndex)
+}
+
+// ProveB returns a merkle proof of the 'B' field of the Case3A object
+func (c *Case3A) ProveB() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, Case3ABGIndex)
+}
+
+// VerifyCase3AB verifies a merkle proof of the 'B' field of a Case3A object
+func VerifyCase3AB(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Case3ABGIndex)
+}
+
+// ProveC returns a merkle proof of the 'C' field of the Case3A object
+func (c *Case3A) ProveC() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, Case3ACGIndex)
+}
+
+// VerifyCase3AC verifies a merkle proof of the 'C' field of a Case3A object
+func VerifyCase3AC(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Case3ACGIndex)
+}
+
+// ProveD returns a merkle proof of the 'D' field of the Case3A object
+func (c *Case3A) ProveD() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, Case3ADGIndex)
+}
+
+// VerifyCase3AD verifies a merkle proof of the 'D' field of a Case3A object
+func VerifyCase3AD(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Case3ADGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the Case3A object
+func (c *Case3A) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: (*Case3B)(nil).SchemaSSZ()},
+		&ssz.SchemaField{Name: "B", Schema: (*Case3B)(nil).SchemaSSZ()},
+		&ssz.SchemaField{Name: "C", Schema: (*other.Case3B)(nil).SchemaSSZ()},
+		&ssz.SchemaField{Name: "D", Schema: (*other.Case3B)(nil).SchemaSSZ()},
+	)
+}
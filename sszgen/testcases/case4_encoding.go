@@ -4,6 +4,8 @@
 package testcases
 
 import (
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 	"github.com/ferranbt/fastssz/sszgen/testcases/other"
 	alias "github.com/ferranbt/fastssz/sszgen/testcases/other2"
@@ -47,8 +49,51 @@ func (c *Case4) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the Case4 object to a writer
+func (c *Case4) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(c, writer)
+}
+
+// MarshalSSZToStream ssz marshals the Case4 object to a target array that is flushed to a stream writer
+func (c *Case4) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'A'
+	if dst, err = c.A.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'B'
+	if c.B == nil {
+		c.B = new(other.Case4Interface)
+	}
+	if dst, err = c.B.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'C'
+	dst = ssz.MarshalUint64(dst, uint64(c.C))
+
+	// Field (3) 'D'
+	if size := len(c.D); size != 96 {
+		err = ssz.ErrBytesLengthFn("Case4.D", size, 96)
+		return
+	}
+	dst = append(dst, c.D...)
+
+	// Field (4) 'E'
+	dst = append(dst, c.E[:]...)
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the Case4 object
 func (c *Case4) UnmarshalSSZ(buf []byte) error {
+	return c.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Case4 object with the decoding options
+func (c *Case4) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 392 {
@@ -56,7 +101,7 @@ func (c *Case4) UnmarshalSSZ(buf []byte) error {
 	}
 
 	// Field (0) 'A'
-	if err = c.A.UnmarshalSSZ(buf[0:96]); err != nil {
+	if err = ssz.UnmarshalSSZWithOptions(&c.A, buf[0:96], opts); err != nil {
 		return err
 	}
 
@@ -64,7 +109,7 @@ func (c *Case4) UnmarshalSSZ(buf []byte) error {
 	if c.B == nil {
 		c.B = new(other.Case4Interface)
 	}
-	if err = c.B.UnmarshalSSZ(buf[96:192]); err != nil {
+	if err = ssz.UnmarshalSSZWithOptions(c.B, buf[96:192], opts); err != nil {
 		return err
 	}
 
@@ -89,6 +134,16 @@ func (c *Case4) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Case4 object
+func (c *Case4) MinSSZSize() uint64 {
+	return 392
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Case4 object
+func (c *Case4) MaxSSZSize() uint64 {
+	return 392
+}
+
 // HashTreeRoot ssz hashes the Case4 object
 func (c *Case4) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(c)
@@ -128,7 +183,130 @@ func (c *Case4) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded Case4 object without decoding it
+func (c *Case4) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(c, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded Case4 object with a hasher
+func (c *Case4) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 392 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	{
+		obj := new(other.Case4Interface)
+		if err = obj.UnmarshalSSZ(buf[0:96]); err != nil {
+			return err
+		}
+		if err = obj.HashTreeRootWith(hh); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'B'
+	{
+		obj := new(other.Case4Interface)
+		if err = obj.UnmarshalSSZ(buf[96:192]); err != nil {
+			return err
+		}
+		if err = obj.HashTreeRootWith(hh); err != nil {
+			return err
+		}
+	}
+
+	// Field (2) 'C'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[192:200]))
+
+	// Field (3) 'D'
+	hh.PutBytes(buf[200:296])
+
+	// Field (4) 'E'
+	hh.PutBytes(buf[296:392])
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the Case4 object
 func (c *Case4) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
+
+const (
+	// Case4AGIndex is the generalized index of the 'A' field
+	Case4AGIndex ssz.GIndex = 8
+	// Case4BGIndex is the generalized index of the 'B' field
+	Case4BGIndex ssz.GIndex = 9
+	// Case4CGIndex is the generalized index of the 'C' field
+	Case4CGIndex ssz.GIndex = 10
+	// Case4DGIndex is the generalized index of the 'D' field
+	Case4DGIndex ssz.GIndex = 11
+	// Case4EGIndex is the generalized index of the 'E' field
+	Case4EGIndex ssz.GIndex = 12
+)
+
+// ProveA returns a merkle proof of the 'A' field of the Case4 object
+func (c *Case4) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, Case4AGIndex)
+}
+
+// VerifyCase4A verifies a merkle proof of the 'A' field of a Case4 object
+func VerifyCase4A(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Case4AGIndex)
+}
+
+// ProveB returns a merkle proof of the 'B' field of the Case4 object
+func (c *Case4) ProveB() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, Case4BGIndex)
+}
+
+// VerifyCase4B verifies a merkle proof of the 'B' field of a Case4 object
+func VerifyCase4B(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Case4BGIndex)
+}
+
+// ProveC returns a merkle proof of the 'C' field of the Case4 object
+func (c *Case4) ProveC() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, Case4CGIndex)
+}
+
+// VerifyCase4C verifies a merkle proof of the 'C' field of a Case4 object
+func VerifyCase4C(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Case4CGIndex)
+}
+
+// ProveD returns a merkle proof of the 'D' field of the Case4 object
+func (c *Case4) ProveD() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, Case4DGIndex)
+}
+
+// VerifyCase4D verifies a merkle proof of the 'D' field of a Case4 object
+func VerifyCase4D(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Case4DGIndex)
+}
+
+// ProveE returns a merkle proof of the 'E' field of the Case4 object
+func (c *Case4) ProveE() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, Case4EGIndex)
+}
+
+// VerifyCase4E verifies a merkle proof of the 'E' field of a Case4 object
+func VerifyCase4E(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Case4EGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the Case4 object
+func (c *Case4) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: ssz.SchemaOf(new(other.Case4Interface))},
+		&ssz.SchemaField{Name: "B", Schema: ssz.SchemaOf(new(other.Case4Interface))},
+		&ssz.SchemaField{Name: "C", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "D", Schema: ssz.SchemaBytes(96)},
+		&ssz.SchemaField{Name: "E", Schema: ssz.SchemaBytes(96)},
+	)
+}
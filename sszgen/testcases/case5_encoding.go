@@ -4,6 +4,8 @@
 package testcases
 
 import (
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -27,6 +29,63 @@ func (c *Case5A) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 			return
 		}
 		dst = append(dst, c.A[ii]...)
+
+	}
+
+	// Field (1) 'B'
+	if size := len(c.B); size != 2 {
+		err = ssz.ErrVectorLengthFn("Case5A.B", size, 2)
+		return
+	}
+	for ii := 0; ii < 2; ii++ {
+		if size := len(c.B[ii]); size != 2 {
+			err = ssz.ErrBytesLengthFn("Case5A.B[ii]", size, 2)
+			return
+		}
+		dst = append(dst, c.B[ii]...)
+
+	}
+
+	// Field (2) 'C'
+	if size := len(c.C); size != 2 {
+		err = ssz.ErrVectorLengthFn("Case5A.C", size, 2)
+		return
+	}
+	for ii := 0; ii < 2; ii++ {
+		if size := len(c.C[ii]); size != 2 {
+			err = ssz.ErrBytesLengthFn("Case5A.C[ii]", size, 2)
+			return
+		}
+		dst = append(dst, c.C[ii]...)
+
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the Case5A object to a writer
+func (c *Case5A) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(c, writer)
+}
+
+// MarshalSSZToStream ssz marshals the Case5A object to a target array that is flushed to a stream writer
+func (c *Case5A) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'A'
+	if size := len(c.A); size != 2 {
+		err = ssz.ErrVectorLengthFn("Case5A.A", size, 2)
+		return
+	}
+	for ii := 0; ii < 2; ii++ {
+		if size := len(c.A[ii]); size != 2 {
+			err = ssz.ErrBytesLengthFn("Case5A.A[ii]", size, 2)
+			return
+		}
+		dst = append(dst, c.A[ii]...)
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
 	}
 
 	// Field (1) 'B'
@@ -40,6 +99,9 @@ func (c *Case5A) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 			return
 		}
 		dst = append(dst, c.B[ii]...)
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
 	}
 
 	// Field (2) 'C'
@@ -53,6 +115,9 @@ func (c *Case5A) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 			return
 		}
 		dst = append(dst, c.C[ii]...)
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
 	}
 
 	return
@@ -60,6 +125,11 @@ func (c *Case5A) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the Case5A object
 func (c *Case5A) UnmarshalSSZ(buf []byte) error {
+	return c.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Case5A object with the decoding options
+func (c *Case5A) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 12 {
@@ -102,6 +172,16 @@ func (c *Case5A) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Case5A object
+func (c *Case5A) MinSSZSize() uint64 {
+	return 12
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Case5A object
+func (c *Case5A) MaxSSZSize() uint64 {
+	return 12
+}
+
 // HashTreeRoot ssz hashes the Case5A object
 func (c *Case5A) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(c)
@@ -166,7 +246,100 @@ func (c *Case5A) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded Case5A object without decoding it
+func (c *Case5A) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(c, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded Case5A object with a hasher
+func (c *Case5A) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 12 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	{
+		subIndx := hh.Index()
+		for ii := 0; ii < len(buf[0:4]); ii += 2 {
+			hh.PutBytes(buf[0:4][ii : ii+2])
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (1) 'B'
+	{
+		subIndx := hh.Index()
+		for ii := 0; ii < len(buf[4:8]); ii += 2 {
+			hh.PutBytes(buf[4:8][ii : ii+2])
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (2) 'C'
+	{
+		subIndx := hh.Index()
+		for ii := 0; ii < len(buf[8:12]); ii += 2 {
+			hh.PutBytes(buf[8:12][ii : ii+2])
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the Case5A object
 func (c *Case5A) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
+
+const (
+	// Case5AAGIndex is the generalized index of the 'A' field
+	Case5AAGIndex ssz.GIndex = 4
+	// Case5ABGIndex is the generalized index of the 'B' field
+	Case5ABGIndex ssz.GIndex = 5
+	// Case5ACGIndex is the generalized index of the 'C' field
+	Case5ACGIndex ssz.GIndex = 6
+)
+
+// ProveA returns a merkle proof of the 'A' field of the Case5A object
+func (c *Case5A) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, Case5AAGIndex)
+}
+
+// VerifyCase5AA verifies a merkle proof of the 'A' field of a Case5A object
+func VerifyCase5AA(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Case5AAGIndex)
+}
+
+// ProveB returns a merkle proof of the 'B' field of the Case5A object
+func (c *Case5A) ProveB() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, Case5ABGIndex)
+}
+
+// VerifyCase5AB verifies a merkle proof of the 'B' field of a Case5A object
+func VerifyCase5AB(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Case5ABGIndex)
+}
+
+// ProveC returns a merkle proof of the 'C' field of the Case5A object
+func (c *Case5A) ProveC() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, Case5ACGIndex)
+}
+
+// VerifyCase5AC verifies a merkle proof of the 'C' field of a Case5A object
+func VerifyCase5AC(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Case5ACGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the Case5A object
+func (c *Case5A) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: ssz.SchemaVector(ssz.SchemaBytes(2), 2)},
+		&ssz.SchemaField{Name: "B", Schema: ssz.SchemaVector(ssz.SchemaBytes(2), 2)},
+		&ssz.SchemaField{Name: "C", Schema: ssz.SchemaVector(ssz.SchemaBytes(2), 2)},
+	)
+}
@@ -4,6 +4,8 @@
 package testcases
 
 import (
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -22,8 +24,28 @@ func (c *Case6) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the Case6 object to a writer
+func (c *Case6) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(c, writer)
+}
+
+// MarshalSSZToStream ssz marshals the Case6 object to a target array that is flushed to a stream writer
+func (c *Case6) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'A'
+	dst = append(dst, c.A[:]...)
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the Case6 object
 func (c *Case6) UnmarshalSSZ(buf []byte) error {
+	return c.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Case6 object with the decoding options
+func (c *Case6) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 32 {
@@ -42,6 +64,16 @@ func (c *Case6) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Case6 object
+func (c *Case6) MinSSZSize() uint64 {
+	return 32
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Case6 object
+func (c *Case6) MaxSSZSize() uint64 {
+	return 32
+}
+
 // HashTreeRoot ssz hashes the Case6 object
 func (c *Case6) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(c)
@@ -58,7 +90,50 @@ func (c *Case6) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded Case6 object without decoding it
+func (c *Case6) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(c, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded Case6 object with a hasher
+func (c *Case6) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 32 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutBytes(buf[0:32])
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the Case6 object
 func (c *Case6) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
+
+const (
+	// Case6AGIndex is the generalized index of the 'A' field
+	Case6AGIndex ssz.GIndex = 1
+)
+
+// ProveA returns a merkle proof of the 'A' field of the Case6 object
+func (c *Case6) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, Case6AGIndex)
+}
+
+// VerifyCase6A verifies a merkle proof of the 'A' field of a Case6 object
+func VerifyCase6A(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Case6AGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the Case6 object
+func (c *Case6) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: ssz.SchemaBytes(32)},
+	)
+}
@@ -4,6 +4,8 @@
 package testcases
 
 import (
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -31,6 +33,39 @@ func (c *Case7) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 			return
 		}
 		dst = append(dst, c.BlobKzgs[ii]...)
+
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the Case7 object to a writer
+func (c *Case7) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(c, writer)
+}
+
+// MarshalSSZToStream ssz marshals the Case7 object to a target array that is flushed to a stream writer
+func (c *Case7) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
+
+	// Offset (0) 'BlobKzgs'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'BlobKzgs'
+	if size := len(c.BlobKzgs); size > 16 {
+		err = ssz.ErrListTooBigFn("Case7.BlobKzgs", size, 16)
+		return
+	}
+	for ii := 0; ii < len(c.BlobKzgs); ii++ {
+		if size := len(c.BlobKzgs[ii]); size != 48 {
+			err = ssz.ErrBytesLengthFn("Case7.BlobKzgs[ii]", size, 48)
+			return
+		}
+		dst = append(dst, c.BlobKzgs[ii]...)
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
 	}
 
 	return
@@ -38,6 +73,11 @@ func (c *Case7) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the Case7 object
 func (c *Case7) UnmarshalSSZ(buf []byte) error {
+	return c.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Case7 object with the decoding options
+func (c *Case7) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size < 4 {
@@ -52,8 +92,8 @@ func (c *Case7) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 4 {
-		return ssz.ErrInvalidVariableOffset
+	if err = opts.ValidateFirstOffset(o0, 4); err != nil {
+		return err
 	}
 
 	// Field (0) 'BlobKzgs'
@@ -84,6 +124,16 @@ func (c *Case7) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Case7 object
+func (c *Case7) MinSSZSize() uint64 {
+	return 4
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Case7 object
+func (c *Case7) MaxSSZSize() uint64 {
+	return 772
+}
+
 // HashTreeRoot ssz hashes the Case7 object
 func (c *Case7) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(c)
@@ -115,7 +165,75 @@ func (c *Case7) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded Case7 object without decoding it
+func (c *Case7) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(c, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded Case7 object with a hasher
+func (c *Case7) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'BlobKzgs'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = ssz.ValidateFirstOffset(o0, 4); err != nil {
+		return err
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'BlobKzgs'
+	{
+		buf := tail[o0:]
+		{
+			subIndx := hh.Index()
+			num, err := ssz.DivideInt2(len(buf), 48, 16)
+			if err != nil {
+				return err
+			}
+			for ii := 0; ii < len(buf); ii += 48 {
+				hh.PutBytes(buf[ii : ii+48])
+			}
+			hh.MerkleizeWithMixin(subIndx, uint64(num), 16)
+		}
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the Case7 object
 func (c *Case7) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
+
+const (
+	// Case7BlobKzgsGIndex is the generalized index of the 'BlobKzgs' field
+	Case7BlobKzgsGIndex ssz.GIndex = 1
+)
+
+// ProveBlobKzgs returns a merkle proof of the 'BlobKzgs' field of the Case7 object
+func (c *Case7) ProveBlobKzgs() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, Case7BlobKzgsGIndex)
+}
+
+// VerifyCase7BlobKzgs verifies a merkle proof of the 'BlobKzgs' field of a Case7 object
+func VerifyCase7BlobKzgs(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Case7BlobKzgsGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the Case7 object
+func (c *Case7) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "BlobKzgs", Schema: ssz.SchemaList(ssz.SchemaBytes(48), 16)},
+	)
+}
@@ -0,0 +1,9 @@
+package testcases
+
+//go:generate go run ../main.go --path cast_type.go
+
+type CastType struct {
+	A []byte `cast-type:"github.com/prysmaticlabs/go-bitfield.Bitvector4" ssz-size:"1"`
+	B []byte `cast-type:"github.com/prysmaticlabs/go-bitfield.Bitlist" ssz:"bitlist" ssz-max:"2048"`
+	C uint64
+}
@@ -0,0 +1,279 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: f19e80843214d67058e630565659ca0838824177fab8f1b6f5537570ebf56f50
+// Version: 0.1.3
+package testcases
+
+import (
+	"io"
+
+	ssz "github.com/ferranbt/fastssz"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+)
+
+// MarshalSSZ ssz marshals the CastType object
+func (c *CastType) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the CastType object to a target array
+func (c *CastType) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(13)
+
+	// Field (0) 'A'
+	if size := len(c.A); size != 1 {
+		err = ssz.ErrBytesLengthFn("CastType.A", size, 1)
+		return
+	}
+	dst = append(dst, []byte(c.A)...)
+
+	// Offset (1) 'B'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (2) 'C'
+	dst = ssz.MarshalUint64(dst, c.C)
+
+	// Field (1) 'B'
+	if size := len(c.B); size > 2048 {
+		err = ssz.ErrBytesLengthFn("CastType.B", size, 2048)
+		return
+	}
+	dst = append(dst, []byte(c.B)...)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the CastType object to a writer
+func (c *CastType) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(c, writer)
+}
+
+// MarshalSSZToStream ssz marshals the CastType object to a target array that is flushed to a stream writer
+func (c *CastType) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(13)
+
+	// Field (0) 'A'
+	if size := len(c.A); size != 1 {
+		err = ssz.ErrBytesLengthFn("CastType.A", size, 1)
+		return
+	}
+	dst = append(dst, []byte(c.A)...)
+
+	// Offset (1) 'B'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (2) 'C'
+	dst = ssz.MarshalUint64(dst, c.C)
+
+	// Field (1) 'B'
+	if size := len(c.B); size > 2048 {
+		err = ssz.ErrBytesLengthFn("CastType.B", size, 2048)
+		return
+	}
+	dst = append(dst, []byte(c.B)...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the CastType object
+func (c *CastType) UnmarshalSSZ(buf []byte) error {
+	return c.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the CastType object with the decoding options
+func (c *CastType) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 13 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'A'
+	if err = opts.ValidateBitvector(buf[0:1], 4); err != nil {
+		return err
+	}
+	if cap(c.A) == 0 {
+		c.A = bitfield.Bitvector4(make([]byte, 0, len(buf[0:1])))
+	}
+	c.A = append(c.A, buf[0:1]...)
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[1:5]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = opts.ValidateFirstOffset(o1, 13); err != nil {
+		return err
+	}
+
+	// Field (2) 'C'
+	c.C = ssz.UnmarshallUint64(buf[5:13])
+
+	// Field (1) 'B'
+	{
+		buf = tail[o1:]
+		if err = ssz.ValidateBitlist(buf, 2048); err != nil {
+			return err
+		}
+		if cap(c.B) == 0 {
+			c.B = bitfield.Bitlist(make([]byte, 0, len(buf)))
+		}
+		c.B = append(c.B, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the CastType object
+func (c *CastType) SizeSSZ() (size int) {
+	size = 13
+
+	// Field (1) 'B'
+	size += len(c.B)
+
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the CastType object
+func (c *CastType) MinSSZSize() uint64 {
+	return 14
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the CastType object
+func (c *CastType) MaxSSZSize() uint64 {
+	return 270
+}
+
+// HashTreeRoot ssz hashes the CastType object
+func (c *CastType) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the CastType object with a hasher
+func (c *CastType) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	if size := len(c.A); size != 1 {
+		err = ssz.ErrBytesLengthFn("CastType.A", size, 1)
+		return
+	}
+	hh.PutBytes([]byte(c.A))
+
+	// Field (1) 'B'
+	if len(c.B) == 0 {
+		err = ssz.ErrEmptyBitlist
+		return
+	}
+	hh.PutBitlist([]byte(c.B), 2048)
+
+	// Field (2) 'C'
+	hh.PutUint64(c.C)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded CastType object without decoding it
+func (c *CastType) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(c, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded CastType object with a hasher
+func (c *CastType) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 13 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[1:5]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = ssz.ValidateFirstOffset(o1, 13); err != nil {
+		return err
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	if err = ssz.ValidateBitvector(buf[0:1], 4); err != nil {
+		return err
+	}
+	hh.PutBytes(buf[0:1])
+
+	// Field (1) 'B'
+	{
+		buf := tail[o1:]
+		if err = ssz.ValidateBitlist(buf, 2048); err != nil {
+			return err
+		}
+		hh.PutBitlist(buf, 2048)
+	}
+
+	// Field (2) 'C'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[5:13]))
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the CastType object
+func (c *CastType) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(c)
+}
+
+const (
+	// CastTypeAGIndex is the generalized index of the 'A' field
+	CastTypeAGIndex ssz.GIndex = 4
+	// CastTypeBGIndex is the generalized index of the 'B' field
+	CastTypeBGIndex ssz.GIndex = 5
+	// CastTypeCGIndex is the generalized index of the 'C' field
+	CastTypeCGIndex ssz.GIndex = 6
+)
+
+// ProveA returns a merkle proof of the 'A' field of the CastType object
+func (c *CastType) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, CastTypeAGIndex)
+}
+
+// VerifyCastTypeA verifies a merkle proof of the 'A' field of a CastType object
+func VerifyCastTypeA(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, CastTypeAGIndex)
+}
+
+// ProveB returns a merkle proof of the 'B' field of the CastType object
+func (c *CastType) ProveB() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, CastTypeBGIndex)
+}
+
+// VerifyCastTypeB verifies a merkle proof of the 'B' field of a CastType object
+func VerifyCastTypeB(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, CastTypeBGIndex)
+}
+
+// ProveC returns a merkle proof of the 'C' field of the CastType object
+func (c *CastType) ProveC() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(c, CastTypeCGIndex)
+}
+
+// VerifyCastTypeC verifies a merkle proof of the 'C' field of a CastType object
+func VerifyCastTypeC(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, CastTypeCGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the CastType object
+func (c *CastType) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: ssz.SchemaBitvector(4)},
+		&ssz.SchemaField{Name: "B", Schema: ssz.SchemaBitlist(2048)},
+		&ssz.SchemaField{Name: "C", Schema: ssz.SchemaUint(8)},
+	)
+}
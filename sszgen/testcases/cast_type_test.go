@@ -0,0 +1,60 @@
+package testcases
+
+import (
+	"bytes"
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+func TestCastType(t *testing.T) {
+	bitvector := bitfield.NewBitvector4()
+	bitvector.SetBitAt(1, true)
+
+	bitlist := bitfield.NewBitlist(10)
+	bitlist.SetBitAt(3, true)
+
+	obj := &CastType{A: bitvector, B: bitlist, C: 1}
+	data, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	obj2 := &CastType{}
+	if err := obj2.UnmarshalSSZ(data); err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+	if !bitfield.Bitvector4(obj2.A).BitAt(1) {
+		t.Fatalf("bitvector bit not set")
+	}
+	if l := bitfield.Bitlist(obj2.B).Len(); l != 10 {
+		t.Fatalf("bad bitlist length, expected 10 but found %d", l)
+	}
+
+	root, err := obj.HashTreeRoot()
+	if err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+	root2, err := obj2.HashTreeRoot()
+	if err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+	if !bytes.Equal(root[:], root2[:]) {
+		t.Fatalf("root mismatch")
+	}
+}
+
+func TestCastType_BitvectorPadding(t *testing.T) {
+	obj := &CastType{A: bitfield.NewBitvector4(), B: bitfield.NewBitlist(10), C: 1}
+	data, err := obj.MarshalSSZ()
+	if err != nil {
+		t.Fatalf("unexpected error: %v\n", err)
+	}
+
+	// set a bit over the size of the Bitvector4
+	data[0] = 0x10
+	if err := new(CastType).UnmarshalSSZ(data); err != ssz.ErrBitvectorPadding {
+		t.Fatalf("expected padding error but found %v", err)
+	}
+}
@@ -4,6 +4,8 @@
 package testcases
 
 import (
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -23,6 +25,31 @@ func (v *Vec) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	}
 	for ii := 0; ii < 6; ii++ {
 		dst = ssz.MarshalUint64(dst, v.Values[ii])
+
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the Vec object to a writer
+func (v *Vec) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(v, writer)
+}
+
+// MarshalSSZToStream ssz marshals the Vec object to a target array that is flushed to a stream writer
+func (v *Vec) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Values'
+	if size := len(v.Values); size != 6 {
+		err = ssz.ErrVectorLengthFn("Vec.Values", size, 6)
+		return
+	}
+	for ii := 0; ii < 6; ii++ {
+		dst = ssz.MarshalUint64(dst, v.Values[ii])
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
 	}
 
 	return
@@ -30,6 +57,11 @@ func (v *Vec) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the Vec object
 func (v *Vec) UnmarshalSSZ(buf []byte) error {
+	return v.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Vec object with the decoding options
+func (v *Vec) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 48 {
@@ -51,6 +83,16 @@ func (v *Vec) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Vec object
+func (v *Vec) MinSSZSize() uint64 {
+	return 48
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Vec object
+func (v *Vec) MaxSSZSize() uint64 {
+	return 48
+}
+
 // HashTreeRoot ssz hashes the Vec object
 func (v *Vec) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(v)
@@ -77,11 +119,58 @@ func (v *Vec) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded Vec object without decoding it
+func (v *Vec) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(v, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded Vec object with a hasher
+func (v *Vec) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 48 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Values'
+	{
+		subIndx := hh.Index()
+		hh.Append(buf[0:48])
+		hh.Merkleize(subIndx)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the Vec object
 func (v *Vec) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(v)
 }
 
+const (
+	// VecValuesGIndex is the generalized index of the 'Values' field
+	VecValuesGIndex ssz.GIndex = 1
+)
+
+// ProveValues returns a merkle proof of the 'Values' field of the Vec object
+func (v *Vec) ProveValues() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(v, VecValuesGIndex)
+}
+
+// VerifyVecValues verifies a merkle proof of the 'Values' field of a Vec object
+func VerifyVecValues(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, VecValuesGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the Vec object
+func (v *Vec) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Values", Schema: ssz.SchemaVector(ssz.SchemaUint(8), 6)},
+	)
+}
+
 // MarshalSSZ ssz marshals the Vec2 object
 func (v *Vec2) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(v)
@@ -102,6 +191,35 @@ func (v *Vec2) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	}
 	for ii := 0; ii < len(v.Values2); ii++ {
 		dst = ssz.MarshalUint32(dst, v.Values2[ii])
+
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the Vec2 object to a writer
+func (v *Vec2) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(v, writer)
+}
+
+// MarshalSSZToStream ssz marshals the Vec2 object to a target array that is flushed to a stream writer
+func (v *Vec2) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
+
+	// Offset (0) 'Values2'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Values2'
+	if size := len(v.Values2); size > 100 {
+		err = ssz.ErrListTooBigFn("Vec2.Values2", size, 100)
+		return
+	}
+	for ii := 0; ii < len(v.Values2); ii++ {
+		dst = ssz.MarshalUint32(dst, v.Values2[ii])
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
 	}
 
 	return
@@ -109,6 +227,11 @@ func (v *Vec2) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the Vec2 object
 func (v *Vec2) UnmarshalSSZ(buf []byte) error {
+	return v.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Vec2 object with the decoding options
+func (v *Vec2) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size < 4 {
@@ -123,8 +246,8 @@ func (v *Vec2) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 4 {
-		return ssz.ErrInvalidVariableOffset
+	if err = opts.ValidateFirstOffset(o0, 4); err != nil {
+		return err
 	}
 
 	// Field (0) 'Values2'
@@ -152,6 +275,16 @@ func (v *Vec2) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Vec2 object
+func (v *Vec2) MinSSZSize() uint64 {
+	return 4
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Vec2 object
+func (v *Vec2) MaxSSZSize() uint64 {
+	return 404
+}
+
 // HashTreeRoot ssz hashes the Vec2 object
 func (v *Vec2) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(v)
@@ -180,7 +313,74 @@ func (v *Vec2) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded Vec2 object without decoding it
+func (v *Vec2) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(v, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded Vec2 object with a hasher
+func (v *Vec2) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Values2'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = ssz.ValidateFirstOffset(o0, 4); err != nil {
+		return err
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Values2'
+	{
+		buf := tail[o0:]
+		{
+			subIndx := hh.Index()
+			num, err := ssz.DivideInt2(len(buf), 4, 100)
+			if err != nil {
+				return err
+			}
+			hh.Append(buf)
+			hh.FillUpTo32()
+			hh.MerkleizeWithMixin(subIndx, uint64(num), ssz.CalculateLimit(100, uint64(num), 4))
+		}
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the Vec2 object
 func (v *Vec2) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(v)
 }
+
+const (
+	// Vec2Values2GIndex is the generalized index of the 'Values2' field
+	Vec2Values2GIndex ssz.GIndex = 1
+)
+
+// ProveValues2 returns a merkle proof of the 'Values2' field of the Vec2 object
+func (v *Vec2) ProveValues2() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(v, Vec2Values2GIndex)
+}
+
+// VerifyVec2Values2 verifies a merkle proof of the 'Values2' field of a Vec2 object
+func VerifyVec2Values2(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Vec2Values2GIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the Vec2 object
+func (v *Vec2) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Values2", Schema: ssz.SchemaList(ssz.SchemaUint(4), 100)},
+	)
+}
@@ -0,0 +1,45 @@
+package testcases
+
+//go:generate go run ../main.go --path fork.go
+
+type ForkPayload struct {
+	A uint64
+	B []byte   `ssz-max:"32"`
+	C uint64   `ssz-fork:"altair"`
+	D []uint64 `ssz-max:"4" ssz-fork:"capella"`
+	E uint64   `ssz-until:"capella"`
+}
+
+type ForkBody struct {
+	Slot    uint64
+	Payload *ForkPayload `ssz-fork:"bellatrix"`
+}
+
+// ForkPayloadPhase0 is the encoding of ForkPayload in phase0
+type ForkPayloadPhase0 struct {
+	A uint64
+	B []byte `ssz-max:"32"`
+	E uint64
+}
+
+// ForkPayloadCapella is the encoding of ForkPayload in capella
+type ForkPayloadCapella struct {
+	A uint64
+	B []byte `ssz-max:"32"`
+	C uint64
+	D []uint64 `ssz-max:"4"`
+}
+
+// ForkBodyBellatrix is the encoding of ForkBody in bellatrix with the altair payload
+type ForkBodyBellatrix struct {
+	Slot    uint64
+	Payload *ForkPayloadAltair
+}
+
+// ForkPayloadAltair is the encoding of ForkPayload in altair
+type ForkPayloadAltair struct {
+	A uint64
+	B []byte `ssz-max:"32"`
+	C uint64
+	E uint64
+}
@@ -0,0 +1,2445 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: 4f0f0e293a2306ed4195b14bb08204ee36bc9793758e94665af3c2f37540cbe8
+// Version: 0.1.3
+package testcases
+
+import (
+	"io"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MarshalSSZ ssz marshals the ForkPayload object
+func (f *ForkPayload) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(f)
+}
+
+// MarshalSSZTo ssz marshals the ForkPayload object to a target array
+func (f *ForkPayload) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	return f.MarshalSSZToFork(buf, ssz.ForkDeneb)
+}
+
+// MarshalSSZFork ssz marshals the ForkPayload object for a given fork
+func (f *ForkPayload) MarshalSSZFork(fork ssz.Fork) ([]byte, error) {
+	return ssz.MarshalSSZFork(f, fork)
+}
+
+// MarshalSSZToFork ssz marshals the ForkPayload object to a target array for a given fork
+func (f *ForkPayload) MarshalSSZToFork(buf []byte, fork ssz.Fork) (dst []byte, err error) {
+	dst = buf
+	switch {
+	case fork >= ssz.ForkCapella:
+		offset := int(24)
+		// Field (0) 'A'
+		dst = ssz.MarshalUint64(dst, f.A)
+
+		// Offset (1) 'B'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(f.B)
+
+		// Field (2) 'C'
+		dst = ssz.MarshalUint64(dst, f.C)
+
+		// Offset (3) 'D'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (1) 'B'
+		if size := len(f.B); size > 32 {
+			err = ssz.ErrBytesLengthFn("ForkPayload.B", size, 32)
+			return
+		}
+		dst = append(dst, f.B...)
+
+		// Field (3) 'D'
+		if size := len(f.D); size > 4 {
+			err = ssz.ErrListTooBigFn("ForkPayload.D", size, 4)
+			return
+		}
+		for ii := 0; ii < len(f.D); ii++ {
+			dst = ssz.MarshalUint64(dst, f.D[ii])
+
+		}
+
+	case fork >= ssz.ForkAltair:
+		offset := int(28)
+		// Field (0) 'A'
+		dst = ssz.MarshalUint64(dst, f.A)
+
+		// Offset (1) 'B'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (2) 'C'
+		dst = ssz.MarshalUint64(dst, f.C)
+
+		// Field (3) 'E'
+		dst = ssz.MarshalUint64(dst, f.E)
+
+		// Field (1) 'B'
+		if size := len(f.B); size > 32 {
+			err = ssz.ErrBytesLengthFn("ForkPayload.B", size, 32)
+			return
+		}
+		dst = append(dst, f.B...)
+
+	default:
+		offset := int(20)
+		// Field (0) 'A'
+		dst = ssz.MarshalUint64(dst, f.A)
+
+		// Offset (1) 'B'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (2) 'E'
+		dst = ssz.MarshalUint64(dst, f.E)
+
+		// Field (1) 'B'
+		if size := len(f.B); size > 32 {
+			err = ssz.ErrBytesLengthFn("ForkPayload.B", size, 32)
+			return
+		}
+		dst = append(dst, f.B...)
+
+	}
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the ForkPayload object to a writer
+func (f *ForkPayload) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(f, writer)
+}
+
+// MarshalSSZToStream ssz marshals the ForkPayload object to a target array that is flushed to a stream writer
+func (f *ForkPayload) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	return f.MarshalSSZToStreamFork(buf, sw, ssz.ForkDeneb)
+}
+
+// MarshalSSZToWriterFork ssz marshals the ForkPayload object to a writer for a given fork
+func (f *ForkPayload) MarshalSSZToWriterFork(writer io.Writer, fork ssz.Fork) (int, error) {
+	return ssz.MarshalSSZToWriterFork(f, writer, fork)
+}
+
+// MarshalSSZToStreamFork ssz marshals the ForkPayload object to a target array that is flushed to a stream writer for a given fork
+func (f *ForkPayload) MarshalSSZToStreamFork(buf []byte, sw *ssz.StreamWriter, fork ssz.Fork) (dst []byte, err error) {
+	dst = buf
+	switch {
+	case fork >= ssz.ForkCapella:
+		offset := int(24)
+		// Field (0) 'A'
+		dst = ssz.MarshalUint64(dst, f.A)
+
+		// Offset (1) 'B'
+		dst = ssz.WriteOffset(dst, offset)
+		offset += len(f.B)
+
+		// Field (2) 'C'
+		dst = ssz.MarshalUint64(dst, f.C)
+
+		// Offset (3) 'D'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (1) 'B'
+		if size := len(f.B); size > 32 {
+			err = ssz.ErrBytesLengthFn("ForkPayload.B", size, 32)
+			return
+		}
+		if dst, err = sw.Append(dst, f.B); err != nil {
+			return
+		}
+
+		// Field (3) 'D'
+		if size := len(f.D); size > 4 {
+			err = ssz.ErrListTooBigFn("ForkPayload.D", size, 4)
+			return
+		}
+		for ii := 0; ii < len(f.D); ii++ {
+			dst = ssz.MarshalUint64(dst, f.D[ii])
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+
+	case fork >= ssz.ForkAltair:
+		offset := int(28)
+		// Field (0) 'A'
+		dst = ssz.MarshalUint64(dst, f.A)
+
+		// Offset (1) 'B'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (2) 'C'
+		dst = ssz.MarshalUint64(dst, f.C)
+
+		// Field (3) 'E'
+		dst = ssz.MarshalUint64(dst, f.E)
+
+		// Field (1) 'B'
+		if size := len(f.B); size > 32 {
+			err = ssz.ErrBytesLengthFn("ForkPayload.B", size, 32)
+			return
+		}
+		if dst, err = sw.Append(dst, f.B); err != nil {
+			return
+		}
+
+	default:
+		offset := int(20)
+		// Field (0) 'A'
+		dst = ssz.MarshalUint64(dst, f.A)
+
+		// Offset (1) 'B'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (2) 'E'
+		dst = ssz.MarshalUint64(dst, f.E)
+
+		// Field (1) 'B'
+		if size := len(f.B); size > 32 {
+			err = ssz.ErrBytesLengthFn("ForkPayload.B", size, 32)
+			return
+		}
+		if dst, err = sw.Append(dst, f.B); err != nil {
+			return
+		}
+
+	}
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ForkPayload object
+func (f *ForkPayload) UnmarshalSSZ(buf []byte) error {
+	return f.UnmarshalSSZForkWithOptions(buf, ssz.ForkDeneb, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the ForkPayload object with the decoding options
+func (f *ForkPayload) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	return f.UnmarshalSSZForkWithOptions(buf, ssz.ForkDeneb, opts)
+}
+
+// UnmarshalSSZFork ssz unmarshals the ForkPayload object for a given fork
+func (f *ForkPayload) UnmarshalSSZFork(buf []byte, fork ssz.Fork) error {
+	return f.UnmarshalSSZForkWithOptions(buf, fork, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZForkWithOptions ssz unmarshals the ForkPayload object for a given fork with the decoding options
+func (f *ForkPayload) UnmarshalSSZForkWithOptions(buf []byte, fork ssz.Fork, opts ssz.UnmarshalOptions) error {
+	var err error
+	switch {
+	case fork >= ssz.ForkCapella:
+		size := uint64(len(buf))
+		if size < 24 {
+			return ssz.ErrSize
+		}
+
+		tail := buf
+		var o1, o3 uint64
+
+		// Field (0) 'A'
+		f.A = ssz.UnmarshallUint64(buf[0:8])
+
+		// Offset (1) 'B'
+		if o1 = ssz.ReadOffset(buf[8:12]); o1 > size {
+			return ssz.ErrOffset
+		}
+
+		if err = opts.ValidateFirstOffset(o1, 24); err != nil {
+			return err
+		}
+
+		// Field (2) 'C'
+		f.C = ssz.UnmarshallUint64(buf[12:20])
+
+		// Offset (3) 'D'
+		if o3 = ssz.ReadOffset(buf[20:24]); o3 > size || o1 > o3 {
+			return ssz.ErrOffset
+		}
+
+		// Field (1) 'B'
+		{
+			buf = tail[o1:o3]
+			if len(buf) > 32 {
+				return ssz.ErrBytesLength
+			}
+			if cap(f.B) == 0 {
+				f.B = make([]byte, 0, len(buf))
+			}
+			f.B = append(f.B, buf...)
+		}
+
+		// Field (3) 'D'
+		{
+			buf = tail[o3:]
+			num, err := ssz.DivideInt2(len(buf), 8, 4)
+			if err != nil {
+				return err
+			}
+			f.D = ssz.ExtendUint64(f.D, num)
+			for ii := 0; ii < num; ii++ {
+				f.D[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+			}
+		}
+	case fork >= ssz.ForkAltair:
+		size := uint64(len(buf))
+		if size < 28 {
+			return ssz.ErrSize
+		}
+
+		tail := buf
+		var o1 uint64
+
+		// Field (0) 'A'
+		f.A = ssz.UnmarshallUint64(buf[0:8])
+
+		// Offset (1) 'B'
+		if o1 = ssz.ReadOffset(buf[8:12]); o1 > size {
+			return ssz.ErrOffset
+		}
+
+		if err = opts.ValidateFirstOffset(o1, 28); err != nil {
+			return err
+		}
+
+		// Field (2) 'C'
+		f.C = ssz.UnmarshallUint64(buf[12:20])
+
+		// Field (3) 'E'
+		f.E = ssz.UnmarshallUint64(buf[20:28])
+
+		// Field (1) 'B'
+		{
+			buf = tail[o1:]
+			if len(buf) > 32 {
+				return ssz.ErrBytesLength
+			}
+			if cap(f.B) == 0 {
+				f.B = make([]byte, 0, len(buf))
+			}
+			f.B = append(f.B, buf...)
+		}
+	default:
+		size := uint64(len(buf))
+		if size < 20 {
+			return ssz.ErrSize
+		}
+
+		tail := buf
+		var o1 uint64
+
+		// Field (0) 'A'
+		f.A = ssz.UnmarshallUint64(buf[0:8])
+
+		// Offset (1) 'B'
+		if o1 = ssz.ReadOffset(buf[8:12]); o1 > size {
+			return ssz.ErrOffset
+		}
+
+		if err = opts.ValidateFirstOffset(o1, 20); err != nil {
+			return err
+		}
+
+		// Field (2) 'E'
+		f.E = ssz.UnmarshallUint64(buf[12:20])
+
+		// Field (1) 'B'
+		{
+			buf = tail[o1:]
+			if len(buf) > 32 {
+				return ssz.ErrBytesLength
+			}
+			if cap(f.B) == 0 {
+				f.B = make([]byte, 0, len(buf))
+			}
+			f.B = append(f.B, buf...)
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ForkPayload object
+func (f *ForkPayload) SizeSSZ() (size int) {
+	return f.SizeSSZFork(ssz.ForkDeneb)
+}
+
+// SizeSSZFork returns the ssz encoded size in bytes for the ForkPayload object for a given fork
+func (f *ForkPayload) SizeSSZFork(fork ssz.Fork) (size int) {
+	switch {
+	case fork >= ssz.ForkCapella:
+		size = 24
+		// Field (1) 'B'
+		size += len(f.B)
+
+		// Field (3) 'D'
+		size += len(f.D) * 8
+	case fork >= ssz.ForkAltair:
+		size = 28
+		// Field (1) 'B'
+		size += len(f.B)
+	default:
+		size = 20
+		// Field (1) 'B'
+		size += len(f.B)
+	}
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the ForkPayload object
+func (f *ForkPayload) MinSSZSize() uint64 {
+	return f.MinSSZSizeFork(ssz.ForkDeneb)
+}
+
+// MinSSZSizeFork returns the minimum ssz encoded size in bytes for the ForkPayload object for a given fork
+func (f *ForkPayload) MinSSZSizeFork(fork ssz.Fork) uint64 {
+	switch {
+	case fork >= ssz.ForkCapella:
+		return 24
+	case fork >= ssz.ForkAltair:
+		return 28
+	default:
+		return 20
+	}
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the ForkPayload object
+func (f *ForkPayload) MaxSSZSize() uint64 {
+	return f.MaxSSZSizeFork(ssz.ForkDeneb)
+}
+
+// MaxSSZSizeFork returns the maximum ssz encoded size in bytes for the ForkPayload object for a given fork
+func (f *ForkPayload) MaxSSZSizeFork(fork ssz.Fork) uint64 {
+	switch {
+	case fork >= ssz.ForkCapella:
+		return 88
+	case fork >= ssz.ForkAltair:
+		return 60
+	default:
+		return 52
+	}
+}
+
+// HashTreeRoot ssz hashes the ForkPayload object
+func (f *ForkPayload) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(f)
+}
+
+// HashTreeRootWith ssz hashes the ForkPayload object with a hasher
+func (f *ForkPayload) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	return f.HashTreeRootWithFork(hh, ssz.ForkDeneb)
+}
+
+// HashTreeRootFork ssz hashes the ForkPayload object for a given fork
+func (f *ForkPayload) HashTreeRootFork(fork ssz.Fork) ([32]byte, error) {
+	return ssz.HashWithDefaultHasherFork(f, fork)
+}
+
+// HashTreeRootWithFork ssz hashes the ForkPayload object with a hasher for a given fork
+func (f *ForkPayload) HashTreeRootWithFork(hh ssz.HashWalker, fork ssz.Fork) (err error) {
+	switch {
+	case fork >= ssz.ForkCapella:
+		indx := hh.Index()
+
+		// Field (0) 'A'
+		hh.PutUint64(f.A)
+
+		// Field (1) 'B'
+		{
+			elemIndx := hh.Index()
+			byteLen := uint64(len(f.B))
+			if byteLen > 32 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			hh.Append(f.B)
+			hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+		}
+
+		// Field (2) 'C'
+		hh.PutUint64(f.C)
+
+		// Field (3) 'D'
+		{
+			if size := len(f.D); size > 4 {
+				err = ssz.ErrListTooBigFn("ForkPayload.D", size, 4)
+				return
+			}
+			subIndx := hh.Index()
+			for _, i := range f.D {
+				hh.AppendUint64(i)
+			}
+			hh.FillUpTo32()
+			numItems := uint64(len(f.D))
+			hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(4, numItems, 8))
+		}
+
+		hh.Merkleize(indx)
+	case fork >= ssz.ForkAltair:
+		indx := hh.Index()
+
+		// Field (0) 'A'
+		hh.PutUint64(f.A)
+
+		// Field (1) 'B'
+		{
+			elemIndx := hh.Index()
+			byteLen := uint64(len(f.B))
+			if byteLen > 32 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			hh.Append(f.B)
+			hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+		}
+
+		// Field (2) 'C'
+		hh.PutUint64(f.C)
+
+		// Field (3) 'E'
+		hh.PutUint64(f.E)
+
+		hh.Merkleize(indx)
+	default:
+		indx := hh.Index()
+
+		// Field (0) 'A'
+		hh.PutUint64(f.A)
+
+		// Field (1) 'B'
+		{
+			elemIndx := hh.Index()
+			byteLen := uint64(len(f.B))
+			if byteLen > 32 {
+				err = ssz.ErrIncorrectListSize
+				return
+			}
+			hh.Append(f.B)
+			hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+		}
+
+		// Field (2) 'E'
+		hh.PutUint64(f.E)
+
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded ForkPayload object without decoding it
+func (f *ForkPayload) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(f, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded ForkPayload object with a hasher
+func (f *ForkPayload) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	return f.HashTreeRootSSZWithFork(hh, buf, ssz.ForkDeneb)
+}
+
+// HashTreeRootSSZFork ssz hashes the encoded ForkPayload object for a given fork without decoding it
+func (f *ForkPayload) HashTreeRootSSZFork(buf []byte, fork ssz.Fork) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasherFork(f, buf, fork)
+}
+
+// HashTreeRootSSZWithFork ssz hashes the encoded ForkPayload object with a hasher for a given fork
+func (f *ForkPayload) HashTreeRootSSZWithFork(hh ssz.HashWalker, buf []byte, fork ssz.Fork) (err error) {
+	switch {
+	case fork >= ssz.ForkCapella:
+		size := uint64(len(buf))
+		if size < 24 {
+			return ssz.ErrSize
+		}
+
+		tail := buf
+		var o1, o3 uint64
+
+		// Offset (1) 'B'
+		if o1 = ssz.ReadOffset(buf[8:12]); o1 > size {
+			return ssz.ErrOffset
+		}
+
+		if err = ssz.ValidateFirstOffset(o1, 24); err != nil {
+			return err
+		}
+
+		// Offset (3) 'D'
+		if o3 = ssz.ReadOffset(buf[20:24]); o3 > size || o1 > o3 {
+			return ssz.ErrOffset
+		}
+
+		indx := hh.Index()
+
+		// Field (0) 'A'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
+
+		// Field (1) 'B'
+		{
+			buf := tail[o1:o3]
+			if len(buf) > 32 {
+				return ssz.ErrBytesLength
+			}
+			{
+				elemIndx := hh.Index()
+				hh.Append(buf)
+				hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (32+31)/32)
+			}
+		}
+
+		// Field (2) 'C'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[12:20]))
+
+		// Field (3) 'D'
+		{
+			buf := tail[o3:]
+			{
+				subIndx := hh.Index()
+				num, err := ssz.DivideInt2(len(buf), 8, 4)
+				if err != nil {
+					return err
+				}
+				hh.Append(buf)
+				hh.FillUpTo32()
+				hh.MerkleizeWithMixin(subIndx, uint64(num), ssz.CalculateLimit(4, uint64(num), 8))
+			}
+		}
+
+		hh.Merkleize(indx)
+	case fork >= ssz.ForkAltair:
+		size := uint64(len(buf))
+		if size < 28 {
+			return ssz.ErrSize
+		}
+
+		tail := buf
+		var o1 uint64
+
+		// Offset (1) 'B'
+		if o1 = ssz.ReadOffset(buf[8:12]); o1 > size {
+			return ssz.ErrOffset
+		}
+
+		if err = ssz.ValidateFirstOffset(o1, 28); err != nil {
+			return err
+		}
+
+		indx := hh.Index()
+
+		// Field (0) 'A'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
+
+		// Field (1) 'B'
+		{
+			buf := tail[o1:]
+			if len(buf) > 32 {
+				return ssz.ErrBytesLength
+			}
+			{
+				elemIndx := hh.Index()
+				hh.Append(buf)
+				hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (32+31)/32)
+			}
+		}
+
+		// Field (2) 'C'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[12:20]))
+
+		// Field (3) 'E'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[20:28]))
+
+		hh.Merkleize(indx)
+	default:
+		size := uint64(len(buf))
+		if size < 20 {
+			return ssz.ErrSize
+		}
+
+		tail := buf
+		var o1 uint64
+
+		// Offset (1) 'B'
+		if o1 = ssz.ReadOffset(buf[8:12]); o1 > size {
+			return ssz.ErrOffset
+		}
+
+		if err = ssz.ValidateFirstOffset(o1, 20); err != nil {
+			return err
+		}
+
+		indx := hh.Index()
+
+		// Field (0) 'A'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
+
+		// Field (1) 'B'
+		{
+			buf := tail[o1:]
+			if len(buf) > 32 {
+				return ssz.ErrBytesLength
+			}
+			{
+				elemIndx := hh.Index()
+				hh.Append(buf)
+				hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (32+31)/32)
+			}
+		}
+
+		// Field (2) 'E'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[12:20]))
+
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// GetTree ssz hashes the ForkPayload object
+func (f *ForkPayload) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(f)
+}
+
+// GetTreeFork ssz hashes the ForkPayload object for a given fork
+func (f *ForkPayload) GetTreeFork(fork ssz.Fork) (*ssz.Node, error) {
+	return ssz.ProofTreeFork(f, fork)
+}
+
+const (
+	// ForkPayloadAGIndex is the generalized index of the 'A' field at the deneb fork
+	ForkPayloadAGIndex ssz.GIndex = 4
+	// ForkPayloadBGIndex is the generalized index of the 'B' field at the deneb fork
+	ForkPayloadBGIndex ssz.GIndex = 5
+	// ForkPayloadCGIndex is the generalized index of the 'C' field at the deneb fork
+	ForkPayloadCGIndex ssz.GIndex = 6
+	// ForkPayloadDGIndex is the generalized index of the 'D' field at the deneb fork
+	ForkPayloadDGIndex ssz.GIndex = 7
+)
+
+// ProveA returns a merkle proof of the 'A' field of the ForkPayload object
+func (f *ForkPayload) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(f, ForkPayloadAGIndex)
+}
+
+// VerifyForkPayloadA verifies a merkle proof of the 'A' field of a ForkPayload object
+func VerifyForkPayloadA(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ForkPayloadAGIndex)
+}
+
+// ProveB returns a merkle proof of the 'B' field of the ForkPayload object
+func (f *ForkPayload) ProveB() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(f, ForkPayloadBGIndex)
+}
+
+// VerifyForkPayloadB verifies a merkle proof of the 'B' field of a ForkPayload object
+func VerifyForkPayloadB(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ForkPayloadBGIndex)
+}
+
+// ProveC returns a merkle proof of the 'C' field of the ForkPayload object
+func (f *ForkPayload) ProveC() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(f, ForkPayloadCGIndex)
+}
+
+// VerifyForkPayloadC verifies a merkle proof of the 'C' field of a ForkPayload object
+func VerifyForkPayloadC(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ForkPayloadCGIndex)
+}
+
+// ProveD returns a merkle proof of the 'D' field of the ForkPayload object
+func (f *ForkPayload) ProveD() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(f, ForkPayloadDGIndex)
+}
+
+// VerifyForkPayloadD verifies a merkle proof of the 'D' field of a ForkPayload object
+func VerifyForkPayloadD(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ForkPayloadDGIndex)
+}
+
+// ForkPayloadAGIndexFork returns the generalized index of the 'A' field for a given fork
+func ForkPayloadAGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	return 4, nil
+}
+
+// ProveAFork returns a merkle proof of the 'A' field of the ForkPayload object for a given fork
+func (f *ForkPayload) ProveAFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ForkPayloadAGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(f, gindex, fork)
+}
+
+// VerifyForkPayloadAFork verifies a merkle proof of the 'A' field of a ForkPayload object for a given fork
+func VerifyForkPayloadAFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ForkPayloadAGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// ForkPayloadBGIndexFork returns the generalized index of the 'B' field for a given fork
+func ForkPayloadBGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	return 5, nil
+}
+
+// ProveBFork returns a merkle proof of the 'B' field of the ForkPayload object for a given fork
+func (f *ForkPayload) ProveBFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ForkPayloadBGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(f, gindex, fork)
+}
+
+// VerifyForkPayloadBFork verifies a merkle proof of the 'B' field of a ForkPayload object for a given fork
+func VerifyForkPayloadBFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ForkPayloadBGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// ForkPayloadCGIndexFork returns the generalized index of the 'C' field for a given fork
+func ForkPayloadCGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkAltair:
+		return 6, nil
+	default:
+		return 0, ssz.ErrForkField
+	}
+}
+
+// ProveCFork returns a merkle proof of the 'C' field of the ForkPayload object for a given fork
+func (f *ForkPayload) ProveCFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ForkPayloadCGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(f, gindex, fork)
+}
+
+// VerifyForkPayloadCFork verifies a merkle proof of the 'C' field of a ForkPayload object for a given fork
+func VerifyForkPayloadCFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ForkPayloadCGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// ForkPayloadDGIndexFork returns the generalized index of the 'D' field for a given fork
+func ForkPayloadDGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkCapella:
+		return 7, nil
+	default:
+		return 0, ssz.ErrForkField
+	}
+}
+
+// ProveDFork returns a merkle proof of the 'D' field of the ForkPayload object for a given fork
+func (f *ForkPayload) ProveDFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ForkPayloadDGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(f, gindex, fork)
+}
+
+// VerifyForkPayloadDFork verifies a merkle proof of the 'D' field of a ForkPayload object for a given fork
+func VerifyForkPayloadDFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ForkPayloadDGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// ForkPayloadEGIndexFork returns the generalized index of the 'E' field for a given fork
+func ForkPayloadEGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkCapella:
+		return 0, ssz.ErrForkField
+	case fork >= ssz.ForkAltair:
+		return 7, nil
+	default:
+		return 6, nil
+	}
+}
+
+// ProveEFork returns a merkle proof of the 'E' field of the ForkPayload object for a given fork
+func (f *ForkPayload) ProveEFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ForkPayloadEGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(f, gindex, fork)
+}
+
+// VerifyForkPayloadEFork verifies a merkle proof of the 'E' field of a ForkPayload object for a given fork
+func VerifyForkPayloadEFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ForkPayloadEGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// SchemaSSZ returns the schema of the tree of the ForkPayload object
+func (f *ForkPayload) SchemaSSZ() *ssz.Schema {
+	return f.SchemaSSZFork(ssz.ForkDeneb)
+}
+
+// SchemaSSZFork returns the schema of the tree of the ForkPayload object for a given fork
+func (f *ForkPayload) SchemaSSZFork(fork ssz.Fork) *ssz.Schema {
+	switch {
+	case fork >= ssz.ForkCapella:
+		return ssz.SchemaContainer(
+			&ssz.SchemaField{Name: "A", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "B", Schema: ssz.SchemaList(ssz.SchemaUint(1), 32)},
+			&ssz.SchemaField{Name: "C", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "D", Schema: ssz.SchemaList(ssz.SchemaUint(8), 4)},
+		)
+	case fork >= ssz.ForkAltair:
+		return ssz.SchemaContainer(
+			&ssz.SchemaField{Name: "A", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "B", Schema: ssz.SchemaList(ssz.SchemaUint(1), 32)},
+			&ssz.SchemaField{Name: "C", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "E", Schema: ssz.SchemaUint(8)},
+		)
+	default:
+		return ssz.SchemaContainer(
+			&ssz.SchemaField{Name: "A", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "B", Schema: ssz.SchemaList(ssz.SchemaUint(1), 32)},
+			&ssz.SchemaField{Name: "E", Schema: ssz.SchemaUint(8)},
+		)
+	}
+}
+
+// MarshalSSZ ssz marshals the ForkBody object
+func (f *ForkBody) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(f)
+}
+
+// MarshalSSZTo ssz marshals the ForkBody object to a target array
+func (f *ForkBody) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	return f.MarshalSSZToFork(buf, ssz.ForkDeneb)
+}
+
+// MarshalSSZFork ssz marshals the ForkBody object for a given fork
+func (f *ForkBody) MarshalSSZFork(fork ssz.Fork) ([]byte, error) {
+	return ssz.MarshalSSZFork(f, fork)
+}
+
+// MarshalSSZToFork ssz marshals the ForkBody object to a target array for a given fork
+func (f *ForkBody) MarshalSSZToFork(buf []byte, fork ssz.Fork) (dst []byte, err error) {
+	dst = buf
+	switch {
+	case fork >= ssz.ForkBellatrix:
+		offset := int(12)
+		// Field (0) 'Slot'
+		dst = ssz.MarshalUint64(dst, f.Slot)
+
+		// Offset (1) 'Payload'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (1) 'Payload'
+		if dst, err = f.Payload.MarshalSSZToFork(dst, fork); err != nil {
+			return
+		}
+
+	default:
+		// Field (0) 'Slot'
+		dst = ssz.MarshalUint64(dst, f.Slot)
+
+	}
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the ForkBody object to a writer
+func (f *ForkBody) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(f, writer)
+}
+
+// MarshalSSZToStream ssz marshals the ForkBody object to a target array that is flushed to a stream writer
+func (f *ForkBody) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	return f.MarshalSSZToStreamFork(buf, sw, ssz.ForkDeneb)
+}
+
+// MarshalSSZToWriterFork ssz marshals the ForkBody object to a writer for a given fork
+func (f *ForkBody) MarshalSSZToWriterFork(writer io.Writer, fork ssz.Fork) (int, error) {
+	return ssz.MarshalSSZToWriterFork(f, writer, fork)
+}
+
+// MarshalSSZToStreamFork ssz marshals the ForkBody object to a target array that is flushed to a stream writer for a given fork
+func (f *ForkBody) MarshalSSZToStreamFork(buf []byte, sw *ssz.StreamWriter, fork ssz.Fork) (dst []byte, err error) {
+	dst = buf
+	switch {
+	case fork >= ssz.ForkBellatrix:
+		offset := int(12)
+		// Field (0) 'Slot'
+		dst = ssz.MarshalUint64(dst, f.Slot)
+
+		// Offset (1) 'Payload'
+		dst = ssz.WriteOffset(dst, offset)
+
+		// Field (1) 'Payload'
+		if dst, err = f.Payload.MarshalSSZToStreamFork(dst, sw, fork); err != nil {
+			return
+		}
+
+	default:
+		// Field (0) 'Slot'
+		dst = ssz.MarshalUint64(dst, f.Slot)
+
+	}
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ForkBody object
+func (f *ForkBody) UnmarshalSSZ(buf []byte) error {
+	return f.UnmarshalSSZForkWithOptions(buf, ssz.ForkDeneb, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the ForkBody object with the decoding options
+func (f *ForkBody) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	return f.UnmarshalSSZForkWithOptions(buf, ssz.ForkDeneb, opts)
+}
+
+// UnmarshalSSZFork ssz unmarshals the ForkBody object for a given fork
+func (f *ForkBody) UnmarshalSSZFork(buf []byte, fork ssz.Fork) error {
+	return f.UnmarshalSSZForkWithOptions(buf, fork, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZForkWithOptions ssz unmarshals the ForkBody object for a given fork with the decoding options
+func (f *ForkBody) UnmarshalSSZForkWithOptions(buf []byte, fork ssz.Fork, opts ssz.UnmarshalOptions) error {
+	var err error
+	switch {
+	case fork >= ssz.ForkBellatrix:
+		size := uint64(len(buf))
+		if size < 12 {
+			return ssz.ErrSize
+		}
+
+		tail := buf
+		var o1 uint64
+
+		// Field (0) 'Slot'
+		f.Slot = ssz.UnmarshallUint64(buf[0:8])
+
+		// Offset (1) 'Payload'
+		if o1 = ssz.ReadOffset(buf[8:12]); o1 > size {
+			return ssz.ErrOffset
+		}
+
+		if err = opts.ValidateFirstOffset(o1, 12); err != nil {
+			return err
+		}
+
+		// Field (1) 'Payload'
+		{
+			buf = tail[o1:]
+			if f.Payload == nil {
+				f.Payload = new(ForkPayload)
+			}
+			if err = f.Payload.UnmarshalSSZForkWithOptions(buf, fork, opts); err != nil {
+				return err
+			}
+		}
+	default:
+		size := uint64(len(buf))
+		if size != 8 {
+			return ssz.ErrSize
+		}
+
+		// Field (0) 'Slot'
+		f.Slot = ssz.UnmarshallUint64(buf[0:8])
+
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ForkBody object
+func (f *ForkBody) SizeSSZ() (size int) {
+	return f.SizeSSZFork(ssz.ForkDeneb)
+}
+
+// SizeSSZFork returns the ssz encoded size in bytes for the ForkBody object for a given fork
+func (f *ForkBody) SizeSSZFork(fork ssz.Fork) (size int) {
+	switch {
+	case fork >= ssz.ForkBellatrix:
+		size = 12
+		// Field (1) 'Payload'
+		if f.Payload == nil {
+			f.Payload = new(ForkPayload)
+		}
+		size += f.Payload.SizeSSZFork(fork)
+	default:
+		size = 8
+
+	}
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the ForkBody object
+func (f *ForkBody) MinSSZSize() uint64 {
+	return f.MinSSZSizeFork(ssz.ForkDeneb)
+}
+
+// MinSSZSizeFork returns the minimum ssz encoded size in bytes for the ForkBody object for a given fork
+func (f *ForkBody) MinSSZSizeFork(fork ssz.Fork) uint64 {
+	switch {
+	case fork >= ssz.ForkCapella:
+		return 36
+	case fork >= ssz.ForkBellatrix:
+		return 40
+	default:
+		return 8
+	}
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the ForkBody object
+func (f *ForkBody) MaxSSZSize() uint64 {
+	return f.MaxSSZSizeFork(ssz.ForkDeneb)
+}
+
+// MaxSSZSizeFork returns the maximum ssz encoded size in bytes for the ForkBody object for a given fork
+func (f *ForkBody) MaxSSZSizeFork(fork ssz.Fork) uint64 {
+	switch {
+	case fork >= ssz.ForkCapella:
+		return 100
+	case fork >= ssz.ForkBellatrix:
+		return 72
+	default:
+		return 8
+	}
+}
+
+// HashTreeRoot ssz hashes the ForkBody object
+func (f *ForkBody) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(f)
+}
+
+// HashTreeRootWith ssz hashes the ForkBody object with a hasher
+func (f *ForkBody) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	return f.HashTreeRootWithFork(hh, ssz.ForkDeneb)
+}
+
+// HashTreeRootFork ssz hashes the ForkBody object for a given fork
+func (f *ForkBody) HashTreeRootFork(fork ssz.Fork) ([32]byte, error) {
+	return ssz.HashWithDefaultHasherFork(f, fork)
+}
+
+// HashTreeRootWithFork ssz hashes the ForkBody object with a hasher for a given fork
+func (f *ForkBody) HashTreeRootWithFork(hh ssz.HashWalker, fork ssz.Fork) (err error) {
+	switch {
+	case fork >= ssz.ForkBellatrix:
+		indx := hh.Index()
+
+		// Field (0) 'Slot'
+		hh.PutUint64(f.Slot)
+
+		// Field (1) 'Payload'
+		if err = f.Payload.HashTreeRootWithFork(hh, fork); err != nil {
+			return
+		}
+
+		hh.Merkleize(indx)
+	default:
+		indx := hh.Index()
+
+		// Field (0) 'Slot'
+		hh.PutUint64(f.Slot)
+
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded ForkBody object without decoding it
+func (f *ForkBody) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(f, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded ForkBody object with a hasher
+func (f *ForkBody) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	return f.HashTreeRootSSZWithFork(hh, buf, ssz.ForkDeneb)
+}
+
+// HashTreeRootSSZFork ssz hashes the encoded ForkBody object for a given fork without decoding it
+func (f *ForkBody) HashTreeRootSSZFork(buf []byte, fork ssz.Fork) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasherFork(f, buf, fork)
+}
+
+// HashTreeRootSSZWithFork ssz hashes the encoded ForkBody object with a hasher for a given fork
+func (f *ForkBody) HashTreeRootSSZWithFork(hh ssz.HashWalker, buf []byte, fork ssz.Fork) (err error) {
+	switch {
+	case fork >= ssz.ForkBellatrix:
+		size := uint64(len(buf))
+		if size < 12 {
+			return ssz.ErrSize
+		}
+
+		tail := buf
+		var o1 uint64
+
+		// Offset (1) 'Payload'
+		if o1 = ssz.ReadOffset(buf[8:12]); o1 > size {
+			return ssz.ErrOffset
+		}
+
+		if err = ssz.ValidateFirstOffset(o1, 12); err != nil {
+			return err
+		}
+
+		indx := hh.Index()
+
+		// Field (0) 'Slot'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
+
+		// Field (1) 'Payload'
+		{
+			buf := tail[o1:]
+			if err = (*ForkPayload)(nil).HashTreeRootSSZWithFork(hh, buf, fork); err != nil {
+				return err
+			}
+		}
+
+		hh.Merkleize(indx)
+	default:
+		size := uint64(len(buf))
+		if size != 8 {
+			return ssz.ErrSize
+		}
+
+		indx := hh.Index()
+
+		// Field (0) 'Slot'
+		hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
+
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// GetTree ssz hashes the ForkBody object
+func (f *ForkBody) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(f)
+}
+
+// GetTreeFork ssz hashes the ForkBody object for a given fork
+func (f *ForkBody) GetTreeFork(fork ssz.Fork) (*ssz.Node, error) {
+	return ssz.ProofTreeFork(f, fork)
+}
+
+const (
+	// ForkBodySlotGIndex is the generalized index of the 'Slot' field at the deneb fork
+	ForkBodySlotGIndex ssz.GIndex = 2
+	// ForkBodyPayloadGIndex is the generalized index of the 'Payload' field at the deneb fork
+	ForkBodyPayloadGIndex ssz.GIndex = 3
+)
+
+// ProveSlot returns a merkle proof of the 'Slot' field of the ForkBody object
+func (f *ForkBody) ProveSlot() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(f, ForkBodySlotGIndex)
+}
+
+// VerifyForkBodySlot verifies a merkle proof of the 'Slot' field of a ForkBody object
+func VerifyForkBodySlot(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ForkBodySlotGIndex)
+}
+
+// ProvePayload returns a merkle proof of the 'Payload' field of the ForkBody object
+func (f *ForkBody) ProvePayload() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(f, ForkBodyPayloadGIndex)
+}
+
+// VerifyForkBodyPayload verifies a merkle proof of the 'Payload' field of a ForkBody object
+func VerifyForkBodyPayload(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ForkBodyPayloadGIndex)
+}
+
+// ForkBodySlotGIndexFork returns the generalized index of the 'Slot' field for a given fork
+func ForkBodySlotGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkBellatrix:
+		return 2, nil
+	default:
+		return 1, nil
+	}
+}
+
+// ProveSlotFork returns a merkle proof of the 'Slot' field of the ForkBody object for a given fork
+func (f *ForkBody) ProveSlotFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ForkBodySlotGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(f, gindex, fork)
+}
+
+// VerifyForkBodySlotFork verifies a merkle proof of the 'Slot' field of a ForkBody object for a given fork
+func VerifyForkBodySlotFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ForkBodySlotGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// ForkBodyPayloadGIndexFork returns the generalized index of the 'Payload' field for a given fork
+func ForkBodyPayloadGIndexFork(fork ssz.Fork) (ssz.GIndex, error) {
+	switch {
+	case fork >= ssz.ForkBellatrix:
+		return 3, nil
+	default:
+		return 0, ssz.ErrForkField
+	}
+}
+
+// ProvePayloadFork returns a merkle proof of the 'Payload' field of the ForkBody object for a given fork
+func (f *ForkBody) ProvePayloadFork(fork ssz.Fork) (*ssz.Proof, error) {
+	gindex, err := ForkBodyPayloadGIndexFork(fork)
+	if err != nil {
+		return nil, err
+	}
+	return ssz.ProveGIndexFork(f, gindex, fork)
+}
+
+// VerifyForkBodyPayloadFork verifies a merkle proof of the 'Payload' field of a ForkBody object for a given fork
+func VerifyForkBodyPayloadFork(root []byte, proof *ssz.Proof, fork ssz.Fork) (bool, error) {
+	gindex, err := ForkBodyPayloadGIndexFork(fork)
+	if err != nil {
+		return false, err
+	}
+	return ssz.VerifyProofAtGIndex(root, proof, gindex)
+}
+
+// SchemaSSZ returns the schema of the tree of the ForkBody object
+func (f *ForkBody) SchemaSSZ() *ssz.Schema {
+	return f.SchemaSSZFork(ssz.ForkDeneb)
+}
+
+// SchemaSSZFork returns the schema of the tree of the ForkBody object for a given fork
+func (f *ForkBody) SchemaSSZFork(fork ssz.Fork) *ssz.Schema {
+	switch {
+	case fork >= ssz.ForkBellatrix:
+		return ssz.SchemaContainer(
+			&ssz.SchemaField{Name: "Slot", Schema: ssz.SchemaUint(8)},
+			&ssz.SchemaField{Name: "Payload", Schema: (*ForkPayload)(nil).SchemaSSZFork(fork)},
+		)
+	default:
+		return ssz.SchemaContainer(
+			&ssz.SchemaField{Name: "Slot", Schema: ssz.SchemaUint(8)},
+		)
+	}
+}
+
+// MarshalSSZ ssz marshals the ForkPayloadPhase0 object
+func (f *ForkPayloadPhase0) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(f)
+}
+
+// MarshalSSZTo ssz marshals the ForkPayloadPhase0 object to a target array
+func (f *ForkPayloadPhase0) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(20)
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint64(dst, f.A)
+
+	// Offset (1) 'B'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (2) 'E'
+	dst = ssz.MarshalUint64(dst, f.E)
+
+	// Field (1) 'B'
+	if size := len(f.B); size > 32 {
+		err = ssz.ErrBytesLengthFn("ForkPayloadPhase0.B", size, 32)
+		return
+	}
+	dst = append(dst, f.B...)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the ForkPayloadPhase0 object to a writer
+func (f *ForkPayloadPhase0) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(f, writer)
+}
+
+// MarshalSSZToStream ssz marshals the ForkPayloadPhase0 object to a target array that is flushed to a stream writer
+func (f *ForkPayloadPhase0) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(20)
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint64(dst, f.A)
+
+	// Offset (1) 'B'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (2) 'E'
+	dst = ssz.MarshalUint64(dst, f.E)
+
+	// Field (1) 'B'
+	if size := len(f.B); size > 32 {
+		err = ssz.ErrBytesLengthFn("ForkPayloadPhase0.B", size, 32)
+		return
+	}
+	if dst, err = sw.Append(dst, f.B); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ForkPayloadPhase0 object
+func (f *ForkPayloadPhase0) UnmarshalSSZ(buf []byte) error {
+	return f.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the ForkPayloadPhase0 object with the decoding options
+func (f *ForkPayloadPhase0) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 20 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'A'
+	f.A = ssz.UnmarshallUint64(buf[0:8])
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = opts.ValidateFirstOffset(o1, 20); err != nil {
+		return err
+	}
+
+	// Field (2) 'E'
+	f.E = ssz.UnmarshallUint64(buf[12:20])
+
+	// Field (1) 'B'
+	{
+		buf = tail[o1:]
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(f.B) == 0 {
+			f.B = make([]byte, 0, len(buf))
+		}
+		f.B = append(f.B, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ForkPayloadPhase0 object
+func (f *ForkPayloadPhase0) SizeSSZ() (size int) {
+	size = 20
+
+	// Field (1) 'B'
+	size += len(f.B)
+
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the ForkPayloadPhase0 object
+func (f *ForkPayloadPhase0) MinSSZSize() uint64 {
+	return 20
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the ForkPayloadPhase0 object
+func (f *ForkPayloadPhase0) MaxSSZSize() uint64 {
+	return 52
+}
+
+// HashTreeRoot ssz hashes the ForkPayloadPhase0 object
+func (f *ForkPayloadPhase0) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(f)
+}
+
+// HashTreeRootWith ssz hashes the ForkPayloadPhase0 object with a hasher
+func (f *ForkPayloadPhase0) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint64(f.A)
+
+	// Field (1) 'B'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(f.B))
+		if byteLen > 32 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(f.B)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+	}
+
+	// Field (2) 'E'
+	hh.PutUint64(f.E)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded ForkPayloadPhase0 object without decoding it
+func (f *ForkPayloadPhase0) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(f, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded ForkPayloadPhase0 object with a hasher
+func (f *ForkPayloadPhase0) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 20 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = ssz.ValidateFirstOffset(o1, 20); err != nil {
+		return err
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'B'
+	{
+		buf := tail[o1:]
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		{
+			elemIndx := hh.Index()
+			hh.Append(buf)
+			hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (32+31)/32)
+		}
+	}
+
+	// Field (2) 'E'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[12:20]))
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ForkPayloadPhase0 object
+func (f *ForkPayloadPhase0) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(f)
+}
+
+const (
+	// ForkPayloadPhase0AGIndex is the generalized index of the 'A' field
+	ForkPayloadPhase0AGIndex ssz.GIndex = 4
+	// ForkPayloadPhase0BGIndex is the generalized index of the 'B' field
+	ForkPayloadPhase0BGIndex ssz.GIndex = 5
+	// ForkPayloadPhase0EGIndex is the generalized index of the 'E' field
+	ForkPayloadPhase0EGIndex ssz.GIndex = 6
+)
+
+// ProveA returns a merkle proof of the 'A' field of the ForkPayloadPhase0 object
+func (f *ForkPayloadPhase0) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(f, ForkPayloadPhase0AGIndex)
+}
+
+// VerifyForkPayloadPhase0A verifies a merkle proof of the 'A' field of a ForkPayloadPhase0 object
+func VerifyForkPayloadPhase0A(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ForkPayloadPhase0AGIndex)
+}
+
+// ProveB returns a merkle proof of the 'B' field of the ForkPayloadPhase0 object
+func (f *ForkPayloadPhase0) ProveB() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(f, ForkPayloadPhase0BGIndex)
+}
+
+// VerifyForkPayloadPhase0B verifies a merkle proof of the 'B' field of a ForkPayloadPhase0 object
+func VerifyForkPayloadPhase0B(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ForkPayloadPhase0BGIndex)
+}
+
+// ProveE returns a merkle proof of the 'E' field of the ForkPayloadPhase0 object
+func (f *ForkPayloadPhase0) ProveE() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(f, ForkPayloadPhase0EGIndex)
+}
+
+// VerifyForkPayloadPhase0E verifies a merkle proof of the 'E' field of a ForkPayloadPhase0 object
+func VerifyForkPayloadPhase0E(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ForkPayloadPhase0EGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the ForkPayloadPhase0 object
+func (f *ForkPayloadPhase0) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "B", Schema: ssz.SchemaList(ssz.SchemaUint(1), 32)},
+		&ssz.SchemaField{Name: "E", Schema: ssz.SchemaUint(8)},
+	)
+}
+
+// MarshalSSZ ssz marshals the ForkPayloadCapella object
+func (f *ForkPayloadCapella) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(f)
+}
+
+// MarshalSSZTo ssz marshals the ForkPayloadCapella object to a target array
+func (f *ForkPayloadCapella) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(24)
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint64(dst, f.A)
+
+	// Offset (1) 'B'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(f.B)
+
+	// Field (2) 'C'
+	dst = ssz.MarshalUint64(dst, f.C)
+
+	// Offset (3) 'D'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'B'
+	if size := len(f.B); size > 32 {
+		err = ssz.ErrBytesLengthFn("ForkPayloadCapella.B", size, 32)
+		return
+	}
+	dst = append(dst, f.B...)
+
+	// Field (3) 'D'
+	if size := len(f.D); size > 4 {
+		err = ssz.ErrListTooBigFn("ForkPayloadCapella.D", size, 4)
+		return
+	}
+	for ii := 0; ii < len(f.D); ii++ {
+		dst = ssz.MarshalUint64(dst, f.D[ii])
+
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the ForkPayloadCapella object to a writer
+func (f *ForkPayloadCapella) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(f, writer)
+}
+
+// MarshalSSZToStream ssz marshals the ForkPayloadCapella object to a target array that is flushed to a stream writer
+func (f *ForkPayloadCapella) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(24)
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint64(dst, f.A)
+
+	// Offset (1) 'B'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(f.B)
+
+	// Field (2) 'C'
+	dst = ssz.MarshalUint64(dst, f.C)
+
+	// Offset (3) 'D'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'B'
+	if size := len(f.B); size > 32 {
+		err = ssz.ErrBytesLengthFn("ForkPayloadCapella.B", size, 32)
+		return
+	}
+	if dst, err = sw.Append(dst, f.B); err != nil {
+		return
+	}
+
+	// Field (3) 'D'
+	if size := len(f.D); size > 4 {
+		err = ssz.ErrListTooBigFn("ForkPayloadCapella.D", size, 4)
+		return
+	}
+	for ii := 0; ii < len(f.D); ii++ {
+		dst = ssz.MarshalUint64(dst, f.D[ii])
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ForkPayloadCapella object
+func (f *ForkPayloadCapella) UnmarshalSSZ(buf []byte) error {
+	return f.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the ForkPayloadCapella object with the decoding options
+func (f *ForkPayloadCapella) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 24 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1, o3 uint64
+
+	// Field (0) 'A'
+	f.A = ssz.UnmarshallUint64(buf[0:8])
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = opts.ValidateFirstOffset(o1, 24); err != nil {
+		return err
+	}
+
+	// Field (2) 'C'
+	f.C = ssz.UnmarshallUint64(buf[12:20])
+
+	// Offset (3) 'D'
+	if o3 = ssz.ReadOffset(buf[20:24]); o3 > size || o1 > o3 {
+		return ssz.ErrOffset
+	}
+
+	// Field (1) 'B'
+	{
+		buf = tail[o1:o3]
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(f.B) == 0 {
+			f.B = make([]byte, 0, len(buf))
+		}
+		f.B = append(f.B, buf...)
+	}
+
+	// Field (3) 'D'
+	{
+		buf = tail[o3:]
+		num, err := ssz.DivideInt2(len(buf), 8, 4)
+		if err != nil {
+			return err
+		}
+		f.D = ssz.ExtendUint64(f.D, num)
+		for ii := 0; ii < num; ii++ {
+			f.D[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ForkPayloadCapella object
+func (f *ForkPayloadCapella) SizeSSZ() (size int) {
+	size = 24
+
+	// Field (1) 'B'
+	size += len(f.B)
+
+	// Field (3) 'D'
+	size += len(f.D) * 8
+
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the ForkPayloadCapella object
+func (f *ForkPayloadCapella) MinSSZSize() uint64 {
+	return 24
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the ForkPayloadCapella object
+func (f *ForkPayloadCapella) MaxSSZSize() uint64 {
+	return 88
+}
+
+// HashTreeRoot ssz hashes the ForkPayloadCapella object
+func (f *ForkPayloadCapella) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(f)
+}
+
+// HashTreeRootWith ssz hashes the ForkPayloadCapella object with a hasher
+func (f *ForkPayloadCapella) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint64(f.A)
+
+	// Field (1) 'B'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(f.B))
+		if byteLen > 32 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(f.B)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+	}
+
+	// Field (2) 'C'
+	hh.PutUint64(f.C)
+
+	// Field (3) 'D'
+	{
+		if size := len(f.D); size > 4 {
+			err = ssz.ErrListTooBigFn("ForkPayloadCapella.D", size, 4)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range f.D {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+		numItems := uint64(len(f.D))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(4, numItems, 8))
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded ForkPayloadCapella object without decoding it
+func (f *ForkPayloadCapella) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(f, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded ForkPayloadCapella object with a hasher
+func (f *ForkPayloadCapella) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 24 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1, o3 uint64
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = ssz.ValidateFirstOffset(o1, 24); err != nil {
+		return err
+	}
+
+	// Offset (3) 'D'
+	if o3 = ssz.ReadOffset(buf[20:24]); o3 > size || o1 > o3 {
+		return ssz.ErrOffset
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'B'
+	{
+		buf := tail[o1:o3]
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		{
+			elemIndx := hh.Index()
+			hh.Append(buf)
+			hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (32+31)/32)
+		}
+	}
+
+	// Field (2) 'C'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[12:20]))
+
+	// Field (3) 'D'
+	{
+		buf := tail[o3:]
+		{
+			subIndx := hh.Index()
+			num, err := ssz.DivideInt2(len(buf), 8, 4)
+			if err != nil {
+				return err
+			}
+			hh.Append(buf)
+			hh.FillUpTo32()
+			hh.MerkleizeWithMixin(subIndx, uint64(num), ssz.CalculateLimit(4, uint64(num), 8))
+		}
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ForkPayloadCapella object
+func (f *ForkPayloadCapella) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(f)
+}
+
+const (
+	// ForkPayloadCapellaAGIndex is the generalized index of the 'A' field
+	ForkPayloadCapellaAGIndex ssz.GIndex = 4
+	// ForkPayloadCapellaBGIndex is the generalized index of the 'B' field
+	ForkPayloadCapellaBGIndex ssz.GIndex = 5
+	// ForkPayloadCapellaCGIndex is the generalized index of the 'C' field
+	ForkPayloadCapellaCGIndex ssz.GIndex = 6
+	// ForkPayloadCapellaDGIndex is the generalized index of the 'D' field
+	ForkPayloadCapellaDGIndex ssz.GIndex = 7
+)
+
+// ProveA returns a merkle proof of the 'A' field of the ForkPayloadCapella object
+func (f *ForkPayloadCapella) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(f, ForkPayloadCapellaAGIndex)
+}
+
+// VerifyForkPayloadCapellaA verifies a merkle proof of the 'A' field of a ForkPayloadCapella object
+func VerifyForkPayloadCapellaA(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ForkPayloadCapellaAGIndex)
+}
+
+// ProveB returns a merkle proof of the 'B' field of the ForkPayloadCapella object
+func (f *ForkPayloadCapella) ProveB() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(f, ForkPayloadCapellaBGIndex)
+}
+
+// VerifyForkPayloadCapellaB verifies a merkle proof of the 'B' field of a ForkPayloadCapella object
+func VerifyForkPayloadCapellaB(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ForkPayloadCapellaBGIndex)
+}
+
+// ProveC returns a merkle proof of the 'C' field of the ForkPayloadCapella object
+func (f *ForkPayloadCapella) ProveC() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(f, ForkPayloadCapellaCGIndex)
+}
+
+// VerifyForkPayloadCapellaC verifies a merkle proof of the 'C' field of a ForkPayloadCapella object
+func VerifyForkPayloadCapellaC(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ForkPayloadCapellaCGIndex)
+}
+
+// ProveD returns a merkle proof of the 'D' field of the ForkPayloadCapella object
+func (f *ForkPayloadCapella) ProveD() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(f, ForkPayloadCapellaDGIndex)
+}
+
+// VerifyForkPayloadCapellaD verifies a merkle proof of the 'D' field of a ForkPayloadCapella object
+func VerifyForkPayloadCapellaD(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ForkPayloadCapellaDGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the ForkPayloadCapella object
+func (f *ForkPayloadCapella) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "B", Schema: ssz.SchemaList(ssz.SchemaUint(1), 32)},
+		&ssz.SchemaField{Name: "C", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "D", Schema: ssz.SchemaList(ssz.SchemaUint(8), 4)},
+	)
+}
+
+// MarshalSSZ ssz marshals the ForkBodyBellatrix object
+func (f *ForkBodyBellatrix) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(f)
+}
+
+// MarshalSSZTo ssz marshals the ForkBodyBellatrix object to a target array
+func (f *ForkBodyBellatrix) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(12)
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, f.Slot)
+
+	// Offset (1) 'Payload'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'Payload'
+	if dst, err = f.Payload.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the ForkBodyBellatrix object to a writer
+func (f *ForkBodyBellatrix) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(f, writer)
+}
+
+// MarshalSSZToStream ssz marshals the ForkBodyBellatrix object to a target array that is flushed to a stream writer
+func (f *ForkBodyBellatrix) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(12)
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, f.Slot)
+
+	// Offset (1) 'Payload'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'Payload'
+	if dst, err = f.Payload.MarshalSSZToStream(dst, sw); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ForkBodyBellatrix object
+func (f *ForkBodyBellatrix) UnmarshalSSZ(buf []byte) error {
+	return f.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the ForkBodyBellatrix object with the decoding options
+func (f *ForkBodyBellatrix) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 12 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'Slot'
+	f.Slot = ssz.UnmarshallUint64(buf[0:8])
+
+	// Offset (1) 'Payload'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = opts.ValidateFirstOffset(o1, 12); err != nil {
+		return err
+	}
+
+	// Field (1) 'Payload'
+	{
+		buf = tail[o1:]
+		if f.Payload == nil {
+			f.Payload = new(ForkPayloadAltair)
+		}
+		if err = f.Payload.UnmarshalSSZWithOptions(buf, opts); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ForkBodyBellatrix object
+func (f *ForkBodyBellatrix) SizeSSZ() (size int) {
+	size = 12
+
+	// Field (1) 'Payload'
+	if f.Payload == nil {
+		f.Payload = new(ForkPayloadAltair)
+	}
+	size += f.Payload.SizeSSZ()
+
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the ForkBodyBellatrix object
+func (f *ForkBodyBellatrix) MinSSZSize() uint64 {
+	return 40
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the ForkBodyBellatrix object
+func (f *ForkBodyBellatrix) MaxSSZSize() uint64 {
+	return 72
+}
+
+// HashTreeRoot ssz hashes the ForkBodyBellatrix object
+func (f *ForkBodyBellatrix) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(f)
+}
+
+// HashTreeRootWith ssz hashes the ForkBodyBellatrix object with a hasher
+func (f *ForkBodyBellatrix) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(f.Slot)
+
+	// Field (1) 'Payload'
+	if err = f.Payload.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded ForkBodyBellatrix object without decoding it
+func (f *ForkBodyBellatrix) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(f, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded ForkBodyBellatrix object with a hasher
+func (f *ForkBodyBellatrix) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 12 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Offset (1) 'Payload'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = ssz.ValidateFirstOffset(o1, 12); err != nil {
+		return err
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'Payload'
+	{
+		buf := tail[o1:]
+		if err = (*ForkPayloadAltair)(nil).HashTreeRootSSZWith(hh, buf); err != nil {
+			return err
+		}
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ForkBodyBellatrix object
+func (f *ForkBodyBellatrix) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(f)
+}
+
+const (
+	// ForkBodyBellatrixSlotGIndex is the generalized index of the 'Slot' field
+	ForkBodyBellatrixSlotGIndex ssz.GIndex = 2
+	// ForkBodyBellatrixPayloadGIndex is the generalized index of the 'Payload' field
+	ForkBodyBellatrixPayloadGIndex ssz.GIndex = 3
+)
+
+// ProveSlot returns a merkle proof of the 'Slot' field of the ForkBodyBellatrix object
+func (f *ForkBodyBellatrix) ProveSlot() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(f, ForkBodyBellatrixSlotGIndex)
+}
+
+// VerifyForkBodyBellatrixSlot verifies a merkle proof of the 'Slot' field of a ForkBodyBellatrix object
+func VerifyForkBodyBellatrixSlot(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ForkBodyBellatrixSlotGIndex)
+}
+
+// ProvePayload returns a merkle proof of the 'Payload' field of the ForkBodyBellatrix object
+func (f *ForkBodyBellatrix) ProvePayload() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(f, ForkBodyBellatrixPayloadGIndex)
+}
+
+// VerifyForkBodyBellatrixPayload verifies a merkle proof of the 'Payload' field of a ForkBodyBellatrix object
+func VerifyForkBodyBellatrixPayload(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ForkBodyBellatrixPayloadGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the ForkBodyBellatrix object
+func (f *ForkBodyBellatrix) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Slot", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "Payload", Schema: (*ForkPayloadAltair)(nil).SchemaSSZ()},
+	)
+}
+
+// MarshalSSZ ssz marshals the ForkPayloadAltair object
+func (f *ForkPayloadAltair) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(f)
+}
+
+// MarshalSSZTo ssz marshals the ForkPayloadAltair object to a target array
+func (f *ForkPayloadAltair) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(28)
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint64(dst, f.A)
+
+	// Offset (1) 'B'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (2) 'C'
+	dst = ssz.MarshalUint64(dst, f.C)
+
+	// Field (3) 'E'
+	dst = ssz.MarshalUint64(dst, f.E)
+
+	// Field (1) 'B'
+	if size := len(f.B); size > 32 {
+		err = ssz.ErrBytesLengthFn("ForkPayloadAltair.B", size, 32)
+		return
+	}
+	dst = append(dst, f.B...)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the ForkPayloadAltair object to a writer
+func (f *ForkPayloadAltair) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(f, writer)
+}
+
+// MarshalSSZToStream ssz marshals the ForkPayloadAltair object to a target array that is flushed to a stream writer
+func (f *ForkPayloadAltair) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(28)
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint64(dst, f.A)
+
+	// Offset (1) 'B'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (2) 'C'
+	dst = ssz.MarshalUint64(dst, f.C)
+
+	// Field (3) 'E'
+	dst = ssz.MarshalUint64(dst, f.E)
+
+	// Field (1) 'B'
+	if size := len(f.B); size > 32 {
+		err = ssz.ErrBytesLengthFn("ForkPayloadAltair.B", size, 32)
+		return
+	}
+	if dst, err = sw.Append(dst, f.B); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ForkPayloadAltair object
+func (f *ForkPayloadAltair) UnmarshalSSZ(buf []byte) error {
+	return f.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the ForkPayloadAltair object with the decoding options
+func (f *ForkPayloadAltair) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 28 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'A'
+	f.A = ssz.UnmarshallUint64(buf[0:8])
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = opts.ValidateFirstOffset(o1, 28); err != nil {
+		return err
+	}
+
+	// Field (2) 'C'
+	f.C = ssz.UnmarshallUint64(buf[12:20])
+
+	// Field (3) 'E'
+	f.E = ssz.UnmarshallUint64(buf[20:28])
+
+	// Field (1) 'B'
+	{
+		buf = tail[o1:]
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(f.B) == 0 {
+			f.B = make([]byte, 0, len(buf))
+		}
+		f.B = append(f.B, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ForkPayloadAltair object
+func (f *ForkPayloadAltair) SizeSSZ() (size int) {
+	size = 28
+
+	// Field (1) 'B'
+	size += len(f.B)
+
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the ForkPayloadAltair object
+func (f *ForkPayloadAltair) MinSSZSize() uint64 {
+	return 28
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the ForkPayloadAltair object
+func (f *ForkPayloadAltair) MaxSSZSize() uint64 {
+	return 60
+}
+
+// HashTreeRoot ssz hashes the ForkPayloadAltair object
+func (f *ForkPayloadAltair) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(f)
+}
+
+// HashTreeRootWith ssz hashes the ForkPayloadAltair object with a hasher
+func (f *ForkPayloadAltair) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint64(f.A)
+
+	// Field (1) 'B'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(f.B))
+		if byteLen > 32 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(f.B)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+	}
+
+	// Field (2) 'C'
+	hh.PutUint64(f.C)
+
+	// Field (3) 'E'
+	hh.PutUint64(f.E)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded ForkPayloadAltair object without decoding it
+func (f *ForkPayloadAltair) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(f, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded ForkPayloadAltair object with a hasher
+func (f *ForkPayloadAltair) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 28 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = ssz.ValidateFirstOffset(o1, 28); err != nil {
+		return err
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'B'
+	{
+		buf := tail[o1:]
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		{
+			elemIndx := hh.Index()
+			hh.Append(buf)
+			hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (32+31)/32)
+		}
+	}
+
+	// Field (2) 'C'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[12:20]))
+
+	// Field (3) 'E'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[20:28]))
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the ForkPayloadAltair object
+func (f *ForkPayloadAltair) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(f)
+}
+
+const (
+	// ForkPayloadAltairAGIndex is the generalized index of the 'A' field
+	ForkPayloadAltairAGIndex ssz.GIndex = 4
+	// ForkPayloadAltairBGIndex is the generalized index of the 'B' field
+	ForkPayloadAltairBGIndex ssz.GIndex = 5
+	// ForkPayloadAltairCGIndex is the generalized index of the 'C' field
+	ForkPayloadAltairCGIndex ssz.GIndex = 6
+	// ForkPayloadAltairEGIndex is the generalized index of the 'E' field
+	ForkPayloadAltairEGIndex ssz.GIndex = 7
+)
+
+// ProveA returns a merkle proof of the 'A' field of the ForkPayloadAltair object
+func (f *ForkPayloadAltair) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(f, ForkPayloadAltairAGIndex)
+}
+
+// VerifyForkPayloadAltairA verifies a merkle proof of the 'A' field of a ForkPayloadAltair object
+func VerifyForkPayloadAltairA(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ForkPayloadAltairAGIndex)
+}
+
+// ProveB returns a merkle proof of the 'B' field of the ForkPayloadAltair object
+func (f *ForkPayloadAltair) ProveB() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(f, ForkPayloadAltairBGIndex)
+}
+
+// VerifyForkPayloadAltairB verifies a merkle proof of the 'B' field of a ForkPayloadAltair object
+func VerifyForkPayloadAltairB(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ForkPayloadAltairBGIndex)
+}
+
+// ProveC returns a merkle proof of the 'C' field of the ForkPayloadAltair object
+func (f *ForkPayloadAltair) ProveC() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(f, ForkPayloadAltairCGIndex)
+}
+
+// VerifyForkPayloadAltairC verifies a merkle proof of the 'C' field of a ForkPayloadAltair object
+func VerifyForkPayloadAltairC(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ForkPayloadAltairCGIndex)
+}
+
+// ProveE returns a merkle proof of the 'E' field of the ForkPayloadAltair object
+func (f *ForkPayloadAltair) ProveE() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(f, ForkPayloadAltairEGIndex)
+}
+
+// VerifyForkPayloadAltairE verifies a merkle proof of the 'E' field of a ForkPayloadAltair object
+func VerifyForkPayloadAltairE(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ForkPayloadAltairEGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the ForkPayloadAltair object
+func (f *ForkPayloadAltair) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "B", Schema: ssz.SchemaList(ssz.SchemaUint(1), 32)},
+		&ssz.SchemaField{Name: "C", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "E", Schema: ssz.SchemaUint(8)},
+	)
+}
@@ -0,0 +1,152 @@
+package testcases
+
+import (
+	"bytes"
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+type forkObj interface {
+	ssz.Marshaler
+	ssz.HashRoot
+}
+
+func TestForkAware(t *testing.T) {
+	cases := []struct {
+		fork ssz.Fork
+		obj  forkObj
+		// the fork aware object with the same values
+		forkObj *ForkPayload
+	}{
+		{
+			fork:    ssz.ForkPhase0,
+			obj:     &ForkPayloadPhase0{A: 1, B: []byte{1, 2}, E: 3},
+			forkObj: &ForkPayload{A: 1, B: []byte{1, 2}, E: 3},
+		},
+		{
+			fork:    ssz.ForkAltair,
+			obj:     &ForkPayloadAltair{A: 1, B: []byte{1, 2}, C: 2, E: 3},
+			forkObj: &ForkPayload{A: 1, B: []byte{1, 2}, C: 2, E: 3},
+		},
+		{
+			fork:    ssz.ForkCapella,
+			obj:     &ForkPayloadCapella{A: 1, B: []byte{1, 2}, C: 2, D: []uint64{4, 5}},
+			forkObj: &ForkPayload{A: 1, B: []byte{1, 2}, C: 2, D: []uint64{4, 5}},
+		},
+	}
+
+	for _, c := range cases {
+		expected, err := c.obj.MarshalSSZ()
+		if err != nil {
+			t.Fatal(err)
+		}
+		found, err := c.forkObj.MarshalSSZFork(c.fork)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(expected, found) {
+			t.Fatalf("%s: marshal mismatch", c.fork)
+		}
+
+		var w bytes.Buffer
+		if _, err := c.forkObj.MarshalSSZToWriterFork(&w, c.fork); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(expected, w.Bytes()) {
+			t.Fatalf("%s: marshal to writer mismatch", c.fork)
+		}
+
+		obj := &ForkPayload{}
+		if err := obj.UnmarshalSSZFork(expected, c.fork); err != nil {
+			t.Fatal(err)
+		}
+		if obj.SizeSSZFork(c.fork) != len(expected) {
+			t.Fatalf("%s: size mismatch", c.fork)
+		}
+
+		expectedRoot, err := c.obj.HashTreeRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		foundRoot, err := obj.HashTreeRootFork(c.fork)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expectedRoot != foundRoot {
+			t.Fatalf("%s: root mismatch", c.fork)
+		}
+
+		sszRoot, err := obj.HashTreeRootSSZFork(expected, c.fork)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expectedRoot != sszRoot {
+			t.Fatalf("%s: root from the encoding mismatch", c.fork)
+		}
+	}
+}
+
+func TestForkAwareNested(t *testing.T) {
+	expected, err := (&ForkBodyBellatrix{Slot: 1, Payload: &ForkPayloadAltair{A: 1, C: 2, E: 3}}).MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	obj := &ForkBody{}
+	if err := obj.UnmarshalSSZFork(expected, ssz.ForkBellatrix); err != nil {
+		t.Fatal(err)
+	}
+	if obj.Payload.E != 3 {
+		t.Fatalf("bad nested field")
+	}
+	found, err := obj.MarshalSSZFork(ssz.ForkBellatrix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(expected, found) {
+		t.Fatal("marshal mismatch")
+	}
+
+	expectedRoot, err := (&ForkBodyBellatrix{Slot: 1, Payload: &ForkPayloadAltair{A: 1, C: 2, E: 3}}).HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sszRoot, err := obj.HashTreeRootSSZFork(expected, ssz.ForkBellatrix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expectedRoot != sszRoot {
+		t.Fatal("root from the encoding mismatch")
+	}
+
+	// the payload is not part of the encoding before bellatrix
+	if size := obj.SizeSSZFork(ssz.ForkAltair); size != 8 {
+		t.Fatalf("expected size 8 in altair but found %d", size)
+	}
+}
+
+func TestForkAwareSchema(t *testing.T) {
+	cases := []struct {
+		fork ssz.Fork
+		path string
+	}{
+		{ssz.ForkBellatrix, "Payload.E"},
+		{ssz.ForkCapella, "Payload.D"},
+	}
+	for _, c := range cases {
+		index, err := ssz.ConcatGIndices(ForkBodyPayloadGIndex, 7)
+		if err != nil {
+			t.Fatal(err)
+		}
+		path, ok := (&ForkBody{}).SchemaSSZFork(c.fork).FieldPath(index)
+		if !ok || path != c.path {
+			t.Fatalf("expected path %s in %s but found %s", c.path, c.fork, path)
+		}
+	}
+
+	// the default schema is the one of the latest fork
+	if path, _ := (&ForkBody{}).SchemaSSZ().FieldPath(ForkBodySlotGIndex); path != "Slot" {
+		t.Fatalf("expected path Slot but found %s", path)
+	}
+}
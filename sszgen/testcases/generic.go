@@ -0,0 +1,53 @@
+package testcases
+
+import ssz "github.com/ferranbt/fastssz"
+
+//go:generate go run ../main.go --path generic.go
+
+// Limit10 is a custom limit for the generic types
+type Limit10 struct{}
+
+func (Limit10) Limit() uint64 { return 10 }
+
+type GenericElem struct {
+	A uint64
+	B ssz.List[byte, ssz.Limit32]
+}
+
+type Generic struct {
+	Validators   ssz.List[*GenericElem, ssz.Limit1T]
+	Balances     ssz.List[uint64, Limit10]
+	Roots        ssz.Vector[[32]byte, ssz.Limit4]
+	Pubkey       ssz.Vector[byte, ssz.Limit48]
+	Extra        ssz.List[byte, ssz.Limit256]
+	Transactions ssz.List[ssz.List[byte, ssz.Limit1G], ssz.Limit1M]
+	Bits         ssz.BitlistOf[ssz.Limit2048]
+	Fixed        ssz.List[*GenericElemFixed, ssz.Limit2]
+}
+
+type GenericElemFixed struct {
+	A uint64
+	B ssz.Vector[byte, ssz.Limit8]
+}
+
+// GenericTagged is the same object as Generic with tags
+type GenericTagged struct {
+	Validators   []*GenericElemTagged      `ssz-max:"1099511627776"`
+	Balances     []uint64                  `ssz-max:"10"`
+	Roots        [][32]byte                `ssz-size:"4"`
+	Pubkey       []byte                    `ssz-size:"48"`
+	Extra        []byte                    `ssz-max:"256"`
+	Transactions [][]byte                  `ssz-max:"1048576,1073741824" ssz-size:"?,?"`
+	Bits         []byte                    `ssz:"bitlist" ssz-max:"2048"`
+	Fixed        []*GenericElemFixedTagged `ssz-max:"2"`
+}
+
+type GenericElemTagged struct {
+	A uint64
+	B []byte `ssz-max:"32"`
+}
+
+type GenericElemFixedTagged struct {
+	A uint64
+	B []byte `ssz-size:"8"`
+}
@@ -0,0 +1,2626 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: 509e5975c1c17ac760c3f2c12df685a3836148f1528115b536525d4962cae7e2
+// Version: 0.1.3
+package testcases
+
+import (
+	"io"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MarshalSSZ ssz marshals the GenericElem object
+func (g *GenericElem) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(g)
+}
+
+// MarshalSSZTo ssz marshals the GenericElem object to a target array
+func (g *GenericElem) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(12)
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint64(dst, g.A)
+
+	// Offset (1) 'B'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'B'
+	if size := len(g.B); size > 32 {
+		err = ssz.ErrBytesLengthFn("GenericElem.B", size, 32)
+		return
+	}
+	dst = append(dst, g.B...)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the GenericElem object to a writer
+func (g *GenericElem) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(g, writer)
+}
+
+// MarshalSSZToStream ssz marshals the GenericElem object to a target array that is flushed to a stream writer
+func (g *GenericElem) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(12)
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint64(dst, g.A)
+
+	// Offset (1) 'B'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'B'
+	if size := len(g.B); size > 32 {
+		err = ssz.ErrBytesLengthFn("GenericElem.B", size, 32)
+		return
+	}
+	if dst, err = sw.Append(dst, g.B); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the GenericElem object
+func (g *GenericElem) UnmarshalSSZ(buf []byte) error {
+	return g.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the GenericElem object with the decoding options
+func (g *GenericElem) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 12 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'A'
+	g.A = ssz.UnmarshallUint64(buf[0:8])
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = opts.ValidateFirstOffset(o1, 12); err != nil {
+		return err
+	}
+
+	// Field (1) 'B'
+	{
+		buf = tail[o1:]
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(g.B) == 0 {
+			g.B = make([]byte, 0, len(buf))
+		}
+		g.B = append(g.B, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the GenericElem object
+func (g *GenericElem) SizeSSZ() (size int) {
+	size = 12
+
+	// Field (1) 'B'
+	size += len(g.B)
+
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the GenericElem object
+func (g *GenericElem) MinSSZSize() uint64 {
+	return 12
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the GenericElem object
+func (g *GenericElem) MaxSSZSize() uint64 {
+	return 44
+}
+
+// HashTreeRoot ssz hashes the GenericElem object
+func (g *GenericElem) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(g)
+}
+
+// HashTreeRootWith ssz hashes the GenericElem object with a hasher
+func (g *GenericElem) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint64(g.A)
+
+	// Field (1) 'B'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(g.B))
+		if byteLen > 32 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(g.B)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded GenericElem object without decoding it
+func (g *GenericElem) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(g, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded GenericElem object with a hasher
+func (g *GenericElem) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 12 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = ssz.ValidateFirstOffset(o1, 12); err != nil {
+		return err
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'B'
+	{
+		buf := tail[o1:]
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		{
+			elemIndx := hh.Index()
+			hh.Append(buf)
+			hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (32+31)/32)
+		}
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the GenericElem object
+func (g *GenericElem) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(g)
+}
+
+const (
+	// GenericElemAGIndex is the generalized index of the 'A' field
+	GenericElemAGIndex ssz.GIndex = 2
+	// GenericElemBGIndex is the generalized index of the 'B' field
+	GenericElemBGIndex ssz.GIndex = 3
+)
+
+// ProveA returns a merkle proof of the 'A' field of the GenericElem object
+func (g *GenericElem) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(g, GenericElemAGIndex)
+}
+
+// VerifyGenericElemA verifies a merkle proof of the 'A' field of a GenericElem object
+func VerifyGenericElemA(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, GenericElemAGIndex)
+}
+
+// ProveB returns a merkle proof of the 'B' field of the GenericElem object
+func (g *GenericElem) ProveB() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(g, GenericElemBGIndex)
+}
+
+// VerifyGenericElemB verifies a merkle proof of the 'B' field of a GenericElem object
+func VerifyGenericElemB(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, GenericElemBGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the GenericElem object
+func (g *GenericElem) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "B", Schema: ssz.SchemaList(ssz.SchemaUint(1), 32)},
+	)
+}
+
+// MarshalSSZ ssz marshals the Generic object
+func (g *Generic) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(g)
+}
+
+// MarshalSSZTo ssz marshals the Generic object to a target array
+func (g *Generic) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(200)
+
+	// Offset (0) 'Validators'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(g.Validators); ii++ {
+		offset += 4
+		offset += g.Validators[ii].SizeSSZ()
+	}
+
+	// Offset (1) 'Balances'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(g.Balances) * 8
+
+	// Field (2) 'Roots'
+	if size := len(g.Roots); size != 4 {
+		err = ssz.ErrVectorLengthFn("Generic.Roots", size, 4)
+		return
+	}
+	for ii := 0; ii < 4; ii++ {
+		dst = append(dst, g.Roots[ii][:]...)
+
+	}
+
+	// Field (3) 'Pubkey'
+	if size := len(g.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("Generic.Pubkey", size, 48)
+		return
+	}
+	dst = append(dst, g.Pubkey...)
+
+	// Offset (4) 'Extra'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(g.Extra)
+
+	// Offset (5) 'Transactions'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(g.Transactions); ii++ {
+		offset += 4
+		offset += len(g.Transactions[ii])
+	}
+
+	// Offset (6) 'Bits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(g.Bits)
+
+	// Offset (7) 'Fixed'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Validators'
+	if size := len(g.Validators); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("Generic.Validators", size, 1099511627776)
+		return
+	}
+	{
+		offset = 4 * len(g.Validators)
+		for ii := 0; ii < len(g.Validators); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += g.Validators[ii].SizeSSZ()
+
+		}
+	}
+	for ii := 0; ii < len(g.Validators); ii++ {
+		if dst, err = g.Validators[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+	}
+
+	// Field (1) 'Balances'
+	if size := len(g.Balances); size > 10 {
+		err = ssz.ErrListTooBigFn("Generic.Balances", size, 10)
+		return
+	}
+	for ii := 0; ii < len(g.Balances); ii++ {
+		dst = ssz.MarshalUint64(dst, g.Balances[ii])
+
+	}
+
+	// Field (4) 'Extra'
+	if size := len(g.Extra); size > 256 {
+		err = ssz.ErrBytesLengthFn("Generic.Extra", size, 256)
+		return
+	}
+	dst = append(dst, g.Extra...)
+
+	// Field (5) 'Transactions'
+	if size := len(g.Transactions); size > 1048576 {
+		err = ssz.ErrListTooBigFn("Generic.Transactions", size, 1048576)
+		return
+	}
+	{
+		offset = 4 * len(g.Transactions)
+		for ii := 0; ii < len(g.Transactions); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += len(g.Transactions[ii])
+
+		}
+	}
+	for ii := 0; ii < len(g.Transactions); ii++ {
+		if size := len(g.Transactions[ii]); size > 1073741824 {
+			err = ssz.ErrBytesLengthFn("Generic.Transactions[ii]", size, 1073741824)
+			return
+		}
+		dst = append(dst, g.Transactions[ii]...)
+
+	}
+
+	// Field (6) 'Bits'
+	if size := len(g.Bits); size > 2048 {
+		err = ssz.ErrBytesLengthFn("Generic.Bits", size, 2048)
+		return
+	}
+	dst = append(dst, g.Bits...)
+
+	// Field (7) 'Fixed'
+	if size := len(g.Fixed); size > 2 {
+		err = ssz.ErrListTooBigFn("Generic.Fixed", size, 2)
+		return
+	}
+	for ii := 0; ii < len(g.Fixed); ii++ {
+		if dst, err = g.Fixed[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the Generic object to a writer
+func (g *Generic) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(g, writer)
+}
+
+// MarshalSSZToStream ssz marshals the Generic object to a target array that is flushed to a stream writer
+func (g *Generic) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(200)
+
+	// Offset (0) 'Validators'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(g.Validators); ii++ {
+		offset += 4
+		offset += g.Validators[ii].SizeSSZ()
+	}
+
+	// Offset (1) 'Balances'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(g.Balances) * 8
+
+	// Field (2) 'Roots'
+	if size := len(g.Roots); size != 4 {
+		err = ssz.ErrVectorLengthFn("Generic.Roots", size, 4)
+		return
+	}
+	for ii := 0; ii < 4; ii++ {
+		dst = append(dst, g.Roots[ii][:]...)
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (3) 'Pubkey'
+	if size := len(g.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("Generic.Pubkey", size, 48)
+		return
+	}
+	dst = append(dst, g.Pubkey...)
+
+	// Offset (4) 'Extra'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(g.Extra)
+
+	// Offset (5) 'Transactions'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(g.Transactions); ii++ {
+		offset += 4
+		offset += len(g.Transactions[ii])
+	}
+
+	// Offset (6) 'Bits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(g.Bits)
+
+	// Offset (7) 'Fixed'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Validators'
+	if size := len(g.Validators); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("Generic.Validators", size, 1099511627776)
+		return
+	}
+	{
+		offset = 4 * len(g.Validators)
+		for ii := 0; ii < len(g.Validators); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += g.Validators[ii].SizeSSZ()
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+	}
+	for ii := 0; ii < len(g.Validators); ii++ {
+		if dst, err = g.Validators[ii].MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (1) 'Balances'
+	if size := len(g.Balances); size > 10 {
+		err = ssz.ErrListTooBigFn("Generic.Balances", size, 10)
+		return
+	}
+	for ii := 0; ii < len(g.Balances); ii++ {
+		dst = ssz.MarshalUint64(dst, g.Balances[ii])
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (4) 'Extra'
+	if size := len(g.Extra); size > 256 {
+		err = ssz.ErrBytesLengthFn("Generic.Extra", size, 256)
+		return
+	}
+	if dst, err = sw.Append(dst, g.Extra); err != nil {
+		return
+	}
+
+	// Field (5) 'Transactions'
+	if size := len(g.Transactions); size > 1048576 {
+		err = ssz.ErrListTooBigFn("Generic.Transactions", size, 1048576)
+		return
+	}
+	{
+		offset = 4 * len(g.Transactions)
+		for ii := 0; ii < len(g.Transactions); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += len(g.Transactions[ii])
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+	}
+	for ii := 0; ii < len(g.Transactions); ii++ {
+		if size := len(g.Transactions[ii]); size > 1073741824 {
+			err = ssz.ErrBytesLengthFn("Generic.Transactions[ii]", size, 1073741824)
+			return
+		}
+		if dst, err = sw.Append(dst, g.Transactions[ii]); err != nil {
+			return
+		}
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (6) 'Bits'
+	if size := len(g.Bits); size > 2048 {
+		err = ssz.ErrBytesLengthFn("Generic.Bits", size, 2048)
+		return
+	}
+	dst = append(dst, g.Bits...)
+
+	// Field (7) 'Fixed'
+	if size := len(g.Fixed); size > 2 {
+		err = ssz.ErrListTooBigFn("Generic.Fixed", size, 2)
+		return
+	}
+	for ii := 0; ii < len(g.Fixed); ii++ {
+		if dst, err = g.Fixed[ii].MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the Generic object
+func (g *Generic) UnmarshalSSZ(buf []byte) error {
+	return g.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Generic object with the decoding options
+func (g *Generic) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 200 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1, o4, o5, o6, o7 uint64
+
+	// Offset (0) 'Validators'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = opts.ValidateFirstOffset(o0, 200); err != nil {
+		return err
+	}
+
+	// Offset (1) 'Balances'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Field (2) 'Roots'
+	g.Roots = make([][32]byte, 4)
+	for ii := 0; ii < 4; ii++ {
+		copy(g.Roots[ii][:], buf[8:136][ii*32:(ii+1)*32])
+	}
+
+	// Field (3) 'Pubkey'
+	if cap(g.Pubkey) == 0 {
+		g.Pubkey = make([]byte, 0, len(buf[136:184]))
+	}
+	g.Pubkey = append(g.Pubkey, buf[136:184]...)
+
+	// Offset (4) 'Extra'
+	if o4 = ssz.ReadOffset(buf[184:188]); o4 > size || o1 > o4 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (5) 'Transactions'
+	if o5 = ssz.ReadOffset(buf[188:192]); o5 > size || o4 > o5 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (6) 'Bits'
+	if o6 = ssz.ReadOffset(buf[192:196]); o6 > size || o5 > o6 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (7) 'Fixed'
+	if o7 = ssz.ReadOffset(buf[196:200]); o7 > size || o6 > o7 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'Validators'
+	{
+		buf = tail[o0:o1]
+		num, err := opts.DecodeDynamicLength(buf, 1099511627776)
+		if err != nil {
+			return err
+		}
+		g.Validators = make([]*GenericElem, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if g.Validators[indx] == nil {
+				g.Validators[indx] = new(GenericElem)
+			}
+			if err = g.Validators[indx].UnmarshalSSZWithOptions(buf, opts); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Balances'
+	{
+		buf = tail[o1:o4]
+		num, err := ssz.DivideInt2(len(buf), 8, 10)
+		if err != nil {
+			return err
+		}
+		g.Balances = ssz.ExtendUint64(g.Balances, num)
+		for ii := 0; ii < num; ii++ {
+			g.Balances[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	// Field (4) 'Extra'
+	{
+		buf = tail[o4:o5]
+		if len(buf) > 256 {
+			return ssz.ErrBytesLength
+		}
+		if cap(g.Extra) == 0 {
+			g.Extra = make([]byte, 0, len(buf))
+		}
+		g.Extra = append(g.Extra, buf...)
+	}
+
+	// Field (5) 'Transactions'
+	{
+		buf = tail[o5:o6]
+		num, err := opts.DecodeDynamicLength(buf, 1048576)
+		if err != nil {
+			return err
+		}
+		g.Transactions = make([]ssz.List[byte, ssz.Limit1G], num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if len(buf) > 1073741824 {
+				return ssz.ErrBytesLength
+			}
+			if cap(g.Transactions[indx]) == 0 {
+				g.Transactions[indx] = make([]byte, 0, len(buf))
+			}
+			g.Transactions[indx] = append(g.Transactions[indx], buf...)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (6) 'Bits'
+	{
+		buf = tail[o6:o7]
+		if err = ssz.ValidateBitlist(buf, 2048); err != nil {
+			return err
+		}
+		if cap(g.Bits) == 0 {
+			g.Bits = make([]byte, 0, len(buf))
+		}
+		g.Bits = append(g.Bits, buf...)
+	}
+
+	// Field (7) 'Fixed'
+	{
+		buf = tail[o7:]
+		num, err := ssz.DivideInt2(len(buf), 16, 2)
+		if err != nil {
+			return err
+		}
+		g.Fixed = make([]*GenericElemFixed, num)
+		for ii := 0; ii < num; ii++ {
+			if g.Fixed[ii] == nil {
+				g.Fixed[ii] = new(GenericElemFixed)
+			}
+			if err = g.Fixed[ii].UnmarshalSSZWithOptions(buf[ii*16:(ii+1)*16], opts); err != nil {
+				return err
+			}
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the Generic object
+func (g *Generic) SizeSSZ() (size int) {
+	size = 200
+
+	// Field (0) 'Validators'
+	for ii := 0; ii < len(g.Validators); ii++ {
+		size += 4
+		size += g.Validators[ii].SizeSSZ()
+	}
+
+	// Field (1) 'Balances'
+	size += len(g.Balances) * 8
+
+	// Field (4) 'Extra'
+	size += len(g.Extra)
+
+	// Field (5) 'Transactions'
+	for ii := 0; ii < len(g.Transactions); ii++ {
+		size += 4
+		size += len(g.Transactions[ii])
+	}
+
+	// Field (6) 'Bits'
+	size += len(g.Bits)
+
+	// Field (7) 'Fixed'
+	size += len(g.Fixed) * 16
+
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Generic object
+func (g *Generic) MinSSZSize() uint64 {
+	return 201
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Generic object
+func (g *Generic) MaxSSZSize() uint64 {
+	return 1178676469171001
+}
+
+// HashTreeRoot ssz hashes the Generic object
+func (g *Generic) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(g)
+}
+
+// HashTreeRootWith ssz hashes the Generic object with a hasher
+func (g *Generic) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Validators'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(g.Validators))
+		if num > 1099511627776 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range g.Validators {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
+	}
+
+	// Field (1) 'Balances'
+	{
+		if size := len(g.Balances); size > 10 {
+			err = ssz.ErrListTooBigFn("Generic.Balances", size, 10)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range g.Balances {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+		numItems := uint64(len(g.Balances))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(10, numItems, 8))
+	}
+
+	// Field (2) 'Roots'
+	{
+		if size := len(g.Roots); size != 4 {
+			err = ssz.ErrVectorLengthFn("Generic.Roots", size, 4)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range g.Roots {
+			hh.Append(i[:])
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (3) 'Pubkey'
+	if size := len(g.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("Generic.Pubkey", size, 48)
+		return
+	}
+	hh.PutBytes(g.Pubkey)
+
+	// Field (4) 'Extra'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(g.Extra))
+		if byteLen > 256 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(g.Extra)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (256+31)/32)
+	}
+
+	// Field (5) 'Transactions'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(g.Transactions))
+		if num > 1048576 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range g.Transactions {
+			{
+				elemIndx := hh.Index()
+				byteLen := uint64(len(elem))
+				if byteLen > 1073741824 {
+					err = ssz.ErrIncorrectListSize
+					return
+				}
+				hh.AppendBytes32(elem)
+				hh.MerkleizeWithMixin(elemIndx, byteLen, (1073741824+31)/32)
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 1048576)
+	}
+
+	// Field (6) 'Bits'
+	if len(g.Bits) == 0 {
+		err = ssz.ErrEmptyBitlist
+		return
+	}
+	hh.PutBitlist(g.Bits, 2048)
+
+	// Field (7) 'Fixed'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(g.Fixed))
+		if num > 2 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range g.Fixed {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 2)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded Generic object without decoding it
+func (g *Generic) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(g, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded Generic object with a hasher
+func (g *Generic) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 200 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1, o4, o5, o6, o7 uint64
+
+	// Offset (0) 'Validators'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = ssz.ValidateFirstOffset(o0, 200); err != nil {
+		return err
+	}
+
+	// Offset (1) 'Balances'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (4) 'Extra'
+	if o4 = ssz.ReadOffset(buf[184:188]); o4 > size || o1 > o4 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (5) 'Transactions'
+	if o5 = ssz.ReadOffset(buf[188:192]); o5 > size || o4 > o5 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (6) 'Bits'
+	if o6 = ssz.ReadOffset(buf[192:196]); o6 > size || o5 > o6 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (7) 'Fixed'
+	if o7 = ssz.ReadOffset(buf[196:200]); o7 > size || o6 > o7 {
+		return ssz.ErrOffset
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Validators'
+	{
+		buf := tail[o0:o1]
+		{
+			subIndx := hh.Index()
+			num, err := ssz.DecodeDynamicLength(buf, 1099511627776)
+			if err != nil {
+				return err
+			}
+			err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+				if err = (*GenericElem)(nil).HashTreeRootSSZWith(hh, buf); err != nil {
+					return err
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			hh.MerkleizeWithMixin(subIndx, uint64(num), 1099511627776)
+		}
+	}
+
+	// Field (1) 'Balances'
+	{
+		buf := tail[o1:o4]
+		{
+			subIndx := hh.Index()
+			num, err := ssz.DivideInt2(len(buf), 8, 10)
+			if err != nil {
+				return err
+			}
+			hh.Append(buf)
+			hh.FillUpTo32()
+			hh.MerkleizeWithMixin(subIndx, uint64(num), ssz.CalculateLimit(10, uint64(num), 8))
+		}
+	}
+
+	// Field (2) 'Roots'
+	{
+		subIndx := hh.Index()
+		hh.Append(buf[8:136])
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (3) 'Pubkey'
+	hh.PutBytes(buf[136:184])
+
+	// Field (4) 'Extra'
+	{
+		buf := tail[o4:o5]
+		if len(buf) > 256 {
+			return ssz.ErrBytesLength
+		}
+		{
+			elemIndx := hh.Index()
+			hh.Append(buf)
+			hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (256+31)/32)
+		}
+	}
+
+	// Field (5) 'Transactions'
+	{
+		buf := tail[o5:o6]
+		{
+			subIndx := hh.Index()
+			num, err := ssz.DecodeDynamicLength(buf, 1048576)
+			if err != nil {
+				return err
+			}
+			err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+				if len(buf) > 1073741824 {
+					return ssz.ErrBytesLength
+				}
+				{
+					elemIndx := hh.Index()
+					hh.AppendBytes32(buf)
+					hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (1073741824+31)/32)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			hh.MerkleizeWithMixin(subIndx, uint64(num), 1048576)
+		}
+	}
+
+	// Field (6) 'Bits'
+	{
+		buf := tail[o6:o7]
+		if err = ssz.ValidateBitlist(buf, 2048); err != nil {
+			return err
+		}
+		hh.PutBitlist(buf, 2048)
+	}
+
+	// Field (7) 'Fixed'
+	{
+		buf := tail[o7:]
+		{
+			subIndx := hh.Index()
+			num, err := ssz.DivideInt2(len(buf), 16, 2)
+			if err != nil {
+				return err
+			}
+			for ii := 0; ii < num; ii++ {
+				if err = (*GenericElemFixed)(nil).HashTreeRootSSZWith(hh, buf[ii*16:(ii+1)*16]); err != nil {
+					return err
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, uint64(num), 2)
+		}
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the Generic object
+func (g *Generic) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(g)
+}
+
+const (
+	// GenericValidatorsGIndex is the generalized index of the 'Validators' field
+	GenericValidatorsGIndex ssz.GIndex = 8
+	// GenericBalancesGIndex is the generalized index of the 'Balances' field
+	GenericBalancesGIndex ssz.GIndex = 9
+	// GenericRootsGIndex is the generalized index of the 'Roots' field
+	GenericRootsGIndex ssz.GIndex = 10
+	// GenericPubkeyGIndex is the generalized index of the 'Pubkey' field
+	GenericPubkeyGIndex ssz.GIndex = 11
+	// GenericExtraGIndex is the generalized index of the 'Extra' field
+	GenericExtraGIndex ssz.GIndex = 12
+	// GenericTransactionsGIndex is the generalized index of the 'Transactions' field
+	GenericTransactionsGIndex ssz.GIndex = 13
+	// GenericBitsGIndex is the generalized index of the 'Bits' field
+	GenericBitsGIndex ssz.GIndex = 14
+	// GenericFixedGIndex is the generalized index of the 'Fixed' field
+	GenericFixedGIndex ssz.GIndex = 15
+)
+
+// ProveValidators returns a merkle proof of the 'Validators' field of the Generic object
+func (g *Generic) ProveValidators() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(g, GenericValidatorsGIndex)
+}
+
+// VerifyGenericValidators verifies a merkle proof of the 'Validators' field of a Generic object
+func VerifyGenericValidators(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, GenericValidatorsGIndex)
+}
+
+// ProveBalances returns a merkle proof of the 'Balances' field of the Generic object
+func (g *Generic) ProveBalances() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(g, GenericBalancesGIndex)
+}
+
+// VerifyGenericBalances verifies a merkle proof of the 'Balances' field of a Generic object
+func VerifyGenericBalances(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, GenericBalancesGIndex)
+}
+
+// ProveRoots returns a merkle proof of the 'Roots' field of the Generic object
+func (g *Generic) ProveRoots() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(g, GenericRootsGIndex)
+}
+
+// VerifyGenericRoots verifies a merkle proof of the 'Roots' field of a Generic object
+func VerifyGenericRoots(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, GenericRootsGIndex)
+}
+
+// ProvePubkey returns a merkle proof of the 'Pubkey' field of the Generic object
+func (g *Generic) ProvePubkey() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(g, GenericPubkeyGIndex)
+}
+
+// VerifyGenericPubkey verifies a merkle proof of the 'Pubkey' field of a Generic object
+func VerifyGenericPubkey(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, GenericPubkeyGIndex)
+}
+
+// ProveExtra returns a merkle proof of the 'Extra' field of the Generic object
+func (g *Generic) ProveExtra() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(g, GenericExtraGIndex)
+}
+
+// VerifyGenericExtra verifies a merkle proof of the 'Extra' field of a Generic object
+func VerifyGenericExtra(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, GenericExtraGIndex)
+}
+
+// ProveTransactions returns a merkle proof of the 'Transactions' field of the Generic object
+func (g *Generic) ProveTransactions() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(g, GenericTransactionsGIndex)
+}
+
+// VerifyGenericTransactions verifies a merkle proof of the 'Transactions' field of a Generic object
+func VerifyGenericTransactions(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, GenericTransactionsGIndex)
+}
+
+// ProveBits returns a merkle proof of the 'Bits' field of the Generic object
+func (g *Generic) ProveBits() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(g, GenericBitsGIndex)
+}
+
+// VerifyGenericBits verifies a merkle proof of the 'Bits' field of a Generic object
+func VerifyGenericBits(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, GenericBitsGIndex)
+}
+
+// ProveFixed returns a merkle proof of the 'Fixed' field of the Generic object
+func (g *Generic) ProveFixed() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(g, GenericFixedGIndex)
+}
+
+// VerifyGenericFixed verifies a merkle proof of the 'Fixed' field of a Generic object
+func VerifyGenericFixed(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, GenericFixedGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the Generic object
+func (g *Generic) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Validators", Schema: ssz.SchemaList((*GenericElem)(nil).SchemaSSZ(), 1099511627776)},
+		&ssz.SchemaField{Name: "Balances", Schema: ssz.SchemaList(ssz.SchemaUint(8), 10)},
+		&ssz.SchemaField{Name: "Roots", Schema: ssz.SchemaVector(ssz.SchemaBytes(32), 4)},
+		&ssz.SchemaField{Name: "Pubkey", Schema: ssz.SchemaBytes(48)},
+		&ssz.SchemaField{Name: "Extra", Schema: ssz.SchemaList(ssz.SchemaUint(1), 256)},
+		&ssz.SchemaField{Name: "Transactions", Schema: ssz.SchemaList(ssz.SchemaList(ssz.SchemaUint(1), 1073741824), 1048576)},
+		&ssz.SchemaField{Name: "Bits", Schema: ssz.SchemaBitlist(2048)},
+		&ssz.SchemaField{Name: "Fixed", Schema: ssz.SchemaList((*GenericElemFixed)(nil).SchemaSSZ(), 2)},
+	)
+}
+
+// MarshalSSZ ssz marshals the GenericElemFixed object
+func (g *GenericElemFixed) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(g)
+}
+
+// MarshalSSZTo ssz marshals the GenericElemFixed object to a target array
+func (g *GenericElemFixed) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint64(dst, g.A)
+
+	// Field (1) 'B'
+	if size := len(g.B); size != 8 {
+		err = ssz.ErrBytesLengthFn("GenericElemFixed.B", size, 8)
+		return
+	}
+	dst = append(dst, g.B...)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the GenericElemFixed object to a writer
+func (g *GenericElemFixed) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(g, writer)
+}
+
+// MarshalSSZToStream ssz marshals the GenericElemFixed object to a target array that is flushed to a stream writer
+func (g *GenericElemFixed) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint64(dst, g.A)
+
+	// Field (1) 'B'
+	if size := len(g.B); size != 8 {
+		err = ssz.ErrBytesLengthFn("GenericElemFixed.B", size, 8)
+		return
+	}
+	dst = append(dst, g.B...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the GenericElemFixed object
+func (g *GenericElemFixed) UnmarshalSSZ(buf []byte) error {
+	return g.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the GenericElemFixed object with the decoding options
+func (g *GenericElemFixed) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 16 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'A'
+	g.A = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'B'
+	if cap(g.B) == 0 {
+		g.B = make([]byte, 0, len(buf[8:16]))
+	}
+	g.B = append(g.B, buf[8:16]...)
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the GenericElemFixed object
+func (g *GenericElemFixed) SizeSSZ() (size int) {
+	size = 16
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the GenericElemFixed object
+func (g *GenericElemFixed) MinSSZSize() uint64 {
+	return 16
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the GenericElemFixed object
+func (g *GenericElemFixed) MaxSSZSize() uint64 {
+	return 16
+}
+
+// HashTreeRoot ssz hashes the GenericElemFixed object
+func (g *GenericElemFixed) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(g)
+}
+
+// HashTreeRootWith ssz hashes the GenericElemFixed object with a hasher
+func (g *GenericElemFixed) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint64(g.A)
+
+	// Field (1) 'B'
+	if size := len(g.B); size != 8 {
+		err = ssz.ErrBytesLengthFn("GenericElemFixed.B", size, 8)
+		return
+	}
+	hh.PutBytes(g.B)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded GenericElemFixed object without decoding it
+func (g *GenericElemFixed) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(g, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded GenericElemFixed object with a hasher
+func (g *GenericElemFixed) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 16 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'B'
+	hh.PutBytes(buf[8:16])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the GenericElemFixed object
+func (g *GenericElemFixed) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(g)
+}
+
+const (
+	// GenericElemFixedAGIndex is the generalized index of the 'A' field
+	GenericElemFixedAGIndex ssz.GIndex = 2
+	// GenericElemFixedBGIndex is the generalized index of the 'B' field
+	GenericElemFixedBGIndex ssz.GIndex = 3
+)
+
+// ProveA returns a merkle proof of the 'A' field of the GenericElemFixed object
+func (g *GenericElemFixed) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(g, GenericElemFixedAGIndex)
+}
+
+// VerifyGenericElemFixedA verifies a merkle proof of the 'A' field of a GenericElemFixed object
+func VerifyGenericElemFixedA(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, GenericElemFixedAGIndex)
+}
+
+// ProveB returns a merkle proof of the 'B' field of the GenericElemFixed object
+func (g *GenericElemFixed) ProveB() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(g, GenericElemFixedBGIndex)
+}
+
+// VerifyGenericElemFixedB verifies a merkle proof of the 'B' field of a GenericElemFixed object
+func VerifyGenericElemFixedB(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, GenericElemFixedBGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the GenericElemFixed object
+func (g *GenericElemFixed) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "B", Schema: ssz.SchemaBytes(8)},
+	)
+}
+
+// MarshalSSZ ssz marshals the GenericTagged object
+func (g *GenericTagged) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(g)
+}
+
+// MarshalSSZTo ssz marshals the GenericTagged object to a target array
+func (g *GenericTagged) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(200)
+
+	// Offset (0) 'Validators'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(g.Validators); ii++ {
+		offset += 4
+		offset += g.Validators[ii].SizeSSZ()
+	}
+
+	// Offset (1) 'Balances'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(g.Balances) * 8
+
+	// Field (2) 'Roots'
+	if size := len(g.Roots); size != 4 {
+		err = ssz.ErrVectorLengthFn("GenericTagged.Roots", size, 4)
+		return
+	}
+	for ii := 0; ii < 4; ii++ {
+		dst = append(dst, g.Roots[ii][:]...)
+
+	}
+
+	// Field (3) 'Pubkey'
+	if size := len(g.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("GenericTagged.Pubkey", size, 48)
+		return
+	}
+	dst = append(dst, g.Pubkey...)
+
+	// Offset (4) 'Extra'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(g.Extra)
+
+	// Offset (5) 'Transactions'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(g.Transactions); ii++ {
+		offset += 4
+		offset += len(g.Transactions[ii])
+	}
+
+	// Offset (6) 'Bits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(g.Bits)
+
+	// Offset (7) 'Fixed'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Validators'
+	if size := len(g.Validators); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("GenericTagged.Validators", size, 1099511627776)
+		return
+	}
+	{
+		offset = 4 * len(g.Validators)
+		for ii := 0; ii < len(g.Validators); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += g.Validators[ii].SizeSSZ()
+
+		}
+	}
+	for ii := 0; ii < len(g.Validators); ii++ {
+		if dst, err = g.Validators[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+	}
+
+	// Field (1) 'Balances'
+	if size := len(g.Balances); size > 10 {
+		err = ssz.ErrListTooBigFn("GenericTagged.Balances", size, 10)
+		return
+	}
+	for ii := 0; ii < len(g.Balances); ii++ {
+		dst = ssz.MarshalUint64(dst, g.Balances[ii])
+
+	}
+
+	// Field (4) 'Extra'
+	if size := len(g.Extra); size > 256 {
+		err = ssz.ErrBytesLengthFn("GenericTagged.Extra", size, 256)
+		return
+	}
+	dst = append(dst, g.Extra...)
+
+	// Field (5) 'Transactions'
+	if size := len(g.Transactions); size > 1048576 {
+		err = ssz.ErrListTooBigFn("GenericTagged.Transactions", size, 1048576)
+		return
+	}
+	{
+		offset = 4 * len(g.Transactions)
+		for ii := 0; ii < len(g.Transactions); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += len(g.Transactions[ii])
+
+		}
+	}
+	for ii := 0; ii < len(g.Transactions); ii++ {
+		if size := len(g.Transactions[ii]); size > 1073741824 {
+			err = ssz.ErrBytesLengthFn("GenericTagged.Transactions[ii]", size, 1073741824)
+			return
+		}
+		dst = append(dst, g.Transactions[ii]...)
+
+	}
+
+	// Field (6) 'Bits'
+	if size := len(g.Bits); size > 2048 {
+		err = ssz.ErrBytesLengthFn("GenericTagged.Bits", size, 2048)
+		return
+	}
+	dst = append(dst, g.Bits...)
+
+	// Field (7) 'Fixed'
+	if size := len(g.Fixed); size > 2 {
+		err = ssz.ErrListTooBigFn("GenericTagged.Fixed", size, 2)
+		return
+	}
+	for ii := 0; ii < len(g.Fixed); ii++ {
+		if dst, err = g.Fixed[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the GenericTagged object to a writer
+func (g *GenericTagged) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(g, writer)
+}
+
+// MarshalSSZToStream ssz marshals the GenericTagged object to a target array that is flushed to a stream writer
+func (g *GenericTagged) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(200)
+
+	// Offset (0) 'Validators'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(g.Validators); ii++ {
+		offset += 4
+		offset += g.Validators[ii].SizeSSZ()
+	}
+
+	// Offset (1) 'Balances'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(g.Balances) * 8
+
+	// Field (2) 'Roots'
+	if size := len(g.Roots); size != 4 {
+		err = ssz.ErrVectorLengthFn("GenericTagged.Roots", size, 4)
+		return
+	}
+	for ii := 0; ii < 4; ii++ {
+		dst = append(dst, g.Roots[ii][:]...)
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (3) 'Pubkey'
+	if size := len(g.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("GenericTagged.Pubkey", size, 48)
+		return
+	}
+	dst = append(dst, g.Pubkey...)
+
+	// Offset (4) 'Extra'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(g.Extra)
+
+	// Offset (5) 'Transactions'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(g.Transactions); ii++ {
+		offset += 4
+		offset += len(g.Transactions[ii])
+	}
+
+	// Offset (6) 'Bits'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(g.Bits)
+
+	// Offset (7) 'Fixed'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Validators'
+	if size := len(g.Validators); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("GenericTagged.Validators", size, 1099511627776)
+		return
+	}
+	{
+		offset = 4 * len(g.Validators)
+		for ii := 0; ii < len(g.Validators); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += g.Validators[ii].SizeSSZ()
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+	}
+	for ii := 0; ii < len(g.Validators); ii++ {
+		if dst, err = g.Validators[ii].MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (1) 'Balances'
+	if size := len(g.Balances); size > 10 {
+		err = ssz.ErrListTooBigFn("GenericTagged.Balances", size, 10)
+		return
+	}
+	for ii := 0; ii < len(g.Balances); ii++ {
+		dst = ssz.MarshalUint64(dst, g.Balances[ii])
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (4) 'Extra'
+	if size := len(g.Extra); size > 256 {
+		err = ssz.ErrBytesLengthFn("GenericTagged.Extra", size, 256)
+		return
+	}
+	if dst, err = sw.Append(dst, g.Extra); err != nil {
+		return
+	}
+
+	// Field (5) 'Transactions'
+	if size := len(g.Transactions); size > 1048576 {
+		err = ssz.ErrListTooBigFn("GenericTagged.Transactions", size, 1048576)
+		return
+	}
+	{
+		offset = 4 * len(g.Transactions)
+		for ii := 0; ii < len(g.Transactions); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += len(g.Transactions[ii])
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+	}
+	for ii := 0; ii < len(g.Transactions); ii++ {
+		if size := len(g.Transactions[ii]); size > 1073741824 {
+			err = ssz.ErrBytesLengthFn("GenericTagged.Transactions[ii]", size, 1073741824)
+			return
+		}
+		if dst, err = sw.Append(dst, g.Transactions[ii]); err != nil {
+			return
+		}
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (6) 'Bits'
+	if size := len(g.Bits); size > 2048 {
+		err = ssz.ErrBytesLengthFn("GenericTagged.Bits", size, 2048)
+		return
+	}
+	dst = append(dst, g.Bits...)
+
+	// Field (7) 'Fixed'
+	if size := len(g.Fixed); size > 2 {
+		err = ssz.ErrListTooBigFn("GenericTagged.Fixed", size, 2)
+		return
+	}
+	for ii := 0; ii < len(g.Fixed); ii++ {
+		if dst, err = g.Fixed[ii].MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the GenericTagged object
+func (g *GenericTagged) UnmarshalSSZ(buf []byte) error {
+	return g.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the GenericTagged object with the decoding options
+func (g *GenericTagged) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 200 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1, o4, o5, o6, o7 uint64
+
+	// Offset (0) 'Validators'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = opts.ValidateFirstOffset(o0, 200); err != nil {
+		return err
+	}
+
+	// Offset (1) 'Balances'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Field (2) 'Roots'
+	g.Roots = make([][32]byte, 4)
+	for ii := 0; ii < 4; ii++ {
+		copy(g.Roots[ii][:], buf[8:136][ii*32:(ii+1)*32])
+	}
+
+	// Field (3) 'Pubkey'
+	if cap(g.Pubkey) == 0 {
+		g.Pubkey = make([]byte, 0, len(buf[136:184]))
+	}
+	g.Pubkey = append(g.Pubkey, buf[136:184]...)
+
+	// Offset (4) 'Extra'
+	if o4 = ssz.ReadOffset(buf[184:188]); o4 > size || o1 > o4 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (5) 'Transactions'
+	if o5 = ssz.ReadOffset(buf[188:192]); o5 > size || o4 > o5 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (6) 'Bits'
+	if o6 = ssz.ReadOffset(buf[192:196]); o6 > size || o5 > o6 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (7) 'Fixed'
+	if o7 = ssz.ReadOffset(buf[196:200]); o7 > size || o6 > o7 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'Validators'
+	{
+		buf = tail[o0:o1]
+		num, err := opts.DecodeDynamicLength(buf, 1099511627776)
+		if err != nil {
+			return err
+		}
+		g.Validators = make([]*GenericElemTagged, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if g.Validators[indx] == nil {
+				g.Validators[indx] = new(GenericElemTagged)
+			}
+			if err = g.Validators[indx].UnmarshalSSZWithOptions(buf, opts); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'Balances'
+	{
+		buf = tail[o1:o4]
+		num, err := ssz.DivideInt2(len(buf), 8, 10)
+		if err != nil {
+			return err
+		}
+		g.Balances = ssz.ExtendUint64(g.Balances, num)
+		for ii := 0; ii < num; ii++ {
+			g.Balances[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	// Field (4) 'Extra'
+	{
+		buf = tail[o4:o5]
+		if len(buf) > 256 {
+			return ssz.ErrBytesLength
+		}
+		if cap(g.Extra) == 0 {
+			g.Extra = make([]byte, 0, len(buf))
+		}
+		g.Extra = append(g.Extra, buf...)
+	}
+
+	// Field (5) 'Transactions'
+	{
+		buf = tail[o5:o6]
+		num, err := opts.DecodeDynamicLength(buf, 1048576)
+		if err != nil {
+			return err
+		}
+		g.Transactions = make([][]byte, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if len(buf) > 1073741824 {
+				return ssz.ErrBytesLength
+			}
+			if cap(g.Transactions[indx]) == 0 {
+				g.Transactions[indx] = make([]byte, 0, len(buf))
+			}
+			g.Transactions[indx] = append(g.Transactions[indx], buf...)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (6) 'Bits'
+	{
+		buf = tail[o6:o7]
+		if err = ssz.ValidateBitlist(buf, 2048); err != nil {
+			return err
+		}
+		if cap(g.Bits) == 0 {
+			g.Bits = make([]byte, 0, len(buf))
+		}
+		g.Bits = append(g.Bits, buf...)
+	}
+
+	// Field (7) 'Fixed'
+	{
+		buf = tail[o7:]
+		num, err := ssz.DivideInt2(len(buf), 16, 2)
+		if err != nil {
+			return err
+		}
+		g.Fixed = make([]*GenericElemFixedTagged, num)
+		for ii := 0; ii < num; ii++ {
+			if g.Fixed[ii] == nil {
+				g.Fixed[ii] = new(GenericElemFixedTagged)
+			}
+			if err = g.Fixed[ii].UnmarshalSSZWithOptions(buf[ii*16:(ii+1)*16], opts); err != nil {
+				return err
+			}
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the GenericTagged object
+func (g *GenericTagged) SizeSSZ() (size int) {
+	size = 200
+
+	// Field (0) 'Validators'
+	for ii := 0; ii < len(g.Validators); ii++ {
+		size += 4
+		size += g.Validators[ii].SizeSSZ()
+	}
+
+	// Field (1) 'Balances'
+	size += len(g.Balances) * 8
+
+	// Field (4) 'Extra'
+	size += len(g.Extra)
+
+	// Field (5) 'Transactions'
+	for ii := 0; ii < len(g.Transactions); ii++ {
+		size += 4
+		size += len(g.Transactions[ii])
+	}
+
+	// Field (6) 'Bits'
+	size += len(g.Bits)
+
+	// Field (7) 'Fixed'
+	size += len(g.Fixed) * 16
+
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the GenericTagged object
+func (g *GenericTagged) MinSSZSize() uint64 {
+	return 201
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the GenericTagged object
+func (g *GenericTagged) MaxSSZSize() uint64 {
+	return 1178676469171001
+}
+
+// HashTreeRoot ssz hashes the GenericTagged object
+func (g *GenericTagged) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(g)
+}
+
+// HashTreeRootWith ssz hashes the GenericTagged object with a hasher
+func (g *GenericTagged) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Validators'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(g.Validators))
+		if num > 1099511627776 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range g.Validators {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
+	}
+
+	// Field (1) 'Balances'
+	{
+		if size := len(g.Balances); size > 10 {
+			err = ssz.ErrListTooBigFn("GenericTagged.Balances", size, 10)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range g.Balances {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+		numItems := uint64(len(g.Balances))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(10, numItems, 8))
+	}
+
+	// Field (2) 'Roots'
+	{
+		if size := len(g.Roots); size != 4 {
+			err = ssz.ErrVectorLengthFn("GenericTagged.Roots", size, 4)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range g.Roots {
+			hh.Append(i[:])
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (3) 'Pubkey'
+	if size := len(g.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("GenericTagged.Pubkey", size, 48)
+		return
+	}
+	hh.PutBytes(g.Pubkey)
+
+	// Field (4) 'Extra'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(g.Extra))
+		if byteLen > 256 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(g.Extra)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (256+31)/32)
+	}
+
+	// Field (5) 'Transactions'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(g.Transactions))
+		if num > 1048576 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range g.Transactions {
+			{
+				elemIndx := hh.Index()
+				byteLen := uint64(len(elem))
+				if byteLen > 1073741824 {
+					err = ssz.ErrIncorrectListSize
+					return
+				}
+				hh.AppendBytes32(elem)
+				hh.MerkleizeWithMixin(elemIndx, byteLen, (1073741824+31)/32)
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 1048576)
+	}
+
+	// Field (6) 'Bits'
+	if len(g.Bits) == 0 {
+		err = ssz.ErrEmptyBitlist
+		return
+	}
+	hh.PutBitlist(g.Bits, 2048)
+
+	// Field (7) 'Fixed'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(g.Fixed))
+		if num > 2 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range g.Fixed {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 2)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded GenericTagged object without decoding it
+func (g *GenericTagged) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(g, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded GenericTagged object with a hasher
+func (g *GenericTagged) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 200 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1, o4, o5, o6, o7 uint64
+
+	// Offset (0) 'Validators'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = ssz.ValidateFirstOffset(o0, 200); err != nil {
+		return err
+	}
+
+	// Offset (1) 'Balances'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (4) 'Extra'
+	if o4 = ssz.ReadOffset(buf[184:188]); o4 > size || o1 > o4 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (5) 'Transactions'
+	if o5 = ssz.ReadOffset(buf[188:192]); o5 > size || o4 > o5 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (6) 'Bits'
+	if o6 = ssz.ReadOffset(buf[192:196]); o6 > size || o5 > o6 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (7) 'Fixed'
+	if o7 = ssz.ReadOffset(buf[196:200]); o7 > size || o6 > o7 {
+		return ssz.ErrOffset
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Validators'
+	{
+		buf := tail[o0:o1]
+		{
+			subIndx := hh.Index()
+			num, err := ssz.DecodeDynamicLength(buf, 1099511627776)
+			if err != nil {
+				return err
+			}
+			err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+				if err = (*GenericElemTagged)(nil).HashTreeRootSSZWith(hh, buf); err != nil {
+					return err
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			hh.MerkleizeWithMixin(subIndx, uint64(num), 1099511627776)
+		}
+	}
+
+	// Field (1) 'Balances'
+	{
+		buf := tail[o1:o4]
+		{
+			subIndx := hh.Index()
+			num, err := ssz.DivideInt2(len(buf), 8, 10)
+			if err != nil {
+				return err
+			}
+			hh.Append(buf)
+			hh.FillUpTo32()
+			hh.MerkleizeWithMixin(subIndx, uint64(num), ssz.CalculateLimit(10, uint64(num), 8))
+		}
+	}
+
+	// Field (2) 'Roots'
+	{
+		subIndx := hh.Index()
+		hh.Append(buf[8:136])
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (3) 'Pubkey'
+	hh.PutBytes(buf[136:184])
+
+	// Field (4) 'Extra'
+	{
+		buf := tail[o4:o5]
+		if len(buf) > 256 {
+			return ssz.ErrBytesLength
+		}
+		{
+			elemIndx := hh.Index()
+			hh.Append(buf)
+			hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (256+31)/32)
+		}
+	}
+
+	// Field (5) 'Transactions'
+	{
+		buf := tail[o5:o6]
+		{
+			subIndx := hh.Index()
+			num, err := ssz.DecodeDynamicLength(buf, 1048576)
+			if err != nil {
+				return err
+			}
+			err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+				if len(buf) > 1073741824 {
+					return ssz.ErrBytesLength
+				}
+				{
+					elemIndx := hh.Index()
+					hh.AppendBytes32(buf)
+					hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (1073741824+31)/32)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			hh.MerkleizeWithMixin(subIndx, uint64(num), 1048576)
+		}
+	}
+
+	// Field (6) 'Bits'
+	{
+		buf := tail[o6:o7]
+		if err = ssz.ValidateBitlist(buf, 2048); err != nil {
+			return err
+		}
+		hh.PutBitlist(buf, 2048)
+	}
+
+	// Field (7) 'Fixed'
+	{
+		buf := tail[o7:]
+		{
+			subIndx := hh.Index()
+			num, err := ssz.DivideInt2(len(buf), 16, 2)
+			if err != nil {
+				return err
+			}
+			for ii := 0; ii < num; ii++ {
+				if err = (*GenericElemFixedTagged)(nil).HashTreeRootSSZWith(hh, buf[ii*16:(ii+1)*16]); err != nil {
+					return err
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, uint64(num), 2)
+		}
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the GenericTagged object
+func (g *GenericTagged) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(g)
+}
+
+const (
+	// GenericTaggedValidatorsGIndex is the generalized index of the 'Validators' field
+	GenericTaggedValidatorsGIndex ssz.GIndex = 8
+	// GenericTaggedBalancesGIndex is the generalized index of the 'Balances' field
+	GenericTaggedBalancesGIndex ssz.GIndex = 9
+	// GenericTaggedRootsGIndex is the generalized index of the 'Roots' field
+	GenericTaggedRootsGIndex ssz.GIndex = 10
+	// GenericTaggedPubkeyGIndex is the generalized index of the 'Pubkey' field
+	GenericTaggedPubkeyGIndex ssz.GIndex = 11
+	// GenericTaggedExtraGIndex is the generalized index of the 'Extra' field
+	GenericTaggedExtraGIndex ssz.GIndex = 12
+	// GenericTaggedTransactionsGIndex is the generalized index of the 'Transactions' field
+	GenericTaggedTransactionsGIndex ssz.GIndex = 13
+	// GenericTaggedBitsGIndex is the generalized index of the 'Bits' field
+	GenericTaggedBitsGIndex ssz.GIndex = 14
+	// GenericTaggedFixedGIndex is the generalized index of the 'Fixed' field
+	GenericTaggedFixedGIndex ssz.GIndex = 15
+)
+
+// ProveValidators returns a merkle proof of the 'Validators' field of the GenericTagged object
+func (g *GenericTagged) ProveValidators() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(g, GenericTaggedValidatorsGIndex)
+}
+
+// VerifyGenericTaggedValidators verifies a merkle proof of the 'Validators' field of a GenericTagged object
+func VerifyGenericTaggedValidators(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, GenericTaggedValidatorsGIndex)
+}
+
+// ProveBalances returns a merkle proof of the 'Balances' field of the GenericTagged object
+func (g *GenericTagged) ProveBalances() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(g, GenericTaggedBalancesGIndex)
+}
+
+// VerifyGenericTaggedBalances verifies a merkle proof of the 'Balances' field of a GenericTagged object
+func VerifyGenericTaggedBalances(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, GenericTaggedBalancesGIndex)
+}
+
+// ProveRoots returns a merkle proof of the 'Roots' field of the GenericTagged object
+func (g *GenericTagged) ProveRoots() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(g, GenericTaggedRootsGIndex)
+}
+
+// VerifyGenericTaggedRoots verifies a merkle proof of the 'Roots' field of a GenericTagged object
+func VerifyGenericTaggedRoots(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, GenericTaggedRootsGIndex)
+}
+
+// ProvePubkey returns a merkle proof of the 'Pubkey' field of the GenericTagged object
+func (g *GenericTagged) ProvePubkey() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(g, GenericTaggedPubkeyGIndex)
+}
+
+// VerifyGenericTaggedPubkey verifies a merkle proof of the 'Pubkey' field of a GenericTagged object
+func VerifyGenericTaggedPubkey(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, GenericTaggedPubkeyGIndex)
+}
+
+// ProveExtra returns a merkle proof of the 'Extra' field of the GenericTagged object
+func (g *GenericTagged) ProveExtra() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(g, GenericTaggedExtraGIndex)
+}
+
+// VerifyGenericTaggedExtra verifies a merkle proof of the 'Extra' field of a GenericTagged object
+func VerifyGenericTaggedExtra(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, GenericTaggedExtraGIndex)
+}
+
+// ProveTransactions returns a merkle proof of the 'Transactions' field of the GenericTagged object
+func (g *GenericTagged) ProveTransactions() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(g, GenericTaggedTransactionsGIndex)
+}
+
+// VerifyGenericTaggedTransactions verifies a merkle proof of the 'Transactions' field of a GenericTagged object
+func VerifyGenericTaggedTransactions(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, GenericTaggedTransactionsGIndex)
+}
+
+// ProveBits returns a merkle proof of the 'Bits' field of the GenericTagged object
+func (g *GenericTagged) ProveBits() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(g, GenericTaggedBitsGIndex)
+}
+
+// VerifyGenericTaggedBits verifies a merkle proof of the 'Bits' field of a GenericTagged object
+func VerifyGenericTaggedBits(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, GenericTaggedBitsGIndex)
+}
+
+// ProveFixed returns a merkle proof of the 'Fixed' field of the GenericTagged object
+func (g *GenericTagged) ProveFixed() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(g, GenericTaggedFixedGIndex)
+}
+
+// VerifyGenericTaggedFixed verifies a merkle proof of the 'Fixed' field of a GenericTagged object
+func VerifyGenericTaggedFixed(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, GenericTaggedFixedGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the GenericTagged object
+func (g *GenericTagged) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Validators", Schema: ssz.SchemaList((*GenericElemTagged)(nil).SchemaSSZ(), 1099511627776)},
+		&ssz.SchemaField{Name: "Balances", Schema: ssz.SchemaList(ssz.SchemaUint(8), 10)},
+		&ssz.SchemaField{Name: "Roots", Schema: ssz.SchemaVector(ssz.SchemaBytes(32), 4)},
+		&ssz.SchemaField{Name: "Pubkey", Schema: ssz.SchemaBytes(48)},
+		&ssz.SchemaField{Name: "Extra", Schema: ssz.SchemaList(ssz.SchemaUint(1), 256)},
+		&ssz.SchemaField{Name: "Transactions", Schema: ssz.SchemaList(ssz.SchemaList(ssz.SchemaUint(1), 1073741824), 1048576)},
+		&ssz.SchemaField{Name: "Bits", Schema: ssz.SchemaBitlist(2048)},
+		&ssz.SchemaField{Name: "Fixed", Schema: ssz.SchemaList((*GenericElemFixedTagged)(nil).SchemaSSZ(), 2)},
+	)
+}
+
+// MarshalSSZ ssz marshals the GenericElemTagged object
+func (g *GenericElemTagged) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(g)
+}
+
+// MarshalSSZTo ssz marshals the GenericElemTagged object to a target array
+func (g *GenericElemTagged) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(12)
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint64(dst, g.A)
+
+	// Offset (1) 'B'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'B'
+	if size := len(g.B); size > 32 {
+		err = ssz.ErrBytesLengthFn("GenericElemTagged.B", size, 32)
+		return
+	}
+	dst = append(dst, g.B...)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the GenericElemTagged object to a writer
+func (g *GenericElemTagged) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(g, writer)
+}
+
+// MarshalSSZToStream ssz marshals the GenericElemTagged object to a target array that is flushed to a stream writer
+func (g *GenericElemTagged) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(12)
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint64(dst, g.A)
+
+	// Offset (1) 'B'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (1) 'B'
+	if size := len(g.B); size > 32 {
+		err = ssz.ErrBytesLengthFn("GenericElemTagged.B", size, 32)
+		return
+	}
+	if dst, err = sw.Append(dst, g.B); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the GenericElemTagged object
+func (g *GenericElemTagged) UnmarshalSSZ(buf []byte) error {
+	return g.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the GenericElemTagged object with the decoding options
+func (g *GenericElemTagged) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 12 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'A'
+	g.A = ssz.UnmarshallUint64(buf[0:8])
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = opts.ValidateFirstOffset(o1, 12); err != nil {
+		return err
+	}
+
+	// Field (1) 'B'
+	{
+		buf = tail[o1:]
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(g.B) == 0 {
+			g.B = make([]byte, 0, len(buf))
+		}
+		g.B = append(g.B, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the GenericElemTagged object
+func (g *GenericElemTagged) SizeSSZ() (size int) {
+	size = 12
+
+	// Field (1) 'B'
+	size += len(g.B)
+
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the GenericElemTagged object
+func (g *GenericElemTagged) MinSSZSize() uint64 {
+	return 12
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the GenericElemTagged object
+func (g *GenericElemTagged) MaxSSZSize() uint64 {
+	return 44
+}
+
+// HashTreeRoot ssz hashes the GenericElemTagged object
+func (g *GenericElemTagged) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(g)
+}
+
+// HashTreeRootWith ssz hashes the GenericElemTagged object with a hasher
+func (g *GenericElemTagged) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint64(g.A)
+
+	// Field (1) 'B'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(g.B))
+		if byteLen > 32 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.Append(g.B)
+		hh.MerkleizeWithMixin(elemIndx, byteLen, (32+31)/32)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded GenericElemTagged object without decoding it
+func (g *GenericElemTagged) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(g, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded GenericElemTagged object with a hasher
+func (g *GenericElemTagged) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 12 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Offset (1) 'B'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = ssz.ValidateFirstOffset(o1, 12); err != nil {
+		return err
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'B'
+	{
+		buf := tail[o1:]
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		{
+			elemIndx := hh.Index()
+			hh.Append(buf)
+			hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (32+31)/32)
+		}
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the GenericElemTagged object
+func (g *GenericElemTagged) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(g)
+}
+
+const (
+	// GenericElemTaggedAGIndex is the generalized index of the 'A' field
+	GenericElemTaggedAGIndex ssz.GIndex = 2
+	// GenericElemTaggedBGIndex is the generalized index of the 'B' field
+	GenericElemTaggedBGIndex ssz.GIndex = 3
+)
+
+// ProveA returns a merkle proof of the 'A' field of the GenericElemTagged object
+func (g *GenericElemTagged) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(g, GenericElemTaggedAGIndex)
+}
+
+// VerifyGenericElemTaggedA verifies a merkle proof of the 'A' field of a GenericElemTagged object
+func VerifyGenericElemTaggedA(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, GenericElemTaggedAGIndex)
+}
+
+// ProveB returns a merkle proof of the 'B' field of the GenericElemTagged object
+func (g *GenericElemTagged) ProveB() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(g, GenericElemTaggedBGIndex)
+}
+
+// VerifyGenericElemTaggedB verifies a merkle proof of the 'B' field of a GenericElemTagged object
+func VerifyGenericElemTaggedB(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, GenericElemTaggedBGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the GenericElemTagged object
+func (g *GenericElemTagged) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "B", Schema: ssz.SchemaList(ssz.SchemaUint(1), 32)},
+	)
+}
+
+// MarshalSSZ ssz marshals the GenericElemFixedTagged object
+func (g *GenericElemFixedTagged) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(g)
+}
+
+// MarshalSSZTo ssz marshals the GenericElemFixedTagged object to a target array
+func (g *GenericElemFixedTagged) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint64(dst, g.A)
+
+	// Field (1) 'B'
+	if size := len(g.B); size != 8 {
+		err = ssz.ErrBytesLengthFn("GenericElemFixedTagged.B", size, 8)
+		return
+	}
+	dst = append(dst, g.B...)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the GenericElemFixedTagged object to a writer
+func (g *GenericElemFixedTagged) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(g, writer)
+}
+
+// MarshalSSZToStream ssz marshals the GenericElemFixedTagged object to a target array that is flushed to a stream writer
+func (g *GenericElemFixedTagged) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'A'
+	dst = ssz.MarshalUint64(dst, g.A)
+
+	// Field (1) 'B'
+	if size := len(g.B); size != 8 {
+		err = ssz.ErrBytesLengthFn("GenericElemFixedTagged.B", size, 8)
+		return
+	}
+	dst = append(dst, g.B...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the GenericElemFixedTagged object
+func (g *GenericElemFixedTagged) UnmarshalSSZ(buf []byte) error {
+	return g.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the GenericElemFixedTagged object with the decoding options
+func (g *GenericElemFixedTagged) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 16 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'A'
+	g.A = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'B'
+	if cap(g.B) == 0 {
+		g.B = make([]byte, 0, len(buf[8:16]))
+	}
+	g.B = append(g.B, buf[8:16]...)
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the GenericElemFixedTagged object
+func (g *GenericElemFixedTagged) SizeSSZ() (size int) {
+	size = 16
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the GenericElemFixedTagged object
+func (g *GenericElemFixedTagged) MinSSZSize() uint64 {
+	return 16
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the GenericElemFixedTagged object
+func (g *GenericElemFixedTagged) MaxSSZSize() uint64 {
+	return 16
+}
+
+// HashTreeRoot ssz hashes the GenericElemFixedTagged object
+func (g *GenericElemFixedTagged) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(g)
+}
+
+// HashTreeRootWith ssz hashes the GenericElemFixedTagged object with a hasher
+func (g *GenericElemFixedTagged) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint64(g.A)
+
+	// Field (1) 'B'
+	if size := len(g.B); size != 8 {
+		err = ssz.ErrBytesLengthFn("GenericElemFixedTagged.B", size, 8)
+		return
+	}
+	hh.PutBytes(g.B)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded GenericElemFixedTagged object without decoding it
+func (g *GenericElemFixedTagged) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(g, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded GenericElemFixedTagged object with a hasher
+func (g *GenericElemFixedTagged) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 16 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'B'
+	hh.PutBytes(buf[8:16])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the GenericElemFixedTagged object
+func (g *GenericElemFixedTagged) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(g)
+}
+
+const (
+	// GenericElemFixedTaggedAGIndex is the generalized index of the 'A' field
+	GenericElemFixedTaggedAGIndex ssz.GIndex = 2
+	// GenericElemFixedTaggedBGIndex is the generalized index of the 'B' field
+	GenericElemFixedTaggedBGIndex ssz.GIndex = 3
+)
+
+// ProveA returns a merkle proof of the 'A' field of the GenericElemFixedTagged object
+func (g *GenericElemFixedTagged) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(g, GenericElemFixedTaggedAGIndex)
+}
+
+// VerifyGenericElemFixedTaggedA verifies a merkle proof of the 'A' field of a GenericElemFixedTagged object
+func VerifyGenericElemFixedTaggedA(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, GenericElemFixedTaggedAGIndex)
+}
+
+// ProveB returns a merkle proof of the 'B' field of the GenericElemFixedTagged object
+func (g *GenericElemFixedTagged) ProveB() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(g, GenericElemFixedTaggedBGIndex)
+}
+
+// VerifyGenericElemFixedTaggedB verifies a merkle proof of the 'B' field of a GenericElemFixedTagged object
+func VerifyGenericElemFixedTaggedB(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, GenericElemFixedTaggedBGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the GenericElemFixedTagged object
+func (g *GenericElemFixedTagged) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "B", Schema: ssz.SchemaBytes(8)},
+	)
+}
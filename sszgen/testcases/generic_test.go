@@ -0,0 +1,213 @@
+package testcases
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+func testGeneric(t *testing.T) (*Generic, *GenericTagged) {
+	g := &Generic{
+		Pubkey: make(ssz.Vector[byte, ssz.Limit48], 48),
+		Roots:  make(ssz.Vector[[32]byte, ssz.Limit4], 4),
+		Extra:  ssz.List[byte, ssz.Limit256]{0x1, 0x2},
+	}
+	g.Pubkey[0] = 0xaa
+	g.Roots[3][31] = 0xbb
+
+	for i := 0; i < 3; i++ {
+		if err := g.Validators.Append(&GenericElem{A: uint64(i), B: []byte{byte(i)}}); err != nil {
+			t.Fatal(err)
+		}
+		if err := g.Balances.Append(uint64(i * 100)); err != nil {
+			t.Fatal(err)
+		}
+		if err := g.Transactions.Append(bytes.Repeat([]byte{byte(i)}, 40*i)); err != nil {
+			t.Fatal(err)
+		}
+		if err := g.Bits.Append(i%2 == 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	g.Fixed = append(g.Fixed, &GenericElemFixed{A: 1, B: make([]byte, 8)})
+
+	tagged := &GenericTagged{
+		Balances: g.Balances,
+		Roots:    g.Roots,
+		Pubkey:   g.Pubkey,
+		Extra:    g.Extra,
+		Bits:     g.Bits,
+		Fixed:    []*GenericElemFixedTagged{{A: 1, B: make([]byte, 8)}},
+	}
+	for _, v := range g.Validators {
+		tagged.Validators = append(tagged.Validators, &GenericElemTagged{A: v.A, B: v.B})
+	}
+	for _, tx := range g.Transactions {
+		tagged.Transactions = append(tagged.Transactions, tx)
+	}
+	return g, tagged
+}
+
+func TestGenericTypes(t *testing.T) {
+	g, tagged := testGeneric(t)
+
+	data, err := g.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := tagged.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, expected) {
+		t.Fatal("the encodings are not equal")
+	}
+
+	var w bytes.Buffer
+	if _, err := g.MarshalSSZToWriter(&w); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, w.Bytes()) {
+		t.Fatal("the encoding to the writer is not equal")
+	}
+
+	root, err := g.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedRoot, err := tagged.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != expectedRoot {
+		t.Fatal("the roots are not equal")
+	}
+
+	sszRoot, err := g.HashTreeRootSSZ(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sszRoot != root {
+		t.Fatal("bad root from the encoding")
+	}
+
+	// the encoding is hashed without the receiver
+	if nilRoot, err := (*Generic)(nil).HashTreeRootSSZ(data); err != nil || nilRoot != root {
+		t.Fatalf("bad root from the encoding with a nil receiver: %v", err)
+	}
+
+	g2 := new(Generic)
+	if err := g2.UnmarshalSSZ(data); err != nil {
+		t.Fatal(err)
+	}
+	if g2.Validators.Len() != 3 || g2.Validators.Get(2).A != 2 {
+		t.Fatal("bad validators")
+	}
+	if g2.Bits.Len() != 3 || !g2.Bits.BitAt(0) || g2.Bits.BitAt(1) {
+		t.Fatal("bad bits")
+	}
+	if root2, _ := g2.HashTreeRoot(); root2 != root {
+		t.Fatal("bad root after decoding")
+	}
+
+	if g.MaxSSZSize() != tagged.MaxSSZSize() || g.MinSSZSize() != tagged.MinSSZSize() {
+		t.Fatal("the size bounds are not equal")
+	}
+}
+
+func TestGenericTypesRuntime(t *testing.T) {
+	g, _ := testGeneric(t)
+
+	// the methods of the generic types match the fields of the generated container
+	fields := []struct {
+		name  string
+		obj   ssz.HashRoot
+		prove func() (*ssz.Proof, error)
+	}{
+		{"Validators", g.Validators, g.ProveValidators},
+		{"Balances", g.Balances, g.ProveBalances},
+		{"Roots", g.Roots, g.ProveRoots},
+		{"Pubkey", g.Pubkey, g.ProvePubkey},
+		{"Extra", g.Extra, g.ProveExtra},
+		{"Transactions", g.Transactions, g.ProveTransactions},
+		{"Bits", g.Bits, g.ProveBits},
+		{"Fixed", g.Fixed, g.ProveFixed},
+	}
+	for _, f := range fields {
+		root, err := f.obj.HashTreeRoot()
+		if err != nil {
+			t.Fatalf("%s: %v", f.name, err)
+		}
+		proof, err := f.prove()
+		if err != nil {
+			t.Fatalf("%s: %v", f.name, err)
+		}
+		if !bytes.Equal(root[:], proof.Leaf) {
+			t.Fatalf("%s: bad root", f.name)
+		}
+	}
+
+	// encode and decode the fields on their own
+	data, err := g.Transactions.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var txs ssz.List[ssz.List[byte, ssz.Limit1G], ssz.Limit1M]
+	if err := txs.UnmarshalSSZ(data); err != nil {
+		t.Fatal(err)
+	}
+	if txs.Len() != 3 || len(txs.Get(2)) != 80 {
+		t.Fatal("bad transactions")
+	}
+
+	data, err = g.Validators.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var validators ssz.List[*GenericElem, ssz.Limit1T]
+	if err := validators.UnmarshalSSZ(data); err != nil {
+		t.Fatal(err)
+	}
+	if validators.Len() != 3 || validators.Get(1).B[0] != 1 {
+		t.Fatal("bad validators")
+	}
+
+	// the limits are checked
+	var balances ssz.List[uint64, Limit10]
+	for i := 0; i < 10; i++ {
+		if err := balances.Append(1); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := balances.Append(1); err == nil {
+		t.Fatal("the list should be full")
+	}
+	if _, err := g.Roots[:3].MarshalSSZ(); err == nil {
+		t.Fatal("the vector should have 4 elements")
+	}
+}
+
+func TestGenericTypesSchema(t *testing.T) {
+	schema := (&Generic{}).SchemaSSZ()
+	if !reflect.DeepEqual(schema, (&GenericTagged{}).SchemaSSZ()) {
+		t.Fatal("the schemas are not equal")
+	}
+
+	// the schema of the generic types match the fields of the generated container
+	g := &Generic{}
+	runtime := ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Validators", Schema: g.Validators.SchemaSSZ()},
+		&ssz.SchemaField{Name: "Balances", Schema: g.Balances.SchemaSSZ()},
+		&ssz.SchemaField{Name: "Roots", Schema: g.Roots.SchemaSSZ()},
+		&ssz.SchemaField{Name: "Pubkey", Schema: g.Pubkey.SchemaSSZ()},
+		&ssz.SchemaField{Name: "Extra", Schema: g.Extra.SchemaSSZ()},
+		&ssz.SchemaField{Name: "Transactions", Schema: g.Transactions.SchemaSSZ()},
+		&ssz.SchemaField{Name: "Bits", Schema: g.Bits.SchemaSSZ()},
+		&ssz.SchemaField{Name: "Fixed", Schema: g.Fixed.SchemaSSZ()},
+	)
+	if !reflect.DeepEqual(schema, runtime) {
+		t.Fatal("the schema of the generic types is not equal")
+	}
+}
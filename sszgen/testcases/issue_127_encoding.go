@@ -4,6 +4,8 @@
 package testcases
 
 import (
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -30,6 +32,7 @@ func (o *Obj2) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		for ii := 0; ii < len(o.T1); ii++ {
 			dst = ssz.WriteOffset(dst, offset)
 			offset += len(o.T1[ii])
+
 		}
 	}
 	for ii := 0; ii < len(o.T1); ii++ {
@@ -38,6 +41,51 @@ func (o *Obj2) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 			return
 		}
 		dst = append(dst, o.T1[ii]...)
+
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the Obj2 object to a writer
+func (o *Obj2) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(o, writer)
+}
+
+// MarshalSSZToStream ssz marshals the Obj2 object to a target array that is flushed to a stream writer
+func (o *Obj2) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
+
+	// Offset (0) 'T1'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'T1'
+	if size := len(o.T1); size > 1024 {
+		err = ssz.ErrListTooBigFn("Obj2.T1", size, 1024)
+		return
+	}
+	{
+		offset = 4 * len(o.T1)
+		for ii := 0; ii < len(o.T1); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += len(o.T1[ii])
+			if dst, err = sw.Flush(dst); err != nil {
+				return
+			}
+		}
+	}
+	for ii := 0; ii < len(o.T1); ii++ {
+		if size := len(o.T1[ii]); size > 256 {
+			err = ssz.ErrBytesLengthFn("Obj2.T1[ii]", size, 256)
+			return
+		}
+		if dst, err = sw.Append(dst, o.T1[ii]); err != nil {
+			return
+		}
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
 	}
 
 	return
@@ -45,6 +93,11 @@ func (o *Obj2) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the Obj2 object
 func (o *Obj2) UnmarshalSSZ(buf []byte) error {
+	return o.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Obj2 object with the decoding options
+func (o *Obj2) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size < 4 {
@@ -59,14 +112,14 @@ func (o *Obj2) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 4 {
-		return ssz.ErrInvalidVariableOffset
+	if err = opts.ValidateFirstOffset(o0, 4); err != nil {
+		return err
 	}
 
 	// Field (0) 'T1'
 	{
 		buf = tail[o0:]
-		num, err := ssz.DecodeDynamicLength(buf, 1024)
+		num, err := opts.DecodeDynamicLength(buf, 1024)
 		if err != nil {
 			return err
 		}
@@ -101,6 +154,16 @@ func (o *Obj2) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Obj2 object
+func (o *Obj2) MinSSZSize() uint64 {
+	return 4
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Obj2 object
+func (o *Obj2) MaxSSZSize() uint64 {
+	return 266244
+}
+
 // HashTreeRoot ssz hashes the Obj2 object
 func (o *Obj2) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(o)
@@ -137,7 +200,86 @@ func (o *Obj2) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded Obj2 object without decoding it
+func (o *Obj2) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(o, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded Obj2 object with a hasher
+func (o *Obj2) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'T1'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = ssz.ValidateFirstOffset(o0, 4); err != nil {
+		return err
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'T1'
+	{
+		buf := tail[o0:]
+		{
+			subIndx := hh.Index()
+			num, err := ssz.DecodeDynamicLength(buf, 1024)
+			if err != nil {
+				return err
+			}
+			err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+				if len(buf) > 256 {
+					return ssz.ErrBytesLength
+				}
+				{
+					elemIndx := hh.Index()
+					hh.AppendBytes32(buf)
+					hh.MerkleizeWithMixin(elemIndx, uint64(len(buf)), (256+31)/32)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			hh.MerkleizeWithMixin(subIndx, uint64(num), 1024)
+		}
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the Obj2 object
 func (o *Obj2) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(o)
 }
+
+const (
+	// Obj2T1GIndex is the generalized index of the 'T1' field
+	Obj2T1GIndex ssz.GIndex = 1
+)
+
+// ProveT1 returns a merkle proof of the 'T1' field of the Obj2 object
+func (o *Obj2) ProveT1() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(o, Obj2T1GIndex)
+}
+
+// VerifyObj2T1 verifies a merkle proof of the 'T1' field of a Obj2 object
+func VerifyObj2T1(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Obj2T1GIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the Obj2 object
+func (o *Obj2) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "T1", Schema: ssz.SchemaList(ssz.SchemaList(ssz.SchemaUint(1), 256), 1024)},
+	)
+}
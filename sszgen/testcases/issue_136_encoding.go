@@ -4,7 +4,10 @@
 package testcases
 
 import (
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
+	"github.com/ferranbt/fastssz/sszgen/testcases/other"
 )
 
 // MarshalSSZ ssz marshals the Issue136 object
@@ -24,8 +27,30 @@ func (i *Issue136) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the Issue136 object to a writer
+func (i *Issue136) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(i, writer)
+}
+
+// MarshalSSZToStream ssz marshals the Issue136 object to a target array that is flushed to a stream writer
+func (i *Issue136) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'C'
+	if dst, err = i.C.MarshalSSZToStream(dst, sw); err != nil {
+		return
+	}
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the Issue136 object
 func (i *Issue136) UnmarshalSSZ(buf []byte) error {
+	return i.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Issue136 object with the decoding options
+func (i *Issue136) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 0 {
@@ -33,7 +58,7 @@ func (i *Issue136) UnmarshalSSZ(buf []byte) error {
 	}
 
 	// Field (0) 'C'
-	if err = i.C.UnmarshalSSZ(buf[0:0]); err != nil {
+	if err = i.C.UnmarshalSSZWithOptions(buf[0:0], opts); err != nil {
 		return err
 	}
 
@@ -46,6 +71,16 @@ func (i *Issue136) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Issue136 object
+func (i *Issue136) MinSSZSize() uint64 {
+	return 0
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Issue136 object
+func (i *Issue136) MaxSSZSize() uint64 {
+	return 0
+}
+
 // HashTreeRoot ssz hashes the Issue136 object
 func (i *Issue136) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(i)
@@ -64,7 +99,52 @@ func (i *Issue136) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded Issue136 object without decoding it
+func (i *Issue136) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(i, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded Issue136 object with a hasher
+func (i *Issue136) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 0 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'C'
+	if err = (*other.Case3B)(nil).HashTreeRootSSZWith(hh, buf[0:0]); err != nil {
+		return err
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the Issue136 object
 func (i *Issue136) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(i)
 }
+
+const (
+	// Issue136CGIndex is the generalized index of the 'C' field
+	Issue136CGIndex ssz.GIndex = 1
+)
+
+// ProveC returns a merkle proof of the 'C' field of the Issue136 object
+func (i *Issue136) ProveC() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(i, Issue136CGIndex)
+}
+
+// VerifyIssue136C verifies a merkle proof of the 'C' field of a Issue136 object
+func VerifyIssue136C(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Issue136CGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the Issue136 object
+func (i *Issue136) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "C", Schema: (*other.Case3B)(nil).SchemaSSZ()},
+	)
+}
@@ -4,6 +4,8 @@
 package testcases
 
 import (
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -28,8 +30,34 @@ func (i *Issue153) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the Issue153 object to a writer
+func (i *Issue153) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(i, writer)
+}
+
+// MarshalSSZToStream ssz marshals the Issue153 object to a target array that is flushed to a stream writer
+func (i *Issue153) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Value1'
+	dst = append(dst, i.Value1[:]...)
+
+	// Field (1) 'Value2'
+	dst = append(dst, i.Value2[:]...)
+
+	// Field (2) 'Value'
+	dst = append(dst, i.Value[:]...)
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the Issue153 object
 func (i *Issue153) UnmarshalSSZ(buf []byte) error {
+	return i.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Issue153 object with the decoding options
+func (i *Issue153) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 128 {
@@ -54,6 +82,16 @@ func (i *Issue153) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Issue153 object
+func (i *Issue153) MinSSZSize() uint64 {
+	return 128
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Issue153 object
+func (i *Issue153) MaxSSZSize() uint64 {
+	return 128
+}
+
 // HashTreeRoot ssz hashes the Issue153 object
 func (i *Issue153) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(i)
@@ -76,7 +114,82 @@ func (i *Issue153) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded Issue153 object without decoding it
+func (i *Issue153) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(i, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded Issue153 object with a hasher
+func (i *Issue153) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 128 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Value1'
+	hh.PutBytes(buf[0:32])
+
+	// Field (1) 'Value2'
+	hh.PutBytes(buf[32:80])
+
+	// Field (2) 'Value'
+	hh.PutBytes(buf[80:128])
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the Issue153 object
 func (i *Issue153) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(i)
 }
+
+const (
+	// Issue153Value1GIndex is the generalized index of the 'Value1' field
+	Issue153Value1GIndex ssz.GIndex = 4
+	// Issue153Value2GIndex is the generalized index of the 'Value2' field
+	Issue153Value2GIndex ssz.GIndex = 5
+	// Issue153ValueGIndex is the generalized index of the 'Value' field
+	Issue153ValueGIndex ssz.GIndex = 6
+)
+
+// ProveValue1 returns a merkle proof of the 'Value1' field of the Issue153 object
+func (i *Issue153) ProveValue1() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(i, Issue153Value1GIndex)
+}
+
+// VerifyIssue153Value1 verifies a merkle proof of the 'Value1' field of a Issue153 object
+func VerifyIssue153Value1(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Issue153Value1GIndex)
+}
+
+// ProveValue2 returns a merkle proof of the 'Value2' field of the Issue153 object
+func (i *Issue153) ProveValue2() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(i, Issue153Value2GIndex)
+}
+
+// VerifyIssue153Value2 verifies a merkle proof of the 'Value2' field of a Issue153 object
+func VerifyIssue153Value2(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Issue153Value2GIndex)
+}
+
+// ProveValue returns a merkle proof of the 'Value' field of the Issue153 object
+func (i *Issue153) ProveValue() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(i, Issue153ValueGIndex)
+}
+
+// VerifyIssue153Value verifies a merkle proof of the 'Value' field of a Issue153 object
+func VerifyIssue153Value(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Issue153ValueGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the Issue153 object
+func (i *Issue153) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Value1", Schema: ssz.SchemaBytes(32)},
+		&ssz.SchemaField{Name: "Value2", Schema: ssz.SchemaBytes(48)},
+		&ssz.SchemaField{Name: "Value", Schema: ssz.SchemaBytes(48)},
+	)
+}
@@ -4,6 +4,8 @@
 package testcases
 
 import (
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -35,8 +37,41 @@ func (i *Issue156) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the Issue156 object to a writer
+func (i *Issue156) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(i, writer)
+}
+
+// MarshalSSZToStream ssz marshals the Issue156 object to a target array that is flushed to a stream writer
+func (i *Issue156) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'A'
+	dst = append(dst, i.A[:]...)
+
+	// Field (1) 'A2'
+	dst = append(dst, i.A2[:]...)
+
+	// Field (2) 'A3'
+	dst = append(dst, i.A3[:]...)
+
+	// Field (3) 'A4'
+	if size := len(i.A4); size != 32 {
+		err = ssz.ErrBytesLengthFn("Issue156.A4", size, 32)
+		return
+	}
+	dst = append(dst, i.A4...)
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the Issue156 object
 func (i *Issue156) UnmarshalSSZ(buf []byte) error {
+	return i.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Issue156 object with the decoding options
+func (i *Issue156) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 128 {
@@ -67,6 +102,16 @@ func (i *Issue156) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Issue156 object
+func (i *Issue156) MinSSZSize() uint64 {
+	return 128
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Issue156 object
+func (i *Issue156) MaxSSZSize() uint64 {
+	return 128
+}
+
 // HashTreeRoot ssz hashes the Issue156 object
 func (i *Issue156) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(i)
@@ -96,7 +141,98 @@ func (i *Issue156) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded Issue156 object without decoding it
+func (i *Issue156) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(i, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded Issue156 object with a hasher
+func (i *Issue156) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 128 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'A'
+	hh.PutBytes(buf[0:32])
+
+	// Field (1) 'A2'
+	hh.PutBytes(buf[32:64])
+
+	// Field (2) 'A3'
+	hh.PutBytes(buf[64:96])
+
+	// Field (3) 'A4'
+	hh.PutBytes(buf[96:128])
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the Issue156 object
 func (i *Issue156) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(i)
 }
+
+const (
+	// Issue156AGIndex is the generalized index of the 'A' field
+	Issue156AGIndex ssz.GIndex = 4
+	// Issue156A2GIndex is the generalized index of the 'A2' field
+	Issue156A2GIndex ssz.GIndex = 5
+	// Issue156A3GIndex is the generalized index of the 'A3' field
+	Issue156A3GIndex ssz.GIndex = 6
+	// Issue156A4GIndex is the generalized index of the 'A4' field
+	Issue156A4GIndex ssz.GIndex = 7
+)
+
+// ProveA returns a merkle proof of the 'A' field of the Issue156 object
+func (i *Issue156) ProveA() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(i, Issue156AGIndex)
+}
+
+// VerifyIssue156A verifies a merkle proof of the 'A' field of a Issue156 object
+func VerifyIssue156A(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Issue156AGIndex)
+}
+
+// ProveA2 returns a merkle proof of the 'A2' field of the Issue156 object
+func (i *Issue156) ProveA2() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(i, Issue156A2GIndex)
+}
+
+// VerifyIssue156A2 verifies a merkle proof of the 'A2' field of a Issue156 object
+func VerifyIssue156A2(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Issue156A2GIndex)
+}
+
+// ProveA3 returns a merkle proof of the 'A3' field of the Issue156 object
+func (i *Issue156) ProveA3() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(i, Issue156A3GIndex)
+}
+
+// VerifyIssue156A3 verifies a merkle proof of the 'A3' field of a Issue156 object
+func VerifyIssue156A3(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Issue156A3GIndex)
+}
+
+// ProveA4 returns a merkle proof of the 'A4' field of the Issue156 object
+func (i *Issue156) ProveA4() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(i, Issue156A4GIndex)
+}
+
+// VerifyIssue156A4 verifies a merkle proof of the 'A4' field of a Issue156 object
+func VerifyIssue156A4(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, Issue156A4GIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the Issue156 object
+func (i *Issue156) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "A", Schema: ssz.SchemaBytes(32)},
+		&ssz.SchemaField{Name: "A2", Schema: ssz.SchemaBytes(32)},
+		&ssz.SchemaField{Name: "A3", Schema: ssz.SchemaBytes(32)},
+		&ssz.SchemaField{Name: "A4", Schema: ssz.SchemaBytes(32)},
+	)
+}
@@ -4,6 +4,8 @@
 package testcases
 
 import (
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -26,8 +28,32 @@ func (b *BytesWrapper) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the BytesWrapper object to a writer
+func (b *BytesWrapper) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(b, writer)
+}
+
+// MarshalSSZToStream ssz marshals the BytesWrapper object to a target array that is flushed to a stream writer
+func (b *BytesWrapper) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Bytes'
+	if size := len(b.Bytes); size != 48 {
+		err = ssz.ErrBytesLengthFn("BytesWrapper.Bytes", size, 48)
+		return
+	}
+	dst = append(dst, b.Bytes...)
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the BytesWrapper object
 func (b *BytesWrapper) UnmarshalSSZ(buf []byte) error {
+	return b.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the BytesWrapper object with the decoding options
+func (b *BytesWrapper) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 48 {
@@ -49,6 +75,16 @@ func (b *BytesWrapper) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the BytesWrapper object
+func (b *BytesWrapper) MinSSZSize() uint64 {
+	return 48
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the BytesWrapper object
+func (b *BytesWrapper) MaxSSZSize() uint64 {
+	return 48
+}
+
 // HashTreeRoot ssz hashes the BytesWrapper object
 func (b *BytesWrapper) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(b)
@@ -69,11 +105,54 @@ func (b *BytesWrapper) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded BytesWrapper object without decoding it
+func (b *BytesWrapper) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(b, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded BytesWrapper object with a hasher
+func (b *BytesWrapper) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 48 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Bytes'
+	hh.PutBytes(buf[0:48])
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the BytesWrapper object
 func (b *BytesWrapper) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(b)
 }
 
+const (
+	// BytesWrapperBytesGIndex is the generalized index of the 'Bytes' field
+	BytesWrapperBytesGIndex ssz.GIndex = 1
+)
+
+// ProveBytes returns a merkle proof of the 'Bytes' field of the BytesWrapper object
+func (b *BytesWrapper) ProveBytes() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(b, BytesWrapperBytesGIndex)
+}
+
+// VerifyBytesWrapperBytes verifies a merkle proof of the 'Bytes' field of a BytesWrapper object
+func VerifyBytesWrapperBytes(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, BytesWrapperBytesGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the BytesWrapper object
+func (b *BytesWrapper) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Bytes", Schema: ssz.SchemaBytes(48)},
+	)
+}
+
 // MarshalSSZ ssz marshals the ListC object
 func (l *ListC) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(l)
@@ -96,6 +175,37 @@ func (l *ListC) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		if dst, err = l.Elems[ii].MarshalSSZTo(dst); err != nil {
 			return
 		}
+
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the ListC object to a writer
+func (l *ListC) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(l, writer)
+}
+
+// MarshalSSZToStream ssz marshals the ListC object to a target array that is flushed to a stream writer
+func (l *ListC) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
+
+	// Offset (0) 'Elems'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Elems'
+	if size := len(l.Elems); size > 32 {
+		err = ssz.ErrListTooBigFn("ListC.Elems", size, 32)
+		return
+	}
+	for ii := 0; ii < len(l.Elems); ii++ {
+		if dst, err = l.Elems[ii].MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
 	}
 
 	return
@@ -103,6 +213,11 @@ func (l *ListC) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the ListC object
 func (l *ListC) UnmarshalSSZ(buf []byte) error {
+	return l.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the ListC object with the decoding options
+func (l *ListC) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size < 4 {
@@ -117,8 +232,8 @@ func (l *ListC) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 4 {
-		return ssz.ErrInvalidVariableOffset
+	if err = opts.ValidateFirstOffset(o0, 4); err != nil {
+		return err
 	}
 
 	// Field (0) 'Elems'
@@ -130,7 +245,7 @@ func (l *ListC) UnmarshalSSZ(buf []byte) error {
 		}
 		l.Elems = make([]BytesWrapper, num)
 		for ii := 0; ii < num; ii++ {
-			if err = l.Elems[ii].UnmarshalSSZ(buf[ii*48 : (ii+1)*48]); err != nil {
+			if err = l.Elems[ii].UnmarshalSSZWithOptions(buf[ii*48:(ii+1)*48], opts); err != nil {
 				return err
 			}
 		}
@@ -148,6 +263,16 @@ func (l *ListC) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the ListC object
+func (l *ListC) MinSSZSize() uint64 {
+	return 4
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the ListC object
+func (l *ListC) MaxSSZSize() uint64 {
+	return 1540
+}
+
 // HashTreeRoot ssz hashes the ListC object
 func (l *ListC) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(l)
@@ -177,11 +302,81 @@ func (l *ListC) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded ListC object without decoding it
+func (l *ListC) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(l, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded ListC object with a hasher
+func (l *ListC) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Elems'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = ssz.ValidateFirstOffset(o0, 4); err != nil {
+		return err
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Elems'
+	{
+		buf := tail[o0:]
+		{
+			subIndx := hh.Index()
+			num, err := ssz.DivideInt2(len(buf), 48, 32)
+			if err != nil {
+				return err
+			}
+			for ii := 0; ii < num; ii++ {
+				if err = (*BytesWrapper)(nil).HashTreeRootSSZWith(hh, buf[ii*48:(ii+1)*48]); err != nil {
+					return err
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, uint64(num), 32)
+		}
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the ListC object
 func (l *ListC) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(l)
 }
 
+const (
+	// ListCElemsGIndex is the generalized index of the 'Elems' field
+	ListCElemsGIndex ssz.GIndex = 1
+)
+
+// ProveElems returns a merkle proof of the 'Elems' field of the ListC object
+func (l *ListC) ProveElems() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(l, ListCElemsGIndex)
+}
+
+// VerifyListCElems verifies a merkle proof of the 'Elems' field of a ListC object
+func VerifyListCElems(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ListCElemsGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the ListC object
+func (l *ListC) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Elems", Schema: ssz.SchemaList((*BytesWrapper)(nil).SchemaSSZ(), 32)},
+	)
+}
+
 // MarshalSSZ ssz marshals the ListP object
 func (l *ListP) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(l)
@@ -204,6 +399,37 @@ func (l *ListP) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		if dst, err = l.Elems[ii].MarshalSSZTo(dst); err != nil {
 			return
 		}
+
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the ListP object to a writer
+func (l *ListP) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(l, writer)
+}
+
+// MarshalSSZToStream ssz marshals the ListP object to a target array that is flushed to a stream writer
+func (l *ListP) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
+
+	// Offset (0) 'Elems'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'Elems'
+	if size := len(l.Elems); size > 32 {
+		err = ssz.ErrListTooBigFn("ListP.Elems", size, 32)
+		return
+	}
+	for ii := 0; ii < len(l.Elems); ii++ {
+		if dst, err = l.Elems[ii].MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
 	}
 
 	return
@@ -211,6 +437,11 @@ func (l *ListP) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the ListP object
 func (l *ListP) UnmarshalSSZ(buf []byte) error {
+	return l.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the ListP object with the decoding options
+func (l *ListP) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size < 4 {
@@ -225,8 +456,8 @@ func (l *ListP) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 4 {
-		return ssz.ErrInvalidVariableOffset
+	if err = opts.ValidateFirstOffset(o0, 4); err != nil {
+		return err
 	}
 
 	// Field (0) 'Elems'
@@ -241,7 +472,7 @@ func (l *ListP) UnmarshalSSZ(buf []byte) error {
 			if l.Elems[ii] == nil {
 				l.Elems[ii] = new(BytesWrapper)
 			}
-			if err = l.Elems[ii].UnmarshalSSZ(buf[ii*48 : (ii+1)*48]); err != nil {
+			if err = l.Elems[ii].UnmarshalSSZWithOptions(buf[ii*48:(ii+1)*48], opts); err != nil {
 				return err
 			}
 		}
@@ -259,6 +490,16 @@ func (l *ListP) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the ListP object
+func (l *ListP) MinSSZSize() uint64 {
+	return 4
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the ListP object
+func (l *ListP) MaxSSZSize() uint64 {
+	return 1540
+}
+
 // HashTreeRoot ssz hashes the ListP object
 func (l *ListP) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(l)
@@ -288,7 +529,77 @@ func (l *ListP) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded ListP object without decoding it
+func (l *ListP) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(l, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded ListP object with a hasher
+func (l *ListP) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Elems'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = ssz.ValidateFirstOffset(o0, 4); err != nil {
+		return err
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Elems'
+	{
+		buf := tail[o0:]
+		{
+			subIndx := hh.Index()
+			num, err := ssz.DivideInt2(len(buf), 48, 32)
+			if err != nil {
+				return err
+			}
+			for ii := 0; ii < num; ii++ {
+				if err = (*BytesWrapper)(nil).HashTreeRootSSZWith(hh, buf[ii*48:(ii+1)*48]); err != nil {
+					return err
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, uint64(num), 32)
+		}
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the ListP object
 func (l *ListP) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(l)
 }
+
+const (
+	// ListPElemsGIndex is the generalized index of the 'Elems' field
+	ListPElemsGIndex ssz.GIndex = 1
+)
+
+// ProveElems returns a merkle proof of the 'Elems' field of the ListP object
+func (l *ListP) ProveElems() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(l, ListPElemsGIndex)
+}
+
+// VerifyListPElems verifies a merkle proof of the 'Elems' field of a ListP object
+func VerifyListPElems(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, ListPElemsGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the ListP object
+func (l *ListP) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Elems", Schema: ssz.SchemaList((*BytesWrapper)(nil).SchemaSSZ(), 32)},
+	)
+}
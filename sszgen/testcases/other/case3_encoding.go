@@ -4,6 +4,8 @@
 package other
 
 import (
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -19,8 +21,25 @@ func (c *Case3B) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the Case3B object to a writer
+func (c *Case3B) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(c, writer)
+}
+
+// MarshalSSZToStream ssz marshals the Case3B object to a target array that is flushed to a stream writer
+func (c *Case3B) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the Case3B object
 func (c *Case3B) UnmarshalSSZ(buf []byte) error {
+	return c.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Case3B object with the decoding options
+func (c *Case3B) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 0 {
@@ -36,6 +55,16 @@ func (c *Case3B) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Case3B object
+func (c *Case3B) MinSSZSize() uint64 {
+	return 0
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Case3B object
+func (c *Case3B) MaxSSZSize() uint64 {
+	return 0
+}
+
 // HashTreeRoot ssz hashes the Case3B object
 func (c *Case3B) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(c)
@@ -49,7 +78,30 @@ func (c *Case3B) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded Case3B object without decoding it
+func (c *Case3B) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(c, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded Case3B object with a hasher
+func (c *Case3B) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 0 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the Case3B object
 func (c *Case3B) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(c)
 }
+
+// SchemaSSZ returns the schema of the tree of the Case3B object
+func (c *Case3B) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer()
+}
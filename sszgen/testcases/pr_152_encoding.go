@@ -4,6 +4,8 @@
 package testcases
 
 import (
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -27,6 +29,35 @@ func (p *PR1512) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	}
 	for ii := 0; ii < len(p.D); ii++ {
 		dst = append(dst, p.D[ii][:]...)
+
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the PR1512 object to a writer
+func (p *PR1512) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(p, writer)
+}
+
+// MarshalSSZToStream ssz marshals the PR1512 object to a target array that is flushed to a stream writer
+func (p *PR1512) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
+
+	// Offset (0) 'D'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (0) 'D'
+	if size := len(p.D); size > 32 {
+		err = ssz.ErrListTooBigFn("PR1512.D", size, 32)
+		return
+	}
+	for ii := 0; ii < len(p.D); ii++ {
+		dst = append(dst, p.D[ii][:]...)
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
 	}
 
 	return
@@ -34,6 +65,11 @@ func (p *PR1512) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 
 // UnmarshalSSZ ssz unmarshals the PR1512 object
 func (p *PR1512) UnmarshalSSZ(buf []byte) error {
+	return p.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the PR1512 object with the decoding options
+func (p *PR1512) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size < 4 {
@@ -48,8 +84,8 @@ func (p *PR1512) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 4 {
-		return ssz.ErrInvalidVariableOffset
+	if err = opts.ValidateFirstOffset(o0, 4); err != nil {
+		return err
 	}
 
 	// Field (0) 'D'
@@ -77,6 +113,16 @@ func (p *PR1512) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the PR1512 object
+func (p *PR1512) MinSSZSize() uint64 {
+	return 4
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the PR1512 object
+func (p *PR1512) MaxSSZSize() uint64 {
+	return 1540
+}
+
 // HashTreeRoot ssz hashes the PR1512 object
 func (p *PR1512) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(p)
@@ -104,7 +150,75 @@ func (p *PR1512) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded PR1512 object without decoding it
+func (p *PR1512) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(p, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded PR1512 object with a hasher
+func (p *PR1512) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'D'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = ssz.ValidateFirstOffset(o0, 4); err != nil {
+		return err
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'D'
+	{
+		buf := tail[o0:]
+		{
+			subIndx := hh.Index()
+			num, err := ssz.DivideInt2(len(buf), 48, 32)
+			if err != nil {
+				return err
+			}
+			for ii := 0; ii < len(buf); ii += 48 {
+				hh.PutBytes(buf[ii : ii+48])
+			}
+			hh.MerkleizeWithMixin(subIndx, uint64(num), 32)
+		}
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the PR1512 object
 func (p *PR1512) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(p)
 }
+
+const (
+	// PR1512DGIndex is the generalized index of the 'D' field
+	PR1512DGIndex ssz.GIndex = 1
+)
+
+// ProveD returns a merkle proof of the 'D' field of the PR1512 object
+func (p *PR1512) ProveD() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(p, PR1512DGIndex)
+}
+
+// VerifyPR1512D verifies a merkle proof of the 'D' field of a PR1512 object
+func VerifyPR1512D(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, PR1512DGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the PR1512 object
+func (p *PR1512) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "D", Schema: ssz.SchemaList(ssz.SchemaBytes(48), 32)},
+	)
+}
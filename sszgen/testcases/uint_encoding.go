@@ -4,6 +4,8 @@
 package testcases
 
 import (
+	"io"
+
 	ssz "github.com/ferranbt/fastssz"
 )
 
@@ -31,8 +33,37 @@ func (u *Uints) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	return
 }
 
+// MarshalSSZToWriter ssz marshals the Uints object to a writer
+func (u *Uints) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(u, writer)
+}
+
+// MarshalSSZToStream ssz marshals the Uints object to a target array that is flushed to a stream writer
+func (u *Uints) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Uint8'
+	dst = ssz.MarshalUint8(dst, uint8(u.Uint8))
+
+	// Field (1) 'Uint16'
+	dst = ssz.MarshalUint16(dst, uint16(u.Uint16))
+
+	// Field (2) 'Uint32'
+	dst = ssz.MarshalUint32(dst, uint32(u.Uint32))
+
+	// Field (3) 'Uint64'
+	dst = ssz.MarshalUint64(dst, uint64(u.Uint64))
+
+	return
+}
+
 // UnmarshalSSZ ssz unmarshals the Uints object
 func (u *Uints) UnmarshalSSZ(buf []byte) error {
+	return u.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the Uints object with the decoding options
+func (u *Uints) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
 	var err error
 	size := uint64(len(buf))
 	if size != 15 {
@@ -60,6 +91,16 @@ func (u *Uints) SizeSSZ() (size int) {
 	return
 }
 
+// MinSSZSize returns the minimum ssz encoded size in bytes for the Uints object
+func (u *Uints) MinSSZSize() uint64 {
+	return 15
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the Uints object
+func (u *Uints) MaxSSZSize() uint64 {
+	return 15
+}
+
 // HashTreeRoot ssz hashes the Uints object
 func (u *Uints) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(u)
@@ -85,7 +126,98 @@ func (u *Uints) HashTreeRootWith(hh ssz.HashWalker) (err error) {
 	return
 }
 
+// HashTreeRootSSZ ssz hashes the encoded Uints object without decoding it
+func (u *Uints) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(u, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded Uints object with a hasher
+func (u *Uints) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 15 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Uint8'
+	hh.PutUint8(ssz.UnmarshallUint8(buf[0:1]))
+
+	// Field (1) 'Uint16'
+	hh.PutUint16(ssz.UnmarshallUint16(buf[1:3]))
+
+	// Field (2) 'Uint32'
+	hh.PutUint32(ssz.UnmarshallUint32(buf[3:7]))
+
+	// Field (3) 'Uint64'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[7:15]))
+
+	hh.Merkleize(indx)
+	return
+}
+
 // GetTree ssz hashes the Uints object
 func (u *Uints) GetTree() (*ssz.Node, error) {
 	return ssz.ProofTree(u)
 }
+
+const (
+	// UintsUint8GIndex is the generalized index of the 'Uint8' field
+	UintsUint8GIndex ssz.GIndex = 4
+	// UintsUint16GIndex is the generalized index of the 'Uint16' field
+	UintsUint16GIndex ssz.GIndex = 5
+	// UintsUint32GIndex is the generalized index of the 'Uint32' field
+	UintsUint32GIndex ssz.GIndex = 6
+	// UintsUint64GIndex is the generalized index of the 'Uint64' field
+	UintsUint64GIndex ssz.GIndex = 7
+)
+
+// ProveUint8 returns a merkle proof of the 'Uint8' field of the Uints object
+func (u *Uints) ProveUint8() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(u, UintsUint8GIndex)
+}
+
+// VerifyUintsUint8 verifies a merkle proof of the 'Uint8' field of a Uints object
+func VerifyUintsUint8(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, UintsUint8GIndex)
+}
+
+// ProveUint16 returns a merkle proof of the 'Uint16' field of the Uints object
+func (u *Uints) ProveUint16() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(u, UintsUint16GIndex)
+}
+
+// VerifyUintsUint16 verifies a merkle proof of the 'Uint16' field of a Uints object
+func VerifyUintsUint16(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, UintsUint16GIndex)
+}
+
+// ProveUint32 returns a merkle proof of the 'Uint32' field of the Uints object
+func (u *Uints) ProveUint32() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(u, UintsUint32GIndex)
+}
+
+// VerifyUintsUint32 verifies a merkle proof of the 'Uint32' field of a Uints object
+func VerifyUintsUint32(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, UintsUint32GIndex)
+}
+
+// ProveUint64 returns a merkle proof of the 'Uint64' field of the Uints object
+func (u *Uints) ProveUint64() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(u, UintsUint64GIndex)
+}
+
+// VerifyUintsUint64 verifies a merkle proof of the 'Uint64' field of a Uints object
+func VerifyUintsUint64(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, UintsUint64GIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the Uints object
+func (u *Uints) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Uint8", Schema: ssz.SchemaUint(1)},
+		&ssz.SchemaField{Name: "Uint16", Schema: ssz.SchemaUint(2)},
+		&ssz.SchemaField{Name: "Uint32", Schema: ssz.SchemaUint(4)},
+		&ssz.SchemaField{Name: "Uint64", Schema: ssz.SchemaUint(8)},
+	)
+}
@@ -0,0 +1,39 @@
+package testcases
+
+//go:generate go run ../main.go --path upgrade.go --upgrade StateV1:StateV2
+
+type UpgradeCheckpoint struct {
+	Epoch uint64
+	Root  [32]byte `ssz-size:"32"`
+}
+
+type HeaderV1 struct {
+	Number uint64
+}
+
+type HeaderV2 struct {
+	Number      uint64
+	Withdrawals [32]byte `ssz-size:"32"`
+}
+
+type StateV1 struct {
+	Slot       uint64
+	Root       []byte   `ssz-size:"32"`
+	Roots      [][]byte `ssz-size:"4,32"`
+	Checkpoint *UpgradeCheckpoint
+	Balances   []uint64             `ssz-max:"16"`
+	History    []*UpgradeCheckpoint `ssz-max:"8"`
+	Header     *HeaderV1
+}
+
+type StateV2 struct {
+	Slot       uint64
+	Root       [32]byte    `ssz-size:"32"`
+	Roots      [4][32]byte `ssz-size:"4,32"`
+	Checkpoint *UpgradeCheckpoint
+	Balances   []uint64             `ssz-max:"16"`
+	History    []*UpgradeCheckpoint `ssz-max:"8"`
+	Header     *HeaderV2
+	NextIndex  uint64
+	Summaries  []*UpgradeCheckpoint `ssz-max:"8"`
+}
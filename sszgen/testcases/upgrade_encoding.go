@@ -0,0 +1,1739 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: d297eb5307a971d9eb9a0c636520f4ff70854cb35ad1b93b4a03cf1721934866
+// Version: 0.1.3
+package testcases
+
+import (
+	"io"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// MarshalSSZ ssz marshals the UpgradeCheckpoint object
+func (u *UpgradeCheckpoint) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(u)
+}
+
+// MarshalSSZTo ssz marshals the UpgradeCheckpoint object to a target array
+func (u *UpgradeCheckpoint) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Epoch'
+	dst = ssz.MarshalUint64(dst, u.Epoch)
+
+	// Field (1) 'Root'
+	dst = append(dst, u.Root[:]...)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the UpgradeCheckpoint object to a writer
+func (u *UpgradeCheckpoint) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(u, writer)
+}
+
+// MarshalSSZToStream ssz marshals the UpgradeCheckpoint object to a target array that is flushed to a stream writer
+func (u *UpgradeCheckpoint) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Epoch'
+	dst = ssz.MarshalUint64(dst, u.Epoch)
+
+	// Field (1) 'Root'
+	dst = append(dst, u.Root[:]...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the UpgradeCheckpoint object
+func (u *UpgradeCheckpoint) UnmarshalSSZ(buf []byte) error {
+	return u.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the UpgradeCheckpoint object with the decoding options
+func (u *UpgradeCheckpoint) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 40 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Epoch'
+	u.Epoch = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Root'
+	copy(u.Root[:], buf[8:40])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the UpgradeCheckpoint object
+func (u *UpgradeCheckpoint) SizeSSZ() (size int) {
+	size = 40
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the UpgradeCheckpoint object
+func (u *UpgradeCheckpoint) MinSSZSize() uint64 {
+	return 40
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the UpgradeCheckpoint object
+func (u *UpgradeCheckpoint) MaxSSZSize() uint64 {
+	return 40
+}
+
+// HashTreeRoot ssz hashes the UpgradeCheckpoint object
+func (u *UpgradeCheckpoint) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(u)
+}
+
+// HashTreeRootWith ssz hashes the UpgradeCheckpoint object with a hasher
+func (u *UpgradeCheckpoint) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Epoch'
+	hh.PutUint64(u.Epoch)
+
+	// Field (1) 'Root'
+	hh.PutBytes(u.Root[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded UpgradeCheckpoint object without decoding it
+func (u *UpgradeCheckpoint) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(u, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded UpgradeCheckpoint object with a hasher
+func (u *UpgradeCheckpoint) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 40 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Epoch'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'Root'
+	hh.PutBytes(buf[8:40])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the UpgradeCheckpoint object
+func (u *UpgradeCheckpoint) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(u)
+}
+
+const (
+	// UpgradeCheckpointEpochGIndex is the generalized index of the 'Epoch' field
+	UpgradeCheckpointEpochGIndex ssz.GIndex = 2
+	// UpgradeCheckpointRootGIndex is the generalized index of the 'Root' field
+	UpgradeCheckpointRootGIndex ssz.GIndex = 3
+)
+
+// ProveEpoch returns a merkle proof of the 'Epoch' field of the UpgradeCheckpoint object
+func (u *UpgradeCheckpoint) ProveEpoch() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(u, UpgradeCheckpointEpochGIndex)
+}
+
+// VerifyUpgradeCheckpointEpoch verifies a merkle proof of the 'Epoch' field of a UpgradeCheckpoint object
+func VerifyUpgradeCheckpointEpoch(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, UpgradeCheckpointEpochGIndex)
+}
+
+// ProveRoot returns a merkle proof of the 'Root' field of the UpgradeCheckpoint object
+func (u *UpgradeCheckpoint) ProveRoot() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(u, UpgradeCheckpointRootGIndex)
+}
+
+// VerifyUpgradeCheckpointRoot verifies a merkle proof of the 'Root' field of a UpgradeCheckpoint object
+func VerifyUpgradeCheckpointRoot(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, UpgradeCheckpointRootGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the UpgradeCheckpoint object
+func (u *UpgradeCheckpoint) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Epoch", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "Root", Schema: ssz.SchemaBytes(32)},
+	)
+}
+
+// MarshalSSZ ssz marshals the HeaderV1 object
+func (h *HeaderV1) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(h)
+}
+
+// MarshalSSZTo ssz marshals the HeaderV1 object to a target array
+func (h *HeaderV1) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Number'
+	dst = ssz.MarshalUint64(dst, h.Number)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the HeaderV1 object to a writer
+func (h *HeaderV1) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(h, writer)
+}
+
+// MarshalSSZToStream ssz marshals the HeaderV1 object to a target array that is flushed to a stream writer
+func (h *HeaderV1) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Number'
+	dst = ssz.MarshalUint64(dst, h.Number)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the HeaderV1 object
+func (h *HeaderV1) UnmarshalSSZ(buf []byte) error {
+	return h.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the HeaderV1 object with the decoding options
+func (h *HeaderV1) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 8 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Number'
+	h.Number = ssz.UnmarshallUint64(buf[0:8])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the HeaderV1 object
+func (h *HeaderV1) SizeSSZ() (size int) {
+	size = 8
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the HeaderV1 object
+func (h *HeaderV1) MinSSZSize() uint64 {
+	return 8
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the HeaderV1 object
+func (h *HeaderV1) MaxSSZSize() uint64 {
+	return 8
+}
+
+// HashTreeRoot ssz hashes the HeaderV1 object
+func (h *HeaderV1) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(h)
+}
+
+// HashTreeRootWith ssz hashes the HeaderV1 object with a hasher
+func (h *HeaderV1) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Number'
+	hh.PutUint64(h.Number)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded HeaderV1 object without decoding it
+func (h *HeaderV1) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(h, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded HeaderV1 object with a hasher
+func (h *HeaderV1) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 8 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Number'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the HeaderV1 object
+func (h *HeaderV1) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(h)
+}
+
+const (
+	// HeaderV1NumberGIndex is the generalized index of the 'Number' field
+	HeaderV1NumberGIndex ssz.GIndex = 1
+)
+
+// ProveNumber returns a merkle proof of the 'Number' field of the HeaderV1 object
+func (h *HeaderV1) ProveNumber() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(h, HeaderV1NumberGIndex)
+}
+
+// VerifyHeaderV1Number verifies a merkle proof of the 'Number' field of a HeaderV1 object
+func VerifyHeaderV1Number(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, HeaderV1NumberGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the HeaderV1 object
+func (h *HeaderV1) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Number", Schema: ssz.SchemaUint(8)},
+	)
+}
+
+// MarshalSSZ ssz marshals the HeaderV2 object
+func (h *HeaderV2) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(h)
+}
+
+// MarshalSSZTo ssz marshals the HeaderV2 object to a target array
+func (h *HeaderV2) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Number'
+	dst = ssz.MarshalUint64(dst, h.Number)
+
+	// Field (1) 'Withdrawals'
+	dst = append(dst, h.Withdrawals[:]...)
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the HeaderV2 object to a writer
+func (h *HeaderV2) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(h, writer)
+}
+
+// MarshalSSZToStream ssz marshals the HeaderV2 object to a target array that is flushed to a stream writer
+func (h *HeaderV2) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Number'
+	dst = ssz.MarshalUint64(dst, h.Number)
+
+	// Field (1) 'Withdrawals'
+	dst = append(dst, h.Withdrawals[:]...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the HeaderV2 object
+func (h *HeaderV2) UnmarshalSSZ(buf []byte) error {
+	return h.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the HeaderV2 object with the decoding options
+func (h *HeaderV2) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 40 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Number'
+	h.Number = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Withdrawals'
+	copy(h.Withdrawals[:], buf[8:40])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the HeaderV2 object
+func (h *HeaderV2) SizeSSZ() (size int) {
+	size = 40
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the HeaderV2 object
+func (h *HeaderV2) MinSSZSize() uint64 {
+	return 40
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the HeaderV2 object
+func (h *HeaderV2) MaxSSZSize() uint64 {
+	return 40
+}
+
+// HashTreeRoot ssz hashes the HeaderV2 object
+func (h *HeaderV2) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(h)
+}
+
+// HashTreeRootWith ssz hashes the HeaderV2 object with a hasher
+func (h *HeaderV2) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Number'
+	hh.PutUint64(h.Number)
+
+	// Field (1) 'Withdrawals'
+	hh.PutBytes(h.Withdrawals[:])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded HeaderV2 object without decoding it
+func (h *HeaderV2) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(h, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded HeaderV2 object with a hasher
+func (h *HeaderV2) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size != 40 {
+		return ssz.ErrSize
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Number'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'Withdrawals'
+	hh.PutBytes(buf[8:40])
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the HeaderV2 object
+func (h *HeaderV2) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(h)
+}
+
+const (
+	// HeaderV2NumberGIndex is the generalized index of the 'Number' field
+	HeaderV2NumberGIndex ssz.GIndex = 2
+	// HeaderV2WithdrawalsGIndex is the generalized index of the 'Withdrawals' field
+	HeaderV2WithdrawalsGIndex ssz.GIndex = 3
+)
+
+// ProveNumber returns a merkle proof of the 'Number' field of the HeaderV2 object
+func (h *HeaderV2) ProveNumber() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(h, HeaderV2NumberGIndex)
+}
+
+// VerifyHeaderV2Number verifies a merkle proof of the 'Number' field of a HeaderV2 object
+func VerifyHeaderV2Number(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, HeaderV2NumberGIndex)
+}
+
+// ProveWithdrawals returns a merkle proof of the 'Withdrawals' field of the HeaderV2 object
+func (h *HeaderV2) ProveWithdrawals() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(h, HeaderV2WithdrawalsGIndex)
+}
+
+// VerifyHeaderV2Withdrawals verifies a merkle proof of the 'Withdrawals' field of a HeaderV2 object
+func VerifyHeaderV2Withdrawals(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, HeaderV2WithdrawalsGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the HeaderV2 object
+func (h *HeaderV2) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Number", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "Withdrawals", Schema: ssz.SchemaBytes(32)},
+	)
+}
+
+// MarshalSSZ ssz marshals the StateV1 object
+func (s *StateV1) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the StateV1 object to a target array
+func (s *StateV1) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(224)
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, s.Slot)
+
+	// Field (1) 'Root'
+	if size := len(s.Root); size != 32 {
+		err = ssz.ErrBytesLengthFn("StateV1.Root", size, 32)
+		return
+	}
+	dst = append(dst, s.Root...)
+
+	// Field (2) 'Roots'
+	if size := len(s.Roots); size != 4 {
+		err = ssz.ErrVectorLengthFn("StateV1.Roots", size, 4)
+		return
+	}
+	for ii := 0; ii < 4; ii++ {
+		if size := len(s.Roots[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("StateV1.Roots[ii]", size, 32)
+			return
+		}
+		dst = append(dst, s.Roots[ii]...)
+
+	}
+
+	// Field (3) 'Checkpoint'
+	if s.Checkpoint == nil {
+		s.Checkpoint = new(UpgradeCheckpoint)
+	}
+	if dst, err = s.Checkpoint.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Offset (4) 'Balances'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(s.Balances) * 8
+
+	// Offset (5) 'History'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (6) 'Header'
+	if s.Header == nil {
+		s.Header = new(HeaderV1)
+	}
+	if dst, err = s.Header.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (4) 'Balances'
+	if size := len(s.Balances); size > 16 {
+		err = ssz.ErrListTooBigFn("StateV1.Balances", size, 16)
+		return
+	}
+	for ii := 0; ii < len(s.Balances); ii++ {
+		dst = ssz.MarshalUint64(dst, s.Balances[ii])
+
+	}
+
+	// Field (5) 'History'
+	if size := len(s.History); size > 8 {
+		err = ssz.ErrListTooBigFn("StateV1.History", size, 8)
+		return
+	}
+	for ii := 0; ii < len(s.History); ii++ {
+		if dst, err = s.History[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the StateV1 object to a writer
+func (s *StateV1) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(s, writer)
+}
+
+// MarshalSSZToStream ssz marshals the StateV1 object to a target array that is flushed to a stream writer
+func (s *StateV1) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(224)
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, s.Slot)
+
+	// Field (1) 'Root'
+	if size := len(s.Root); size != 32 {
+		err = ssz.ErrBytesLengthFn("StateV1.Root", size, 32)
+		return
+	}
+	dst = append(dst, s.Root...)
+
+	// Field (2) 'Roots'
+	if size := len(s.Roots); size != 4 {
+		err = ssz.ErrVectorLengthFn("StateV1.Roots", size, 4)
+		return
+	}
+	for ii := 0; ii < 4; ii++ {
+		if size := len(s.Roots[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("StateV1.Roots[ii]", size, 32)
+			return
+		}
+		dst = append(dst, s.Roots[ii]...)
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (3) 'Checkpoint'
+	if s.Checkpoint == nil {
+		s.Checkpoint = new(UpgradeCheckpoint)
+	}
+	if dst, err = s.Checkpoint.MarshalSSZToStream(dst, sw); err != nil {
+		return
+	}
+
+	// Offset (4) 'Balances'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(s.Balances) * 8
+
+	// Offset (5) 'History'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (6) 'Header'
+	if s.Header == nil {
+		s.Header = new(HeaderV1)
+	}
+	if dst, err = s.Header.MarshalSSZToStream(dst, sw); err != nil {
+		return
+	}
+
+	// Field (4) 'Balances'
+	if size := len(s.Balances); size > 16 {
+		err = ssz.ErrListTooBigFn("StateV1.Balances", size, 16)
+		return
+	}
+	for ii := 0; ii < len(s.Balances); ii++ {
+		dst = ssz.MarshalUint64(dst, s.Balances[ii])
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (5) 'History'
+	if size := len(s.History); size > 8 {
+		err = ssz.ErrListTooBigFn("StateV1.History", size, 8)
+		return
+	}
+	for ii := 0; ii < len(s.History); ii++ {
+		if dst, err = s.History[ii].MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the StateV1 object
+func (s *StateV1) UnmarshalSSZ(buf []byte) error {
+	return s.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the StateV1 object with the decoding options
+func (s *StateV1) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 224 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o4, o5 uint64
+
+	// Field (0) 'Slot'
+	s.Slot = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Root'
+	if cap(s.Root) == 0 {
+		s.Root = make([]byte, 0, len(buf[8:40]))
+	}
+	s.Root = append(s.Root, buf[8:40]...)
+
+	// Field (2) 'Roots'
+	s.Roots = make([][]byte, 4)
+	for ii := 0; ii < 4; ii++ {
+		if cap(s.Roots[ii]) == 0 {
+			s.Roots[ii] = make([]byte, 0, len(buf[40:168][ii*32:(ii+1)*32]))
+		}
+		s.Roots[ii] = append(s.Roots[ii], buf[40:168][ii*32:(ii+1)*32]...)
+	}
+
+	// Field (3) 'Checkpoint'
+	if s.Checkpoint == nil {
+		s.Checkpoint = new(UpgradeCheckpoint)
+	}
+	if err = s.Checkpoint.UnmarshalSSZWithOptions(buf[168:208], opts); err != nil {
+		return err
+	}
+
+	// Offset (4) 'Balances'
+	if o4 = ssz.ReadOffset(buf[208:212]); o4 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = opts.ValidateFirstOffset(o4, 224); err != nil {
+		return err
+	}
+
+	// Offset (5) 'History'
+	if o5 = ssz.ReadOffset(buf[212:216]); o5 > size || o4 > o5 {
+		return ssz.ErrOffset
+	}
+
+	// Field (6) 'Header'
+	if s.Header == nil {
+		s.Header = new(HeaderV1)
+	}
+	if err = s.Header.UnmarshalSSZWithOptions(buf[216:224], opts); err != nil {
+		return err
+	}
+
+	// Field (4) 'Balances'
+	{
+		buf = tail[o4:o5]
+		num, err := ssz.DivideInt2(len(buf), 8, 16)
+		if err != nil {
+			return err
+		}
+		s.Balances = ssz.ExtendUint64(s.Balances, num)
+		for ii := 0; ii < num; ii++ {
+			s.Balances[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	// Field (5) 'History'
+	{
+		buf = tail[o5:]
+		num, err := ssz.DivideInt2(len(buf), 40, 8)
+		if err != nil {
+			return err
+		}
+		s.History = make([]*UpgradeCheckpoint, num)
+		for ii := 0; ii < num; ii++ {
+			if s.History[ii] == nil {
+				s.History[ii] = new(UpgradeCheckpoint)
+			}
+			if err = s.History[ii].UnmarshalSSZWithOptions(buf[ii*40:(ii+1)*40], opts); err != nil {
+				return err
+			}
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the StateV1 object
+func (s *StateV1) SizeSSZ() (size int) {
+	size = 224
+
+	// Field (4) 'Balances'
+	size += len(s.Balances) * 8
+
+	// Field (5) 'History'
+	size += len(s.History) * 40
+
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the StateV1 object
+func (s *StateV1) MinSSZSize() uint64 {
+	return 224
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the StateV1 object
+func (s *StateV1) MaxSSZSize() uint64 {
+	return 672
+}
+
+// HashTreeRoot ssz hashes the StateV1 object
+func (s *StateV1) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the StateV1 object with a hasher
+func (s *StateV1) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(s.Slot)
+
+	// Field (1) 'Root'
+	if size := len(s.Root); size != 32 {
+		err = ssz.ErrBytesLengthFn("StateV1.Root", size, 32)
+		return
+	}
+	hh.PutBytes(s.Root)
+
+	// Field (2) 'Roots'
+	{
+		if size := len(s.Roots); size != 4 {
+			err = ssz.ErrVectorLengthFn("StateV1.Roots", size, 4)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range s.Roots {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (3) 'Checkpoint'
+	if s.Checkpoint == nil {
+		s.Checkpoint = new(UpgradeCheckpoint)
+	}
+	if err = s.Checkpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (4) 'Balances'
+	{
+		if size := len(s.Balances); size > 16 {
+			err = ssz.ErrListTooBigFn("StateV1.Balances", size, 16)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range s.Balances {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+		numItems := uint64(len(s.Balances))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(16, numItems, 8))
+	}
+
+	// Field (5) 'History'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(s.History))
+		if num > 8 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range s.History {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 8)
+	}
+
+	// Field (6) 'Header'
+	if s.Header == nil {
+		s.Header = new(HeaderV1)
+	}
+	if err = s.Header.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded StateV1 object without decoding it
+func (s *StateV1) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(s, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded StateV1 object with a hasher
+func (s *StateV1) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 224 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o4, o5 uint64
+
+	// Offset (4) 'Balances'
+	if o4 = ssz.ReadOffset(buf[208:212]); o4 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = ssz.ValidateFirstOffset(o4, 224); err != nil {
+		return err
+	}
+
+	// Offset (5) 'History'
+	if o5 = ssz.ReadOffset(buf[212:216]); o5 > size || o4 > o5 {
+		return ssz.ErrOffset
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'Root'
+	hh.PutBytes(buf[8:40])
+
+	// Field (2) 'Roots'
+	{
+		subIndx := hh.Index()
+		hh.Append(buf[40:168])
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (3) 'Checkpoint'
+	if err = (*UpgradeCheckpoint)(nil).HashTreeRootSSZWith(hh, buf[168:208]); err != nil {
+		return err
+	}
+
+	// Field (4) 'Balances'
+	{
+		buf := tail[o4:o5]
+		{
+			subIndx := hh.Index()
+			num, err := ssz.DivideInt2(len(buf), 8, 16)
+			if err != nil {
+				return err
+			}
+			hh.Append(buf)
+			hh.FillUpTo32()
+			hh.MerkleizeWithMixin(subIndx, uint64(num), ssz.CalculateLimit(16, uint64(num), 8))
+		}
+	}
+
+	// Field (5) 'History'
+	{
+		buf := tail[o5:]
+		{
+			subIndx := hh.Index()
+			num, err := ssz.DivideInt2(len(buf), 40, 8)
+			if err != nil {
+				return err
+			}
+			for ii := 0; ii < num; ii++ {
+				if err = (*UpgradeCheckpoint)(nil).HashTreeRootSSZWith(hh, buf[ii*40:(ii+1)*40]); err != nil {
+					return err
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, uint64(num), 8)
+		}
+	}
+
+	// Field (6) 'Header'
+	if err = (*HeaderV1)(nil).HashTreeRootSSZWith(hh, buf[216:224]); err != nil {
+		return err
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the StateV1 object
+func (s *StateV1) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+const (
+	// StateV1SlotGIndex is the generalized index of the 'Slot' field
+	StateV1SlotGIndex ssz.GIndex = 8
+	// StateV1RootGIndex is the generalized index of the 'Root' field
+	StateV1RootGIndex ssz.GIndex = 9
+	// StateV1RootsGIndex is the generalized index of the 'Roots' field
+	StateV1RootsGIndex ssz.GIndex = 10
+	// StateV1CheckpointGIndex is the generalized index of the 'Checkpoint' field
+	StateV1CheckpointGIndex ssz.GIndex = 11
+	// StateV1BalancesGIndex is the generalized index of the 'Balances' field
+	StateV1BalancesGIndex ssz.GIndex = 12
+	// StateV1HistoryGIndex is the generalized index of the 'History' field
+	StateV1HistoryGIndex ssz.GIndex = 13
+	// StateV1HeaderGIndex is the generalized index of the 'Header' field
+	StateV1HeaderGIndex ssz.GIndex = 14
+)
+
+// ProveSlot returns a merkle proof of the 'Slot' field of the StateV1 object
+func (s *StateV1) ProveSlot() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(s, StateV1SlotGIndex)
+}
+
+// VerifyStateV1Slot verifies a merkle proof of the 'Slot' field of a StateV1 object
+func VerifyStateV1Slot(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, StateV1SlotGIndex)
+}
+
+// ProveRoot returns a merkle proof of the 'Root' field of the StateV1 object
+func (s *StateV1) ProveRoot() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(s, StateV1RootGIndex)
+}
+
+// VerifyStateV1Root verifies a merkle proof of the 'Root' field of a StateV1 object
+func VerifyStateV1Root(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, StateV1RootGIndex)
+}
+
+// ProveRoots returns a merkle proof of the 'Roots' field of the StateV1 object
+func (s *StateV1) ProveRoots() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(s, StateV1RootsGIndex)
+}
+
+// VerifyStateV1Roots verifies a merkle proof of the 'Roots' field of a StateV1 object
+func VerifyStateV1Roots(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, StateV1RootsGIndex)
+}
+
+// ProveCheckpoint returns a merkle proof of the 'Checkpoint' field of the StateV1 object
+func (s *StateV1) ProveCheckpoint() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(s, StateV1CheckpointGIndex)
+}
+
+// VerifyStateV1Checkpoint verifies a merkle proof of the 'Checkpoint' field of a StateV1 object
+func VerifyStateV1Checkpoint(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, StateV1CheckpointGIndex)
+}
+
+// ProveBalances returns a merkle proof of the 'Balances' field of the StateV1 object
+func (s *StateV1) ProveBalances() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(s, StateV1BalancesGIndex)
+}
+
+// VerifyStateV1Balances verifies a merkle proof of the 'Balances' field of a StateV1 object
+func VerifyStateV1Balances(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, StateV1BalancesGIndex)
+}
+
+// ProveHistory returns a merkle proof of the 'History' field of the StateV1 object
+func (s *StateV1) ProveHistory() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(s, StateV1HistoryGIndex)
+}
+
+// VerifyStateV1History verifies a merkle proof of the 'History' field of a StateV1 object
+func VerifyStateV1History(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, StateV1HistoryGIndex)
+}
+
+// ProveHeader returns a merkle proof of the 'Header' field of the StateV1 object
+func (s *StateV1) ProveHeader() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(s, StateV1HeaderGIndex)
+}
+
+// VerifyStateV1Header verifies a merkle proof of the 'Header' field of a StateV1 object
+func VerifyStateV1Header(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, StateV1HeaderGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the StateV1 object
+func (s *StateV1) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Slot", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "Root", Schema: ssz.SchemaBytes(32)},
+		&ssz.SchemaField{Name: "Roots", Schema: ssz.SchemaVector(ssz.SchemaBytes(32), 4)},
+		&ssz.SchemaField{Name: "Checkpoint", Schema: (*UpgradeCheckpoint)(nil).SchemaSSZ()},
+		&ssz.SchemaField{Name: "Balances", Schema: ssz.SchemaList(ssz.SchemaUint(8), 16)},
+		&ssz.SchemaField{Name: "History", Schema: ssz.SchemaList((*UpgradeCheckpoint)(nil).SchemaSSZ(), 8)},
+		&ssz.SchemaField{Name: "Header", Schema: (*HeaderV1)(nil).SchemaSSZ()},
+	)
+}
+
+// MarshalSSZ ssz marshals the StateV2 object
+func (s *StateV2) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the StateV2 object to a target array
+func (s *StateV2) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(268)
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, s.Slot)
+
+	// Field (1) 'Root'
+	dst = append(dst, s.Root[:]...)
+
+	// Field (2) 'Roots'
+	for ii := 0; ii < 4; ii++ {
+		dst = append(dst, s.Roots[ii][:]...)
+
+	}
+
+	// Field (3) 'Checkpoint'
+	if s.Checkpoint == nil {
+		s.Checkpoint = new(UpgradeCheckpoint)
+	}
+	if dst, err = s.Checkpoint.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Offset (4) 'Balances'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(s.Balances) * 8
+
+	// Offset (5) 'History'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(s.History) * 40
+
+	// Field (6) 'Header'
+	if s.Header == nil {
+		s.Header = new(HeaderV2)
+	}
+	if dst, err = s.Header.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (7) 'NextIndex'
+	dst = ssz.MarshalUint64(dst, s.NextIndex)
+
+	// Offset (8) 'Summaries'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (4) 'Balances'
+	if size := len(s.Balances); size > 16 {
+		err = ssz.ErrListTooBigFn("StateV2.Balances", size, 16)
+		return
+	}
+	for ii := 0; ii < len(s.Balances); ii++ {
+		dst = ssz.MarshalUint64(dst, s.Balances[ii])
+
+	}
+
+	// Field (5) 'History'
+	if size := len(s.History); size > 8 {
+		err = ssz.ErrListTooBigFn("StateV2.History", size, 8)
+		return
+	}
+	for ii := 0; ii < len(s.History); ii++ {
+		if dst, err = s.History[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+	}
+
+	// Field (8) 'Summaries'
+	if size := len(s.Summaries); size > 8 {
+		err = ssz.ErrListTooBigFn("StateV2.Summaries", size, 8)
+		return
+	}
+	for ii := 0; ii < len(s.Summaries); ii++ {
+		if dst, err = s.Summaries[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+
+	}
+
+	return
+}
+
+// MarshalSSZToWriter ssz marshals the StateV2 object to a writer
+func (s *StateV2) MarshalSSZToWriter(writer io.Writer) (int, error) {
+	return ssz.MarshalSSZToWriter(s, writer)
+}
+
+// MarshalSSZToStream ssz marshals the StateV2 object to a target array that is flushed to a stream writer
+func (s *StateV2) MarshalSSZToStream(buf []byte, sw *ssz.StreamWriter) (dst []byte, err error) {
+	dst = buf
+	offset := int(268)
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, s.Slot)
+
+	// Field (1) 'Root'
+	dst = append(dst, s.Root[:]...)
+
+	// Field (2) 'Roots'
+	for ii := 0; ii < 4; ii++ {
+		dst = append(dst, s.Roots[ii][:]...)
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (3) 'Checkpoint'
+	if s.Checkpoint == nil {
+		s.Checkpoint = new(UpgradeCheckpoint)
+	}
+	if dst, err = s.Checkpoint.MarshalSSZToStream(dst, sw); err != nil {
+		return
+	}
+
+	// Offset (4) 'Balances'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(s.Balances) * 8
+
+	// Offset (5) 'History'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(s.History) * 40
+
+	// Field (6) 'Header'
+	if s.Header == nil {
+		s.Header = new(HeaderV2)
+	}
+	if dst, err = s.Header.MarshalSSZToStream(dst, sw); err != nil {
+		return
+	}
+
+	// Field (7) 'NextIndex'
+	dst = ssz.MarshalUint64(dst, s.NextIndex)
+
+	// Offset (8) 'Summaries'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (4) 'Balances'
+	if size := len(s.Balances); size > 16 {
+		err = ssz.ErrListTooBigFn("StateV2.Balances", size, 16)
+		return
+	}
+	for ii := 0; ii < len(s.Balances); ii++ {
+		dst = ssz.MarshalUint64(dst, s.Balances[ii])
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (5) 'History'
+	if size := len(s.History); size > 8 {
+		err = ssz.ErrListTooBigFn("StateV2.History", size, 8)
+		return
+	}
+	for ii := 0; ii < len(s.History); ii++ {
+		if dst, err = s.History[ii].MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (8) 'Summaries'
+	if size := len(s.Summaries); size > 8 {
+		err = ssz.ErrListTooBigFn("StateV2.Summaries", size, 8)
+		return
+	}
+	for ii := 0; ii < len(s.Summaries); ii++ {
+		if dst, err = s.Summaries[ii].MarshalSSZToStream(dst, sw); err != nil {
+			return
+		}
+		if dst, err = sw.Flush(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the StateV2 object
+func (s *StateV2) UnmarshalSSZ(buf []byte) error {
+	return s.UnmarshalSSZWithOptions(buf, ssz.UnmarshalOptions{})
+}
+
+// UnmarshalSSZWithOptions ssz unmarshals the StateV2 object with the decoding options
+func (s *StateV2) UnmarshalSSZWithOptions(buf []byte, opts ssz.UnmarshalOptions) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 268 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o4, o5, o8 uint64
+
+	// Field (0) 'Slot'
+	s.Slot = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Root'
+	copy(s.Root[:], buf[8:40])
+
+	// Field (2) 'Roots'
+
+	for ii := 0; ii < 4; ii++ {
+		copy(s.Roots[ii][:], buf[40:168][ii*32:(ii+1)*32])
+	}
+
+	// Field (3) 'Checkpoint'
+	if s.Checkpoint == nil {
+		s.Checkpoint = new(UpgradeCheckpoint)
+	}
+	if err = s.Checkpoint.UnmarshalSSZWithOptions(buf[168:208], opts); err != nil {
+		return err
+	}
+
+	// Offset (4) 'Balances'
+	if o4 = ssz.ReadOffset(buf[208:212]); o4 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = opts.ValidateFirstOffset(o4, 268); err != nil {
+		return err
+	}
+
+	// Offset (5) 'History'
+	if o5 = ssz.ReadOffset(buf[212:216]); o5 > size || o4 > o5 {
+		return ssz.ErrOffset
+	}
+
+	// Field (6) 'Header'
+	if s.Header == nil {
+		s.Header = new(HeaderV2)
+	}
+	if err = s.Header.UnmarshalSSZWithOptions(buf[216:256], opts); err != nil {
+		return err
+	}
+
+	// Field (7) 'NextIndex'
+	s.NextIndex = ssz.UnmarshallUint64(buf[256:264])
+
+	// Offset (8) 'Summaries'
+	if o8 = ssz.ReadOffset(buf[264:268]); o8 > size || o5 > o8 {
+		return ssz.ErrOffset
+	}
+
+	// Field (4) 'Balances'
+	{
+		buf = tail[o4:o5]
+		num, err := ssz.DivideInt2(len(buf), 8, 16)
+		if err != nil {
+			return err
+		}
+		s.Balances = ssz.ExtendUint64(s.Balances, num)
+		for ii := 0; ii < num; ii++ {
+			s.Balances[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+
+	// Field (5) 'History'
+	{
+		buf = tail[o5:o8]
+		num, err := ssz.DivideInt2(len(buf), 40, 8)
+		if err != nil {
+			return err
+		}
+		s.History = make([]*UpgradeCheckpoint, num)
+		for ii := 0; ii < num; ii++ {
+			if s.History[ii] == nil {
+				s.History[ii] = new(UpgradeCheckpoint)
+			}
+			if err = s.History[ii].UnmarshalSSZWithOptions(buf[ii*40:(ii+1)*40], opts); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (8) 'Summaries'
+	{
+		buf = tail[o8:]
+		num, err := ssz.DivideInt2(len(buf), 40, 8)
+		if err != nil {
+			return err
+		}
+		s.Summaries = make([]*UpgradeCheckpoint, num)
+		for ii := 0; ii < num; ii++ {
+			if s.Summaries[ii] == nil {
+				s.Summaries[ii] = new(UpgradeCheckpoint)
+			}
+			if err = s.Summaries[ii].UnmarshalSSZWithOptions(buf[ii*40:(ii+1)*40], opts); err != nil {
+				return err
+			}
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the StateV2 object
+func (s *StateV2) SizeSSZ() (size int) {
+	size = 268
+
+	// Field (4) 'Balances'
+	size += len(s.Balances) * 8
+
+	// Field (5) 'History'
+	size += len(s.History) * 40
+
+	// Field (8) 'Summaries'
+	size += len(s.Summaries) * 40
+
+	return
+}
+
+// MinSSZSize returns the minimum ssz encoded size in bytes for the StateV2 object
+func (s *StateV2) MinSSZSize() uint64 {
+	return 268
+}
+
+// MaxSSZSize returns the maximum ssz encoded size in bytes for the StateV2 object
+func (s *StateV2) MaxSSZSize() uint64 {
+	return 1036
+}
+
+// HashTreeRoot ssz hashes the StateV2 object
+func (s *StateV2) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the StateV2 object with a hasher
+func (s *StateV2) HashTreeRootWith(hh ssz.HashWalker) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(s.Slot)
+
+	// Field (1) 'Root'
+	hh.PutBytes(s.Root[:])
+
+	// Field (2) 'Roots'
+	{
+		subIndx := hh.Index()
+		for _, i := range s.Roots {
+			hh.Append(i[:])
+		}
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (3) 'Checkpoint'
+	if s.Checkpoint == nil {
+		s.Checkpoint = new(UpgradeCheckpoint)
+	}
+	if err = s.Checkpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (4) 'Balances'
+	{
+		if size := len(s.Balances); size > 16 {
+			err = ssz.ErrListTooBigFn("StateV2.Balances", size, 16)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range s.Balances {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+		numItems := uint64(len(s.Balances))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(16, numItems, 8))
+	}
+
+	// Field (5) 'History'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(s.History))
+		if num > 8 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range s.History {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 8)
+	}
+
+	// Field (6) 'Header'
+	if s.Header == nil {
+		s.Header = new(HeaderV2)
+	}
+	if err = s.Header.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (7) 'NextIndex'
+	hh.PutUint64(s.NextIndex)
+
+	// Field (8) 'Summaries'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(s.Summaries))
+		if num > 8 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range s.Summaries {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 8)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// HashTreeRootSSZ ssz hashes the encoded StateV2 object without decoding it
+func (s *StateV2) HashTreeRootSSZ(buf []byte) ([32]byte, error) {
+	return ssz.HashSSZWithDefaultHasher(s, buf)
+}
+
+// HashTreeRootSSZWith ssz hashes the encoded StateV2 object with a hasher
+func (s *StateV2) HashTreeRootSSZWith(hh ssz.HashWalker, buf []byte) (err error) {
+	size := uint64(len(buf))
+	if size < 268 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o4, o5, o8 uint64
+
+	// Offset (4) 'Balances'
+	if o4 = ssz.ReadOffset(buf[208:212]); o4 > size {
+		return ssz.ErrOffset
+	}
+
+	if err = ssz.ValidateFirstOffset(o4, 268); err != nil {
+		return err
+	}
+
+	// Offset (5) 'History'
+	if o5 = ssz.ReadOffset(buf[212:216]); o5 > size || o4 > o5 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (8) 'Summaries'
+	if o8 = ssz.ReadOffset(buf[264:268]); o8 > size || o5 > o8 {
+		return ssz.ErrOffset
+	}
+
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'Root'
+	hh.PutBytes(buf[8:40])
+
+	// Field (2) 'Roots'
+	{
+		subIndx := hh.Index()
+		hh.Append(buf[40:168])
+		hh.Merkleize(subIndx)
+	}
+
+	// Field (3) 'Checkpoint'
+	if err = (*UpgradeCheckpoint)(nil).HashTreeRootSSZWith(hh, buf[168:208]); err != nil {
+		return err
+	}
+
+	// Field (4) 'Balances'
+	{
+		buf := tail[o4:o5]
+		{
+			subIndx := hh.Index()
+			num, err := ssz.DivideInt2(len(buf), 8, 16)
+			if err != nil {
+				return err
+			}
+			hh.Append(buf)
+			hh.FillUpTo32()
+			hh.MerkleizeWithMixin(subIndx, uint64(num), ssz.CalculateLimit(16, uint64(num), 8))
+		}
+	}
+
+	// Field (5) 'History'
+	{
+		buf := tail[o5:o8]
+		{
+			subIndx := hh.Index()
+			num, err := ssz.DivideInt2(len(buf), 40, 8)
+			if err != nil {
+				return err
+			}
+			for ii := 0; ii < num; ii++ {
+				if err = (*UpgradeCheckpoint)(nil).HashTreeRootSSZWith(hh, buf[ii*40:(ii+1)*40]); err != nil {
+					return err
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, uint64(num), 8)
+		}
+	}
+
+	// Field (6) 'Header'
+	if err = (*HeaderV2)(nil).HashTreeRootSSZWith(hh, buf[216:256]); err != nil {
+		return err
+	}
+
+	// Field (7) 'NextIndex'
+	hh.PutUint64(ssz.UnmarshallUint64(buf[256:264]))
+
+	// Field (8) 'Summaries'
+	{
+		buf := tail[o8:]
+		{
+			subIndx := hh.Index()
+			num, err := ssz.DivideInt2(len(buf), 40, 8)
+			if err != nil {
+				return err
+			}
+			for ii := 0; ii < num; ii++ {
+				if err = (*UpgradeCheckpoint)(nil).HashTreeRootSSZWith(hh, buf[ii*40:(ii+1)*40]); err != nil {
+					return err
+				}
+			}
+			hh.MerkleizeWithMixin(subIndx, uint64(num), 8)
+		}
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// GetTree ssz hashes the StateV2 object
+func (s *StateV2) GetTree() (*ssz.Node, error) {
+	return ssz.ProofTree(s)
+}
+
+const (
+	// StateV2SlotGIndex is the generalized index of the 'Slot' field
+	StateV2SlotGIndex ssz.GIndex = 16
+	// StateV2RootGIndex is the generalized index of the 'Root' field
+	StateV2RootGIndex ssz.GIndex = 17
+	// StateV2RootsGIndex is the generalized index of the 'Roots' field
+	StateV2RootsGIndex ssz.GIndex = 18
+	// StateV2CheckpointGIndex is the generalized index of the 'Checkpoint' field
+	StateV2CheckpointGIndex ssz.GIndex = 19
+	// StateV2BalancesGIndex is the generalized index of the 'Balances' field
+	StateV2BalancesGIndex ssz.GIndex = 20
+	// StateV2HistoryGIndex is the generalized index of the 'History' field
+	StateV2HistoryGIndex ssz.GIndex = 21
+	// StateV2HeaderGIndex is the generalized index of the 'Header' field
+	StateV2HeaderGIndex ssz.GIndex = 22
+	// StateV2NextIndexGIndex is the generalized index of the 'NextIndex' field
+	StateV2NextIndexGIndex ssz.GIndex = 23
+	// StateV2SummariesGIndex is the generalized index of the 'Summaries' field
+	StateV2SummariesGIndex ssz.GIndex = 24
+)
+
+// ProveSlot returns a merkle proof of the 'Slot' field of the StateV2 object
+func (s *StateV2) ProveSlot() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(s, StateV2SlotGIndex)
+}
+
+// VerifyStateV2Slot verifies a merkle proof of the 'Slot' field of a StateV2 object
+func VerifyStateV2Slot(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, StateV2SlotGIndex)
+}
+
+// ProveRoot returns a merkle proof of the 'Root' field of the StateV2 object
+func (s *StateV2) ProveRoot() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(s, StateV2RootGIndex)
+}
+
+// VerifyStateV2Root verifies a merkle proof of the 'Root' field of a StateV2 object
+func VerifyStateV2Root(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, StateV2RootGIndex)
+}
+
+// ProveRoots returns a merkle proof of the 'Roots' field of the StateV2 object
+func (s *StateV2) ProveRoots() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(s, StateV2RootsGIndex)
+}
+
+// VerifyStateV2Roots verifies a merkle proof of the 'Roots' field of a StateV2 object
+func VerifyStateV2Roots(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, StateV2RootsGIndex)
+}
+
+// ProveCheckpoint returns a merkle proof of the 'Checkpoint' field of the StateV2 object
+func (s *StateV2) ProveCheckpoint() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(s, StateV2CheckpointGIndex)
+}
+
+// VerifyStateV2Checkpoint verifies a merkle proof of the 'Checkpoint' field of a StateV2 object
+func VerifyStateV2Checkpoint(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, StateV2CheckpointGIndex)
+}
+
+// ProveBalances returns a merkle proof of the 'Balances' field of the StateV2 object
+func (s *StateV2) ProveBalances() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(s, StateV2BalancesGIndex)
+}
+
+// VerifyStateV2Balances verifies a merkle proof of the 'Balances' field of a StateV2 object
+func VerifyStateV2Balances(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, StateV2BalancesGIndex)
+}
+
+// ProveHistory returns a merkle proof of the 'History' field of the StateV2 object
+func (s *StateV2) ProveHistory() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(s, StateV2HistoryGIndex)
+}
+
+// VerifyStateV2History verifies a merkle proof of the 'History' field of a StateV2 object
+func VerifyStateV2History(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, StateV2HistoryGIndex)
+}
+
+// ProveHeader returns a merkle proof of the 'Header' field of the StateV2 object
+func (s *StateV2) ProveHeader() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(s, StateV2HeaderGIndex)
+}
+
+// VerifyStateV2Header verifies a merkle proof of the 'Header' field of a StateV2 object
+func VerifyStateV2Header(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, StateV2HeaderGIndex)
+}
+
+// ProveNextIndex returns a merkle proof of the 'NextIndex' field of the StateV2 object
+func (s *StateV2) ProveNextIndex() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(s, StateV2NextIndexGIndex)
+}
+
+// VerifyStateV2NextIndex verifies a merkle proof of the 'NextIndex' field of a StateV2 object
+func VerifyStateV2NextIndex(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, StateV2NextIndexGIndex)
+}
+
+// ProveSummaries returns a merkle proof of the 'Summaries' field of the StateV2 object
+func (s *StateV2) ProveSummaries() (*ssz.Proof, error) {
+	return ssz.ProveGIndex(s, StateV2SummariesGIndex)
+}
+
+// VerifyStateV2Summaries verifies a merkle proof of the 'Summaries' field of a StateV2 object
+func VerifyStateV2Summaries(root []byte, proof *ssz.Proof) (bool, error) {
+	return ssz.VerifyProofAtGIndex(root, proof, StateV2SummariesGIndex)
+}
+
+// SchemaSSZ returns the schema of the tree of the StateV2 object
+func (s *StateV2) SchemaSSZ() *ssz.Schema {
+	return ssz.SchemaContainer(
+		&ssz.SchemaField{Name: "Slot", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "Root", Schema: ssz.SchemaBytes(32)},
+		&ssz.SchemaField{Name: "Roots", Schema: ssz.SchemaVector(ssz.SchemaBytes(32), 4)},
+		&ssz.SchemaField{Name: "Checkpoint", Schema: (*UpgradeCheckpoint)(nil).SchemaSSZ()},
+		&ssz.SchemaField{Name: "Balances", Schema: ssz.SchemaList(ssz.SchemaUint(8), 16)},
+		&ssz.SchemaField{Name: "History", Schema: ssz.SchemaList((*UpgradeCheckpoint)(nil).SchemaSSZ(), 8)},
+		&ssz.SchemaField{Name: "Header", Schema: (*HeaderV2)(nil).SchemaSSZ()},
+		&ssz.SchemaField{Name: "NextIndex", Schema: ssz.SchemaUint(8)},
+		&ssz.SchemaField{Name: "Summaries", Schema: ssz.SchemaList((*UpgradeCheckpoint)(nil).SchemaSSZ(), 8)},
+	)
+}
+
+// UpgradeStateV1ToStateV2 creates a StateV2 object from a StateV1 object.
+// The fields with the same name and ssz type are copied and the new fields are empty.
+func UpgradeStateV1ToStateV2(src *StateV1) *StateV2 {
+	if src == nil {
+		return nil
+	}
+	dst := new(StateV2)
+	// Field 'Slot'
+	dst.Slot = src.Slot
+
+	// Field 'Root'
+	copy(dst.Root[:], src.Root)
+
+	// Field 'Roots'
+	for ii := 0; ii < len(src.Roots) && ii < len(dst.Roots); ii++ {
+		copy(dst.Roots[ii][:], src.Roots[ii])
+	}
+
+	// Field 'Checkpoint'
+	if src.Checkpoint != nil {
+		dst.Checkpoint = new(UpgradeCheckpoint)
+		dst.Checkpoint.Epoch = src.Checkpoint.Epoch
+		dst.Checkpoint.Root = src.Checkpoint.Root
+	}
+
+	// Field 'Balances'
+	dst.Balances = append(src.Balances[:0:0], src.Balances...)
+
+	// Field 'History'
+	if src.History != nil {
+		dst.History = make([]*UpgradeCheckpoint, len(src.History))
+		for ii := range src.History {
+			if src.History[ii] != nil {
+				dst.History[ii] = new(UpgradeCheckpoint)
+				dst.History[ii].Epoch = src.History[ii].Epoch
+				dst.History[ii].Root = src.History[ii].Root
+			}
+		}
+	}
+	return dst
+}
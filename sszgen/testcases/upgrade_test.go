@@ -0,0 +1,62 @@
+package testcases
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestUpgrade(t *testing.T) {
+	src := &StateV1{
+		Slot:       1,
+		Root:       bytes.Repeat([]byte{1}, 32),
+		Roots:      [][]byte{bytes.Repeat([]byte{2}, 32), bytes.Repeat([]byte{3}, 32)},
+		Checkpoint: &UpgradeCheckpoint{Epoch: 2, Root: [32]byte{4}},
+		Balances:   []uint64{1, 2, 3},
+		History:    []*UpgradeCheckpoint{{Epoch: 3}, nil},
+		Header:     &HeaderV1{Number: 5},
+	}
+	dst := UpgradeStateV1ToStateV2(src)
+
+	// shared fields are copied even if the Go representation is different
+	if dst.Slot != src.Slot {
+		t.Fatal("bad slot")
+	}
+	if !bytes.Equal(dst.Root[:], src.Root) {
+		t.Fatal("bad root")
+	}
+	for i := range src.Roots {
+		if !bytes.Equal(dst.Roots[i][:], src.Roots[i]) {
+			t.Fatal("bad roots")
+		}
+	}
+	if dst.Roots[2] != [32]byte{} || dst.Roots[3] != [32]byte{} {
+		t.Fatal("the missing roots are not empty")
+	}
+	if !reflect.DeepEqual(dst.Checkpoint, src.Checkpoint) {
+		t.Fatal("bad checkpoint")
+	}
+	if !reflect.DeepEqual(dst.Balances, src.Balances) {
+		t.Fatal("bad balances")
+	}
+	if !reflect.DeepEqual(dst.History, src.History) {
+		t.Fatal("bad history")
+	}
+
+	// fields with a different ssz type and new fields are empty
+	if dst.Header != nil || dst.NextIndex != 0 || dst.Summaries != nil {
+		t.Fatal("the new fields are not empty")
+	}
+
+	// the objects do not share memory
+	src.Checkpoint.Epoch++
+	src.Balances[0]++
+	src.History[0].Epoch++
+	if dst.Checkpoint.Epoch == src.Checkpoint.Epoch || dst.Balances[0] == src.Balances[0] || dst.History[0].Epoch == src.History[0].Epoch {
+		t.Fatal("the objects share memory")
+	}
+
+	if UpgradeStateV1ToStateV2(nil) != nil {
+		t.Fatal("expected nil")
+	}
+}
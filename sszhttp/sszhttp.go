@@ -0,0 +1,286 @@
+// Package sszhttp writes and reads the SSZ or JSON bodies of the Beacon API. The content type
+// is negotiated with the Accept header and the fork of the object is set in the
+// Eth-Consensus-Version header.
+package sszhttp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+const (
+	// ContentTypeSSZ is the content type of the SSZ bodies
+	ContentTypeSSZ = "application/octet-stream"
+	// ContentTypeJSON is the content type of the JSON bodies
+	ContentTypeJSON = "application/json"
+	// HeaderConsensusVersion is the header with the fork of the object in the body
+	HeaderConsensusVersion = "Eth-Consensus-Version"
+
+	// DefaultMaxSize is the size limit of the bodies of the objects without static bounds
+	DefaultMaxSize = 64 * 1024 * 1024
+)
+
+var (
+	// ErrUnsupportedMediaType is returned when the body is neither SSZ nor JSON
+	ErrUnsupportedMediaType = errors.New("unsupported media type")
+	// ErrBodyTooBig is returned when the body is bigger than the size limit of the object
+	ErrBodyTooBig = errors.New("body too big")
+	// ErrVersionMismatch is returned when the version of a JSON body is not the fork of the header
+	ErrVersionMismatch = errors.New("version does not match the Eth-Consensus-Version header")
+)
+
+// AcceptsSSZ returns true if the Accept header of the request prefers SSZ over JSON.
+// JSON is used if there is no Accept header or both have the same quality.
+func AcceptsSSZ(r *http.Request) bool {
+	var qSSZ, qJSON float64
+	for _, accept := range r.Header.Values("Accept") {
+		for _, part := range strings.Split(accept, ",") {
+			mediaType, params, err := mime.ParseMediaType(part)
+			if err != nil {
+				continue
+			}
+			q := 1.0
+			if str, ok := params["q"]; ok {
+				if q, err = strconv.ParseFloat(str, 64); err != nil {
+					continue
+				}
+			}
+			switch mediaType {
+			case ContentTypeSSZ:
+				qSSZ = maxFloat(qSSZ, q)
+			case ContentTypeJSON:
+				qJSON = maxFloat(qJSON, q)
+			case "*/*", "application/*":
+				qSSZ, qJSON = maxFloat(qSSZ, q), maxFloat(qJSON, q)
+			}
+		}
+	}
+	return qSSZ > qJSON
+}
+
+func maxFloat(a, b float64) float64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// Write writes the object as SSZ or JSON depending on the Accept header of the
+// request and sets the fork of the object in the Eth-Consensus-Version header
+func Write(w http.ResponseWriter, r *http.Request, obj ssz.Marshaler, fork ssz.Fork) error {
+	if AcceptsSSZ(r) {
+		return WriteSSZ(w, obj, fork)
+	}
+	return WriteJSON(w, obj, fork)
+}
+
+// WriteSSZ writes the SSZ encoding of the object for the given fork
+func WriteSSZ(w http.ResponseWriter, obj ssz.Marshaler, fork ssz.Fork) error {
+	buf, err := marshalFork(obj, fork)
+	if err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", ContentTypeSSZ)
+	w.Header().Set("Content-Length", strconv.Itoa(len(buf)))
+	w.Header().Set(HeaderConsensusVersion, fork.String())
+	_, err = w.Write(buf)
+	return err
+}
+
+// jsonBody is the JSON body of the Beacon API with the fork of the object in 'version'
+type jsonBody struct {
+	Version string          `json:"version"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// WriteJSON writes the JSON encoding of the object in the 'data' field of the body
+// and sets the fork in the 'version' field and in the Eth-Consensus-Version header
+func WriteJSON(w http.ResponseWriter, obj interface{}, fork ssz.Fork) error {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", ContentTypeJSON)
+	w.Header().Set(HeaderConsensusVersion, fork.String())
+	return json.NewEncoder(w).Encode(&jsonBody{Version: fork.String(), Data: data})
+}
+
+func marshalFork(obj ssz.Marshaler, fork ssz.Fork) ([]byte, error) {
+	if f, ok := obj.(ssz.ForkMarshaler); ok {
+		return f.MarshalSSZToFork(make([]byte, 0, f.SizeSSZFork(fork)), fork)
+	}
+	return obj.MarshalSSZ()
+}
+
+// Registry creates the objects of each type and fork to decode the bodies
+type Registry struct {
+	types map[string]map[ssz.Fork]func() ssz.Unmarshaler
+
+	// MaxSize is the size limit of the bodies of the objects without static bounds
+	MaxSize uint64
+}
+
+// NewRegistry creates an empty Registry
+func NewRegistry() *Registry {
+	return &Registry{
+		types:   map[string]map[ssz.Fork]func() ssz.Unmarshaler{},
+		MaxSize: DefaultMaxSize,
+	}
+}
+
+// Register sets the constructor of the object of a type (i.e. 'SignedBeaconBlock') for a fork
+func (r *Registry) Register(name string, fork ssz.Fork, fn func() ssz.Unmarshaler) {
+	if _, ok := r.types[name]; !ok {
+		r.types[name] = map[ssz.Fork]func() ssz.Unmarshaler{}
+	}
+	r.types[name][fork] = fn
+}
+
+// New creates the object of a type for a fork
+func (r *Registry) New(name string, fork ssz.Fork) (ssz.Unmarshaler, error) {
+	fn, ok := r.types[name][fork]
+	if !ok {
+		return nil, fmt.Errorf("type '%s' not registered for fork %s", name, fork)
+	}
+	return fn(), nil
+}
+
+// DecodeRequest decodes the body of the request as the object of the given type
+// and the fork of the Eth-Consensus-Version header
+func (r *Registry) DecodeRequest(req *http.Request, name string) (ssz.Unmarshaler, ssz.Fork, error) {
+	return r.Decode(req.Header, req.Body, name)
+}
+
+// DecodeResponse decodes the body of the response as the object of the given type
+// and the fork of the Eth-Consensus-Version header
+func (r *Registry) DecodeResponse(resp *http.Response, name string) (ssz.Unmarshaler, ssz.Fork, error) {
+	return r.Decode(resp.Header, resp.Body, name)
+}
+
+// Decode decodes a SSZ or JSON body with the given headers. The size of a SSZ body is
+// checked against the static bounds of the object for the fork and it is never bigger
+// than MaxSize, no more bytes than the limit are read. A JSON body has the object in the
+// 'data' field and the 'version' field has to be the fork of the header.
+func (r *Registry) Decode(header http.Header, body io.Reader, name string) (ssz.Unmarshaler, ssz.Fork, error) {
+	version := header.Get(HeaderConsensusVersion)
+	if version == "" {
+		return nil, 0, fmt.Errorf("header '%s' not found", HeaderConsensusVersion)
+	}
+	fork, err := ssz.ForkFromString(version)
+	if err != nil {
+		return nil, 0, err
+	}
+	obj, err := r.New(name, fork)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	contentType := ContentTypeJSON
+	if str := header.Get("Content-Type"); str != "" {
+		if contentType, _, err = mime.ParseMediaType(str); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	switch contentType {
+	case ContentTypeSSZ:
+		buf, err := r.readSSZ(header, body, obj, fork)
+		if err != nil {
+			return nil, 0, err
+		}
+		if f, ok := obj.(ssz.ForkUnmarshaler); ok {
+			err = f.UnmarshalSSZFork(buf, fork)
+		} else {
+			err = obj.UnmarshalSSZ(buf)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+
+	case ContentTypeJSON:
+		var res jsonBody
+		if err := json.NewDecoder(io.LimitReader(body, int64(r.MaxSize))).Decode(&res); err != nil {
+			return nil, 0, err
+		}
+		if res.Version != version {
+			return nil, 0, fmt.Errorf("%w: '%s' and '%s'", ErrVersionMismatch, res.Version, version)
+		}
+		if len(res.Data) == 0 {
+			return nil, 0, fmt.Errorf("field 'data' not found")
+		}
+		if err := json.Unmarshal(res.Data, obj); err != nil {
+			return nil, 0, err
+		}
+
+	default:
+		return nil, 0, fmt.Errorf("%w '%s'", ErrUnsupportedMediaType, contentType)
+	}
+	return obj, fork, nil
+}
+
+// forkBounds are the size bounds of a fork aware object for a given fork
+type forkBounds struct {
+	obj  ssz.ForkSizeBounder
+	fork ssz.Fork
+}
+
+func (f *forkBounds) MinSSZSize() uint64 {
+	return f.obj.MinSSZSizeFork(f.fork)
+}
+
+func (f *forkBounds) MaxSSZSize() uint64 {
+	return f.obj.MaxSSZSizeFork(f.fork)
+}
+
+// sizeBounds returns the size bounds of the object for the fork if it has any
+func sizeBounds(obj ssz.Unmarshaler, fork ssz.Fork) (ssz.SizeBounder, bool) {
+	if f, ok := obj.(ssz.ForkSizeBounder); ok {
+		return &forkBounds{obj: f, fork: fork}, true
+	}
+	if _, ok := obj.(ssz.ForkUnmarshaler); ok {
+		// the bounds of the object are the ones of the latest fork
+		return nil, false
+	}
+	bounds, ok := obj.(ssz.SizeBounder)
+	return bounds, ok
+}
+
+// readSSZ reads a SSZ body up to the maximum size of the object, capped at MaxSize
+func (r *Registry) readSSZ(header http.Header, body io.Reader, obj ssz.Unmarshaler, fork ssz.Fork) ([]byte, error) {
+	maxSize := r.MaxSize
+	bounds, ok := sizeBounds(obj, fork)
+	if ok && bounds.MaxSSZSize() < maxSize {
+		maxSize = bounds.MaxSSZSize()
+	}
+	if str := header.Get("Content-Length"); str != "" {
+		if length, err := strconv.ParseUint(str, 10, 64); err == nil && length > maxSize {
+			return nil, fmt.Errorf("%w: the limit is %d bytes", ErrBodyTooBig, maxSize)
+		}
+	}
+	limit := int64(math.MaxInt64)
+	if maxSize < math.MaxInt64 {
+		limit = int64(maxSize) + 1
+	}
+	buf, err := io.ReadAll(io.LimitReader(body, limit))
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(buf)) > maxSize {
+		return nil, fmt.Errorf("%w: the limit is %d bytes", ErrBodyTooBig, maxSize)
+	}
+	if ok {
+		if err := ssz.CheckSizeBounds(buf, bounds); err != nil {
+			return nil, err
+		}
+	}
+	return buf, nil
+}
@@ -0,0 +1,246 @@
+package sszhttp
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/ferranbt/fastssz/sszgen/testcases"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcceptsSSZ(t *testing.T) {
+	cases := []struct {
+		accept []string
+		ssz    bool
+	}{
+		{nil, false},
+		{[]string{"application/json"}, false},
+		{[]string{"application/octet-stream"}, true},
+		{[]string{"*/*"}, false},
+		{[]string{"application/octet-stream;q=1.0,application/json;q=0.9"}, true},
+		{[]string{"application/octet-stream;q=0.5,application/json"}, false},
+		{[]string{"application/json;q=0.1", "application/octet-stream"}, true},
+		{[]string{"application/octet-stream, */*;q=0.2"}, true},
+	}
+	for _, c := range cases {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		for _, accept := range c.accept {
+			r.Header.Add("Accept", accept)
+		}
+		require.Equal(t, c.ssz, AcceptsSSZ(r), "accept %v", c.accept)
+	}
+}
+
+func testRegistry() *Registry {
+	r := NewRegistry()
+	for _, fork := range []ssz.Fork{ssz.ForkPhase0, ssz.ForkCapella} {
+		r.Register("ForkPayload", fork, func() ssz.Unmarshaler {
+			return new(testcases.ForkPayload)
+		})
+	}
+	r.Register("ForkPayloadFork", ssz.ForkPhase0, func() ssz.Unmarshaler {
+		return new(testcases.ForkPayloadPhase0)
+	})
+	r.Register("ForkPayloadFork", ssz.ForkCapella, func() ssz.Unmarshaler {
+		return new(testcases.ForkPayloadCapella)
+	})
+	return r
+}
+
+func TestWriteAndDecodeResponse(t *testing.T) {
+	payload := &testcases.ForkPayload{A: 1, B: []byte{0x1, 0x2}, C: 3, D: []uint64{4, 5}, E: 6}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fork, err := ssz.ForkFromString(r.URL.Query().Get("fork"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := Write(w, r, payload, fork); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}))
+	defer srv.Close()
+
+	registry := testRegistry()
+	for _, fork := range []ssz.Fork{ssz.ForkPhase0, ssz.ForkCapella} {
+		expected, err := payload.MarshalSSZToFork(nil, fork)
+		require.NoError(t, err)
+
+		for _, accept := range []string{ContentTypeSSZ, ContentTypeJSON} {
+			req, err := http.NewRequest(http.MethodGet, srv.URL+"?fork="+fork.String(), nil)
+			require.NoError(t, err)
+			req.Header.Set("Accept", accept)
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			require.Equal(t, accept, resp.Header.Get("Content-Type"))
+			require.Equal(t, fork.String(), resp.Header.Get(HeaderConsensusVersion))
+
+			body, err := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			require.NoError(t, err)
+			if accept == ContentTypeJSON {
+				// the object is in the envelope with the fork
+				var res struct {
+					Version string
+					Data    *testcases.ForkPayload
+				}
+				require.NoError(t, json.Unmarshal(body, &res))
+				require.Equal(t, fork.String(), res.Version)
+				require.Equal(t, payload.A, res.Data.A)
+			}
+
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+			obj, objFork, err := registry.DecodeResponse(resp, "ForkPayload")
+			require.NoError(t, err)
+			require.Equal(t, fork, objFork)
+
+			found, err := obj.(*testcases.ForkPayload).MarshalSSZToFork(nil, fork)
+			require.NoError(t, err)
+			require.Equal(t, expected, found)
+		}
+	}
+}
+
+func TestDecodeRequest(t *testing.T) {
+	registry := testRegistry()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		obj, fork, err := registry.DecodeRequest(r, "ForkPayloadFork")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		fmt.Fprintf(w, "%s %T", fork, obj)
+	}))
+	defer srv.Close()
+
+	post := func(fork string, contentType string, body []byte) (int, string) {
+		req, err := http.NewRequest(http.MethodPost, srv.URL, bytes.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", contentType)
+		if fork != "" {
+			req.Header.Set(HeaderConsensusVersion, fork)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		var buf bytes.Buffer
+		_, err = buf.ReadFrom(resp.Body)
+		require.NoError(t, err)
+		return resp.StatusCode, buf.String()
+	}
+
+	phase0, err := (&testcases.ForkPayloadPhase0{A: 1, B: []byte{0x1}, E: 2}).MarshalSSZ()
+	require.NoError(t, err)
+	capella, err := (&testcases.ForkPayloadCapella{A: 1, B: []byte{0x1}, C: 2, D: []uint64{3}}).MarshalSSZ()
+	require.NoError(t, err)
+
+	status, body := post("phase0", ContentTypeSSZ, phase0)
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, "phase0 *testcases.ForkPayloadPhase0", body)
+
+	status, body = post("capella", ContentTypeSSZ, capella)
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, "capella *testcases.ForkPayloadCapella", body)
+
+	status, body = post("capella", ContentTypeJSON, []byte(`{"version": "capella", "data": {"A": 1, "B": "AQ==", "C": 2, "D": [3]}}`))
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, "capella *testcases.ForkPayloadCapella", body)
+
+	// the version of the JSON body is not the one of the header
+	status, body = post("capella", ContentTypeJSON, []byte(`{"version": "phase0", "data": {"A": 1, "B": "AQ==", "E": 2}}`))
+	require.Equal(t, http.StatusBadRequest, status)
+	require.Contains(t, body, ErrVersionMismatch.Error())
+
+	// the JSON body without the envelope
+	for _, str := range []string{`{"A": 1, "B": "AQ==", "C": 2, "D": [3]}`, `{"version": "capella"}`} {
+		status, _ = post("capella", ContentTypeJSON, []byte(str))
+		require.Equal(t, http.StatusBadRequest, status)
+	}
+
+	// the phase0 encoding is not a valid capella payload
+	status, _ = post("capella", ContentTypeSSZ, phase0)
+	require.Equal(t, http.StatusBadRequest, status)
+
+	// without fork, unknown fork and a fork that is not registered
+	for _, fork := range []string{"", "unknown", "altair"} {
+		status, _ = post(fork, ContentTypeSSZ, phase0)
+		require.Equal(t, http.StatusBadRequest, status)
+	}
+
+	status, _ = post("phase0", "text/plain", phase0)
+	require.Equal(t, http.StatusBadRequest, status)
+}
+
+func TestDecodeSizeLimit(t *testing.T) {
+	registry := testRegistry()
+
+	header := http.Header{}
+	header.Set(HeaderConsensusVersion, "phase0")
+	header.Set("Content-Type", ContentTypeSSZ)
+
+	obj := new(testcases.ForkPayloadPhase0)
+	body := make([]byte, obj.MaxSSZSize()+1)
+	_, _, err := registry.Decode(header, bytes.NewReader(body), "ForkPayloadFork")
+	require.ErrorIs(t, err, ErrBodyTooBig)
+
+	// the size is checked with the content length before the body is read
+	header.Set("Content-Length", fmt.Sprint(len(body)))
+	_, _, err = registry.Decode(header, bytes.NewReader(nil), "ForkPayloadFork")
+	require.ErrorIs(t, err, ErrBodyTooBig)
+	header.Del("Content-Length")
+
+	// smaller than the minimum size
+	_, _, err = registry.Decode(header, bytes.NewReader(body[:obj.MinSSZSize()-1]), "ForkPayloadFork")
+	require.ErrorIs(t, err, ssz.ErrSize)
+}
+
+func TestDecodeSizeLimitFork(t *testing.T) {
+	registry := testRegistry()
+
+	header := http.Header{}
+	header.Set(HeaderConsensusVersion, "phase0")
+	header.Set("Content-Type", ContentTypeSSZ)
+
+	// the bounds of the fork aware object are the ones of the fork in the header
+	obj := new(testcases.ForkPayload)
+	body := make([]byte, obj.MaxSSZSizeFork(ssz.ForkPhase0)+1)
+	require.Less(t, uint64(len(body)), obj.MaxSSZSize())
+
+	_, _, err := registry.Decode(header, bytes.NewReader(body), "ForkPayload")
+	require.ErrorIs(t, err, ErrBodyTooBig)
+
+	_, _, err = registry.Decode(header, bytes.NewReader(body[:obj.MinSSZSizeFork(ssz.ForkPhase0)-1]), "ForkPayload")
+	require.ErrorIs(t, err, ssz.ErrSize)
+}
+
+func TestDecodeMaxSize(t *testing.T) {
+	registry := testRegistry()
+
+	header := http.Header{}
+	header.Set(HeaderConsensusVersion, "phase0")
+	header.Set("Content-Type", ContentTypeSSZ)
+
+	phase0, err := (&testcases.ForkPayloadPhase0{A: 1, B: []byte{0x1, 0x2}, E: 2}).MarshalSSZ()
+	require.NoError(t, err)
+
+	// MaxSize caps the static bounds of the objects
+	registry.MaxSize = uint64(len(phase0)) - 1
+	require.Less(t, registry.MaxSize, new(testcases.ForkPayloadPhase0).MaxSSZSize())
+
+	_, _, err = registry.Decode(header, bytes.NewReader(phase0), "ForkPayloadFork")
+	require.ErrorIs(t, err, ErrBodyTooBig)
+
+	registry.MaxSize = uint64(len(phase0))
+	_, _, err = registry.Decode(header, bytes.NewReader(phase0), "ForkPayloadFork")
+	require.NoError(t, err)
+}
@@ -0,0 +1,468 @@
+package ssz
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"sync"
+)
+
+var (
+	// ErrNodeNotFound means that the node is not in the store
+	ErrNodeNotFound = fmt.Errorf("node not found")
+)
+
+// NodeStoreBackend is the key-value storage used by the NodeStore to persist the nodes.
+// Get returns ErrNodeNotFound if the key does not exists.
+type NodeStoreBackend interface {
+	Get(key []byte) ([]byte, error)
+	Put(key, value []byte) error
+}
+
+const (
+	nodeKindLeaf byte = iota
+	nodeKindEmpty
+	nodeKindBranch
+)
+
+// branchRecordSize is the size of a branch node in the backend:
+// the kind and the hash of both children.
+const branchRecordSize = 2 * (1 + 32)
+
+// NodeStore is a content-addressed store of nodes. The nodes are identified by their
+// kind (leaf, empty or branch) and their hash, so two trees that share a subtree
+// (i.e. two consecutive states) also share the nodes of the subtree in memory (hash-consing).
+// The branch nodes can be persisted in a backend and loaded again by their root. The nodes
+// loaded from the backend are expanded lazily when the tree is traversed (i.e. for proofs).
+// The nodes are kept in memory until they are pruned (see Prune and Compact).
+type NodeStore struct {
+	lock    sync.Mutex
+	nodes   map[string]*Node
+	backend NodeStoreBackend
+
+	// persisted is the set of branch nodes already written in the backend
+	persisted map[string]struct{}
+
+	// hashing is the hash function of the nodes loaded from the backend
+	hashing *Hashing
+}
+
+// NewNodeStore creates a new NodeStore. The backend is optional,
+// without a backend the nodes are only stored in memory.
+func NewNodeStore(backend NodeStoreBackend) *NodeStore {
+	return NewNodeStoreWithHashing(backend, DefaultHashing)
+}
+
+// NewNodeStoreWithHashing creates a new NodeStore for trees with the given Hashing
+func NewNodeStoreWithHashing(backend NodeStoreBackend, hh *Hashing) *NodeStore {
+	return &NodeStore{
+		nodes:     map[string]*Node{},
+		backend:   backend,
+		persisted: map[string]struct{}{},
+		hashing:   hh,
+	}
+}
+
+// ProofTreeWithStore returns the tree of a HashRoot object with
+// the subtrees deduplicated in the given store.
+func ProofTreeWithStore(v HashRoot, s *NodeStore) (*Node, error) {
+	w := &Wrapper{store: s, hashing: s.hashing}
+	if err := v.HashTreeRootWith(w); err != nil {
+		return nil, err
+	}
+	return s.Add(w.Node()), nil
+}
+
+// Len returns the number of nodes in memory
+func (s *NodeStore) Len() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return len(s.nodes)
+}
+
+// Add adds a tree to the store and returns the equivalent tree in which the
+// subtrees already in the store are replaced with the stored ones.
+func (s *NodeStore) Add(n *Node) *Node {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.add(n)
+}
+
+func (s *NodeStore) add(n *Node) *Node {
+	kind := n.kind()
+	key := nodeKey(kind, hashNode(n))
+	if found, ok := s.nodes[key]; ok {
+		return found
+	}
+
+	if kind == nodeKindBranch && n.store == nil {
+		// add the children first, the nodes are not modified and
+		// a new node is created if any of the children changes.
+		left, right := s.add(n.left), s.add(n.right)
+		if left != n.left || right != n.right {
+			n = newNodeWithLRAndHashing(left, right, n.hashing)
+		}
+	}
+	s.nodes[key] = n
+	return n
+}
+
+// Persist writes the branch nodes of the tree in the backend
+func (s *NodeStore) Persist(root *Node) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.backend == nil {
+		return fmt.Errorf("node store does not have a backend")
+	}
+	return s.persist(root)
+}
+
+func (s *NodeStore) persist(n *Node) error {
+	if n.kind() != nodeKindBranch || n.store != nil {
+		// only branch nodes are persisted and the
+		// lazy nodes are already in the backend
+		return nil
+	}
+	hash := hashNode(n)
+	if _, ok := s.persisted[string(hash)]; ok {
+		return nil
+	}
+
+	if err := s.persist(n.left); err != nil {
+		return err
+	}
+	if err := s.persist(n.right); err != nil {
+		return err
+	}
+
+	record := make([]byte, 0, branchRecordSize)
+	for _, child := range []*Node{n.left, n.right} {
+		childHash := hashNode(child)
+		if len(childHash) != 32 {
+			return fmt.Errorf("cannot persist a node of %d bytes", len(childHash))
+		}
+		record = append(record, child.kind())
+		record = append(record, childHash...)
+	}
+	if err := s.backend.Put(hash, record); err != nil {
+		return err
+	}
+	s.persisted[string(hash)] = struct{}{}
+	return nil
+}
+
+// Load returns the tree with the given root. If the tree is not in memory, it is
+// loaded from the backend and its nodes are expanded the first time they are used.
+func (s *NodeStore) Load(root []byte) (*Node, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if n, ok := s.nodes[nodeKey(nodeKindBranch, root)]; ok {
+		return n, nil
+	}
+	if s.backend == nil {
+		return nil, ErrNodeNotFound
+	}
+	if _, err := s.backend.Get(root); err != nil {
+		return nil, err
+	}
+	return s.lazyNode(root), nil
+}
+
+// lazyNode returns a branch node with the given hash that loads
+// its children from the backend
+func (s *NodeStore) lazyNode(hash []byte) *Node {
+	key := nodeKey(nodeKindBranch, hash)
+	if n, ok := s.nodes[key]; ok {
+		return n
+	}
+	n := &Node{value: append([]byte{}, hash...), store: s, hashing: s.hashing}
+	s.nodes[key] = n
+	s.persisted[string(hash)] = struct{}{}
+	return n
+}
+
+// loadChildren sets the children of a branch node loaded from the backend. The
+// children are set with the lock held since Prune walks the expanded nodes.
+func (s *NodeStore) loadChildren(n *Node) error {
+	s.lock.Lock()
+	backend := s.backend
+	s.lock.Unlock()
+
+	record, err := backend.Get(n.value)
+	if err != nil {
+		return err
+	}
+	if len(record) != branchRecordSize {
+		return fmt.Errorf("incorrect node record size %d", len(record))
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	children := [2]*Node{}
+	for i := range children {
+		kind, childHash := record[i*33], record[i*33+1:(i+1)*33]
+
+		switch kind {
+		case nodeKindLeaf:
+			children[i] = s.add(NewNodeWithValue(append([]byte{}, childHash...)))
+		case nodeKindEmpty:
+			children[i] = s.add(newEmptyNodeWithHashing(append([]byte{}, childHash...), s.hashing))
+		case nodeKindBranch:
+			children[i] = s.lazyNode(childHash)
+		default:
+			return fmt.Errorf("unknown node kind %d", kind)
+		}
+	}
+	n.left, n.right = children[0], children[1]
+	return nil
+}
+
+// Prune removes from memory the nodes that are not reachable from the given roots
+// (i.e. the trees of the old states) and returns the number of removed nodes. The
+// memory of the removed nodes is reclaimed once the trees that use them are not
+// referenced anymore. The nodes of the roots that are not loaded yet are not loaded.
+func (s *NodeStore) Prune(roots ...*Node) int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.prune(roots)
+}
+
+func (s *NodeStore) prune(roots []*Node) int {
+	reachable := map[string]struct{}{}
+	for _, root := range roots {
+		mark(root, reachable)
+	}
+
+	removed := 0
+	for key := range s.nodes {
+		if _, ok := reachable[key]; !ok {
+			delete(s.nodes, key)
+			removed++
+		}
+	}
+	for hash := range s.persisted {
+		// the pruned nodes are written again in the backend if they are persisted
+		if _, ok := reachable[nodeKey(nodeKindBranch, []byte(hash))]; !ok {
+			delete(s.persisted, hash)
+		}
+	}
+	return removed
+}
+
+// mark adds the keys of the nodes of the tree to the reachable set
+func mark(n *Node, reachable map[string]struct{}) {
+	kind := n.kind()
+	key := nodeKey(kind, hashNode(n))
+	if _, ok := reachable[key]; ok {
+		return
+	}
+	reachable[key] = struct{}{}
+
+	// the children of the nodes of the backend are nil until they are loaded
+	if kind == nodeKindBranch && n.left != nil {
+		mark(n.left, reachable)
+		mark(n.right, reachable)
+	}
+}
+
+// Compact copies the persisted trees of the given roots to a new backend and uses it as
+// the backend of the store, the nodes in memory of the other trees are pruned. Since
+// the FileBackend is append-only, the nodes of the old trees are dropped by compacting
+// the store into a new file. The other trees of the store cannot be used afterwards.
+func (s *NodeStore) Compact(dst NodeStoreBackend, roots ...[]byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.backend == nil {
+		return fmt.Errorf("node store does not have a backend")
+	}
+
+	copied := map[string]struct{}{}
+	for _, root := range roots {
+		if err := s.copyNode(dst, root, copied); err != nil {
+			return err
+		}
+	}
+
+	nodes := []*Node{}
+	for _, root := range roots {
+		if n, ok := s.nodes[nodeKey(nodeKindBranch, root)]; ok {
+			nodes = append(nodes, n)
+		}
+	}
+	s.prune(nodes)
+
+	s.backend = dst
+	s.persisted = copied
+	return nil
+}
+
+// copyNode copies the record of a branch node and its branch children to the backend
+func (s *NodeStore) copyNode(dst NodeStoreBackend, hash []byte, copied map[string]struct{}) error {
+	if _, ok := copied[string(hash)]; ok {
+		return nil
+	}
+	record, err := s.backend.Get(hash)
+	if err != nil {
+		return err
+	}
+	if len(record) != branchRecordSize {
+		return fmt.Errorf("incorrect node record size %d", len(record))
+	}
+	if err := dst.Put(hash, record); err != nil {
+		return err
+	}
+	copied[string(hash)] = struct{}{}
+
+	for i := 0; i < 2; i++ {
+		if record[i*33] == nodeKindBranch {
+			if err := s.copyNode(dst, record[i*33+1:(i+1)*33], copied); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func nodeKey(kind byte, hash []byte) string {
+	return string(kind) + string(hash)
+}
+
+// MemoryBackend is a NodeStoreBackend that keeps the nodes in memory
+type MemoryBackend struct {
+	lock sync.Mutex
+	kv   map[string][]byte
+}
+
+// NewMemoryBackend creates a new MemoryBackend
+func NewMemoryBackend() *MemoryBackend {
+	return &MemoryBackend{kv: map[string][]byte{}}
+}
+
+// Get implements the NodeStoreBackend interface
+func (m *MemoryBackend) Get(key []byte) ([]byte, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	val, ok := m.kv[string(key)]
+	if !ok {
+		return nil, ErrNodeNotFound
+	}
+	return val, nil
+}
+
+// Put implements the NodeStoreBackend interface
+func (m *MemoryBackend) Put(key, value []byte) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.kv[string(key)] = append([]byte{}, value...)
+	return nil
+}
+
+// FileBackend is a NodeStoreBackend that appends the entries to a file. Each entry
+// is encoded as the size of the key and the value (4 bytes each, little endian)
+// followed by the key and the value. The position of the values is indexed in memory
+// when the file is opened.
+type FileBackend struct {
+	lock  sync.Mutex
+	file  *os.File
+	size  int64
+	index map[string]fileEntry
+}
+
+type fileEntry struct {
+	offset int64
+	size   uint32
+}
+
+// OpenFileBackend opens (or creates) a FileBackend in the given path
+func OpenFileBackend(path string) (*FileBackend, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	f := &FileBackend{
+		file:  file,
+		index: map[string]fileEntry{},
+	}
+	if err := f.readIndex(); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *FileBackend) readIndex() error {
+	stat, err := f.file.Stat()
+	if err != nil {
+		return err
+	}
+
+	header := make([]byte, 8)
+	for f.size < stat.Size() {
+		if _, err := f.file.ReadAt(header, f.size); err != nil {
+			return fmt.Errorf("failed to read entry at offset %d: %v", f.size, err)
+		}
+		keySize := binary.LittleEndian.Uint32(header[0:4])
+		valSize := binary.LittleEndian.Uint32(header[4:8])
+
+		end := f.size + 8 + int64(keySize) + int64(valSize)
+		if end > stat.Size() {
+			return fmt.Errorf("truncated entry at offset %d", f.size)
+		}
+		key := make([]byte, keySize)
+		if _, err := f.file.ReadAt(key, f.size+8); err != nil {
+			return fmt.Errorf("failed to read entry at offset %d: %v", f.size, err)
+		}
+		f.index[string(key)] = fileEntry{offset: f.size + 8 + int64(keySize), size: valSize}
+		f.size = end
+	}
+	return nil
+}
+
+// Get implements the NodeStoreBackend interface
+func (f *FileBackend) Get(key []byte) ([]byte, error) {
+	f.lock.Lock()
+	entry, ok := f.index[string(key)]
+	f.lock.Unlock()
+
+	if !ok {
+		return nil, ErrNodeNotFound
+	}
+	val := make([]byte, entry.size)
+	if _, err := f.file.ReadAt(val, entry.offset); err != nil {
+		return nil, err
+	}
+	return val, nil
+}
+
+// Put implements the NodeStoreBackend interface
+func (f *FileBackend) Put(key, value []byte) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	buf := make([]byte, 8, 8+len(key)+len(value))
+	binary.LittleEndian.PutUint32(buf[0:4], uint32(len(key)))
+	binary.LittleEndian.PutUint32(buf[4:8], uint32(len(value)))
+	buf = append(buf, key...)
+	buf = append(buf, value...)
+
+	if _, err := f.file.WriteAt(buf, f.size); err != nil {
+		return err
+	}
+	f.index[string(key)] = fileEntry{offset: f.size + 8 + int64(len(key)), size: uint32(len(value))}
+	f.size += int64(len(buf))
+	return nil
+}
+
+// Close closes the file
+func (f *FileBackend) Close() error {
+	return f.file.Close()
+}
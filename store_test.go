@@ -0,0 +1,245 @@
+package ssz
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testStoreTree(t *testing.T, num int) *Node {
+	leaves := []*Node{}
+	for i := 0; i < num; i++ {
+		leaves = append(leaves, LeafFromUint64(uint64(i)))
+	}
+	r, err := TreeFromNodesWithMixin(leaves, len(leaves), 128)
+	require.NoError(t, err)
+	return r
+}
+
+func TestNodeStoreDedup(t *testing.T) {
+	s := NewNodeStore(nil)
+
+	r1 := s.Add(testStoreTree(t, 100))
+	num := s.Len()
+
+	// the same tree is not added twice
+	require.Same(t, r1, s.Add(testStoreTree(t, 100)))
+	require.Equal(t, num, s.Len())
+
+	// only the nodes in the path are added
+	r2, err := r1.SetLeaf(256, LeafFromUint64(1000).Hash())
+	require.NoError(t, err)
+	r2 = s.Add(r2)
+	require.Equal(t, num+getPathLength(256)+1, s.Len())
+
+	require.Same(t, r1.left.right, r2.left.right)
+}
+
+func TestNodeStorePersist(t *testing.T) {
+	backend := NewMemoryBackend()
+
+	r := testStoreTree(t, 100)
+	require.NoError(t, NewNodeStore(backend).Persist(r))
+
+	s := NewNodeStore(backend)
+	r2, err := s.Load(r.Hash())
+	require.NoError(t, err)
+	require.Equal(t, r.Hash(), r2.Hash())
+
+	// the tree is not expanded until it is used
+	require.Equal(t, 1, s.Len())
+
+	proof, err := r.Prove(300)
+	require.NoError(t, err)
+	proof2, err := r2.Prove(300)
+	require.NoError(t, err)
+	require.Equal(t, proof, proof2)
+
+	// update the loaded tree and persist the changes
+	r3, err := r2.SetLeaf(300, LeafFromUint64(1000).Hash())
+	require.NoError(t, err)
+	require.NoError(t, s.Persist(r3))
+
+	r4, err := NewNodeStore(backend).Load(r3.Hash())
+	require.NoError(t, err)
+	leaf, err := r4.Get(300)
+	require.NoError(t, err)
+	require.Equal(t, LeafFromUint64(1000).Hash(), leaf.Hash())
+
+	_, err = s.Load(make([]byte, 32))
+	require.ErrorIs(t, err, ErrNodeNotFound)
+}
+
+func TestNodeStoreFileBackend(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nodes")
+
+	backend, err := OpenFileBackend(path)
+	require.NoError(t, err)
+
+	r := testStoreTree(t, 100)
+	require.NoError(t, NewNodeStore(backend).Persist(r))
+	require.NoError(t, backend.Close())
+
+	backend, err = OpenFileBackend(path)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	r2, err := NewNodeStore(backend).Load(r.Hash())
+	require.NoError(t, err)
+
+	proof, err := r.ProveMulti([]GIndex{300, 301, 3})
+	require.NoError(t, err)
+	proof2, err := r2.ProveMulti([]GIndex{300, 301, 3})
+	require.NoError(t, err)
+	require.Equal(t, proof, proof2)
+}
+
+func TestNodeStoreFileBackendTruncated(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nodes")
+
+	backend, err := OpenFileBackend(path)
+	require.NoError(t, err)
+	require.NoError(t, backend.Put([]byte{0x1}, []byte{0x2, 0x3}))
+	require.NoError(t, backend.Close())
+
+	stat, err := os.Stat(path)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(path, stat.Size()-1))
+
+	_, err = OpenFileBackend(path)
+	require.Error(t, err)
+}
+
+func TestNodeStorePrune(t *testing.T) {
+	s := NewNodeStore(nil)
+
+	r1 := s.Add(testStoreTree(t, 100))
+	r2 := s.Add(testSetLeaf(t, r1, 256, LeafFromUint64(1000).Hash()))
+
+	// only the nodes in the path of the old tree are removed
+	require.Equal(t, getPathLength(256)+1, s.Prune(r2))
+
+	s2 := NewNodeStore(nil)
+	s2.Add(r2)
+	require.Equal(t, s2.Len(), s.Len())
+
+	// the tree is still deduplicated
+	require.Same(t, r2, s.Add(testSetLeaf(t, testStoreTree(t, 100), 256, LeafFromUint64(1000).Hash())))
+
+	require.Equal(t, s2.Len(), s.Prune())
+	require.Equal(t, 0, s.Len())
+}
+
+func testSetLeaf(t *testing.T, n *Node, i GIndex, leaf []byte) *Node {
+	r, err := n.SetLeaf(i, leaf)
+	require.NoError(t, err)
+	return r
+}
+
+func TestNodeStorePruneReclaimsMemory(t *testing.T) {
+	s := NewNodeStore(nil)
+
+	released := make(chan struct{})
+	func() {
+		r1 := s.Add(testStoreTree(t, 100))
+		runtime.SetFinalizer(r1, func(*Node) { close(released) })
+
+		r2 := s.Add(testSetLeaf(t, r1, 256, LeafFromUint64(1000).Hash()))
+		s.Prune(r2)
+	}()
+
+	// the root of the pruned tree is not referenced by the store
+	for i := 0; i < 10; i++ {
+		runtime.GC()
+		select {
+		case <-released:
+			return
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	t.Fatal("the pruned tree is not released")
+}
+
+// run with the race detector (go test -race) to find data races
+func TestNodeStorePruneLazy(t *testing.T) {
+	backend := NewMemoryBackend()
+
+	r := testStoreTree(t, 100)
+	require.NoError(t, NewNodeStore(backend).Persist(r))
+
+	s := NewNodeStore(backend)
+	r2, err := s.Load(r.Hash())
+	require.NoError(t, err)
+
+	// the trees are expanded while the store is pruned
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if _, err := r2.Prove(GIndex(256 + i)); err != nil {
+				t.Error(err)
+			}
+		}(i)
+		s.Prune(r2)
+	}
+	wg.Wait()
+
+	// the loaded nodes of the tree are kept
+	num := s.Len()
+	require.Equal(t, 0, s.Prune(r2))
+	require.Equal(t, num, s.Len())
+
+	proof, err := r.Prove(300)
+	require.NoError(t, err)
+	proof2, err := r2.Prove(300)
+	require.NoError(t, err)
+	require.Equal(t, proof, proof2)
+}
+
+func TestNodeStoreCompact(t *testing.T) {
+	dir := t.TempDir()
+
+	backend, err := OpenFileBackend(filepath.Join(dir, "nodes"))
+	require.NoError(t, err)
+	defer backend.Close()
+
+	s := NewNodeStore(backend)
+	r1 := s.Add(testStoreTree(t, 100))
+	require.NoError(t, s.Persist(r1))
+	r2 := s.Add(testSetLeaf(t, r1, 300, LeafFromUint64(1000).Hash()))
+	require.NoError(t, s.Persist(r2))
+
+	compacted, err := OpenFileBackend(filepath.Join(dir, "compacted"))
+	require.NoError(t, err)
+	defer compacted.Close()
+
+	require.NoError(t, s.Compact(compacted, r2.Hash()))
+	require.Less(t, compacted.size, backend.size)
+
+	// the new file only has the nodes of the compacted tree
+	r3, err := NewNodeStore(compacted).Load(r2.Hash())
+	require.NoError(t, err)
+	proof, err := r2.ProveMulti([]GIndex{300, 301, 3})
+	require.NoError(t, err)
+	proof2, err := r3.ProveMulti([]GIndex{300, 301, 3})
+	require.NoError(t, err)
+	require.Equal(t, proof, proof2)
+
+	_, err = NewNodeStore(compacted).Load(r1.Hash())
+	require.ErrorIs(t, err, ErrNodeNotFound)
+
+	// the store uses the compacted backend
+	r4 := s.Add(testSetLeaf(t, r2, 301, LeafFromUint64(2000).Hash()))
+	require.NoError(t, s.Persist(r4))
+	_, err = NewNodeStore(compacted).Load(r4.Hash())
+	require.NoError(t, err)
+
+	// the roots must be persisted
+	require.ErrorIs(t, s.Compact(NewMemoryBackend(), make([]byte, 32)), ErrNodeNotFound)
+}
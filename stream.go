@@ -0,0 +1,98 @@
+package ssz
+
+import "io"
+
+// streamBufferSize is the size of the buffer of a StreamWriter. The encoded
+// bytes are written to the writer once the buffer is full.
+const streamBufferSize = 4096
+
+// StreamMarshaler is the interface implemented by types that can marshal
+// themselves to a StreamWriter.
+type StreamMarshaler interface {
+	MarshalSSZToStream(dst []byte, sw *StreamWriter) ([]byte, error)
+}
+
+// ForkStreamMarshaler is the interface implemented by types that can marshal
+// themselves to a StreamWriter for a given fork.
+type ForkStreamMarshaler interface {
+	MarshalSSZToStreamFork(dst []byte, sw *StreamWriter, fork Fork) ([]byte, error)
+}
+
+// StreamWriter writes the ssz encoding of an object to an io.Writer with a small
+// buffer. The generated MarshalSSZToStream functions append the encoding to the
+// buffer like MarshalSSZTo and flush it to the writer once it is full.
+type StreamWriter struct {
+	w   io.Writer
+	buf []byte
+	n   int
+}
+
+// NewStreamWriter creates a StreamWriter for the writer
+func NewStreamWriter(w io.Writer) *StreamWriter {
+	return &StreamWriter{w: w, buf: make([]byte, 0, streamBufferSize)}
+}
+
+// Written returns the number of bytes written to the writer
+func (s *StreamWriter) Written() int {
+	return s.n
+}
+
+// Flush writes dst to the writer if the buffer is full and
+// returns the buffer to append the next bytes
+func (s *StreamWriter) Flush(dst []byte) ([]byte, error) {
+	if len(dst) < streamBufferSize {
+		return dst, nil
+	}
+	return s.write(dst)
+}
+
+// Append appends b to dst. If they do not fit in the buffer, both
+// are written to the writer without copying b to the buffer.
+func (s *StreamWriter) Append(dst []byte, b []byte) ([]byte, error) {
+	if len(dst)+len(b) < streamBufferSize {
+		return append(dst, b...), nil
+	}
+	dst, err := s.write(dst)
+	if err != nil {
+		return nil, err
+	}
+	n, err := s.w.Write(b)
+	s.n += n
+	if err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
+
+func (s *StreamWriter) write(dst []byte) ([]byte, error) {
+	n, err := s.w.Write(dst)
+	s.n += n
+	if err != nil {
+		return nil, err
+	}
+	// keep the buffer if it has grown with a large fixed size value
+	s.buf = dst[:0]
+	return s.buf, nil
+}
+
+// MarshalSSZToWriter marshals a StreamMarshaler object to the
+// writer and returns the number of bytes written
+func MarshalSSZToWriter(m StreamMarshaler, w io.Writer) (int, error) {
+	sw := NewStreamWriter(w)
+	dst, err := m.MarshalSSZToStream(sw.buf, sw)
+	if err == nil {
+		_, err = sw.write(dst)
+	}
+	return sw.n, err
+}
+
+// MarshalSSZToWriterFork marshals a ForkStreamMarshaler object for the given
+// fork to the writer and returns the number of bytes written
+func MarshalSSZToWriterFork(m ForkStreamMarshaler, w io.Writer, fork Fork) (int, error) {
+	sw := NewStreamWriter(w)
+	dst, err := m.MarshalSSZToStreamFork(sw.buf, sw, fork)
+	if err == nil {
+		_, err = sw.write(dst)
+	}
+	return sw.n, err
+}
@@ -0,0 +1,57 @@
+package ssz
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type failWriter struct {
+	n int
+}
+
+func (f *failWriter) Write(p []byte) (int, error) {
+	if f.n < len(p) {
+		n := f.n
+		f.n = 0
+		return n, errors.New("write failed")
+	}
+	f.n -= len(p)
+	return len(p), nil
+}
+
+func TestStreamWriter(t *testing.T) {
+	var w bytes.Buffer
+	sw := NewStreamWriter(&w)
+
+	// the bytes are buffered until the buffer is full
+	dst, err := sw.Flush(append(sw.buf, 1, 2, 3))
+	require.NoError(t, err)
+	require.Equal(t, 0, w.Len())
+
+	dst, err = sw.Flush(append(dst, make([]byte, streamBufferSize)...))
+	require.NoError(t, err)
+	require.Len(t, dst, 0)
+	require.Equal(t, streamBufferSize+3, w.Len())
+
+	// the large bytes are written without buffering
+	dst, err = sw.Append(append(dst, 4), bytes.Repeat([]byte{5}, 2*streamBufferSize))
+	require.NoError(t, err)
+	require.Len(t, dst, 0)
+	require.Equal(t, 3*streamBufferSize+4, w.Len())
+	require.Equal(t, w.Len(), sw.Written())
+
+	dst, err = sw.Append(dst, []byte{6})
+	require.NoError(t, err)
+	require.Equal(t, []byte{6}, dst)
+}
+
+func TestStreamWriter_Error(t *testing.T) {
+	sw := NewStreamWriter(&failWriter{n: 10})
+
+	_, err := sw.Flush(make([]byte, streamBufferSize))
+	require.Error(t, err)
+	require.Equal(t, 10, sw.Written())
+}
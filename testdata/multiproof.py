@@ -0,0 +1,110 @@
+# Generates multiproof.yaml with the reference functions of the
+# consensus-specs merkle proofs document (ssz/merkle-proofs.md).
+from hashlib import sha256
+
+
+def hash(x):
+    return sha256(x).digest()
+
+
+def generalized_index_sibling(index):
+    return index ^ 1
+
+
+def generalized_index_parent(index):
+    return index // 2
+
+
+def get_branch_indices(tree_index):
+    o = [generalized_index_sibling(tree_index)]
+    while o[-1] > 1:
+        o.append(generalized_index_sibling(generalized_index_parent(o[-1])))
+    return o[:-1]
+
+
+def get_path_indices(tree_index):
+    o = [tree_index]
+    while o[-1] > 1:
+        o.append(generalized_index_parent(o[-1]))
+    return o[:-1]
+
+
+def get_helper_indices(indices):
+    all_helper_indices = set()
+    all_path_indices = set()
+    for index in indices:
+        all_helper_indices = all_helper_indices.union(set(get_branch_indices(index)))
+        all_path_indices = all_path_indices.union(set(get_path_indices(index)))
+
+    return sorted(all_helper_indices.difference(all_path_indices), reverse=True)
+
+
+def calculate_multi_merkle_root(leaves, proof, indices):
+    assert len(leaves) == len(indices)
+    helper_indices = get_helper_indices(indices)
+    assert len(proof) == len(helper_indices)
+    objects = {
+        **{index: node for node, index in zip(leaves, indices)},
+        **{index: node for node, index in zip(proof, helper_indices)}
+    }
+    keys = sorted(objects.keys(), reverse=True)
+    pos = 0
+    while pos < len(keys):
+        k = keys[pos]
+        if k in objects and k ^ 1 in objects and k // 2 not in objects:
+            objects[k // 2] = hash(objects[(k | 1) ^ 1] + objects[k | 1])
+            keys.append(k // 2)
+        pos += 1
+    return objects[1]
+
+
+def build_tree(depth):
+    nodes = {}
+    for i in range(2 ** depth):
+        nodes[2 ** depth + i] = i.to_bytes(8, 'little') + bytes(24)
+    for i in reversed(range(1, 2 ** depth)):
+        nodes[i] = hash(nodes[2 * i] + nodes[2 * i + 1])
+    return nodes
+
+
+def hex(b):
+    return '0x' + b.hex()
+
+
+DEPTH = 5
+CASES = [
+    [32],
+    [63],
+    [2],
+    [3],
+    [32, 33],
+    [32, 63],
+    [40, 41, 42],
+    [34, 47, 56],
+    [8, 37],
+    [6, 7],
+    [20, 21, 22, 23, 31],
+    [50, 13, 5],
+    [1],
+]
+
+nodes = build_tree(DEPTH)
+print('depth: %d' % DEPTH)
+print('leaves:')
+for i in range(2 ** DEPTH):
+    print("  - '%s'" % hex(nodes[2 ** DEPTH + i]))
+print("root: '%s'" % hex(nodes[1]))
+print('cases:')
+for indices in CASES:
+    helpers = get_helper_indices(indices)
+    leaves = [nodes[i] for i in indices]
+    proof = [nodes[i] for i in helpers]
+    assert calculate_multi_merkle_root(leaves, proof, indices) == nodes[1]
+    print('  - indices: [%s]' % ', '.join(str(i) for i in indices))
+    print('    helper_indices: [%s]' % ', '.join(str(i) for i in helpers))
+    print('    leaves:')
+    for leaf in leaves:
+        print("      - '%s'" % hex(leaf))
+    print('    proof:%s' % (' []' if not proof else ''))
+    for h in proof:
+        print("      - '%s'" % hex(h))
@@ -1,23 +1,32 @@
 package ssz
 
 import (
+	"bytes"
 	"encoding/binary"
 	"encoding/hex"
 	"errors"
 	"fmt"
-	"math"
+	"math/big"
+	"math/bits"
+	"sync"
+)
+
+var (
+	// ErrOpaqueNode means that the node is only known by its hash (i.e. the
+	// unproven branches of a tree created from a multiproof) and it has no children
+	ErrOpaqueNode = fmt.Errorf("opaque node")
 )
 
 // Proof represents a merkle proof against a general index.
 type Proof struct {
-	Index  int
+	Index  GIndex
 	Leaf   []byte
 	Hashes [][]byte
 }
 
 // Multiproof represents a merkle proof of several leaves.
 type Multiproof struct {
-	Indices []int
+	Indices []GIndex
 	Leaves  [][]byte
 	Hashes  [][]byte
 }
@@ -25,6 +34,12 @@ type Multiproof struct {
 // Compress returns a new proof with zero hashes omitted.
 // See `CompressedMultiproof` for more info.
 func (p *Multiproof) Compress() *CompressedMultiproof {
+	return p.CompressWithHashing(DefaultHashing)
+}
+
+// CompressWithHashing returns a new proof with the zero
+// hashes of the given Hashing omitted.
+func (p *Multiproof) CompressWithHashing(hh *Hashing) *CompressedMultiproof {
 	compressed := &CompressedMultiproof{
 		Indices:    p.Indices,
 		Leaves:     p.Leaves,
@@ -33,7 +48,7 @@ func (p *Multiproof) Compress() *CompressedMultiproof {
 	}
 
 	for _, h := range p.Hashes {
-		if l, ok := zeroHashLevels[string(h)]; ok {
+		if l, ok := hh.zeroLevel(h); ok {
 			compressed.ZeroLevels = append(compressed.ZeroLevels, l)
 			compressed.Hashes = append(compressed.Hashes, nil)
 		} else {
@@ -48,7 +63,7 @@ func (p *Multiproof) Compress() *CompressedMultiproof {
 // Compression is achieved by omitting zero hashes (and their hashes). `ZeroLevels`
 // contains information which helps the verifier fill in those hashes.
 type CompressedMultiproof struct {
-	Indices    []int
+	Indices    []GIndex
 	Leaves     [][]byte
 	Hashes     [][]byte
 	ZeroLevels []int // Stores the level for every omitted zero hash in the proof
@@ -57,6 +72,12 @@ type CompressedMultiproof struct {
 // Decompress returns a new multiproof, filling in the omitted
 // zero hashes. See `CompressedMultiProof` for more info.
 func (c *CompressedMultiproof) Decompress() *Multiproof {
+	return c.DecompressWithHashing(DefaultHashing)
+}
+
+// DecompressWithHashing returns a new multiproof, filling in
+// the omitted zero hashes of the given Hashing.
+func (c *CompressedMultiproof) DecompressWithHashing(hh *Hashing) *Multiproof {
 	p := &Multiproof{
 		Indices: c.Indices,
 		Leaves:  c.Leaves,
@@ -66,7 +87,7 @@ func (c *CompressedMultiproof) Decompress() *Multiproof {
 	zc := 0
 	for i, h := range c.Hashes {
 		if h == nil {
-			p.Hashes[i] = zeroHashes[c.ZeroLevels[zc]][:]
+			p.Hashes[i] = hh.ZeroHash(c.ZeroLevels[zc])
 			zc++
 		} else {
 			p.Hashes[i] = c.Hashes[i]
@@ -77,13 +98,52 @@ func (c *CompressedMultiproof) Decompress() *Multiproof {
 }
 
 // Node represents a node in the tree
-// backing of a SSZ object.
+// backing of a SSZ object. The nodes are immutable once created
+// and safe to use by concurrent readers.
 type Node struct {
 	left    *Node
 	right   *Node
 	isEmpty bool
 
+	// value is the value of a leaf node or the hash of a branch node
 	value []byte
+	// once guards the computation of the hash of a branch node
+	once sync.Once
+
+	// hashing is the hash function of the branch and empty
+	// nodes. If nil, DefaultHashing is used
+	hashing *Hashing
+
+	// store is set for the branch nodes loaded from a NodeStore. The
+	// children of these nodes are loaded the first time they are used
+	store    *NodeStore
+	loadOnce sync.Once
+	loadErr  error
+
+	// opaque is set for the nodes of a partial tree (see NodeFromMultiproof)
+	// that are known only by their hash
+	opaque bool
+}
+
+// expand loads the children of a node from the store
+func (n *Node) expand() error {
+	if n.store == nil {
+		return nil
+	}
+	n.loadOnce.Do(func() {
+		n.loadErr = n.store.loadChildren(n)
+	})
+	return n.loadErr
+}
+
+func (n *Node) kind() byte {
+	if n.store != nil || n.left != nil || n.right != nil {
+		return nodeKindBranch
+	}
+	if n.isEmpty {
+		return nodeKindEmpty
+	}
+	return nodeKindLeaf
 }
 
 func (n *Node) Show(maxDepth int) {
@@ -103,11 +163,14 @@ func (n *Node) show(depth int, maxDepth int) {
 		}
 	}
 
+	if err := n.expand(); err != nil {
+		print("ERROR: " + err.Error() + "\n")
+		return
+	}
 	if n.left != nil || n.right != nil {
 		// leaf hash is the same as value
 		print("HASH: " + hex.EncodeToString(n.Hash()) + "\n")
-	}
-	if n.value != nil {
+	} else if n.value != nil {
 		print("VALUE: " + hex.EncodeToString(n.value) + "\n")
 	}
 
@@ -142,6 +205,14 @@ func NewNodeWithLR(left, right *Node) *Node {
 	return &Node{left: left, right: right, value: nil}
 }
 
+func newEmptyNodeWithHashing(zeroOrderHash []byte, hh *Hashing) *Node {
+	return &Node{value: zeroOrderHash, isEmpty: true, hashing: hh}
+}
+
+func newNodeWithLRAndHashing(left, right *Node, hh *Hashing) *Node {
+	return &Node{left: left, right: right, hashing: hh}
+}
+
 // TreeFromChunks constructs a tree from leaf values.
 // The number of leaves should be a power of 2.
 func TreeFromChunks(chunks [][]byte) (*Node, error) {
@@ -162,14 +233,20 @@ func TreeFromChunks(chunks [][]byte) (*Node, error) {
 // The limit should be a power of 2.
 // Adjacent sibling nodes will be filled with zero order hashes that have been precomputed based on the tree depth.
 func TreeFromNodes(leaves []*Node, limit int) (*Node, error) {
+	return TreeFromNodesWithHashing(leaves, limit, DefaultHashing)
+}
+
+// TreeFromNodesWithHashing constructs a tree from leaf nodes with the given Hashing.
+// See TreeFromNodes for more details.
+func TreeFromNodesWithHashing(leaves []*Node, limit int, hh *Hashing) (*Node, error) {
 	numLeaves := len(leaves)
 
 	depth := floorLog2(limit)
-	zeroOrderHashes := getZeroOrderHashes(depth)
+	zeroOrderHashes := getZeroOrderHashes(depth, hh)
 
 	// there are no leaves, return a zero order hash node
 	if numLeaves == 0 {
-		return NewEmptyNode(zeroOrderHashes[0]), nil
+		return newEmptyNodeWithHashing(zeroOrderHashes[0], hh), nil
 	}
 
 	// now we know numLeaves are at least 1.
@@ -182,10 +259,10 @@ func TreeFromNodes(leaves []*Node, limit int) (*Node, error) {
 	if limit == 2 {
 		// but we only have 1 leaf, add a zero order hash as the right node
 		if numLeaves == 1 {
-			return NewNodeWithLR(leaves[0], NewEmptyNode(zeroOrderHashes[1])), nil
+			return newNodeWithLRAndHashing(leaves[0], newEmptyNodeWithHashing(zeroOrderHashes[1], hh), hh), nil
 		}
 		// otherwise return the two nodes we have
-		return NewNodeWithLR(leaves[0], leaves[1]), nil
+		return newNodeWithLRAndHashing(leaves[0], leaves[1], hh), nil
 	}
 
 	if !isPowerOfTwo(limit) {
@@ -216,16 +293,16 @@ func TreeFromNodes(leaves []*Node, limit int) (*Node, error) {
 				}
 				// node with empty right node, add zero order hash as right node and mark right node as empty
 				if nodes[leftIndex] != nil && nodes[rightIndex] == nil {
-					nodes[i] = NewNodeWithLR(nodes[leftIndex], NewEmptyNode(zeroOrderHashes[k+1]))
+					nodes[i] = newNodeWithLRAndHashing(nodes[leftIndex], newEmptyNodeWithHashing(zeroOrderHashes[k+1], hh), hh)
 				}
 				// node with left and right child
 				if nodes[leftIndex] != nil && nodes[rightIndex] != nil {
-					nodes[i] = NewNodeWithLR(nodes[leftIndex], nodes[rightIndex])
+					nodes[i] = newNodeWithLRAndHashing(nodes[leftIndex], nodes[rightIndex], hh)
 				}
 			}
 		}
 		nodesStartIndex = nodesStartIndex / 2
-		nodesEndIndex = int(math.Floor(float64(nodesEndIndex)) / 2)
+		nodesEndIndex = nodesEndIndex / 2
 	}
 
 	rootNode := nodes[1]
@@ -238,27 +315,91 @@ func TreeFromNodes(leaves []*Node, limit int) (*Node, error) {
 }
 
 func TreeFromNodesWithMixin(leaves []*Node, num, limit int) (*Node, error) {
+	return TreeFromNodesWithMixinAndHashing(leaves, num, limit, DefaultHashing)
+}
+
+// TreeFromNodesWithMixinAndHashing constructs a tree from leaf nodes with the length
+// mixin and the given Hashing.
+func TreeFromNodesWithMixinAndHashing(leaves []*Node, num, limit int, hh *Hashing) (*Node, error) {
 	if !isPowerOfTwo(limit) {
 		return nil, errors.New("size of tree should be a power of 2")
 	}
 
-	mainTree, err := TreeFromNodes(leaves, limit)
+	mainTree, err := TreeFromNodesWithHashing(leaves, limit, hh)
 	if err != nil {
 		return nil, err
 	}
 
 	// Mixin len
 	countLeaf := LeafFromUint64(uint64(num))
-	node := NewNodeWithLR(mainTree, countLeaf)
+	node := newNodeWithLRAndHashing(mainTree, countLeaf, hh)
 	return node, nil
 }
 
+// NodeFromMultiproof creates a partial tree from a multiproof of the tree with the given root.
+// The tree only has the paths from the root to the proven leaves, the rest of the branches are
+// opaque nodes that are known only by their hash (the hashes of the proof). The leaves are also
+// opaque since they might be the root of a subtree. Get, Set and Hash can be used on the proven
+// paths and return an ErrOpaqueNode error if the path goes through an opaque node.
+func NodeFromMultiproof(root []byte, p *Multiproof) (*Node, error) {
+	return NodeFromMultiproofWithHashing(root, p, DefaultHashing)
+}
+
+// NodeFromMultiproofWithHashing creates a partial tree from a multiproof with the given Hashing
+func NodeFromMultiproofWithHashing(root []byte, p *Multiproof, hh *Hashing) (*Node, error) {
+	nodes, err := multiproofNodes(p.Hashes, p.Leaves, p.Indices)
+	if err != nil {
+		return nil, err
+	}
+	for i := range nodes {
+		nodes[i].node = &Node{value: nodes[i].hash, opaque: true, hashing: hh}
+	}
+
+	res, err := walkMultiproof(nodes, func(left, right *proofNode) proofNode {
+		return proofNode{node: newNodeWithLRAndHashing(left.node, right.node, hh)}
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(hashNode(res.node), root) {
+		return nil, errors.New("multiproof does not match the root")
+	}
+	return res.node, nil
+}
+
 // Get fetches a node with the given general index.
-func (n *Node) Get(index int) (*Node, error) {
-	pathLen := getPathLength(index)
+func (n *Node) Get(index GIndex) (*Node, error) {
+	if index < 1 {
+		return nil, fmt.Errorf("invalid index %d", index)
+	}
+	return n.getPath(getPathLength(index), func(level int) bool {
+		return getPosAtLevel(index, level)
+	})
+}
+
+// GetBig fetches a node with the given general index for trees
+// deeper than the depth supported by GIndex.
+func (n *Node) GetBig(index *big.Int) (*Node, error) {
+	if index.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid index %s", index.String())
+	}
+	return n.getPath(index.BitLen()-1, func(level int) bool {
+		return index.Bit(level) == 1
+	})
+}
+
+// getPath returns the node at the end of a path of the given length, isRight
+// returns the direction at each level (being 0 the level of the node).
+func (n *Node) getPath(pathLen int, isRight func(level int) bool) (*Node, error) {
 	cur := n
 	for i := pathLen - 1; i >= 0; i-- {
-		if isRight := getPosAtLevel(index, i); isRight {
+		if err := cur.expand(); err != nil {
+			return nil, err
+		}
+		if cur.opaque {
+			return nil, fmt.Errorf("%w at depth %d cannot be traversed", ErrOpaqueNode, pathLen-1-i)
+		}
+		if isRight(i) {
 			cur = cur.right
 		} else {
 			cur = cur.left
@@ -271,6 +412,78 @@ func (n *Node) Get(index int) (*Node, error) {
 	return cur, nil
 }
 
+// Set replaces the node at the given general index and returns the root of the new tree.
+// The tree is not modified, the nodes in the path from the root to the index are copied
+// (copy-on-write) and the rest of the nodes are shared between both trees. Then, only
+// the hashes of the nodes in the path are computed again.
+// Empty subtrees (zero hashes) in the path are expanded.
+func (n *Node) Set(index GIndex, node *Node) (*Node, error) {
+	if index < 1 {
+		return nil, fmt.Errorf("invalid index %d", index)
+	}
+	if node == nil {
+		return nil, errors.New("cannot set a nil node")
+	}
+	return n.set(index, getPathLength(index)-1, node)
+}
+
+// SetLeaf replaces the node at the given general index with a leaf
+// and returns the root of the new tree. See Set for more details.
+func (n *Node) SetLeaf(index GIndex, value []byte) (*Node, error) {
+	if len(value) > 32 {
+		return nil, fmt.Errorf("leaf value of %d bytes is larger than 32 bytes", len(value))
+	}
+	buf := make([]byte, 32)
+	copy(buf, value)
+	return n.Set(index, NewNodeWithValue(buf))
+}
+
+func (n *Node) set(index GIndex, level int, node *Node) (*Node, error) {
+	if level < 0 {
+		return node, nil
+	}
+
+	left, right, err := n.children()
+	if err == ErrOpaqueNode {
+		return nil, fmt.Errorf("%w %d cannot be traversed", ErrOpaqueNode, index>>(level+1))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if isRight := getPosAtLevel(index, level); isRight {
+		right, err = right.set(index, level-1, node)
+	} else {
+		left, err = left.set(index, level-1, node)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return newNodeWithLRAndHashing(left, right, n.hashing), nil
+}
+
+// children returns the children of a branch node or
+// the children of the subtree of an empty node
+func (n *Node) children() (*Node, *Node, error) {
+	if err := n.expand(); err != nil {
+		return nil, nil, err
+	}
+	if n.opaque {
+		return nil, nil, ErrOpaqueNode
+	}
+	if n.left != nil && n.right != nil {
+		return n.left, n.right, nil
+	}
+	if n.isEmpty {
+		hh := orDefaultHashing(n.hashing)
+		level, ok := hh.zeroLevel(n.value)
+		if ok && level > 0 {
+			child := hh.ZeroHash(level - 1)
+			return newEmptyNodeWithHashing(child, n.hashing), newEmptyNodeWithHashing(child, n.hashing), nil
+		}
+	}
+	return nil, nil, errors.New("Node not found in tree")
+}
+
 // Hash returns the hash of the subtree with the given Node as its root.
 // If root has no children, it returns root's value (not its hash).
 func (n *Node) Hash() []byte {
@@ -279,6 +492,10 @@ func (n *Node) Hash() []byte {
 }
 
 func hashNode(n *Node) []byte {
+	if n.store != nil {
+		// the hash of the nodes from a store is known
+		return n.value
+	}
 	if n.left == nil && n.right == nil {
 		return n.value
 	}
@@ -287,41 +504,46 @@ func hashNode(n *Node) []byte {
 		panic("Tree incomplete")
 	}
 
-	if n.right.isEmpty {
-		result := hashFn(append(hashNode(n.left), n.right.value...))
-		n.value = result // Set the hash result on each node so that proofs can be generated for any level
-		return result
-	}
-
-	result := hashFn(append(hashNode(n.left), hashNode(n.right)...))
-	n.value = result
-	return result
+	// The hash is computed only once and cached in the node so that proofs can be generated
+	// for any level. Since the nodes are not modified once created (see Set), the cached hash
+	// is always valid.
+	n.once.Do(func() {
+		n.value = orDefaultHashing(n.hashing).hash(hashNode(n.left), hashNode(n.right))
+	})
+	return n.value
 }
 
-// getZeroOrderHashes precomputes zero order hashes to create an easy map lookup
-// for zero leafs and their parent nodes.
-func getZeroOrderHashes(depth int) map[int][]byte {
+// getZeroOrderHashes returns the zero order hashes of a tree of the given depth
+// to create an easy map lookup for zero leafs and their parent nodes.
+func getZeroOrderHashes(depth int, hh *Hashing) map[int][]byte {
 	zeroOrderHashes := make(map[int][]byte)
-
-	emptyValue := make([]byte, 32)
-	zeroOrderHashes[depth] = emptyValue
-
-	for i := depth - 1; i >= 0; i-- {
-		zeroOrderHashes[i] = hashFn(append(zeroOrderHashes[i+1], zeroOrderHashes[i+1]...))
+	for i := depth; i >= 0; i-- {
+		zeroOrderHashes[i] = hh.ZeroHash(depth - i)
 	}
-
 	return zeroOrderHashes
 }
 
 // Prove returns a list of sibling values and hashes needed
 // to compute the root hash for a given general index.
-func (n *Node) Prove(index int) (*Proof, error) {
+func (n *Node) Prove(index GIndex) (*Proof, error) {
+	if index < 1 {
+		return nil, fmt.Errorf("invalid index %d", index)
+	}
 	pathLen := getPathLength(index)
 	proof := &Proof{Index: index}
 	hashes := make([][]byte, 0, pathLen)
 
 	cur := n
 	for i := pathLen - 1; i >= 0; i-- {
+		if err := cur.expand(); err != nil {
+			return nil, err
+		}
+		if cur.opaque {
+			return nil, fmt.Errorf("%w %d cannot be traversed", ErrOpaqueNode, index>>(i+1))
+		}
+		if cur.left == nil || cur.right == nil {
+			return nil, errors.New("Node not found in tree")
+		}
 		var siblingHash []byte
 		if isRight := getPosAtLevel(index, i); isRight {
 			siblingHash = hashNode(cur.left)
@@ -337,13 +559,18 @@ func (n *Node) Prove(index int) (*Proof, error) {
 	}
 
 	proof.Hashes = hashes
-	proof.Leaf = cur.value
+	proof.Leaf = hashNode(cur)
 
 	return proof, nil
 }
 
-func (n *Node) ProveMulti(indices []int) (*Multiproof, error) {
-	reqIndices := getRequiredIndices(indices)
+// ProveMulti returns the leaves and the hashes of the helper indices needed
+// to compute the root hash for several general indices.
+func (n *Node) ProveMulti(indices []GIndex) (*Multiproof, error) {
+	if err := checkIndices(indices); err != nil {
+		return nil, err
+	}
+	reqIndices := getHelperIndices(indices)
 	proof := &Multiproof{Indices: indices, Leaves: make([][]byte, len(indices)), Hashes: make([][]byte, len(reqIndices))}
 
 	for i, gi := range indices {
@@ -351,7 +578,7 @@ func (n *Node) ProveMulti(indices []int) (*Multiproof, error) {
 		if err != nil {
 			return nil, err
 		}
-		proof.Leaves[i] = node.value
+		proof.Leaves[i] = hashNode(node)
 	}
 
 	for i, gi := range reqIndices {
@@ -365,6 +592,29 @@ func (n *Node) ProveMulti(indices []int) (*Multiproof, error) {
 	return proof, nil
 }
 
+// ProveListLength returns a proof of the length mix-in leaf of the list at the given
+// general index (the right child of the list root, see TreeFromNodesWithMixin).
+func (n *Node) ProveListLength(list GIndex) (*Proof, error) {
+	index, err := list.Right()
+	if err != nil {
+		return nil, err
+	}
+	return n.Prove(index)
+}
+
+// ProveZeroSubtree returns a proof of the node at the given general index
+// and checks that the node is the root of an empty subtree (zero hash).
+func (n *Node) ProveZeroSubtree(index GIndex) (*Proof, error) {
+	proof, err := n.Prove(index)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := orDefaultHashing(n.hashing).zeroLevel(proof.Leaf); !ok {
+		return nil, fmt.Errorf("node %d is not an empty subtree", index)
+	}
+	return proof, nil
+}
+
 func LeafFromUint64(i uint64) *Node {
 	buf := make([]byte, 32)
 	binary.LittleEndian.PutUint64(buf[:8], i)
@@ -440,9 +690,9 @@ func isPowerOfTwo(n int) bool {
 }
 
 func floorLog2(n int) int {
-	return int(math.Floor(math.Log2(float64(n))))
+	return bits.Len(uint(n)) - 1
 }
 
 func powerTwo(n int) int {
-	return int(math.Pow(2, float64(n)))
+	return 1 << n
 }
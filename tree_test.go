@@ -3,6 +3,8 @@ package ssz
 import (
 	"bytes"
 	"encoding/hex"
+	"math/big"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -20,7 +22,7 @@ func TestTreeFromChunks(t *testing.T) {
 	if err != nil {
 		t.Errorf("Failed to construct tree: %v\n", err)
 	}
-	for i := 4; i < 8; i++ {
+	for i := GIndex(4); i < 8; i++ {
 		l, err := r.Get(i)
 		if err != nil {
 			t.Errorf("Failed getting leaf: %v\n", err)
@@ -124,6 +126,25 @@ func TestHashTree(t *testing.T) {
 	}
 }
 
+func TestWrapperListLimitNotPowerOfTwo(t *testing.T) {
+	// a list of 40 uint64 has a limit of 10 chunks, which is not a power of two
+	for _, num := range []int{0, 1, 4, 5, 40} {
+		list := []uint64{}
+		for i := 0; i < num; i++ {
+			list = append(list, uint64(i+1))
+		}
+
+		hh := NewHasher()
+		hh.PutUint64Array(list, 40)
+		root, err := hh.HashRoot()
+		require.NoError(t, err)
+
+		w := &Wrapper{}
+		w.PutUint64Array(list, 40)
+		require.Equal(t, root[:], w.Node().Hash())
+	}
+}
+
 func TestProve(t *testing.T) {
 	expectedProofHex := []string{
 		"0000",
@@ -180,7 +201,7 @@ func TestProveMulti(t *testing.T) {
 		t.Errorf("Failed to construct tree: %v\n", err)
 	}
 
-	p, err := r.ProveMulti([]int{6, 7})
+	p, err := r.ProveMulti([]GIndex{6, 7})
 	if err != nil {
 		t.Errorf("Failed to generate proof: %v\n", err)
 	}
@@ -190,10 +211,10 @@ func TestProveMulti(t *testing.T) {
 	}
 }
 
-func TestGetRequiredIndices(t *testing.T) {
-	indices := []int{10, 48, 49}
-	expected := []int{25, 13, 11, 7, 4}
-	req := getRequiredIndices(indices)
+func TestGetHelperIndices(t *testing.T) {
+	indices := []GIndex{10, 48, 49}
+	expected := []GIndex{25, 13, 11, 7, 4}
+	req := getHelperIndices(indices)
 	if len(expected) != len(req) {
 		t.Fatalf("Required indices has wrong length. Expected %d, got %d\n", len(expected), len(req))
 	}
@@ -203,3 +224,354 @@ func TestGetRequiredIndices(t *testing.T) {
 		}
 	}
 }
+
+func TestConcatGIndices(t *testing.T) {
+	cases := []struct {
+		indices []GIndex
+		res     GIndex
+	}{
+		{[]GIndex{}, 1},
+		{[]GIndex{1}, 1},
+		{[]GIndex{1, 5}, 5},
+		{[]GIndex{52, 3}, 105},
+		{[]GIndex{2, 3, 4}, 20},
+		{[]GIndex{1 << 32, 1 << 31}, 1 << 63},
+	}
+	for _, c := range cases {
+		res, err := ConcatGIndices(c.indices...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res != c.res {
+			t.Errorf("Invalid concatenation of %v. Expected %d, got %d\n", c.indices, c.res, res)
+		}
+
+		// the big.Int version returns the same result
+		bigIndices := []*big.Int{}
+		for _, index := range c.indices {
+			bigIndices = append(bigIndices, index.Big())
+		}
+		bigRes, err := ConcatGIndicesBig(bigIndices...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bigRes.Cmp(c.res.Big()) != 0 {
+			t.Errorf("Invalid big concatenation of %v. Expected %d, got %s\n", c.indices, c.res, bigRes.String())
+		}
+	}
+
+	// overflow
+	if _, err := ConcatGIndices(1<<32, 1<<32); err != ErrGIndexOverflow {
+		t.Errorf("Expected overflow but got %v", err)
+	}
+	if _, err := ConcatGIndices(0, 3); err == nil {
+		t.Error("Expected error for index 0")
+	}
+
+	// the big.Int version has no limit
+	res, err := ConcatGIndicesBig(big.NewInt(1<<32), big.NewInt(1<<32), big.NewInt(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 1 followed by 64 zero bits (left) and a 1 (right)
+	expected := new(big.Int).Lsh(big.NewInt(1), 65)
+	expected.SetBit(expected, 0, 1)
+	if res.Cmp(expected) != 0 {
+		t.Errorf("Invalid big concatenation. Expected %s, got %s", expected.String(), res.String())
+	}
+	if _, err := GIndexFromBig(res); err != ErrGIndexOverflow {
+		t.Errorf("Expected overflow but got %v", err)
+	}
+}
+
+func TestGIndex(t *testing.T) {
+	cases := []struct {
+		index GIndex
+		depth int
+	}{
+		{1, 0},
+		{2, 1},
+		{3, 1},
+		{1<<48 - 1, 47},
+		{1 << 48, 48},
+		{1<<53 + 1, 53},
+		{1<<63 + 1, 63},
+		{1<<64 - 1, 63},
+	}
+	for _, c := range cases {
+		// math.Log2 on float64 rounds up for the indices close to a power of 2
+		if depth := c.index.Depth(); depth != c.depth {
+			t.Errorf("Invalid depth of %d. Expected %d, got %d", c.index, c.depth, depth)
+		}
+	}
+
+	g, err := NewGIndex(3, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g != 13 || !g.IsRight() || g.Parent() != 6 || g.Sibling() != 12 {
+		t.Errorf("Invalid generalized index %d", g)
+	}
+	if left, err := g.Left(); err != nil || left != 26 {
+		t.Errorf("Invalid left child %d", left)
+	}
+	if right, err := g.Right(); err != nil || right != 27 {
+		t.Errorf("Invalid right child %d", right)
+	}
+	if _, err := NewGIndex(3, 8); err == nil {
+		t.Error("Expected error for out of range index")
+	}
+	if _, err := NewGIndex(64, 0); err != ErrGIndexOverflow {
+		t.Errorf("Expected overflow but got %v", err)
+	}
+	if _, err := GIndex(1 << 63).Left(); err != ErrGIndexOverflow {
+		t.Errorf("Expected overflow but got %v", err)
+	}
+}
+
+func TestNodeGetBig(t *testing.T) {
+	// a tree of depth 70 with only the left-most path expanded
+	leaf := LeafFromUint64(1)
+	r := leaf
+	for i := 0; i < 70; i++ {
+		r = NewNodeWithLR(r, LeafFromUint64(uint64(i)))
+	}
+
+	index := new(big.Int).Lsh(big.NewInt(1), 70)
+	node, err := r.GetBig(index)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if node != leaf {
+		t.Error("Incorrect node at index 2^70")
+	}
+	if _, err := GIndexFromBig(index); err != ErrGIndexOverflow {
+		t.Errorf("Expected overflow but got %v", err)
+	}
+
+	// the node at depth 63 can be addressed with a GIndex
+	node, err = r.Get(1 << 63)
+	if err != nil {
+		t.Fatal(err)
+	}
+	node2, err := r.GetBig(new(big.Int).Lsh(big.NewInt(1), 63))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if node != node2 {
+		t.Error("Incorrect node at index 2^63")
+	}
+
+	if _, err := r.Get(0); err == nil {
+		t.Error("Expected error for index 0")
+	}
+}
+
+func TestNodeSet(t *testing.T) {
+	chunks := [][]byte{
+		LeafFromUint64(1).value,
+		LeafFromUint64(2).value,
+		LeafFromUint64(3).value,
+		LeafFromUint64(4).value,
+	}
+	r, err := TreeFromChunks(chunks)
+	require.NoError(t, err)
+	root := r.Hash()
+
+	r2, err := r.SetLeaf(5, LeafFromUint64(10).value)
+	require.NoError(t, err)
+
+	// the original tree is not modified
+	require.Equal(t, root, r.Hash())
+
+	chunks[1] = LeafFromUint64(10).value
+	expected, err := TreeFromChunks(chunks)
+	require.NoError(t, err)
+	require.Equal(t, expected.Hash(), r2.Hash())
+
+	// the nodes outside of the path are shared
+	require.Same(t, r.right, r2.right)
+	require.Same(t, r.left.left, r2.left.left)
+
+	// replace a subtree
+	r3, err := r2.Set(3, r.right)
+	require.NoError(t, err)
+	require.Same(t, r.right, r3.right)
+
+	_, err = r.Set(8, EmptyLeaf())
+	require.Error(t, err)
+
+	_, err = r.SetLeaf(4, make([]byte, 33))
+	require.Error(t, err)
+}
+
+func TestNodeSetEmptySubtree(t *testing.T) {
+	r, err := TreeFromNodes([]*Node{LeafFromUint64(1)}, 8)
+	require.NoError(t, err)
+
+	// index 11 is part of an empty subtree
+	r2, err := r.SetLeaf(11, LeafFromUint64(4).value)
+	require.NoError(t, err)
+
+	expected, err := TreeFromNodes([]*Node{LeafFromUint64(1), EmptyLeaf(), EmptyLeaf(), LeafFromUint64(4)}, 8)
+	require.NoError(t, err)
+	require.Equal(t, expected.Hash(), r2.Hash())
+}
+
+func testConcurrentTree(t *testing.T) *Node {
+	leaves := []*Node{}
+	for i := 0; i < 100; i++ {
+		leaves = append(leaves, LeafFromUint64(uint64(i)))
+	}
+	r, err := TreeFromNodesWithMixin(leaves, len(leaves), 128)
+	require.NoError(t, err)
+	return r
+}
+
+// run with the race detector (go test -race) to find data races
+func TestNodeConcurrentHash(t *testing.T) {
+	r := testConcurrentTree(t)
+	expected := testConcurrentTree(t).Hash()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if !bytes.Equal(expected, r.Hash()) {
+				t.Error("bad root")
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestNodeConcurrentProve(t *testing.T) {
+	r := testConcurrentTree(t)
+	root := testConcurrentTree(t).Hash()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			index := GIndex(256 + i)
+			proof, err := r.Prove(index)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if ok, err := VerifyProof(root, proof); err != nil || !ok {
+				t.Errorf("failed to verify proof for index %d", index)
+			}
+
+			multiproof, err := r.ProveMulti([]GIndex{index, 3})
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if ok, err := VerifyMultiproof(root, multiproof.Hashes, multiproof.Leaves, multiproof.Indices); err != nil || !ok {
+				t.Errorf("failed to verify multiproof for index %d", index)
+			}
+
+			if _, err := r.Get(index); err != nil {
+				t.Error(err)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestNodeConcurrentSet(t *testing.T) {
+	r := testConcurrentTree(t)
+	root := testConcurrentTree(t).Hash()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			// each goroutine updates its own copy of the tree
+			r2, err := r.SetLeaf(GIndex(256+i), LeafFromUint64(1000).Hash())
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if bytes.Equal(root, r2.Hash()) {
+				t.Error("root not updated")
+			}
+			if !bytes.Equal(root, r.Hash()) {
+				t.Error("original root updated")
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestNodeFromMultiproof(t *testing.T) {
+	r := testConcurrentTree(t)
+
+	proof, err := r.ProveMulti([]GIndex{256, 300, 3})
+	require.NoError(t, err)
+
+	partial, err := NodeFromMultiproof(r.Hash(), proof)
+	require.NoError(t, err)
+	require.Equal(t, r.Hash(), partial.Hash())
+
+	// the partial tree can prove the same indices
+	proof2, err := partial.ProveMulti(proof.Indices)
+	require.NoError(t, err)
+	require.Equal(t, proof, proof2)
+
+	// the helper nodes are opaque
+	_, err = partial.Get(257)
+	require.NoError(t, err)
+	_, err = partial.Get(5)
+	require.NoError(t, err)
+	_, err = partial.Get(10)
+	require.ErrorIs(t, err, ErrOpaqueNode)
+	_, err = partial.Prove(10)
+	require.ErrorIs(t, err, ErrOpaqueNode)
+
+	// the proven leaves are opaque too
+	_, err = partial.Get(6)
+	require.ErrorIs(t, err, ErrOpaqueNode)
+
+	// invalid multiproofs
+	_, err = NodeFromMultiproof(make([]byte, 32), proof)
+	require.Error(t, err)
+
+	proof.Hashes = proof.Hashes[1:]
+	_, err = NodeFromMultiproof(r.Hash(), proof)
+	require.Error(t, err)
+}
+
+func TestDiffTrees(t *testing.T) {
+	a := testConcurrentTree(t)
+	require.Empty(t, DiffTrees(a, a))
+
+	b, err := a.SetLeaf(300, LeafFromUint64(1).Hash())
+	require.NoError(t, err)
+	b, err = b.SetLeaf(301, LeafFromUint64(2).Hash())
+	require.NoError(t, err)
+	b, err = b.SetLeaf(257, LeafFromUint64(3).Hash())
+	require.NoError(t, err)
+	require.Equal(t, []GIndex{257, 300, 301}, DiffTrees(a, b))
+
+	// subtree with a different structure
+	c, err := a.Set(3, LeafFromUint64(1))
+	require.NoError(t, err)
+	require.Equal(t, []GIndex{3}, DiffTrees(a, c))
+}
+
+func TestDiffTreesEmptySubtree(t *testing.T) {
+	a, err := TreeFromNodesWithMixin([]*Node{LeafFromUint64(1)}, 1, 8)
+	require.NoError(t, err)
+	b, err := TreeFromNodesWithMixin([]*Node{LeafFromUint64(1), LeafFromUint64(2), LeafFromUint64(3)}, 3, 8)
+	require.NoError(t, err)
+
+	// the empty subtree of a is expanded up to the leaves
+	require.Equal(t, []GIndex{17, 18, 3}, DiffTrees(a, b))
+}
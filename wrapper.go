@@ -12,9 +12,24 @@ func ProofTree(v HashRoot) (*Node, error) {
 	return w.Node(), nil
 }
 
+// ProofTreeWithHashing returns the tree of a HashRoot object with the given Hashing
+func ProofTreeWithHashing(v HashRoot, hh *Hashing) (*Node, error) {
+	w := &Wrapper{hashing: hh}
+	if err := v.HashTreeRootWith(w); err != nil {
+		return nil, err
+	}
+	return w.Node(), nil
+}
+
 type Wrapper struct {
 	nodes []*Node
 	buf   []byte
+
+	// store is an optional NodeStore to deduplicate the subtrees
+	store *NodeStore
+
+	// hashing is the hash function of the tree. If nil, DefaultHashing is used
+	hashing *Hashing
 }
 
 /// --- wrapper implements the HashWalker interface ---
@@ -189,10 +204,13 @@ func (w *Wrapper) Hash() []byte {
 
 func (w *Wrapper) Commit(i int) {
 	// create tree from nodes
-	res, err := TreeFromNodes(w.nodes[i:], w.getLimit(i))
+	res, err := TreeFromNodesWithHashing(w.nodes[i:], w.getLimit(i), orDefaultHashing(w.hashing))
 	if err != nil {
 		panic(err)
 	}
+	if w.store != nil {
+		res = w.store.Add(res)
+	}
 	// remove the old nodes
 	w.nodes = w.nodes[:i]
 	// add the new node
@@ -200,11 +218,19 @@ func (w *Wrapper) Commit(i int) {
 }
 
 func (w *Wrapper) CommitWithMixin(i, num, limit int) {
+	if !isPowerOfTwo(limit) {
+		// the limit in chunks of a list of basic types (i.e. 10 uint64) is
+		// padded to the next power of two as the Hasher does
+		limit = int(nextPowerOfTwo(uint64(limit)))
+	}
 	// create tree from nodes
-	res, err := TreeFromNodesWithMixin(w.nodes[i:], num, limit)
+	res, err := TreeFromNodesWithMixinAndHashing(w.nodes[i:], num, limit, orDefaultHashing(w.hashing))
 	if err != nil {
 		panic(err)
 	}
+	if w.store != nil {
+		res = w.store.Add(res)
+	}
 	// remove the old nodes
 	w.nodes = w.nodes[:i]
 